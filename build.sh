@@ -4,8 +4,23 @@
 echo "Building DigiLogRT..."
 mkdir -p bin
 
+# sqlite_fts5 compiles SQLite full-text search into go-sqlite3 for ranked
+# repeater search. Builds without it fall back to substring matching
+TAGS="sqlite_fts5"
+
+# Compile every package and command first so a broken tool or a stray
+# import path can't go unnoticed just because the GUI still builds
+echo "Checking all packages and commands..."
+go build -tags "$TAGS" ./...
+CHECK_EXIT_CODE=$?
+if [ $CHECK_EXIT_CODE -ne 0 ]; then
+    echo "✗ Package check failed with exit code $CHECK_EXIT_CODE"
+    exit $CHECK_EXIT_CODE
+fi
+echo "✓ All packages compile"
+
 # Build the application and capture the exit code
-go build -ldflags="-s -w" -o bin/digilogrt cmd/digilogrt/main.go
+go build -tags "$TAGS" -ldflags="-s -w" -o bin/digilogrt cmd/digilogrt/main.go
 BUILD_EXIT_CODE=$?
 
 # Check if build was successful
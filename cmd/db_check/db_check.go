@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+func main() {
+	dbPath := flag.String("db", "", "Database file path (defaults to database.path from config)")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: db_check [flags]\n\nChecks the database for corruption and broken references, exits 1 if any are found.\n\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *dbPath == "" {
+		*dbPath = config.DefaultDatabasePath()
+		if cfg, err := config.LoadConfig(); err == nil {
+			*dbPath = cfg.Database.Path
+		}
+	}
+
+	// Checking a database that isn't there would create an empty one and pass
+	if _, err := os.Stat(*dbPath); err != nil {
+		log.Fatalf("Failed to open %s: %v", *dbPath, err)
+	}
+	db, err := database.NewDatabase(*dbPath)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", *dbPath, err)
+	}
+	defer db.Close()
+
+	fmt.Printf("🔍 Checking %s...\n", *dbPath)
+	if err := db.IntegrityCheck(); err != nil {
+		fmt.Printf("✗ %v\n", err)
+		fmt.Println("Restore a backup, or delete the database and run sync_databases to rebuild it")
+		db.Close()
+		os.Exit(1)
+	}
+	fmt.Println("✓ No problems found")
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+func main() {
+	maxShow := flag.Int("show", 20, "Maximum number of example differences to print per section")
+	asJSON := flag.Bool("json", false, "Print the full diff as JSON")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: db_diff [flags] <left.db> <right.db>\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	leftPath, rightPath := flag.Arg(0), flag.Arg(1)
+
+	left, err := openExisting(leftPath)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", leftPath, err)
+	}
+	defer left.Close()
+
+	right, err := openExisting(rightPath)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", rightPath, err)
+	}
+	defer right.Close()
+
+	diff, err := left.CompareTo(right)
+	if err != nil {
+		log.Fatalf("Failed to compare databases: %v", err)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(diff); err != nil {
+			log.Fatalf("Failed to encode diff: %v", err)
+		}
+	} else {
+		printDiff(diff, leftPath, rightPath, *maxShow)
+	}
+
+	if diff.HasDifferences() {
+		os.Exit(1)
+	}
+}
+
+// openExisting opens a database without creating a new empty one by mistake
+func openExisting(path string) (*database.Database, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	return database.NewDatabase(path)
+}
+
+func printDiff(diff *database.DatabaseDiff, leftPath, rightPath string, maxShow int) {
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("DATABASE DIFF\n  left:  %s\n  right: %s\n", leftPath, rightPath)
+	fmt.Println(strings.Repeat("=", 70))
+
+	fmt.Printf("%-15s %10s %10s %10s\n", "Source", "Left", "Right", "Delta")
+	fmt.Println(strings.Repeat("-", 50))
+	for _, c := range diff.SourceCounts {
+		marker := ""
+		if c.Left != c.Right {
+			marker = " ⚠️"
+		}
+		fmt.Printf("%-15s %10d %10d %+10d%s\n", c.Source, c.Left, c.Right, c.Right-c.Left, marker)
+	}
+
+	printKeys("Only in left", diff.OnlyInLeft, maxShow)
+	printKeys("Only in right", diff.OnlyInRight, maxShow)
+
+	fmt.Printf("\nField differences: %d\n", len(diff.FieldDiffs))
+	for i, fd := range diff.FieldDiffs {
+		if i >= maxShow {
+			fmt.Printf("  ... and %d more\n", len(diff.FieldDiffs)-maxShow)
+			break
+		}
+		fmt.Printf("  %s/%s %s: %q → %q\n", fd.Key.Source, fd.Key.ExternalID, fd.Field, fd.Left, fd.Right)
+	}
+
+	if diff.HasDifferences() {
+		fmt.Println("\n✗ Databases differ")
+	} else {
+		fmt.Println("\n✓ Databases match")
+	}
+}
+
+func printKeys(title string, keys []database.RecordKey, maxShow int) {
+	fmt.Printf("\n%s: %d\n", title, len(keys))
+	for i, key := range keys {
+		if i >= maxShow {
+			fmt.Printf("  ... and %d more\n", len(keys)-maxShow)
+			break
+		}
+		fmt.Printf("  %s/%s\n", key.Source, key.ExternalID)
+	}
+}
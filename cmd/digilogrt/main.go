@@ -1,43 +1,89 @@
 package main
 
 import (
+	"fmt"
 	"log"
+	"log/slog"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
+	"fyne.io/fyne/v2/dialog"
 	"fyne.io/fyne/v2/theme"
 
+	"github.com/unklstewy/digiLogRT/internal/api"
 	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+	"github.com/unklstewy/digiLogRT/internal/logging"
 	"github.com/unklstewy/digiLogRT/internal/ui"
 )
 
 func main() {
-	log.Println("Starting DigiLogRT...")
-
 	// Load configuration
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		log.Printf("Warning: Could not load config, using defaults: %v", err)
 		cfg = config.GetDefaultConfig()
 	}
-	log.Printf("Configuration loaded: %s v%s", cfg.App.Name, cfg.App.Version)
+
+	// Everything after this, including the packages' log output, goes through the leveled logger
+	logFile, err := logging.Setup(cfg.Logging)
+	if err != nil {
+		log.Fatalf("Failed to set up logging: %v", err)
+	}
+	defer logFile.Close()
+
+	if err := api.ApplyConfig(cfg); err != nil {
+		slog.Warn("Ignoring invalid settings", "error", err)
+	}
+	if cfg.Offline {
+		slog.Info("Offline mode, using cached data only")
+	}
+
+	slog.Info("Starting DigiLogRT", "name", cfg.App.Name, "version", cfg.App.Version)
+
+	// Open the shared production database (the GUI still runs without it)
+	db, err := database.NewDatabase(cfg.Database.Path)
+	if err != nil {
+		slog.Warn("Could not open database", "path", cfg.Database.Path, "error", err)
+	} else {
+		defer db.Close()
+		slog.Info("Database opened", "path", cfg.Database.Path)
+	}
+
+	var integrityErr error
+	if db != nil && cfg.Database.CheckIntegrity {
+		if integrityErr = db.IntegrityCheck(); integrityErr != nil {
+			slog.Error("Database failed its integrity check", "path", cfg.Database.Path, "error", integrityErr)
+		} else {
+			slog.Info("Database passed its integrity check")
+		}
+	}
 
 	// Create the Fyne application
 	myApp := app.New()
 	myApp.Settings().SetTheme(theme.DefaultTheme())
 
 	// Create main window
-	myWindow := myApp.NewWindow("DigiLogRT - Digital Log Road Trip")
+	title := "DigiLogRT - Digital Log Road Trip"
+	if cfg.Offline {
+		title += " (offline)"
+	}
+	myWindow := myApp.NewWindow(title)
 	myWindow.Resize(fyne.NewSize(float32(cfg.Window.Width), float32(cfg.Window.Height)))
 
-	// Create the main tabbed interface with config
-	mainTabs := ui.NewMainTabs(cfg)
-	myWindow.SetContent(mainTabs.GetContainer())
+	// Create the main tabbed interface with config, the cache status bar under it
+	mainTabs := ui.NewMainTabs(cfg, db)
+	myWindow.SetContent(mainTabs.GetContent())
 
 	// Set window properties
 	myWindow.SetFixedSize(false)
 	myWindow.CenterOnScreen()
 
-	log.Println("Window initialized with functional APRS tab...")
+	// Searches on a damaged database can return garbage, so say so up front
+	if integrityErr != nil {
+		dialog.ShowError(fmt.Errorf("%v\n\nRestore a backup or delete the database and sync again", integrityErr), myWindow)
+	}
+
+	slog.Debug("Window initialized")
 	myWindow.ShowAndRun()
 }
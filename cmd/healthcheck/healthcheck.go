@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+)
+
+func main() {
+	timeout := flag.Duration("timeout", 2*time.Minute, "Maximum time to wait for all sources")
+	flag.Parse()
+
+	// Load configuration
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if err := api.ApplyConfig(cfg); err != nil {
+		log.Fatalf("Failed to apply configuration: %v", err)
+	}
+
+	// Initialization errors are reported per source by the health check below
+	pool := api.GetGlobalPool()
+	if err := pool.Initialize(cfg).Err(); err != nil {
+		log.Printf("Warning: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	results := pool.HealthCheck(ctx)
+
+	sources := make([]string, 0, len(results))
+	for source := range results {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	failed := 0
+	for _, source := range sources {
+		if err := results[source]; err != nil {
+			fmt.Printf("  ✗ %-15s %v\n", source, err)
+			failed++
+		} else {
+			fmt.Printf("  ✓ %-15s reachable\n", source)
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("%d of %d sources unhealthy\n", failed, len(results))
+		os.Exit(1)
+	}
+	fmt.Println("All sources healthy")
+}
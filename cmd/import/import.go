@@ -0,0 +1,290 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+const usage = `Usage: import -source <name> [flags] <file.json>
+
+Imports a JSON list of repeaters, either an array of objects or an object holding
+the array under "results", "repeaters" or "data".
+
+Field names come from -format, and -map overrides them field by field with
+unified=json pairs. Dotted JSON names reach into nested objects:
+
+  import -source club -map callsign=call,tx_frequency=output,city=location.town club.json
+
+Fields: %s
+
+Frequencies are read like query freq reads them: MHz, kHz or Hz, with or without
+a unit, and a comma may be the decimal point. Importing the same list again
+updates the records in place.
+
+Flags:
+`
+
+// formats are the built-in JSON shapes, unified field name -> JSON field name
+var formats = map[string]map[string]string{
+	// The field names of database.UnifiedRepeater
+	"unified": {
+		"external_id": "external_id", "callsign": "callsign",
+		"city": "city", "state": "state", "country": "country",
+		"latitude": "latitude", "longitude": "longitude",
+		"tx_frequency": "tx_frequency", "rx_frequency": "rx_frequency", "tone_frequency": "tone_frequency",
+		"mode": "mode", "color_code": "color_code", "power_watts": "power_watts",
+		"website": "website", "description": "description",
+	},
+	// RepeaterBook's export.php output. Rptr_ID is only unique within a state, so
+	// records are matched on callsign and frequency instead
+	"repeaterbook": {
+		"callsign": "Callsign", "city": "Nearest_City", "state": "State", "country": "Country",
+		"latitude": "Lat", "longitude": "Long",
+		"tx_frequency": "Frequency", "rx_frequency": "Input_Freq", "tone_frequency": "Access_Tone",
+		"description": "Notes",
+	},
+}
+
+func main() {
+	dbPath := flag.String("db", "", "Database file path (defaults to database.path from config)")
+	source := flag.String("source", "", "Source name to import under, created if it doesn't exist (required)")
+	format := flag.String("format", "unified", "Built-in field names: "+strings.Join(formatNames(), ", "))
+	mapping := flag.String("map", "", "Field overrides as unified=json pairs, comma separated")
+	dryRun := flag.Bool("dry-run", false, "Parse and show the first records without writing to the database")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, usage, strings.Join(fieldNames(), ", "))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		usageError("expected exactly one JSON file")
+	}
+	if *source == "" && !*dryRun {
+		usageError("-source is required")
+	}
+
+	fields, ok := formats[*format]
+	if !ok {
+		usageError(fmt.Sprintf("unknown format %q", *format))
+	}
+	fields, err := applyMapping(fields, *mapping)
+	if err != nil {
+		usageError(err.Error())
+	}
+
+	records, err := readRecords(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", flag.Arg(0), err)
+	}
+	fmt.Printf("📄 Read %d records from %s\n", len(records), flag.Arg(0))
+
+	repeaters := make([]database.UnifiedRepeater, 0, len(records))
+	for i, record := range records {
+		rep, err := convert(record, fields)
+		if err != nil {
+			fmt.Printf("⚠️  Skipping record %d: %v\n", i+1, err)
+			continue
+		}
+		repeaters = append(repeaters, rep)
+	}
+
+	if *dryRun {
+		for i, rep := range repeaters {
+			if i == 5 {
+				fmt.Printf("... and %d more\n", len(repeaters)-i)
+				break
+			}
+			fmt.Printf("  %-10s %9.4f MHz  %s, %s, %s\n", rep.Callsign, rep.TxFrequency, rep.City, rep.State, rep.Country)
+		}
+		fmt.Printf("✓ %d of %d records would be imported\n", len(repeaters), len(records))
+		return
+	}
+
+	if *dbPath == "" {
+		*dbPath = config.DefaultDatabasePath()
+		if cfg, err := config.LoadConfig(); err == nil {
+			*dbPath = cfg.Database.Path
+		}
+	}
+
+	db, err := database.NewDatabase(*dbPath)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", *dbPath, err)
+	}
+	defer db.Close()
+
+	imported, err := db.ImportRepeaters(repeaters, *source)
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+	fmt.Printf("✓ Imported %d repeaters into %s as source %q\n", imported, *dbPath, *source)
+}
+
+func usageError(message string) {
+	fmt.Fprintf(os.Stderr, "Error: %s\n\n", message)
+	flag.Usage()
+	os.Exit(2)
+}
+
+func formatNames() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func fieldNames() []string {
+	names := make([]string, 0, len(formats["unified"]))
+	for name := range formats["unified"] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// applyMapping returns a copy of fields with the "unified=json,..." overrides applied
+func applyMapping(fields map[string]string, mapping string) (map[string]string, error) {
+	result := make(map[string]string, len(fields))
+	for unified, key := range fields {
+		result[unified] = key
+	}
+
+	for _, pair := range strings.Split(mapping, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		unified, key, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid mapping %q, expected unified=json", pair)
+		}
+		if _, known := formats["unified"][unified]; !known {
+			return nil, fmt.Errorf("unknown field %q in mapping", unified)
+		}
+		result[unified] = key
+	}
+	return result, nil
+}
+
+// readRecords loads the array of records, unwrapping the common {"results": [...]} envelopes
+func readRecords(path string) ([]map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []map[string]interface{}
+	if err := json.Unmarshal(data, &records); err == nil {
+		return records, nil
+	}
+
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("not a JSON array or object: %v", err)
+	}
+	for _, key := range []string{"results", "repeaters", "data"} {
+		if raw, ok := envelope[key]; ok {
+			if err := json.Unmarshal(raw, &records); err != nil {
+				return nil, fmt.Errorf("failed to parse %q: %v", key, err)
+			}
+			return records, nil
+		}
+	}
+	return nil, fmt.Errorf("no array found under \"results\", \"repeaters\" or \"data\"")
+}
+
+// lookup finds a possibly dotted key in a record
+func lookup(record map[string]interface{}, key string) (interface{}, bool) {
+	var value interface{} = record
+	for _, part := range strings.Split(key, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[part]; !ok || value == nil {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// convert builds a repeater from one record using the unified -> JSON field names
+func convert(record map[string]interface{}, fields map[string]string) (database.UnifiedRepeater, error) {
+	var rep database.UnifiedRepeater
+	var err error
+
+	text := func(name string) string {
+		value, ok := lookup(record, fields[name])
+		if !ok {
+			return ""
+		}
+		if s, isString := value.(string); isString {
+			return strings.TrimSpace(s)
+		}
+		return fmt.Sprint(value)
+	}
+	number := func(name string) float64 {
+		s := text(name)
+		if s == "" || err != nil {
+			return 0
+		}
+		f, parseErr := strconv.ParseFloat(s, 64)
+		if parseErr != nil {
+			err = fmt.Errorf("%s %q is not a number", fields[name], s)
+		}
+		return f
+	}
+	frequency := func(name string) float64 {
+		s := text(name)
+		if s == "" || err != nil {
+			return 0
+		}
+		f, parseErr := database.ParseFrequency(s)
+		if parseErr != nil {
+			err = fmt.Errorf("%s: %v", fields[name], parseErr)
+		}
+		return f
+	}
+
+	rep.ExternalID = text("external_id")
+	rep.Callsign = strings.ToUpper(text("callsign"))
+	rep.City = text("city")
+	rep.State = text("state")
+	rep.Country = text("country")
+	rep.Latitude = number("latitude")
+	rep.Longitude = number("longitude")
+	rep.TxFrequency = frequency("tx_frequency")
+	rep.RxFrequency = frequency("rx_frequency")
+	if tone, parseErr := strconv.ParseFloat(text("tone_frequency"), 64); parseErr == nil {
+		rep.ToneFrequency = tone // Anything else is carrier squelch or DCS
+	}
+	rep.Mode = text("mode")
+	rep.ColorCode = int(number("color_code"))
+	rep.PowerWatts = int(math.Round(number("power_watts")))
+	rep.Website = text("website")
+	rep.Description = text("description")
+	if err != nil {
+		return rep, err
+	}
+
+	if rep.Callsign == "" {
+		return rep, fmt.Errorf("no callsign in %q", fields["callsign"])
+	}
+	if rep.Latitude < -90 || rep.Latitude > 90 || rep.Longitude < -180 || rep.Longitude > 180 {
+		return rep, fmt.Errorf("%s has coordinates out of range", rep.Callsign)
+	}
+	return rep, nil
+}
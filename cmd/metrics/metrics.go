@@ -0,0 +1,80 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+	"github.com/unklstewy/digiLogRT/internal/metrics"
+)
+
+func main() {
+	addr := flag.String("addr", ":9100", "Address to serve /metrics on")
+	interval := flag.Duration("interval", time.Hour, "How often to sync all sources")
+	dbPath := flag.String("db", "", "Database file path (defaults to database.path from config)")
+	flag.Parse()
+
+	// Load configuration
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if err := api.ApplyConfig(cfg); err != nil {
+		log.Fatalf("Failed to apply configuration: %v", err)
+	}
+	if *dbPath == "" {
+		*dbPath = cfg.Database.Path
+	}
+
+	db, err := database.NewDatabase(*dbPath)
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	// Serve metrics in the background while we sync
+	go func() {
+		log.Fatalf("Metrics server stopped: %v", metrics.Serve(*addr))
+	}()
+	fmt.Printf("📈 Serving metrics on http://%s/metrics (sync every %v)\n", *addr, *interval)
+
+	for {
+		syncAll(db, cfg)
+		time.Sleep(*interval)
+	}
+}
+
+// syncAll fetches every source (using the file caches when fresh) and syncs it to the database
+// Errors are logged and counted in the metrics rather than stopping the exporter
+func syncAll(db *database.Database, cfg *config.Config) {
+	start := time.Now()
+
+	if cfg.APIs.BrandmeisterKey != "" {
+		repeaters, err := api.NewBrandmeisterClient(cfg.APIs, cfg.Cache.Brandmeister).GetAllRepeaters()
+		if err != nil {
+			log.Printf("Failed to get Brandmeister repeaters: %v", err)
+		} else if err := db.SyncBrandmeisterData(repeaters); err != nil {
+			log.Printf("Failed to sync Brandmeister data: %v", err)
+		}
+	}
+
+	talkgroups, err := api.NewTGIFClient(cfg.Cache.TGIF).GetAllTalkgroups()
+	if err != nil {
+		log.Printf("Failed to get TGIF talkgroups: %v", err)
+	} else if err := db.SyncTGIFData(talkgroups); err != nil {
+		log.Printf("Failed to sync TGIF data: %v", err)
+	}
+
+	hearham, err := api.NewHearhamClient(cfg.Cache.Hearham).GetAllRepeaters()
+	if err != nil {
+		log.Printf("Failed to get hearham repeaters: %v", err)
+	} else if err := db.SyncHearhamData(hearham); err != nil {
+		log.Printf("Failed to sync hearham data: %v", err)
+	}
+
+	fmt.Printf("✓ Sync pass completed in %v\n", time.Since(start))
+}
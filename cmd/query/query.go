@@ -0,0 +1,602 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+	"github.com/unklstewy/digiLogRT/internal/gpx"
+	"github.com/unklstewy/digiLogRT/internal/kml"
+	"github.com/unklstewy/digiLogRT/internal/report"
+)
+
+const usage = `Usage: query [flags] <command> [args]
+
+Commands:
+  search <text>                  Match callsign, city, state, country or description (-fuzzy allows typos,
+                                 -offset pages through the matches in callsign order)
+  near [<lat> <lng>] <radiusKm>  Repeaters within a radius, closest first
+  nearest [<lat> <lng>] [band...]
+                                 Nearest operational repeater per band or mode (default 2m 70cm DMR)
+  freq <freq> <rangeMhz>         Repeaters whose output is within range of a frequency
+                                 (146.52, 146,52, "146520 kHz" and "146.52 MHz" all work)
+  callsign <callsign>            Every source's listings of a callsign, suffixed ones like W3ABC/R too
+                                 (-exact for the callsign alone), to check a trustee's listings
+  master <id>                    Brandmeister repeaters last connected to a master server
+  compare <id> <id>...           Frequencies, offsets, tones and talkgroups of repeaters side by side
+  kml <file> [text]              Stream every repeater (or those matching text) to a KML file
+  talkgroups <file> [anytone]    Write the synced talkgroups to a CSV file, or as an Anytone CPS talkgroup list
+  runs [source]                  Recent sync runs with how many repeaters each added and changed
+  changes [runId]                Repeaters added or changed by a sync run (default the latest)
+  watch <id>                     Add a repeater to the watchlist, the GUI alerts when it comes on the air
+  unwatch <id>                   Take a repeater off the watchlist
+  watchlist                      Watched repeaters and whether each was online at the last check
+  stats                          Repeater counts by source
+  reindex                        Rebuild the full-text search index
+
+near and nearest search around your configured location when <lat> <lng> are left out.
+
+Flags:
+`
+
+func main() {
+	dbPath := flag.String("db", "", "Database file path (defaults to database.path from config)")
+	asJSON := flag.Bool("json", false, "Print results as JSON")
+	limit := flag.Int("limit", 50, "Maximum number of repeaters to return")
+	offset := flag.Int("offset", 0, "Skip this many matches, to page through search results in callsign order (search only)")
+	onlineOnly := flag.Bool("online", false, "Only show repeaters that are currently online (search and kml only)")
+	gpxFile := flag.String("gpx", "", "Also write the repeaters found to this GPX waypoint file (search, near, freq, callsign)")
+	csvFile := flag.String("csv", "", "Also write the comparison to this CSV file (compare only)")
+	columns := flag.String("columns", "", fmt.Sprintf("Comma-separated CSV columns to write, in order, default all (talkgroups: %s; compare -csv: %s)",
+		strings.Join(database.TalkgroupCSVColumns(), ","), strings.Join(report.ComparisonCSVColumns(), ",")))
+	precision := flag.Int("precision", database.DefaultFrequencyPrecision, "Decimals in CSV frequencies (compare -csv)")
+	noHeader := flag.Bool("no-header", false, "Leave the header row out of CSV files (compare -csv and talkgroups)")
+	hotspots := flag.Bool("hotspots", false, "Include Brandmeister hotspots, which searches leave out by default")
+	minPower := flag.Int("min-power", -1, "Leave out repeaters reporting fewer watts, 0 keeps them all (defaults to search.min_power_watts from config)")
+	exact := flag.Bool("exact", false, "Only match the callsign itself, not suffixed listings of it (callsign only)")
+	fuzzy := flag.Bool("fuzzy", false, "Allow for typos and rank by match quality (search only, ignores -online)")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	// Giving -offset at all, even -offset 0, asks for the stable order pages need
+	paged := false
+	flag.Visit(func(f *flag.Flag) { paged = paged || f.Name == "offset" })
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	command, args := flag.Arg(0), flag.Args()[1:]
+
+	// The config is optional, without one there's no default location
+	cfg, cfgErr := config.LoadConfig()
+	if *dbPath == "" {
+		*dbPath = config.DefaultDatabasePath()
+		if cfgErr == nil {
+			*dbPath = cfg.Database.Path
+		}
+	}
+
+	db, err := openExisting(*dbPath)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", *dbPath, err)
+	}
+	defer db.Close()
+	var devices database.DeviceFilter
+	if cfgErr == nil {
+		devices = database.SearchDeviceFilter(cfg.Search)
+	}
+	devices.IncludeHotspots = *hotspots
+	if *minPower >= 0 {
+		devices.MinPowerWatts = *minPower
+	}
+
+	if *precision < 1 {
+		usageError("-precision needs at least 1 decimal")
+	}
+	csvOptions := database.ExportOptions{Precision: *precision, NoHeader: *noHeader}
+	if *columns != "" {
+		csvOptions.Columns = strings.Split(*columns, ",")
+	}
+
+	switch command {
+	case "search":
+		if len(args) == 0 {
+			usageError("search needs some text to look for")
+		}
+		if *fuzzy && paged {
+			usageError("-offset can't page -fuzzy results, their order depends on match quality")
+		}
+		if *offset < 0 {
+			usageError("-offset can't be negative")
+		}
+		text := strings.Join(args, " ")
+		var results []database.RepeaterRecord
+		remaining := 0
+		switch {
+		case *fuzzy:
+			results, err = db.SearchRepeatersFuzzy(text, *limit, devices)
+		case !*onlineOnly && !paged:
+			// Best matches first when the full-text index is available
+			results, err = db.SearchRepeatersRanked(text, *limit, devices)
+		default:
+			var page database.SearchPage
+			page, err = db.SearchRepeatersPage(text, *limit, *offset, *onlineOnly, devices)
+			results, remaining = page.Repeaters, page.Remaining()
+		}
+		if err != nil {
+			log.Fatalf("Search failed: %v", err)
+		}
+		writeGPX(*gpxFile, results)
+		output(results, *asJSON, func() { printRepeaters(results) })
+		printMoreResults(remaining, *offset+len(results), *asJSON)
+
+	case "near":
+		var lat, lng float64
+		switch len(args) {
+		case 3:
+			lat, lng = parseFloat(args[0], "latitude"), parseFloat(args[1], "longitude")
+		case 1:
+			lat, lng = userLocation(cfg, cfgErr)
+		default:
+			usageError("near needs [<lat> <lng>] <radiusKm>")
+		}
+		radius := parseFloat(args[len(args)-1], "radius")
+		results, err := db.GetRepeatersNear(lat, lng, radius, *limit, devices)
+		if err != nil {
+			log.Fatalf("Nearby search failed: %v", err)
+		}
+		records := make([]database.RepeaterRecord, len(results))
+		for i, r := range results {
+			records[i] = r.RepeaterRecord
+		}
+		writeGPX(*gpxFile, records)
+		output(results, *asJSON, func() { printNearby(results) })
+
+	case "nearest":
+		var lat, lng float64
+		bands := args
+		if hasCoordinates(args) {
+			lat, lng = parseFloat(args[0], "latitude"), parseFloat(args[1], "longitude")
+			bands = args[2:]
+		} else {
+			lat, lng = userLocation(cfg, cfgErr)
+		}
+		if len(bands) == 0 {
+			bands = []string{"2m", "70cm", "DMR"}
+		}
+		results, err := db.GetNearestByBand(lat, lng, bands, devices)
+		if err != nil {
+			log.Fatalf("Nearest search failed: %v", err)
+		}
+		output(results, *asJSON, func() { printNearest(results, bands, lat, lng) })
+
+	case "freq":
+		if len(args) != 2 {
+			usageError("freq needs <freq> <rangeMhz>")
+		}
+		frequency, err := database.ParseFrequency(args[0])
+		if err != nil {
+			usageError(err.Error())
+		}
+		rangeMHz := parseFloat(args[1], "range")
+		results, err := db.GetRepeatersByFrequency(frequency, rangeMHz, *limit, devices)
+		if err != nil {
+			log.Fatalf("Frequency search failed: %v", err)
+		}
+		writeGPX(*gpxFile, results)
+		output(results, *asJSON, func() { printRepeaters(results) })
+
+	case "callsign":
+		if len(args) != 1 {
+			usageError("callsign needs a <callsign>")
+		}
+		results, err := db.GetRepeatersByCallsign(args[0], *exact)
+		if err != nil {
+			log.Fatalf("Callsign lookup failed: %v", err)
+		}
+		names, err := db.GetSourceNames()
+		if err != nil {
+			log.Fatalf("Failed to get source names: %v", err)
+		}
+		writeGPX(*gpxFile, results)
+		output(results, *asJSON, func() { printListings(results, names) })
+
+	case "master":
+		if len(args) != 1 {
+			usageError("master needs a master server <id>")
+		}
+		masterID, err := strconv.Atoi(args[0])
+		if err != nil {
+			usageError(fmt.Sprintf("invalid master ID %q", args[0]))
+		}
+		results, err := db.GetRepeatersByMaster(masterID)
+		if err != nil {
+			log.Fatalf("Master search failed: %v", err)
+		}
+		output(results, *asJSON, func() { printRepeaters(results) })
+
+	case "compare":
+		if len(args) == 0 {
+			usageError("compare needs one or more repeater <id>s")
+		}
+		ids := make([]int, len(args))
+		for i, arg := range args {
+			if ids[i], err = strconv.Atoi(arg); err != nil {
+				usageError(fmt.Sprintf("invalid repeater ID %q", arg))
+			}
+		}
+		comparison, err := db.GetRepeaterComparison(ids)
+		if err != nil {
+			log.Fatalf("Comparison failed: %v", err)
+		}
+		writeComparisonCSV(*csvFile, comparison, csvOptions)
+		output(comparison, *asJSON, func() {
+			if err := report.WriteComparisonTable(os.Stdout, comparison); err != nil {
+				log.Fatalf("Failed to print comparison: %v", err)
+			}
+		})
+
+	case "kml":
+		if len(args) == 0 {
+			usageError("kml needs an output <file>")
+		}
+		file, err := os.Create(args[0])
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", args[0], err)
+		}
+		opts := database.SearchOptions{Query: strings.Join(args[1:], " "), OnlineOnly: *onlineOnly, Devices: devices}
+		count, err := kml.WriteRepeatersKML(context.Background(), file, db, "digiLogRT Repeaters", opts)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			log.Fatalf("KML export failed: %v", err)
+		}
+		fmt.Printf("✓ Wrote %d repeaters to %s\n", count, args[0])
+
+	case "talkgroups":
+		if len(args) == 0 || len(args) > 2 || (len(args) == 2 && args[1] != "anytone") {
+			usageError("talkgroups needs an output <file>, optionally followed by anytone")
+		}
+		file, err := os.Create(args[0])
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", args[0], err)
+		}
+		// The Anytone list is the layout CPS imports, the CSV options don't apply to it
+		if len(args) == 2 {
+			err = db.ExportTalkgroupsAnytoneCSV(file)
+		} else {
+			err = db.ExportTalkgroupsCSV(file, csvOptions)
+		}
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			log.Fatalf("Talkgroup export failed: %v", err)
+		}
+		fmt.Printf("✓ Wrote talkgroups to %s\n", args[0])
+
+	case "runs":
+		source := ""
+		if len(args) > 0 {
+			source = args[0]
+		}
+		runs, err := db.GetSyncRuns(source, *limit)
+		if err != nil {
+			log.Fatalf("Failed to get sync runs: %v", err)
+		}
+		output(runs, *asJSON, func() { printRuns(runs) })
+
+	case "changes":
+		var runID int
+		if len(args) > 0 {
+			if runID, err = strconv.Atoi(args[0]); err != nil {
+				usageError(fmt.Sprintf("invalid run ID %q", args[0]))
+			}
+		} else {
+			runs, err := db.GetSyncRuns("", 1)
+			if err != nil {
+				log.Fatalf("Failed to get sync runs: %v", err)
+			}
+			if len(runs) == 0 {
+				log.Fatalf("No sync runs recorded yet")
+			}
+			runID = runs[0].ID
+		}
+		changes, err := db.GetChangesForRun(runID)
+		if err != nil {
+			log.Fatalf("Failed to get changes: %v", err)
+		}
+		output(changes, *asJSON, func() { printChanges(changes, runID) })
+
+	case "watch", "unwatch":
+		if len(args) != 1 {
+			usageError(command + " needs a repeater <id>")
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			usageError(fmt.Sprintf("invalid repeater ID %q", args[0]))
+		}
+		if command == "watch" {
+			if err := db.AddToWatchlist(id); err != nil {
+				log.Fatalf("Failed to watch repeater: %v", err)
+			}
+			fmt.Printf("✓ Watching repeater %d\n", id)
+		} else {
+			if err := db.RemoveFromWatchlist(id); err != nil {
+				log.Fatalf("Failed to stop watching repeater: %v", err)
+			}
+			fmt.Printf("✓ No longer watching repeater %d\n", id)
+		}
+
+	case "watchlist":
+		watched, err := db.GetWatchlist()
+		if err != nil {
+			log.Fatalf("Failed to get watchlist: %v", err)
+		}
+		output(watched, *asJSON, func() { printWatchlist(watched) })
+
+	case "stats":
+		stats, err := db.GetRepeaterStats()
+		if err != nil {
+			log.Fatalf("Failed to get stats: %v", err)
+		}
+		output(stats, *asJSON, func() { printStats(stats) })
+
+	case "reindex":
+		if !db.HasSearchIndex() {
+			log.Fatalf("Full-text search isn't available, rebuild with -tags sqlite_fts5")
+		}
+		if err := db.RebuildSearchIndex(); err != nil {
+			log.Fatalf("Failed to rebuild search index: %v", err)
+		}
+		fmt.Println("✓ Search index rebuilt")
+
+	default:
+		usageError(fmt.Sprintf("unknown command %q", command))
+	}
+}
+
+// openExisting opens a database without creating a new empty one by mistake
+func openExisting(path string) (*database.Database, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	return database.NewDatabase(path)
+}
+
+func usageError(message string) {
+	fmt.Fprintf(os.Stderr, "Error: %s\n\n", message)
+	flag.Usage()
+	os.Exit(2)
+}
+
+// hasCoordinates reports whether args start with a latitude and longitude rather than bands
+func hasCoordinates(args []string) bool {
+	if len(args) < 2 {
+		return false
+	}
+	_, latErr := strconv.ParseFloat(args[0], 64)
+	_, lngErr := strconv.ParseFloat(args[1], 64)
+	return latErr == nil && lngErr == nil
+}
+
+// userLocation returns the location from the config, for near and nearest without coordinates
+func userLocation(cfg *config.Config, cfgErr error) (lat, lng float64) {
+	if cfgErr != nil {
+		log.Fatalf("No coordinates given and the config can't be loaded: %v", cfgErr)
+	}
+	lat, lng, ok := cfg.GetUserLocation()
+	if !ok {
+		usageError("no coordinates given and no location is set in configs/config.yaml")
+	}
+	return lat, lng
+}
+
+func parseFloat(value, name string) float64 {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		usageError(fmt.Sprintf("invalid %s %q", name, value))
+	}
+	return f
+}
+
+// writeGPX saves the repeaters as GPX waypoints when a file was asked for
+func writeGPX(path string, repeaters []database.RepeaterRecord) {
+	if path == "" {
+		return
+	}
+	data, err := gpx.BuildGPX(repeaters)
+	if err != nil {
+		log.Fatalf("Failed to build GPX: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		log.Fatalf("Failed to write %s: %v", path, err)
+	}
+	fmt.Fprintf(os.Stderr, "✓ Wrote %s\n", path)
+}
+
+// writeComparisonCSV saves the comparison as CSV when a file was asked for
+func writeComparisonCSV(path string, comparison []database.RepeaterComparison, opts database.ExportOptions) {
+	if path == "" {
+		return
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		log.Fatalf("Failed to create %s: %v", path, err)
+	}
+	err = report.WriteComparisonCSV(file, comparison, opts)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		log.Fatalf("Failed to write %s: %v", path, err)
+	}
+	fmt.Fprintf(os.Stderr, "✓ Wrote %s\n", path)
+}
+
+// output prints v as JSON, or runs printTable for the human-readable form
+func output(v interface{}, asJSON bool, printTable func()) {
+	if !asJSON {
+		printTable()
+		return
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		log.Fatalf("Failed to encode results: %v", err)
+	}
+}
+
+func printRepeaters(repeaters []database.RepeaterRecord) {
+	fmt.Printf("%-10s %-8s %-10s %s\n", "Callsign", "Mode", "Output", "Location")
+	fmt.Println(strings.Repeat("-", 70))
+	for _, r := range repeaters {
+		fmt.Printf("%-10s %-8s %-10s %s\n", r.Callsign, r.Mode, frequencyColumn(r), r.GetLocationString())
+	}
+	fmt.Printf("\n%d repeater(s)\n", len(repeaters))
+}
+
+// printMoreResults says how many matches are past this page and the -offset that gets the next one
+// With -json it goes to stderr, keeping stdout valid JSON for scripts
+func printMoreResults(remaining, nextOffset int, asJSON bool) {
+	if remaining == 0 {
+		return
+	}
+	out := os.Stdout
+	if asJSON {
+		out = os.Stderr
+	}
+	fmt.Fprintf(out, "%d more results (next page: -offset %d)\n", remaining, nextOffset)
+}
+
+// printListings prints a callsign's listings with the source each came from
+func printListings(repeaters []database.RepeaterRecord, sourceNames map[int]string) {
+	fmt.Printf("%-13s %-12s %-8s %-10s %s\n", "Source", "Callsign", "Mode", "Output", "Location")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, r := range repeaters {
+		fmt.Printf("%-13s %-12s %-8s %-10s %s\n",
+			sourceNames[r.SourceID], r.Callsign, r.Mode, frequencyColumn(r), r.GetLocationString())
+	}
+	fmt.Printf("\n%d listing(s)\n", len(repeaters))
+}
+
+func printNearby(repeaters []database.NearbyRepeater) {
+	fmt.Printf("%-10s %-8s %-10s %9s  %s\n", "Callsign", "Mode", "Output", "Distance", "Location")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, r := range repeaters {
+		fmt.Printf("%-10s %-8s %-10s %6.1f km  %s\n",
+			r.Callsign, r.Mode, frequencyColumn(r.RepeaterRecord), r.DistanceKm, r.GetLocationString())
+	}
+	fmt.Printf("\n%d repeater(s)\n", len(repeaters))
+}
+
+func printNearest(nearest map[string]database.RepeaterRecord, bands []string, lat, lng float64) {
+	fmt.Printf("%-6s %-10s %-8s %-10s %9s  %s\n", "Band", "Callsign", "Mode", "Output", "Distance", "Location")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, band := range bands {
+		r, ok := nearest[band]
+		if !ok {
+			fmt.Printf("%-6s (none within range)\n", band)
+			continue
+		}
+		distance := database.HaversineKm(lat, lng, *r.Latitude, *r.Longitude)
+		fmt.Printf("%-6s %-10s %-8s %-10s %6.1f km  %s\n",
+			band, r.Callsign, r.Mode, frequencyColumn(r), distance, r.GetLocationString())
+	}
+}
+
+func printWatchlist(watched []database.WatchedRepeater) {
+	fmt.Printf("%-7s %-10s %-8s %-10s %-8s %s\n", "ID", "Callsign", "Mode", "Output", "Status", "Location")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, w := range watched {
+		status := "offline"
+		if w.LastOnline {
+			status = "online"
+		}
+		r := w.Repeater
+		fmt.Printf("%-7d %-10s %-8s %-10s %-8s %s\n", r.ID, r.Callsign, r.Mode, frequencyColumn(r), status, r.GetLocationString())
+	}
+	fmt.Printf("\n%d watched repeater(s)\n", len(watched))
+}
+
+func printRuns(runs []database.SyncRun) {
+	fmt.Printf("%-5s %-14s %-19s %9s %8s  %s\n", "Run", "Source", "Started", "Duration", "New", "Changed")
+	fmt.Println(strings.Repeat("-", 70))
+	for _, run := range runs {
+		duration := "-"
+		if run.FinishedAt != nil {
+			duration = run.FinishedAt.Sub(run.StartedAt).Round(time.Second).String()
+		}
+		fmt.Printf("%-5d %-14s %-19s %9s %8d  %d\n", run.ID, run.Source,
+			run.StartedAt.Local().Format("2006-01-02 15:04:05"), duration, run.Inserted, run.Updated)
+	}
+}
+
+func printChanges(changes []database.RepeaterChange, runID int) {
+	fmt.Printf("%-8s %-10s %-8s %-10s %s\n", "Change", "Callsign", "Mode", "Output", "Location")
+	fmt.Println(strings.Repeat("-", 70))
+	for _, c := range changes {
+		change := "changed"
+		if c.Inserted {
+			change = "new"
+		}
+		fmt.Printf("%-8s %-10s %-8s %-10s %s\n", change, c.Callsign, c.Mode, frequencyColumn(c.RepeaterRecord), c.GetLocationString())
+	}
+	fmt.Printf("\n%d repeater(s) changed in sync run %d\n", len(changes), runID)
+}
+
+func frequencyColumn(r database.RepeaterRecord) string {
+	if r.TxFrequency == nil {
+		return "?"
+	}
+	return fmt.Sprintf("%.4f", *r.TxFrequency)
+}
+
+func printStats(stats map[string]interface{}) {
+	fmt.Printf("Total repeaters:  %d\n", stats["total_repeaters"])
+	fmt.Printf("Online repeaters: %d\n", stats["online_repeaters"])
+
+	if bySource, ok := stats["by_source"].(map[string]int); ok {
+		sources := make([]string, 0, len(bySource))
+		for source := range bySource {
+			sources = append(sources, source)
+		}
+		sort.Strings(sources)
+
+		freshness, _ := stats["freshness"].(map[string]database.SourceFreshness)
+		fmt.Println("\nBy source:")
+		for _, source := range sources {
+			synced := "never synced"
+			if f := freshness[source]; !f.LastSync.IsZero() {
+				synced = "last synced " + formatAge(f.Age) + " ago"
+			}
+			fmt.Printf("  %-15s %8d  %s\n", source, bySource[source], synced)
+		}
+	}
+}
+
+// formatAge rounds an age to whole minutes, hours or days, whichever reads best
+func formatAge(age time.Duration) string {
+	switch {
+	case age < time.Hour:
+		return fmt.Sprintf("%dm", int(age.Minutes()))
+	case age < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(age.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(age.Hours()/24))
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// report prints one line per component checked, with a hint on how to fix each failure
+type report struct {
+	failed int
+	total  int
+}
+
+func (r *report) pass(component, detail string) {
+	r.total++
+	fmt.Printf("  ✓ %-15s %s\n", component, detail)
+}
+
+func (r *report) fail(component string, err error, hint string) {
+	r.total++
+	r.failed++
+	fmt.Printf("  ✗ %-15s %v\n", component, err)
+	if hint != "" {
+		fmt.Printf("    %-15s → %s\n", "", hint)
+	}
+}
+
+// skip reports a check that wasn't run, it doesn't count as a failure
+func (r *report) skip(component, reason string) {
+	fmt.Printf("  - %-15s %s\n", component, reason)
+}
+
+func main() {
+	timeout := flag.Duration("timeout", 2*time.Minute, "Maximum time to wait for all sources")
+	dbPath := flag.String("db", "", "Database file path (defaults to database.path from config)")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: selftest [flags]\n\nChecks the configuration, every data source and the database, exits 1 if any check fails.\n\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var r report
+
+	fmt.Println("Configuration")
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		r.fail("config.yaml", err, "fix configs/config.yaml, run from the repository root so configs/ is found")
+		// Nothing else can be checked properly without a configuration
+		finish(&r)
+	}
+	r.pass("config.yaml", "loaded and valid")
+	if err := api.ApplyConfig(cfg); err != nil {
+		r.fail("settings", err, "in configs/config.yaml http_proxy must be an http, https or socks5 URL and caching.format \"json\" or \"gob\"")
+	}
+
+	fmt.Println("API keys")
+	// Syncs need the Brandmeister key, APRS and RepeaterBook lookups are optional and just off without theirs
+	keys := []struct {
+		component string
+		set       bool
+		required  bool
+		hint      string
+	}{
+		{"brandmeister", cfg.APIs.BrandmeisterKey != "", true,
+			"Brandmeister key missing — set apis.brandmeister_key in configs/config.yaml"},
+		{"aprs", len(cfg.APIs.AprsKey) > 0, false,
+			"APRS lookups off, set apis.aprs_key in configs/config.yaml to use them (get one from your aprs.fi account)"},
+		{"repeaterbook", cfg.APIs.RepeaterBookKey != "", false,
+			"RepeaterBook lookups off, set apis.repeater_book_key in configs/config.yaml to use them"},
+	}
+	for _, key := range keys {
+		switch {
+		case key.set:
+			r.pass(key.component, "set")
+		case key.required:
+			r.fail(key.component, fmt.Errorf("no API key"), key.hint)
+		default:
+			r.skip(key.component, key.hint)
+		}
+	}
+
+	fmt.Println("Sources")
+	if cfg.Offline {
+		r.skip("all sources", "skipped, offline mode is on (offline in configs/config.yaml or "+config.OfflineEnv+")")
+	} else {
+		checkSources(&r, cfg, *timeout)
+	}
+
+	fmt.Println("Database")
+	if *dbPath == "" {
+		*dbPath = cfg.Database.Path
+		if *dbPath == "" {
+			*dbPath = config.DefaultDatabasePath()
+		}
+	}
+	checkDatabase(&r, *dbPath)
+
+	finish(&r)
+}
+
+// checkSources runs every client's connection test, in parallel, each bounded by timeout
+func checkSources(r *report, cfg *config.Config, timeout time.Duration) {
+	// Initialization errors are reported per source by the health check below
+	pool := api.GetGlobalPool()
+	if err := pool.Initialize(cfg).Err(); err != nil {
+		log.Printf("Warning: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	done := make(chan map[string]error)
+	go func() { done <- pool.HealthCheck(ctx) }()
+
+	// The pool doesn't hold APRS or RepeaterBook clients, and without a key there's nothing to test
+	extra := make(map[string]chan error)
+	if len(cfg.APIs.AprsKey) > 0 {
+		client := api.NewAPRSClient(cfg.APIs.AprsKey, cfg.Cache.APRS)
+		extra["aprs"] = runCheck(client.TestConnection)
+	}
+	if cfg.APIs.RepeaterBookKey != "" {
+		client := api.NewRepeaterBookClient(cfg.APIs.RepeaterBookKey, cfg.Cache.RepeaterBook)
+		extra["repeaterbook"] = runCheck(client.TestConnection)
+	}
+	extraResults := make(map[string]error, len(extra))
+	for source, result := range extra {
+		select {
+		case err := <-result:
+			extraResults[source] = err
+		case <-ctx.Done():
+			extraResults[source] = fmt.Errorf("%s health check cancelled: %v", source, ctx.Err())
+		}
+	}
+
+	results := <-done
+	for source, err := range extraResults {
+		results[source] = err
+	}
+	// A missing Brandmeister key has already failed above, its uninitialized client needn't fail again
+	if cfg.APIs.BrandmeisterKey == "" {
+		delete(results, "brandmeister")
+	}
+
+	sources := make([]string, 0, len(results))
+	for source := range results {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		if err := results[source]; err != nil {
+			r.fail(source, err, "check the network and http_proxy, or retry later if the service is down")
+		} else {
+			r.pass(source, "reachable")
+		}
+	}
+}
+
+// runCheck starts check and returns where its result will arrive, so a slow source can't outlive the timeout
+func runCheck(check func() error) chan error {
+	result := make(chan error, 1)
+	go func() { result <- check() }()
+	return result
+}
+
+// checkDatabase opens the database, creating it when it's missing, and runs its integrity check
+func checkDatabase(r *report, path string) {
+	_, statErr := os.Stat(path)
+	created := os.IsNotExist(statErr)
+
+	db, err := database.NewDatabase(path)
+	if err != nil {
+		r.fail("open", err, "check that the directory of database.path in configs/config.yaml is writable")
+		return
+	}
+	defer db.Close()
+
+	if created {
+		r.pass("open", "created "+path+", run sync_databases to fill it")
+	} else {
+		r.pass("open", path)
+	}
+
+	if err := db.IntegrityCheck(); err != nil {
+		r.fail("integrity", err, "restore a backup, or delete the database and run sync_databases to rebuild it")
+		return
+	}
+	r.pass("integrity", "no problems found")
+}
+
+// finish prints the summary and exits 1 when anything failed
+func finish(r *report) {
+	if r.failed > 0 {
+		fmt.Printf("%d of %d checks failed\n", r.failed, r.total)
+		os.Exit(1)
+	}
+	fmt.Printf("All %d checks passed\n", r.total)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+const usage = `Usage: snapshot [flags] export <file.json>
+       snapshot [flags] import <file.json>
+
+Exports the whole database (repeaters, locations, talkgroups and sources) to one
+versioned JSON file, or imports such a file into a fresh database. Use - for
+stdout or stdin. Sync history and personal data (favorites, notes, custom names
+and the watchlist) are left out, so a snapshot can be shared.
+
+  snapshot -db digilogrt.db export backup.json
+  snapshot -db restored.db import backup.json
+
+Flags:
+`
+
+func main() {
+	dbPath := flag.String("db", "", "Database file path (defaults to database.path from config)")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		usageError("expected a command and a file")
+	}
+	command, path := flag.Arg(0), flag.Arg(1)
+	if command != "export" && command != "import" {
+		usageError(fmt.Sprintf("unknown command %q", command))
+	}
+
+	if *dbPath == "" {
+		*dbPath = config.DefaultDatabasePath()
+		if cfg, err := config.LoadConfig(); err == nil {
+			*dbPath = cfg.Database.Path
+		}
+	}
+	// Exporting a database that isn't there would create an empty one and export nothing
+	if command == "export" {
+		if _, err := os.Stat(*dbPath); err != nil {
+			log.Fatalf("Failed to open %s: %v", *dbPath, err)
+		}
+	}
+
+	db, err := database.NewDatabase(*dbPath)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", *dbPath, err)
+	}
+	defer db.Close()
+
+	if command == "export" {
+		exportSnapshot(db, *dbPath, path)
+	} else {
+		importSnapshot(db, *dbPath, path)
+	}
+}
+
+func exportSnapshot(db *database.Database, dbPath, path string) {
+	out := os.Stdout
+	if path != "-" {
+		file, err := os.Create(path)
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", path, err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if err := db.ExportSnapshot(out); err != nil {
+		log.Fatalf("Export failed: %v", err)
+	}
+	if path != "-" {
+		fmt.Printf("✓ Exported %s to %s\n", dbPath, path)
+	}
+}
+
+func importSnapshot(db *database.Database, dbPath, path string) {
+	in := os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			log.Fatalf("Failed to open %s: %v", path, err)
+		}
+		defer file.Close()
+		in = file
+	}
+
+	fmt.Printf("📥 Importing %s into %s...\n", path, dbPath)
+	if err := db.ImportSnapshot(in); err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	stats, err := db.GetRepeaterStats()
+	if err != nil {
+		log.Fatalf("Failed to read stats: %v", err)
+	}
+	fmt.Printf("✓ Imported %v repeaters\n", stats["total_repeaters"])
+	if sources, ok := stats["by_source"].(map[string]int); ok {
+		for source, count := range sources {
+			fmt.Printf("  %-15s %d\n", source, count)
+		}
+	}
+}
+
+func usageError(message string) {
+	fmt.Fprintf(os.Stderr, "Error: %s\n\n", message)
+	flag.Usage()
+	os.Exit(2)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// interruptContext is cancelled by the first Ctrl-C or SIGTERM, which stops the running sync between
+// records so its transaction rolls back. A second one quits at once
+func interruptContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		fmt.Println("\n⚠️  Interrupted, rolling back the sync in progress (Ctrl-C again to quit now)...")
+		cancel()
+		<-signals
+		os.Exit(130)
+	}()
+
+	return ctx
+}
+
+// exitInterrupted checkpoints and closes the database after an interrupted sync, then exits
+// committed counts the records of the sources that finished before the interrupt
+func exitInterrupted(db *database.Database, committed int) {
+	if err := db.Checkpoint(); err != nil {
+		log.Printf("Failed to checkpoint database: %v", err)
+	}
+	if err := db.Close(); err != nil {
+		log.Printf("Failed to close database: %v", err)
+	}
+	fmt.Printf("sync interrupted, %d records committed.\n", committed)
+	os.Exit(130)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -14,23 +15,36 @@ import (
 
 // TimingResult tracks detailed timing information for each sync operation
 type TimingResult struct {
-	Source           string
-	RecordCount      int
-	InitTime         time.Duration
-	FetchTime        time.Duration
-	ProcessTime      time.Duration
-	TotalTime        time.Duration
-	RecordsPerSecond float64
+	Source           string        `json:"source"`
+	RecordCount      int           `json:"record_count"`
+	InitTime         time.Duration `json:"init_time"`
+	FetchTime        time.Duration `json:"fetch_time"`
+	ProcessTime      time.Duration `json:"process_time"`
+	TotalTime        time.Duration `json:"total_time"`
+	RecordsPerSecond float64       `json:"records_per_second"`
 }
 
 func main() {
 	// Command line flags
-	dbPath := flag.String("db", "digilog_production.db", "Database file path")
-	sources := flag.String("sources", "brandmeister,tgif,hearham", "Comma-separated list of sources to sync")
+	dbPath := flag.String("db", "", "Database file path (defaults to database.path from config)")
+	sources := flag.String("sources", "brandmeister,tgif,hearham", "Comma-separated list of sources to sync (repeaterbook is opt-in)")
 	_ = flag.Bool("force", false, "Force refresh even if cache is valid")
 	verbose := flag.Bool("verbose", false, "Show detailed timing information")
+	staticTGs := flag.Bool("static-tgs", false, "Also fetch Brandmeister static talkgroups (one API call per online repeater)")
+	dynamicTGs := flag.Bool("dynamic-tgs", false, "With -static-tgs, fetch each repeater's profile to store its dynamic talkgroups too")
+	fast := flag.Bool("fast", false, "Sync from the pre-warmed cache files without calling the APIs (run warm_cache first)")
+	rate := flag.Float64("rate", 0, "Most records per second each sync writes, to leave disk IO for the GUI (0 = unlimited)")
+	allowEmpty := flag.Bool("allow-empty", false, "Accept a source returning no records even if the database has some (normally refused as an outage)")
 	flag.Parse()
 
+	ctx := interruptContext()
+	syncOptions := database.SyncOptions{Context: ctx, RateLimit: *rate}
+
+	if *fast {
+		runFastSync(syncOptions, *dbPath, *allowEmpty)
+		return
+	}
+
 	log.Printf("Starting database sync for sources: %s", *sources)
 	overallStart := time.Now()
 
@@ -39,6 +53,12 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
+	if err := api.ApplyConfig(cfg); err != nil {
+		log.Fatalf("Failed to apply configuration: %v", err)
+	}
+	if *dbPath == "" {
+		*dbPath = cfg.Database.Path
+	}
 
 	// Create database
 	dbStart := time.Now()
@@ -47,6 +67,8 @@ func main() {
 		log.Fatalf("Failed to create database: %v", err)
 	}
 	defer db.Close()
+	db.SetAllowEmptySync(*allowEmpty)
+	db.SetSyncOptions(syncOptions)
 	dbInitTime := time.Since(dbStart)
 
 	fmt.Printf("✓ Database initialized: %s (took %v)\n", *dbPath, dbInitTime)
@@ -55,12 +77,22 @@ func main() {
 	fmt.Println("\n🚀 Initializing API clients in parallel...")
 	poolStart := time.Now()
 	pool := api.GetGlobalPool()
-	if err := pool.Initialize(cfg.APIs.BrandmeisterKey); err != nil {
-		log.Fatalf("Failed to initialize client pool: %v", err)
-	}
+	initResult := pool.Initialize(cfg)
 	poolInitTime := time.Since(poolStart)
 
-	fmt.Printf("✓ All clients initialized in parallel: %v\n", poolInitTime)
+	// Carry on with whichever sources came up, only give up if none did
+	if initResult.AllFailed() {
+		log.Fatalf("Failed to initialize client pool: %v", initResult.Err())
+	}
+	if failed := initResult.Failed(); len(failed) > 0 {
+		for _, source := range failed {
+			fmt.Printf("⚠️  %s client failed to initialize, skipping: %v\n", source, initResult.Errors[source])
+		}
+		fmt.Printf("✓ %d of %d clients initialized in parallel: %v\n",
+			len(initResult.Errors)-len(failed), len(initResult.Errors), poolInitTime)
+	} else {
+		fmt.Printf("✓ All clients initialized in parallel: %v\n", poolInitTime)
+	}
 
 	// Get initialized clients
 	brandmeisterClient, tgifClient, hearhamClient := pool.GetClients()
@@ -77,13 +109,25 @@ func main() {
 	for _, source := range sourceList {
 		var result TimingResult
 
+		if ctx.Err() != nil {
+			exitInterrupted(db, totalRecords)
+		}
+
+		if initResult.Errors[source] != nil {
+			log.Printf("Skipping %s - client failed to initialize", source)
+			continue
+		}
+
 		switch source {
 		case "brandmeister":
 			if brandmeisterClient != nil {
-				result = syncBrandmeisterWithPool(db, brandmeisterClient, *verbose)
+				result = syncBrandmeisterWithPool(ctx, db, brandmeisterClient, *verbose)
 				if result.RecordCount > 0 {
 					totalRecords += result.RecordCount
 					timingResults = append(timingResults, result)
+					if *staticTGs && ctx.Err() == nil {
+						syncBrandmeisterTalkgroups(db, brandmeisterClient, *dynamicTGs)
+					}
 				}
 			} else {
 				log.Println("Skipping Brandmeister - no API key configured")
@@ -111,15 +155,28 @@ func main() {
 				log.Println("Skipping hearham - client not initialized")
 			}
 
+		case "repeaterbook":
+			// Not part of the client pool, the sync walks the configured regions itself
+			result = syncRepeaterBook(db, cfg, *verbose)
+			if result.RecordCount > 0 {
+				totalRecords += result.RecordCount
+				timingResults = append(timingResults, result)
+			}
+
 		default:
 			log.Printf("Unknown source: %s", source)
 		}
 	}
+	if ctx.Err() != nil {
+		exitInterrupted(db, totalRecords)
+	}
 
 	overallElapsed := time.Since(overallStart)
 
 	// Show detailed timing analysis with pool metrics
-	showTimingAnalysisWithPool(timingResults, totalRecords, overallElapsed, dbInitTime, poolInitTime, *verbose)
+	report := buildSyncReport(timingResults, totalRecords, overallElapsed, dbInitTime, poolInitTime)
+	report.RateLimit = syncOptions.RateLimit
+	report.Print(*verbose)
 
 	// Show database statistics
 	statsStart := time.Now()
@@ -142,7 +199,7 @@ func main() {
 	fmt.Printf("\n✓ Database ready for production use: %s\n", *dbPath)
 }
 
-func syncBrandmeisterWithPool(db *database.Database, client *api.BrandmeisterClient, verbose bool) TimingResult {
+func syncBrandmeisterWithPool(ctx context.Context, db *database.Database, client *api.BrandmeisterClient, verbose bool) TimingResult {
 	result := TimingResult{Source: "brandmeister"}
 	sourceStart := time.Now()
 
@@ -155,7 +212,7 @@ func syncBrandmeisterWithPool(db *database.Database, client *api.BrandmeisterCli
 
 	// Fetch data
 	fetchStart := time.Now()
-	response, err := client.GetAllRepeaters()
+	response, err := client.GetAllRepeatersContext(ctx)
 	if err != nil {
 		log.Printf("Failed to get Brandmeister repeaters: %v", err)
 		return result
@@ -170,19 +227,58 @@ func syncBrandmeisterWithPool(db *database.Database, client *api.BrandmeisterCli
 	// Use the repeaters as returned by the API client
 	if err := db.SyncBrandmeisterData(response); err != nil {
 		log.Printf("Failed to sync Brandmeister data: %v", err)
+		result.RecordCount = 0 // Rolled back, nothing was committed
 		return result
 	}
 	result.ProcessTime = time.Since(processStart)
 	result.TotalTime = time.Since(sourceStart)
 	result.RecordsPerSecond = float64(result.RecordCount) / result.TotalTime.Seconds()
 
-	fmt.Printf("⏱️  Database sync: %v\n", result.ProcessTime)
+	fmt.Printf("⏱️  Database sync: %v (%.0f records/second)\n", result.ProcessTime, float64(result.RecordCount)/result.ProcessTime.Seconds())
 	fmt.Printf("⏱️  Total time: %v\n", result.TotalTime)
 	fmt.Printf("🚀 Processing rate: %.0f records/second\n", result.RecordsPerSecond)
 
 	return result
 }
 
+// syncBrandmeisterTalkgroups links online Brandmeister repeaters to their static talkgroups, and to their
+// dynamic ones too when asked
+func syncBrandmeisterTalkgroups(db *database.Database, client *api.BrandmeisterClient, dynamic bool) {
+	repeaters, err := client.GetAllRepeaters()
+	if err != nil {
+		log.Printf("Failed to get Brandmeister repeaters: %v", err)
+		return
+	}
+
+	var deviceIDs []int
+	for _, r := range repeaters {
+		if r.IsOnline() {
+			deviceIDs = append(deviceIDs, r.ID)
+		}
+	}
+
+	if dynamic {
+		fmt.Printf("\n🔗 Fetching static and dynamic talkgroups for %d online Brandmeister devices...\n", len(deviceIDs))
+		start := time.Now()
+		links := client.GetRepeaterTalkgroupsForDevices(deviceIDs, 8)
+		fmt.Printf("⏱️  Talkgroup fetch: %v (%d devices)\n", time.Since(start), len(links))
+
+		if err := db.SyncBrandmeisterTalkgroups(links); err != nil {
+			log.Printf("Failed to sync Brandmeister talkgroups: %v", err)
+		}
+		return
+	}
+
+	fmt.Printf("\n🔗 Fetching static talkgroups for %d online Brandmeister devices...\n", len(deviceIDs))
+	start := time.Now()
+	links := client.GetStaticTalkgroupsForDevices(deviceIDs, 8)
+	fmt.Printf("⏱️  Static talkgroup fetch: %v (%d devices)\n", time.Since(start), len(links))
+
+	if err := db.SyncBrandmeisterStaticTalkgroups(links); err != nil {
+		log.Printf("Failed to sync Brandmeister static talkgroups: %v", err)
+	}
+}
+
 func syncTGIFWithPool(db *database.Database, client *api.TGIFClient, verbose bool) TimingResult {
 	result := TimingResult{Source: "tgif"}
 	sourceStart := time.Now()
@@ -210,13 +306,14 @@ func syncTGIFWithPool(db *database.Database, client *api.TGIFClient, verbose boo
 	processStart := time.Now()
 	if err := db.SyncTGIFData(response); err != nil {
 		log.Printf("Failed to sync TGIF data: %v", err)
+		result.RecordCount = 0 // Rolled back, nothing was committed
 		return result
 	}
 	result.ProcessTime = time.Since(processStart)
 	result.TotalTime = time.Since(sourceStart)
 	result.RecordsPerSecond = float64(result.RecordCount) / result.TotalTime.Seconds()
 
-	fmt.Printf("⏱️  Database sync: %v\n", result.ProcessTime)
+	fmt.Printf("⏱️  Database sync: %v (%.0f records/second)\n", result.ProcessTime, float64(result.RecordCount)/result.ProcessTime.Seconds())
 	fmt.Printf("⏱️  Total time: %v\n", result.TotalTime)
 	fmt.Printf("🚀 Processing rate: %.0f records/second\n", result.RecordsPerSecond)
 
@@ -250,20 +347,141 @@ func syncHearhamWithPool(db *database.Database, client *api.HearhamClient, verbo
 	processStart := time.Now()
 	if err := db.SyncHearhamData(response); err != nil {
 		log.Printf("Failed to sync hearham data: %v", err)
+		result.RecordCount = 0 // Rolled back, nothing was committed
+		return result
+	}
+	result.ProcessTime = time.Since(processStart)
+	result.TotalTime = time.Since(sourceStart)
+	result.RecordsPerSecond = float64(result.RecordCount) / result.TotalTime.Seconds()
+
+	fmt.Printf("⏱️  Database sync: %v (%.0f records/second)\n", result.ProcessTime, float64(result.RecordCount)/result.ProcessTime.Seconds())
+	fmt.Printf("⏱️  Total time: %v\n", result.TotalTime)
+	fmt.Printf("🚀 Processing rate: %.0f records/second\n", result.RecordsPerSecond)
+
+	return result
+}
+
+// syncRepeaterBook fetches every configured RepeaterBook region and syncs the combined results
+func syncRepeaterBook(db *database.Database, cfg *config.Config, verbose bool) TimingResult {
+	result := TimingResult{Source: "repeaterbook"}
+	sourceStart := time.Now()
+
+	fmt.Println("\n" + strings.Repeat("=", 50))
+	fmt.Println("SYNCING REPEATERBOOK DATA")
+	fmt.Println(strings.Repeat("=", 50))
+
+	regions := api.RepeaterBookRegions(cfg.RepeaterBook.States, cfg.RepeaterBook.Countries)
+	if len(regions) == 0 {
+		log.Println("Skipping RepeaterBook - no states or countries configured under repeaterbook in config.yaml")
+		return result
+	}
+
+	initStart := time.Now()
+	client := api.NewRepeaterBookClient(cfg.APIs.RepeaterBookKey, cfg.Cache.RepeaterBook)
+	result.InitTime = time.Since(initStart)
+
+	// Fetch data
+	delay := cfg.RepeaterBook.RequestDelayTime(5 * time.Second)
+	fmt.Printf("Fetching %d regions (%v between API calls)...\n", len(regions), delay)
+
+	fetchStart := time.Now()
+	response, err := client.FetchRegions(regions, delay)
+	if err != nil {
+		log.Printf("Failed to get RepeaterBook repeaters: %v", err)
+		return result
+	}
+	result.FetchTime = time.Since(fetchStart)
+	result.RecordCount = len(response)
+
+	fmt.Printf("⏱️  Data fetch: %v (%d records)\n", result.FetchTime, result.RecordCount)
+
+	// Process data
+	processStart := time.Now()
+	if err := db.SyncRepeaterBookData(response); err != nil {
+		log.Printf("Failed to sync RepeaterBook data: %v", err)
+		result.RecordCount = 0 // Rolled back, nothing was committed
 		return result
 	}
 	result.ProcessTime = time.Since(processStart)
 	result.TotalTime = time.Since(sourceStart)
 	result.RecordsPerSecond = float64(result.RecordCount) / result.TotalTime.Seconds()
 
-	fmt.Printf("⏱️  Database sync: %v\n", result.ProcessTime)
+	fmt.Printf("⏱️  Database sync: %v (%.0f records/second)\n", result.ProcessTime, float64(result.RecordCount)/result.ProcessTime.Seconds())
 	fmt.Printf("⏱️  Total time: %v\n", result.TotalTime)
 	fmt.Printf("🚀 Processing rate: %.0f records/second\n", result.RecordsPerSecond)
 
 	return result
 }
 
-func showTimingAnalysisWithPool(results []TimingResult, totalRecords int, overallTime time.Duration, dbInitTime time.Duration, poolInitTime time.Duration, verbose bool) {
+// SyncReport is the structured result of a sync run
+// It holds everything the timing analysis computes so it can be printed, logged or serialized
+type SyncReport struct {
+	Results          []TimingResult `json:"results"`
+	TotalRecords     int            `json:"total_records"`
+	DatabaseInitTime time.Duration  `json:"database_init_time"`
+	PoolInitTime     time.Duration  `json:"pool_init_time"`
+	TotalFetchTime   time.Duration  `json:"total_fetch_time"`
+	TotalProcessTime time.Duration  `json:"total_process_time"`
+	OverallTime      time.Duration  `json:"overall_time"`
+	OverallRate      float64        `json:"overall_records_per_second"`
+	DatabaseRate     float64        `json:"database_records_per_second"` // Records/second spent in DB processing only
+	RateLimit        float64        `json:"rate_limit,omitempty"`        // SyncOptions.RateLimit, 0 when unlimited
+	TimeSaved        time.Duration  `json:"time_saved"`                  // Estimated saving from parallel client init
+	Bottleneck       string         `json:"bottleneck"`                  // "network" or "database"
+}
+
+// buildSyncReport computes totals, rates and the bottleneck from per-source timings
+func buildSyncReport(results []TimingResult, totalRecords int, overallTime time.Duration, dbInitTime time.Duration, poolInitTime time.Duration) *SyncReport {
+	report := &SyncReport{
+		Results:          results,
+		TotalRecords:     totalRecords,
+		DatabaseInitTime: dbInitTime,
+		PoolInitTime:     poolInitTime,
+		OverallTime:      overallTime,
+	}
+
+	sequentialInitTime := time.Duration(0)
+	for _, result := range results {
+		report.TotalFetchTime += result.FetchTime
+		report.TotalProcessTime += result.ProcessTime
+		sequentialInitTime += result.InitTime
+	}
+
+	if overallTime > 0 {
+		report.OverallRate = float64(totalRecords) / overallTime.Seconds()
+	}
+	if report.TotalProcessTime > 0 {
+		report.DatabaseRate = float64(totalRecords) / report.TotalProcessTime.Seconds()
+	}
+
+	// Performance improvement calculation
+	if sequentialInitTime == 0 {
+		// Estimate based on previous runs (use your measured ~10.4s)
+		sequentialInitTime = 10400 * time.Millisecond
+	}
+	if saved := sequentialInitTime - poolInitTime; saved > 0 {
+		report.TimeSaved = saved
+	}
+
+	if report.TotalFetchTime > report.TotalProcessTime {
+		report.Bottleneck = "network"
+	} else {
+		report.Bottleneck = "database"
+	}
+
+	return report
+}
+
+// percentOfOverall returns d as a percentage of the overall elapsed time
+func (r *SyncReport) percentOfOverall(d time.Duration) float64 {
+	if r.OverallTime == 0 {
+		return 0
+	}
+	return float64(d) / float64(r.OverallTime) * 100
+}
+
+// Print writes the detailed timing analysis to stdout
+func (r *SyncReport) Print(verbose bool) {
 	fmt.Println("\n" + strings.Repeat("=", 70))
 	fmt.Println("DETAILED TIMING ANALYSIS (WITH PARALLEL INIT)")
 	fmt.Println(strings.Repeat("=", 70))
@@ -273,10 +491,7 @@ func showTimingAnalysisWithPool(results []TimingResult, totalRecords int, overal
 		"Source", "Records", "Init", "Fetch", "Process", "Total")
 	fmt.Println(strings.Repeat("-", 70))
 
-	totalFetchTime := time.Duration(0)
-	totalProcessTime := time.Duration(0)
-
-	for _, result := range results {
+	for _, result := range r.Results {
 		fmt.Printf("%-15s %8d %12s %12v %12v %12v\n",
 			result.Source,
 			result.RecordCount,
@@ -284,75 +499,60 @@ func showTimingAnalysisWithPool(results []TimingResult, totalRecords int, overal
 			result.FetchTime,
 			result.ProcessTime,
 			result.TotalTime)
-
-		totalFetchTime += result.FetchTime
-		totalProcessTime += result.ProcessTime
 	}
 
 	fmt.Println(strings.Repeat("-", 70))
 
 	// Overall statistics
 	fmt.Printf("\nOVERALL PERFORMANCE METRICS:\n")
-	fmt.Printf("  Database initialization: %v\n", dbInitTime)
-	fmt.Printf("  Parallel client init:    %v\n", poolInitTime)
-	fmt.Printf("  Total data fetch time:   %v\n", totalFetchTime)
-	fmt.Printf("  Total processing time:   %v\n", totalProcessTime)
-	fmt.Printf("  Overall elapsed time:    %v\n", overallTime)
-	fmt.Printf("  Total records processed: %d\n", totalRecords)
-
-	overallRate := float64(totalRecords) / overallTime.Seconds()
-	fmt.Printf("  Overall processing rate: %.0f records/second\n", overallRate)
-
-	// Performance improvement calculation
-	sequentialInitTime := time.Duration(0)
-	for _, result := range results {
-		sequentialInitTime += result.InitTime
-	}
-	if sequentialInitTime == 0 {
-		// Estimate based on previous runs (use your measured ~10.4s)
-		sequentialInitTime = 10400 * time.Millisecond
-	}
-
-	savedTime := sequentialInitTime - poolInitTime
-	if savedTime > 0 {
+	fmt.Printf("  Database initialization: %v\n", r.DatabaseInitTime)
+	fmt.Printf("  Parallel client init:    %v\n", r.PoolInitTime)
+	fmt.Printf("  Total data fetch time:   %v\n", r.TotalFetchTime)
+	fmt.Printf("  Total processing time:   %v\n", r.TotalProcessTime)
+	fmt.Printf("  Overall elapsed time:    %v\n", r.OverallTime)
+	fmt.Printf("  Total records processed: %d\n", r.TotalRecords)
+	fmt.Printf("  Overall processing rate: %.0f records/second\n", r.OverallRate)
+
+	if r.TimeSaved > 0 {
+		sequentialInitTime := r.TimeSaved + r.PoolInitTime
 		fmt.Printf("  Time saved with parallel: %v (%.1f%% faster)\n",
-			savedTime, float64(savedTime)/float64(sequentialInitTime)*100)
+			r.TimeSaved, float64(r.TimeSaved)/float64(sequentialInitTime)*100)
 	}
 
 	// Performance breakdown percentages
 	if verbose {
 		fmt.Printf("\nTIME BREAKDOWN:\n")
-		fmt.Printf("  Database init: %.1f%%\n", float64(dbInitTime)/float64(overallTime)*100)
-		fmt.Printf("  Parallel init: %.1f%%\n", float64(poolInitTime)/float64(overallTime)*100)
-		fmt.Printf("  Data fetching: %.1f%%\n", float64(totalFetchTime)/float64(overallTime)*100)
-		fmt.Printf("  DB processing: %.1f%%\n", float64(totalProcessTime)/float64(overallTime)*100)
+		fmt.Printf("  Database init: %.1f%%\n", r.percentOfOverall(r.DatabaseInitTime))
+		fmt.Printf("  Parallel init: %.1f%%\n", r.percentOfOverall(r.PoolInitTime))
+		fmt.Printf("  Data fetching: %.1f%%\n", r.percentOfOverall(r.TotalFetchTime))
+		fmt.Printf("  DB processing: %.1f%%\n", r.percentOfOverall(r.TotalProcessTime))
 	}
 
 	// Performance insights
 	fmt.Printf("\nPERFORMANCE INSIGHTS:\n")
-	if totalFetchTime > totalProcessTime {
-		fmt.Printf("  🌐 Network I/O is the bottleneck (%.1f%% of time)\n",
-			float64(totalFetchTime)/float64(overallTime)*100)
+	if r.Bottleneck == "network" {
+		fmt.Printf("  🌐 Network I/O is the bottleneck (%.1f%% of time)\n", r.percentOfOverall(r.TotalFetchTime))
 	} else {
-		fmt.Printf("  💾 Database processing is the bottleneck (%.1f%% of time)\n",
-			float64(totalProcessTime)/float64(overallTime)*100)
+		fmt.Printf("  💾 Database processing is the bottleneck (%.1f%% of time)\n", r.percentOfOverall(r.TotalProcessTime))
 	}
 
-	if overallRate > 15000 {
+	if r.OverallRate > 15000 {
 		fmt.Printf("  🚀 Excellent performance: >15k records/second\n")
-	} else if overallRate > 10000 {
+	} else if r.OverallRate > 10000 {
 		fmt.Printf("  ⚡ Very good performance: >10k records/second\n")
-	} else if overallRate > 5000 {
+	} else if r.OverallRate > 5000 {
 		fmt.Printf("  ✅ Good performance: >5k records/second\n")
-	} else if overallRate > 1000 {
+	} else if r.OverallRate > 1000 {
 		fmt.Printf("  🔄 Acceptable performance: >1k records/second\n")
 	} else {
 		fmt.Printf("  ⚠️  Consider optimization: <1k records/second\n")
 	}
 
 	// Database efficiency
-	if totalProcessTime > 0 {
-		dbRate := float64(totalRecords) / totalProcessTime.Seconds()
-		fmt.Printf("  💾 Pure database rate: %.0f records/second\n", dbRate)
+	if r.TotalProcessTime > 0 {
+		fmt.Printf("  💾 Pure database rate: %.0f records/second\n", r.DatabaseRate)
+	}
+	if r.RateLimit > 0 {
+		fmt.Printf("  🐢 Rate limited to %.0f records/second per sync\n", r.RateLimit)
 	}
 }
@@ -1,96 +1,111 @@
 package main
 
 import (
-	"encoding/json"
-	"flag"
+	"context"
+	"errors"
 	"fmt"
 	"log"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
+	"github.com/unklstewy/digiLogRT/internal/config"
 	"github.com/unklstewy/digiLogRT/internal/database"
 )
 
-func main() {
-	dbFile := flag.String("db", "fast_sync.db", "Database file to sync to")
-	flag.Bool("verbose", false, "Enable verbose output (for compatibility)")
-	flag.Parse()
+// runFastSync loads the database straight from the pre-warmed cache files without touching the APIs
+func runFastSync(syncOptions database.SyncOptions, dbPath string, allowEmpty bool) {
+	if dbPath == "" {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			cfg = config.GetDefaultConfig()
+		}
+		dbPath = cfg.Database.Path
+	}
 
 	fmt.Printf("🚀 FAST SYNC: Reading from pre-warmed caches\n")
 	start := time.Now()
 
 	// Initialize database only
-	fmt.Printf("Initializing database: %s\n", *dbFile)
+	fmt.Printf("Initializing database: %s\n", dbPath)
 	dbStart := time.Now()
-	db, err := database.NewDatabase(*dbFile)
+	db, err := database.NewDatabase(dbPath)
 	if err != nil {
 		log.Fatalf("Failed to initialize database: %v", err)
 	}
 	defer db.Close()
+	db.SetAllowEmptySync(allowEmpty)
+	db.SetSyncOptions(syncOptions)
 	dbInitTime := time.Since(dbStart)
-	fmt.Printf("✓ Database initialized: %s (took %v)\n", *dbFile, dbInitTime)
+	fmt.Printf("✓ Database initialized: %s (took %v)\n", dbPath, dbInitTime)
 
 	// Read directly from cache files instead of initializing APIs
-	cacheDir := filepath.Join(os.TempDir(), "digiLogRT", "cache")
-
+	// A missing or corrupt cache skips that source instead of failing the whole sync
 	// Load Brandmeister data from cache
-	brandmeisterFile := filepath.Join(cacheDir, "brandmeister_repeaters.json")
 	bmStart := time.Now()
-	bmData, err := readBrandmeisterCache(brandmeisterFile)
-	if err != nil {
-		log.Fatalf("Failed to read Brandmeister cache: %v (run warm_cache first)", err)
-	}
+	bmData, bmOK := loadFastCache[api.BrandmeisterRepeater](cache.SourceBrandmeister)
 	bmReadTime := time.Since(bmStart)
 
 	// Load TGIF data from cache
-	tgifFile := filepath.Join(cacheDir, "tgif_talkgroups.json")
 	tgStart := time.Now()
-	tgData, err := readTGIFCache(tgifFile)
-	if err != nil {
-		log.Fatalf("Failed to read TGIF cache: %v (run warm_cache first)", err)
-	}
+	tgData, tgOK := loadFastCache[api.TGIFTalkgroup](cache.SourceTGIF)
 	tgReadTime := time.Since(tgStart)
 
 	// Load hearham data from cache
-	hearhamFile := filepath.Join(cacheDir, "hearham_repeaters.json")
 	hhStart := time.Now()
-	hhData, err := readHearhamCache(hearhamFile)
-	if err != nil {
-		log.Fatalf("Failed to read hearham cache: %v (run warm_cache first)", err)
-	}
+	hhData, hhOK := loadFastCache[api.HearhamRepeater](cache.SourceHearham)
 	hhReadTime := time.Since(hhStart)
 
+	if !bmOK && !tgOK && !hhOK {
+		log.Fatalf("No usable cache files (run warm_cache first)")
+	}
+
 	totalReadTime := bmReadTime + tgReadTime + hhReadTime
-	fmt.Printf("✓ All cache files loaded in %v\n", totalReadTime)
+	fmt.Printf("✓ Cache files loaded in %v\n", totalReadTime)
 
 	// Sync to database
 	fmt.Printf("\n🚀 SYNCING FROM CACHED DATA\n")
 
 	// Sync Brandmeister - use the same method names as the original sync
-	fmt.Printf("Syncing %d Brandmeister repeaters...\n", len(bmData))
-	bmSyncStart := time.Now()
-	if err := db.SyncBrandmeisterData(bmData); err != nil {
-		log.Fatalf("Failed to sync Brandmeister data: %v", err)
+	var bmSyncTime, tgSyncTime, hhSyncTime time.Duration
+	committed := 0
+	if bmOK {
+		fmt.Printf("Syncing %d Brandmeister repeaters...\n", len(bmData))
+		bmSyncStart := time.Now()
+		if err := db.SyncBrandmeisterData(bmData); errors.Is(err, context.Canceled) {
+			exitInterrupted(db, committed)
+		} else if err != nil {
+			log.Fatalf("Failed to sync Brandmeister data: %v", err)
+		}
+		bmSyncTime = time.Since(bmSyncStart)
+		committed += len(bmData)
 	}
-	bmSyncTime := time.Since(bmSyncStart)
 
 	// Sync TGIF
-	fmt.Printf("Syncing %d TGIF talkgroups...\n", len(tgData))
-	tgSyncStart := time.Now()
-	if err := db.SyncTGIFData(tgData); err != nil {
-		log.Fatalf("Failed to sync TGIF data: %v", err)
+	if tgOK {
+		fmt.Printf("Syncing %d TGIF talkgroups...\n", len(tgData))
+		tgSyncStart := time.Now()
+		if err := db.SyncTGIFData(tgData); errors.Is(err, context.Canceled) {
+			exitInterrupted(db, committed)
+		} else if err != nil {
+			log.Fatalf("Failed to sync TGIF data: %v", err)
+		}
+		tgSyncTime = time.Since(tgSyncStart)
+		committed += len(tgData)
 	}
-	tgSyncTime := time.Since(tgSyncStart)
 
 	// Sync hearham
-	fmt.Printf("Syncing %d hearham repeaters...\n", len(hhData))
-	hhSyncStart := time.Now()
-	if err := db.SyncHearhamData(hhData); err != nil {
-		log.Fatalf("Failed to sync hearham data: %v", err)
+	if hhOK {
+		fmt.Printf("Syncing %d hearham repeaters...\n", len(hhData))
+		hhSyncStart := time.Now()
+		if err := db.SyncHearhamData(hhData); errors.Is(err, context.Canceled) {
+			exitInterrupted(db, committed)
+		} else if err != nil {
+			log.Fatalf("Failed to sync hearham data: %v", err)
+		}
+		hhSyncTime = time.Since(hhSyncStart)
+		committed += len(hhData)
 	}
-	hhSyncTime := time.Since(hhSyncStart)
 
 	totalSyncTime := bmSyncTime + tgSyncTime + hhSyncTime
 	totalTime := time.Since(start)
@@ -107,6 +122,9 @@ func main() {
 	fmt.Printf("Total records:     %d\n", totalRecords)
 	fmt.Printf("Overall rate:      %.0f records/second\n", float64(totalRecords)/totalTime.Seconds())
 	fmt.Printf("Pure DB rate:      %.0f records/second\n", float64(totalRecords)/totalSyncTime.Seconds())
+	if syncOptions.RateLimit > 0 {
+		fmt.Printf("Rate limit:        %.0f records/second per sync\n", syncOptions.RateLimit)
+	}
 
 	// Performance comparison
 	fmt.Printf("\n🚀 PERFORMANCE COMPARISON:\n")
@@ -135,38 +153,22 @@ func main() {
 		}
 	}
 
-	fmt.Printf("\n✅ BLAZING FAST SYNC COMPLETE! Database ready: %s\n", *dbFile)
+	fmt.Printf("\n✅ BLAZING FAST SYNC COMPLETE! Database ready: %s\n", dbPath)
 }
 
-func readBrandmeisterCache(filename string) ([]api.BrandmeisterRepeater, error) {
-	data, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, err
+// loadFastCache reads one source's cache file, reporting and skipping it when missing or corrupt
+func loadFastCache[T any](source string) ([]T, bool) {
+	path := cache.SourcePath(source)
+	data, err := cache.LoadCache[T](path)
+	if errors.Is(err, cache.ErrCorrupt) {
+		fmt.Printf("⚠️  %s cache was corrupt and has been removed, skipping (run warm_cache to rebuild it)\n", source)
+		return nil, false
+	} else if err != nil {
+		fmt.Printf("⚠️  Failed to read %s cache, skipping: %v (run warm_cache first)\n", source, err)
+		return nil, false
 	}
-
-	var repeaters []api.BrandmeisterRepeater
-	err = json.Unmarshal(data, &repeaters)
-	return repeaters, err
-}
-
-func readTGIFCache(filename string) ([]api.TGIFTalkgroup, error) {
-	data, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, err
+	if format, err := cache.FileFormat(path); err == nil {
+		fmt.Printf("  %s: %d records (%s cache)\n", source, len(data), format)
 	}
-
-	var talkgroups []api.TGIFTalkgroup
-	err = json.Unmarshal(data, &talkgroups)
-	return talkgroups, err
-}
-
-func readHearhamCache(filename string) ([]api.HearhamRepeater, error) {
-	data, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, err
-	}
-
-	var repeaters []api.HearhamRepeater
-	err = json.Unmarshal(data, &repeaters)
-	return repeaters, err
+	return data, true
 }
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// status tracks how the last warm and sync of each source went, for /healthz
+type status struct {
+	mu      sync.Mutex
+	sources map[string]*sourceStatus
+}
+
+type sourceStatus struct {
+	LastWarm  *time.Time `json:"last_warm,omitempty"`
+	WarmError string     `json:"warm_error,omitempty"`
+	LastSync  *time.Time `json:"last_sync,omitempty"`
+	SyncError string     `json:"sync_error,omitempty"`
+}
+
+func newStatus(sources []string) *status {
+	s := &status{sources: make(map[string]*sourceStatus)}
+	for _, source := range sources {
+		s.sources[source] = &sourceStatus{}
+	}
+	return s
+}
+
+func (s *status) warmed(source string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.sources[source].LastWarm = &now
+	s.sources[source].WarmError = errorString(err)
+}
+
+func (s *status) synced(source string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.sources[source].LastSync = &now
+	s.sources[source].SyncError = errorString(err)
+}
+
+// ServeHTTP answers 200 once every source has synced and the last sync of each succeeded, 503 otherwise
+// A failed warm alone stays healthy, the database still holds the last good sync
+func (s *status) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	healthy := true
+	for _, source := range s.sources {
+		if source.LastSync == nil || source.SyncError != "" {
+			healthy = false
+		}
+	}
+	body, err := json.MarshalIndent(map[string]interface{}{
+		"healthy": healthy,
+		"sources": s.sources,
+	}, "", "  ")
+	s.mu.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	w.Write(body)
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
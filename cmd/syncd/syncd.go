@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+	"github.com/unklstewy/digiLogRT/internal/logging"
+	"github.com/unklstewy/digiLogRT/internal/metrics"
+)
+
+const usage = `Usage: syncd [flags]
+
+Runs in the background, warming the API caches and syncing the database on the
+schedule in the daemon section of configs/config.yaml, and serving /healthz and
+/metrics. Every warm_interval it refreshes the caches older than that, and every
+sync_interval it warms them again and syncs the database from them. /healthz
+answers 503 until every source has synced, and whenever a source's last sync
+failed. Ctrl-C or SIGTERM stops it, rolling back a sync in progress and
+checkpointing the database.
+
+Flags:
+`
+
+func main() {
+	dbPath := flag.String("db", "", "Database file path (defaults to database.path from config)")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() > 0 {
+		usageError(fmt.Sprintf("unexpected argument %q", flag.Arg(0)))
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	logFile, err := logging.Setup(cfg.Logging)
+	if err != nil {
+		log.Fatalf("Failed to set up logging: %v", err)
+	}
+	defer logFile.Close()
+
+	if err := api.ApplyConfig(cfg); err != nil {
+		log.Fatalf("Failed to apply configuration: %v", err)
+	}
+	if *dbPath == "" {
+		*dbPath = cfg.Database.Path
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	db, err := database.NewDatabase(*dbPath)
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+	db.SetSyncOptions(database.SyncOptions{Context: ctx, RateLimit: cfg.Daemon.RateLimit})
+
+	sources := daemonSources(cfg)
+	d := &daemon{
+		cfg:          cfg,
+		db:           db,
+		sources:      sources,
+		warmInterval: cfg.Daemon.WarmIntervalTime(),
+		status:       newStatus(sources),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	mux.Handle("/healthz", d.status)
+	server := &http.Server{Addr: cfg.Daemon.Addr, Handler: mux}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("HTTP server failed: %v", err)
+		}
+	}()
+
+	slog.Info("syncd started", "db", *dbPath, "sources", sources, "addr", cfg.Daemon.Addr,
+		"warm_interval", d.warmInterval, "sync_interval", cfg.Daemon.SyncIntervalTime())
+
+	d.run(ctx, cfg.Daemon.SyncIntervalTime())
+
+	slog.Info("syncd stopping")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("HTTP server did not shut down cleanly", "error", err)
+	}
+	if err := db.Checkpoint(); err != nil {
+		slog.Warn("Failed to checkpoint database", "error", err)
+	}
+	if err := db.Close(); err != nil {
+		slog.Warn("Failed to close database", "error", err)
+	}
+}
+
+// daemonSources are the cached sources syncd can keep fresh, Brandmeister only with an API key
+func daemonSources(cfg *config.Config) []string {
+	var sources []string
+	for _, source := range cache.Sources() {
+		if source == cache.SourceBrandmeister && cfg.APIs.BrandmeisterKey == "" {
+			slog.Warn("No Brandmeister API key configured, leaving Brandmeister out")
+			continue
+		}
+		sources = append(sources, source)
+	}
+	return sources
+}
+
+type daemon struct {
+	cfg          *config.Config
+	db           *database.Database
+	sources      []string
+	warmInterval time.Duration
+	status       *status
+}
+
+// run warms and syncs once at startup, then on every tick until ctx is cancelled
+func (d *daemon) run(ctx context.Context, syncInterval time.Duration) {
+	warmTicker := time.NewTicker(d.warmInterval)
+	defer warmTicker.Stop()
+	syncTicker := time.NewTicker(syncInterval)
+	defer syncTicker.Stop()
+
+	d.warm()
+	d.sync(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-warmTicker.C:
+			d.warm()
+		case <-syncTicker.C:
+			// Warm first, so the sync never reads a cache older than the warm interval
+			d.warm()
+			d.sync(ctx)
+		}
+	}
+}
+
+// warm refreshes the caches older than the warm interval
+func (d *daemon) warm() {
+	start := time.Now()
+	results := api.GetGlobalPool().WarmCaches(d.cfg, d.warmInterval, d.sources)
+
+	for _, r := range results {
+		switch {
+		case r.Err != nil:
+			slog.Warn("Cache warm failed", "source", r.Source, "error", r.Err)
+		case r.Refreshed:
+			slog.Info("Cache refreshed", "source", r.Source, "was", r.Age.Round(time.Second))
+		default:
+			slog.Debug("Cache fresh", "source", r.Source, "age", r.Age.Round(time.Second))
+		}
+		d.status.warmed(r.Source, r.Err)
+	}
+	slog.Info("Cache warm pass finished", "took", time.Since(start).Round(time.Millisecond))
+}
+
+// sync loads every source's cache file into the database
+func (d *daemon) sync(ctx context.Context) {
+	start := time.Now()
+	for _, source := range d.sources {
+		if ctx.Err() != nil {
+			return
+		}
+
+		sourceStart := time.Now()
+		records, err := d.syncSource(source)
+		if errors.Is(err, context.Canceled) {
+			slog.Info("Sync interrupted and rolled back", "source", source)
+			return
+		}
+		if err != nil {
+			slog.Error("Sync failed", "source", source, "error", err)
+		} else {
+			slog.Info("Synced", "source", source, "records", records, "took", time.Since(sourceStart).Round(time.Millisecond))
+		}
+		d.status.synced(source, err)
+	}
+	slog.Info("Sync pass finished", "took", time.Since(start).Round(time.Millisecond))
+}
+
+// syncSource syncs one source from its cache file and returns how many records it had
+func (d *daemon) syncSource(source string) (int, error) {
+	path := cache.SourcePath(source)
+	switch source {
+	case cache.SourceBrandmeister:
+		repeaters, err := cache.LoadCache[api.BrandmeisterRepeater](path)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read cache: %v", err)
+		}
+		return len(repeaters), d.db.SyncBrandmeisterData(repeaters)
+	case cache.SourceTGIF:
+		talkgroups, err := cache.LoadCache[api.TGIFTalkgroup](path)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read cache: %v", err)
+		}
+		return len(talkgroups), d.db.SyncTGIFData(talkgroups)
+	case cache.SourceHearham:
+		repeaters, err := cache.LoadCache[api.HearhamRepeater](path)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read cache: %v", err)
+		}
+		return len(repeaters), d.db.SyncHearhamData(repeaters)
+	}
+	return 0, fmt.Errorf("unknown source %q", source)
+}
+
+func usageError(message string) {
+	fmt.Fprintf(os.Stderr, "Error: %s\n\n", message)
+	flag.Usage()
+	os.Exit(2)
+}
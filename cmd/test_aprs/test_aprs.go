@@ -5,13 +5,14 @@ import (
 	"log"
 
 	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
 )
 
 func main() {
 	log.Println("Testing APRS.fi API...")
 
 	// Create APRS client with your API key
-	client := api.NewAPRSClient("126515.6ryMvtanTmJDG")
+	client := api.NewAPRSClient([]string{"126515.6ryMvtanTmJDG"}, config.CacheSettings{})
 
 	// Test the connection
 	fmt.Println("Testing API connection...")
@@ -38,7 +39,25 @@ func main() {
 		fmt.Printf("Last seen: %s\n", station.GetLastTimeString()) // Using helper method
 		fmt.Printf("Time: %s\n", station.GetTimeString())          // Using helper method
 		fmt.Printf("Comment: %s\n", station.Comment)
+
+		// Stations around it, nearest first
+		fmt.Println("\nTesting nearby stations...")
+		nearby, err := client.GetNearbyStations(station.GetLatitude(), station.GetLongitude(), 25, 10)
+		if err != nil {
+			log.Fatalf("Nearby search failed: %v", err)
+		}
+		for _, n := range nearby {
+			fmt.Printf("  %-12s %6.1f km\n", n.Name, n.DistanceKm)
+		}
+		fmt.Printf("✓ %d nearest station(s) within 25 km\n", len(nearby))
 	}
 
+	// Show cache status
+	status := client.GetCacheStatus()
+	fmt.Printf("\nCache Status:\n")
+	fmt.Printf("  Cached records: %d\n", status["count"])
+	fmt.Printf("  Cache Valid: %t\n", status["cache_valid"])
+	fmt.Printf("  Needs Refresh: %t\n", status["needs_refresh"])
+
 	fmt.Println("\n✓ APRS API test completed successfully!")
 }
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+)
+
+// Checks that APRSClient.PollStation reports a moving station once per position, dropping repeated
+// beacons from the same spot and riding out a rate limited key, and that a rejected key reads as ErrAuth.
+// Uses local test servers:
+//
+//	go run ./cmd/test_aprs_poll
+
+// beacons are what the test server answers for each poll in turn, the last one repeats
+var beacons = []string{
+	`{"result":"ok","found":1,"entries":[{"name":"W3TRK-9","time":"1700000000","lat":"40.0000","lng":"-75.0000"}]}`,
+	`{"result":"ok","found":1,"entries":[{"name":"W3TRK-9","time":"1700000000","lat":"40.0000","lng":"-75.0000"}]}`,
+	`{"result":"fail","description":"API rate limit exceeded"}`,
+	`{"result":"ok","found":2,"entries":[{"name":"W3TRK","time":"1700000060","lat":"41.0000","lng":"-76.0000"},{"name":"W3TRK-9","time":"1700000060","lat":"40.0100","lng":"-75.0100"}]}`,
+	`{"result":"ok","found":1,"entries":[{"name":"W3TRK-9","time":"1700000060","lat":"40.0100","lng":"-75.0100"}]}`,
+	`{"result":"ok","found":1,"entries":[{"name":"W3TRK-9","time":"1700000120","lat":"40.0200","lng":"-75.0200"}]}`,
+}
+
+func main() {
+	log.Println("Testing APRS position polling...")
+
+	var mu sync.Mutex
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		body := beacons[min(polls, len(beacons)-1)]
+		polls++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	// Two keys, so the rate limited one is rested and the next poll still gets through
+	client := api.NewAPRSClient([]string{"key1", "key2"}, config.CacheSettings{})
+	client.BaseURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 8*50*time.Millisecond)
+	defer cancel()
+
+	var updates []api.APRSStation
+	client.PollStation(ctx, "W3TRK-9", 50*time.Millisecond, func(station api.APRSStation) {
+		fmt.Printf("  update: %s at %.4f,%.4f\n", station.Name, station.GetLatitude(), station.GetLongitude())
+		updates = append(updates, station)
+	})
+
+	want := []float64{40.00, 40.01, 40.02}
+	if len(updates) != len(want) {
+		log.Fatalf("✗ Got %d updates from %d polls, want %d (one per position)", len(updates), polls, len(want))
+	}
+	for i, station := range updates {
+		if station.Name != "W3TRK-9" || station.GetLatitude() != want[i] {
+			log.Fatalf("✗ Update %d was %s at %.4f, want W3TRK-9 at %.4f", i+1, station.Name, station.GetLatitude(), want[i])
+		}
+	}
+	fmt.Printf("✓ %d polls gave %d updates, repeats and the rate limited poll were dropped\n", polls, len(updates))
+
+	// aprs.fi rejects a bad key with a 200 too, it must still read as an auth failure
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"result":"fail","description":"authentication failed"}`)
+	}))
+	defer rejecting.Close()
+	badKey := api.NewAPRSClient([]string{"bad"}, config.CacheSettings{})
+	badKey.BaseURL = rejecting.URL
+	if _, err := badKey.GetStation("W3TRK-9"); !errors.Is(err, api.ErrAuth) {
+		log.Fatalf("✗ A rejected key gave %v, want an auth error", err)
+	}
+	fmt.Println("✓ A rejected key is reported as an auth error")
+
+	fmt.Println("\n✓ APRS polling tests passed!")
+}
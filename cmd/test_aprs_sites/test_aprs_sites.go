@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Checks that APRS stations recorded at a repeater's site under its callsign are found for the repeater,
+// and that other SSIDs far away and other callsigns at the site are not, against an in-memory database:
+//
+//	go run ./cmd/test_aprs_sites
+
+func main() {
+	log.Println("Testing APRS site correlation...")
+
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	devices := []api.BrandmeisterRepeater{
+		{ID: 310001, Callsign: "W3ABC", City: "Media", Country: "United States", TxFreq: "443.3000", RxFreq: "448.3000",
+			Latitude: 39.9168, Longitude: -75.3877},
+		{ID: 310002, Callsign: "K3XYZ", City: "Media", Country: "United States", TxFreq: "442.1000", RxFreq: "447.1000",
+			Latitude: 39.9168, Longitude: -75.3877},
+	}
+	if err := db.SyncBrandmeisterData(devices); err != nil {
+		log.Fatalf("Brandmeister sync failed: %v", err)
+	}
+
+	stations := []api.APRSStation{
+		// The digipeater on the tower, a few hundred meters from the listed coordinates
+		{Name: "W3ABC-10", Symbol: "/#", Time: api.FlexibleTime{Value: 1700000000},
+			Lat: api.FlexibleFloat{Value: 39.9190}, Lng: api.FlexibleFloat{Value: -75.3850}},
+		// The trustee's car, heard later but 30 km away
+		{Name: "W3ABC-9", Symbol: "/>", Time: api.FlexibleTime{Value: 1700000600},
+			Lat: api.FlexibleFloat{Value: 40.1500}, Lng: api.FlexibleFloat{Value: -75.1500}},
+		// A longer callsign at the same site
+		{Name: "W3ABCD-10", Symbol: "/#", Time: api.FlexibleTime{Value: 1700000900},
+			Lat: api.FlexibleFloat{Value: 39.9168}, Lng: api.FlexibleFloat{Value: -75.3877}},
+	}
+	for _, station := range stations {
+		if err := db.RecordAPRSPosition(station); err != nil {
+			log.Fatalf("Failed to record %s: %v", station.Name, err)
+		}
+	}
+
+	failed := 0
+	check := func(what string, ok bool) {
+		if !ok {
+			fmt.Printf("✗ %s\n", what)
+			failed++
+			return
+		}
+		fmt.Printf("✓ %s\n", what)
+	}
+
+	site := func(callsign string) (database.APRSSite, bool) {
+		records, err := db.GetRepeatersByCallsign(callsign, true)
+		if err != nil || len(records) != 1 {
+			log.Fatalf("Failed to look up %s: %v (%d records)", callsign, err, len(records))
+		}
+		site, found, err := db.GetAPRSSite(records[0])
+		if err != nil {
+			log.Fatalf("Failed to look up the APRS site of %s: %v", callsign, err)
+		}
+		return site, found
+	}
+
+	digi, found := site("W3ABC")
+	check(fmt.Sprintf("W3ABC: %s", digi), found && digi.Callsign == "W3ABC-10" && digi.Role == "digipeater" &&
+		digi.DistanceKm >= 0 && digi.DistanceKm < database.APRSSiteRadiusKm)
+	_, found = site("K3XYZ")
+	check("K3XYZ has no APRS station at its site", !found)
+
+	// Without repeater coordinates the callsign alone decides
+	igate := api.APRSStation{Name: "W3ABC-1", Symbol: "I&", Lat: api.FlexibleFloat{Value: 45}, Lng: api.FlexibleFloat{Value: -90}}
+	match, ok := database.MatchAPRSStation(database.RepeaterRecord{Callsign: "W3ABC/R"}, igate)
+	check(fmt.Sprintf("W3ABC/R without coordinates: %s", match), ok && match.Role == "I-gate" && match.DistanceKm == -1)
+
+	if failed > 0 {
+		log.Fatalf("✗ %d checks failed", failed)
+	}
+	fmt.Println("✓ APRS site tests passed!")
+}
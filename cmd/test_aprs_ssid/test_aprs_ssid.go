@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+)
+
+// Checks SSID parsing and collapsing radius results to the latest station heard per base callsign:
+//
+//	go run ./cmd/test_aprs_ssid
+
+func main() {
+	log.Println("Testing APRS SSID grouping...")
+
+	failed := 0
+	check := func(what, got, want string) {
+		if got != want {
+			fmt.Printf("✗ %s: got %q, want %q\n", what, got, want)
+			failed++
+			return
+		}
+		fmt.Printf("✓ %s: %q\n", what, got)
+	}
+
+	for name, want := range map[string]string{
+		"W3ABC-9":       "W3ABC|9",
+		"W3ABC-15":      "W3ABC|15",
+		"N3QQZ-WX":      "N3QQZ|WX",
+		"W3ABC":         "W3ABC|",
+		"VK9C/VK4AAA-7": "VK9C/VK4AAA|7",
+		"K3XYZ-123":     "K3XYZ-123|", // Too long for an SSID
+		"-9":            "-9|",
+	} {
+		base, ssid := api.SplitSSID(name)
+		check("SplitSSID "+name, base+"|"+ssid, want)
+	}
+
+	stations := []api.APRSStation{
+		{Name: "W3ABC-9", LastTime: api.FlexibleTime{Value: 1700000300}},
+		{Name: "K3XYZ", LastTime: api.FlexibleTime{Value: 1700000100}},
+		{Name: "W3ABC-7", LastTime: api.FlexibleTime{Value: 1700000900}},
+		{Name: "w3abc", LastTime: api.FlexibleTime{Value: 1700000500}},
+		{Name: "N3QQZ-10", LastTime: api.FlexibleTime{Value: 1700000200}},
+		{Name: "N3QQZ-WX", LastTime: api.FlexibleTime{Value: 1700000200}},
+	}
+	names := func(stations []api.APRSStation) string {
+		list := make([]string, len(stations))
+		for i, station := range stations {
+			list[i] = station.Name
+		}
+		return strings.Join(list, ",")
+	}
+
+	check("latest per callsign", names(api.LatestPerCallsign(stations, false)), "W3ABC-7,K3XYZ,N3QQZ-10")
+	check("keeping all SSIDs", names(api.LatestPerCallsign(stations, true)), names(stations))
+
+	if failed > 0 {
+		log.Fatalf("✗ %d checks failed", failed)
+	}
+	fmt.Println("✓ APRS SSID tests passed!")
+}
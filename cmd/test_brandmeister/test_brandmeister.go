@@ -23,7 +23,7 @@ func main() {
 	}
 
 	// Create Brandmeister client with API key from configuration
-	client := api.NewBrandmeisterClient(cfg.APIs.BrandmeisterKey)
+	client := api.NewBrandmeisterClient(cfg.APIs, cfg.Cache.Brandmeister)
 
 	// Test initialization (will check cache age and refresh if needed)
 	fmt.Println("Initializing Brandmeister client...")
@@ -74,7 +74,7 @@ func main() {
 
 	// Test repeater search
 	fmt.Println("\n\nTesting repeater search (searching for 'Pennsylvania')...")
-	searchResults, err := client.SearchRepeaters("Pennsylvania")
+	searchResults, err := client.SearchRepeaters("Pennsylvania", false)
 	if err != nil {
 		log.Printf("Search failed: %v", err)
 	} else {
@@ -92,6 +92,17 @@ func main() {
 		}
 	}
 
+	// Test the online filter
+	online, err := client.GetOnlineRepeaters()
+	if err != nil {
+		log.Printf("Online repeater lookup failed: %v", err)
+	} else {
+		fmt.Printf("\n%d of %d repeaters are online\n", len(online), len(repeaters))
+	}
+	if onlineResults, err := client.SearchRepeaters("Pennsylvania", true); err == nil {
+		fmt.Printf("%d online repeaters match 'Pennsylvania'\n", onlineResults.Count)
+	}
+
 	// Test specific repeater lookup
 	fmt.Println("\nTesting specific repeater lookup...")
 
@@ -150,5 +161,18 @@ func main() {
 		fmt.Printf("Updated cache age: %v\n", newStatus["age"])
 	}
 
+	// Test the live activity feed
+	fmt.Println("\nTesting last heard activity feed...")
+	activity, err := client.GetLastHeard(10)
+	if err != nil {
+		log.Printf("Last heard failed: %v", err)
+	} else {
+		fmt.Printf("✓ Got %d recent transmissions\n", len(activity))
+		for _, a := range activity {
+			fmt.Printf("  %s  %-8s TG %-7d via %s\n",
+				a.StartTime().Format("15:04:05"), a.Callsign, a.TalkgroupID, a.RepeaterCallsign)
+		}
+	}
+
 	fmt.Println("\n✓ Brandmeister.network API test completed successfully!")
 }
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Checks that reads, a user data write and a second sync from other connections succeed while a slow sync
+// runs, the way the GUI runs alongside cmd/syncd. Each Database keeps a single connection, so each gets its
+// own. The sync is rate limited to hold the write lock for a few seconds, the writes wait for it to commit
+// and must get in within SQLite's 5s busy_timeout:
+//
+//	go run ./cmd/test_busy
+
+const (
+	deviceCount = 3000
+	syncRate    = 1000 // Records per second, so the sync holds the lock for about 3s
+	busyTimeout = 5 * time.Second
+)
+
+func main() {
+	log.Println("Testing reads and writes during a sync from another connection...")
+
+	tmp, err := os.MkdirTemp("", "digilogrt-busy")
+	if err != nil {
+		log.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+	path := filepath.Join(tmp, "busy.db")
+
+	devices := make([]api.BrandmeisterRepeater, deviceCount)
+	for i := range devices {
+		devices[i] = api.BrandmeisterRepeater{
+			ID: 310000 + i, Callsign: fmt.Sprintf("W%dBSY", i), City: "Media", Country: "United States",
+			TxFreq: "443.3000", RxFreq: "448.3000", Latitude: 39.9, Longitude: -75.4, PEP: 50,
+		}
+	}
+
+	syncer, err := database.NewDatabase(path)
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer syncer.Close()
+	if err := syncer.SyncBrandmeisterData(devices); err != nil {
+		log.Fatalf("Initial sync failed: %v", err)
+	}
+
+	gui := open(path)
+	defer gui.Close()
+	notes := open(path)
+	defer notes.Close()
+	other := open(path)
+	defer other.Close()
+	found, err := gui.SearchRepeaters("W1BSY", 1, false, database.DeviceFilter{})
+	if err != nil || len(found) == 0 {
+		log.Fatalf("Failed to find a synced repeater: %v", err)
+	}
+
+	// The slow re-sync
+	syncer.SetSyncOptions(database.SyncOptions{RateLimit: syncRate})
+	for i := range devices {
+		devices[i].PEP = 25
+	}
+	syncDone := make(chan error, 1)
+	syncStart := time.Now()
+	go func() { syncDone <- syncer.SyncBrandmeisterData(devices) }()
+	time.Sleep(500 * time.Millisecond) // Let it take the lock
+
+	var reads, readErrors atomic.Int64
+	readsDone := make(chan struct{})
+	go func() {
+		defer close(readsDone)
+		for time.Since(syncStart) < 4*time.Second {
+			if _, err := gui.SearchRepeaters("BSY", 20, false, database.DeviceFilter{}); err != nil {
+				readErrors.Add(1)
+				log.Printf("Read failed: %v", err)
+			}
+			reads.Add(1)
+			time.Sleep(50 * time.Millisecond)
+		}
+	}()
+
+	writes := make(chan string, 2)
+	go func() {
+		start := time.Now()
+		if err := notes.SetRepeaterNotes(found[0].ID, "Heard during a sync"); err != nil {
+			writes <- fmt.Sprintf("✗ Saving a note during the sync failed after %v: %v", time.Since(start).Round(time.Millisecond), err)
+			return
+		}
+		if waited := time.Since(start); waited >= busyTimeout {
+			writes <- fmt.Sprintf("✗ Saving a note waited %v, past busy_timeout", waited.Round(time.Millisecond))
+			return
+		}
+		writes <- fmt.Sprintf("✓ Note saved after waiting %v for the sync", time.Since(start).Round(time.Millisecond))
+	}()
+	go func() {
+		start := time.Now()
+		if err := other.SyncTGIFData([]api.TGIFTalkgroup{{ID: "31999", Name: "Busy Net"}}); err != nil {
+			writes <- fmt.Sprintf("✗ A second sync during the first failed: %v", err)
+			return
+		}
+		writes <- fmt.Sprintf("✓ TGIF sync ran after waiting %v", time.Since(start).Round(time.Millisecond))
+	}()
+
+	failed := 0
+	for i := 0; i < 2; i++ {
+		result := <-writes
+		if strings.HasPrefix(result, "✗") {
+			failed++
+		}
+		fmt.Println(result)
+	}
+
+	if err := <-syncDone; err != nil {
+		fmt.Printf("✗ The slow sync failed: %v\n", err)
+		failed++
+	} else {
+		fmt.Printf("✓ Slow sync finished in %v\n", time.Since(syncStart).Round(time.Millisecond))
+	}
+	<-readsDone
+	if readErrors.Load() > 0 {
+		fmt.Printf("✗ %d of %d reads during the sync failed\n", readErrors.Load(), reads.Load())
+		failed++
+	} else {
+		fmt.Printf("✓ %d reads during the sync, none failed\n", reads.Load())
+	}
+
+	if data, err := gui.GetRepeaterUserData(found[0].ID); err != nil || data.Notes != "Heard during a sync" {
+		fmt.Printf("✗ Note not stored: %+v, %v\n", data, err)
+		failed++
+	}
+
+	if failed > 0 {
+		log.Fatalf("✗ %d checks failed", failed)
+	}
+	fmt.Println("\n✓ Busy database tests passed!")
+}
+
+// open opens another connection to the database, as a second process would
+func open(path string) *database.Database {
+	db, err := database.NewDatabase(path)
+	if err != nil {
+		log.Fatalf("Failed to open another connection: %v", err)
+	}
+	return db
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
+)
+
+func main() {
+	log.Println("Testing cache file handling...")
+
+	dir, err := os.MkdirTemp("", "digilogrt-cache-test")
+	if err != nil {
+		log.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	repeaters := []api.HearhamRepeater{
+		{ID: 1, Callsign: "W3ABC", City: "Philadelphia", Frequency: 146940000},
+		{ID: 2, Callsign: "K3XYZ", City: "Pittsburgh", Frequency: 442000000},
+	}
+
+	// Round trip a good cache file
+	fmt.Println("Testing save and load...")
+	goodFile := filepath.Join(dir, "good.json")
+	if err := cache.SaveCache(goodFile, repeaters); err != nil {
+		log.Fatalf("SaveCache failed: %v", err)
+	}
+	loaded, err := cache.LoadCache[api.HearhamRepeater](goodFile)
+	if err != nil {
+		log.Fatalf("LoadCache failed on a good file: %v", err)
+	}
+	if len(loaded) != len(repeaters) || loaded[1].Callsign != "K3XYZ" {
+		log.Fatalf("Round trip mismatch: got %+v", loaded)
+	}
+	leftovers, _ := filepath.Glob(filepath.Join(dir, "*.tmp"))
+	if len(leftovers) > 0 {
+		log.Fatalf("SaveCache left temp files behind: %v", leftovers)
+	}
+	fmt.Printf("✓ Saved and loaded %d records\n", len(loaded))
+
+	// Simulate a save killed halfway through
+	fmt.Println("\nTesting a half-written cache file...")
+	data, err := os.ReadFile(goodFile)
+	if err != nil {
+		log.Fatalf("Failed to read cache file: %v", err)
+	}
+	badFile := filepath.Join(dir, "truncated.json")
+	if err := os.WriteFile(badFile, data[:len(data)/2], 0644); err != nil {
+		log.Fatalf("Failed to write truncated file: %v", err)
+	}
+
+	_, err = cache.LoadCache[api.HearhamRepeater](badFile)
+	if !errors.Is(err, cache.ErrCorrupt) {
+		log.Fatalf("Expected ErrCorrupt for a truncated file, got: %v", err)
+	}
+	if _, statErr := os.Stat(badFile); !os.IsNotExist(statErr) {
+		log.Fatalf("Corrupt cache file was not removed")
+	}
+	fmt.Printf("✓ Truncated file reported as corrupt and removed: %v\n", err)
+
+	// A missing file is a plain read error, not corruption
+	_, err = cache.LoadCache[api.HearhamRepeater](badFile)
+	if err == nil || errors.Is(err, cache.ErrCorrupt) {
+		log.Fatalf("Expected a not-found error for a missing file, got: %v", err)
+	}
+	fmt.Println("✓ Missing file reported as not found")
+
+	// The binary format round trips, and LoadCache tells it from JSON by its header
+	fmt.Println("\nTesting the binary format...")
+	if err := cache.SetFormat(cache.FormatGob); err != nil {
+		log.Fatalf("SetFormat failed: %v", err)
+	}
+	binaryFile := filepath.Join(dir, "binary.json")
+	if err := cache.SaveCache(binaryFile, repeaters); err != nil {
+		log.Fatalf("SaveCache failed in the binary format: %v", err)
+	}
+	if format, err := cache.FileFormat(binaryFile); err != nil || format != cache.FormatGob {
+		log.Fatalf("Expected a gob file, got %q: %v", format, err)
+	}
+	if format, err := cache.FileFormat(goodFile); err != nil || format != cache.FormatJSON {
+		log.Fatalf("Expected a JSON file, got %q: %v", format, err)
+	}
+	for name, load := range map[string]func(string) ([]api.HearhamRepeater, error){
+		"LoadCache":       cache.LoadCache[api.HearhamRepeater],
+		"LoadCacheBinary": cache.LoadCacheBinary[api.HearhamRepeater],
+	} {
+		loaded, err := load(binaryFile)
+		if err != nil || len(loaded) != len(repeaters) || loaded[1].Callsign != "K3XYZ" {
+			log.Fatalf("%s binary round trip mismatch: %+v (%v)", name, loaded, err)
+		}
+	}
+	if loaded, err := cache.LoadCache[api.HearhamRepeater](goodFile); err != nil || len(loaded) != len(repeaters) {
+		log.Fatalf("LoadCache failed on the JSON file after switching formats: %v", err)
+	}
+	if _, err := cache.LoadCacheBinary[api.HearhamRepeater](goodFile); err == nil || errors.Is(err, cache.ErrCorrupt) {
+		log.Fatalf("Expected LoadCacheBinary to refuse a JSON file, got: %v", err)
+	}
+	if _, err := os.Stat(goodFile); err != nil {
+		log.Fatalf("LoadCacheBinary removed a good JSON file: %v", err)
+	}
+	if err := cache.SetFormat("xml"); err == nil {
+		log.Fatalf("SetFormat accepted an unknown format")
+	}
+	fmt.Println("✓ Binary file saved, detected and loaded; JSON files still load")
+
+	data, err = os.ReadFile(binaryFile)
+	if err != nil {
+		log.Fatalf("Failed to read binary cache file: %v", err)
+	}
+	if err := os.WriteFile(badFile, data[:len(data)-10], 0644); err != nil {
+		log.Fatalf("Failed to write truncated file: %v", err)
+	}
+	if _, err = cache.LoadCache[api.HearhamRepeater](badFile); !errors.Is(err, cache.ErrCorrupt) {
+		log.Fatalf("Expected ErrCorrupt for a truncated binary file, got: %v", err)
+	}
+	fmt.Printf("✓ Truncated binary file reported as corrupt: %v\n", err)
+
+	// The sidecar's fetch time wins over an mtime a copy has reset
+	fmt.Println("\nTesting cache sidecars...")
+	metaFile := filepath.Join(dir, "sidecar.json")
+	fetched := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := cache.SaveCacheFrom(metaFile, "https://example.com/repeaters", fetched, repeaters); err != nil {
+		log.Fatalf("Failed to save cache with sidecar: %v", err)
+	}
+	meta, err := cache.LoadMeta(metaFile)
+	if err != nil {
+		log.Fatalf("Failed to load sidecar %s: %v", cache.MetaPath(metaFile), err)
+	}
+	info, err := os.Stat(metaFile)
+	if err != nil {
+		log.Fatalf("Failed to stat cache file: %v", err)
+	}
+	if !meta.FetchedAt.Equal(fetched) || meta.SourceURL != "https://example.com/repeaters" || meta.RecordCount != 2 ||
+		meta.Size != info.Size() || !strings.HasPrefix(meta.ContentHash, "sha256:") {
+		log.Fatalf("Unexpected sidecar: %+v", meta)
+	}
+	fmt.Printf("✓ Sidecar %s: %d records from %s, %s\n", filepath.Base(cache.MetaPath(metaFile)),
+		meta.RecordCount, meta.SourceURL, meta.ContentHash)
+
+	if got, err := cache.FetchedAt(metaFile); err != nil || !got.Equal(fetched) {
+		log.Fatalf("Expected the sidecar's fetch time %v, got %v (%v)", fetched, got, err)
+	}
+	fmt.Println("✓ Fetch time read from the sidecar, not the fresh mtime")
+
+	// A file rewritten without its sidecar, or saved before sidecars, goes by its mtime
+	stale := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.WriteFile(metaFile, []byte("[]"), 0644); err != nil {
+		log.Fatalf("Failed to rewrite cache file: %v", err)
+	}
+	if err := os.Chtimes(metaFile, stale, stale); err != nil {
+		log.Fatalf("Failed to set mtime: %v", err)
+	}
+	if got, err := cache.FetchedAt(metaFile); err != nil || !got.Equal(stale) {
+		log.Fatalf("Expected the mtime %v for a file its sidecar doesn't match, got %v (%v)", stale, got, err)
+	}
+	if err := os.Remove(cache.MetaPath(metaFile)); err != nil {
+		log.Fatalf("Failed to remove sidecar: %v", err)
+	}
+	if got, err := cache.FetchedAt(metaFile); err != nil || !got.Equal(stale) {
+		log.Fatalf("Expected the mtime %v without a sidecar, got %v (%v)", stale, got, err)
+	}
+	fmt.Println("✓ Mismatched and missing sidecars fall back to the mtime")
+
+	if err := cache.SaveCache(metaFile, repeaters); err != nil {
+		log.Fatalf("Failed to save cache: %v", err)
+	}
+	if err := cache.Remove(metaFile); err != nil {
+		log.Fatalf("Failed to remove cache: %v", err)
+	}
+	for _, path := range []string{metaFile, cache.MetaPath(metaFile)} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			log.Fatalf("Remove left %s behind", path)
+		}
+	}
+	fmt.Println("✓ Remove deletes the cache file and its sidecar")
+
+	fmt.Println("\n✓ Cache file test completed successfully!")
+}
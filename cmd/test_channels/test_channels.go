@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Checks that ChannelForFrequency names the FRS/GMRS, MURS, marine VHF and PMR446 channels and leaves
+// amateur and in-between frequencies alone:
+//
+//	go run ./cmd/test_channels
+
+func main() {
+	log.Println("Testing channel names...")
+
+	failed := 0
+	for _, c := range []struct {
+		mhz  float64
+		want string
+	}{
+		{156.800, "Marine Ch 16"},
+		{156.450, "Marine Ch 9"},
+		{156.025, "Marine Ch 60"},
+		{157.425, "Marine Ch 88"},
+		{157.450, ""},
+		{462.5625, "FRS/GMRS Ch 1"},
+		{467.7125, "FRS/GMRS Ch 14"},
+		{462.725, "FRS/GMRS Ch 22"},
+		{467.675, "GMRS Ch 20R input"},
+		{151.820, "MURS Ch 1"},
+		{151.940, "MURS Ch 3"},
+		{154.600, "MURS Ch 5"},
+		{446.00625, "PMR446 Ch 1"},
+		{446.19375, "PMR446 Ch 16"},
+		{446.000, ""},
+		{146.520, ""},
+		{462.5700, ""},
+	} {
+		got := database.ChannelForFrequency(c.mhz)
+		if got != c.want {
+			fmt.Printf("✗ ChannelForFrequency(%.5f) = %q, want %q\n", c.mhz, got, c.want)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ %.5f MHz: %q\n", c.mhz, got)
+	}
+
+	if failed > 0 {
+		log.Fatalf("✗ %d checks failed", failed)
+	}
+	fmt.Println("\n✓ Channel name tests passed!")
+}
@@ -42,12 +42,39 @@ func main() {
 	fmt.Printf("✓ Brandmeister source ID: %d\n", sourceID)
 
 	// Test search functionality
-	results, err := db.SearchRepeaters("Los Angeles", 10)
+	results, err := db.SearchRepeaters("Los Angeles", 10, false, database.DeviceFilter{})
 	if err != nil {
 		log.Fatalf("Failed to search repeaters: %v", err)
 	}
 	fmt.Printf("✓ Search test completed, found %d results\n", len(results))
 
+	// Frequency search must return each repeater once
+	fmt.Println("\nTesting frequency search...")
+	imported, err := db.ImportRepeaters([]database.UnifiedRepeater{
+		{Callsign: "W6AAA", TxFrequency: 146.520, RxFrequency: 146.520, Mode: "FM"},
+		{Callsign: "W6BBB", TxFrequency: 146.940, RxFrequency: 146.340, Mode: "FM"},
+		{Callsign: "W6CCC", TxFrequency: 147.300, RxFrequency: 147.900, Mode: "FM"},
+		{Callsign: "W6DDD", TxFrequency: 446.000, RxFrequency: 441.000, Mode: "FM"},
+	}, "test")
+	if err != nil {
+		log.Fatalf("Failed to import test repeaters: %v", err)
+	}
+	freqResults, err := db.GetRepeatersByFrequency(146.52, 1.0, 10, database.DeviceFilter{})
+	if err != nil {
+		log.Fatalf("Failed to search by frequency: %v", err)
+	}
+	seen := make(map[int]bool)
+	for _, r := range freqResults {
+		if seen[r.ID] {
+			log.Fatalf("Frequency search returned %s twice", r.Callsign)
+		}
+		seen[r.ID] = true
+	}
+	if len(freqResults) != 3 {
+		log.Fatalf("Expected 3 of %d repeaters within 1 MHz of 146.52, got %d", imported, len(freqResults))
+	}
+	fmt.Printf("✓ Frequency search found %d repeaters, no duplicates\n", len(freqResults))
+
 	// Get database statistics
 	stats, err := db.GetRepeaterStats()
 	if err != nil {
@@ -34,7 +34,7 @@ func main() {
 	// Sync Brandmeister data
 	if cfg.APIs.BrandmeisterKey != "" {
 		fmt.Println("\nSyncing Brandmeister data...")
-		client := api.NewBrandmeisterClient(cfg.APIs.BrandmeisterKey)
+		client := api.NewBrandmeisterClient(cfg.APIs, cfg.Cache.Brandmeister)
 		if err := client.Initialize(); err != nil {
 			log.Printf("Failed to initialize Brandmeister: %v", err)
 		} else {
@@ -51,7 +51,7 @@ func main() {
 
 	// Sync TGIF data
 	fmt.Println("\nSyncing TGIF data...")
-	tgifClient := api.NewTGIFClient()
+	tgifClient := api.NewTGIFClient(cfg.Cache.TGIF)
 	if err := tgifClient.Initialize(); err != nil {
 		log.Printf("Failed to initialize TGIF: %v", err)
 	} else {
@@ -67,7 +67,7 @@ func main() {
 
 	// Sync hearham data
 	fmt.Println("\nSyncing hearham data...")
-	hearhamClient := api.NewHearhamClient()
+	hearhamClient := api.NewHearhamClient(cfg.Cache.Hearham)
 	if err := hearhamClient.Initialize(); err != nil {
 		log.Printf("Failed to initialize hearham: %v", err)
 	} else {
@@ -106,7 +106,7 @@ func main() {
 
 	// Search test
 	fmt.Println("\nSearching for 'California' repeaters...")
-	results, err := db.SearchRepeaters("California", 5)
+	results, err := db.SearchRepeaters("California", 5, false, database.DeviceFilter{})
 	if err != nil {
 		log.Printf("Search failed: %v", err)
 	} else {
@@ -119,7 +119,7 @@ func main() {
 
 	// Frequency search test
 	fmt.Println("\nSearching for repeaters near 146.52 MHz (±1 MHz)...")
-	freqResults, err := db.GetRepeatersByFrequency(146.52, 1.0, 5)
+	freqResults, err := db.GetRepeatersByFrequency(146.52, 1.0, 5, database.DeviceFilter{})
 	if err != nil {
 		log.Printf("Frequency search failed: %v", err)
 	} else {
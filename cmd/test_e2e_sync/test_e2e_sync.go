@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// End-to-end check of the whole sync pipeline without the network: fetch from local test servers serving
+// the testdata fixtures, write the file caches, sync into an in-memory database and check the counts.
+// A second pass with the servers gone syncs again from the caches alone. Run it from the repo root:
+//
+//	go run ./cmd/test_e2e_sync
+
+// Records each fixture should end up as in the database
+const (
+	wantBrandmeister = 2
+	wantTGIF         = 6
+	wantHearham      = 2
+	// One Brandmeister fixture sits at 0,0, which the map leaves out
+	wantLocated = wantBrandmeister + wantHearham - 1
+)
+
+// mockServer serves one fixture on every path and counts the requests
+type mockServer struct {
+	*httptest.Server
+	requests atomic.Int32
+}
+
+func newMockServer(fixture string) (*mockServer, error) {
+	body, err := os.ReadFile(filepath.Join("testdata", fixture))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %v", err)
+	}
+
+	m := &mockServer{}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+	return m, nil
+}
+
+// clients are fresh API clients, pointed at the mock servers when there are any
+type clients struct {
+	brandmeister *api.BrandmeisterClient
+	tgif         *api.TGIFClient
+	hearham      *api.HearhamClient
+}
+
+func newClients(brandmeisterURL, tgifURL, hearhamURL string) clients {
+	c := clients{
+		brandmeister: api.NewBrandmeisterClient(config.APISettings{BrandmeisterKey: "test"}, config.CacheSettings{}),
+		tgif:         api.NewTGIFClient(config.CacheSettings{}),
+		hearham:      api.NewHearhamClient(config.CacheSettings{}),
+	}
+	c.brandmeister.SetBaseURL(brandmeisterURL)
+	c.tgif.BaseURL = tgifURL
+	c.hearham.BaseURL = hearhamURL
+	return c
+}
+
+func main() {
+	log.Println("Testing fetch → cache → database end to end against mock servers...")
+
+	// A temp dir of our own keeps the real caches untouched and starts every source cold
+	tmp, err := os.MkdirTemp("", "digilogrt-e2e")
+	if err != nil {
+		log.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+	os.Setenv("TMPDIR", tmp)
+
+	brandmeister, err := newMockServer("brandmeister_devices.json")
+	if err != nil {
+		log.Fatalf("Brandmeister server: %v", err)
+	}
+	tgif, err := newMockServer("tgif_talkgroups.json")
+	if err != nil {
+		log.Fatalf("TGIF server: %v", err)
+	}
+	hearham, err := newMockServer("hearham_repeaters.json")
+	if err != nil {
+		log.Fatalf("hearham server: %v", err)
+	}
+
+	fmt.Println("\n📡 Pass 1: syncing from the mock servers...")
+	c := newClients(brandmeister.URL, tgif.URL, hearham.URL)
+	if err := runPass(c); err != nil {
+		log.Fatalf("✗ Pass 1 failed: %v", err)
+	}
+	for name, server := range map[string]*mockServer{"brandmeister": brandmeister, "tgif": tgif, "hearham": hearham} {
+		if server.requests.Load() == 0 {
+			log.Fatalf("✗ Pass 1 failed: %s server was never called", name)
+		}
+		if _, exists := api.CacheFileAge(name); !exists {
+			log.Fatalf("✗ Pass 1 failed: no %s cache file was written", name)
+		}
+	}
+	fmt.Println("✓ Pass 1: fetched, cached and synced every source")
+
+	// Nothing answers any more, so the second pass can only succeed from the cache files
+	brandmeister.Close()
+	tgif.Close()
+	hearham.Close()
+
+	fmt.Println("\n💾 Pass 2: syncing from the cache files with the servers gone...")
+	c = newClients(brandmeister.URL, tgif.URL, hearham.URL)
+	if err := runPass(c); err != nil {
+		log.Fatalf("✗ Pass 2 failed: %v", err)
+	}
+	fmt.Println("✓ Pass 2: synced every source from its cache")
+
+	fmt.Println("\n✓ End-to-end sync test passed!")
+}
+
+// runPass fetches every source, syncs it into a new in-memory database and checks the counts
+func runPass(c clients) error {
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		return fmt.Errorf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	repeaters, err := c.brandmeister.GetAllRepeaters()
+	if err != nil {
+		return fmt.Errorf("brandmeister fetch: %v", err)
+	}
+	if err := db.SyncBrandmeisterData(repeaters); err != nil {
+		return fmt.Errorf("brandmeister sync: %v", err)
+	}
+
+	talkgroups, err := c.tgif.GetAllTalkgroups()
+	if err != nil {
+		return fmt.Errorf("tgif fetch: %v", err)
+	}
+	if err := db.SyncTGIFData(talkgroups); err != nil {
+		return fmt.Errorf("tgif sync: %v", err)
+	}
+
+	hearhamRepeaters, err := c.hearham.GetAllRepeaters()
+	if err != nil {
+		return fmt.Errorf("hearham fetch: %v", err)
+	}
+	if err := db.SyncHearhamData(hearhamRepeaters); err != nil {
+		return fmt.Errorf("hearham sync: %v", err)
+	}
+
+	stats, err := db.GetRepeaterStats()
+	if err != nil {
+		return fmt.Errorf("failed to get stats: %v", err)
+	}
+	bySource, _ := stats["by_source"].(map[string]int)
+	if bySource["brandmeister"] != wantBrandmeister || bySource["hearham"] != wantHearham {
+		return fmt.Errorf("expected %d brandmeister and %d hearham repeaters, got %v",
+			wantBrandmeister, wantHearham, bySource)
+	}
+	if total, _ := stats["total_repeaters"].(int); total != wantBrandmeister+wantHearham {
+		return fmt.Errorf("expected %d repeaters in total, got %d", wantBrandmeister+wantHearham, total)
+	}
+
+	tgifCount, err := countTalkgroups(db)
+	if err != nil {
+		return err
+	}
+	if tgifCount != wantTGIF {
+		return fmt.Errorf("expected %d TGIF talkgroups, got %d", wantTGIF, tgifCount)
+	}
+
+	located, err := db.GetRepeatersInBoundingBox(database.WorldBoundingBox, 0, database.DeviceFilter{})
+	if err != nil {
+		return fmt.Errorf("failed to search by location: %v", err)
+	}
+	if len(located) != wantLocated {
+		return fmt.Errorf("expected %d repeaters with coordinates, got %d", wantLocated, len(located))
+	}
+
+	fmt.Printf("  %d brandmeister, %d hearham repeaters and %d TGIF talkgroups\n",
+		bySource["brandmeister"], bySource["hearham"], tgifCount)
+	return nil
+}
+
+// countTalkgroups counts the talkgroups table through a snapshot, the database has no count of its own
+func countTalkgroups(db *database.Database) (int, error) {
+	var buf bytes.Buffer
+	if err := db.ExportSnapshot(&buf); err != nil {
+		return 0, fmt.Errorf("failed to export snapshot: %v", err)
+	}
+	var snapshot database.Snapshot
+	if err := json.Unmarshal(buf.Bytes(), &snapshot); err != nil {
+		return 0, fmt.Errorf("failed to read snapshot: %v", err)
+	}
+	for _, table := range snapshot.Tables {
+		if table.Name == "talkgroups" {
+			return len(table.Rows), nil
+		}
+	}
+	return 0, fmt.Errorf("snapshot has no talkgroups table")
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/database"
+	"github.com/unklstewy/digiLogRT/internal/kml"
+)
+
+// Checks the live KML feed cache: polls within the TTL are served from memory, polls that arrive together
+// after it expires share one build, and a failed build falls back to the last good document. Run it with
+// the race detector:
+//
+//	go run -race ./cmd/test_feed_cache
+
+const ttl = 100 * time.Millisecond
+
+var box = database.BoundingBox{MinLat: 39, MinLng: -78, MaxLat: 40, MaxLng: -77}
+
+func main() {
+	log.Println("Testing the live KML feed cache...")
+
+	checks := []struct {
+		name string
+		run  func() error
+	}{
+		{"polls within the TTL are served from memory", checkTTLHit},
+		{"concurrent polls after the TTL share one build", checkSharedBuild},
+		{"a failed build serves the last good document", checkStaleFallback},
+		{"a failed build with nothing cached returns the error", checkColdFailure},
+	}
+
+	failed := 0
+	for _, c := range checks {
+		if err := c.run(); err != nil {
+			fmt.Printf("✗ %s: %v\n", c.name, err)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ %s\n", c.name)
+	}
+
+	if failed > 0 {
+		log.Fatalf("%d of %d checks failed", failed, len(checks))
+	}
+	fmt.Printf("\n✓ All %d checks passed!\n", len(checks))
+}
+
+// countingBuild returns a build func that answers with doc, counting its calls
+func countingBuild(doc string, calls *atomic.Int32, delay time.Duration) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		calls.Add(1)
+		time.Sleep(delay)
+		return []byte(doc), nil
+	}
+}
+
+func checkTTLHit() error {
+	cache := kml.NewFeedCache(ttl)
+	var calls atomic.Int32
+	build := countingBuild("<kml>first</kml>", &calls, 0)
+
+	for i := 0; i < 3; i++ {
+		data, stale, err := cache.Get(box, build)
+		if err != nil {
+			return fmt.Errorf("poll %d failed: %v", i, err)
+		}
+		if stale || string(data) != "<kml>first</kml>" {
+			return fmt.Errorf("poll %d got %q (stale %v)", i, data, stale)
+		}
+	}
+	if n := calls.Load(); n != 1 {
+		return fmt.Errorf("built %d times, want 1", n)
+	}
+
+	// Once the TTL passes the next poll builds again
+	time.Sleep(2 * ttl)
+	if _, _, err := cache.Get(box, build); err != nil {
+		return fmt.Errorf("poll after the TTL failed: %v", err)
+	}
+	if n := calls.Load(); n != 2 {
+		return fmt.Errorf("built %d times after the TTL, want 2", n)
+	}
+	return nil
+}
+
+func checkSharedBuild() error {
+	cache := kml.NewFeedCache(ttl)
+	var calls atomic.Int32
+	if _, _, err := cache.Get(box, countingBuild("<kml>old</kml>", &calls, 0)); err != nil {
+		return fmt.Errorf("first poll failed: %v", err)
+	}
+	time.Sleep(2 * ttl)
+
+	// The slow build keeps the first one in flight until every poller has asked
+	const pollers = 10
+	build := countingBuild("<kml>new</kml>", &calls, 300*time.Millisecond)
+	results := make([][]byte, pollers)
+	errs := make([]error, pollers)
+	var wg sync.WaitGroup
+	for i := 0; i < pollers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _, errs[i] = cache.Get(box, build)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return fmt.Errorf("poller %d failed: %v", i, err)
+		}
+		if !bytes.Equal(results[i], []byte("<kml>new</kml>")) {
+			return fmt.Errorf("poller %d got %q", i, results[i])
+		}
+	}
+	if n := calls.Load(); n != 2 {
+		return fmt.Errorf("%d pollers made %d builds after the TTL, want 1", pollers, n-1)
+	}
+	return nil
+}
+
+func checkStaleFallback() error {
+	cache := kml.NewFeedCache(ttl)
+	var calls atomic.Int32
+	if _, _, err := cache.Get(box, countingBuild("<kml>good</kml>", &calls, 0)); err != nil {
+		return fmt.Errorf("first poll failed: %v", err)
+	}
+	time.Sleep(2 * ttl)
+
+	data, stale, err := cache.Get(box, func() ([]byte, error) {
+		return nil, errors.New("database is locked")
+	})
+	if err != nil {
+		return fmt.Errorf("got an error instead of the last good document: %v", err)
+	}
+	if !stale || string(data) != "<kml>good</kml>" {
+		return fmt.Errorf("got %q (stale %v), want the last good document marked stale", data, stale)
+	}
+	return nil
+}
+
+func checkColdFailure() error {
+	cache := kml.NewFeedCache(ttl)
+	other := database.BoundingBox{MinLat: 10, MinLng: 10, MaxLat: 11, MaxLng: 11}
+	_, _, err := cache.Get(other, func() ([]byte, error) {
+		return nil, errors.New("database is locked")
+	})
+	if err == nil {
+		return errors.New("got no error with nothing cached to fall back on")
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+)
+
+// fixtureCase runs one client's decode path against a fixture served by a local test server
+type fixtureCase struct {
+	name    string // Golden file is testdata/golden/<name>.json
+	fixture string // Response body, from testdata/
+	path    string // Request path the fixture is served on
+	decode  func(baseURL string) (interface{}, error)
+	check   func(v interface{}) error // Spot checks of the quirk the fixture exercises
+}
+
+var cases = []fixtureCase{
+	{
+		name: "brandmeister_devices", fixture: "brandmeister_devices.json", path: "/v2/device",
+		decode: func(baseURL string) (interface{}, error) {
+			client := api.NewBrandmeisterClient(config.APISettings{}, config.CacheSettings{})
+			client.SetBaseURL(baseURL)
+			return client.GetAllRepeaters()
+		},
+		check: func(v interface{}) error {
+			repeaters := v.([]api.BrandmeisterRepeater)
+			if len(repeaters) != 2 || repeaters[0].Callsign != "W3PVI" || repeaters[0].TxFreq != "145.2700" {
+				return fmt.Errorf("bare array decoded wrong: %+v", repeaters)
+			}
+			if !repeaters[0].IsOnline() || repeaters[1].IsOnline() {
+				return fmt.Errorf("status codes read wrong")
+			}
+			return nil
+		},
+	},
+	{
+		name: "brandmeister_devices_wrapped", fixture: "brandmeister_devices_wrapped.json", path: "/v2/device",
+		decode: func(baseURL string) (interface{}, error) {
+			client := api.NewBrandmeisterClient(config.APISettings{}, config.CacheSettings{})
+			client.SetBaseURL(baseURL)
+			return client.GetAllRepeaters()
+		},
+		check: func(v interface{}) error {
+			repeaters := v.([]api.BrandmeisterRepeater)
+			if len(repeaters) != 1 || repeaters[0].Callsign != "K3ABC" {
+				return fmt.Errorf("wrapped object decoded wrong: %+v", repeaters)
+			}
+			return nil
+		},
+	},
+	{
+		// Served on a path the client doesn't probe, so only the config's base URL and endpoint can find it
+		name: "brandmeister_devices_override", fixture: "brandmeister_devices.json", path: "/v3/devices",
+		decode: func(baseURL string) (interface{}, error) {
+			apis := config.APISettings{BrandmeisterBaseURL: baseURL + "/", BrandmeisterEndpoint: "/v3/devices"}
+			return api.NewBrandmeisterClient(apis, config.CacheSettings{}).GetAllRepeaters()
+		},
+		check: func(v interface{}) error {
+			repeaters := v.([]api.BrandmeisterRepeater)
+			if len(repeaters) != 2 || repeaters[0].Callsign != "W3PVI" {
+				return fmt.Errorf("overridden endpoint decoded wrong: %+v", repeaters)
+			}
+			return nil
+		},
+	},
+	{
+		name: "tgif_talkgroups", fixture: "tgif_talkgroups.json", path: "/tgif",
+		decode: func(baseURL string) (interface{}, error) {
+			client := api.NewTGIFClient(config.CacheSettings{})
+			client.BaseURL = baseURL + "/tgif"
+			return client.GetAllTalkgroups()
+		},
+		check: func(v interface{}) error {
+			talkgroups := v.([]api.TGIFTalkgroup)
+			if len(talkgroups) != 6 {
+				return fmt.Errorf("expected 6 talkgroups, got %d", len(talkgroups))
+			}
+			if id, err := talkgroups[0].GetIDInt(); err != nil || id != 101 {
+				return fmt.Errorf("string ID read as %d (%v)", id, err)
+			}
+			if description, _ := talkgroups[0].GetDecodedDescription(); description != "For everyone" {
+				return fmt.Errorf("base64 description decoded as %q", description)
+			}
+			if slot := talkgroups[0].GetSlotInfo(); slot != "Unknown" {
+				return fmt.Errorf("missing slot read as %q", slot)
+			}
+			return nil
+		},
+	},
+	{
+		name: "tgif_talkgroups_wrapped", fixture: "tgif_talkgroups_wrapped.json", path: "/tgif",
+		decode: func(baseURL string) (interface{}, error) {
+			client := api.NewTGIFClient(config.CacheSettings{})
+			client.BaseURL = baseURL + "/tgif"
+			return client.GetAllTalkgroups()
+		},
+		check: func(v interface{}) error {
+			talkgroups := v.([]api.TGIFTalkgroup)
+			if len(talkgroups) != 3 {
+				return fmt.Errorf("expected 3 talkgroups, got %d", len(talkgroups))
+			}
+			if slot := talkgroups[0].GetSlotInfo(); slot != "Slot 2" {
+				return fmt.Errorf("string slot read as %q", slot)
+			}
+			if active, known := talkgroups[0].IsActive(); !active || !known {
+				return fmt.Errorf("string active flag read as %v/%v", active, known)
+			}
+			if active, known := talkgroups[2].IsActive(); active || !known {
+				return fmt.Errorf("numeric active flag read as %v/%v", active, known)
+			}
+			if description, _ := talkgroups[1].GetDecodedDescription(); description != "Not base64 at all!" {
+				return fmt.Errorf("plain description changed to %q", description)
+			}
+			return nil
+		},
+	},
+	{
+		name: "aprs_station", fixture: "aprs_station.json", path: "/get",
+		decode: func(baseURL string) (interface{}, error) {
+			client := api.NewAPRSClient([]string{"test"}, config.CacheSettings{})
+			client.BaseURL = baseURL
+			return client.GetStation("OH7RDA")
+		},
+		check: func(v interface{}) error {
+			station := v.(*api.APRSResponse).Entries[0]
+			if station.Time.Value != 1267445689 || station.LastTime.Value != 1270580127 {
+				return fmt.Errorf("string times read as %d/%d", station.Time.Value, station.LastTime.Value)
+			}
+			if station.GetLatitude() != 63.06717 || station.GetLongitude() != 27.6605 {
+				return fmt.Errorf("string coordinates read as %v,%v", station.GetLatitude(), station.GetLongitude())
+			}
+			return nil
+		},
+	},
+	{
+		name: "aprs_radius", fixture: "aprs_radius.json", path: "/get",
+		decode: func(baseURL string) (interface{}, error) {
+			client := api.NewAPRSClient([]string{"test"}, config.CacheSettings{})
+			client.BaseURL = baseURL
+			return client.GetStationsInRadius(39.95, -75.16, 25)
+		},
+		check: func(v interface{}) error {
+			entries := v.(*api.APRSResponse).Entries
+			if len(entries) != 3 {
+				return fmt.Errorf("expected 3 stations, got %d", len(entries))
+			}
+			if entries[0].Speed.Value != 48 || entries[0].Course.Value != 270 {
+				return fmt.Errorf("mixed number fields read as speed %d course %d", entries[0].Speed.Value, entries[0].Course.Value)
+			}
+			if entries[1].Time.Value != 0 || entries[1].LastTime.Value != 0 || entries[1].Course.Value != 0 || !entries[1].HasPosition() {
+				return fmt.Errorf("empty and bad values not zeroed: %+v", entries[1])
+			}
+			if entries[2].Altitude.Value != 0 || entries[2].GetLatitude() != 40.0123 {
+				return fmt.Errorf("bad altitude or string latitude read wrong: %+v", entries[2])
+			}
+			return nil
+		},
+	},
+	{
+		name: "hearham_repeaters", fixture: "hearham_repeaters.json", path: "/hearham",
+		decode: func(baseURL string) (interface{}, error) {
+			client := api.NewHearhamClient(config.CacheSettings{})
+			client.BaseURL = baseURL + "/hearham"
+			return client.GetAllRepeaters()
+		},
+		check: func(v interface{}) error {
+			repeaters := v.([]api.HearhamRepeater)
+			if len(repeaters) != 2 || repeaters[0].GetFrequencyMHz() != 147.03 || repeaters[1].GetOffsetMHz() != -7 {
+				return fmt.Errorf("Hz frequencies read wrong: %+v", repeaters)
+			}
+			if !repeaters[0].IsOperational() || repeaters[1].IsOperational() {
+				return fmt.Errorf("operational flags read wrong")
+			}
+			return nil
+		},
+	},
+	{
+		name: "repeaterbook_export", fixture: "repeaterbook_export.json", path: "/export.php",
+		decode: func(baseURL string) (interface{}, error) {
+			client := api.NewRepeaterBookClient("", config.CacheSettings{})
+			client.BaseURL = baseURL
+			return client.SearchByState("Pennsylvania")
+		},
+		check: func(v interface{}) error {
+			results := v.(*api.RepeaterBookResponse).Results
+			if len(results) != 2 {
+				return fmt.Errorf("expected 2 repeaters, got %d", len(results))
+			}
+			if tone, err := results[0].GetToneFrequency(); err != nil || tone != 131.8 {
+				return fmt.Errorf("tone read as %v (%v)", tone, err)
+			}
+			if _, err := results[1].GetToneFrequency(); err == nil {
+				return fmt.Errorf("CSQ read as a tone")
+			}
+			if modes := results[1].GetDigitalModes(); len(modes) != 2 || results[1].IsOperational() {
+				return fmt.Errorf("digital modes or status read wrong: %v", modes)
+			}
+			return nil
+		},
+	},
+}
+
+// Checks each client's parsed result for the API responses in testdata/, served from a local test server,
+// against testdata/golden/. After an intentional change to the parsed structs, review the diff -update
+// writes before committing it:
+//
+//	go run ./cmd/test_fixtures
+func main() {
+	update := flag.Bool("update", false, "Rewrite the golden files from the current output")
+	flag.Parse()
+
+	log.Println("Testing API response decoding against recorded fixtures...")
+
+	tmp, err := os.MkdirTemp("", "digilogrt-fixtures")
+	if err != nil {
+		log.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	failed := 0
+	for i, c := range cases {
+		fmt.Printf("\nTesting %s...\n", c.name)
+
+		// The clients keep their file caches under the temp dir, a fresh one per case keeps the
+		// real caches untouched and makes every case go to the test server
+		caseTmp := filepath.Join(tmp, fmt.Sprint(i))
+		if err := os.Mkdir(caseTmp, 0755); err != nil {
+			log.Fatalf("Failed to create temp dir: %v", err)
+		}
+		os.Setenv("TMPDIR", caseTmp)
+
+		if err := runCase(c, *update); err != nil {
+			fmt.Printf("✗ %s: %v\n", c.name, err)
+			failed++
+			continue
+		}
+		if *update {
+			fmt.Printf("✓ %s golden file updated\n", c.name)
+		} else {
+			fmt.Printf("✓ %s matches its golden file\n", c.name)
+		}
+	}
+
+	if failed > 0 {
+		log.Fatalf("%d of %d fixture tests failed", failed, len(cases))
+	}
+	fmt.Printf("\n✓ All %d fixture tests passed!\n", len(cases))
+}
+
+func runCase(c fixtureCase, update bool) error {
+	body, err := os.ReadFile(filepath.Join("testdata", c.fixture))
+	if err != nil {
+		return fmt.Errorf("failed to read fixture: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != c.path {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	v, err := c.decode(server.URL)
+	if err != nil {
+		return fmt.Errorf("decode failed: %v", err)
+	}
+	if err := c.check(v); err != nil {
+		return err
+	}
+
+	got, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode result: %v", err)
+	}
+	got = append(got, '\n')
+
+	goldenFile := filepath.Join("testdata", "golden", c.name+".json")
+	if update {
+		return os.WriteFile(goldenFile, got, 0644)
+	}
+
+	want, err := os.ReadFile(goldenFile)
+	if err != nil {
+		return fmt.Errorf("failed to read golden file (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		return fmt.Errorf("parsed result differs from %s:\n%s", goldenFile, got)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/unklstewy/digiLogRT/internal/geocode"
+)
+
+// Checks geocode.Lookup on cities either side of state, province and national borders, where simplified
+// outlines go wrong first, and on coastal and island towns. Points sit a little back from border rivers,
+// where Natural Earth's 1:10m lines can be a kilometer or so off. Needs no network or database:
+//
+//	go run ./cmd/test_geocode_borders
+
+func main() {
+	log.Println("Testing boundary lookups near borders...")
+
+	failed := 0
+	for _, c := range []struct {
+		name           string
+		lat, lng       float64
+		state, country string
+	}{
+		{"Ciudad Juárez", 31.69, -106.42, "", "Mexico"},
+		{"El Paso", 31.76, -106.49, "Texas", "United States"},
+		{"Tijuana", 32.51, -117.04, "", "Mexico"},
+		{"San Diego", 32.72, -117.16, "California", "United States"},
+		{"Niagara Falls, Ontario", 43.0896, -79.0849, "Ontario", "Canada"},
+		{"Niagara Falls, New York", 43.0962, -79.0377, "New York", "United States"},
+		{"Windsor", 42.3149, -83.0364, "Ontario", "Canada"},
+		{"Detroit", 42.3314, -83.0458, "Michigan", "United States"},
+		{"Camden", 39.9375, -75.1056, "New Jersey", "United States"},
+		{"Philadelphia", 39.9526, -75.1652, "Pennsylvania", "United States"},
+		{"Jersey City", 40.7178, -74.0431, "New Jersey", "United States"},
+		{"Manhattan", 40.7831, -73.9712, "New York", "United States"},
+		{"Gatineau", 45.4765, -75.7013, "Quebec", "Canada"},
+		{"Ottawa", 45.4215, -75.6972, "Ontario", "Canada"},
+		{"Duluth", 46.7867, -92.1005, "Minnesota", "United States"},
+		{"Superior", 46.7208, -92.1041, "Wisconsin", "United States"},
+		{"Covington", 39.0837, -84.5086, "Kentucky", "United States"},
+		{"Cincinnati", 39.1290, -84.5100, "Ohio", "United States"},
+		{"East St. Louis", 38.6245, -90.1509, "Illinois", "United States"},
+		{"St. Louis", 38.6300, -90.2300, "Missouri", "United States"},
+		{"Jeffersonville", 38.2776, -85.7372, "Indiana", "United States"},
+		{"Louisville", 38.2527, -85.7585, "Kentucky", "United States"},
+		{"Arlington", 38.8816, -77.0910, "Virginia", "United States"},
+		{"Washington", 38.8951, -77.0364, "District of Columbia", "United States"},
+		{"Moorhead", 46.8738, -96.7678, "Minnesota", "United States"},
+		{"Fargo", 46.8772, -96.7898, "North Dakota", "United States"},
+		{"Erie", 42.1292, -80.0851, "Pennsylvania", "United States"},
+		{"Hempstead", 40.7062, -73.6187, "New York", "United States"},
+		{"Riverhead", 40.9170, -72.6620, "New York", "United States"},
+		{"Montauk", 41.0359, -71.9545, "New York", "United States"},
+		{"Key West", 24.5551, -81.7800, "Florida", "United States"},
+		{"Honolulu", 21.3069, -157.8583, "Hawaii", "United States"},
+		{"Strasbourg", 48.5734, 7.7521, "", "France"},
+		{"Kehl", 48.5722, 7.8156, "", "Germany"},
+		{"Basel", 47.5596, 7.5886, "", "Switzerland"},
+		{"Maseru", -29.3151, 27.4869, "", ""}, // Lesotho, a hole in South Africa
+		{"Mid-Atlantic", 38.0, -50.0, "", ""},
+	} {
+		state, country, _ := geocode.Lookup(c.lat, c.lng)
+		if state != c.state || country != c.country {
+			fmt.Printf("✗ %s (%.4f, %.4f): got %q, %q, want %q, %q\n", c.name, c.lat, c.lng, state, country, c.state, c.country)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ %s: %s\n", c.name, describe(state, country))
+	}
+
+	if failed > 0 {
+		fmt.Printf("\n✗ %d lookups failed\n", failed)
+		os.Exit(1)
+	}
+	fmt.Println("\n✓ Border lookup tests passed!")
+}
+
+// describe names a lookup result for the log
+func describe(state, country string) string {
+	switch {
+	case country == "":
+		return "not found"
+	case state == "":
+		return country
+	}
+	return state + ", " + country
+}
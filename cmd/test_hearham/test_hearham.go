@@ -5,13 +5,20 @@ import (
 	"log"
 
 	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
 )
 
 func main() {
 	log.Println("Testing hearham.com API with intelligent caching...")
 
+	// Load configuration for cache settings (defaults are fine for testing)
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		cfg = config.GetDefaultConfig()
+	}
+
 	// Create hearham client
-	client := api.NewHearhamClient()
+	client := api.NewHearhamClient(cfg.Cache.Hearham)
 
 	// Test initialization (will check cache age and refresh if needed)
 	fmt.Println("Initializing hearham client...")
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Checks that repeaters at the same coordinates share one location row, that repeaters elsewhere in the same
+// city get their own, that listings with no place at all get none, and that a sync only drops the rows its
+// listings moved off, against an in-memory database:
+//
+//	go run ./cmd/test_locations
+
+func main() {
+	log.Println("Testing location rows...")
+
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	devices := []api.BrandmeisterRepeater{
+		{ID: 310001, Callsign: "W3AAA", City: "Media", Country: "United States", TxFreq: "443.3000", RxFreq: "448.3000",
+			Latitude: 39.9168, Longitude: -75.3877},
+		{ID: 310002, Callsign: "W3AAB", City: "Media", Country: "United States", TxFreq: "147.0600", RxFreq: "147.6600",
+			Latitude: 39.9168, Longitude: -75.3877},
+		{ID: 310003, Callsign: "W3AAC", City: "Media", Country: "United States", TxFreq: "442.1000", RxFreq: "447.1000",
+			Latitude: 39.9012, Longitude: -75.4101},
+		{ID: 310004, Callsign: "W3AAD", TxFreq: "440.0000", RxFreq: "445.0000"},
+	}
+	if err := db.SyncBrandmeisterData(devices); err != nil {
+		log.Fatalf("Brandmeister sync failed: %v", err)
+	}
+
+	locations := make(map[string]*int)
+	for _, device := range devices {
+		records, err := db.GetRepeatersByCallsign(device.Callsign, true)
+		if err != nil || len(records) != 1 {
+			log.Fatalf("Failed to look up %s: %v (%d records)", device.Callsign, err, len(records))
+		}
+		locations[device.Callsign] = records[0].LocationID
+	}
+
+	failed := 0
+	check := func(what string, ok bool) {
+		if !ok {
+			fmt.Printf("✗ %s\n", what)
+			failed++
+			return
+		}
+		fmt.Printf("✓ %s\n", what)
+	}
+
+	same, other, blank := locations["W3AAA"], locations["W3AAC"], locations["W3AAD"]
+	check("repeaters at the same coordinates share one location row",
+		same != nil && locations["W3AAB"] != nil && *same == *locations["W3AAB"])
+	check("a repeater elsewhere in the same city has its own location row", other != nil && same != nil && *other != *same)
+	check("a listing with no place has no location row", blank == nil)
+
+	// UpsertLocation finds the row the sync made rather than adding another
+	id, err := db.UpsertLocation("Media", "Pennsylvania", "United States", 39.9168, -75.3877)
+	if err != nil {
+		log.Fatalf("Failed to upsert location: %v", err)
+	}
+	check(fmt.Sprintf("UpsertLocation reuses location %d", id), same != nil && id == *same)
+
+	// A sync only cleans up the rows its own listings moved off, not one UpsertLocation just made for
+	// a repeater its caller hasn't stored yet
+	fresh, err := db.UpsertLocation("Swarthmore", "Pennsylvania", "United States", 39.9021, -75.3499)
+	if err != nil {
+		log.Fatalf("Failed to upsert location: %v", err)
+	}
+	devices[2].Latitude, devices[2].Longitude = 39.9100, -75.4000
+	if err := db.SyncBrandmeisterData(devices); err != nil {
+		log.Fatalf("Brandmeister re-sync failed: %v", err)
+	}
+	again, err := db.UpsertLocation("Swarthmore", "Pennsylvania", "United States", 39.9021, -75.3499)
+	if err != nil {
+		log.Fatalf("Failed to upsert location: %v", err)
+	}
+	check("a sync keeps an unused location it didn't detach", again == fresh)
+
+	// Location IDs aren't reused, so moving back only gets the old ID if the row outlived the move
+	devices[2].Latitude, devices[2].Longitude = 39.9012, -75.4101
+	if err := db.SyncBrandmeisterData(devices); err != nil {
+		log.Fatalf("Brandmeister re-sync failed: %v", err)
+	}
+	records, err := db.GetRepeatersByCallsign("W3AAC", true)
+	if err != nil || len(records) != 1 {
+		log.Fatalf("Failed to look up W3AAC: %v (%d records)", err, len(records))
+	}
+	check("a sync drops the location a repeater moved off",
+		records[0].LocationID != nil && other != nil && *records[0].LocationID != *other)
+
+	if failed > 0 {
+		log.Fatalf("✗ %d checks failed", failed)
+	}
+	fmt.Println("✓ Location tests passed!")
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Checks the minimum power filter searches and refining apply: the watts cutoff, repeaters with no power
+// listed and how it stacks with the hotspot filter. Uses an in-memory database, run it from the repo root:
+//
+//	go run ./cmd/test_min_power
+
+const testCity = "Wattsburg"
+
+func main() {
+	log.Println("Testing the minimum power filter...")
+
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	repeaters := []api.BrandmeisterRepeater{
+		{ID: 310001, Callsign: "W1BIG", TxFreq: "444.1000", RxFreq: "449.1000", PEP: 50, AGL: 30},
+		{ID: 310002, Callsign: "W1EDGE", TxFreq: "444.2000", RxFreq: "449.2000", PEP: 2, AGL: 10},
+		{ID: 310003, Callsign: "W1LOW", TxFreq: "444.3000", RxFreq: "449.3000", PEP: 1, AGL: 10},
+		{ID: 310004, Callsign: "W1NONE", TxFreq: "444.4000", RxFreq: "449.4000", AGL: 10},
+		{ID: 310005, Callsign: "W1HS", TxFreq: "438.8000", RxFreq: "438.8000", PEP: 5},
+	}
+	for i := range repeaters {
+		repeaters[i].City, repeaters[i].State, repeaters[i].Country = testCity, "Pennsylvania", "United States"
+	}
+	if err := db.SyncBrandmeisterData(repeaters); err != nil {
+		log.Fatalf("Sync failed: %v", err)
+	}
+
+	// Each search carries its own filter, so one with the power filter off can't change the next
+	expect(db, "No filter", database.DeviceFilter{}, "W1BIG", "W1EDGE", "W1LOW", "W1NONE")
+	expect(db, "2 W keeps the cutoff itself and unknown power", database.DeviceFilter{MinPowerWatts: 2},
+		"W1BIG", "W1EDGE", "W1NONE")
+	expect(db, "2 W excluding unknown power", database.DeviceFilter{MinPowerWatts: 2, ExcludeUnknownPower: true},
+		"W1BIG", "W1EDGE")
+	expect(db, "Unknown power alone doesn't filter", database.DeviceFilter{ExcludeUnknownPower: true},
+		"W1BIG", "W1EDGE", "W1LOW", "W1NONE")
+	expect(db, "Hotspots included at 2 W", database.DeviceFilter{IncludeHotspots: true, MinPowerWatts: 2},
+		"W1BIG", "W1EDGE", "W1HS", "W1NONE")
+	expect(db, "Hotspots included at 10 W", database.DeviceFilter{IncludeHotspots: true, MinPowerWatts: 10},
+		"W1BIG", "W1NONE")
+
+	settings := config.SearchSettings{MinPowerWatts: 2, UnknownPower: config.UnknownPowerExclude}
+	expect(db, "search: in config.yaml", database.SearchDeviceFilter(settings), "W1BIG", "W1EDGE")
+
+	// Refining the unfiltered results applies the same cutoff
+	all, err := db.SearchRepeaters(testCity, 50, false, database.DeviceFilter{IncludeHotspots: true})
+	if err != nil {
+		log.Fatalf("Search failed: %v", err)
+	}
+	ids := make([]int, len(all))
+	for i, r := range all {
+		ids[i] = r.ID
+	}
+	refined, err := db.RefineRepeaters(ids, database.SearchFilter{MinPowerWatts: 2})
+	if err != nil {
+		log.Fatalf("Refine failed: %v", err)
+	}
+	check("Refining at 2 W", refined, "W1BIG", "W1EDGE", "W1HS", "W1NONE")
+	refined, err = db.RefineRepeaters(ids, database.SearchFilter{MinPowerWatts: 2, ExcludeUnknownPower: true})
+	if err != nil {
+		log.Fatalf("Refine failed: %v", err)
+	}
+	check("Refining at 2 W excluding unknown power", refined, "W1BIG", "W1EDGE", "W1HS")
+
+	fmt.Println("\n✓ Minimum power tests passed!")
+}
+
+// expect searches the test repeaters with devices and checks exactly the callsigns want come back
+func expect(db *database.Database, name string, devices database.DeviceFilter, want ...string) {
+	results, err := db.SearchRepeaters(testCity, 50, false, devices)
+	if err != nil {
+		log.Fatalf("%s: search failed: %v", name, err)
+	}
+	check(name, results, want...)
+}
+
+// check compares the callsigns of results, in any order, with want
+func check(name string, results []database.RepeaterRecord, want ...string) {
+	got := make([]string, len(results))
+	for i, r := range results {
+		got[i] = r.Callsign
+	}
+	sort.Strings(got)
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		log.Fatalf("✗ %s: got %v, want %v", name, got, want)
+	}
+	fmt.Printf("✓ %s: %s\n", name, strings.Join(got, ", "))
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+	"github.com/unklstewy/digiLogRT/internal/geocode"
+)
+
+// Checks NearestCity against the bundled gazetteer and that the syncs fill in an empty city from it, but
+// not across a border or far from any city. Uses an in-memory database:
+//
+//	go run ./cmd/test_nearest_city
+
+func main() {
+	log.Println("Testing nearest city labels...")
+
+	failed := 0
+	for _, c := range []struct {
+		lat, lng    float64
+		name, state string
+	}{
+		{39.92, -75.39, "Philadelphia", "Pennsylvania"}, // Media, 20 km out
+		{45.52, -73.60, "Montréal", "Quebec"},
+		{51.45, -0.30, "London", ""},
+		{-33.80, 151.00, "Sydney", ""},
+		{38.50, -117.00, "", ""}, // Central Nevada, nowhere near a bundled city
+		{0, 0, "", ""},
+	} {
+		name, state := geocode.NearestCity(c.lat, c.lng)
+		if name != c.name || state != c.state {
+			fmt.Printf("✗ NearestCity(%.2f, %.2f) = %q, %q, want %q, %q\n", c.lat, c.lng, name, state, c.name, c.state)
+			failed++
+		}
+	}
+	fmt.Println("✓ Gazetteer lookups checked")
+
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	devices := []api.BrandmeisterRepeater{
+		{ID: 310001, Callsign: "W3AAA", Country: "United States", TxFreq: "443.3000", RxFreq: "448.3000", Latitude: 39.92, Longitude: -75.39},
+		{ID: 310002, Callsign: "W3BBB", City: "Bryn Mawr", Country: "United States", TxFreq: "442.2000", RxFreq: "447.2000", Latitude: 40.02, Longitude: -75.32},
+		// Windsor is nearer but across the border
+		{ID: 310003, Callsign: "K8CCC", Country: "United States", TxFreq: "444.1000", RxFreq: "449.1000", Latitude: 42.30, Longitude: -83.06},
+		{ID: 310004, Callsign: "K7DDD", Country: "United States", TxFreq: "444.2000", RxFreq: "449.2000", Latitude: 38.50, Longitude: -117.00},
+	}
+	if err := db.SyncBrandmeisterData(devices); err != nil {
+		log.Fatalf("Brandmeister sync failed: %v", err)
+	}
+	hearham := []api.HearhamRepeater{
+		{ID: 1, Callsign: "VE3EEE", Latitude: 42.28, Longitude: -83.00, Mode: "FM", Frequency: 146940000},
+	}
+	if err := db.SyncHearhamData(hearham); err != nil {
+		log.Fatalf("hearham sync failed: %v", err)
+	}
+
+	for _, want := range []struct {
+		callsign, city string
+		inferred       bool
+	}{
+		{"W3AAA", "Philadelphia", true},
+		{"W3BBB", "Bryn Mawr", false}, // Listed cities are kept, and not flagged
+		{"K8CCC", "Detroit", true},
+		{"K7DDD", "", false},
+		{"VE3EEE", "Windsor", true},
+	} {
+		results, err := db.GetRepeatersByCallsign(want.callsign, true)
+		if err != nil || len(results) != 1 {
+			log.Fatalf("Failed to find %s: %v", want.callsign, err)
+		}
+		city := ""
+		if results[0].City != nil {
+			city = *results[0].City
+		}
+		if city != want.city || results[0].CityInferred != want.inferred {
+			fmt.Printf("✗ %s: city %q inferred %v, want %q inferred %v\n",
+				want.callsign, city, results[0].CityInferred, want.city, want.inferred)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ %s: %s (inferred %v)\n", want.callsign, results[0].GetLocationString(), results[0].CityInferred)
+	}
+
+	if failed > 0 {
+		log.Fatalf("✗ %d checks failed", failed)
+	}
+	fmt.Println("\n✓ Nearest city tests passed!")
+}
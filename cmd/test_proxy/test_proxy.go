@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+)
+
+// The clients are pointed at a host that doesn't resolve, so a request only succeeds if it goes
+// through the proxy. Each fake proxy answers with the Hearham fixture and counts what it served
+const targetURL = "http://api.example.invalid/hearham"
+
+// fakeProxy is a plain HTTP forward proxy that serves body for requests to targetURL
+type fakeProxy struct {
+	*httptest.Server
+	hits int32
+}
+
+func newFakeProxy(body []byte) *fakeProxy {
+	p := &fakeProxy{}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxied request carries the absolute URL of the real target
+		if r.URL.String() != targetURL {
+			http.Error(w, "unexpected target "+r.URL.String(), http.StatusBadGateway)
+			return
+		}
+		atomic.AddInt32(&p.hits, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+	return p
+}
+
+func main() {
+	log.Println("Testing HTTP proxy support...")
+
+	body, err := os.ReadFile(filepath.Join("testdata", "hearham_repeaters.json"))
+	if err != nil {
+		log.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	tmp, err := os.MkdirTemp("", "digilogrt-proxy")
+	if err != nil {
+		log.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	envProxy := newFakeProxy(body)
+	defer envProxy.Close()
+	configProxy := newFakeProxy(body)
+	defer configProxy.Close()
+
+	// The environment is read once, on the first proxied request, so it has to be set before any
+	os.Setenv("HTTP_PROXY", envProxy.URL)
+	os.Setenv("NO_PROXY", "")
+
+	fmt.Println("\nTesting the proxy from HTTP_PROXY...")
+	if err := fetch(tmp, "env"); err != nil {
+		log.Fatalf("Request through HTTP_PROXY failed: %v", err)
+	}
+	if envProxy.hits != 1 || configProxy.hits != 0 {
+		log.Fatalf("Expected one request through HTTP_PROXY, got %d (configured proxy %d)", envProxy.hits, configProxy.hits)
+	}
+	fmt.Println("✓ Request went through HTTP_PROXY")
+
+	fmt.Println("\nTesting the configured http_proxy...")
+	if err := api.SetProxy(configProxy.URL); err != nil {
+		log.Fatalf("SetProxy failed: %v", err)
+	}
+	if err := fetch(tmp, "config"); err != nil {
+		log.Fatalf("Request through the configured proxy failed: %v", err)
+	}
+	if configProxy.hits != 1 || envProxy.hits != 1 {
+		log.Fatalf("Expected one request through the configured proxy, got %d (HTTP_PROXY %d)", configProxy.hits, envProxy.hits)
+	}
+	fmt.Println("✓ Configured proxy overrides HTTP_PROXY")
+
+	fmt.Println("\nTesting that clearing http_proxy goes back to the environment...")
+	if err := api.SetProxy(""); err != nil {
+		log.Fatalf("SetProxy failed: %v", err)
+	}
+	if err := fetch(tmp, "cleared"); err != nil {
+		log.Fatalf("Request after clearing the proxy failed: %v", err)
+	}
+	if envProxy.hits != 2 || configProxy.hits != 1 {
+		log.Fatalf("Expected the request back on HTTP_PROXY, got %d (configured proxy %d)", envProxy.hits, configProxy.hits)
+	}
+	fmt.Println("✓ Request went through HTTP_PROXY again")
+
+	fmt.Println("\nTesting proxy URL validation...")
+	for _, bad := range []string{"proxy.local:3128", "ftp://proxy.local", "http://"} {
+		if err := api.SetProxy(bad); err == nil {
+			log.Fatalf("SetProxy accepted %q", bad)
+		}
+	}
+	fmt.Println("✓ Invalid proxy URLs rejected")
+
+	// A bad setting is reported without stopping the others from applying
+	cfg := &config.Config{HTTPProxy: "ftp://proxy.local", Offline: true}
+	cfg.Cache.Format = "gob"
+	if err := api.ApplyConfig(cfg); err == nil {
+		log.Fatalf("ApplyConfig accepted proxy %q", cfg.HTTPProxy)
+	}
+	if !api.IsOffline() {
+		log.Fatalf("ApplyConfig skipped offline mode after a bad proxy")
+	}
+	api.SetOffline(false)
+	fmt.Println("✓ ApplyConfig reports a bad proxy and still applies the rest")
+
+	fmt.Println("\n✓ All proxy tests passed!")
+}
+
+// fetch makes one uncached Hearham request, with the client's file cache in its own directory
+func fetch(tmp, name string) error {
+	dir := filepath.Join(tmp, name)
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return err
+	}
+	os.Setenv("TMPDIR", dir)
+
+	client := api.NewHearhamClient(config.CacheSettings{})
+	client.BaseURL = targetURL
+	repeaters, err := client.GetAllRepeaters()
+	if err != nil {
+		return err
+	}
+	if len(repeaters) != 2 {
+		return fmt.Errorf("expected 2 repeaters from the fixture, got %d", len(repeaters))
+	}
+	return nil
+}
@@ -5,13 +5,14 @@ import (
 	"log"
 
 	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
 )
 
 func main() {
 	log.Println("Testing RepeaterBook.com API...")
 
 	// Create RepeaterBook client (no API key needed for testing)
-	client := api.NewRepeaterBookClient("")
+	client := api.NewRepeaterBookClient("", config.CacheSettings{})
 
 	// Test the connection
 	fmt.Println("Testing API connection...")
@@ -63,5 +64,24 @@ func main() {
 		}
 	}
 
+	// Test country search against the worldwide endpoint
+	fmt.Println("\nTesting country search (Australia)...")
+	worldResponse, err := client.SearchByCountry("Australia")
+	if err != nil {
+		log.Fatalf("Country search failed: %v", err)
+	}
+	fmt.Printf("Found %d repeaters in Australia\n", worldResponse.Count)
+	if len(worldResponse.Results) > 0 {
+		repeater := worldResponse.Results[0]
+		fmt.Printf("  First: %s %s MHz (%s, %s)\n", repeater.Callsign, repeater.Frequency, repeater.Nearest, repeater.Country)
+	}
+
+	// Show cache status
+	status := client.GetCacheStatus()
+	fmt.Printf("\nCache Status:\n")
+	fmt.Printf("  Cached records: %d\n", status["count"])
+	fmt.Printf("  Cache Valid: %t\n", status["cache_valid"])
+	fmt.Printf("  Needs Refresh: %t\n", status["needs_refresh"])
+
 	fmt.Println("\n✓ RepeaterBook API test completed successfully!")
 }
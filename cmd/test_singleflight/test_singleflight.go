@@ -0,0 +1,137 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+)
+
+// Checks that concurrent bulk fetches on a cold cache share one request, run it with the race detector:
+//
+//	go run -race ./cmd/test_singleflight
+
+// fetchCase fetches one source's whole list from a local test server on a fresh client
+type fetchCase struct {
+	source  string
+	fixture string // Response body, from testdata/
+	fetch   func(baseURL string) (int, error)
+}
+
+var cases = []fetchCase{
+	{
+		source: "brandmeister", fixture: "brandmeister_devices.json",
+		fetch: func(baseURL string) (int, error) {
+			client := api.NewBrandmeisterClient(config.APISettings{}, config.CacheSettings{})
+			client.SetBaseURL(baseURL)
+			repeaters, err := client.GetAllRepeaters()
+			return len(repeaters), err
+		},
+	},
+	{
+		source: "tgif", fixture: "tgif_talkgroups.json",
+		fetch: func(baseURL string) (int, error) {
+			client := api.NewTGIFClient(config.CacheSettings{})
+			client.BaseURL = baseURL
+			talkgroups, err := client.GetAllTalkgroups()
+			return len(talkgroups), err
+		},
+	},
+	{
+		source: "hearham", fixture: "hearham_repeaters.json",
+		fetch: func(baseURL string) (int, error) {
+			client := api.NewHearhamClient(config.CacheSettings{})
+			client.BaseURL = baseURL
+			repeaters, err := client.GetAllRepeaters()
+			return len(repeaters), err
+		},
+	},
+}
+
+func main() {
+	callers := flag.Int("callers", 10, "Concurrent GetAll* calls per source")
+	flag.Parse()
+
+	log.Println("Testing that concurrent fetches on a cold cache share one request...")
+
+	tmp, err := os.MkdirTemp("", "digilogrt-singleflight")
+	if err != nil {
+		log.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	failed := 0
+	for i, c := range cases {
+		fmt.Printf("\nTesting %s with %d concurrent callers...\n", c.source, *callers)
+
+		// An empty cache dir per case keeps the real caches untouched and makes every call miss
+		caseTmp := filepath.Join(tmp, fmt.Sprint(i))
+		if err := os.Mkdir(caseTmp, 0755); err != nil {
+			log.Fatalf("Failed to create temp dir: %v", err)
+		}
+		os.Setenv("TMPDIR", caseTmp)
+
+		if err := runCase(c, *callers); err != nil {
+			fmt.Printf("✗ %s: %v\n", c.source, err)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ %s: %d callers shared one request\n", c.source, *callers)
+	}
+
+	if failed > 0 {
+		log.Fatalf("%d of %d sources made more than one request", failed, len(cases))
+	}
+	fmt.Printf("\n✓ All %d sources passed!\n", len(cases))
+}
+
+func runCase(c fetchCase, callers int) error {
+	body, err := os.ReadFile(filepath.Join("testdata", c.fixture))
+	if err != nil {
+		return fmt.Errorf("failed to read fixture: %v", err)
+	}
+
+	// The slow response keeps the first fetch in flight until every caller has asked
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		time.Sleep(300 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	counts := make([]int, callers)
+	errs := make([]error, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			counts[i], errs[i] = c.fetch(server.URL)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return fmt.Errorf("caller %d failed: %v", i, err)
+		}
+		if counts[i] == 0 || counts[i] != counts[0] {
+			return fmt.Errorf("callers got different results: %v", counts)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		return fmt.Errorf("%d requests reached the server, want 1", n)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Checks the US band plan splits and that the syncs fill them in, flagged as inferred, only for US repeaters
+// listed without an input frequency or offset. Uses an in-memory database, run it from the repo root:
+//
+//	go run ./cmd/test_standard_offset
+
+func main() {
+	log.Println("Testing standard repeater offsets...")
+
+	failed := 0
+	for _, c := range []struct {
+		mhz, want float64
+	}{
+		{146.94, -0.6}, {147.03, 0.6}, {147.39, 0.6}, {145.35, -0.6}, {146.52, 0},
+		{224.50, -1.6}, {442.05, 5}, {448.975, -5}, {440.0, 0}, {927.5, -25}, {53.03, 0}, {53.55, -1},
+	} {
+		if got := database.StandardOffsetForFrequency(c.mhz); got != c.want {
+			fmt.Printf("✗ StandardOffsetForFrequency(%.3f) = %+.1f, want %+.1f\n", c.mhz, got, c.want)
+			failed++
+		}
+	}
+	fmt.Println("✓ Band plan offsets checked")
+
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	hearham := []api.HearhamRepeater{
+		// Philadelphia, no offset listed
+		{ID: 1, Callsign: "W3AAA", Latitude: 39.95, Longitude: -75.16, City: "Philadelphia", Mode: "FM", Frequency: 146940000},
+		// Listed offset, kept even though it isn't the standard one
+		{ID: 2, Callsign: "W3BBB", Latitude: 40.04, Longitude: -75.38, City: "Bryn Mawr", Mode: "FM", Frequency: 147030000, Offset: -600000},
+		// Sydney uses a different band plan, nothing is inferred
+		{ID: 3, Callsign: "VK2CCC", Latitude: -33.7, Longitude: 150.9, City: "Sydney", Mode: "FM", Frequency: 146950000},
+	}
+	if err := db.SyncHearhamData(hearham); err != nil {
+		log.Fatalf("hearham sync failed: %v", err)
+	}
+
+	repeaterBook := []api.RepeaterBookRepeater{
+		{StateID: "42", Rptr_ID: "1", Frequency: "442.10000", Callsign: "K3DDD", Nearest: "Media",
+			State: "Pennsylvania", Country: "United States", Latitude: "39.91", Longitude: "-75.38"},
+		{StateID: "42", Rptr_ID: "2", Frequency: "146.88000", InputFreq: "146.28000", Callsign: "K3EEE",
+			Nearest: "Media", State: "Pennsylvania", Country: "United States", Latitude: "39.92", Longitude: "-75.39"},
+	}
+	if err := db.SyncRepeaterBookData(repeaterBook); err != nil {
+		log.Fatalf("RepeaterBook sync failed: %v", err)
+	}
+
+	for _, want := range []struct {
+		callsign    string
+		programming string
+		inferred    bool
+	}{
+		{"W3AAA", "W3AAA 146.940 -0.600", true},
+		{"W3BBB", "W3BBB 147.030 -0.600", false},
+		{"VK2CCC", "VK2CCC 146.950 simplex", false},
+		{"K3DDD", "K3DDD 442.100 +5.000", true},
+		{"K3EEE", "K3EEE 146.880 -0.600", false},
+	} {
+		results, err := db.GetRepeatersByCallsign(want.callsign, true)
+		if err != nil || len(results) != 1 {
+			log.Fatalf("Failed to find %s: %v", want.callsign, err)
+		}
+		r := results[0]
+		line := r.ProgrammingString()
+		if !strings.HasPrefix(line, want.programming) || r.OffsetInferred != want.inferred {
+			fmt.Printf("✗ %s: got %q (inferred %v), want %q (inferred %v)\n", want.callsign, line, r.OffsetInferred, want.programming, want.inferred)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ %s: %s (inferred %v)\n", want.callsign, line, r.OffsetInferred)
+	}
+
+	if failed > 0 {
+		log.Fatalf("✗ %d checks failed", failed)
+	}
+	fmt.Println("\n✓ Standard offset tests passed!")
+}
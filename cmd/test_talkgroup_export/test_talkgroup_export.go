@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Checks the plain CSV and Anytone talkgroup exports against talkgroups synced from TGIF and Brandmeister
+// into an in-memory database, and the CSV export options:
+//
+//	go run ./cmd/test_talkgroup_export
+
+func main() {
+	log.Println("Testing talkgroup exports...")
+
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	inactive := api.FlexibleBool{Value: false}
+	talkgroups := []api.TGIFTalkgroup{
+		{ID: "31665", Name: "TGIF Network", Description: "The main &amp; <b>busiest</b> talkgroup"},
+		{ID: "3100", Name: "USA, \"nationwide\" TGIF bridge", Description: "Bridged, usually"},
+		{ID: "9999", Name: "Retired", Active: &inactive},
+	}
+	if err := db.SyncTGIFData(talkgroups); err != nil {
+		log.Fatalf("TGIF sync failed: %v", err)
+	}
+
+	// A Brandmeister link adds an unnamed placeholder for talkgroup 3100, and a new one for 91
+	devices := []api.BrandmeisterRepeater{{ID: 310001, Callsign: "W3AAA", City: "Media", Country: "United States",
+		TxFreq: "443.3000", RxFreq: "448.3000", Latitude: 39.9, Longitude: -75.4}}
+	if err := db.SyncBrandmeisterData(devices); err != nil {
+		log.Fatalf("Brandmeister sync failed: %v", err)
+	}
+	links := map[int][]api.BrandmeisterTGAssignment{310001: {{Talkgroup: 3100, Timeslot: 1, Static: true}, {Talkgroup: 91, Timeslot: 2}}}
+	if err := db.SyncBrandmeisterTalkgroups(links); err != nil {
+		log.Fatalf("Brandmeister talkgroup sync failed: %v", err)
+	}
+
+	failed := 0
+	check := func(what string, export func(*bytes.Buffer) error, want string) {
+		var buf bytes.Buffer
+		if err := export(&buf); err != nil {
+			log.Fatalf("%s export failed: %v", what, err)
+		}
+		if buf.String() != want {
+			fmt.Printf("✗ %s export:\n%s\nwant:\n%s\n", what, buf.String(), want)
+			failed++
+			return
+		}
+		fmt.Printf("✓ %s export:\n%s\n", what, buf.String())
+	}
+
+	check("CSV", func(buf *bytes.Buffer) error { return db.ExportTalkgroupsCSV(buf, database.ExportOptions{}) }, ""+
+		"ID,Name,Description,Network\n"+
+		"91,,,brandmeister\n"+
+		"3100,\"USA, \"\"nationwide\"\" TGIF bridge\",\"Bridged, usually\",tgif\n"+
+		"3100,,,brandmeister\n"+
+		"9999,Retired,,tgif\n"+
+		"31665,TGIF Network,The main & busiest talkgroup,tgif\n")
+
+	check("Anytone", func(buf *bytes.Buffer) error { return db.ExportTalkgroupsAnytoneCSV(buf) }, ""+
+		"\"No.\",\"Radio ID\",\"Name\",\"Call Type\",\"Call Alert\"\r\n"+
+		"\"1\",\"91\",\"TG 91\",\"Group Call\",\"None\"\r\n"+
+		"\"2\",\"3100\",\"USA, \"\"nationwide\",\"Group Call\",\"None\"\r\n"+
+		"\"3\",\"31665\",\"TGIF Network\",\"Group Call\",\"None\"\r\n")
+
+	// Columns in the order asked for, case ignored, without a header
+	opts := database.ExportOptions{Columns: []string{"network", "ID"}, NoHeader: true}
+	check("CSV columns", func(buf *bytes.Buffer) error { return db.ExportTalkgroupsCSV(buf, opts) }, ""+
+		"brandmeister,91\n"+
+		"tgif,3100\n"+
+		"brandmeister,3100\n"+
+		"tgif,9999\n"+
+		"tgif,31665\n")
+
+	if err := db.ExportTalkgroupsCSV(&bytes.Buffer{}, database.ExportOptions{Columns: []string{"ID", "Timeslot"}}); err == nil {
+		fmt.Println("✗ unknown column accepted")
+		failed++
+	} else {
+		fmt.Printf("✓ unknown column refused: %v\n", err)
+	}
+
+	for _, tc := range []struct {
+		precision int
+		want      string
+	}{{0, "146.9400"}, {3, "146.940"}, {5, "146.94000"}} {
+		got := database.ExportOptions{Precision: tc.precision}.FormatFrequency(146.94)
+		if got != tc.want {
+			fmt.Printf("✗ precision %d: got %s, want %s\n", tc.precision, got, tc.want)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ precision %d: %s\n", tc.precision, got)
+	}
+
+	if failed > 0 {
+		log.Fatalf("✗ %d checks failed", failed)
+	}
+	fmt.Println("✓ Talkgroup export tests passed!")
+}
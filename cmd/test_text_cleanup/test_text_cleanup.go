@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"unicode/utf8"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Checks that the syncs repair invalid UTF-8 and strip control characters in free-text fields before
+// storing them, and that Brandmeister's HTML descriptions are stored as plain text with the markup kept
+// beside it, using records with broken byte sequences and markup and an in-memory database:
+//
+//	go run ./cmd/test_text_cleanup
+
+func main() {
+	log.Println("Testing free-text cleanup during sync...")
+
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	repeaters := []api.BrandmeisterRepeater{
+		{
+			// Latin-1 from an older system, the accent is recoverable
+			ID: 302001, Callsign: "VE2RXX", City: "Montr\xe9al", Country: "Canada",
+			TxFreq: "443.3000", RxFreq: "448.3000", Latitude: 45.5, Longitude: -73.57,
+			Description: "Relais r\xe9gional",
+		},
+		{
+			// UTF-8 with a truncated sequence and a stray NUL, only the bad parts go
+			ID: 262001, Callsign: "DB0ABC", City: "München", Country: "Germany",
+			TxFreq: "439.2000", RxFreq: "431.6000", Latitude: 48.14, Longitude: 11.58,
+			Description: "Über\x00 dem Dach \xc3",
+		},
+		{
+			// HTML as Brandmeister's dashboard saves it
+			ID: 310777, Callsign: "W3HTM", City: "Media", Country: "United States",
+			TxFreq: "443.3500", RxFreq: "448.3500", Latitude: 39.92, Longitude: -75.39,
+			Description: "<p>Linked to <b>TG 3142</b>&nbsp;on TS1</p>\n<p>Net Tue &amp; Thu<br/>8pm, TG <3100> on request</p>",
+		},
+	}
+	if err := db.SyncBrandmeisterData(repeaters); err != nil {
+		log.Fatalf("Brandmeister sync failed: %v", err)
+	}
+
+	talkgroups := []api.TGIFTalkgroup{{ID: "31999", Name: "Caf\xe9 Net", Description: "Grüße \xff\xfe vom Netz"}}
+	if err := db.SyncTGIFData(talkgroups); err != nil {
+		log.Fatalf("TGIF sync failed: %v", err)
+	}
+
+	failed := 0
+	check := func(what, got, want string) {
+		if !utf8.ValidString(got) || got != want {
+			fmt.Printf("✗ %s: got %q, want %q\n", what, got, want)
+			failed++
+			return
+		}
+		fmt.Printf("✓ %s: %q\n", what, got)
+	}
+
+	for _, want := range []struct{ callsign, city, description, html string }{
+		{"VE2RXX", "Montréal", "Relais régional", "Relais régional"},
+		{"DB0ABC", "München", "Über dem Dach �", "Über dem Dach �"},
+		{"W3HTM", "Media", "Linked to TG 3142 on TS1\n\nNet Tue & Thu\n8pm, TG <3100> on request",
+			"<p>Linked to <b>TG 3142</b>&nbsp;on TS1</p>\n<p>Net Tue &amp; Thu<br/>8pm, TG <3100> on request</p>"},
+	} {
+		results, err := db.SearchRepeaters(want.callsign, 1, false, database.DeviceFilter{})
+		if err != nil || len(results) == 0 {
+			log.Fatalf("Failed to find %s: %v", want.callsign, err)
+		}
+		r := results[0]
+		check(want.callsign+" city", stringValue(r.City), want.city)
+		check(want.callsign+" description", stringValue(r.Description), want.description)
+		check(want.callsign+" description HTML", stringValue(r.DescriptionHTML), want.html)
+
+		// What the database returns must survive JSON unchanged, as the caches and exports re-encode it
+		encoded, err := json.Marshal(r)
+		if err != nil {
+			log.Fatalf("Failed to encode %s: %v", want.callsign, err)
+		}
+		var decoded database.RepeaterRecord
+		if err := json.Unmarshal(encoded, &decoded); err != nil || stringValue(decoded.City) != want.city {
+			fmt.Printf("✗ %s didn't survive a JSON round trip: %v\n", want.callsign, err)
+			failed++
+		}
+	}
+
+	tg, err := talkgroupColumns(db, 31999)
+	if err != nil {
+		log.Fatalf("Failed to read talkgroup: %v", err)
+	}
+	check("TGIF name", tg["name"], "Café Net")
+	check("TGIF description", tg["description"], "Grüße � vom Netz")
+
+	if failed > 0 {
+		log.Fatalf("✗ %d checks failed", failed)
+	}
+	fmt.Println("\n✓ Text cleanup tests passed!")
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// talkgroupColumns reads a talkgroup's text columns through a snapshot, the database has no getter for one
+func talkgroupColumns(db *database.Database, talkgroupID int) (map[string]string, error) {
+	var buf bytes.Buffer
+	if err := db.ExportSnapshot(&buf); err != nil {
+		return nil, err
+	}
+	var snapshot database.Snapshot
+	if err := json.Unmarshal(buf.Bytes(), &snapshot); err != nil {
+		return nil, err
+	}
+
+	for _, table := range snapshot.Tables {
+		if table.Name != "talkgroups" {
+			continue
+		}
+		for _, row := range table.Rows {
+			columns := make(map[string]string)
+			for i, name := range table.Columns {
+				columns[name] = fmt.Sprint(row[i])
+			}
+			if columns["talkgroup_id"] == fmt.Sprint(talkgroupID) {
+				return columns, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("talkgroup %d not found", talkgroupID)
+}
@@ -5,13 +5,20 @@ import (
 	"log"
 
 	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
 )
 
 func main() {
 	log.Println("Testing TGIF.network API with intelligent caching...")
 
+	// Load configuration for cache settings (defaults are fine for testing)
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		cfg = config.GetDefaultConfig()
+	}
+
 	// Create TGIF client
-	client := api.NewTGIFClient()
+	client := api.NewTGIFClient(cfg.Cache.TGIF)
 
 	// Test initialization (will check cache age and refresh if needed)
 	fmt.Println("Initializing TGIF client...")
@@ -56,6 +63,12 @@ func main() {
 				fmt.Printf("  Description: %s (encoded)\n", tg.Description)
 			}
 		}
+		fmt.Printf("  Slot: %s\n", tg.GetSlotInfo())
+		if active, known := tg.IsActive(); known {
+			fmt.Printf("  Active: %t\n", active)
+		} else {
+			fmt.Printf("  Active: Unknown\n")
+		}
 	}
 
 	// Test talkgroup search
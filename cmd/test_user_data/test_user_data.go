@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Checks that favorites, notes and custom names survive a re-sync that changes the repeater's upstream
+// details, and that snapshots leave them out. Uses the Brandmeister fixture and an in-memory database, run it from the repo root:
+//
+//	go run ./cmd/test_user_data
+
+const (
+	testNote = "Linked to the club net Tuesdays 8pm"
+	testName = "Club DMR"
+)
+
+func main() {
+	log.Println("Testing that user data survives a re-sync...")
+
+	data, err := os.ReadFile(filepath.Join("testdata", "brandmeister_devices.json"))
+	if err != nil {
+		log.Fatalf("Failed to read fixture: %v", err)
+	}
+	var repeaters []api.BrandmeisterRepeater
+	if err := json.Unmarshal(data, &repeaters); err != nil {
+		log.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.SyncBrandmeisterData(repeaters); err != nil {
+		log.Fatalf("Initial sync failed: %v", err)
+	}
+	id := repeaterID(db, repeaters[0].Callsign)
+
+	if err := db.SetRepeaterNotes(id, testNote); err != nil {
+		log.Fatalf("Failed to set note: %v", err)
+	}
+	if err := db.SetRepeaterFavorite(id, true); err != nil {
+		log.Fatalf("Failed to set favorite: %v", err)
+	}
+	if err := db.SetRepeaterCustomName(id, testName); err != nil {
+		log.Fatalf("Failed to set custom name: %v", err)
+	}
+	fmt.Printf("✓ Saved a note, favorite and custom name on %s (ID %d)\n", repeaters[0].Callsign, id)
+
+	// The upstream record changes, the sync must take the change and keep the user's data
+	repeaters[0].Description = "Changed upstream"
+	if err := db.SyncBrandmeisterData(repeaters); err != nil {
+		log.Fatalf("Re-sync failed: %v", err)
+	}
+
+	if after := repeaterID(db, repeaters[0].Callsign); after != id {
+		log.Fatalf("✗ Re-sync moved %s from ID %d to %d", repeaters[0].Callsign, id, after)
+	}
+	synced, err := db.GetRepeatersByIDs([]int{id})
+	if err != nil || len(synced) != 1 {
+		log.Fatalf("Failed to read repeater %d back: %v", id, err)
+	}
+	if synced[0].Description == nil || *synced[0].Description != "Changed upstream" {
+		log.Fatalf("✗ Re-sync didn't update the upstream description: %v", synced[0].Description)
+	}
+	fmt.Println("✓ Re-sync updated the upstream fields")
+
+	user, err := db.GetRepeaterUserData(id)
+	if err != nil {
+		log.Fatalf("Failed to read user data: %v", err)
+	}
+	if user.Notes != testNote || !user.Favorite || user.CustomName != testName {
+		log.Fatalf("✗ User data didn't survive the re-sync: %+v", user)
+	}
+	fmt.Println("✓ Note, favorite and custom name survived the re-sync")
+
+	// A repeater the user never touched has no data, and clearing a field leaves the others alone
+	if untouched, err := db.GetRepeaterUserData(repeaterID(db, repeaters[1].Callsign)); err != nil || untouched.UpdatedAt != nil {
+		log.Fatalf("✗ Untouched repeater has user data: %+v (%v)", untouched, err)
+	}
+	if err := db.SetRepeaterNotes(id, ""); err != nil {
+		log.Fatalf("Failed to clear note: %v", err)
+	}
+	if user, err = db.GetRepeaterUserData(id); err != nil || user.Notes != "" || !user.Favorite {
+		log.Fatalf("✗ Clearing the note changed the other fields: %+v (%v)", user, err)
+	}
+	fmt.Println("✓ Clearing the note kept the favorite")
+
+	// Snapshots are shared with other users, so user data stays out of them and an older snapshot's is skipped
+	var buf bytes.Buffer
+	if err := db.ExportSnapshot(&buf); err != nil {
+		log.Fatalf("Failed to export snapshot: %v", err)
+	}
+	var snapshot database.Snapshot
+	if err := json.Unmarshal(buf.Bytes(), &snapshot); err != nil {
+		log.Fatalf("Failed to parse snapshot: %v", err)
+	}
+	for _, table := range snapshot.Tables {
+		if table.Name == "repeater_user_data" {
+			log.Fatalf("✗ Snapshot carries %d user data rows", len(table.Rows))
+		}
+	}
+	snapshot.Tables = append(snapshot.Tables, database.SnapshotTable{
+		Name:    "repeater_user_data",
+		Columns: []string{"repeater_id", "favorite", "notes"},
+		Rows:    [][]interface{}{{id, true, testNote}},
+	})
+	buf.Reset()
+	if err := json.NewEncoder(&buf).Encode(snapshot); err != nil {
+		log.Fatalf("Failed to write snapshot: %v", err)
+	}
+	imported, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer imported.Close()
+	if err := imported.ImportSnapshot(&buf); err != nil {
+		log.Fatalf("✗ Importing a snapshot with user data failed: %v", err)
+	}
+	if user, err = imported.GetRepeaterUserData(id); err != nil || user.UpdatedAt != nil {
+		log.Fatalf("✗ Import brought in user data: %+v (%v)", user, err)
+	}
+	fmt.Println("✓ Snapshots leave user data out")
+
+	fmt.Println("\n✓ User data tests passed!")
+}
+
+// repeaterID looks up a synced repeater's database ID by callsign
+func repeaterID(db *database.Database, callsign string) int {
+	results, err := db.SearchRepeaters(callsign, 1, false, database.DeviceFilter{})
+	if err != nil || len(results) == 0 {
+		log.Fatalf("Failed to find %s: %v", callsign, err)
+	}
+	return results[0].ID
+}
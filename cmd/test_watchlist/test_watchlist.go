@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+	"github.com/unklstewy/digiLogRT/internal/watch"
+)
+
+// Checks that the watchlist checker alerts once each time a watched repeater comes on the air, using a
+// local Brandmeister server whose last heard list is changed between checks and an in-memory database.
+// Also checks snapshots leave the watchlist out:
+//
+//	go run ./cmd/test_watchlist
+
+// lastHeard is what the test server's last heard endpoint returns, changed between checks
+type lastHeard struct {
+	mu       sync.Mutex
+	activity []api.BrandmeisterActivity
+}
+
+func (l *lastHeard) set(repeaterIDs ...int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.activity = nil
+	for _, id := range repeaterIDs {
+		l.activity = append(l.activity, api.BrandmeisterActivity{RepeaterID: id, Callsign: "N0CALL", Start: 1})
+	}
+}
+
+func main() {
+	log.Println("Testing watchlist alerts...")
+
+	// Keep the real caches out of it
+	tmp, err := os.MkdirTemp("", "digilogrt-watchlist")
+	if err != nil {
+		log.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+	os.Setenv("TMPDIR", tmp)
+
+	devices := []api.BrandmeisterRepeater{
+		{ID: 310001, Callsign: "W3AAA", City: "Media", Country: "United States", TxFreq: "443.3000", RxFreq: "448.3000", Latitude: 39.9, Longitude: -75.4},
+		{ID: 310002, Callsign: "W3BBB", City: "Media", Country: "United States", TxFreq: "442.2000", RxFreq: "447.2000", Latitude: 39.9, Longitude: -75.4},
+		{ID: 310003, Callsign: "W3CCC", City: "Media", Country: "United States", TxFreq: "444.1000", RxFreq: "449.1000", Latitude: 39.9, Longitude: -75.4, Status: 1},
+	}
+	deviceList, err := json.Marshal(devices)
+	if err != nil {
+		log.Fatalf("Failed to encode devices: %v", err)
+	}
+	heard := &lastHeard{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(r.URL.Path, "/v2/lastheard") {
+			heard.mu.Lock()
+			defer heard.mu.Unlock()
+			json.NewEncoder(w).Encode(heard.activity)
+			return
+		}
+		w.Write(deviceList)
+	}))
+	defer server.Close()
+
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer db.Close()
+	if err := db.SyncBrandmeisterData(devices); err != nil {
+		log.Fatalf("Sync failed: %v", err)
+	}
+
+	ids := make(map[string]int)
+	for _, d := range devices {
+		results, err := db.GetRepeatersByCallsign(d.Callsign, true)
+		if err != nil || len(results) != 1 {
+			log.Fatalf("Failed to find %s: %v", d.Callsign, err)
+		}
+		ids[d.Callsign] = results[0].ID
+		if err := db.AddToWatchlist(results[0].ID); err != nil {
+			log.Fatalf("Failed to watch %s: %v", d.Callsign, err)
+		}
+	}
+	if err := db.AddToWatchlist(999999); err == nil {
+		log.Fatalf("✗ Watching a repeater that doesn't exist succeeded")
+	}
+
+	var alerts []string
+	onOnline := func(r database.RepeaterRecord) { alerts = append(alerts, r.Callsign) }
+	client := api.NewBrandmeisterClient(config.APISettings{BrandmeisterKey: "test"}, config.CacheSettings{})
+	client.SetBaseURL(server.URL)
+	live := watch.NewChecker(db, client, 0, onOnline)
+	synced := watch.NewChecker(db, nil, 0, onOnline)
+
+	failed := 0
+	step := func(what string, checker *watch.Checker, want ...string) {
+		alerts = nil
+		if err := checker.Check(); err != nil {
+			log.Fatalf("Check failed: %v", err)
+		}
+		if strings.Join(alerts, ",") != strings.Join(want, ",") {
+			fmt.Printf("✗ %s: alerts %v, want %v\n", what, alerts, want)
+			failed++
+			return
+		}
+		fmt.Printf("✓ %s: alerts %v\n", what, alerts)
+	}
+
+	step("Nothing heard, W3CCC was already up when added", live)
+	heard.set(310001)
+	step("W3AAA heard", live, "W3AAA")
+	step("W3AAA still heard", live)
+	heard.set()
+	step("W3AAA gone quiet for a check", live)
+	heard.set(310001)
+	step("W3AAA heard again, still up", live)
+
+	// Only a repeater missed by several checks in a row is off the air, and alerts when heard after that
+	heard.set()
+	for i := 1; i <= watch.QuietChecksOffline; i++ {
+		step(fmt.Sprintf("W3AAA quiet for %d checks", i), live)
+	}
+	heard.set(310001)
+	step("W3AAA heard after going off the air", live, "W3AAA")
+
+	// Without a client the synced status decides
+	devices[1].Status = 1
+	if err := db.SyncBrandmeisterData(devices); err != nil {
+		log.Fatalf("Re-sync failed: %v", err)
+	}
+	step("W3BBB online after a sync", synced, "W3BBB")
+
+	if err := db.RemoveFromWatchlist(ids["W3AAA"]); err != nil {
+		log.Fatalf("Failed to stop watching W3AAA: %v", err)
+	}
+	heard.set()
+	step("W3AAA unwatched while quiet", live)
+	heard.set(310001)
+	step("W3AAA heard while unwatched", live)
+
+	// Snapshots are shared with other users, so the watchlist stays out of them and an older snapshot's is skipped
+	var buf bytes.Buffer
+	if err := db.ExportSnapshot(&buf); err != nil {
+		log.Fatalf("Failed to export snapshot: %v", err)
+	}
+	var snapshot database.Snapshot
+	if err := json.Unmarshal(buf.Bytes(), &snapshot); err != nil {
+		log.Fatalf("Failed to parse snapshot: %v", err)
+	}
+	for _, table := range snapshot.Tables {
+		if table.Name == "watchlist" {
+			log.Fatalf("✗ Snapshot carries %d watchlist rows", len(table.Rows))
+		}
+	}
+	snapshot.Tables = append(snapshot.Tables, database.SnapshotTable{
+		Name:    "watchlist",
+		Columns: []string{"repeater_id"},
+		Rows:    [][]interface{}{{ids["W3BBB"]}},
+	})
+	buf.Reset()
+	if err := json.NewEncoder(&buf).Encode(snapshot); err != nil {
+		log.Fatalf("Failed to write snapshot: %v", err)
+	}
+	imported, err := database.NewDatabase(":memory:")
+	if err != nil {
+		log.Fatalf("Failed to create database: %v", err)
+	}
+	defer imported.Close()
+	if err := imported.ImportSnapshot(&buf); err != nil {
+		log.Fatalf("✗ Importing a snapshot with a watchlist failed: %v", err)
+	}
+	if watched, err := imported.GetWatchlist(); err != nil || len(watched) != 0 {
+		log.Fatalf("✗ Import brought in %d watched repeaters (%v)", len(watched), err)
+	}
+	fmt.Println("✓ Snapshots leave the watchlist out")
+
+	if failed > 0 {
+		log.Fatalf("✗ %d checks failed", failed)
+	}
+	fmt.Println("\n✓ Watchlist tests passed!")
+}
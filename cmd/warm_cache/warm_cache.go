@@ -4,45 +4,69 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
 	"github.com/unklstewy/digiLogRT/internal/config"
 )
 
 func main() {
-	maxAge := flag.String("max-age", "1h", "Maximum cache age before refresh (e.g., 1h, 30m, 24h)")
-	sources := flag.String("sources", "brandmeister,tgif,hearham", "Comma-separated list of sources to warm")
+	since := flag.Duration("since", time.Hour, "Refresh caches older than this (e.g., 30m, 1h, 24h)")
+	maxAge := flag.String("max-age", "", "Deprecated alias for -since")
+	sources := flag.String("sources", strings.Join(cache.Sources(), ","), "Comma-separated list of sources to warm")
 	flag.Parse()
 
-	fmt.Printf("🔥 Warming API caches (max age: %s, sources: %s)\n", *maxAge, *sources)
-	start := time.Now()
-
-	// Parse max age
-	maxCacheAge, err := time.ParseDuration(*maxAge)
+	maxCacheAge := *since
+	if *maxAge != "" {
+		d, err := time.ParseDuration(*maxAge)
+		if err != nil {
+			log.Fatalf("Invalid max-age format: %v", err)
+		}
+		maxCacheAge = d
+	}
+	sourceList, err := api.ParseCacheSources(*sources)
 	if err != nil {
-		log.Fatalf("Invalid max-age format: %v", err)
+		log.Fatalf("Invalid -sources: %v", err)
 	}
 
+	fmt.Printf("🔥 Warming API caches (refreshing anything older than %v, sources: %s)\n", maxCacheAge, strings.Join(sourceList, ", "))
+	start := time.Now()
+
 	// Load configuration
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
+	if err := api.ApplyConfig(cfg); err != nil {
+		log.Fatalf("Failed to apply configuration: %v", err)
+	}
 
-	// Warm caches in parallel
+	// Fresh caches are skipped from their file ages alone, only stale ones hit the network
 	pool := api.GetGlobalPool()
-	warmStart := time.Now()
+	results := pool.WarmCaches(cfg, maxCacheAge, sourceList)
 
-	// Force cache refresh if older than maxAge
-	if err := pool.WarmCaches(cfg.APIs.BrandmeisterKey, maxCacheAge); err != nil {
-		log.Printf("Cache warming failed: %v", err)
+	failed := 0
+	for _, r := range results {
+		switch {
+		case r.Err != nil:
+			fmt.Printf("  ✗ %-13s %v\n", r.Source, r.Err)
+			failed++
+		case r.Refreshed && !r.Existed:
+			fmt.Printf("  🔄 %-13s refreshed (no cache yet)\n", r.Source)
+		case r.Refreshed:
+			fmt.Printf("  🔄 %-13s refreshed (was %v old)\n", r.Source, r.Age.Round(time.Second))
+		default:
+			fmt.Printf("  ✓ %-13s fresh, skipped (%v old)\n", r.Source, r.Age.Round(time.Second))
+		}
 	}
 
-	warmTime := time.Since(warmStart)
-	totalTime := time.Since(start)
-
-	fmt.Printf("✓ Cache warming completed in %v\n", warmTime)
-	fmt.Printf("✓ Total time: %v\n", totalTime)
+	fmt.Printf("✓ Cache warming completed in %v\n", time.Since(start))
+	if failed > 0 {
+		fmt.Printf("⚠️  %d of %d sources could not be warmed\n", failed, len(results))
+		os.Exit(1)
+	}
 	fmt.Println("🚀 Subsequent syncs should be near-instant!")
 }
@@ -1,12 +1,20 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/metrics"
 )
 
 // FlexibleTime handles both string and int64 timestamps from the API
@@ -114,6 +122,11 @@ func (fi *FlexibleInt) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON writes the plain number so cached data reads back in
+func (fi FlexibleInt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fi.Value)
+}
+
 // APRS station data structure with flexible field handling
 type APRSStation struct {
 	Name        string        `json:"name"`
@@ -157,104 +170,266 @@ func (s *APRSStation) GetLongitude() float64 {
 	return s.Lng.Value
 }
 
+// DistanceFromPoint returns the distance in kilometers from the station to lat/lng
+func (s *APRSStation) DistanceFromPoint(lat, lng float64) float64 {
+	return haversineKm(s.Lat.Value, s.Lng.Value, lat, lng)
+}
+
+// HasPosition reports whether the station reported coordinates, aprs.fi sends 0,0 when it has none
+func (s *APRSStation) HasPosition() bool {
+	return s.Lat.Value != 0 || s.Lng.Value != 0
+}
+
+// NearbyStation is an APRS station with its distance from a search point
+type NearbyStation struct {
+	APRSStation
+	DistanceKm float64 `json:"distance_km"`
+}
+
+// SortByDistance returns the stations nearest-first with their distance from lat/lng
+// Stations without a position are left out, limit caps the result when above zero
+func SortByDistance(stations []APRSStation, lat, lng float64, limit int) []NearbyStation {
+	nearby := make([]NearbyStation, 0, len(stations))
+	for _, station := range stations {
+		if !station.HasPosition() {
+			continue
+		}
+		nearby = append(nearby, NearbyStation{
+			APRSStation: station,
+			DistanceKm:  station.DistanceFromPoint(lat, lng),
+		})
+	}
+
+	sort.SliceStable(nearby, func(i, j int) bool {
+		if nearby[i].DistanceKm != nearby[j].DistanceKm {
+			return nearby[i].DistanceKm < nearby[j].DistanceKm
+		}
+		return nearby[i].Name < nearby[j].Name
+	})
+
+	if limit > 0 && len(nearby) > limit {
+		nearby = nearby[:limit]
+	}
+	return nearby
+}
+
+// LatestPerCallsign collapses stations that share a base callsign (W3ABC-9, W3ABC-7) into the one heard last,
+// in the order each callsign first appears. keepSSIDs returns the stations as they are
+func LatestPerCallsign(stations []APRSStation, keepSSIDs bool) []APRSStation {
+	if keepSSIDs {
+		return stations
+	}
+
+	latest := make([]APRSStation, 0, len(stations))
+	index := make(map[string]int)
+	for _, station := range stations {
+		base, _ := SplitSSID(station.Name)
+		base = strings.ToUpper(base)
+		if i, seen := index[base]; !seen {
+			index[base] = len(latest)
+			latest = append(latest, station)
+		} else if station.LastTime.Value > latest[i].LastTime.Value {
+			latest[i] = station
+		}
+	}
+	return latest
+}
+
 // APRS API response structure
 type APRSResponse struct {
-	Command string        `json:"command"`
-	Result  string        `json:"result"`
-	What    string        `json:"what"`
-	Found   int           `json:"found"`
-	Entries []APRSStation `json:"entries"`
+	Command     string        `json:"command"`
+	Result      string        `json:"result"`
+	Description string        `json:"description,omitempty"` // Why the query failed, when Result is "fail"
+	What        string        `json:"what"`
+	Found       int           `json:"found"`
+	Entries     []APRSStation `json:"entries"`
 }
 
 // APRS API client
 type APRSClient struct {
-	APIKey  string
 	BaseURL string
 	client  *http.Client
+	keys    *keyRing                      // aprs.fi keys, lookups rotate through them
+	cache   *responseCache[*APRSResponse] // Recent lookups, keyed by query
 }
 
 // Create new APRS client
-func NewAPRSClient(apiKey string) *APRSClient {
+// Lookups rotate through apiKeys, moving on to the next key when aprs.fi says one is over its limit
+// Positions go stale quickly, so lookups are only cached briefly (2m unless configured)
+func NewAPRSClient(apiKeys []string, cache config.CacheSettings) *APRSClient {
 	return &APRSClient{
-		APIKey:  apiKey,
 		BaseURL: "https://api.aprs.fi/api",
-		client: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		client:  newHTTPClient(30 * time.Second),
+		keys:    newKeyRing(apiKeys),
+		cache:   newResponseCache[*APRSResponse](cache.CacheTime(2 * time.Minute)),
 	}
 }
 
 // Get station information by callsign
 func (c *APRSClient) GetStation(callsign string) (*APRSResponse, error) {
-	// Build the URL with parameters
-	u, err := url.Parse(c.BaseURL + "/get")
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse URL: %v", err)
+	return c.GetStationContext(context.Background(), callsign)
+}
+
+// GetStationContext looks up a station by callsign, aborting when ctx is cancelled
+func (c *APRSClient) GetStationContext(ctx context.Context, callsign string) (_ *APRSResponse, err error) {
+	start := time.Now()
+	defer func() { metrics.ObserveFetch("aprs", start, err) }()
+
+	cacheKey := "station:" + callsign
+	if cached, ok := c.cache.get(cacheKey); ok {
+		metrics.CacheHits.Inc("aprs")
+		return cached, nil
 	}
+	metrics.CacheMisses.Inc("aprs")
+
+	return c.fetchStation(ctx, callsign)
+}
 
-	// Add query parameters
+// fetchStation looks a station up on aprs.fi whatever the cache holds, then caches the answer
+func (c *APRSClient) fetchStation(ctx context.Context, callsign string) (*APRSResponse, error) {
 	params := url.Values{}
 	params.Add("name", callsign)
 	params.Add("what", "loc")
-	params.Add("apikey", c.APIKey)
 	params.Add("format", "json")
-	u.RawQuery = params.Encode()
 
-	// Make the request
-	resp, err := c.client.Get(u.String())
+	aprsResp, err := c.get(ctx, params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %v", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	// Check status code
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
-	}
-
-	// Parse JSON response
-	var aprsResp APRSResponse
-	if err := json.NewDecoder(resp.Body).Decode(&aprsResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
-	}
-
-	return &aprsResp, nil
+	c.cache.put("station:"+callsign, aprsResp, len(aprsResp.Entries))
+	return aprsResp, nil
 }
 
-// Get stations within a radius of coordinates
+// Get stations within a radius (km) of coordinates
 func (c *APRSClient) GetStationsInRadius(lat, lng float64, radius int) (*APRSResponse, error) {
-	u, err := url.Parse(c.BaseURL + "/get")
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse URL: %v", err)
+	return c.GetStationsInRadiusContext(context.Background(), lat, lng, radius)
+}
+
+// GetStationsInRadiusContext finds stations near a point, aborting when ctx is cancelled
+func (c *APRSClient) GetStationsInRadiusContext(ctx context.Context, lat, lng float64, radius int) (_ *APRSResponse, err error) {
+	start := time.Now()
+	defer func() { metrics.ObserveFetch("aprs", start, err) }()
+
+	cacheKey := fmt.Sprintf("radius:%.6f,%.6f,%d", lat, lng, radius)
+	if cached, ok := c.cache.get(cacheKey); ok {
+		metrics.CacheHits.Inc("aprs")
+		return cached, nil
 	}
+	metrics.CacheMisses.Inc("aprs")
 
 	params := url.Values{}
 	params.Add("lat", fmt.Sprintf("%.6f", lat))
 	params.Add("lng", fmt.Sprintf("%.6f", lng))
 	params.Add("distance", fmt.Sprintf("%d", radius))
 	params.Add("what", "loc")
-	params.Add("apikey", c.APIKey)
 	params.Add("format", "json")
+
+	aprsResp, err := c.get(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.put(cacheKey, aprsResp, len(aprsResp.Entries))
+	return aprsResp, nil
+}
+
+// get runs a query with the next usable key, trying the following key whenever one is rate limited
+func (c *APRSClient) get(ctx context.Context, params url.Values) (*APRSResponse, error) {
+	for {
+		key, wait, ok := c.keys.pick()
+		if !ok {
+			which := fmt.Sprintf("all %d APRS keys are", c.keys.size())
+			if c.keys.size() == 1 {
+				which = "the APRS key is"
+			}
+			return nil, &APIError{Source: "aprs", Kind: KindRateLimited,
+				Err: fmt.Errorf("%s rate limited, try again in %v", which, wait.Round(time.Second))}
+		}
+		params.Set("apikey", key)
+
+		aprsResp, err := c.getWithKey(ctx, params)
+		if errors.Is(err, ErrRateLimited) {
+			log.Printf("APRS key %s is rate limited, resting it: %v", maskKey(key), err)
+			c.keys.limited(key)
+			continue
+		}
+		if err == nil {
+			c.keys.succeeded(key)
+		}
+		return aprsResp, err
+	}
+}
+
+// getWithKey makes one request to the /get endpoint, params include the key
+func (c *APRSClient) getWithKey(ctx context.Context, params url.Values) (*APRSResponse, error) {
+	u, err := url.Parse(c.BaseURL + "/get")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %v", err)
+	}
 	u.RawQuery = params.Encode()
 
-	resp, err := c.client.Get(u.String())
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %v", err)
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, networkError("aprs", "failed to make request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+		return nil, statusError("aprs", resp.StatusCode, "API request failed with status: %d", resp.StatusCode)
 	}
 
 	var aprsResp APRSResponse
 	if err := json.NewDecoder(resp.Body).Decode(&aprsResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
+		return nil, decodeError("aprs", "failed to decode response: %w", err)
 	}
 
+	// aprs.fi reports an exhausted or rejected key in the body with a 200
+	if aprsResp.Result == "fail" {
+		description := strings.ToLower(aprsResp.Description)
+		switch {
+		case strings.Contains(description, "limit"):
+			return nil, &APIError{Source: "aprs", Kind: KindRateLimited, StatusCode: resp.StatusCode,
+				Err: fmt.Errorf("API request failed: %s", aprsResp.Description)}
+		case strings.Contains(description, "auth") || strings.Contains(description, "apikey") ||
+			strings.Contains(description, "api key"):
+			return nil, &APIError{Source: "aprs", Kind: KindAuth, StatusCode: resp.StatusCode,
+				Err: fmt.Errorf("API request failed: %s", aprsResp.Description)}
+		}
+	}
 	return &aprsResp, nil
 }
 
+// KeyUsage reports how each APRS key has been used, in configuration order
+func (c *APRSClient) KeyUsage() []KeyUsage {
+	return c.keys.usage()
+}
+
+// GetNearbyStations finds stations within radius km of a point, nearest first
+// At most limit stations are returned when limit is above zero
+func (c *APRSClient) GetNearbyStations(lat, lng float64, radius, limit int) ([]NearbyStation, error) {
+	return c.GetNearbyStationsContext(context.Background(), lat, lng, radius, limit)
+}
+
+// GetNearbyStationsContext is GetNearbyStations, aborting when ctx is cancelled
+func (c *APRSClient) GetNearbyStationsContext(ctx context.Context, lat, lng float64, radius, limit int) ([]NearbyStation, error) {
+	response, err := c.GetStationsInRadiusContext(ctx, lat, lng, radius)
+	if err != nil {
+		return nil, err
+	}
+	return SortByDistance(response.Entries, lat, lng, limit), nil
+}
+
+// GetCacheStatus returns information about the recent lookup cache
+func (c *APRSClient) GetCacheStatus() map[string]interface{} {
+	return c.cache.status()
+}
+
 // Test the API connection
 func (c *APRSClient) TestConnection() error {
 	// Test with a known callsign
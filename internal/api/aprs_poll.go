@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/metrics"
+)
+
+// DefaultAPRSPollInterval is PollStation's interval when none is given, few stations beacon more often
+const DefaultAPRSPollInterval = time.Minute
+
+// PollStation looks callsign up every interval until ctx is cancelled, calling onUpdate with the first
+// position and then only when the station has moved. Repeated beacons from the same spot are dropped, so
+// a tracking view (or Database.RecordAPRSPosition) sees one sample per position
+// Polls skip the short lookup cache but go through the key ring like every lookup: when all keys are
+// rate limited the poll is skipped and tried again next interval. Other errors are logged and polling
+// carries on. An interval of 0 means DefaultAPRSPollInterval
+func (c *APRSClient) PollStation(ctx context.Context, callsign string, interval time.Duration, onUpdate func(APRSStation)) {
+	if interval <= 0 {
+		interval = DefaultAPRSPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *APRSStation
+	for {
+		if station, ok := c.pollOnce(ctx, callsign); ok && moved(last, station) {
+			last = &station
+			onUpdate(station)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollOnce fetches the callsign's current position, ok is false when there is none to report
+func (c *APRSClient) pollOnce(ctx context.Context, callsign string) (_ APRSStation, ok bool) {
+	start := time.Now()
+	response, err := c.fetchStation(ctx, callsign)
+	metrics.ObserveFetch("aprs", start, err)
+
+	switch {
+	case ctx.Err() != nil:
+		return APRSStation{}, false
+	case errors.Is(err, ErrRateLimited):
+		log.Printf("Polling %s: skipping this poll, %v", callsign, err)
+		return APRSStation{}, false
+	case err != nil:
+		log.Printf("Polling %s failed: %v", callsign, err)
+		return APRSStation{}, false
+	}
+
+	// A name lookup can return several entries, prefer the exact callsign
+	for _, station := range response.Entries {
+		if strings.EqualFold(station.Name, callsign) && station.HasPosition() {
+			return station, true
+		}
+	}
+	for _, station := range response.Entries {
+		if station.HasPosition() {
+			return station, true
+		}
+	}
+	return APRSStation{}, false
+}
+
+// moved reports whether station is at a different position than last, the first position always counts
+// aprs.fi's time field only changes along with the position, so the coordinates decide
+func moved(last *APRSStation, station APRSStation) bool {
+	return last == nil || last.Lat.Value != station.Lat.Value || last.Lng.Value != station.Lng.Value
+}
@@ -1,27 +1,41 @@
 package api
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
-	"os"
-	"path/filepath"
+	neturl "net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/metrics"
 )
 
 // BrandmeisterClient handles API calls to the Brandmeister network
 // Brandmeister is a popular DMR network with repeaters and hotspots worldwide
 type BrandmeisterClient struct {
-	baseURL    string                 // Base URL for API calls
-	apiKey     string                 // API key for authentication
-	httpClient *http.Client           // HTTP client for making requests
-	allData    []BrandmeisterRepeater // Cache of all repeater data
-	lastUpdate time.Time              // When we last fetched data
-	cacheValid bool                   // Whether our cache is still valid
+	baseURL        string                 // Base URL for API calls
+	endpoint       string                 // Device list path from the config, empty probes the known ones
+	apiKey         string                 // API key for authentication
+	httpClient     *http.Client           // HTTP client for making requests
+	allData        []BrandmeisterRepeater // Cache of all repeater data
+	lastUpdate     time.Time              // When we last fetched data
+	cacheValid     bool                   // Whether our cache is still valid
+	cacheTime      time.Duration          // How long in-memory data stays valid
+	startupRefresh time.Duration          // How old the file cache can be before refetching
+
+	revalidate *revalidation[BrandmeisterRepeater] // Background refresh of expired data, see ensureData
 }
 
 // BrandmeisterRepeater represents a single repeater/hotspot in the Brandmeister network
@@ -43,17 +57,14 @@ type BrandmeisterRepeater struct {
 	Firmware    string  `json:"firmware"`        // Firmware version (note: "firmware" not "software")
 	Website     string  `json:"website"`         // Website URL
 	PEP         int     `json:"pep"`             // Power in watts
-	AGL         int     `json:"agl"`             // Height above ground level
+	AGL         int     `json:"agl"`             // Antenna height above ground in meters (the device's configured Height)
 	LastMaster  int     `json:"lastKnownMaster"` // Last known master ID
 	Description string  `json:"description"`     // HTML description
 }
 
 // getCacheFile returns the path to the cache file
 func (c *BrandmeisterClient) getCacheFile() string {
-	// Use a cache directory in the user's cache folder or temp
-	cacheDir := filepath.Join(os.TempDir(), "digiLogRT", "cache")
-	os.MkdirAll(cacheDir, 0755) // Create directory if it doesn't exist
-	return filepath.Join(cacheDir, "brandmeister_repeaters.json")
+	return cache.Path(cache.BrandmeisterCacheFile)
 }
 
 // CheckCacheAge returns whether cache needs refresh and current age
@@ -61,23 +72,25 @@ func (c *BrandmeisterClient) CheckCacheAge() (bool, time.Duration) {
 	cacheFile := c.getCacheFile()
 
 	// Check if cache file exists
-	info, err := os.Stat(cacheFile)
+	fetched, err := cache.FetchedAt(cacheFile)
 	if err != nil {
 		// Cache doesn't exist, needs refresh
 		return true, 0
 	}
 
-	age := time.Since(info.ModTime())
-	maxAge := 24 * time.Hour // Brandmeister cache valid for 24 hours
+	age := time.Since(fetched)
 
-	return age > maxAge, age
+	return age > c.startupRefresh, age
 }
 
 // RefreshCache forces a cache refresh
 func (c *BrandmeisterClient) RefreshCache() error {
+	if IsOffline() {
+		return refreshRefused("brandmeister")
+	}
 	// Simply delete the cache file, next GetAllRepeaters call will refresh
 	cacheFile := c.getCacheFile()
-	if err := os.Remove(cacheFile); err != nil && !os.IsNotExist(err) {
+	if err := cache.Remove(cacheFile); err != nil {
 		return fmt.Errorf("failed to remove cache file: %v", err)
 	}
 
@@ -94,73 +107,128 @@ type BrandmeisterResponse struct {
 	Repeaters []BrandmeisterRepeater `json:"repeaters"` // Array of repeater data
 }
 
+// brandmeisterBaseURL is the Brandmeister API server when apis.brandmeister_base_url is unset
+const brandmeisterBaseURL = "https://api.brandmeister.network"
+
+// brandmeisterEndpoints are the device list paths tried in turn when apis.brandmeister_endpoint is unset,
+// the current API first
+var brandmeisterEndpoints = []string{
+	"/v2/device",
+	"/v1/device",
+	"/device",
+}
+
 // NewBrandmeisterClient creates a new Brandmeister API client
-// This is the constructor function - it sets up the client with the provided API key
-func NewBrandmeisterClient(apiKey string) *BrandmeisterClient {
-	return &BrandmeisterClient{
-		baseURL: "https://api.brandmeister.network", // Remove /v2 from base URL
-		apiKey:  apiKey,                             // API key from configuration
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second, // 30 second timeout for API calls
-		},
-		allData:    make([]BrandmeisterRepeater, 0), // Initialize empty slice
-		cacheValid: false,                           // Cache starts invalid
-	}
+// This is the constructor function - it sets up the client with the API key and endpoint overrides from
+// the apis config section. Cache durations come from the caching.brandmeister config section (24h when unset)
+func NewBrandmeisterClient(apis config.APISettings, cache config.CacheSettings) *BrandmeisterClient {
+	c := &BrandmeisterClient{
+		baseURL:        brandmeisterBaseURL,
+		endpoint:       apis.BrandmeisterEndpoint,
+		apiKey:         apis.BrandmeisterKey,            // API key from configuration
+		httpClient:     newHTTPClient(30 * time.Second), // 30 second timeout for API calls
+		allData:        make([]BrandmeisterRepeater, 0), // Initialize empty slice
+		cacheValid:     false,                           // Cache starts invalid
+		cacheTime:      cache.CacheTime(24 * time.Hour), // Brandmeister data changes less frequently
+		startupRefresh: cache.StartupRefreshTime(24 * time.Hour),
+		revalidate:     newRevalidation[BrandmeisterRepeater](),
+	}
+	if apis.BrandmeisterBaseURL != "" {
+		c.SetBaseURL(apis.BrandmeisterBaseURL)
+	}
+	return c
+}
+
+// SetBaseURL points the client at another server, such as a mirror or a local test server
+func (c *BrandmeisterClient) SetBaseURL(baseURL string) {
+	c.baseURL = strings.TrimRight(baseURL, "/")
 }
 
 // Initialize sets up the client and loads initial data if needed
 // This checks if we need to refresh our cache based on age
 func (c *BrandmeisterClient) Initialize() error {
-	// Check if we need to refresh cache (if it's older than 24 hours)
+	// Check if we need to refresh cache (if it's older than the configured cache time)
 	cacheAge := time.Since(c.lastUpdate)
-	cacheMaxAge := 24 * time.Hour // Brandmeister data changes less frequently
 
-	if !c.cacheValid || cacheAge > cacheMaxAge {
-		fmt.Println("Cache is stale (>24h old), refreshing on startup...")
-		return c.refreshData()
+	if !c.cacheValid || cacheAge > c.cacheTime {
+		fmt.Printf("Cache is stale (>%v old), refreshing on startup...\n", c.cacheTime)
+		return c.refreshData(context.Background())
 	}
 
 	fmt.Printf("Cache is valid (age: %v), using cached data\n", cacheAge)
 	return nil
 }
 
-// refreshData fetches fresh data from the Brandmeister API
-func (c *BrandmeisterClient) refreshData() error {
-	fmt.Println("Fetching repeater data from Brandmeister.network...")
+// refreshData fetches fresh data from the Brandmeister API, ctx cuts short the fetch and its retries
+func (c *BrandmeisterClient) refreshData(ctx context.Context) error {
+	if IsOffline() {
+		return c.useCacheOffline()
+	}
 
-	// Try different endpoints
-	endpoints := []string{
-		"/v2/device",
-		"/v1/device",
-		"/device",
+	repeaters, err := c.download(ctx)
+	if err != nil {
+		return err
 	}
 
-	for _, endpoint := range endpoints {
-		url := c.baseURL + endpoint
-		fmt.Printf("Trying endpoint: %s\n", url)
+	c.allData = repeaters
+	c.lastUpdate = time.Now()
+	c.cacheValid = true
+	return nil
+}
+
+// download tries each device list endpoint in turn, or just the configured one, concurrent callers on any
+// Brandmeister client share one fetch, which runs under the first caller's ctx
+func (c *BrandmeisterClient) download(ctx context.Context) ([]BrandmeisterRepeater, error) {
+	return ShareFetch("brandmeister "+c.baseURL+c.endpoint, func() (repeaters []BrandmeisterRepeater, err error) {
+		fmt.Println("Fetching repeater data from Brandmeister.network...")
+		start := time.Now()
+		defer func() { metrics.ObserveFetch("brandmeister", start, err) }()
+
+		endpoints := brandmeisterEndpoints
+		if c.endpoint != "" {
+			endpoints = []string{c.endpoint}
+		}
+
+		var firstErr error
+		for _, endpoint := range endpoints {
+			url := c.baseURL + endpoint
+			fmt.Printf("Trying endpoint: %s\n", url)
 
-		if err := c.tryEndpoint(url); err == nil {
-			fmt.Printf("✓ SUCCESS with endpoint: %s\n", endpoint)
-			return nil
-		} else {
+			repeaters, err := c.tryEndpoint(ctx, url)
+			if err == nil {
+				fmt.Printf("✓ SUCCESS with endpoint: %s\n", endpoint)
+				return repeaters, nil
+			}
 			fmt.Printf("✗ Failed with endpoint %s: %v\n", endpoint, err)
+			if firstErr == nil {
+				firstErr = err
+			}
 		}
-	}
 
-	return fmt.Errorf("all endpoints failed")
+		// The first endpoint is the current API (or the configured one), the fallbacks mostly just 404
+		return nil, fmt.Errorf("all endpoints failed: %w", firstErr)
+	})
 }
 
 // GetAllRepeaters returns all cached repeater data with file caching
 func (c *BrandmeisterClient) GetAllRepeaters() ([]BrandmeisterRepeater, error) {
+	return c.GetAllRepeatersContext(context.Background())
+}
+
+// GetAllRepeatersContext is GetAllRepeaters, giving up on a download and its retries when ctx is cancelled
+func (c *BrandmeisterClient) GetAllRepeatersContext(ctx context.Context) ([]BrandmeisterRepeater, error) {
 	// Try to load from file cache first
 	if data, err := c.loadFromCache(); err == nil {
+		metrics.CacheHits.Inc("brandmeister")
 		c.allData = data
+		c.lastUpdate = cacheFileUpdated(cache.SourceBrandmeister)
 		c.cacheValid = true
 		return data, nil
 	}
+	metrics.CacheMisses.Inc("brandmeister")
 
 	// If file cache miss, fetch from API
-	if err := c.refreshData(); err != nil {
+	if err := c.refreshData(ctx); err != nil {
 		return nil, err
 	}
 
@@ -177,49 +245,62 @@ func (c *BrandmeisterClient) loadFromCache() ([]BrandmeisterRepeater, error) {
 	cacheFile := c.getCacheFile()
 
 	// Check if file exists and is fresh enough
-	info, err := os.Stat(cacheFile)
+	fetched, err := cache.FetchedAt(cacheFile)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check cache age
-	age := time.Since(info.ModTime())
-	if age > 24*time.Hour {
+	// Check cache age (offline, old data beats none)
+	age := time.Since(fetched)
+	if age > c.startupRefresh && !IsOffline() {
 		return nil, fmt.Errorf("cache too old: %v", age)
 	}
 
 	// Read and parse cache file
-	data, err := os.ReadFile(cacheFile)
-	if err != nil {
+	return cache.LoadCache[BrandmeisterRepeater](cacheFile)
+}
+
+// fetchAndSave fetches fresh data and writes it to the file cache, it's what a background refresh runs
+func (c *BrandmeisterClient) fetchAndSave() ([]BrandmeisterRepeater, error) {
+	if err := c.refreshData(context.Background()); err != nil {
 		return nil, err
 	}
-
-	var repeaters []BrandmeisterRepeater
-	err = json.Unmarshal(data, &repeaters)
-	return repeaters, err
+	if err := c.saveToCache(c.allData); err != nil {
+		log.Printf("Warning: Failed to save cache to file: %v", err)
+	}
+	return c.allData, nil
 }
 
-// saveToCache saves data to file cache
-func (c *BrandmeisterClient) saveToCache(data []BrandmeisterRepeater) error {
-	cacheFile := c.getCacheFile()
-
-	// Ensure cache directory exists
-	if err := os.MkdirAll(filepath.Dir(cacheFile), 0755); err != nil {
-		return err
+// useRevalidated swaps in the data of a finished background refresh
+func (c *BrandmeisterClient) useRevalidated() {
+	if data, fetched, ok := c.revalidate.take(); ok {
+		c.allData = data
+		c.lastUpdate = fetched
+		c.cacheValid = true
 	}
+}
 
-	// Marshal to JSON
-	jsonData, err := json.MarshalIndent(data, "", "  ")
+// useCacheOffline loads the file cache whatever its age, in offline mode it's all the data there is
+func (c *BrandmeisterClient) useCacheOffline() error {
+	data, err := c.loadFromCache()
 	if err != nil {
-		return err
+		return noCachedData("brandmeister")
 	}
 
-	// Write to file
-	return os.WriteFile(cacheFile, jsonData, 0644)
+	c.allData = data
+	c.lastUpdate = cacheFileUpdated(cache.SourceBrandmeister)
+	c.cacheValid = true
+	return nil
+}
+
+// saveToCache saves data to file cache
+func (c *BrandmeisterClient) saveToCache(data []BrandmeisterRepeater) error {
+	return cache.SaveCacheFrom(c.getCacheFile(), c.baseURL+c.endpoint, c.lastUpdate, data)
 }
 
 // SearchRepeaters searches for repeaters by callsign, city, or state
-func (c *BrandmeisterClient) SearchRepeaters(query string) (*BrandmeisterResponse, error) {
+// With onlineOnly set, repeaters that aren't currently online (see IsOnline) are left out
+func (c *BrandmeisterClient) SearchRepeaters(query string, onlineOnly bool) (*BrandmeisterResponse, error) {
 	// Make sure we have data
 	if err := c.ensureData(); err != nil {
 		return nil, err
@@ -231,6 +312,10 @@ func (c *BrandmeisterClient) SearchRepeaters(query string) (*BrandmeisterRespons
 
 	// Search through all repeaters
 	for _, repeater := range c.allData {
+		if onlineOnly && !repeater.IsOnline() {
+			continue
+		}
+
 		// Check if query matches callsign, city, state, or country
 		if strings.Contains(strings.ToLower(repeater.Callsign), query) ||
 			strings.Contains(strings.ToLower(repeater.City), query) ||
@@ -246,6 +331,21 @@ func (c *BrandmeisterClient) SearchRepeaters(query string) (*BrandmeisterRespons
 	}, nil
 }
 
+// GetOnlineRepeaters returns every repeater that is currently online
+func (c *BrandmeisterClient) GetOnlineRepeaters() ([]BrandmeisterRepeater, error) {
+	if err := c.ensureData(); err != nil {
+		return nil, err
+	}
+
+	var online []BrandmeisterRepeater
+	for _, repeater := range c.allData {
+		if repeater.IsOnline() {
+			online = append(online, repeater)
+		}
+	}
+	return online, nil
+}
+
 // GetRepeater looks up a specific repeater by ID
 func (c *BrandmeisterClient) GetRepeater(id int) (*BrandmeisterRepeater, error) {
 	// Make sure we have data
@@ -264,25 +364,35 @@ func (c *BrandmeisterClient) GetRepeater(id int) (*BrandmeisterRepeater, error)
 }
 
 // GetCacheStatus returns information about the current cache
+// Until the client has fetched data it describes the cache file instead, last_update is zero when there is none
 func (c *BrandmeisterClient) GetCacheStatus() map[string]interface{} {
-	cacheAge := time.Since(c.lastUpdate)
-	needsRefresh := cacheAge > (24 * time.Hour)
+	lastUpdate := c.lastUpdate
+	if lastUpdate.IsZero() {
+		lastUpdate = cacheFileUpdated(cache.SourceBrandmeister)
+	}
+	cacheAge := time.Since(lastUpdate)
+	needsRefresh := cacheAge > c.cacheTime
 
 	return map[string]interface{}{
 		"count":         len(c.allData),
-		"last_update":   c.lastUpdate,
+		"last_update":   lastUpdate,
 		"age":           cacheAge,
 		"cache_valid":   c.cacheValid,
 		"needs_refresh": needsRefresh,
+		"stale":         len(c.allData) > 0 && needsRefresh, // Searches are answered from expired data
+		"revalidating":  c.revalidate.inProgress(),
 	}
 }
 
 // ForceRefresh forces a refresh of the cache regardless of age
 func (c *BrandmeisterClient) ForceRefresh() error {
+	if IsOffline() {
+		return refreshRefused("brandmeister")
+	}
 	fmt.Println("Force refreshing Brandmeister.network data...")
 	oldCount := len(c.allData)
 
-	if err := c.refreshData(); err != nil {
+	if err := c.refreshData(context.Background()); err != nil {
 		return err
 	}
 
@@ -293,13 +403,27 @@ func (c *BrandmeisterClient) ForceRefresh() error {
 		fmt.Printf("Repeater data refreshed: %d repeaters (no count change)\n", newCount)
 	}
 
+	// Keep the file cache in step, so the next start doesn't see the old data as stale
+	if err := c.saveToCache(c.allData); err != nil {
+		log.Printf("Warning: Failed to save cache to file: %v", err)
+	}
 	return nil
 }
 
 // ensureData makes sure we have valid data, refreshing if necessary
+// Expired data is still used while a background refresh fetches the new data for a later call
 func (c *BrandmeisterClient) ensureData() error {
+	c.useRevalidated()
+
 	if !c.cacheValid || len(c.allData) == 0 {
-		return c.refreshData()
+		return c.refreshData(context.Background())
+	}
+
+	if age := time.Since(c.lastUpdate); age > c.cacheTime && !IsOffline() {
+		clone := *c // The refresh runs on a copy, so it can't touch the data in use
+		if c.revalidate.start("brandmeister", clone.fetchAndSave) {
+			fmt.Printf("Cache expired (%v old), refreshing in the background...\n", age.Round(time.Minute))
+		}
 	}
 	return nil
 }
@@ -351,6 +475,8 @@ func (r *BrandmeisterRepeater) GetRxFrequencyFloat() (float64, error) {
 
 // IsOnline returns whether the repeater is currently online
 // Based on Brandmeister status codes (this might need adjustment)
+// This is the only place the status code is interpreted: the online filters and
+// the database's online_status column all go through it
 func (r *BrandmeisterRepeater) IsOnline() bool {
 	// Status 1 might mean online, but we need to check the API docs
 	// For now, let's assume status > 0 means some level of activity
@@ -368,58 +494,513 @@ func (r *BrandmeisterRepeater) GetSlotInfo() string {
 	return "Dual Slot DMR"
 }
 
+// GetPowerWatts returns the transmit power in watts, ok is false when it isn't reported
+func (r *BrandmeisterRepeater) GetPowerWatts() (watts int, ok bool) {
+	return r.PEP, r.PEP > 0
+}
+
+// GetAntennaHeightMeters returns the antenna height above ground, ok is false when it isn't reported
+// Brandmeister passes on the Height the device sends at login, which MMDVM-style configs give in meters
+func (r *BrandmeisterRepeater) GetAntennaHeightMeters() (meters int, ok bool) {
+	return r.AGL, r.AGL > 0
+}
+
+// Brandmeister device types, the API lists personal hotspots alongside repeaters
+const (
+	DeviceTypeRepeater = "repeater"
+	DeviceTypeHotspot  = "hotspot"
+)
+
+// hotspotHardware are lowercased parts of the hardware names hotspot boards and apps report, e.g.
+// "MMDVM_MMDVM_HS_Hat", "MMDVM_ZUMspot" or "openSPOT4 Pro v1.0". Duplex repeater controllers report neither
+var hotspotHardware = []string{"_hs", "-hs", "hotspot", "zumspot", "jumbospot", "openspot", "dvmega",
+	"skybridge", "bluedv", "dv4mini", "_dmo"}
+
+// IsHotspot reports whether the device looks like a personal hotspot rather than a repeater
+// The API has no device type field, so this needs a positive sign of one: the same transmit and receive
+// frequency, or hotspot hardware listing no power or antenna height. Missing power and antenna height alone
+// aren't enough, plenty of real repeaters don't list them, and a board with an amplifier and a mast is a repeater
+func (r *BrandmeisterRepeater) IsHotspot() bool {
+	tx, txErr := r.GetTxFrequencyFloat()
+	rx, rxErr := r.GetRxFrequencyFloat()
+	if txErr == nil && rxErr == nil && tx > 0 && tx == rx {
+		return true
+	}
+	if r.PEP != 0 || r.AGL != 0 {
+		return false
+	}
+
+	hardware := strings.ToLower(r.Hardware)
+	for _, marker := range hotspotHardware {
+		if strings.Contains(hardware, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeviceType returns DeviceTypeHotspot or DeviceTypeRepeater
+func (r *BrandmeisterRepeater) DeviceType() string {
+	if r.IsHotspot() {
+		return DeviceTypeHotspot
+	}
+	return DeviceTypeRepeater
+}
+
 // GetPowerInfo returns power and antenna information
 func (r *BrandmeisterRepeater) GetPowerInfo() string {
-	if r.PEP > 0 && r.AGL > 0 {
-		return fmt.Sprintf("%d watts, %d ft AGL", r.PEP, r.AGL)
-	} else if r.PEP > 0 {
-		return fmt.Sprintf("%d watts", r.PEP)
-	} else if r.AGL > 0 {
-		return fmt.Sprintf("%d ft AGL", r.AGL)
+	watts, hasPower := r.GetPowerWatts()
+	meters, hasHeight := r.GetAntennaHeightMeters()
+	if hasPower && hasHeight {
+		return fmt.Sprintf("%d watts, %d m AGL", watts, meters)
+	} else if hasPower {
+		return fmt.Sprintf("%d watts", watts)
+	} else if hasHeight {
+		return fmt.Sprintf("%d m AGL", meters)
 	}
 	return "Power info not available"
 }
 
-// tryEndpoint attempts to fetch data from a specific endpoint
-func (c *BrandmeisterClient) tryEndpoint(url string) error {
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
+// TestConnection checks that the Brandmeister API is reachable and returns data
+func (c *BrandmeisterClient) TestConnection() error {
+	if err := c.refreshData(context.Background()); err != nil {
 		return err
 	}
+	if len(c.allData) == 0 {
+		return fmt.Errorf("no repeater data received from API")
+	}
+	return nil
+}
+
+// Device list paging limits
+const (
+	brandmeisterMaxPages    = 500             // Stop following next links after this many pages
+	brandmeisterPageRetries = 3               // Attempts per page before the fetch fails
+	brandmeisterRetryDelay  = 2 * time.Second // Wait before the first retry, doubled each time
+)
+
+// brandmeisterPageInfo is the paging metadata a wrapped device list may carry
+type brandmeisterPageInfo struct {
+	Total *int   `json:"total"` // Devices across all pages
+	Next  string `json:"next"`  // URL of the following page
+}
+
+// tryEndpoint attempts to fetch the device list from a specific endpoint
+// The device list is served in one response today, but pages are followed if the API
+// starts paginating (a Link rel="next" header or a "next" URL in the body), and the result
+// is rejected if it falls short of a total the API reports
+func (c *BrandmeisterClient) tryEndpoint(ctx context.Context, url string) ([]BrandmeisterRepeater, error) {
+	var repeaters []BrandmeisterRepeater
+	total := -1
+
+	for page, next := 1, url; next != ""; page++ {
+		if page > brandmeisterMaxPages {
+			return nil, fmt.Errorf("gave up after %d pages, the API kept returning next links", brandmeisterMaxPages)
+		}
+
+		body, header, err := c.getPage(ctx, next)
+		if err != nil {
+			if page > 1 {
+				return nil, fmt.Errorf("page %d: %w", page, err)
+			}
+			return nil, err
+		}
+		if page == 1 {
+			fmt.Printf("Raw response (first 500 chars): \n%s\n", string(body[:min(500, len(body))]))
+		}
+
+		pageRepeaters, err := decodeList[BrandmeisterRepeater](body, "repeaters")
+		if err != nil {
+			return nil, decodeError("brandmeister", "JSON decode error on page %d: %w", page, err)
+		}
+		repeaters = append(repeaters, pageRepeaters...)
+
+		// Bare arrays carry no paging information, only the wrapped form can
+		var info brandmeisterPageInfo
+		if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '{' {
+			json.Unmarshal(body, &info)
+		}
+		if info.Total != nil {
+			total = *info.Total
+		}
+
+		following := nextPageLink(header.Get("Link"))
+		if following == "" {
+			following = info.Next
+		}
+		if following == "" {
+			break
+		}
+		if next, err = resolveURL(next, following); err != nil {
+			return nil, fmt.Errorf("bad next page link %q: %v", following, err)
+		}
+		if len(pageRepeaters) == 0 {
+			break // An empty page with a next link would loop forever
+		}
+		fmt.Printf("  Page %d: %d repeaters so far...\n", page, len(repeaters))
+	}
 
-	if c.apiKey != "" {
-		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	if total >= 0 && len(repeaters) < total {
+		return nil, decodeError("brandmeister", "partial result: got %d of %d repeaters", len(repeaters), total)
+	}
+
+	fmt.Printf("Successfully loaded %d repeaters from Brandmeister.network using %s\n", len(repeaters), url)
+	return repeaters, nil
+}
+
+// getPage fetches one page, retrying network errors, rate limiting and server errors until ctx is cancelled
+func (c *BrandmeisterClient) getPage(ctx context.Context, url string) ([]byte, http.Header, error) {
+	delay := brandmeisterRetryDelay
+	var lastErr error
+
+	for attempt := 1; attempt <= brandmeisterPageRetries; attempt++ {
+		if attempt > 1 {
+			fmt.Printf("  Retrying in %v (attempt %d of %d): %v\n", delay, attempt, brandmeisterPageRetries, lastErr)
+			select {
+			case <-ctx.Done():
+				return nil, nil, lastErr
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		c.setAuth(req)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			lastErr = networkError("brandmeister", "failed to make request: %w", err)
+			if errors.Is(err, ErrOffline) {
+				break // Retrying can't help
+			}
+			continue
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		fmt.Printf("Response status for %s: %d\n", url, resp.StatusCode)
+
+		switch {
+		case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+			lastErr = statusError("brandmeister", resp.StatusCode, "status %d: %s", resp.StatusCode, string(body))
+			continue
+		case resp.StatusCode != http.StatusOK:
+			return nil, nil, statusError("brandmeister", resp.StatusCode, "status %d: %s", resp.StatusCode, string(body))
+		case err != nil:
+			lastErr = networkError("brandmeister", "failed to read response: %w", err) // Connection dropped mid-body
+			continue
+		}
+		return body, resp.Header, nil
+	}
+
+	return nil, nil, lastErr
+}
+
+// nextPageLink returns the rel="next" target of a Link header, or "" when there isn't one
+func nextPageLink(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		target := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		for _, param := range parts[1:] {
+			param = strings.ReplaceAll(strings.TrimSpace(param), " ", "")
+			if param == `rel="next"` || param == "rel=next" {
+				return strings.Trim(target, "<>")
+			}
+		}
+	}
+	return ""
+}
+
+// resolveURL resolves a possibly relative link against the page it came from
+func resolveURL(base, ref string) (string, error) {
+	baseURL, err := neturl.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	refURL, err := neturl.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+	return baseURL.ResolveReference(refURL).String(), nil
+}
+
+// BrandmeisterActivity is one "last heard" transmission on the Brandmeister network
+type BrandmeisterActivity struct {
+	SourceID         int    `json:"SourceID"`        // DMR ID of the transmitting station
+	Callsign         string `json:"SourceCall"`      // Callsign of the transmitting station
+	Name             string `json:"SourceName"`      // Operator name, when registered
+	TalkgroupID      int    `json:"DestinationID"`   // Talkgroup (or private call) destination
+	TalkgroupName    string `json:"DestinationName"` // Talkgroup name, when known
+	RepeaterID       int    `json:"ContextID"`       // Repeater/hotspot the call came in on
+	RepeaterCallsign string `json:"LinkCall"`        // Callsign of that repeater/hotspot
+	Slot             int    `json:"Slot"`            // Timeslot (1 or 2)
+	Start            int64  `json:"Start"`           // Unix time the transmission started
+	Stop             int64  `json:"Stop"`            // Unix time it ended (0 while still active)
+}
+
+// StartTime returns when the transmission started
+func (a *BrandmeisterActivity) StartTime() time.Time {
+	return time.Unix(a.Start, 0)
+}
+
+// IsActive returns whether the station is still transmitting
+func (a *BrandmeisterActivity) IsActive() bool {
+	return a.Stop == 0
+}
+
+const (
+	brandmeisterLastHeardEndpoint = "/v2/lastheard"        // One-shot JSON list, when available
+	brandmeisterLastHeardStream   = "/v2/lastheard/stream" // Server-sent event feed
+	brandmeisterLastHeardPoll     = 10 * time.Second       // How long to read the stream before giving up
+)
+
+// GetLastHeard returns up to limit of the most recent transmissions on the network, newest first
+// The one-shot JSON endpoint is tried first. If it isn't available the live event
+// stream is read until limit events arrive or the poll window ends
+func (c *BrandmeisterClient) GetLastHeard(limit int) ([]BrandmeisterActivity, error) {
+	if limit <= 0 {
+		limit = 50
 	}
 
+	activity, err := c.fetchLastHeard(limit)
+	if err != nil {
+		log.Printf("Brandmeister last heard endpoint failed (%v), polling stream instead", err)
+		activity, err = c.pollLastHeardStream(limit, brandmeisterLastHeardPoll)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Brandmeister last heard: %w", err)
+		}
+	}
+
+	sort.SliceStable(activity, func(i, j int) bool {
+		return activity[i].Start > activity[j].Start
+	})
+	if len(activity) > limit {
+		activity = activity[:limit]
+	}
+
+	return activity, nil
+}
+
+// fetchLastHeard requests the last heard list as a single JSON document
+func (c *BrandmeisterClient) fetchLastHeard(limit int) ([]BrandmeisterActivity, error) {
+	url := fmt.Sprintf("%s%s?limit=%d", c.baseURL, brandmeisterLastHeardEndpoint, limit)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.setAuth(req)
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return err
+		return nil, networkError("brandmeister", "failed to make request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	fmt.Printf("Response status for %s: %d\n", url, resp.StatusCode)
+	if resp.StatusCode != http.StatusOK {
+		return nil, statusError("brandmeister", resp.StatusCode, "status %d", resp.StatusCode)
+	}
+
+	var activity []BrandmeisterActivity
+	if err := json.NewDecoder(resp.Body).Decode(&activity); err != nil {
+		return nil, decodeError("brandmeister", "JSON decode error: %w", err)
+	}
+
+	return activity, nil
+}
+
+// pollLastHeardStream reads "data:" events from the live feed until limit events
+// arrive or the timeout passes, returning whatever was collected
+func (c *BrandmeisterClient) pollLastHeardStream(limit int, timeout time.Duration) ([]BrandmeisterActivity, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+brandmeisterLastHeardStream, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	c.setAuth(req)
+
+	// The shared client's timeout would cut the stream off, rely on the context instead
+	streamClient := &http.Client{Transport: c.httpClient.Transport}
+	resp, err := streamClient.Do(req)
+	if err != nil {
+		return nil, networkError("brandmeister", "failed to open stream: %w", err)
+	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
+		return nil, statusError("brandmeister", resp.StatusCode, "stream status %d", resp.StatusCode)
+	}
+
+	var activity []BrandmeisterActivity
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() && len(activity) < limit {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue // Skip comments, event names and keep-alives
+		}
+
+		var a BrandmeisterActivity
+		if err := json.Unmarshal([]byte(strings.TrimSpace(line[len("data:"):])), &a); err != nil {
+			continue // Ignore events that aren't last heard records
+		}
+		activity = append(activity, a)
+	}
+
+	// Running out of time is the normal way a bounded poll ends
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return activity, err
+	}
+	if len(activity) == 0 {
+		return nil, fmt.Errorf("no activity received within %v", timeout)
+	}
+
+	return activity, nil
+}
+
+// setAuth adds the API key to a request when one is configured
+func (c *BrandmeisterClient) setAuth(req *http.Request) {
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+}
+
+// BrandmeisterStaticTalkgroup is a talkgroup permanently linked to a device timeslot
+// The API has returned these numbers both as JSON numbers and strings, json.Number accepts either
+type BrandmeisterStaticTalkgroup struct {
+	Talkgroup  json.Number `json:"talkgroup"`  // DMR talkgroup number
+	Slot       json.Number `json:"slot"`       // Timeslot (1 or 2, 0 for simplex hotspots)
+	RepeaterID json.Number `json:"repeaterid"` // Device the link belongs to
+}
+
+// TalkgroupID returns the talkgroup number as an int
+func (s *BrandmeisterStaticTalkgroup) TalkgroupID() int {
+	id, _ := s.Talkgroup.Int64()
+	return int(id)
+}
+
+// Timeslot returns the timeslot as an int
+func (s *BrandmeisterStaticTalkgroup) Timeslot() int {
+	slot, _ := s.Slot.Int64()
+	return int(slot)
+}
+
+// GetStaticTalkgroups returns the static talkgroups configured on one repeater/hotspot
+func (c *BrandmeisterClient) GetStaticTalkgroups(deviceID int) ([]BrandmeisterStaticTalkgroup, error) {
+	var talkgroups []BrandmeisterStaticTalkgroup
+	if err := c.getDevice(deviceID, "talkgroup", &talkgroups); err != nil {
+		return nil, err
+	}
+	return talkgroups, nil
+}
+
+// BrandmeisterTGAssignment is a talkgroup on one repeater timeslot. Static ones are always linked, dynamic
+// ones were linked on demand by someone keying up and drop again after a while without traffic
+type BrandmeisterTGAssignment struct {
+	Talkgroup int  `json:"talkgroup"`
+	Timeslot  int  `json:"timeslot"` // 1 or 2, 0 for simplex hotspots
+	Static    bool `json:"static"`
+}
+
+// brandmeisterProfile is the part of a device's profile listing its talkgroup subscriptions
+type brandmeisterProfile struct {
+	StaticSubscriptions  []BrandmeisterStaticTalkgroup `json:"staticSubscriptions"`
+	DynamicSubscriptions []BrandmeisterStaticTalkgroup `json:"dynamicSubscriptions"`
+}
+
+// GetRepeaterTalkgroups returns the static and dynamic talkgroups of one repeater/hotspot, static first
+func (c *BrandmeisterClient) GetRepeaterTalkgroups(repeaterID int) ([]BrandmeisterTGAssignment, error) {
+	var profile brandmeisterProfile
+	if err := c.getDevice(repeaterID, "profile", &profile); err != nil {
+		return nil, err
+	}
+
+	var assignments []BrandmeisterTGAssignment
+	for _, tg := range profile.StaticSubscriptions {
+		assignments = append(assignments, BrandmeisterTGAssignment{Talkgroup: tg.TalkgroupID(), Timeslot: tg.Timeslot(), Static: true})
 	}
+	for _, tg := range profile.DynamicSubscriptions {
+		assignments = append(assignments, BrandmeisterTGAssignment{Talkgroup: tg.TalkgroupID(), Timeslot: tg.Timeslot()})
+	}
+	return assignments, nil
+}
 
-	body, err := io.ReadAll(resp.Body)
+// getDevice decodes one of a device's /v2/device/{id}/... endpoints into v
+func (c *BrandmeisterClient) getDevice(deviceID int, endpoint string, v interface{}) error {
+	url := fmt.Sprintf("%s/v2/device/%d/%s", c.baseURL, deviceID, endpoint)
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return err
 	}
+	c.setAuth(req)
 
-	fmt.Printf("Raw response (first 500 chars): \n%s\n", string(body[:min(500, len(body))]))
-
-	var repeaters []BrandmeisterRepeater
-	if err := json.Unmarshal(body, &repeaters); err != nil {
-		return fmt.Errorf("JSON decode error: %v", err)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return networkError("brandmeister", "failed to make request: %w", err)
 	}
+	defer resp.Body.Close()
 
-	c.allData = repeaters
-	c.lastUpdate = time.Now()
-	c.cacheValid = true
+	if resp.StatusCode != http.StatusOK {
+		return statusError("brandmeister", resp.StatusCode, "status %d for device %d", resp.StatusCode, deviceID)
+	}
 
-	fmt.Printf("Successfully loaded %d repeaters from Brandmeister.network using %s\n", len(repeaters), url)
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return decodeError("brandmeister", "JSON decode error: %w", err)
+	}
 	return nil
 }
+
+// GetStaticTalkgroupsForDevices fetches static talkgroups for many devices using a few parallel workers
+// Devices that fail are logged and left out of the result so one bad device doesn't stop the rest
+func (c *BrandmeisterClient) GetStaticTalkgroupsForDevices(deviceIDs []int, workers int) map[int][]BrandmeisterStaticTalkgroup {
+	return forEachDevice(deviceIDs, workers, "static talkgroups", c.GetStaticTalkgroups)
+}
+
+// GetRepeaterTalkgroupsForDevices is GetRepeaterTalkgroups for many devices, like GetStaticTalkgroupsForDevices
+func (c *BrandmeisterClient) GetRepeaterTalkgroupsForDevices(deviceIDs []int, workers int) map[int][]BrandmeisterTGAssignment {
+	return forEachDevice(deviceIDs, workers, "talkgroups", c.GetRepeaterTalkgroups)
+}
+
+// forEachDevice calls fetch for every device on a few parallel workers, logging and skipping failures
+func forEachDevice[T any](deviceIDs []int, workers int, what string, fetch func(int) (T, error)) map[int]T {
+	if workers <= 0 {
+		workers = 4
+	}
+
+	results := make(map[int]T)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	ids := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				result, err := fetch(id)
+				if err != nil {
+					log.Printf("Failed to get %s for device %d: %v", what, id, err)
+					continue
+				}
+				mu.Lock()
+				results[id] = result
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, id := range deviceIDs {
+		ids <- id
+	}
+	close(ids)
+	wg.Wait()
+
+	return results
+}
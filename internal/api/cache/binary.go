@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// Cache file formats for SetFormat. JSON is the default, it can be read and diffed by hand
+const (
+	FormatJSON = "json"
+	FormatGob  = "gob" // Smaller and faster to decode, see cmd/bench_cache
+)
+
+// binaryMagic starts every binary cache file, LoadCache tells the formats apart by it
+// The file names keep their .json extension either way, so every tool finds the cache whatever its format
+var binaryMagic = []byte("digiLogRT gob cache v1\n")
+
+// format is the one SaveCache writes, "" meaning FormatJSON
+var format atomic.Value
+
+// SetFormat chooses the format SaveCache writes, FormatJSON or FormatGob ("" is FormatJSON)
+// Files already saved in the other format still load, and are rewritten in this one on their next refresh
+func SetFormat(name string) error {
+	switch name {
+	case "", FormatJSON, FormatGob:
+		format.Store(name)
+		return nil
+	}
+	return fmt.Errorf("unknown cache format %q (use %q or %q)", name, FormatJSON, FormatGob)
+}
+
+// Format returns the format SaveCache writes
+func Format() string {
+	if name, _ := format.Load().(string); name != "" {
+		return name
+	}
+	return FormatJSON
+}
+
+// LoadCacheBinary reads a cache file written by SaveCacheBinary, LoadCache reads either format
+// A JSON file is refused but left alone, a binary one that doesn't decode is corrupt like with LoadCache
+func LoadCacheBinary[T any](filename string) ([]T, error) {
+	if format, err := FileFormat(filename); err != nil {
+		return nil, err
+	} else if format != FormatGob {
+		return nil, fmt.Errorf("%s is not a binary cache file", filename)
+	}
+	return loadCache(filename, decodeBinary[T])
+}
+
+// SaveCacheBinary writes a slice of T to a binary cache file, whatever the format set with SetFormat
+func SaveCacheBinary[T any](filename string, items []T) error {
+	data, err := encodeBinary(items)
+	if err != nil {
+		return err
+	}
+	return save(filename, data, len(items), "", time.Now())
+}
+
+// encodeBinary encodes items as the data of a binary cache file
+func encodeBinary[T any](items []T) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(binaryMagic)
+	if err := gob.NewEncoder(&buf).Encode(items); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FileFormat reports which format a cache file was saved in, FormatJSON or FormatGob
+func FileFormat(filename string) (string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	header := make([]byte, len(binaryMagic))
+	n, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+	if isBinary(header[:n]) {
+		return FormatGob, nil
+	}
+	return FormatJSON, nil
+}
+
+// isBinary reports whether cache file data was written by SaveCacheBinary
+func isBinary(data []byte) bool {
+	return bytes.HasPrefix(data, binaryMagic)
+}
+
+// decodeBinary decodes the data of a binary cache file
+func decodeBinary[T any](data []byte) ([]T, error) {
+	if !isBinary(data) {
+		return nil, fmt.Errorf("not a binary cache file")
+	}
+	var items []T
+	if err := gob.NewDecoder(bytes.NewReader(data[len(binaryMagic):])).Decode(&items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
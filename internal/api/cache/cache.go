@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Shared file cache helpers used by the API clients and the sync tools
+
+// Dir returns the directory holding the API cache files
+func Dir() string {
+	// Use a cache directory in the temp folder shared by all tools
+	return filepath.Join(os.TempDir(), "digiLogRT", "cache")
+}
+
+// Path returns the full path of a cache file, creating the cache directory if needed
+func Path(name string) string {
+	cacheDir := Dir()
+	os.MkdirAll(cacheDir, 0755) // Create directory if it doesn't exist
+	return filepath.Join(cacheDir, name)
+}
+
+// ErrCorrupt is wrapped by LoadCache errors for cache files that can't be decoded
+var ErrCorrupt = errors.New("corrupt cache file")
+
+// LoadCache reads a cache file into a slice of T, detecting whether it was saved as JSON or binary
+// A file that doesn't decode (e.g. truncated when a save was killed) is deleted so the
+// next fetch rebuilds it, and the returned error wraps ErrCorrupt
+func LoadCache[T any](filename string) ([]T, error) {
+	return loadCache(filename, func(data []byte) ([]T, error) {
+		if isBinary(data) {
+			return decodeBinary[T](data)
+		}
+		var items []T
+		err := json.Unmarshal(data, &items)
+		return items, err
+	})
+}
+
+// loadCache reads a cache file with decode, removing it when it doesn't decode
+func loadCache[T any](filename string, decode func([]byte) ([]T, error)) ([]T, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := decode(data)
+	if err != nil {
+		log.Printf("Warning: cache file %s is corrupt (%v), removing it", filename, err)
+		if removeErr := Remove(filename); removeErr != nil {
+			log.Printf("Warning: failed to remove corrupt cache file %s: %v", filename, removeErr)
+		}
+		return nil, fmt.Errorf("%w %s: %v", ErrCorrupt, filename, err)
+	}
+	return items, nil
+}
+
+// SaveCache writes a slice of T to a cache file in the format chosen with SetFormat, JSON by default
+// The sidecar records the items as fetched now from an unknown source, see SaveCacheFrom
+func SaveCache[T any](filename string, items []T) error {
+	return SaveCacheFrom(filename, "", time.Now(), items)
+}
+
+// SaveCacheFrom is SaveCache for items fetched from sourceURL at fetchedAt, which the sidecar records
+func SaveCacheFrom[T any](filename, sourceURL string, fetchedAt time.Time, items []T) error {
+	var data []byte
+	var err error
+	if Format() == FormatGob {
+		data, err = encodeBinary(items)
+	} else {
+		data, err = json.MarshalIndent(items, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	return save(filename, data, len(items), sourceURL, fetchedAt)
+}
+
+// save writes a cache file and then its sidecar
+// A save killed in between leaves the older sidecar, which no longer matches the file and is ignored
+func save(filename string, data []byte, records int, sourceURL string, fetchedAt time.Time) error {
+	if err := writeFile(filename, data); err != nil {
+		return err
+	}
+	return saveMeta(filename, data, records, sourceURL, fetchedAt)
+}
+
+// writeFile writes a cache file through a temp file renamed into place, so a killed save never leaves a
+// half-written cache
+func writeFile(filename string, data []byte) error {
+	// Ensure cache directory exists
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), filename); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package cache
+
+import "sort"
+
+// Sources with a bulk-download cache file, the identifiers clients and sync tools look files up by
+const (
+	SourceBrandmeister = "brandmeister"
+	SourceTGIF         = "tgif"
+	SourceHearham      = "hearham"
+)
+
+// Cache file names. Every file the clients write is named here, so two sources can't end up sharing one
+const (
+	BrandmeisterCacheFile = "brandmeister_repeaters.json"
+	TGIFCacheFile         = "tgif_talkgroups.json"
+	HearhamCacheFile      = "hearham_repeaters.json"
+
+	// RepeaterBookCachePrefix starts every RepeaterBook cache file, there is one file per search query
+	RepeaterBookCachePrefix = "repeaterbook_"
+)
+
+// sourceFiles maps each source to its cache file
+var sourceFiles = map[string]string{
+	SourceBrandmeister: BrandmeisterCacheFile,
+	SourceTGIF:         TGIFCacheFile,
+	SourceHearham:      HearhamCacheFile,
+}
+
+// SourcePath returns the cache file path of a source, or "" if the source has no file cache
+func SourcePath(source string) string {
+	name, ok := sourceFiles[source]
+	if !ok {
+		return ""
+	}
+	return Path(name)
+}
+
+// Sources lists the sources with a cache file, sorted
+func Sources() []string {
+	sources := make([]string, 0, len(sourceFiles))
+	for source := range sourceFiles {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	return sources
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Meta is the sidecar saved next to a cache file, recording where and when its data came from
+// Freshness checks go by FetchedAt rather than the file's mtime, which copies and rsync don't always keep
+type Meta struct {
+	FetchedAt   time.Time `json:"fetched_at"`
+	SourceURL   string    `json:"source_url,omitempty"`
+	RecordCount int       `json:"record_count"`
+	ContentHash string    `json:"content_hash"` // "sha256:" and the hex digest of the cache file
+	Size        int64     `json:"size"`         // Of the cache file, a sidecar beside a file it doesn't match is ignored
+}
+
+// MetaPath returns the sidecar path of a cache file, brandmeister_repeaters.json has brandmeister_repeaters.meta.json
+func MetaPath(filename string) string {
+	return strings.TrimSuffix(filename, filepath.Ext(filename)) + ".meta.json"
+}
+
+// LoadMeta reads the sidecar of a cache file
+func LoadMeta(filename string) (Meta, error) {
+	var meta Meta
+	data, err := os.ReadFile(MetaPath(filename))
+	if err != nil {
+		return meta, err
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return meta, fmt.Errorf("failed to decode %s: %v", MetaPath(filename), err)
+	}
+	return meta, nil
+}
+
+// FetchedAt returns when a cache file's data was fetched
+// That's the sidecar's time when the file has a sidecar that matches it, and the file's mtime for caches saved
+// before sidecars (or copied without theirs)
+func FetchedAt(filename string) (time.Time, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if meta, err := LoadMeta(filename); err == nil && meta.Size == info.Size() && !meta.FetchedAt.IsZero() {
+		return meta.FetchedAt, nil
+	}
+	return info.ModTime(), nil
+}
+
+// Remove deletes a cache file and its sidecar, neither existing is fine
+func Remove(filename string) error {
+	for _, path := range []string{filename, MetaPath(filename)} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveMeta writes the sidecar for cache file data just saved
+func saveMeta(filename string, data []byte, records int, sourceURL string, fetchedAt time.Time) error {
+	sum := sha256.Sum256(data)
+	meta := Meta{
+		FetchedAt:   fetchedAt.UTC(),
+		SourceURL:   sourceURL,
+		RecordCount: records,
+		ContentHash: "sha256:" + hex.EncodeToString(sum[:]),
+		Size:        int64(len(data)),
+	}
+
+	metaData, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(MetaPath(filename), metaData)
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
+)
+
+// CacheFilePath returns the cache file path for a source, or "" if the source has no file cache
+func CacheFilePath(source string) string {
+	return cache.SourcePath(source)
+}
+
+// CacheFileAge returns how old a source's cache file is, without building a client
+// The age is by the fetch time in its sidecar, or its mtime when it has none (see cache.FetchedAt)
+// exists is false when the source has no cache file yet
+func CacheFileAge(source string) (age time.Duration, exists bool) {
+	path := CacheFilePath(source)
+	if path == "" {
+		return 0, false
+	}
+
+	fetched, err := cache.FetchedAt(path)
+	if err != nil {
+		return 0, false
+	}
+	return time.Since(fetched), true
+}
+
+// cacheFileUpdated returns when a source's cache file data was fetched, or the zero time when it has none
+// GetCacheStatus reports it before a client has loaded any data, the file is what it would start from
+func cacheFileUpdated(source string) time.Time {
+	fetched, err := cache.FetchedAt(CacheFilePath(source))
+	if err != nil {
+		return time.Time{}
+	}
+	return fetched
+}
+
+// SetCacheFormat chooses the format the clients save their cache files in, see cache.SetFormat
+// Loading detects the format, so caches written in the other one keep working
+func SetCacheFormat(format string) error {
+	return cache.SetFormat(format)
+}
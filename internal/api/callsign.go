@@ -0,0 +1,60 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// callsignPart matches one "/"-separated piece of a callsign, with an optional APRS SSID (-9, -15, -WX)
+var callsignPart = regexp.MustCompile(`^[A-Z0-9]+(-[A-Z0-9]{1,2})?$`)
+
+// ValidateCallsign trims and uppercases a callsign and checks that it looks plausible
+// It is deliberately permissive: prefixes and portable suffixes (VK9C/VK4AAA, W3MSG/P)
+// and APRS SSIDs (W3MSG-9) are accepted, only clearly bad input is rejected
+func ValidateCallsign(s string) (string, error) {
+	callsign := strings.ToUpper(strings.TrimSpace(s))
+	if callsign == "" {
+		return "", fmt.Errorf("please enter a callsign")
+	}
+
+	// Longest real-world forms are around 15 characters including prefix/suffix
+	if len(callsign) > 20 || strings.ContainsAny(callsign, " \t") {
+		return "", fmt.Errorf("%q doesn't look like a callsign", strings.TrimSpace(s))
+	}
+
+	hasCallsign := false
+	for _, part := range strings.Split(callsign, "/") {
+		if !callsignPart.MatchString(part) {
+			return "", fmt.Errorf("%q doesn't look like a callsign", strings.TrimSpace(s))
+		}
+
+		// At least one piece must mix letters and digits like every issued callsign does
+		base := strings.SplitN(part, "-", 2)[0]
+		if strings.ContainsAny(base, "0123456789") && strings.IndexFunc(base, isLetter) >= 0 {
+			hasCallsign = true
+		}
+	}
+	if !hasCallsign {
+		return "", fmt.Errorf("%q doesn't look like a callsign", strings.TrimSpace(s))
+	}
+
+	return callsign, nil
+}
+
+func isLetter(r rune) bool {
+	return r >= 'A' && r <= 'Z'
+}
+
+// ssidSuffix matches an APRS name ending in an SSID, the base and the SSID are its groups
+var ssidSuffix = regexp.MustCompile(`^(.+)-([A-Za-z0-9]{1,2})$`)
+
+// SplitSSID splits an APRS name into its base callsign and SSID, W3ABC-9 gives W3ABC and 9
+// Names without an SSID come back whole with an empty SSID
+func SplitSSID(name string) (base, ssid string) {
+	match := ssidSuffix.FindStringSubmatch(name)
+	if match == nil {
+		return name, ""
+	}
+	return match[1], match[2]
+}
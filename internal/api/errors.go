@@ -0,0 +1,81 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Failed API calls come back as an *APIError whose Kind says what went wrong, so callers can check
+// errors.Is(err, api.ErrAuth) to say "check your API key" rather than matching message text
+
+// ErrorKind is the broad cause of a failed API call
+type ErrorKind int
+
+const (
+	KindNetwork     ErrorKind = iota + 1 // No response: DNS, refused connection, timeout or offline mode
+	KindAuth                             // The API rejected the key (401 or 403, or aprs.fi's "authentication failed")
+	KindRateLimited                      // Too many requests (429)
+	KindStatus                           // Any other unexpected HTTP status
+	KindDecode                           // The response didn't parse
+)
+
+// Sentinels matching each kind with errors.Is
+var (
+	ErrNetwork     = errors.New("network error")
+	ErrAuth        = errors.New("authentication failed")
+	ErrRateLimited = errors.New("rate limited")
+	ErrStatus      = errors.New("unexpected HTTP status")
+	ErrDecode      = errors.New("unreadable response")
+)
+
+var kindErrors = map[ErrorKind]error{
+	KindNetwork:     ErrNetwork,
+	KindAuth:        ErrAuth,
+	KindRateLimited: ErrRateLimited,
+	KindStatus:      ErrStatus,
+	KindDecode:      ErrDecode,
+}
+
+// APIError is a failed call to one of the APIs
+type APIError struct {
+	Source     string // "brandmeister", "tgif", "hearham", "aprs", "repeaterbook" or "tiles"
+	Kind       ErrorKind
+	StatusCode int   // HTTP status, 0 when there was no response
+	Err        error // What went wrong, its message is the APIError's message
+}
+
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches the sentinel of the error's kind, e.g. errors.Is(err, ErrAuth)
+func (e *APIError) Is(target error) bool {
+	return kindErrors[e.Kind] == target
+}
+
+// networkError is a request that got no response, wrap the cause with %w so ErrOffline stays visible
+func networkError(source, format string, args ...interface{}) error {
+	return &APIError{Source: source, Kind: KindNetwork, Err: fmt.Errorf(format, args...)}
+}
+
+// statusError is a response with an unexpected status, its kind follows from the status
+func statusError(source string, statusCode int, format string, args ...interface{}) error {
+	kind := KindStatus
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		kind = KindAuth
+	case http.StatusTooManyRequests:
+		kind = KindRateLimited
+	}
+	return &APIError{Source: source, Kind: kind, StatusCode: statusCode, Err: fmt.Errorf(format, args...)}
+}
+
+// decodeError is a response that didn't parse
+func decodeError(source, format string, args ...interface{}) error {
+	return &APIError{Source: source, Kind: KindDecode, Err: fmt.Errorf(format, args...)}
+}
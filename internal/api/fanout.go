@@ -0,0 +1,25 @@
+package api
+
+import "sync"
+
+// fanOut runs the tasks concurrently, at most limit at a time, and waits for them all
+// Tasks past the limit start as earlier ones finish, so a long source list never opens more than limit
+// connections at once. A limit of 0 or less runs everything at once
+func fanOut(limit int, tasks []func()) {
+	if limit <= 0 {
+		limit = len(tasks)
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, max(limit, 1))
+	for _, task := range tasks {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(task func()) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			task()
+		}(task)
+	}
+	wg.Wait()
+}
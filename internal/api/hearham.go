@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"math"
 	"net/http"
-	"os"
-	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/metrics"
 )
 
 // hearham.com repeater data structure (corrected based on actual API response)
@@ -41,16 +42,14 @@ type HearhamClient struct {
 	lastUpdate      time.Time
 	cacheValid      bool // Add this field
 	cacheTime       time.Duration
-	startupRefresh  time.Duration // How old cache can be before forcing refresh on startup
-	backgroundCheck time.Duration // How often to check for updates in background
+	startupRefresh  time.Duration                  // How old cache can be before forcing refresh on startup
+	backgroundCheck time.Duration                  // How often to check for updates in background
+	revalidate      *revalidation[HearhamRepeater] // Background refresh of expired data, see ensureData
 }
 
 // getCacheFile returns the path to the cache file
 func (c *HearhamClient) getCacheFile() string {
-	// Use a cache directory in the user's cache folder or temp
-	cacheDir := filepath.Join(os.TempDir(), "digiLogRT", "cache")
-	os.MkdirAll(cacheDir, 0755) // Create directory if it doesn't exist
-	return filepath.Join(cacheDir, "hearham_repeaters.json")
+	return cache.Path(cache.HearhamCacheFile)
 }
 
 // CheckCacheAge returns whether cache needs refresh and current age
@@ -58,23 +57,25 @@ func (c *HearhamClient) CheckCacheAge() (bool, time.Duration) {
 	cacheFile := c.getCacheFile()
 
 	// Check if cache file exists
-	info, err := os.Stat(cacheFile)
+	fetched, err := cache.FetchedAt(cacheFile)
 	if err != nil {
 		// Cache doesn't exist, needs refresh
 		return true, 0
 	}
 
-	age := time.Since(info.ModTime())
-	maxAge := 6 * time.Hour // hearham cache valid for 6 hours
+	age := time.Since(fetched)
 
-	return age > maxAge, age
+	return age > c.startupRefresh, age
 }
 
 // RefreshCache forces a cache refresh
 func (c *HearhamClient) RefreshCache() error {
+	if IsOffline() {
+		return refreshRefused("hearham")
+	}
 	// Simply delete the cache file, next GetAllRepeaters call will refresh
 	cacheFile := c.getCacheFile()
-	if err := os.Remove(cacheFile); err != nil && !os.IsNotExist(err) {
+	if err := cache.Remove(cacheFile); err != nil {
 		return fmt.Errorf("failed to remove cache file: %v", err)
 	}
 
@@ -150,20 +151,7 @@ func (h *HearhamRepeater) GetState() string {
 
 // Calculate distance between two points using Haversine formula
 func (h *HearhamRepeater) DistanceFromPoint(lat, lng float64) float64 {
-	const earthRadius = 6371 // Earth's radius in kilometers
-
-	lat1 := h.Latitude * math.Pi / 180
-	lng1 := h.Longitude * math.Pi / 180
-	lat2 := lat * math.Pi / 180
-	lng2 := lng * math.Pi / 180
-
-	dlat := lat2 - lat1
-	dlng := lng2 - lng1
-
-	a := math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dlng/2)*math.Sin(dlng/2)
-	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
-
-	return earthRadius * c
+	return haversineKm(h.Latitude, h.Longitude, lat, lng)
 }
 
 // hearham.com API response structure
@@ -174,44 +162,28 @@ type HearhamResponse struct {
 	Repeaters []HearhamRepeater `json:"repeaters,omitempty"`
 }
 
-// Create new hearham client with configurable caching
-func NewHearhamClient() *HearhamClient {
+// Create new hearham client with caching from the caching.hearham config section
+func NewHearhamClient(cache config.CacheSettings) *HearhamClient {
 	return &HearhamClient{
-		BaseURL: "https://hearham.com/api/repeaters/v1",
-		client: &http.Client{
-			Timeout: 60 * time.Second,
-		},
-		cacheTime:       24 * time.Hour, // Cache valid for 24 hours
-		startupRefresh:  6 * time.Hour,  // Force refresh if cache older than 6 hours on startup
-		backgroundCheck: 12 * time.Hour, // Check for updates every 12 hours
+		BaseURL:         "https://hearham.com/api/repeaters/v1",
+		client:          newHTTPClient(60 * time.Second),
+		cacheTime:       cache.CacheTime(24 * time.Hour),           // Cache valid for 24 hours
+		startupRefresh:  cache.StartupRefreshTime(6 * time.Hour),   // Force refresh if cache older than 6 hours on startup
+		backgroundCheck: cache.BackgroundCheckTime(12 * time.Hour), // Check for updates every 12 hours
+		revalidate:      newRevalidation[HearhamRepeater](),
 	}
 }
 
 // Fetch all repeater data from hearham.com with change detection
 func (c *HearhamClient) fetchAllData() error {
-	fmt.Println("Fetching repeater data from hearham.com...")
-
-	resp, err := c.client.Get(c.BaseURL)
-	if err != nil {
-		return fmt.Errorf("failed to make request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API request failed with status: %d", resp.StatusCode)
-	}
-
-	// Read the response body
-	var body []byte
-	if body, err = io.ReadAll(resp.Body); err != nil {
-		return fmt.Errorf("failed to read response body: %v", err)
+	if IsOffline() {
+		return c.useCacheOffline()
 	}
 
-	// Decode as array
-	var newRepeaters []HearhamRepeater
-	if err := json.Unmarshal(body, &newRepeaters); err != nil {
-		fmt.Printf("Raw response (first 500 chars): %s\n", string(body[:min(500, len(body))]))
-		return fmt.Errorf("failed to decode JSON response: %v", err)
+	fmt.Println("Fetching repeater data from hearham.com...")
+	newRepeaters, err := c.download()
+	if err != nil {
+		return err
 	}
 
 	// Check for changes if we have existing data
@@ -236,9 +208,40 @@ func (c *HearhamClient) fetchAllData() error {
 	return nil
 }
 
+// download fetches the repeater list, concurrent callers on any hearham client share one request
+func (c *HearhamClient) download() ([]HearhamRepeater, error) {
+	return ShareFetch("hearham "+c.BaseURL, func() (repeaters []HearhamRepeater, err error) {
+		start := time.Now()
+		defer func() { metrics.ObserveFetch("hearham", start, err) }()
+
+		resp, err := c.client.Get(c.BaseURL)
+		if err != nil {
+			return nil, networkError("hearham", "failed to make request: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, statusError("hearham", resp.StatusCode, "API request failed with status: %d", resp.StatusCode)
+		}
+
+		// Read the response body
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, networkError("hearham", "failed to read response body: %w", err)
+		}
+
+		// Decode as array
+		if err := json.Unmarshal(body, &repeaters); err != nil {
+			fmt.Printf("Raw response (first 500 chars): %s\n", string(body[:min(500, len(body))]))
+			return nil, decodeError("hearham", "failed to decode JSON response: %w", err)
+		}
+		return repeaters, nil
+	})
+}
+
 // Ensure we have fresh data with intelligent refresh logic
 func (c *HearhamClient) ensureData() error {
-	now := time.Now()
+	c.useRevalidated()
 
 	// If no data, always fetch
 	if len(c.allData) == 0 {
@@ -246,20 +249,37 @@ func (c *HearhamClient) ensureData() error {
 		return c.fetchAllData()
 	}
 
-	// If cache is very old (beyond cacheTime), force refresh
-	if now.Sub(c.lastUpdate) > c.cacheTime {
-		fmt.Printf("Cache expired (%v old), refreshing...\n", now.Sub(c.lastUpdate).Round(time.Minute))
-		return c.fetchAllData()
+	// Offline there's nothing newer to get, keep using what was loaded
+	if IsOffline() {
+		return nil
+	}
+
+	// If cache is very old, refresh it in the background and keep answering from it meanwhile
+	if age := time.Since(c.lastUpdate); age > c.cacheTime {
+		clone := *c // The refresh runs on a copy, so it can't touch the data in use
+		if c.revalidate.start("hearham", clone.fetchAndSave) {
+			fmt.Printf("Cache expired (%v old), refreshing in the background...\n", age.Round(time.Minute))
+		}
 	}
 
-	// Cache is valid, use existing data
 	return nil
 }
 
 // Force refresh of data (for manual updates)
 func (c *HearhamClient) ForceRefresh() error {
+	if IsOffline() {
+		return refreshRefused("hearham")
+	}
 	fmt.Println("Force refreshing hearham.com data...")
-	return c.fetchAllData()
+	if err := c.fetchAllData(); err != nil {
+		return err
+	}
+
+	// Keep the file cache in step, so the next start doesn't see the old data as stale
+	if err := c.saveToCache(c.allData); err != nil {
+		log.Printf("Warning: Failed to save cache to file: %v", err)
+	}
+	return nil
 }
 
 // Check if data should be refreshed on startup
@@ -290,13 +310,23 @@ func (c *HearhamClient) Initialize() error {
 }
 
 // Get cache status information
+// Until the client has fetched data it describes the cache file instead, last_update is zero when there is none
 func (c *HearhamClient) GetCacheStatus() map[string]interface{} {
+	lastUpdate := c.lastUpdate
+	needsRefresh := c.ShouldRefreshOnStartup()
+	if lastUpdate.IsZero() {
+		lastUpdate = cacheFileUpdated(cache.SourceHearham)
+		needsRefresh = lastUpdate.IsZero() || time.Since(lastUpdate) > c.startupRefresh
+	}
+
 	return map[string]interface{}{
 		"count":         len(c.allData),
-		"last_update":   c.lastUpdate,
-		"age":           time.Since(c.lastUpdate),
-		"cache_valid":   time.Since(c.lastUpdate) < c.cacheTime,
-		"needs_refresh": c.ShouldRefreshOnStartup(),
+		"last_update":   lastUpdate,
+		"age":           time.Since(lastUpdate),
+		"cache_valid":   time.Since(lastUpdate) < c.cacheTime,
+		"needs_refresh": needsRefresh,
+		"stale":         len(c.allData) > 0 && time.Since(lastUpdate) > c.cacheTime, // Searches are answered from expired data
+		"revalidating":  c.revalidate.inProgress(),
 	}
 }
 
@@ -374,10 +404,13 @@ func (c *HearhamClient) SearchByLocation(lat, lng float64, radiusKm int) (*Hearh
 func (c *HearhamClient) GetAllRepeaters() ([]HearhamRepeater, error) {
 	// Try to load from file cache first
 	if data, err := c.loadFromCache(); err == nil {
+		metrics.CacheHits.Inc("hearham")
 		c.allData = data
+		c.lastUpdate = cacheFileUpdated(cache.SourceHearham)
 		c.cacheValid = true
 		return data, nil
 	}
+	metrics.CacheMisses.Inc("hearham")
 
 	// If file cache miss, fetch from API
 	if err := c.fetchAllData(); err != nil { // Use fetchAllData instead of refreshData
@@ -397,45 +430,57 @@ func (c *HearhamClient) loadFromCache() ([]HearhamRepeater, error) {
 	cacheFile := c.getCacheFile()
 
 	// Check if file exists and is fresh enough
-	info, err := os.Stat(cacheFile)
+	fetched, err := cache.FetchedAt(cacheFile)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check cache age
-	age := time.Since(info.ModTime())
-	if age > 6*time.Hour {
+	// Check cache age (offline, old data beats none)
+	age := time.Since(fetched)
+	if age > c.startupRefresh && !IsOffline() {
 		return nil, fmt.Errorf("cache too old: %v", age)
 	}
 
 	// Read and parse cache file
-	data, err := os.ReadFile(cacheFile)
-	if err != nil {
+	return cache.LoadCache[HearhamRepeater](cacheFile)
+}
+
+// fetchAndSave fetches fresh data and writes it to the file cache, it's what a background refresh runs
+func (c *HearhamClient) fetchAndSave() ([]HearhamRepeater, error) {
+	if err := c.fetchAllData(); err != nil {
 		return nil, err
 	}
-
-	var repeaters []HearhamRepeater
-	err = json.Unmarshal(data, &repeaters)
-	return repeaters, err
+	if err := c.saveToCache(c.allData); err != nil {
+		log.Printf("Warning: Failed to save cache to file: %v", err)
+	}
+	return c.allData, nil
 }
 
-// saveToCache saves data to file cache
-func (c *HearhamClient) saveToCache(data []HearhamRepeater) error {
-	cacheFile := c.getCacheFile()
-
-	// Ensure cache directory exists
-	if err := os.MkdirAll(filepath.Dir(cacheFile), 0755); err != nil {
-		return err
+// useRevalidated swaps in the data of a finished background refresh
+func (c *HearhamClient) useRevalidated() {
+	if data, fetched, ok := c.revalidate.take(); ok {
+		c.allData = data
+		c.lastUpdate = fetched
+		c.cacheValid = true
 	}
+}
 
-	// Marshal to JSON
-	jsonData, err := json.MarshalIndent(data, "", "  ")
+// useCacheOffline loads the file cache whatever its age, in offline mode it's all the data there is
+func (c *HearhamClient) useCacheOffline() error {
+	data, err := c.loadFromCache()
 	if err != nil {
-		return err
+		return noCachedData("hearham")
 	}
 
-	// Write to file
-	return os.WriteFile(cacheFile, jsonData, 0644)
+	c.allData = data
+	c.lastUpdate = cacheFileUpdated(cache.SourceHearham)
+	c.cacheValid = true
+	return nil
+}
+
+// saveToCache saves data to file cache
+func (c *HearhamClient) saveToCache(data []HearhamRepeater) error {
+	return cache.SaveCacheFrom(c.getCacheFile(), c.BaseURL, c.lastUpdate, data)
 }
 
 // ...existing code...
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// A key that hits its rate limit rests for keyBackoff, doubling up to keyMaxBackoff while it keeps hitting it
+const (
+	keyBackoff    = time.Minute
+	keyMaxBackoff = 30 * time.Minute
+)
+
+// KeyUsage is how one API key has been used since the client was created
+type KeyUsage struct {
+	Key         string    // Masked to its last 4 characters
+	Requests    int       // Requests sent with the key
+	RateLimited int       // Of those, how many the API refused as over the limit
+	RestUntil   time.Time // When a rate-limited key is used again, zero when it's usable now
+}
+
+type ringKey struct {
+	key         string
+	requests    int
+	rateLimited int
+	backoff     time.Duration
+	restUntil   time.Time
+}
+
+// keyRing hands out API keys round-robin, skipping keys that are resting after a rate limit
+type keyRing struct {
+	mu   sync.Mutex
+	keys []*ringKey
+	next int
+}
+
+// newKeyRing rotates through keys. Without any it hands out an empty key, which the API then rejects
+func newKeyRing(keys []string) *keyRing {
+	if len(keys) == 0 {
+		keys = []string{""}
+	}
+	ring := &keyRing{}
+	for _, key := range keys {
+		ring.keys = append(ring.keys, &ringKey{key: key})
+	}
+	return ring
+}
+
+// pick returns the next usable key and counts a request against it
+// When every key is resting ok is false and wait says how long until the first one is usable again
+func (r *keyRing) pick() (key string, wait time.Duration, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	var soonest time.Time
+	for i := range r.keys {
+		k := r.keys[(r.next+i)%len(r.keys)]
+		if now.Before(k.restUntil) {
+			if soonest.IsZero() || k.restUntil.Before(soonest) {
+				soonest = k.restUntil
+			}
+			continue
+		}
+		r.next = (r.next + i + 1) % len(r.keys)
+		k.requests++
+		return k.key, 0, true
+	}
+	return "", soonest.Sub(now), false
+}
+
+// limited rests a key the API refused as over its limit
+func (r *keyRing) limited(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, k := range r.keys {
+		if k.key != key {
+			continue
+		}
+		k.rateLimited++
+		k.backoff *= 2
+		if k.backoff == 0 {
+			k.backoff = keyBackoff
+		} else if k.backoff > keyMaxBackoff {
+			k.backoff = keyMaxBackoff
+		}
+		k.restUntil = time.Now().Add(k.backoff)
+	}
+}
+
+// succeeded resets a key's back-off once the API accepts it again
+func (r *keyRing) succeeded(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, k := range r.keys {
+		if k.key == key {
+			k.backoff = 0
+		}
+	}
+}
+
+// size is how many keys the ring rotates through
+func (r *keyRing) size() int {
+	return len(r.keys)
+}
+
+func (r *keyRing) usage() []KeyUsage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	usage := make([]KeyUsage, len(r.keys))
+	for i, k := range r.keys {
+		usage[i] = KeyUsage{Key: maskKey(k.key), Requests: k.requests, RateLimited: k.rateLimited}
+		if now.Before(k.restUntil) {
+			usage[i].RestUntil = k.restUntil
+		}
+	}
+	return usage
+}
+
+// maskKey keeps the last 4 characters of a key, enough to tell keys apart in logs
+func maskKey(key string) string {
+	if len(key) <= 4 {
+		return strings.Repeat("*", len(key))
+	}
+	return "..." + key[len(key)-4:]
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+)
+
+// Offline mode is for field use without connectivity: no client touches the network, they work from
+// whatever is in their file caches however old it is
+
+var offline atomic.Bool
+
+// ErrOffline is wrapped by every error offline mode causes, so callers can tell it apart from an outage
+var ErrOffline = errors.New("offline")
+
+// SetOffline turns offline mode on or off for every client, existing ones too
+func SetOffline(enabled bool) {
+	offline.Store(enabled)
+}
+
+// IsOffline reports whether offline mode is on
+func IsOffline() bool {
+	return offline.Load()
+}
+
+// offlineTransport fails requests straight away in offline mode, rather than after the client's timeout
+type offlineTransport struct {
+	next http.RoundTripper
+}
+
+func (t offlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if IsOffline() {
+		return nil, fmt.Errorf("%w, not contacting %s", ErrOffline, req.URL.Host)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// noCachedData is the error for a source with nothing cached to work from in offline mode
+func noCachedData(source string) error {
+	return fmt.Errorf("%w, no cached %s data", ErrOffline, source)
+}
+
+// refreshRefused is the error for a forced refresh in offline mode, which would have to fetch
+func refreshRefused(source string) error {
+	return fmt.Errorf("%w, can't refresh %s data", ErrOffline, source)
+}
@@ -1,9 +1,15 @@
 package api
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
+	"github.com/unklstewy/digiLogRT/internal/config"
 )
 
 // ClientPool manages reusable API client connections
@@ -13,6 +19,8 @@ type ClientPool struct {
 	hearham      *HearhamClient
 	initOnce     sync.Once
 	initTime     time.Duration
+	initResult   *InitResult
+	fetchLimit   int // Config.MaxConcurrentFetches, HealthCheck keeps to it too
 }
 
 var globalPool *ClientPool
@@ -27,136 +35,183 @@ func GetGlobalPool() *ClientPool {
 }
 
 // ...existing code...
-// WarmCaches proactively refreshes caches if they're older than maxAge
-func (p *ClientPool) WarmCaches(brandmeisterKey string, maxAge time.Duration) error {
-	fmt.Printf("🔥 Checking cache freshness (max age: %v)\n", maxAge)
-
-	var wg sync.WaitGroup
-	errors := make(chan error, 3)
-
-	// Check and warm each cache in parallel
-	if brandmeisterKey != "" {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			client := NewBrandmeisterClient(brandmeisterKey)
-			if needsRefresh, age := client.CheckCacheAge(); needsRefresh && age > maxAge {
-				fmt.Printf("  🔄 Brandmeister cache is %v old, refreshing...\n", age)
-				if err := client.RefreshCache(); err != nil {
-					errors <- fmt.Errorf("brandmeister cache refresh failed: %v", err)
-					return
-				}
-				fmt.Printf("  ✓ Brandmeister cache refreshed\n")
-			} else {
-				fmt.Printf("  ✓ Brandmeister cache is fresh (%v old)\n", age)
-			}
-		}()
+// CacheWarmResult reports what WarmCaches did for one source
+type CacheWarmResult struct {
+	Source    string
+	Existed   bool          // Whether there was a cache file before warming
+	Age       time.Duration // Age of that file (0 when there was none)
+	Refreshed bool          // Whether the source was fetched from the API
+	Err       error         // Why the refresh failed or was impossible
+}
+
+// ParseCacheSources splits a comma-separated source list for WarmCaches, dropping blanks and repeats
+// Every name must be a source with a file cache
+func ParseCacheSources(list string) ([]string, error) {
+	var sources []string
+	seen := make(map[string]bool)
+	for _, source := range strings.Split(list, ",") {
+		source = strings.ToLower(strings.TrimSpace(source))
+		if source == "" || seen[source] {
+			continue
+		}
+		if cache.SourcePath(source) == "" {
+			return nil, fmt.Errorf("unknown source %q (known sources: %s)", source, strings.Join(cache.Sources(), ", "))
+		}
+		seen[source] = true
+		sources = append(sources, source)
 	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no sources given (known sources: %s)", strings.Join(cache.Sources(), ", "))
+	}
+	return sources, nil
+}
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		client := NewTGIFClient()
-		if needsRefresh, age := client.CheckCacheAge(); needsRefresh && age > maxAge {
-			fmt.Printf("  🔄 TGIF cache is %v old, refreshing...\n", age)
-			if err := client.RefreshCache(); err != nil {
-				errors <- fmt.Errorf("tgif cache refresh failed: %v", err)
-				return
-			}
-			fmt.Printf("  ✓ TGIF cache refreshed\n")
-		} else {
-			fmt.Printf("  ✓ TGIF cache is fresh (%v old)\n", age)
+// WarmCaches refreshes the cache files of the listed sources that are missing or older than maxAge
+// Freshness is judged from the file mtimes alone, so fresh sources cost no client setup or network
+// At most cfg.MaxConcurrentFetches sources are refreshed at once
+// Sources not listed are left alone, see ParseCacheSources
+func (p *ClientPool) WarmCaches(cfg *config.Config, maxAge time.Duration, sources []string) []CacheWarmResult {
+	results := make([]CacheWarmResult, len(sources))
+
+	var refreshes []func()
+	for i, source := range sources {
+		age, exists := CacheFileAge(source)
+		results[i] = CacheWarmResult{Source: source, Existed: exists, Age: age}
+
+		// A brand-new install has no cache at all, which always needs warming
+		if exists && age <= maxAge {
+			continue
+		}
+
+		refresh, err := cacheRefresher(cfg, source)
+		if err != nil {
+			results[i].Err = err
+			continue
 		}
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		client := NewHearhamClient()
-		if needsRefresh, age := client.CheckCacheAge(); needsRefresh && age > maxAge {
-			fmt.Printf("  🔄 hearham cache is %v old, refreshing...\n", age)
-			if err := client.RefreshCache(); err != nil {
-				errors <- fmt.Errorf("hearham cache refresh failed: %v", err)
+
+		result := &results[i]
+		refreshes = append(refreshes, func() {
+			if err := refresh(); err != nil {
+				result.Err = fmt.Errorf("%s cache refresh failed: %w", result.Source, err)
 				return
 			}
-			fmt.Printf("  ✓ hearham cache refreshed\n")
-		} else {
-			fmt.Printf("  ✓ hearham cache is fresh (%v old)\n", age)
+			result.Refreshed = true
+		})
+	}
+	fanOut(cfg.MaxConcurrentFetches, refreshes)
+
+	return results
+}
+
+// cacheRefresher builds the client for a source and returns its RefreshCache
+func cacheRefresher(cfg *config.Config, source string) (func() error, error) {
+	switch source {
+	case "brandmeister":
+		if cfg.APIs.BrandmeisterKey == "" {
+			return nil, fmt.Errorf("no Brandmeister API key configured")
 		}
-	}()
+		return NewBrandmeisterClient(cfg.APIs, cfg.Cache.Brandmeister).RefreshCache, nil
+	case "tgif":
+		return NewTGIFClient(cfg.Cache.TGIF).RefreshCache, nil
+	case "hearham":
+		return NewHearhamClient(cfg.Cache.Hearham).RefreshCache, nil
+	}
+	return nil, fmt.Errorf("unknown source %q", source)
+}
 
-	wg.Wait()
-	close(errors)
+// ...existing code...
+
+// InitResult reports how each pooled client's initialization went
+type InitResult struct {
+	Errors   map[string]error // Source name to its init error (nil on success), skipped sources are absent
+	Duration time.Duration    // Total parallel initialization time
+}
 
-	// Check for errors
-	for err := range errors {
+// Succeeded returns whether a source was initialized without error
+func (r *InitResult) Succeeded(source string) bool {
+	err, attempted := r.Errors[source]
+	return attempted && err == nil
+}
+
+// Failed returns the names of the sources that failed to initialize, sorted
+func (r *InitResult) Failed() []string {
+	var failed []string
+	for source, err := range r.Errors {
 		if err != nil {
-			return err
+			failed = append(failed, source)
 		}
 	}
+	sort.Strings(failed)
+	return failed
+}
 
-	return nil
+// AllFailed returns whether no attempted source initialized
+func (r *InitResult) AllFailed() bool {
+	return len(r.Errors) > 0 && len(r.Failed()) == len(r.Errors)
 }
 
-// ...existing code...
+// Err combines the per-source failures into one error, or returns nil when every source succeeded
+func (r *InitResult) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
 
-// Initialize all clients once
-func (p *ClientPool) Initialize(brandmeisterKey string) error {
-	var initErr error
+	messages := make([]string, len(failed))
+	for i, source := range failed {
+		messages[i] = fmt.Sprintf("%s: %v", source, r.Errors[source])
+	}
+	return fmt.Errorf("%d of %d clients failed to initialize (%s)",
+		len(failed), len(r.Errors), strings.Join(messages, "; "))
+}
 
+// Initialize all clients once, using the API keys and cache settings from cfg
+// Every client is attempted even when others fail, so callers can carry on with the
+// sources that did initialize. Later calls return the result of the first one
+func (p *ClientPool) Initialize(cfg *config.Config) *InitResult {
 	p.initOnce.Do(func() {
 		start := time.Now()
+		result := &InitResult{Errors: make(map[string]error)}
 
-		// Initialize all clients in parallel
-		var wg sync.WaitGroup
-		errors := make(chan error, 3)
+		// Initialize all clients in parallel, up to the fetch limit
+		var mu sync.Mutex
+		record := func(source string, err error) {
+			mu.Lock()
+			result.Errors[source] = err
+			mu.Unlock()
+		}
+
+		var inits []func()
 
 		// Brandmeister
-		if brandmeisterKey != "" {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				p.brandmeister = NewBrandmeisterClient(brandmeisterKey)
-				if err := p.brandmeister.Initialize(); err != nil {
-					errors <- err
-				}
-			}()
+		if cfg.APIs.BrandmeisterKey != "" {
+			inits = append(inits, func() {
+				p.brandmeister = NewBrandmeisterClient(cfg.APIs, cfg.Cache.Brandmeister)
+				record("brandmeister", p.brandmeister.Initialize())
+			})
 		}
 
 		// TGIF
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			p.tgif = NewTGIFClient()
-			if err := p.tgif.Initialize(); err != nil {
-				errors <- err
-			}
-		}()
+		inits = append(inits, func() {
+			p.tgif = NewTGIFClient(cfg.Cache.TGIF)
+			record("tgif", p.tgif.Initialize())
+		})
 
 		// Hearham
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			p.hearham = NewHearhamClient()
-			if err := p.hearham.Initialize(); err != nil {
-				errors <- err
-			}
-		}()
+		inits = append(inits, func() {
+			p.hearham = NewHearhamClient(cfg.Cache.Hearham)
+			record("hearham", p.hearham.Initialize())
+		})
 
-		wg.Wait()
-		close(errors)
-
-		// Check for errors
-		for err := range errors {
-			if err != nil && initErr == nil {
-				initErr = err
-			}
-		}
+		fanOut(cfg.MaxConcurrentFetches, inits)
+		p.fetchLimit = cfg.MaxConcurrentFetches
 
 		p.initTime = time.Since(start)
+		result.Duration = p.initTime
+		p.initResult = result
 	})
 
-	return initErr
+	return p.initResult
 }
 
 // GetClients returns initialized clients
@@ -168,3 +223,62 @@ func (p *ClientPool) GetClients() (*BrandmeisterClient, *TGIFClient, *HearhamCli
 func (p *ClientPool) GetInitTime() time.Duration {
 	return p.initTime
 }
+
+// HealthCheck tests every pooled client's connection in parallel, up to the fetch limit Initialize was given
+// The result maps source name to nil (reachable) or the error seen for that source
+func (p *ClientPool) HealthCheck(ctx context.Context) map[string]error {
+	checks := map[string]func() error{
+		"brandmeister": nil,
+		"tgif":         nil,
+		"hearham":      nil,
+	}
+	if p.brandmeister != nil {
+		checks["brandmeister"] = p.brandmeister.TestConnection
+	}
+	if p.tgif != nil {
+		checks["tgif"] = p.tgif.TestConnection
+	}
+	if p.hearham != nil {
+		checks["hearham"] = p.hearham.TestConnection
+	}
+
+	var mu sync.Mutex
+	results := make(map[string]error, len(checks))
+	record := func(source string, err error) {
+		mu.Lock()
+		results[source] = err
+		mu.Unlock()
+	}
+
+	var tasks []func()
+	for source, check := range checks {
+		if check == nil {
+			results[source] = fmt.Errorf("%s client not initialized", source)
+			continue
+		}
+
+		source, check := source, check
+		tasks = append(tasks, func() {
+			// Checks queued behind the fetch limit may find the context already gone
+			if err := ctx.Err(); err != nil {
+				record(source, fmt.Errorf("%s health check cancelled: %v", source, err))
+				return
+			}
+
+			// Run the check separately so a slow source can't outlive the context
+			done := make(chan error, 1)
+			go func() { done <- check() }()
+
+			var err error
+			select {
+			case err = <-done:
+			case <-ctx.Done():
+				err = fmt.Errorf("%s health check cancelled: %v", source, ctx.Err())
+			}
+			record(source, err)
+		})
+	}
+
+	fanOut(p.fetchLimit, tasks)
+	return results
+}
@@ -3,10 +3,18 @@ package api
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/metrics"
 )
 
 // RepeaterBook repeater data structure
@@ -62,6 +70,28 @@ func (r *RepeaterBookRepeater) GetFrequencyFloat() (float64, error) {
 	return strconv.ParseFloat(r.Frequency, 64)
 }
 
+func (r *RepeaterBookRepeater) GetInputFrequencyFloat() (float64, error) {
+	if r.InputFreq == "" {
+		return 0, fmt.Errorf("no input frequency data")
+	}
+	return strconv.ParseFloat(r.InputFreq, 64)
+}
+
+// GetToneFrequency returns the CTCSS access tone in Hz
+// DCS codes ("D023") and carrier squelch ("CSQ") have no tone frequency
+func (r *RepeaterBookRepeater) GetToneFrequency() (float64, error) {
+	tone := strings.TrimSpace(r.AccessTone)
+	if tone == "" || strings.EqualFold(tone, "CSQ") || strings.HasPrefix(strings.ToUpper(tone), "D") {
+		return 0, fmt.Errorf("no CTCSS tone")
+	}
+	return strconv.ParseFloat(tone, 64)
+}
+
+// IsOperational reports whether RepeaterBook doesn't list the repeater as off the air
+func (r *RepeaterBookRepeater) IsOperational() bool {
+	return !strings.EqualFold(strings.TrimSpace(r.Status), "Off-air")
+}
+
 func (r *RepeaterBookRepeater) IsDigital() bool {
 	return r.DSTAR != "" || r.DMR != "" || r.YSF != "" || r.NXDN != "" || r.P25 != "" || r.TETRA != ""
 }
@@ -101,38 +131,134 @@ type RepeaterBookClient struct {
 	BaseURL   string
 	UserAgent string
 	client    *http.Client
+	cache     *responseCache[*RepeaterBookResponse] // Recent searches, keyed by query
+	cacheTime time.Duration                         // How long a search result (memory or file) stays valid
 }
 
 // Create new RepeaterBook client
-func NewRepeaterBookClient(apiKey string) *RepeaterBookClient {
+// RepeaterBook data is stable and the API is strictly rate limited, so searches
+// are cached in memory and on disk for 24h unless configured
+func NewRepeaterBookClient(apiKey string, cache config.CacheSettings) *RepeaterBookClient {
+	cacheTime := cache.CacheTime(24 * time.Hour)
 	return &RepeaterBookClient{
 		APIKey:    apiKey,
 		BaseURL:   "https://www.repeaterbook.com/api",
 		UserAgent: "DigiLogRT/0.1.0 Amateur Radio Digital Logging Tool (https://github.com/unklstewy/digiLog, unklstewy@example.com)",
-		client: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		client:    newHTTPClient(30 * time.Second),
+		cache:     newResponseCache[*RepeaterBookResponse](cacheTime),
+		cacheTime: cacheTime,
+	}
+}
+
+// RepeaterBook splits its exports: North America is served by export.php and
+// everywhere else by the rest-of-world export (exportROW.php)
+const (
+	repeaterBookNAEndpoint    = "/export.php"
+	repeaterBookWorldEndpoint = "/exportROW.php"
+)
+
+// northAmericanCountries are the countries covered by the North American export
+var northAmericanCountries = map[string]bool{
+	"united states": true,
+	"usa":           true,
+	"us":            true,
+	"canada":        true,
+	"ca":            true,
+	"mexico":        true,
+	"mx":            true,
+}
+
+// IsNorthAmericanCountry reports whether a country is served by the North American export
+func IsNorthAmericanCountry(country string) bool {
+	return northAmericanCountries[strings.ToLower(strings.TrimSpace(country))]
+}
+
+// countryEndpoint picks the export endpoint and the country name RepeaterBook expects
+func countryEndpoint(country string) (endpoint, name string) {
+	name = strings.TrimSpace(country)
+	switch strings.ToLower(name) {
+	case "usa", "us":
+		name = "United States"
+	case "ca":
+		name = "Canada"
+	case "mx":
+		name = "Mexico"
 	}
+
+	if IsNorthAmericanCountry(name) {
+		return repeaterBookNAEndpoint, name
+	}
+	return repeaterBookWorldEndpoint, name
 }
 
 // Search repeaters by state
 func (c *RepeaterBookClient) SearchByState(state string) (*RepeaterBookResponse, error) {
-	u, err := url.Parse(c.BaseURL + "/export.php")
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse URL: %v", err)
+	params := url.Values{}
+	params.Add("state", state)
+	return c.search(repeaterBookNAEndpoint, params)
+}
+
+// SearchByCountry searches every repeater in a country
+// US, Canada and Mexico use the North American export, other countries the worldwide one
+func (c *RepeaterBookClient) SearchByCountry(country string) (*RepeaterBookResponse, error) {
+	if strings.TrimSpace(country) == "" {
+		return nil, fmt.Errorf("country is required")
 	}
 
+	endpoint, name := countryEndpoint(country)
 	params := url.Values{}
-	params.Add("state", state)
-	params.Add("format", "json")
+	params.Add("country", name)
+	return c.search(endpoint, params)
+}
+
+// Search repeaters by location (lat/lon with radius in km)
+func (c *RepeaterBookClient) SearchByLocation(lat, lng float64, radius int) (*RepeaterBookResponse, error) {
+	params := url.Values{}
+	params.Add("lat", fmt.Sprintf("%.6f", lat))
+	params.Add("long", fmt.Sprintf("%.6f", lng))
+	params.Add("distance", fmt.Sprintf("%d", radius))
+	params.Add("dunit", "km") // RepeaterBook defaults to miles
+	return c.search("/proximity.php", params)
+}
+
+// search runs a query against an export endpoint, answering from the cache when possible
+func (c *RepeaterBookClient) search(endpoint string, params url.Values) (*RepeaterBookResponse, error) {
+	resp, _, err := c.fetch(endpoint, params)
+	return resp, err
+}
+
+// fetch is search, also reporting whether the result came from the cache rather than the API
+func (c *RepeaterBookClient) fetch(endpoint string, params url.Values) (_ *RepeaterBookResponse, cached bool, err error) {
+	start := time.Now()
+	defer func() { metrics.ObserveFetch("repeaterbook", start, err) }()
+
+	u, err := url.Parse(c.BaseURL + endpoint)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to parse URL: %v", err)
+	}
+
+	params.Set("format", "json")
 	if c.APIKey != "" {
-		params.Add("api_key", c.APIKey)
+		params.Set("api_key", c.APIKey)
 	}
 	u.RawQuery = params.Encode()
 
+	// The API key is left out of the cache key so it never ends up in a file name
+	key := repeaterBookCacheKey(endpoint, params)
+	if hit, ok := c.cache.get(key); ok {
+		metrics.CacheHits.Inc("repeaterbook")
+		return hit, true, nil
+	}
+	if hit, err := c.loadFromCache(key); err == nil {
+		metrics.CacheHits.Inc("repeaterbook")
+		c.cache.put(key, hit, len(hit.Results))
+		return hit, true, nil
+	}
+	metrics.CacheMisses.Inc("repeaterbook")
+
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
+		return nil, false, fmt.Errorf("failed to create request: %v", err)
 	}
 
 	// Set required User-Agent header
@@ -140,62 +266,109 @@ func (c *RepeaterBookClient) SearchByState(state string) (*RepeaterBookResponse,
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %v", err)
+		return nil, false, networkError("repeaterbook", "failed to make request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+		return nil, false, statusError("repeaterbook", resp.StatusCode, "API request failed with status: %d", resp.StatusCode)
 	}
 
 	var rbResp RepeaterBookResponse
 	if err := json.NewDecoder(resp.Body).Decode(&rbResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
+		return nil, false, decodeError("repeaterbook", "failed to decode response: %w", err)
 	}
 
-	return &rbResp, nil
+	// The cache sidecar records where the results came from, without the API key
+	source := *u
+	query := source.Query()
+	query.Del("api_key")
+	source.RawQuery = query.Encode()
+
+	c.cache.put(key, &rbResp, len(rbResp.Results))
+	if err := c.saveToCache(key, source.String(), &rbResp); err != nil {
+		log.Printf("Warning: Failed to save RepeaterBook cache to file: %v", err)
+	}
+	return &rbResp, false, nil
 }
 
-// Search repeaters by location (lat/lon with radius)
-func (c *RepeaterBookClient) SearchByLocation(lat, lng float64, radius int) (*RepeaterBookResponse, error) {
-	u, err := url.Parse(c.BaseURL + "/proximity.php")
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse URL: %v", err)
+// repeaterBookCacheKey builds a file-name-safe key from the endpoint and query parameters
+func repeaterBookCacheKey(endpoint string, params url.Values) string {
+	query := url.Values{}
+	for k, v := range params {
+		if k != "api_key" && k != "format" {
+			query[k] = v
+		}
 	}
 
-	params := url.Values{}
-	params.Add("lat", fmt.Sprintf("%.6f", lat))
-	params.Add("long", fmt.Sprintf("%.6f", lng))
-	params.Add("distance", fmt.Sprintf("%d", radius))
-	params.Add("format", "json")
-	if c.APIKey != "" {
-		params.Add("api_key", c.APIKey)
+	key := strings.TrimSuffix(strings.TrimPrefix(endpoint, "/"), ".php")
+	if encoded := query.Encode(); encoded != "" {
+		key += "_" + encoded
 	}
-	u.RawQuery = params.Encode()
 
-	req, err := http.NewRequest("GET", u.String(), nil)
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		case r == '=':
+			return '-'
+		default:
+			return '_'
+		}
+	}, key)
+}
+
+// getCacheFile returns the path to the cache file for a query
+func (c *RepeaterBookClient) getCacheFile(key string) string {
+	return cache.Path(cache.RepeaterBookCachePrefix + key + ".json")
+}
+
+// loadFromCache loads a search result from its file cache if it is fresh enough, or at all in offline mode
+func (c *RepeaterBookClient) loadFromCache(key string) (*RepeaterBookResponse, error) {
+	cacheFile := c.getCacheFile(key)
+
+	fetched, err := cache.FetchedAt(cacheFile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
+		return nil, err
 	}
 
-	req.Header.Set("User-Agent", c.UserAgent)
+	// Offline, old results beat none
+	if age := time.Since(fetched); age > c.cacheTime && !IsOffline() {
+		return nil, fmt.Errorf("cache too old: %v", age)
+	}
 
-	resp, err := c.client.Do(req)
+	results, err := cache.LoadCache[RepeaterBookRepeater](cacheFile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %v", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
+	return &RepeaterBookResponse{Count: len(results), Results: results}, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
-	}
+// saveToCache saves a search result fetched from sourceURL to its file cache
+func (c *RepeaterBookClient) saveToCache(key, sourceURL string, resp *RepeaterBookResponse) error {
+	return cache.SaveCacheFrom(c.getCacheFile(key), sourceURL, time.Now(), resp.Results)
+}
 
-	var rbResp RepeaterBookResponse
-	if err := json.NewDecoder(resp.Body).Decode(&rbResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
+// ForceRefresh drops every cached search, in memory and on disk, so the next searches hit the API
+func (c *RepeaterBookClient) ForceRefresh() error {
+	fmt.Println("Force refreshing RepeaterBook data...")
+	c.cache.clear()
+
+	files, err := filepath.Glob(cache.Path(cache.RepeaterBookCachePrefix + "*.json"))
+	if err != nil {
+		return fmt.Errorf("failed to list cache files: %v", err)
+	}
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove cache file: %v", err)
+		}
 	}
+	return nil
+}
 
-	return &rbResp, nil
+// GetCacheStatus returns information about the search cache
+func (c *RepeaterBookClient) GetCacheStatus() map[string]interface{} {
+	return c.cache.status()
 }
 
 // Test the API connection
@@ -204,3 +377,95 @@ func (c *RepeaterBookClient) TestConnection() error {
 	_, err := c.SearchByState("Pennsylvania")
 	return err
 }
+
+// RepeaterBookRegion is one state or country to fetch during a sync
+// Country is used when State is empty
+type RepeaterBookRegion struct {
+	State   string
+	Country string
+}
+
+func (r RepeaterBookRegion) String() string {
+	if r.State != "" {
+		return r.State
+	}
+	return r.Country
+}
+
+// RepeaterBookRegions builds the region list for a sync, states first
+func RepeaterBookRegions(states, countries []string) []RepeaterBookRegion {
+	var regions []RepeaterBookRegion
+	for _, state := range states {
+		if state = strings.TrimSpace(state); state != "" {
+			regions = append(regions, RepeaterBookRegion{State: state})
+		}
+	}
+	for _, country := range countries {
+		if country = strings.TrimSpace(country); country != "" {
+			regions = append(regions, RepeaterBookRegion{Country: country})
+		}
+	}
+	return regions
+}
+
+// FetchRegions searches each region in turn, waiting delay after every call that went to the API
+// Regions that fail are reported and skipped, an error is only returned if every region failed
+// Repeaters listed by more than one region (a state and its country) are returned once
+func (c *RepeaterBookClient) FetchRegions(regions []RepeaterBookRegion, delay time.Duration) ([]RepeaterBookRepeater, error) {
+	if len(regions) == 0 {
+		return nil, fmt.Errorf("no RepeaterBook regions configured")
+	}
+
+	var all []RepeaterBookRepeater
+	seen := make(map[string]bool)
+	failed := 0
+	var lastErr error
+
+	for i, region := range regions {
+		var endpoint string
+		params := url.Values{}
+		if region.State != "" {
+			endpoint = repeaterBookNAEndpoint
+			params.Add("state", region.State)
+		} else {
+			var name string
+			endpoint, name = countryEndpoint(region.Country)
+			params.Add("country", name)
+		}
+
+		resp, cached, err := c.fetch(endpoint, params)
+		if err != nil {
+			fmt.Printf("  [%d/%d] %s: failed: %v\n", i+1, len(regions), region, err)
+			failed++
+			lastErr = err
+		} else {
+			added := 0
+			for _, r := range resp.Results {
+				key := r.StateID + "-" + r.Rptr_ID
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				all = append(all, r)
+				added++
+			}
+
+			source := "API"
+			if cached {
+				source = "cache"
+			}
+			fmt.Printf("  [%d/%d] %s: %d repeaters (%d new, from %s)\n",
+				i+1, len(regions), region, len(resp.Results), added, source)
+		}
+
+		// Be polite: only live calls count against RepeaterBook's rate limit
+		if !cached && i < len(regions)-1 && delay > 0 {
+			time.Sleep(delay)
+		}
+	}
+
+	if failed == len(regions) {
+		return nil, fmt.Errorf("all %d RepeaterBook regions failed, last error: %w", failed, lastErr)
+	}
+	return all, nil
+}
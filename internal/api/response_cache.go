@@ -0,0 +1,88 @@
+package api
+
+import (
+	"sync"
+	"time"
+)
+
+// responseCache keeps recent API responses in memory keyed by the query that produced them
+// It backs the query-style clients (APRS, RepeaterBook) that have no bulk data set to cache
+type responseCache[T any] struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	entries    map[string]cachedResponse[T]
+	lastUpdate time.Time
+}
+
+type cachedResponse[T any] struct {
+	value   T
+	count   int // Number of records in the response, for status reporting
+	fetched time.Time
+}
+
+func newResponseCache[T any](ttl time.Duration) *responseCache[T] {
+	return &responseCache[T]{
+		ttl:     ttl,
+		entries: make(map[string]cachedResponse[T]),
+	}
+}
+
+// get returns the cached response for key if it is still fresh
+func (c *responseCache[T]) get(key string) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Since(entry.fetched) > c.ttl {
+		var zero T
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// put stores a response, dropping any entries that have expired
+func (c *responseCache[T]) put(key string, value T, count int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.Sub(entry.fetched) > c.ttl {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = cachedResponse[T]{value: value, count: count, fetched: now}
+	c.lastUpdate = now
+}
+
+// clear empties the cache so the next call of every query goes to the API
+func (c *responseCache[T]) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]cachedResponse[T])
+}
+
+// status reports the cache using the same keys as the bulk clients' GetCacheStatus
+func (c *responseCache[T]) status() map[string]interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count := 0
+	for _, entry := range c.entries {
+		if time.Since(entry.fetched) <= c.ttl {
+			count += entry.count
+		}
+	}
+	cacheAge := time.Since(c.lastUpdate)
+
+	return map[string]interface{}{
+		"count":         count,
+		"last_update":   c.lastUpdate,
+		"age":           cacheAge,
+		"cache_valid":   count > 0,
+		"needs_refresh": cacheAge > c.ttl,
+		"stale":         false, // Expired responses are never served, lookups want live data
+		"revalidating":  false,
+	}
+}
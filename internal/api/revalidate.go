@@ -0,0 +1,69 @@
+package api
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// revalidation refreshes a bulk client's data in the background once it has expired, while searches keep
+// answering from the stale copy (stale-while-revalidate). However many searches find the data stale only
+// one refresh runs, and its result waits here until the client's next ensureData swaps it in, so the data
+// in use is only ever replaced on the caller's goroutine
+type revalidation[T any] struct {
+	mu      sync.Mutex
+	running bool
+	fresh   []T       // Data from a finished refresh, not yet taken
+	fetched time.Time // When fresh was fetched
+}
+
+func newRevalidation[T any]() *revalidation[T] {
+	return &revalidation[T]{}
+}
+
+// start runs fetch in the background unless a refresh is already running, and reports whether it did
+// A failed refresh is logged and the stale data stays in use, the next expired search tries again
+func (r *revalidation[T]) start(source string, fetch func() ([]T, error)) bool {
+	r.mu.Lock()
+	if r.running {
+		r.mu.Unlock()
+		return false
+	}
+	r.running = true
+	r.mu.Unlock()
+
+	go func() {
+		data, err := fetch()
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.running = false
+		if err != nil {
+			log.Printf("Background %s refresh failed, still using the stale data: %v", source, err)
+			return
+		}
+		r.fresh = data
+		r.fetched = time.Now()
+	}()
+	return true
+}
+
+// take returns the data of a finished refresh, once
+func (r *revalidation[T]) take() ([]T, time.Time, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.fresh == nil {
+		return nil, time.Time{}, false
+	}
+	data := r.fresh
+	r.fresh = nil
+	return data, r.fetched, true
+}
+
+// inProgress reports whether a background refresh is running
+func (r *revalidation[T]) inProgress() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.running
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/unklstewy/digiLogRT/internal/config"
+)
+
+// ApplyConfig applies the package-wide settings from cfg: offline mode, the HTTP proxy and the cache format
+// Each is applied even when another is invalid, the invalid ones are left as they were and come back joined
+func ApplyConfig(cfg *config.Config) error {
+	SetOffline(cfg.Offline)
+
+	var errs []error
+	if err := SetProxy(cfg.HTTPProxy); err != nil {
+		errs = append(errs, fmt.Errorf("http_proxy: %v", err))
+	}
+	if err := SetCacheFormat(cfg.Cache.Format); err != nil {
+		errs = append(errs, fmt.Errorf("caching.format: %v", err))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,45 @@
+package api
+
+import "sync"
+
+// A cut-down golang.org/x/sync/singleflight: concurrent calls with the same key share one execution.
+// Every bulk download goes through it keyed by source and URL, so a sync and a GUI search that both find
+// the cache cold (or a background refresh racing a forced one) make a single request between them
+
+type flight struct {
+	wg   sync.WaitGroup
+	data interface{}
+	err  error
+}
+
+var (
+	flightsMu sync.Mutex
+	flights   = make(map[string]*flight)
+)
+
+// ShareFetch runs fetch, unless a fetch for the same key is already in flight, in which case it waits for
+// that one and returns its result. Every caller gets the same slice, none of them may modify it
+func ShareFetch[T any](key string, fetch func() ([]T, error)) ([]T, error) {
+	flightsMu.Lock()
+	if f, ok := flights[key]; ok {
+		flightsMu.Unlock()
+		f.wg.Wait()
+		data, _ := f.data.([]T)
+		return data, f.err
+	}
+	f := &flight{}
+	f.wg.Add(1)
+	flights[key] = f
+	flightsMu.Unlock()
+
+	defer func() {
+		flightsMu.Lock()
+		delete(flights, key)
+		flightsMu.Unlock()
+		f.wg.Done()
+	}()
+
+	data, err := fetch()
+	f.data, f.err = data, err
+	return data, err
+}
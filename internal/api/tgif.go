@@ -7,11 +7,13 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/metrics"
 )
 
 type TGIFTalkgroup struct {
@@ -19,7 +21,52 @@ type TGIFTalkgroup struct {
 	Name        string `json:"name"`
 	Website     string `json:"website"`
 	Description string `json:"description"`
-	// Add other fields as needed
+
+	// Optional metadata - the talkgroup list doesn't always include these,
+	// nil means the API didn't say rather than a default
+	Slot   *FlexibleInt  `json:"slot,omitempty"`   // Preferred timeslot (1 or 2)
+	Active *FlexibleBool `json:"active,omitempty"` // Whether the talkgroup is in service
+}
+
+// FlexibleBool handles booleans sent as true/false, 1/0 or "1"/"0"/"true"/"false"
+type FlexibleBool struct {
+	Value bool
+}
+
+func (fb *FlexibleBool) UnmarshalJSON(data []byte) error {
+	// Try to unmarshal as bool first
+	var boolValue bool
+	if err := json.Unmarshal(data, &boolValue); err == nil {
+		fb.Value = boolValue
+		return nil
+	}
+
+	// Then as a number
+	var intValue int
+	if err := json.Unmarshal(data, &intValue); err == nil {
+		fb.Value = intValue != 0
+		return nil
+	}
+
+	// Finally as a string
+	var stringValue string
+	if err := json.Unmarshal(data, &stringValue); err != nil {
+		return err
+	}
+
+	boolValue, err := strconv.ParseBool(strings.TrimSpace(stringValue))
+	if err != nil {
+		fb.Value = false
+		return nil // Don't fail on bad data
+	}
+
+	fb.Value = boolValue
+	return nil
+}
+
+// MarshalJSON writes the plain boolean so cached data reads back in
+func (fb FlexibleBool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fb.Value)
 }
 
 // TGIF API response structures
@@ -35,16 +82,14 @@ type TGIFClient struct {
 	allData        []TGIFTalkgroup
 	lastUpdate     time.Time
 	cacheValid     bool
-	startupRefresh time.Duration // Add this field
-	cacheTime      time.Duration // Add this field
+	startupRefresh time.Duration                // Add this field
+	cacheTime      time.Duration                // Add this field
+	revalidate     *revalidation[TGIFTalkgroup] // Background refresh of expired data, see ensureData
 }
 
 // getCacheFile returns the path to the cache file
 func (c *TGIFClient) getCacheFile() string {
-	// Use a cache directory in the user's cache folder or temp
-	cacheDir := filepath.Join(os.TempDir(), "digiLogRT", "cache")
-	os.MkdirAll(cacheDir, 0755) // Create directory if it doesn't exist
-	return filepath.Join(cacheDir, "tgif_talkgroups.json")
+	return cache.Path(cache.TGIFCacheFile)
 }
 
 // CheckCacheAge returns whether cache needs refresh and current age
@@ -52,23 +97,25 @@ func (c *TGIFClient) CheckCacheAge() (bool, time.Duration) {
 	cacheFile := c.getCacheFile()
 
 	// Check if cache file exists
-	info, err := os.Stat(cacheFile)
+	fetched, err := cache.FetchedAt(cacheFile)
 	if err != nil {
 		// Cache doesn't exist, needs refresh
 		return true, 0
 	}
 
-	age := time.Since(info.ModTime())
-	maxAge := 2 * time.Hour // TGIF cache valid for 2 hours
+	age := time.Since(fetched)
 
-	return age > maxAge, age
+	return age > c.startupRefresh, age
 }
 
 // RefreshCache forces a cache refresh
 func (c *TGIFClient) RefreshCache() error {
+	if IsOffline() {
+		return refreshRefused("tgif")
+	}
 	// Simply delete the cache file, next GetAllTalkgroups call will refresh
 	cacheFile := c.getCacheFile()
-	if err := os.Remove(cacheFile); err != nil && !os.IsNotExist(err) {
+	if err := cache.Remove(cacheFile); err != nil {
 		return fmt.Errorf("failed to remove cache file: %v", err)
 	}
 
@@ -96,61 +143,84 @@ func (tg *TGIFTalkgroup) GetIDInt() (int, error) {
 	return strconv.Atoi(tg.ID)
 }
 
-// Update your existing methods to work with string IDs
+// GetSlotInfo returns the talkgroup's timeslot, or "Unknown" when TGIF didn't report one
 func (tg *TGIFTalkgroup) GetSlotInfo() string {
-	// Implementation depends on how you determine slot info
-	// For now, return a placeholder
-	return "Slot 1" // Update based on your logic
+	if tg.Slot == nil || tg.Slot.Value <= 0 {
+		return "Unknown"
+	}
+	return fmt.Sprintf("Slot %d", tg.Slot.Value)
 }
 
-func (tg *TGIFTalkgroup) IsActive() bool {
-	// Implementation depends on how you determine if active
-	// For now, return true
-	return true // Update based on your logic
+// IsActive returns whether the talkgroup is active, and whether TGIF reported that at all
+func (tg *TGIFTalkgroup) IsActive() (active bool, known bool) {
+	if tg.Active == nil {
+		return false, false
+	}
+	return tg.Active.Value, true
 }
-func NewTGIFClient() *TGIFClient {
+
+// NewTGIFClient creates a TGIF client using the caching.tgif config section (2h when unset)
+func NewTGIFClient(cache config.CacheSettings) *TGIFClient {
 	return &TGIFClient{
 		BaseURL:        "https://api.tgif.network/dmr/talkgroups/json",
-		httpClient:     &http.Client{Timeout: 30 * time.Second},
-		startupRefresh: 2 * time.Hour, // Default refresh interval
-		cacheTime:      2 * time.Hour, // Default cache validity
+		httpClient:     newHTTPClient(30 * time.Second),
+		startupRefresh: cache.StartupRefreshTime(2 * time.Hour), // Default refresh interval
+		cacheTime:      cache.CacheTime(2 * time.Hour),          // Default cache validity
+		revalidate:     newRevalidation[TGIFTalkgroup](),
 	}
 }
 
-// Fetch all talkgroup data from TGIF with change detection
+// Fetch all talkgroup data from TGIF
 func (c *TGIFClient) fetchAllData() error {
-	fmt.Println("Fetching talkgroup data from TGIF.network...")
-
-	resp, err := c.httpClient.Get(c.BaseURL) // Use httpClient instead of c.client
-	if err != nil {
-		return fmt.Errorf("failed to make request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API returned status %d", resp.StatusCode)
+	if IsOffline() {
+		return c.useCacheOffline()
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	fmt.Println("Fetching talkgroup data from TGIF.network...")
+	talkgroups, err := c.download()
 	if err != nil {
-		return fmt.Errorf("failed to read response: %v", err)
-	}
-
-	var response TGIFTalkgroupResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return fmt.Errorf("failed to parse JSON: %v", err)
+		return err
 	}
 
 	// Just make sure to set cacheValid = true when data is loaded
-	c.allData = response.Talkgroups
+	c.allData = talkgroups
 	c.lastUpdate = time.Now()
 	c.cacheValid = true
 	return nil
 }
 
+// download fetches the talkgroup list, concurrent callers on any TGIF client share one request
+func (c *TGIFClient) download() ([]TGIFTalkgroup, error) {
+	return ShareFetch("tgif "+c.BaseURL, func() (talkgroups []TGIFTalkgroup, err error) {
+		start := time.Now()
+		defer func() { metrics.ObserveFetch("tgif", start, err) }()
+
+		resp, err := c.httpClient.Get(c.BaseURL)
+		if err != nil {
+			return nil, networkError("tgif", "failed to make request: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, statusError("tgif", resp.StatusCode, "API returned status %d", resp.StatusCode)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, networkError("tgif", "failed to read response: %w", err)
+		}
+
+		talkgroups, err = decodeList[TGIFTalkgroup](body, "talkgroups")
+		if err != nil {
+			return nil, decodeError("tgif", "failed to parse JSON: %w", err)
+		}
+		return talkgroups, nil
+	})
+}
+
 // Ensure we have fresh data with intelligent refresh logic
 func (c *TGIFClient) ensureData() error {
-	now := time.Now()
+	c.useRevalidated()
 
 	// If no data, always fetch
 	if len(c.allData) == 0 {
@@ -158,20 +228,37 @@ func (c *TGIFClient) ensureData() error {
 		return c.fetchAllData()
 	}
 
-	// If cache is very old, force refresh
-	if now.Sub(c.lastUpdate) > c.cacheTime {
-		fmt.Printf("Cache expired (%v old), refreshing...\n", now.Sub(c.lastUpdate).Round(time.Minute))
-		return c.fetchAllData()
+	// Offline there's nothing newer to get, keep using what was loaded
+	if IsOffline() {
+		return nil
+	}
+
+	// If cache is very old, refresh it in the background and keep answering from it meanwhile
+	if age := time.Since(c.lastUpdate); age > c.cacheTime {
+		clone := *c // The refresh runs on a copy, so it can't touch the data in use
+		if c.revalidate.start("tgif", clone.fetchAndSave) {
+			fmt.Printf("Cache expired (%v old), refreshing in the background...\n", age.Round(time.Minute))
+		}
 	}
 
-	// Cache is valid, use existing data
 	return nil
 }
 
 // Force refresh of data (for manual updates)
 func (c *TGIFClient) ForceRefresh() error {
+	if IsOffline() {
+		return refreshRefused("tgif")
+	}
 	fmt.Println("Force refreshing TGIF.network data...")
-	return c.fetchAllData()
+	if err := c.fetchAllData(); err != nil {
+		return err
+	}
+
+	// Keep the file cache in step, so the next start doesn't see the old data as stale
+	if err := c.saveToCache(c.allData); err != nil {
+		log.Printf("Warning: Failed to save cache to file: %v", err)
+	}
+	return nil
 }
 
 // Check if data should be refreshed on startup
@@ -202,13 +289,23 @@ func (c *TGIFClient) Initialize() error {
 }
 
 // Get cache status information
+// Until the client has fetched data it describes the cache file instead, last_update is zero when there is none
 func (c *TGIFClient) GetCacheStatus() map[string]interface{} {
+	lastUpdate := c.lastUpdate
+	needsRefresh := c.ShouldRefreshOnStartup()
+	if lastUpdate.IsZero() {
+		lastUpdate = cacheFileUpdated(cache.SourceTGIF)
+		needsRefresh = lastUpdate.IsZero() || time.Since(lastUpdate) > c.startupRefresh
+	}
+
 	return map[string]interface{}{
 		"count":         len(c.allData),
-		"last_update":   c.lastUpdate,
-		"age":           time.Since(c.lastUpdate),
-		"cache_valid":   time.Since(c.lastUpdate) < c.cacheTime,
-		"needs_refresh": c.ShouldRefreshOnStartup(),
+		"last_update":   lastUpdate,
+		"age":           time.Since(lastUpdate),
+		"cache_valid":   time.Since(lastUpdate) < c.cacheTime,
+		"needs_refresh": needsRefresh,
+		"stale":         len(c.allData) > 0 && time.Since(lastUpdate) > c.cacheTime, // Searches are answered from expired data
+		"revalidating":  c.revalidate.inProgress(),
 	}
 }
 
@@ -255,10 +352,13 @@ func (c *TGIFClient) SearchTalkgroups(query string) (*TGIFTalkgroupResponse, err
 func (c *TGIFClient) GetAllTalkgroups() ([]TGIFTalkgroup, error) {
 	// Try to load from file cache first
 	if data, err := c.loadFromCache(); err == nil {
+		metrics.CacheHits.Inc("tgif")
 		c.allData = data
+		c.lastUpdate = cacheFileUpdated(cache.SourceTGIF)
 		c.cacheValid = true
 		return data, nil
 	}
+	metrics.CacheMisses.Inc("tgif")
 
 	// If file cache miss, fetch from API
 	if err := c.refreshData(); err != nil {
@@ -275,29 +375,17 @@ func (c *TGIFClient) GetAllTalkgroups() ([]TGIFTalkgroup, error) {
 
 // refreshData fetches fresh data from TGIF API
 func (c *TGIFClient) refreshData() error {
-	fmt.Println("Fetching talkgroup data from TGIF.network...")
-
-	resp, err := c.httpClient.Get(c.BaseURL)
-	if err != nil {
-		return fmt.Errorf("failed to fetch data: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API returned status %d", resp.StatusCode)
+	if IsOffline() {
+		return c.useCacheOffline()
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	fmt.Println("Fetching talkgroup data from TGIF.network...")
+	talkgroups, err := c.download()
 	if err != nil {
-		return fmt.Errorf("failed to read response: %v", err)
-	}
-
-	var response TGIFTalkgroupResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return fmt.Errorf("failed to parse JSON: %v", err)
+		return err
 	}
 
-	c.allData = response.Talkgroups
+	c.allData = talkgroups
 	c.lastUpdate = time.Now()
 	c.cacheValid = true
 
@@ -310,45 +398,57 @@ func (c *TGIFClient) loadFromCache() ([]TGIFTalkgroup, error) {
 	cacheFile := c.getCacheFile()
 
 	// Check if file exists and is fresh enough
-	info, err := os.Stat(cacheFile)
+	fetched, err := cache.FetchedAt(cacheFile)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check cache age
-	age := time.Since(info.ModTime())
-	if age > 2*time.Hour {
+	// Check cache age (offline, old data beats none)
+	age := time.Since(fetched)
+	if age > c.startupRefresh && !IsOffline() {
 		return nil, fmt.Errorf("cache too old: %v", age)
 	}
 
 	// Read and parse cache file
-	data, err := os.ReadFile(cacheFile)
-	if err != nil {
+	return cache.LoadCache[TGIFTalkgroup](cacheFile)
+}
+
+// fetchAndSave fetches fresh data and writes it to the file cache, it's what a background refresh runs
+func (c *TGIFClient) fetchAndSave() ([]TGIFTalkgroup, error) {
+	if err := c.fetchAllData(); err != nil {
 		return nil, err
 	}
-
-	var talkgroups []TGIFTalkgroup
-	err = json.Unmarshal(data, &talkgroups)
-	return talkgroups, err
+	if err := c.saveToCache(c.allData); err != nil {
+		log.Printf("Warning: Failed to save cache to file: %v", err)
+	}
+	return c.allData, nil
 }
 
-// saveToCache saves data to file cache
-func (c *TGIFClient) saveToCache(data []TGIFTalkgroup) error {
-	cacheFile := c.getCacheFile()
-
-	// Ensure cache directory exists
-	if err := os.MkdirAll(filepath.Dir(cacheFile), 0755); err != nil {
-		return err
+// useRevalidated swaps in the data of a finished background refresh
+func (c *TGIFClient) useRevalidated() {
+	if data, fetched, ok := c.revalidate.take(); ok {
+		c.allData = data
+		c.lastUpdate = fetched
+		c.cacheValid = true
 	}
+}
 
-	// Marshal to JSON
-	jsonData, err := json.MarshalIndent(data, "", "  ")
+// useCacheOffline loads the file cache whatever its age, in offline mode it's all the data there is
+func (c *TGIFClient) useCacheOffline() error {
+	data, err := c.loadFromCache()
 	if err != nil {
-		return err
+		return noCachedData("tgif")
 	}
 
-	// Write to file
-	return os.WriteFile(cacheFile, jsonData, 0644)
+	c.allData = data
+	c.lastUpdate = cacheFileUpdated(cache.SourceTGIF)
+	c.cacheValid = true
+	return nil
+}
+
+// saveToCache saves data to file cache
+func (c *TGIFClient) saveToCache(data []TGIFTalkgroup) error {
+	return cache.SaveCacheFrom(c.getCacheFile(), c.BaseURL, c.lastUpdate, data)
 }
 
 // Test the API connection
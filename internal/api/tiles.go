@@ -0,0 +1,94 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api/cache"
+)
+
+// tileCacheAge is how long a downloaded map tile is reused before it is fetched again
+// OpenStreetMap's tile policy asks clients to cache tiles for at least a week
+const tileCacheAge = 7 * 24 * time.Hour
+
+// TileClient fetches slippy-map tiles (256px PNGs addressed by zoom, x and y) and caches them on disk
+type TileClient struct {
+	URLTemplate string // e.g. "https://tile.openstreetmap.org/{z}/{x}/{y}.png"
+	UserAgent   string // Tile servers require an identifying User-Agent
+	client      *http.Client
+	cacheDir    string
+}
+
+// NewTileClient creates a tile client for a {z}/{x}/{y} URL template
+func NewTileClient(urlTemplate, userAgent string) *TileClient {
+	return &TileClient{
+		URLTemplate: urlTemplate,
+		UserAgent:   userAgent,
+		client:      newHTTPClient(15 * time.Second),
+		cacheDir:    filepath.Join(cache.Dir(), "tiles"),
+	}
+}
+
+// GetTile returns the image data of one tile, from the disk cache when it is fresh enough
+// A stale cached tile is still returned if the server can't be reached
+func (c *TileClient) GetTile(z, x, y int) ([]byte, error) {
+	path := filepath.Join(c.cacheDir, strconv.Itoa(z), strconv.Itoa(x), strconv.Itoa(y)+".png")
+
+	cached, cacheErr := os.ReadFile(path)
+	if cacheErr == nil {
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) < tileCacheAge {
+			return cached, nil
+		}
+	}
+
+	data, err := c.fetchTile(z, x, y)
+	if err != nil {
+		if cacheErr == nil {
+			return cached, nil
+		}
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err == nil {
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			fmt.Printf("Warning: failed to cache tile %d/%d/%d: %v\n", z, x, y, err)
+		}
+	}
+	return data, nil
+}
+
+func (c *TileClient) fetchTile(z, x, y int) ([]byte, error) {
+	url := strings.NewReplacer(
+		"{z}", strconv.Itoa(z),
+		"{x}", strconv.Itoa(x),
+		"{y}", strconv.Itoa(y),
+	).Replace(c.URLTemplate)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tile request: %v", err)
+	}
+	req.Header.Set("User-Agent", c.UserAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, networkError("tiles", "failed to fetch tile %d/%d/%d: %w", z, x, y, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, statusError("tiles", resp.StatusCode, "tile server returned status %d for %d/%d/%d", resp.StatusCode, z, x, y)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, networkError("tiles", "failed to read tile %d/%d/%d: %w", z, x, y, err)
+	}
+	return data, nil
+}
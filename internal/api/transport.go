@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/config"
+)
+
+// All API clients share one transport, so they share connections and proxy settings
+// Without a configured proxy it follows HTTP_PROXY, HTTPS_PROXY and NO_PROXY from the environment
+
+var (
+	proxyMu  sync.RWMutex
+	proxyURL *url.URL // Set by SetProxy, nil means use the environment
+)
+
+var sharedTransport = func() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxyForRequest
+	return transport
+}()
+
+// proxyForRequest picks the configured proxy, falling back to the environment
+func proxyForRequest(req *http.Request) (*url.URL, error) {
+	proxyMu.RLock()
+	configured := proxyURL
+	proxyMu.RUnlock()
+
+	if configured != nil {
+		return configured, nil
+	}
+	return http.ProxyFromEnvironment(req)
+}
+
+// SetProxy sends every client's requests through proxy, e.g. "http://proxy:3128" or "socks5://127.0.0.1:9050"
+// An empty string goes back to the environment's proxy settings. It applies to existing clients too
+func SetProxy(proxy string) error {
+	var parsed *url.URL
+	if proxy != "" {
+		var err error
+		if parsed, err = config.ParseProxyURL(proxy); err != nil {
+			return err
+		}
+	}
+
+	proxyMu.Lock()
+	proxyURL = parsed
+	proxyMu.Unlock()
+
+	// Connections opened through the old proxy would otherwise keep being reused
+	sharedTransport.CloseIdleConnections()
+	return nil
+}
+
+// newHTTPClient returns a client on the shared transport, which refuses every request in offline mode
+func newHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout, Transport: offlineTransport{sharedTransport}}
+}
@@ -1,5 +1,11 @@
 package api
 
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
 // Shared utility functions for all API clients
 
 // Helper function for min
@@ -17,3 +23,41 @@ func max(a, b int) int {
 	}
 	return b
 }
+
+// haversineKm calculates the great-circle distance between two points in kilometers
+func haversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	const earthRadius = 6371 // Earth's radius in kilometers
+
+	lat1Rad := lat1 * math.Pi / 180
+	lat2Rad := lat2 * math.Pi / 180
+	dlat := (lat2 - lat1) * math.Pi / 180
+	dlng := (lng2 - lng1) * math.Pi / 180
+
+	a := math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(lat1Rad)*math.Cos(lat2Rad)*math.Sin(dlng/2)*math.Sin(dlng/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return earthRadius * c
+}
+
+// decodeList decodes a response that is either a bare JSON array or an object holding the array under key
+// Both TGIF and Brandmeister have served each shape at different times
+func decodeList[T any](body []byte, key string) ([]T, error) {
+	var list []T
+	arrayErr := json.Unmarshal(body, &list)
+	if arrayErr == nil {
+		return list, nil
+	}
+
+	var wrapped map[string]json.RawMessage
+	if err := json.Unmarshal(body, &wrapped); err != nil {
+		return nil, arrayErr
+	}
+	raw, ok := wrapped[key]
+	if !ok {
+		return nil, fmt.Errorf("expected an array or an object with %q", key)
+	}
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// APISettings hold the API keys and, for Brandmeister, where its API lives
+type APISettings struct {
+	AprsKey         APIKeys `yaml:"aprs_key"` // One key or a list, lookups rotate through them
+	RepeaterBookKey string  `yaml:"repeater_book_key"`
+	BrandmeisterKey string  `yaml:"brandmeister_key"`
+
+	// Brandmeister moves its device list between API versions now and then. Empty values use the built-in
+	// server and probe the known device list paths; set these to follow a move without a new build
+	BrandmeisterBaseURL  string `yaml:"brandmeister_base_url"` // e.g. "https://api.brandmeister.network"
+	BrandmeisterEndpoint string `yaml:"brandmeister_endpoint"` // Device list path, e.g. "/v2/device"; only this one is tried
+}
+
+// validate checks the Brandmeister overrides, a bad URL would otherwise only show up as failed syncs
+func (s APISettings) validate() error {
+	if s.BrandmeisterBaseURL != "" {
+		parsed, err := url.Parse(s.BrandmeisterBaseURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid apis.brandmeister_base_url %q: must be an http or https URL", s.BrandmeisterBaseURL)
+		}
+	}
+	if s.BrandmeisterEndpoint != "" && !strings.HasPrefix(s.BrandmeisterEndpoint, "/") {
+		return fmt.Errorf("invalid apis.brandmeister_endpoint %q: must be a path starting with /", s.BrandmeisterEndpoint)
+	}
+	return nil
+}
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -18,11 +22,157 @@ type Config struct {
 		Height int `yaml:"height"`
 	} `yaml:"window"`
 
-	APIs struct {
-		AprsKey         string `yaml:"aprs_key"`
-		RepeaterBookKey string `yaml:"repeater_book_key"`
-		BrandmeisterKey string `yaml:"brandmeister_key"`
-	} `yaml:"apis"`
+	APIs APISettings `yaml:"apis"`
+
+	Database struct {
+		Path           string `yaml:"path"`            // SQLite database file shared by the GUI and sync tools
+		CheckIntegrity bool   `yaml:"check_integrity"` // Run Database.IntegrityCheck when the GUI starts
+	} `yaml:"database"`
+
+	Cache CacheConfig `yaml:"caching"`
+
+	Units         Units       `yaml:"units"`          // Distance units shown in the UI: "km" or "mi"
+	DefaultRadius float64     `yaml:"default_radius"` // Starting radius for nearby searches, in Units
+	HeightUnits   HeightUnits `yaml:"height_units"`   // Antenna heights shown in the UI: "m" or "ft"
+
+	RepeaterBook RepeaterBookSettings `yaml:"repeaterbook"`
+
+	Logging LoggingSettings `yaml:"logging"`
+
+	Location LocationSettings `yaml:"location"` // The user's station, see GetUserLocation
+
+	Map MapSettings `yaml:"map"`
+
+	Search SearchSettings `yaml:"search"` // Minimum power filter applied to searches and exports
+
+	Daemon DaemonSettings `yaml:"daemon"` // Schedule of cmd/syncd
+
+	HTTPProxy string `yaml:"http_proxy"` // Proxy for all API traffic, empty uses HTTP_PROXY/HTTPS_PROXY from the environment
+	Offline   bool   `yaml:"offline"`    // Never touch the network, work from the caches and database; OfflineEnv overrides it
+
+	MaxConcurrentFetches int `yaml:"max_concurrent_fetches"` // Most sources fetched at once when warming, initializing or health checking
+}
+
+// DefaultMaxConcurrentFetches is the fetch limit when max_concurrent_fetches is left out, enough for every
+// source today
+const DefaultMaxConcurrentFetches = 4
+
+// RepeaterBookSettings control the RepeaterBook sync
+// RepeaterBook has no "everything" export, so the sync fetches each listed region in turn
+type RepeaterBookSettings struct {
+	States       []string `yaml:"states"`        // North American states/provinces, e.g. "Pennsylvania"
+	Countries    []string `yaml:"countries"`     // Whole countries, e.g. "Australia"
+	RequestDelay string   `yaml:"request_delay"` // Pause between API calls, e.g. "5s"
+}
+
+// RequestDelayTime returns the configured delay between RepeaterBook calls, or def when unset
+func (s RepeaterBookSettings) RequestDelayTime(def time.Duration) time.Duration {
+	return parseDurationOr(s.RequestDelay, def)
+}
+
+// CacheConfig holds the per-source cache freshness settings and the format the cache files are written in
+type CacheConfig struct {
+	Format       string        `yaml:"format"` // "json" (the default) or "gob", a smaller binary format faster to load
+	Brandmeister CacheSettings `yaml:"brandmeister"`
+	TGIF         CacheSettings `yaml:"tgif"`
+	Hearham      CacheSettings `yaml:"hearham"`
+	APRS         CacheSettings `yaml:"aprs"`
+	RepeaterBook CacheSettings `yaml:"repeaterbook"`
+}
+
+// CacheSettings are the cache durations for one source, as duration strings (e.g. "6h")
+// Empty values mean "use the client's built-in default"
+type CacheSettings struct {
+	CacheDuration   string `yaml:"cache_duration"`   // How long cached data is valid
+	StartupRefresh  string `yaml:"startup_refresh"`  // Force refresh if older than this on startup
+	BackgroundCheck string `yaml:"background_check"` // Check for updates every X
+}
+
+// CacheTime returns the configured cache duration, or def when unset
+func (s CacheSettings) CacheTime(def time.Duration) time.Duration {
+	return parseDurationOr(s.CacheDuration, def)
+}
+
+// StartupRefreshTime returns the configured startup refresh age, or def when unset
+func (s CacheSettings) StartupRefreshTime(def time.Duration) time.Duration {
+	return parseDurationOr(s.StartupRefresh, def)
+}
+
+// BackgroundCheckTime returns the configured background check interval, or def when unset
+func (s CacheSettings) BackgroundCheckTime(def time.Duration) time.Duration {
+	return parseDurationOr(s.BackgroundCheck, def)
+}
+
+// validate makes sure every duration that was set can be parsed
+func (s CacheSettings) validate(source string) error {
+	for name, value := range map[string]string{
+		"cache_duration":   s.CacheDuration,
+		"startup_refresh":  s.StartupRefresh,
+		"background_check": s.BackgroundCheck,
+	} {
+		if value == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+			return fmt.Errorf("invalid caching.%s.%s %q: must be a positive duration like \"6h\"", source, name, value)
+		}
+	}
+	return nil
+}
+
+func parseDurationOr(value string, def time.Duration) time.Duration {
+	if value == "" {
+		return def
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
+
+// DefaultDatabasePath returns the per-user location of the production database
+func DefaultDatabasePath() string {
+	// Prefer the user's cache folder, fall back to temp like the API caches do
+	dataDir, err := os.UserCacheDir()
+	if err != nil {
+		dataDir = os.TempDir()
+	}
+	return filepath.Join(dataDir, "digiLogRT", "digilog_production.db")
+}
+
+// ParseProxyURL checks a proxy URL has a scheme the transport supports and a host
+func ParseProxyURL(proxy string) (*url.URL, error) {
+	parsed, err := url.Parse(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %v", proxy, err)
+	}
+	switch parsed.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http, https or socks5", proxy)
+	}
+	if parsed.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", proxy)
+	}
+	return parsed, nil
+}
+
+// OfflineEnv overrides the offline setting, e.g. DIGILOGRT_OFFLINE=1 for a trip out of signal range
+const OfflineEnv = "DIGILOGRT_OFFLINE"
+
+// applyOfflineEnv sets Offline from OfflineEnv when it's set
+func (c *Config) applyOfflineEnv() error {
+	value := os.Getenv(OfflineEnv)
+	if value == "" {
+		return nil
+	}
+	offline, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: must be true or false", OfflineEnv, value)
+	}
+	c.Offline = offline
+	return nil
 }
 
 func LoadConfig() (*Config, error) {
@@ -39,11 +189,94 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	// Reject cache durations we can't parse rather than silently ignoring them
+	for source, settings := range map[string]CacheSettings{
+		"brandmeister": config.Cache.Brandmeister,
+		"tgif":         config.Cache.TGIF,
+		"hearham":      config.Cache.Hearham,
+		"aprs":         config.Cache.APRS,
+		"repeaterbook": config.Cache.RepeaterBook,
+	} {
+		if err := settings.validate(source); err != nil {
+			return nil, err
+		}
+	}
+
+	switch config.Cache.Format {
+	case "", "json", "gob":
+	default:
+		return nil, fmt.Errorf("invalid caching.format %q: must be \"json\" or \"gob\"", config.Cache.Format)
+	}
+
+	if config.RepeaterBook.RequestDelay != "" {
+		if d, err := time.ParseDuration(config.RepeaterBook.RequestDelay); err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid repeaterbook.request_delay %q: must be a positive duration like \"5s\"", config.RepeaterBook.RequestDelay)
+		}
+	}
+
+	if err := config.APIs.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.Logging.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.Location.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.Map.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.Search.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.Daemon.validate(); err != nil {
+		return nil, err
+	}
+	if config.HTTPProxy != "" {
+		if _, err := ParseProxyURL(config.HTTPProxy); err != nil {
+			return nil, fmt.Errorf("invalid http_proxy: %v", err)
+		}
+	}
+
+	if err := config.applyOfflineEnv(); err != nil {
+		return nil, err
+	}
+
+	units, err := ParseUnits(string(config.Units))
+	if err != nil {
+		return nil, err
+	}
+	config.Units = units
+	heightUnits, err := ParseHeightUnits(string(config.HeightUnits))
+	if err != nil {
+		return nil, err
+	}
+	config.HeightUnits = heightUnits
+	if config.MaxConcurrentFetches < 0 {
+		return nil, fmt.Errorf("invalid max_concurrent_fetches %d: must be positive", config.MaxConcurrentFetches)
+	}
+	if config.DefaultRadius < 0 {
+		return nil, fmt.Errorf("invalid default_radius %v: must be positive", config.DefaultRadius)
+	}
+
+	// Fill in defaults for settings that were left out of the file
+	if config.Database.Path == "" {
+		config.Database.Path = DefaultDatabasePath()
+	}
+	if config.DefaultRadius == 0 {
+		config.DefaultRadius = DefaultRadius
+	}
+	if config.MaxConcurrentFetches == 0 {
+		config.MaxConcurrentFetches = DefaultMaxConcurrentFetches
+	}
+	config.Map = config.Map.withDefaults()
+	config.Daemon = config.Daemon.withDefaults()
+
 	return &config, nil
 }
 
 func GetDefaultConfig() *Config {
-	return &Config{
+	cfg := &Config{
 		App: struct {
 			Name    string `yaml:"name"`
 			Version string `yaml:"version"`
@@ -58,12 +291,22 @@ func GetDefaultConfig() *Config {
 			Width:  1200,
 			Height: 800,
 		},
-		APIs: struct {
-			AprsKey         string `yaml:"aprs_key"`
-			RepeaterBookKey string `yaml:"repeater_book_key"`
-			BrandmeisterKey string `yaml:"brandmeister_key"`
+		APIs: APISettings{
+			AprsKey: APIKeys{"126515.6ryMvtanTmJDG"},
+		},
+		Database: struct {
+			Path           string `yaml:"path"`
+			CheckIntegrity bool   `yaml:"check_integrity"`
 		}{
-			AprsKey: "126515.6ryMvtanTmJDG",
+			Path: DefaultDatabasePath(),
 		},
+		Units:                Kilometers,
+		DefaultRadius:        DefaultRadius,
+		MaxConcurrentFetches: DefaultMaxConcurrentFetches,
+		HeightUnits:          Meters,
+		Map:                  MapSettings{}.withDefaults(),
+		Daemon:               DaemonSettings{}.withDefaults(),
 	}
+	cfg.applyOfflineEnv() // The defaults can't fail, an invalid value leaves offline mode off
+	return cfg
 }
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// Daemon defaults, used for settings left out of the config file
+const (
+	DefaultDaemonWarmInterval = 30 * time.Minute
+	DefaultDaemonSyncInterval = 6 * time.Hour
+	DefaultDaemonAddr         = ":9100"
+)
+
+// DaemonSettings control cmd/syncd, the background service that keeps the caches and database fresh
+type DaemonSettings struct {
+	WarmInterval string  `yaml:"warm_interval"` // Refresh caches older than this, this often, e.g. "30m"
+	SyncInterval string  `yaml:"sync_interval"` // Sync the database from the caches this often, e.g. "6h"
+	Addr         string  `yaml:"addr"`          // Where /healthz and /metrics are served, empty means :9100
+	RateLimit    float64 `yaml:"rate_limit"`    // Most records per second a sync writes, 0 means unlimited
+}
+
+// WarmIntervalTime returns the configured cache warming interval, or the default
+func (s DaemonSettings) WarmIntervalTime() time.Duration {
+	return parseDurationOr(s.WarmInterval, DefaultDaemonWarmInterval)
+}
+
+// SyncIntervalTime returns the configured sync interval, or the default
+func (s DaemonSettings) SyncIntervalTime() time.Duration {
+	return parseDurationOr(s.SyncInterval, DefaultDaemonSyncInterval)
+}
+
+// validate checks the settings LoadConfig can't fix up itself
+func (s DaemonSettings) validate() error {
+	for name, value := range map[string]string{
+		"warm_interval": s.WarmInterval,
+		"sync_interval": s.SyncInterval,
+	} {
+		if value == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+			return fmt.Errorf("invalid daemon.%s %q: must be a positive duration like \"30m\"", name, value)
+		}
+	}
+	if s.RateLimit < 0 {
+		return fmt.Errorf("invalid daemon.rate_limit %v: must be 0 (unlimited) or more", s.RateLimit)
+	}
+	return nil
+}
+
+// withDefaults fills in the settings that were left out
+func (s DaemonSettings) withDefaults() DaemonSettings {
+	if s.Addr == "" {
+		s.Addr = DefaultDaemonAddr
+	}
+	return s
+}
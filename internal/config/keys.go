@@ -0,0 +1,39 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// APIKeys holds one or more keys for an API, clients spread their requests across them
+// In YAML it's either a single string or a list:
+//
+//	aprs_key: "abc"
+//	aprs_key: ["abc", "def"]
+type APIKeys []string
+
+func (k *APIKeys) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var single string
+	if err := unmarshal(&single); err == nil {
+		*k = APIKeys{single}.withoutBlanks()
+		return nil
+	}
+
+	var list []string
+	if err := unmarshal(&list); err != nil {
+		return fmt.Errorf("API key must be a string or a list of strings")
+	}
+	*k = APIKeys(list).withoutBlanks()
+	return nil
+}
+
+// withoutBlanks drops empty keys, an unset key in the YAML means none
+func (k APIKeys) withoutBlanks() APIKeys {
+	var keys APIKeys
+	for _, key := range k {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
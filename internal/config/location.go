@@ -0,0 +1,105 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/unklstewy/digiLogRT/internal/maidenhead"
+)
+
+// LocationSettings are the user's own station location
+// Set latitude/longitude, or just a grid square for an approximate position
+type LocationSettings struct {
+	Latitude  float64 `yaml:"latitude"`  // Decimal degrees, 0,0 means not set
+	Longitude float64 `yaml:"longitude"` // Negative is west
+	Grid      string  `yaml:"grid"`      // Maidenhead grid square, e.g. "FM29kw", used when the coordinates aren't set
+}
+
+// validate checks the coordinates are in range and the grid square parses
+func (s LocationSettings) validate() error {
+	if s.Latitude < -90 || s.Latitude > 90 {
+		return fmt.Errorf("invalid location.latitude %v: must be between -90 and 90", s.Latitude)
+	}
+	if s.Longitude < -180 || s.Longitude > 180 {
+		return fmt.Errorf("invalid location.longitude %v: must be between -180 and 180", s.Longitude)
+	}
+	if s.Grid != "" {
+		if _, _, err := maidenhead.ToLatLng(s.Grid); err != nil {
+			return fmt.Errorf("invalid location.grid: %v", err)
+		}
+	}
+	return nil
+}
+
+// GetUserLocation returns the user's coordinates, from latitude/longitude or else the center of the grid square
+// ok is false when no location is configured
+func (c *Config) GetUserLocation() (lat, lng float64, ok bool) {
+	if c.Location.Latitude != 0 || c.Location.Longitude != 0 {
+		return c.Location.Latitude, c.Location.Longitude, true
+	}
+	if c.Location.Grid != "" {
+		if lat, lng, err := maidenhead.ToLatLng(c.Location.Grid); err == nil {
+			return lat, lng, true
+		}
+	}
+	return 0, 0, false
+}
+
+// locationKey matches the top-level line that opens the location section
+var locationKey = regexp.MustCompile(`^location:\s*(#.*)?$`)
+
+// SaveLocation validates a location and writes it to the location section of the config file,
+// leaving the rest of the file and its comments as they are
+func SaveLocation(location LocationSettings) error {
+	if err := location.validate(); err != nil {
+		return err
+	}
+
+	configPath := filepath.Join("configs", "config.yaml")
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to read config: %v", err)
+	}
+
+	block := []string{
+		"location:",
+		fmt.Sprintf("%-25s # Your station, used to center the map and nearby searches",
+			"  latitude: "+strconv.FormatFloat(location.Latitude, 'f', -1, 64)),
+		"  longitude: " + strconv.FormatFloat(location.Longitude, 'f', -1, 64),
+		fmt.Sprintf("%-25s # Maidenhead grid square, used when latitude/longitude are 0",
+			"  grid: "+strconv.Quote(location.Grid)),
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	start := -1
+	for i, line := range lines {
+		if locationKey.MatchString(line) {
+			start = i
+			break
+		}
+	}
+
+	if start < 0 {
+		lines = append(lines, "", "# Your station")
+		lines = append(lines, block...)
+	} else {
+		// The section runs until the next line that isn't indented (or blank)
+		end := start + 1
+		for end < len(lines) && (lines[end] == "" || lines[end][0] == ' ' || lines[end][0] == '\t') {
+			end++
+		}
+		for end > start+1 && lines[end-1] == "" {
+			end-- // Keep the blank lines between sections
+		}
+		lines = append(lines[:start], append(block, lines[end:]...)...)
+	}
+
+	if err := os.WriteFile(configPath, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to write config: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// LoggingSettings control where log output goes and how much of it there is
+type LoggingSettings struct {
+	Level      string `yaml:"level"`       // "debug", "info", "warn" or "error", empty means info
+	File       string `yaml:"file"`        // Log file path, empty logs to stderr
+	MaxSizeMB  int    `yaml:"max_size_mb"` // Rotate the file when it grows past this, 0 never rotates
+	MaxBackups int    `yaml:"max_backups"` // Rotated files to keep (file.1, file.2, ...), 0 means 3
+}
+
+// ParseLogLevel accepts debug, info, warn/warning and error in any case, empty means info
+func ParseLogLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("invalid logging.level %q: must be debug, info, warn or error", s)
+}
+
+// validate checks the settings LoadConfig can't fix up itself
+func (s LoggingSettings) validate() error {
+	if _, err := ParseLogLevel(s.Level); err != nil {
+		return err
+	}
+	if s.MaxSizeMB < 0 {
+		return fmt.Errorf("invalid logging.max_size_mb %d: must not be negative", s.MaxSizeMB)
+	}
+	if s.MaxBackups < 0 {
+		return fmt.Errorf("invalid logging.max_backups %d: must not be negative", s.MaxBackups)
+	}
+	return nil
+}
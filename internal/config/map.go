@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultTileURL is the OpenStreetMap standard tile layer
+const DefaultTileURL = "https://tile.openstreetmap.org/{z}/{x}/{y}.png"
+
+// Map defaults, used for settings left out of the config file
+const (
+	DefaultMapLatitude  = 39.8283 // Middle of the contiguous US, where the map opens without a location
+	DefaultMapLongitude = -98.5795
+	DefaultMapZoom      = 4
+	MinMapZoom          = 2
+	MaxMapZoom          = 18
+)
+
+// MapSettings control the in-app map, which opens centered on the user's location
+type MapSettings struct {
+	Zoom    int    `yaml:"zoom"`     // 2 (world) to 18 (street), 0 means the default
+	TileURL string `yaml:"tile_url"` // Tile server template with {z}, {x} and {y}, empty means OpenStreetMap
+}
+
+// validate checks the settings LoadConfig can't fix up itself
+func (s MapSettings) validate() error {
+	if s.Zoom != 0 && (s.Zoom < MinMapZoom || s.Zoom > MaxMapZoom) {
+		return fmt.Errorf("invalid map.zoom %d: must be between %d and %d", s.Zoom, MinMapZoom, MaxMapZoom)
+	}
+	if s.TileURL != "" {
+		for _, placeholder := range []string{"{z}", "{x}", "{y}"} {
+			if !strings.Contains(s.TileURL, placeholder) {
+				return fmt.Errorf("invalid map.tile_url %q: missing %s", s.TileURL, placeholder)
+			}
+		}
+	}
+	return nil
+}
+
+// withDefaults fills in the settings that were left out
+func (s MapSettings) withDefaults() MapSettings {
+	if s.Zoom == 0 {
+		s.Zoom = DefaultMapZoom
+	}
+	if s.TileURL == "" {
+		s.TileURL = DefaultTileURL
+	}
+	return s
+}
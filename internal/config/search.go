@@ -0,0 +1,33 @@
+package config
+
+import "fmt"
+
+// Ways to treat repeaters that list no power under search.min_power_watts
+const (
+	UnknownPowerInclude = "include"
+	UnknownPowerExclude = "exclude"
+)
+
+// SearchSettings filter what searches, the map and exports return, see database.SearchDeviceFilter
+type SearchSettings struct {
+	MinPowerWatts int    `yaml:"min_power_watts"` // Leave out repeaters reporting less power, 0 keeps them all
+	UnknownPower  string `yaml:"unknown_power"`   // "include" (the default) or "exclude" repeaters with no power listed
+}
+
+// ExcludeUnknownPower reports whether repeaters with no power listed fail the minimum power
+func (s SearchSettings) ExcludeUnknownPower() bool {
+	return s.UnknownPower == UnknownPowerExclude
+}
+
+// validate checks the settings LoadConfig can't fix up itself
+func (s SearchSettings) validate() error {
+	if s.MinPowerWatts < 0 {
+		return fmt.Errorf("invalid search.min_power_watts %d: can't be negative", s.MinPowerWatts)
+	}
+	switch s.UnknownPower {
+	case "", UnknownPowerInclude, UnknownPowerExclude:
+	default:
+		return fmt.Errorf("invalid search.unknown_power %q: must be %q or %q", s.UnknownPower, UnknownPowerInclude, UnknownPowerExclude)
+	}
+	return nil
+}
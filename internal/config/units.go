@@ -0,0 +1,104 @@
+package config
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Units is the distance unit shown to the user ("km" or "mi")
+// API calls and the database always work in kilometers, convert at the UI edge
+type Units string
+
+const (
+	Kilometers Units = "km"
+	Miles      Units = "mi"
+
+	kmPerMile = 1.609344
+
+	// DefaultRadius is the starting radius for searches, in the configured units
+	DefaultRadius = 50.0
+)
+
+// ParseUnits accepts the common spellings of kilometers and miles, empty means kilometers
+func ParseUnits(s string) (Units, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "km", "kilometer", "kilometers", "kilometre", "kilometres":
+		return Kilometers, nil
+	case "mi", "mile", "miles":
+		return Miles, nil
+	}
+	return "", fmt.Errorf("invalid units %q: must be \"km\" or \"mi\"", s)
+}
+
+// ToKm converts a distance in these units to kilometers
+func (u Units) ToKm(distance float64) float64 {
+	if u == Miles {
+		return distance * kmPerMile
+	}
+	return distance
+}
+
+// FromKm converts kilometers to these units
+func (u Units) FromKm(km float64) float64 {
+	if u == Miles {
+		return km / kmPerMile
+	}
+	return km
+}
+
+// RadiusKm converts a search radius in these units to whole kilometers for the APIs
+// It rounds up so the search never covers less than the user asked for
+func (u Units) RadiusKm(radius float64) int {
+	return int(math.Ceil(u.ToKm(radius) - 1e-9))
+}
+
+// Format renders a distance given in kilometers in these units, e.g. "12.4 mi"
+func (u Units) Format(km float64) string {
+	return fmt.Sprintf("%.1f %s", u.FromKm(km), u.label())
+}
+
+func (u Units) label() string {
+	if u == Miles {
+		return "mi"
+	}
+	return "km"
+}
+
+// HeightUnits is the unit antenna heights are shown in ("m" or "ft")
+// The database always stores heights in meters
+type HeightUnits string
+
+const (
+	Meters HeightUnits = "m"
+	Feet   HeightUnits = "ft"
+
+	feetPerMeter = 3.28084
+)
+
+// ParseHeightUnits accepts the common spellings of meters and feet, empty means meters
+func ParseHeightUnits(s string) (HeightUnits, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "m", "meter", "meters", "metre", "metres":
+		return Meters, nil
+	case "ft", "foot", "feet":
+		return Feet, nil
+	}
+	return "", fmt.Errorf("invalid height_units %q: must be \"m\" or \"ft\"", s)
+}
+
+// FromMeters converts a height in meters to these units
+func (u HeightUnits) FromMeters(meters float64) float64 {
+	if u == Feet {
+		return meters * feetPerMeter
+	}
+	return meters
+}
+
+// Format renders a height given in meters in these units, e.g. "98 ft"
+func (u HeightUnits) Format(meters int) string {
+	if u == Feet {
+		return fmt.Sprintf("%.0f ft", u.FromMeters(float64(meters)))
+	}
+	return fmt.Sprintf("%d m", meters)
+}
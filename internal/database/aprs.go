@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+)
+
+// APRSPositionRecord represents a single recorded APRS position report
+type APRSPositionRecord struct {
+	ID           int       `db:"id"`
+	Callsign     string    `db:"callsign"`
+	Latitude     float64   `db:"latitude"`
+	Longitude    float64   `db:"longitude"`
+	PositionTime time.Time `db:"position_time"`
+	Comment      string    `db:"comment"`
+	Symbol       string    `db:"symbol"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// RecordAPRSPosition stores a station's reported position in the history table
+// Looking up the same report twice only stores it once
+func (d *Database) RecordAPRSPosition(station api.APRSStation) error {
+	callsign := strings.ToUpper(strings.TrimSpace(station.Name))
+	if callsign == "" {
+		return fmt.Errorf("station has no callsign")
+	}
+
+	// Prefer the time of the position report, fall back to now if the API omitted it
+	positionTime := time.Now()
+	if station.Time.Value != 0 {
+		positionTime = time.Unix(station.Time.Value, 0)
+	}
+
+	_, err := d.exec(`
+        INSERT OR IGNORE INTO aprs_positions (callsign, latitude, longitude, position_time, comment, symbol)
+        VALUES (?, ?, ?, ?, ?, ?)
+    `, callsign, station.GetLatitude(), station.GetLongitude(), positionTime.UTC(), station.Comment, station.Symbol)
+	if err != nil {
+		return fmt.Errorf("failed to record APRS position for %s: %v", callsign, err)
+	}
+
+	return nil
+}
+
+// GetAPRSHistory returns the most recent recorded positions for a callsign, newest first
+func (d *Database) GetAPRSHistory(callsign string, limit int) ([]APRSPositionRecord, error) {
+	return d.GetAPRSHistoryContext(context.Background(), callsign, limit)
+}
+
+// GetAPRSHistoryContext is GetAPRSHistory, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetAPRSHistoryContext(ctx context.Context, callsign string, limit int) ([]APRSPositionRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	query := `
+        SELECT id, callsign, latitude, longitude, position_time, comment, symbol, created_at
+        FROM aprs_positions
+        WHERE callsign = ?
+        ORDER BY position_time DESC
+        LIMIT ?
+    `
+
+	rows, err := d.queryRows(ctx, query, strings.ToUpper(strings.TrimSpace(callsign)), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query APRS history: %v", err)
+	}
+	defer rows.Close()
+
+	var positions []APRSPositionRecord
+	for rows.Next() {
+		var p APRSPositionRecord
+		var lat, lng sql.NullFloat64
+		var comment, symbol sql.NullString
+
+		if err := rows.Scan(&p.ID, &p.Callsign, &lat, &lng, &p.PositionTime, &comment, &symbol, &p.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan APRS position: %v", err)
+		}
+
+		p.Latitude = lat.Float64
+		p.Longitude = lng.Float64
+		p.Comment = comment.String
+		p.Symbol = symbol.String
+
+		positions = append(positions, p)
+	}
+
+	return positions, rows.Err()
+}
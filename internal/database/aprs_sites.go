@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+)
+
+// APRSSiteRadiusKm is how far an APRS station may be from a repeater and still count as being at its site
+const APRSSiteRadiusKm = 2.0
+
+// APRSSite is an APRS station on the air at a repeater's site under the repeater's callsign,
+// usually a digipeater or I-gate sharing the tower
+type APRSSite struct {
+	Callsign   string    // As heard on APRS, usually with an SSID (W3ABC-10)
+	Role       string    // "digipeater", "I-gate" or "station", from its APRS symbol
+	LastHeard  time.Time // Zero when the report carried no time
+	DistanceKm float64   // From the repeater, -1 when either has no position
+}
+
+// String describes the site for the repeater detail view, "Also an APRS digipeater (W3ABC-10), last heard ..."
+func (s APRSSite) String() string {
+	text := fmt.Sprintf("Also an APRS %s (%s)", s.Role, s.Callsign)
+	if !s.LastHeard.IsZero() {
+		text += ", last heard " + s.LastHeard.Local().Format("2006-01-02 15:04")
+	}
+	return text
+}
+
+// aprsRole names what an APRS symbol says a station is, the symbol's second character is the icon
+// "#" is a digipeater and "&" a gateway in both symbol tables, overlays included
+func aprsRole(symbol string) string {
+	if len(symbol) == 2 {
+		switch symbol[1] {
+		case '#':
+			return "digipeater"
+		case '&':
+			return "I-gate"
+		}
+	}
+	return "station"
+}
+
+// baseCallsign returns a callsign without its SSID or portable suffix, W3ABC/R and W3ABC-2 give W3ABC
+// With a prefix or suffix the longest part is taken as the callsign (VK9C/VK4AAA gives VK4AAA)
+func baseCallsign(callsign string) string {
+	base := ""
+	for _, part := range strings.Split(strings.ToUpper(strings.TrimSpace(callsign)), "/") {
+		if len(part) > len(base) {
+			base = part
+		}
+	}
+	base, _ = api.SplitSSID(base)
+	return base
+}
+
+// MatchAPRSStation reports whether an APRS station is at a repeater's site: it has the repeater's base
+// callsign, whatever its SSID, and is within APRSSiteRadiusKm when both have a position
+func MatchAPRSStation(r RepeaterRecord, station api.APRSStation) (APRSSite, bool) {
+	base := baseCallsign(r.Callsign)
+	if base == "" || baseCallsign(station.Name) != base {
+		return APRSSite{}, false
+	}
+
+	site := APRSSite{Callsign: strings.ToUpper(station.Name), Role: aprsRole(station.Symbol), DistanceKm: -1}
+	if r.Latitude != nil && r.Longitude != nil && station.HasPosition() {
+		site.DistanceKm = HaversineKm(*r.Latitude, *r.Longitude, station.GetLatitude(), station.GetLongitude())
+		if site.DistanceKm > APRSSiteRadiusKm {
+			return APRSSite{}, false
+		}
+	}
+
+	heard := station.LastTime.Value
+	if heard == 0 {
+		heard = station.Time.Value
+	}
+	if heard != 0 {
+		site.LastHeard = time.Unix(heard, 0)
+	}
+	return site, true
+}
+
+// GetAPRSSite finds the APRS station heard most recently at a repeater's site, see MatchAPRSStation
+// It looks through the positions recorded by APRS lookups, so a station only shows up once it has been seen
+// there. found is false when none matches
+func (d *Database) GetAPRSSite(r RepeaterRecord) (site APRSSite, found bool, err error) {
+	return d.GetAPRSSiteContext(context.Background(), r)
+}
+
+// GetAPRSSiteContext is GetAPRSSite, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetAPRSSiteContext(ctx context.Context, r RepeaterRecord) (site APRSSite, found bool, err error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	base := baseCallsign(r.Callsign)
+	if base == "" {
+		return APRSSite{}, false, nil
+	}
+
+	rows, err := d.queryRows(ctx, `
+        SELECT callsign, latitude, longitude, position_time, symbol
+        FROM aprs_positions
+        WHERE callsign = ? OR callsign LIKE ? ESCAPE '\'
+        ORDER BY position_time DESC
+    `, base, escapeLike(base)+"-%")
+	if err != nil {
+		return APRSSite{}, false, fmt.Errorf("failed to look up APRS stations for %s: %v", base, err)
+	}
+	defer rows.Close()
+
+	// Only each station's latest report counts, a mobile that once passed the site isn't at it
+	seen := make(map[string]bool)
+	for rows.Next() {
+		var callsign string
+		var lat, lng sql.NullFloat64
+		var positionTime time.Time
+		var symbol sql.NullString
+		if err := rows.Scan(&callsign, &lat, &lng, &positionTime, &symbol); err != nil {
+			return APRSSite{}, false, fmt.Errorf("failed to scan APRS position: %v", err)
+		}
+		if seen[callsign] {
+			continue
+		}
+		seen[callsign] = true
+
+		station := api.APRSStation{
+			Name:     callsign,
+			Lat:      api.FlexibleFloat{Value: lat.Float64},
+			Lng:      api.FlexibleFloat{Value: lng.Float64},
+			LastTime: api.FlexibleTime{Value: positionTime.Unix()},
+			Symbol:   symbol.String,
+		}
+		if site, ok := MatchAPRSStation(r, station); ok {
+			return site, true, nil
+		}
+	}
+	return APRSSite{}, false, rows.Err()
+}
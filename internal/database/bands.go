@@ -0,0 +1,65 @@
+package database
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Band is an amateur radio band used to classify repeaters by frequency
+// The ranges mirror the frequency_bands rows seeded by schema.sql
+type Band struct {
+	Name         string
+	MinFrequency float64 // MHz
+	MaxFrequency float64 // MHz
+}
+
+// Bands lists the known bands in frequency order
+var Bands = []Band{
+	{Name: "2m", MinFrequency: 144.0, MaxFrequency: 148.0},
+	{Name: "1.25m", MinFrequency: 219.0, MaxFrequency: 225.0},
+	{Name: "70cm", MinFrequency: 420.0, MaxFrequency: 450.0},
+	{Name: "33cm", MinFrequency: 902.0, MaxFrequency: 928.0},
+	{Name: "23cm", MinFrequency: 1240.0, MaxFrequency: 1300.0},
+}
+
+// BandForFrequency returns the band name for a frequency in MHz, or "" if it isn't in a known band
+func BandForFrequency(mhz float64) string {
+	for _, band := range Bands {
+		if mhz >= band.MinFrequency && mhz <= band.MaxFrequency {
+			return band.Name
+		}
+	}
+	return ""
+}
+
+// GetBand returns the band of the repeater's output frequency, or "" if unknown
+func (r *RepeaterRecord) GetBand() string {
+	if r.TxFrequency == nil {
+		return ""
+	}
+	return BandForFrequency(*r.TxFrequency)
+}
+
+// matchesBand reports whether the repeater is on a frequency band, or supports a mode, by name
+func (r *RepeaterRecord) matchesBand(band string) bool {
+	for _, b := range Bands {
+		if strings.EqualFold(b.Name, band) {
+			return strings.EqualFold(r.GetBand(), band)
+		}
+	}
+
+	if strings.EqualFold(r.Mode, band) {
+		return true
+	}
+	if r.DigitalModes != nil {
+		var modes []string
+		if err := json.Unmarshal([]byte(*r.DigitalModes), &modes); err == nil {
+			for _, mode := range modes {
+				if strings.EqualFold(mode, band) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
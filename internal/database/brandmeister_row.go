@@ -0,0 +1,71 @@
+package database
+
+import (
+	"database/sql"
+	"strconv"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+)
+
+// brandmeisterRow is a Brandmeister repeater with the values the sync derives from it worked out
+type brandmeisterRow struct {
+	rep             api.BrandmeisterRepeater
+	state, country  string
+	locationKey     string
+	cityInferred    bool // rep.City is the nearest bundled city, Brandmeister didn't list one
+	hasLocation     bool
+	txFreq, rxFreq  sql.NullFloat64
+	online          bool
+	power, agl      sql.NullInt64
+	lastMaster      sql.NullInt64
+	deviceType      string
+	descriptionHTML sql.NullString // The description as Brandmeister gave it, rep.Description is its plain text
+}
+
+// prepareBrandmeisterRow does the per-repeater work of a Brandmeister sync that needs no database,
+// the region lookup above all, and cleans up the free-text fields
+func prepareBrandmeisterRow(rep api.BrandmeisterRepeater) brandmeisterRow {
+	rep.Callsign, rep.City, rep.Country = cleanText(rep.Callsign), cleanText(rep.City), cleanText(rep.Country)
+	rep.Hardware, rep.Website, rep.Description = cleanText(rep.Hardware), cleanText(rep.Website), cleanText(rep.Description)
+	row := brandmeisterRow{rep: rep}
+
+	// Keep the markup for web views, the description itself is plain text for terminals and search
+	if rep.Description != "" {
+		row.descriptionHTML = sql.NullString{String: rep.Description, Valid: true}
+		row.rep.Description = plainText(rep.Description)
+	}
+
+	// Brandmeister has no state field, fill it (and a missing country or city) in from the coordinates
+	row.state, row.country = inferRegion("", rep.Country, rep.Latitude, rep.Longitude)
+	rep.City, row.cityInferred = inferCity(rep.City, row.state, row.country, rep.Latitude, rep.Longitude)
+	row.rep.City = rep.City
+	row.locationKey = locationKey(rep.City, row.state, row.country, rep.Latitude, rep.Longitude)
+	row.hasLocation = !blankLocation(rep.City, row.state, row.country, rep.Latitude, rep.Longitude)
+
+	// Parse frequencies
+	if freq, err := strconv.ParseFloat(rep.TxFreq, 64); err == nil {
+		row.txFreq = sql.NullFloat64{Float64: freq, Valid: true}
+	}
+	if freq, err := strconv.ParseFloat(rep.RxFreq, 64); err == nil {
+		row.rxFreq = sql.NullFloat64{Float64: freq, Valid: true}
+	}
+
+	// Same rule as the client's online filters
+	row.online = rep.IsOnline()
+
+	// Power in watts, antenna height in meters; store NULL when not reported rather than 0
+	if watts, ok := rep.GetPowerWatts(); ok {
+		row.power = sql.NullInt64{Int64: int64(watts), Valid: true}
+	}
+	if meters, ok := rep.GetAntennaHeightMeters(); ok {
+		row.agl = sql.NullInt64{Int64: int64(meters), Valid: true}
+	}
+
+	// 0 means the device has never connected to a master
+	if rep.LastMaster > 0 {
+		row.lastMaster = sql.NullInt64{Int64: int64(rep.LastMaster), Valid: true}
+	}
+
+	row.deviceType = rep.DeviceType()
+	return row
+}
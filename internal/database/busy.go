@@ -0,0 +1,88 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// busyRetryTimeout is how long an operation keeps retrying while another process holds the write lock
+// busy_timeout already waits 5s inside SQLite, this outlasts a sync from cmd/syncd holding it for longer
+const busyRetryTimeout = 30 * time.Second
+
+// First pause between busy retries, doubled each time up to maxBusyBackoff
+const (
+	busyBackoff    = 10 * time.Millisecond
+	maxBusyBackoff = 500 * time.Millisecond
+)
+
+// isBusy reports whether err is SQLite's "database is locked", also when it was wrapped with %v
+func isBusy(err error) bool {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+	}
+	return err != nil && (strings.Contains(err.Error(), "database is locked") ||
+		strings.Contains(err.Error(), "database table is locked"))
+}
+
+// retryBusy runs op until it doesn't fail with a locked database, ctx is done or busyRetryTimeout passes
+func retryBusy(ctx context.Context, op func() error) error {
+	deadline := time.Now().Add(busyRetryTimeout)
+	backoff := busyBackoff
+	for {
+		err := op()
+		if !isBusy(err) || time.Now().Add(backoff).After(deadline) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxBusyBackoff)
+	}
+}
+
+// exec is d.db.Exec retried while another process holds the write lock
+func (d *Database) exec(query string, args ...interface{}) (result sql.Result, err error) {
+	err = retryBusy(context.Background(), func() error {
+		result, err = d.db.Exec(query, args...)
+		return err
+	})
+	return result, err
+}
+
+// queryRows is d.db.QueryContext retried while the database is locked
+// WAL lets reads run alongside a write, but they can still hit the lock while a checkpoint or recovery runs
+func (d *Database) queryRows(ctx context.Context, query string, args ...interface{}) (rows *sql.Rows, err error) {
+	err = retryBusy(ctx, func() error {
+		rows, err = d.db.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// beginWrite begins a transaction that already holds the write lock, _txlock=immediate in the connection
+// string makes Begin a BEGIN IMMEDIATE. A plain BEGIN only takes the lock at its first write, where a sync
+// running in another process makes it fail midway; here the wait and retries happen up front, and once it
+// returns no statement in it can hit the lock
+func (d *Database) beginWrite() (*sql.Tx, error) {
+	ctx := d.syncOptions.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var tx *sql.Tx
+	err := retryBusy(ctx, func() error {
+		var err error
+		tx, err = d.db.Begin()
+		return err
+	})
+	return tx, err
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// GetRepeatersByCallsign finds every listing of a callsign across all sources, so a trustee can check
+// their repeaters are listed correctly everywhere. Case doesn't matter
+// With exact set only the callsign itself matches ("W3ABC"); otherwise suffixed listings of it match too
+// ("W3ABC/R", "W3ABC-2") but longer callsigns don't ("W3ABCD"). Hotspots are always included, the
+// trustee's own devices are part of what they're checking. Results are grouped by source
+func (d *Database) GetRepeatersByCallsign(callsign string, exact bool) ([]RepeaterRecord, error) {
+	return d.GetRepeatersByCallsignContext(context.Background(), callsign, exact)
+}
+
+// GetRepeatersByCallsignContext is GetRepeatersByCallsign, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetRepeatersByCallsignContext(ctx context.Context, callsign string, exact bool) ([]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	callsign = strings.ToUpper(strings.TrimSpace(callsign))
+	if callsign == "" {
+		return nil, fmt.Errorf("no callsign given")
+	}
+
+	condition, arg := `UPPER(r.callsign) = ?`, callsign
+	if !exact {
+		// LIKE ignores ASCII case, callsignSuffixed weeds out the longer callsigns it also matches
+		condition, arg = `r.callsign LIKE ? ESCAPE '\'`, escapeLike(callsign)+"%"
+	}
+
+	rows, err := d.queryRows(ctx, repeaterSelectColumns+`
+        WHERE `+condition+`
+        ORDER BY r.source_id, r.callsign, r.id
+    `, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up callsign %s: %v", callsign, err)
+	}
+	defer rows.Close()
+
+	var repeaters []RepeaterRecord
+	for rows.Next() {
+		r, err := scanRepeaterRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		if exact || callsignSuffixed(strings.ToUpper(r.Callsign), callsign) {
+			repeaters = append(repeaters, r)
+		}
+	}
+	return repeaters, rows.Err()
+}
+
+// callsignSuffixed reports whether listed is base itself or base followed by a separator and a suffix
+func callsignSuffixed(listed, base string) bool {
+	rest, ok := strings.CutPrefix(listed, base)
+	if !ok {
+		return false
+	}
+	return rest == "" || !(rest[0] >= 'A' && rest[0] <= 'Z' || rest[0] >= '0' && rest[0] <= '9')
+}
+
+// escapeLike escapes LIKE's wildcards in s, for patterns using ESCAPE '\'
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"fmt"
+	"math"
+)
+
+// channelTolerance is how close in MHz a frequency must be to a channel to be named after it
+// It is well under the 12.5 kHz PMR446 spacing, the tightest of the plans
+const channelTolerance = 0.001
+
+// channelPlans are the well-known non-amateur channel plans, each run of evenly spaced channels on its own line
+// Marine channels are the ship transmit frequencies, the same for simplex and duplex channels
+var channelPlans = []struct {
+	format       string // Channel name with %d for the number
+	firstChannel int
+	firstMHz     float64
+	stepMHz      float64
+	count        int
+}{
+	{"FRS/GMRS Ch %d", 1, 462.5625, 0.025, 7},
+	{"FRS/GMRS Ch %d", 8, 467.5625, 0.025, 7},
+	{"FRS/GMRS Ch %d", 15, 462.550, 0.025, 8},
+	{"GMRS Ch %dR input", 15, 467.550, 0.025, 8},
+	{"MURS Ch %d", 1, 151.820, 0.060, 3},
+	{"MURS Ch %d", 4, 154.570, 0.030, 2},
+	{"Marine Ch %d", 1, 156.050, 0.050, 28},
+	{"Marine Ch %d", 60, 156.025, 0.050, 29},
+	{"PMR446 Ch %d", 1, 446.00625, 0.0125, 16},
+}
+
+// ChannelForFrequency names the standard channel a frequency in MHz falls on, e.g. "Marine Ch 16" for
+// 156.800, or returns "" when it isn't on a FRS/GMRS, MURS, marine VHF or PMR446 channel
+func ChannelForFrequency(mhz float64) string {
+	for _, plan := range channelPlans {
+		n := math.Round((mhz - plan.firstMHz) / plan.stepMHz)
+		if n < 0 || int(n) >= plan.count {
+			continue
+		}
+		if math.Abs(mhz-(plan.firstMHz+n*plan.stepMHz)) < channelTolerance {
+			return fmt.Sprintf(plan.format, plan.firstChannel+int(n))
+		}
+	}
+	return ""
+}
+
+// GetChannelName returns the standard channel of the repeater's output frequency, or "" if it isn't on one
+func (r *RepeaterRecord) GetChannelName() string {
+	if r.TxFrequency == nil {
+		return ""
+	}
+	return ChannelForFrequency(*r.TxFrequency)
+}
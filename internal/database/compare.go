@@ -0,0 +1,207 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// RecordKey identifies the same physical repeater across databases
+type RecordKey struct {
+	Source     string `json:"source"`
+	ExternalID string `json:"external_id"`
+}
+
+// SourceCountDiff compares the number of repeaters from one source
+type SourceCountDiff struct {
+	Source string `json:"source"`
+	Left   int    `json:"left"`
+	Right  int    `json:"right"`
+}
+
+// FieldDiff describes one field that differs between two copies of a record
+type FieldDiff struct {
+	Key   RecordKey `json:"key"`
+	Field string    `json:"field"`
+	Left  string    `json:"left"`
+	Right string    `json:"right"`
+}
+
+// DatabaseDiff is the structured result of Database.CompareTo
+type DatabaseDiff struct {
+	SourceCounts []SourceCountDiff `json:"source_counts"`
+	OnlyInLeft   []RecordKey       `json:"only_in_left"`
+	OnlyInRight  []RecordKey       `json:"only_in_right"`
+	FieldDiffs   []FieldDiff       `json:"field_diffs"`
+}
+
+// HasDifferences reports whether the two databases differ at all
+func (d *DatabaseDiff) HasDifferences() bool {
+	if len(d.OnlyInLeft) > 0 || len(d.OnlyInRight) > 0 || len(d.FieldDiffs) > 0 {
+		return true
+	}
+	for _, c := range d.SourceCounts {
+		if c.Left != c.Right {
+			return true
+		}
+	}
+	return false
+}
+
+// compareFields are the repeater columns compared field by field, in report order
+// Every value is read as text so NULL, numbers and strings compare uniformly
+var compareFields = []struct {
+	name string
+	expr string
+}{
+	{"callsign", "r.callsign"},
+	{"tx_frequency", "r.tx_frequency"},
+	{"rx_frequency", "r.rx_frequency"},
+	{"offset_frequency", "r.offset_frequency"},
+	{"tone_frequency", "r.tone_frequency"},
+	{"mode", "r.mode"},
+	{"color_code", "r.color_code"},
+	{"digital_modes", "r.digital_modes"},
+	{"operational", "r.operational"},
+	{"online_status", "r.online_status"},
+	{"power_watts", "r.power_watts"},
+	{"antenna_height_agl", "r.antenna_height_agl"},
+	{"antenna_height_msl", "r.antenna_height_msl"},
+	{"hardware", "r.hardware"},
+	{"firmware", "r.firmware"},
+	{"website", "r.website"},
+	{"description", "r.description"},
+	{"city", "l.city"},
+	{"state", "l.state"},
+	{"country", "l.country"},
+	{"latitude", "l.latitude"},
+	{"longitude", "l.longitude"},
+}
+
+// CompareTo compares this database (left) with other (right)
+// Repeaters are matched by source name + external ID, since internal IDs differ between databases
+func (d *Database) CompareTo(other *Database) (*DatabaseDiff, error) {
+	return d.CompareToContext(context.Background(), other)
+}
+
+// CompareToContext is CompareTo, aborting when ctx is cancelled or either database's query timeout passes
+func (d *Database) CompareToContext(ctx context.Context, other *Database) (*DatabaseDiff, error) {
+	left, err := d.loadComparableRecords(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read left database: %v", err)
+	}
+	right, err := other.loadComparableRecords(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read right database: %v", err)
+	}
+
+	diff := &DatabaseDiff{}
+
+	// Per-source counts
+	leftCounts := make(map[string]int)
+	rightCounts := make(map[string]int)
+	for key := range left {
+		leftCounts[key.Source]++
+	}
+	for key := range right {
+		rightCounts[key.Source]++
+	}
+	sources := make(map[string]bool)
+	for s := range leftCounts {
+		sources[s] = true
+	}
+	for s := range rightCounts {
+		sources[s] = true
+	}
+	for s := range sources {
+		diff.SourceCounts = append(diff.SourceCounts, SourceCountDiff{Source: s, Left: leftCounts[s], Right: rightCounts[s]})
+	}
+	sort.Slice(diff.SourceCounts, func(i, j int) bool { return diff.SourceCounts[i].Source < diff.SourceCounts[j].Source })
+
+	// Missing records and field-level differences
+	for key, leftValues := range left {
+		rightValues, ok := right[key]
+		if !ok {
+			diff.OnlyInLeft = append(diff.OnlyInLeft, key)
+			continue
+		}
+		for i, field := range compareFields {
+			if leftValues[i] != rightValues[i] {
+				diff.FieldDiffs = append(diff.FieldDiffs, FieldDiff{
+					Key:   key,
+					Field: field.name,
+					Left:  leftValues[i],
+					Right: rightValues[i],
+				})
+			}
+		}
+	}
+	for key := range right {
+		if _, ok := left[key]; !ok {
+			diff.OnlyInRight = append(diff.OnlyInRight, key)
+		}
+	}
+
+	sortKeys(diff.OnlyInLeft)
+	sortKeys(diff.OnlyInRight)
+	sort.Slice(diff.FieldDiffs, func(i, j int) bool {
+		a, b := diff.FieldDiffs[i], diff.FieldDiffs[j]
+		if a.Key != b.Key {
+			return keyLess(a.Key, b.Key)
+		}
+		return a.Field < b.Field
+	})
+
+	return diff, nil
+}
+
+// loadComparableRecords reads every repeater as text values keyed by source + external ID
+func (d *Database) loadComparableRecords(ctx context.Context) (map[RecordKey][]string, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	query := "SELECT COALESCE(rs.source_name, ''), COALESCE(r.external_id, '')"
+	for _, field := range compareFields {
+		query += fmt.Sprintf(", COALESCE(CAST(%s AS TEXT), '')", field.expr)
+	}
+	query += `
+        FROM repeaters r
+        LEFT JOIN repeater_sources rs ON r.source_id = rs.id
+        LEFT JOIN locations l ON r.location_id = l.id
+    `
+
+	rows, err := d.queryRows(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query repeaters: %v", err)
+	}
+	defer rows.Close()
+
+	records := make(map[RecordKey][]string)
+	for rows.Next() {
+		var key RecordKey
+		values := make([]string, len(compareFields))
+
+		dest := []interface{}{&key.Source, &key.ExternalID}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan repeater: %v", err)
+		}
+
+		records[key] = values
+	}
+
+	return records, rows.Err()
+}
+
+func keyLess(a, b RecordKey) bool {
+	if a.Source != b.Source {
+		return a.Source < b.Source
+	}
+	return a.ExternalID < b.ExternalID
+}
+
+func sortKeys(keys []RecordKey) {
+	sort.Slice(keys, func(i, j int) bool { return keyLess(keys[i], keys[j]) })
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// RepeaterComparison is one repeater of a side by side comparison: its radio settings and linked talkgroups
+type RepeaterComparison struct {
+	Repeater    RepeaterRecord      `json:"repeater"`
+	Programming *ProgrammingInfo    `json:"programming,omitempty"` // nil when the output frequency is unknown
+	Talkgroups  []RepeaterTalkgroup `json:"talkgroups"`
+}
+
+// GetRepeaterComparison loads repeaters by database ID with their programming details and talkgroups,
+// in the order given, for documenting a linked system. Every ID must exist
+func (d *Database) GetRepeaterComparison(ids []int) ([]RepeaterComparison, error) {
+	return d.GetRepeaterComparisonContext(context.Background(), ids)
+}
+
+// GetRepeaterComparisonContext is GetRepeaterComparison, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetRepeaterComparisonContext(ctx context.Context, ids []int) ([]RepeaterComparison, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	repeaters, err := d.GetRepeatersByIDsContext(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if missing := missingIDs(ids, repeaters); len(missing) > 0 {
+		return nil, fmt.Errorf("repeaters not found: %s", strings.Join(missing, ", "))
+	}
+
+	comparison := make([]RepeaterComparison, len(repeaters))
+	for i, r := range repeaters {
+		comparison[i].Repeater = r
+		if info, err := r.GetProgrammingInfo(); err == nil {
+			comparison[i].Programming = &info
+		}
+
+		talkgroups, err := d.GetTalkgroupsForRepeaterContext(ctx, r.ID)
+		if err != nil {
+			return nil, err
+		}
+		comparison[i].Talkgroups = talkgroups
+	}
+
+	return comparison, nil
+}
+
+// missingIDs lists the requested IDs that didn't load
+func missingIDs(ids []int, repeaters []RepeaterRecord) []string {
+	found := make(map[int]bool, len(repeaters))
+	for _, r := range repeaters {
+		found[r.ID] = true
+	}
+
+	var missing []string
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, fmt.Sprint(id))
+			found[id] = true // Report repeated IDs once
+		}
+	}
+	return missing
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// RepeaterConflict is a pair of repeaters that share a frequency within interference range
+type RepeaterConflict struct {
+	A                 RepeaterRecord `json:"a"`
+	B                 RepeaterRecord `json:"b"`
+	DistanceKm        float64        `json:"distance_km"`
+	FrequencyDeltaKHz float64        `json:"frequency_delta_khz"`
+}
+
+// FindFrequencyConflicts finds repeater pairs whose output frequencies are within
+// freqToleranceKHz of each other and whose sites are within distanceKm
+// The same callsign listed by more than one source is not reported as a conflict
+// Results are ordered closest first
+func (d *Database) FindFrequencyConflicts(freqToleranceKHz float64, distanceKm float64) ([]RepeaterConflict, error) {
+	return d.FindFrequencyConflictsContext(context.Background(), freqToleranceKHz, distanceKm)
+}
+
+// FindFrequencyConflictsContext is FindFrequencyConflicts, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) FindFrequencyConflictsContext(ctx context.Context, freqToleranceKHz float64, distanceKm float64) ([]RepeaterConflict, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	query := repeaterSelectColumns + `
+        WHERE r.tx_frequency IS NOT NULL
+          AND l.latitude IS NOT NULL AND l.longitude IS NOT NULL
+          AND NOT (l.latitude = 0 AND l.longitude = 0)
+        ORDER BY r.tx_frequency
+    `
+
+	rows, err := d.queryRows(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query repeaters for conflicts: %v", err)
+	}
+	defer rows.Close()
+
+	var repeaters []RepeaterRecord
+	for rows.Next() {
+		r, err := scanRepeaterRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		repeaters = append(repeaters, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	toleranceMHz := freqToleranceKHz / 1000.0
+	var conflicts []RepeaterConflict
+
+	// Rows are sorted by frequency, so only a sliding window needs comparing
+	for i := range repeaters {
+		a := repeaters[i]
+		box := BoundingBoxAround(*a.Latitude, *a.Longitude, distanceKm)
+
+		for j := i + 1; j < len(repeaters); j++ {
+			b := repeaters[j]
+			delta := *b.TxFrequency - *a.TxFrequency
+			if delta > toleranceMHz {
+				break
+			}
+
+			if strings.EqualFold(a.Callsign, b.Callsign) {
+				continue
+			}
+
+			// Cheap bounding-box check before the exact distance
+			if *b.Latitude < box.MinLat || *b.Latitude > box.MaxLat ||
+				*b.Longitude < box.MinLng || *b.Longitude > box.MaxLng {
+				continue
+			}
+
+			distance := HaversineKm(*a.Latitude, *a.Longitude, *b.Latitude, *b.Longitude)
+			if distance > distanceKm {
+				continue
+			}
+
+			conflicts = append(conflicts, RepeaterConflict{
+				A:                 a,
+				B:                 b,
+				DistanceKm:        distance,
+				FrequencyDeltaKHz: math.Round(math.Abs(delta)*1000*1000) / 1000, // kHz, rounded to Hz
+			})
+		}
+	}
+
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		return conflicts[i].DistanceKm < conflicts[j].DistanceKm
+	})
+
+	return conflicts, nil
+}
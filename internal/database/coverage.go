@@ -0,0 +1,36 @@
+package database
+
+import "math"
+
+const (
+	// coverageMobileHeight is the antenna height assumed for the station being reached, a car roof (meters)
+	coverageMobileHeight = 1.5
+
+	// coverageReferenceWatts is the power the plain radio horizon is taken to be right for
+	coverageReferenceWatts = 50
+)
+
+// EstimateCoverageKm returns a rough radius the repeater can be worked from by a mobile, ok is false
+// without an antenna height above ground
+//
+// The base is the radio horizon between the two antennas, 4.12·(√h + √1.5) km for h meters above ground
+// and a 1.5 m mobile antenna, the usual 4/3-earth approximation. Power scales it by (P/50 W)^¼, the
+// fourth-root rule of plane-earth propagation, held between ½ and 1½ so power never outweighs height;
+// unknown power counts as 50 W. MSL height is ignored, it says how high the site is, not how much
+// terrain it clears. Terrain, receiver sensitivity and band are all left out: treat the figure as a
+// planning hint, hills easily halve it and a repeater on a summit can beat it
+func (r *RepeaterRecord) EstimateCoverageKm() (km float64, ok bool) {
+	if r.AntennaHeightAGL == nil || *r.AntennaHeightAGL <= 0 {
+		return 0, false
+	}
+
+	horizon := 4.12 * (math.Sqrt(float64(*r.AntennaHeightAGL)) + math.Sqrt(coverageMobileHeight))
+
+	factor := 1.0
+	if r.PowerWatts != nil && *r.PowerWatts > 0 {
+		factor = math.Pow(float64(*r.PowerWatts)/coverageReferenceWatts, 0.25)
+		factor = math.Max(0.5, math.Min(1.5, factor))
+	}
+
+	return horizon * factor, true
+}
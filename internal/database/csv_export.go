@@ -0,0 +1,97 @@
+package database
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DefaultFrequencyPrecision is how many decimals CSV exports give frequencies unless told otherwise
+const DefaultFrequencyPrecision = 4
+
+// ExportOptions chooses what a CSV export writes, downstream tools differ in the columns and precision they want
+// The zero value writes every column in the export's own order, frequencies to 4 decimals, with a header row
+type ExportOptions struct {
+	Columns   []string // Headers of the columns to write, in this order, case ignored; every column when empty
+	Precision int      // Decimals in frequencies; DefaultFrequencyPrecision when 0
+	NoHeader  bool     // Leave out the header row
+}
+
+// CSVColumn is one column a CSV export can write, Value formats it for a row
+type CSVColumn[T any] struct {
+	Header string
+	Value  func(row T, opts ExportOptions) string
+}
+
+// FormatFrequency formats MHz with the decimals the options ask for
+func (opts ExportOptions) FormatFrequency(mhz float64) string {
+	precision := opts.Precision
+	if precision <= 0 {
+		precision = DefaultFrequencyPrecision
+	}
+	return fmt.Sprintf("%.*f", precision, mhz)
+}
+
+// CSVHeaders lists the headers of columns, for usage messages
+func CSVHeaders[T any](columns []CSVColumn[T]) []string {
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.Header
+	}
+	return headers
+}
+
+// WriteCSV writes rows as CSV, with the columns and header opts ask for
+// Naming a column the export doesn't have is an error, listing the ones it does
+func WriteCSV[T any](w io.Writer, columns []CSVColumn[T], rows []T, opts ExportOptions) error {
+	selected, err := selectColumns(columns, opts.Columns)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if !opts.NoHeader {
+		if err := writer.Write(CSVHeaders(selected)); err != nil {
+			return fmt.Errorf("failed to write CSV: %v", err)
+		}
+	}
+	for _, row := range rows {
+		record := make([]string, len(selected))
+		for i, column := range selected {
+			record[i] = column.Value(row, opts)
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV: %v", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %v", err)
+	}
+	return nil
+}
+
+// selectColumns picks the named columns in the order given, or all of them when none are named
+func selectColumns[T any](columns []CSVColumn[T], names []string) ([]CSVColumn[T], error) {
+	if len(names) == 0 {
+		return columns, nil
+	}
+
+	selected := make([]CSVColumn[T], 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, column := range columns {
+			if strings.EqualFold(column.Header, strings.TrimSpace(name)) {
+				selected = append(selected, column)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column %q (available: %s)", name, strings.Join(CSVHeaders(columns), ", "))
+		}
+	}
+	return selected, nil
+}
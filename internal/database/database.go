@@ -1,21 +1,30 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3" // SQLite driver
+
+	"github.com/unklstewy/digiLogRT/internal/config"
 )
 
 // Database represents our SQLite database connection and operations
 type Database struct {
-	db   *sql.DB
-	path string
+	db     *sql.DB
+	path   string
+	hasFTS bool // SQLite was built with FTS5, see search_index.go
+
+	allowEmptySync bool         // Let the Sync* methods accept an empty dataset, see SetAllowEmptySync
+	syncOptions    SyncOptions  // Batch size of the Sync* methods, see SetSyncOptions
+	queryTimeout   atomic.Int64 // Longest a search may run as a time.Duration, see SetQueryTimeout
 }
 
 // RepeaterRecord represents a unified repeater record in the database
@@ -25,9 +34,11 @@ type RepeaterRecord struct {
 	SourceID         int        `db:"source_id"`
 	ExternalID       string     `db:"external_id"`
 	LocationID       *int       `db:"location_id"`
+	CityInferred     bool       `db:"city_inferred"` // City is the nearest bundled city, the source didn't give one
 	TxFrequency      *float64   `db:"tx_frequency"`
 	RxFrequency      *float64   `db:"rx_frequency"`
 	OffsetFrequency  *float64   `db:"offset_frequency"`
+	OffsetInferred   bool       `db:"offset_inferred"` // The split is the band plan's, the source didn't give one
 	ToneFrequency    *float64   `db:"tone_frequency"`
 	Mode             string     `db:"mode"`
 	ColorCode        *int       `db:"color_code"`
@@ -35,13 +46,16 @@ type RepeaterRecord struct {
 	Operational      bool       `db:"operational"`
 	OnlineStatus     bool       `db:"online_status"`
 	LastSeen         *time.Time `db:"last_seen"`
-	PowerWatts       *int       `db:"power_watts"`
-	AntennaHeightAGL *int       `db:"antenna_height_agl"`
-	AntennaHeightMSL *int       `db:"antenna_height_msl"`
+	PowerWatts       *int       `db:"power_watts"`        // Watts
+	AntennaHeightAGL *int       `db:"antenna_height_agl"` // Meters
+	AntennaHeightMSL *int       `db:"antenna_height_msl"` // Meters
 	Hardware         *string    `db:"hardware"`
 	Firmware         *string    `db:"firmware"`
 	Website          *string    `db:"website"`
-	Description      *string    `db:"description"`
+	Description      *string    `db:"description"`      // Plain text
+	DescriptionHTML  *string    `db:"description_html"` // Original markup, nil for sources without HTML
+	LastMaster       *int       `db:"last_master"`      // Brandmeister master server ID
+	DeviceType       string     `db:"device_type"`      // "repeater" or "hotspot" for Brandmeister devices, empty for other sources
 	CreatedAt        time.Time  `db:"created_at"`
 	UpdatedAt        time.Time  `db:"updated_at"`
 	LastAPISync      time.Time  `db:"last_api_sync"`
@@ -87,8 +101,8 @@ func NewDatabase(dbPath string) (*Database, error) {
 		return nil, fmt.Errorf("failed to create database directory: %v", err)
 	}
 
-	// Open the database with performance optimizations
-	connectionString := dbPath + "?_journal_mode=WAL&_foreign_keys=on&_synchronous=NORMAL&_cache_size=10000&_temp_store=memory"
+	// Open the database with performance optimizations, transactions take the write lock when they begin (see beginWrite)
+	connectionString := dbPath + "?_journal_mode=WAL&_foreign_keys=on&_synchronous=NORMAL&_cache_size=10000&_temp_store=memory&_busy_timeout=5000&_txlock=immediate"
 	db, err := sql.Open("sqlite3", connectionString)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %v", err)
@@ -108,12 +122,23 @@ func NewDatabase(dbPath string) (*Database, error) {
 		db:   db,
 		path: dbPath,
 	}
+	database.SetQueryTimeout(DefaultQueryTimeout)
 
 	// Initialize the schema
 	if err := database.initSchema(); err != nil {
 		return nil, fmt.Errorf("failed to initialize schema: %v", err)
 	}
 
+	// Bring databases created by older versions up to date
+	if err := database.migrate(); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %v", err)
+	}
+
+	// Full-text search, when the SQLite build supports it
+	if err := database.initSearchIndex(); err != nil {
+		return nil, fmt.Errorf("failed to initialize search index: %v", err)
+	}
+
 	// Set additional performance pragmas
 	if err := database.setPragmas(); err != nil {
 		return nil, fmt.Errorf("failed to set performance pragmas: %v", err)
@@ -130,6 +155,7 @@ func (d *Database) setPragmas() error {
 		"PRAGMA cache_size = 10000",
 		"PRAGMA temp_store = memory",
 		"PRAGMA mmap_size = 268435456", // 256MB
+		"PRAGMA busy_timeout = 5000",   // Wait up to 5s for another process's write (a sync) instead of failing
 	}
 
 	for _, pragma := range pragmas {
@@ -168,82 +194,432 @@ func (d *Database) Close() error {
 	return nil
 }
 
+// Checkpoint writes the WAL back into the database file and truncates it, so the file on disk is complete
+func (d *Database) Checkpoint() error {
+	if _, err := d.db.Exec("PRAGMA wal_checkpoint(TRUNCATE)"); err != nil {
+		return fmt.Errorf("failed to checkpoint database: %v", err)
+	}
+	return nil
+}
+
+// maxIntegrityProblems caps how many problems IntegrityCheck lists, a badly damaged file can have thousands
+const maxIntegrityProblems = 100
+
+// IntegrityCheck runs SQLite's integrity_check and foreign_key_check, returning nil for a healthy database
+// and otherwise an error listing the problems found
+func (d *Database) IntegrityCheck() error {
+	return d.IntegrityCheckContext(context.Background())
+}
+
+// IntegrityCheckContext is IntegrityCheck, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) IntegrityCheckContext(ctx context.Context) error {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	var problems []string
+
+	rows, err := d.queryRows(ctx, "PRAGMA integrity_check")
+	if err != nil {
+		return fmt.Errorf("failed to run integrity check: %v", err)
+	}
+	for rows.Next() {
+		var message string
+		if err := rows.Scan(&message); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to read integrity check: %v", err)
+		}
+		if message != "ok" {
+			problems = append(problems, message)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read integrity check: %v", err)
+	}
+
+	rows, err = d.queryRows(ctx, "PRAGMA foreign_key_check")
+	if err != nil {
+		return fmt.Errorf("failed to run foreign key check: %v", err)
+	}
+	for rows.Next() {
+		var table, parent string
+		var rowID sql.NullInt64
+		var fkID int
+		if err := rows.Scan(&table, &rowID, &parent, &fkID); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to read foreign key check: %v", err)
+		}
+		problems = append(problems, fmt.Sprintf("%s row %d points at a missing %s row", table, rowID.Int64, parent))
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read foreign key check: %v", err)
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	total := len(problems)
+	if total > maxIntegrityProblems {
+		problems = append(problems[:maxIntegrityProblems], fmt.Sprintf("... and %d more", total-maxIntegrityProblems))
+	}
+	noun := "problems"
+	if total == 1 {
+		noun = "problem"
+	}
+	return fmt.Errorf("database integrity check found %d %s:\n  %s", total, noun, strings.Join(problems, "\n  "))
+}
+
 // GetSourceID returns the ID for a given source name
 func (d *Database) GetSourceID(sourceName string) (int, error) {
+	return d.GetSourceIDContext(context.Background(), sourceName)
+}
+
+// GetSourceIDContext is GetSourceID, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetSourceIDContext(ctx context.Context, sourceName string) (int, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
 	var id int
 	query := "SELECT id FROM repeater_sources WHERE source_name = ?"
-	err := d.db.QueryRow(query, sourceName).Scan(&id)
+	err := d.db.QueryRowContext(ctx, query, sourceName).Scan(&id)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get source ID for %s: %v", sourceName, err)
 	}
 	return id, nil
 }
 
-// UpsertLocation inserts or updates a location record
+// GetSourceNames returns every repeater source name keyed by its ID
+func (d *Database) GetSourceNames() (map[int]string, error) {
+	rows, err := d.db.Query("SELECT id, source_name FROM repeater_sources")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sources: %v", err)
+	}
+	defer rows.Close()
+
+	names := make(map[int]string)
+	for rows.Next() {
+		var id int
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan source: %v", err)
+		}
+		names[id] = name
+	}
+	return names, rows.Err()
+}
+
+// UpsertLocation returns the location row for an exact place, inserting it if it isn't there yet
+// A row for the same city with no coordinates is given these ones rather than left beside a new row
 func (d *Database) UpsertLocation(city, state, country string, lat, lng float64) (int, error) {
 	// First, try to find existing location
 	var id int
-	query := "SELECT id FROM locations WHERE city = ? AND state = ? AND country = ?"
-	err := d.db.QueryRow(query, city, state, country).Scan(&id)
-
-	if err == sql.ErrNoRows {
-		// Insert new location
-		insertQuery := `
-            INSERT INTO locations (city, state, country, latitude, longitude)
-            VALUES (?, ?, ?, ?, ?)
-        `
-		result, err := d.db.Exec(insertQuery, city, state, country, lat, lng)
-		if err != nil {
-			return 0, fmt.Errorf("failed to insert location: %v", err)
-		}
+	err := d.db.QueryRow(locationLookupSQL, city, state, country, lat, lng).Scan(&id)
+	if err == nil {
+		return id, nil
+	} else if err != sql.ErrNoRows {
+		return 0, fmt.Errorf("failed to query location: %v", err)
+	}
 
-		lastID, err := result.LastInsertId()
-		if err != nil {
-			return 0, fmt.Errorf("failed to get last insert ID: %v", err)
+	// Fill in the coordinates of a row that had none
+	if lat != 0 || lng != 0 {
+		err = d.db.QueryRow(`
+            SELECT id FROM locations
+            WHERE city = ? AND state = ? AND country = ? AND latitude = 0 AND longitude = 0
+        `, city, state, country).Scan(&id)
+		if err == nil {
+			if _, err := d.exec("UPDATE locations SET latitude = ?, longitude = ? WHERE id = ?", lat, lng, id); err != nil {
+				return 0, fmt.Errorf("failed to update location: %v", err)
+			}
+			return id, nil
+		} else if err != sql.ErrNoRows {
+			return 0, fmt.Errorf("failed to query location: %v", err)
 		}
-
-		return int(lastID), nil
-	} else if err != nil {
-		return 0, fmt.Errorf("failed to query location: %v", err)
 	}
 
-	// Update existing location with new coordinates if they're more precise
-	updateQuery := `
-        UPDATE locations 
-        SET latitude = ?, longitude = ? 
-        WHERE id = ? AND (latitude = 0 OR longitude = 0 OR ? != 0 AND ? != 0)
+	// Insert new location
+	insertQuery := `
+        INSERT INTO locations (city, state, country, latitude, longitude)
+        VALUES (?, ?, ?, ?, ?)
     `
-	_, err = d.db.Exec(updateQuery, lat, lng, id, lat, lng)
+	result, err := d.exec(insertQuery, city, state, country, lat, lng)
 	if err != nil {
-		return 0, fmt.Errorf("failed to update location: %v", err)
+		return 0, fmt.Errorf("failed to insert location: %v", err)
 	}
 
-	return id, nil
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert ID: %v", err)
+	}
+
+	return int(lastID), nil
 }
 
-// SearchRepeaters performs a complex search across all repeater data
-func (d *Database) SearchRepeaters(query string, limit int) ([]RepeaterRecord, error) {
-	sqlQuery := `
-        SELECT r.id, r.callsign, r.source_id, r.external_id, r.location_id,
-               r.tx_frequency, r.rx_frequency, r.offset_frequency, r.tone_frequency,
+// repeaterSelectColumns is the column list expected by scanRepeaterRow
+const repeaterSelectColumns = `
+        SELECT r.id, r.callsign, r.source_id, r.external_id, r.location_id, r.city_inferred,
+               r.tx_frequency, r.rx_frequency, r.offset_frequency, r.offset_inferred, r.tone_frequency,
                r.mode, r.color_code, r.digital_modes, r.operational, r.online_status,
                r.last_seen, r.power_watts, r.antenna_height_agl, r.antenna_height_msl,
-               r.hardware, r.firmware, r.website, r.description,
+               r.hardware, r.firmware, r.website, r.description, r.description_html, r.last_master, r.device_type,
                r.created_at, r.updated_at, r.last_api_sync,
                l.city, l.state, l.country, l.latitude, l.longitude
         FROM repeaters r
-        LEFT JOIN locations l ON r.location_id = l.id
-        WHERE r.callsign LIKE ? 
+        LEFT JOIN locations l ON r.location_id = l.id`
+
+// DeviceFilter chooses which kinds of device a search, map query or export returns, each caller passing its own
+// The zero value leaves out Brandmeister hotspots, most of them are single-user devices that don't help
+// coverage planning, and keeps every power
+type DeviceFilter struct {
+	IncludeHotspots bool
+
+	// MinPowerWatts leaves out repeaters reporting less power, 0 turns it off. Repeaters with no power listed
+	// are kept unless ExcludeUnknownPower is set: Brandmeister lists PEP in whole watts and a hotspot
+	// reporting 0 has no power stored, so that's what drops those
+	// This is on top of the hotspot filter, a device classified as a hotspot is left out whatever its power
+	MinPowerWatts       int
+	ExcludeUnknownPower bool
+}
+
+// SearchDeviceFilter is the DeviceFilter configured under search: in config.yaml, hotspots left out
+func SearchDeviceFilter(settings config.SearchSettings) DeviceFilter {
+	return DeviceFilter{MinPowerWatts: settings.MinPowerWatts, ExcludeUnknownPower: settings.ExcludeUnknownPower()}
+}
+
+// deviceFilter is the search condition that leaves out Brandmeister hotspots and low-power devices, bind
+// deviceFilterArgs to it
+const deviceFilter = `(? = 1 OR r.device_type IS NOT 'hotspot')
+          AND (? = 0 OR r.power_watts >= ? OR (r.power_watts IS NULL AND ? = 0))`
+
+// deviceFilterArgs are the values for deviceFilter
+func deviceFilterArgs(devices DeviceFilter) []interface{} {
+	watts := max(0, devices.MinPowerWatts)
+	return []interface{}{devices.IncludeHotspots, watts, watts, devices.ExcludeUnknownPower}
+}
+
+// meetsMinPower reports whether a repeater passes the filter's minimum power, the same test as deviceFilter
+func (f DeviceFilter) meetsMinPower(r RepeaterRecord) bool {
+	if f.MinPowerWatts <= 0 {
+		return true
+	}
+	if r.PowerWatts == nil {
+		return !f.ExcludeUnknownPower
+	}
+	return *r.PowerWatts >= f.MinPowerWatts
+}
+
+// scanRepeaterRow scans one row selected with repeaterSelectColumns, converting nullable columns to pointers
+func scanRepeaterRow(rows *sql.Rows) (RepeaterRecord, error) {
+	var r RepeaterRecord
+
+	// Use sql.Null types for nullable fields
+	var locationID sql.NullInt64
+	var txFreq, rxFreq, offsetFreq, toneFreq sql.NullFloat64
+	var offsetInferred, cityInferred sql.NullBool
+	var colorCode sql.NullInt64
+	var mode, externalID, deviceType sql.NullString
+	var digitalModes, hardware, firmware, website, description, descriptionHTML sql.NullString
+	var lastSeen sql.NullTime
+	var powerWatts, antennaHeightAGL, antennaHeightMSL, lastMaster sql.NullInt64
+	var city, state, country sql.NullString
+	var lat, lng sql.NullFloat64
+	var sourceID sql.NullInt64
+
+	err := rows.Scan(
+		&r.ID, &r.Callsign, &sourceID, &externalID, &locationID, &cityInferred,
+		&txFreq, &rxFreq, &offsetFreq, &offsetInferred, &toneFreq,
+		&mode, &colorCode, &digitalModes, &r.Operational, &r.OnlineStatus,
+		&lastSeen, &powerWatts, &antennaHeightAGL, &antennaHeightMSL,
+		&hardware, &firmware, &website, &description, &descriptionHTML, &lastMaster, &deviceType,
+		&r.CreatedAt, &r.UpdatedAt, &r.LastAPISync,
+		&city, &state, &country, &lat, &lng,
+	)
+	if err != nil {
+		return r, fmt.Errorf("failed to scan repeater: %v", err)
+	}
+
+	r.SourceID = int(sourceID.Int64)
+	r.ExternalID = externalID.String
+	r.Mode = mode.String
+	r.DeviceType = deviceType.String
+	r.OffsetInferred = offsetInferred.Bool
+	r.CityInferred = cityInferred.Bool
+
+	// Convert nullable fields to pointers
+	if locationID.Valid {
+		id := int(locationID.Int64)
+		r.LocationID = &id
+	}
+	if txFreq.Valid {
+		r.TxFrequency = &txFreq.Float64
+	}
+	if rxFreq.Valid {
+		r.RxFrequency = &rxFreq.Float64
+	}
+	if offsetFreq.Valid {
+		r.OffsetFrequency = &offsetFreq.Float64
+	}
+	if toneFreq.Valid {
+		r.ToneFrequency = &toneFreq.Float64
+	}
+	if colorCode.Valid {
+		cc := int(colorCode.Int64)
+		r.ColorCode = &cc
+	}
+	if digitalModes.Valid {
+		r.DigitalModes = &digitalModes.String
+	}
+	if lastSeen.Valid {
+		r.LastSeen = &lastSeen.Time
+	}
+	if powerWatts.Valid {
+		pw := int(powerWatts.Int64)
+		r.PowerWatts = &pw
+	}
+	if antennaHeightAGL.Valid {
+		agl := int(antennaHeightAGL.Int64)
+		r.AntennaHeightAGL = &agl
+	}
+	if antennaHeightMSL.Valid {
+		msl := int(antennaHeightMSL.Int64)
+		r.AntennaHeightMSL = &msl
+	}
+	if hardware.Valid {
+		r.Hardware = &hardware.String
+	}
+	if firmware.Valid {
+		r.Firmware = &firmware.String
+	}
+	if website.Valid {
+		r.Website = &website.String
+	}
+	if description.Valid {
+		r.Description = &description.String
+	}
+	if descriptionHTML.Valid {
+		r.DescriptionHTML = &descriptionHTML.String
+	}
+	if lastMaster.Valid {
+		master := int(lastMaster.Int64)
+		r.LastMaster = &master
+	}
+	if city.Valid {
+		r.City = &city.String
+	}
+	if state.Valid {
+		r.State = &state.String
+	}
+	if country.Valid {
+		r.Country = &country.String
+	}
+	if lat.Valid {
+		r.Latitude = &lat.Float64
+	}
+	if lng.Valid {
+		r.Longitude = &lng.Float64
+	}
+
+	return r, nil
+}
+
+// maxIDsPerQuery keeps IN lists under SQLite's host parameter limit (999 on older builds)
+const maxIDsPerQuery = 500
+
+// GetRepeatersByIDs loads repeaters by database ID in one query per 500 IDs
+// Results are in the order of ids; IDs that don't exist are skipped and repeated IDs are returned once
+func (d *Database) GetRepeatersByIDs(ids []int) ([]RepeaterRecord, error) {
+	return d.GetRepeatersByIDsContext(context.Background(), ids)
+}
+
+// GetRepeatersByIDsContext is GetRepeatersByIDs, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetRepeatersByIDsContext(ctx context.Context, ids []int) ([]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	order := make(map[int]int, len(ids))
+	var unique []int
+	for _, id := range ids {
+		if _, seen := order[id]; !seen {
+			order[id] = len(unique)
+			unique = append(unique, id)
+		}
+	}
+
+	found := make([]*RepeaterRecord, len(unique))
+	for start := 0; start < len(unique); start += maxIDsPerQuery {
+		chunk := unique[start:min(start+maxIDsPerQuery, len(unique))]
+
+		placeholders := make([]string, len(chunk))
+		args := make([]interface{}, len(chunk))
+		for i, id := range chunk {
+			placeholders[i] = "?"
+			args[i] = id
+		}
+
+		rows, err := d.queryRows(ctx, repeaterSelectColumns+`
+        WHERE r.id IN (`+strings.Join(placeholders, ",")+`)`, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load repeaters: %v", err)
+		}
+		for rows.Next() {
+			r, err := scanRepeaterRow(rows)
+			if err != nil {
+				rows.Close()
+				return nil, err
+			}
+			found[order[r.ID]] = &r
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load repeaters: %v", err)
+		}
+	}
+
+	repeaters := make([]RepeaterRecord, 0, len(unique))
+	for _, r := range found {
+		if r != nil {
+			repeaters = append(repeaters, *r)
+		}
+	}
+	return repeaters, nil
+}
+
+// repeaterSearchCondition is the WHERE clause of SearchRepeaters and SearchRepeatersPage, bind
+// repeaterSearchArgs to it
+const repeaterSearchCondition = `
+        WHERE (r.callsign LIKE ?
            OR l.city LIKE ?
            OR l.state LIKE ?
            OR l.country LIKE ?
-           OR r.description LIKE ?
-        ORDER BY r.callsign
+           OR r.description LIKE ?)
+          AND (? = 0 OR r.online_status = true)
+          AND ` + deviceFilter
+
+// repeaterSearchArgs are the values for repeaterSearchCondition
+func (d *Database) repeaterSearchArgs(query string, onlineOnly bool, devices DeviceFilter) []interface{} {
+	searchTerm := "%" + query + "%"
+	return append([]interface{}{searchTerm, searchTerm, searchTerm, searchTerm, searchTerm, onlineOnly}, deviceFilterArgs(devices)...)
+}
+
+// SearchRepeaters performs a complex search across all repeater data
+// With onlineOnly set only repeaters whose online_status is set are returned;
+// Brandmeister is currently the only source that reports it
+func (d *Database) SearchRepeaters(query string, limit int, onlineOnly bool, devices DeviceFilter) ([]RepeaterRecord, error) {
+	return d.SearchRepeatersContext(context.Background(), query, limit, onlineOnly, devices)
+}
+
+// SearchRepeatersContext is SearchRepeaters, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) SearchRepeatersContext(ctx context.Context, query string, limit int, onlineOnly bool, devices DeviceFilter) ([]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	sqlQuery := repeaterSelectColumns + repeaterSearchCondition + `
+        ORDER BY r.callsign, r.id
         LIMIT ?
     `
 
-	searchTerm := "%" + query + "%"
-	rows, err := d.db.Query(sqlQuery, searchTerm, searchTerm, searchTerm, searchTerm, searchTerm, limit)
+	rows, err := d.queryRows(ctx, sqlQuery, append(d.repeaterSearchArgs(query, onlineOnly, devices), limit)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search repeaters: %v", err)
 	}
@@ -251,111 +627,32 @@ func (d *Database) SearchRepeaters(query string, limit int) ([]RepeaterRecord, e
 
 	var repeaters []RepeaterRecord
 	for rows.Next() {
-		var r RepeaterRecord
-
-		// Use sql.Null types for nullable fields
-		var locationID sql.NullInt64
-		var txFreq, rxFreq, offsetFreq, toneFreq sql.NullFloat64
-		var colorCode sql.NullInt64
-		var digitalModes, hardware, firmware, website, description sql.NullString
-		var lastSeen sql.NullTime
-		var powerWatts, antennaHeightAGL, antennaHeightMSL sql.NullInt64
-		var city, state, country sql.NullString
-		var lat, lng sql.NullFloat64
-
-		err := rows.Scan(
-			&r.ID, &r.Callsign, &r.SourceID, &r.ExternalID, &locationID,
-			&txFreq, &rxFreq, &offsetFreq, &toneFreq,
-			&r.Mode, &colorCode, &digitalModes, &r.Operational, &r.OnlineStatus,
-			&lastSeen, &powerWatts, &antennaHeightAGL, &antennaHeightMSL,
-			&hardware, &firmware, &website, &description,
-			&r.CreatedAt, &r.UpdatedAt, &r.LastAPISync,
-			&city, &state, &country, &lat, &lng,
-		)
+		r, err := scanRepeaterRow(rows)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan repeater: %v", err)
-		}
-
-		// Convert nullable fields to pointers
-		if locationID.Valid {
-			id := int(locationID.Int64)
-			r.LocationID = &id
-		}
-		if txFreq.Valid {
-			r.TxFrequency = &txFreq.Float64
-		}
-		if rxFreq.Valid {
-			r.RxFrequency = &rxFreq.Float64
-		}
-		if offsetFreq.Valid {
-			r.OffsetFrequency = &offsetFreq.Float64
-		}
-		if toneFreq.Valid {
-			r.ToneFrequency = &toneFreq.Float64
-		}
-		if colorCode.Valid {
-			cc := int(colorCode.Int64)
-			r.ColorCode = &cc
-		}
-		if digitalModes.Valid {
-			r.DigitalModes = &digitalModes.String
-		}
-		if lastSeen.Valid {
-			r.LastSeen = &lastSeen.Time
-		}
-		if powerWatts.Valid {
-			pw := int(powerWatts.Int64)
-			r.PowerWatts = &pw
-		}
-		if antennaHeightAGL.Valid {
-			agl := int(antennaHeightAGL.Int64)
-			r.AntennaHeightAGL = &agl
-		}
-		if antennaHeightMSL.Valid {
-			msl := int(antennaHeightMSL.Int64)
-			r.AntennaHeightMSL = &msl
-		}
-		if hardware.Valid {
-			r.Hardware = &hardware.String
-		}
-		if firmware.Valid {
-			r.Firmware = &firmware.String
-		}
-		if website.Valid {
-			r.Website = &website.String
-		}
-		if description.Valid {
-			r.Description = &description.String
-		}
-		if city.Valid {
-			r.City = &city.String
-		}
-		if state.Valid {
-			r.State = &state.String
-		}
-		if country.Valid {
-			r.Country = &country.String
-		}
-		if lat.Valid {
-			r.Latitude = &lat.Float64
-		}
-		if lng.Valid {
-			r.Longitude = &lng.Float64
+			return nil, err
 		}
-
 		repeaters = append(repeaters, r)
 	}
 
-	return repeaters, nil
+	return repeaters, rows.Err()
 }
 
 // GetRepeaterStats returns statistics about the repeater database
+// "freshness" maps each source to its SourceFreshness
 func (d *Database) GetRepeaterStats() (map[string]interface{}, error) {
+	return d.GetRepeaterStatsContext(context.Background())
+}
+
+// GetRepeaterStatsContext is GetRepeaterStats, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetRepeaterStatsContext(ctx context.Context) (map[string]interface{}, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
 	stats := make(map[string]interface{})
 
 	// Total repeaters
 	var total int
-	err := d.db.QueryRow("SELECT COUNT(*) FROM repeaters").Scan(&total)
+	err := d.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM repeaters").Scan(&total)
 	if err != nil {
 		return nil, err
 	}
@@ -368,7 +665,7 @@ func (d *Database) GetRepeaterStats() (map[string]interface{}, error) {
         LEFT JOIN repeaters r ON rs.id = r.source_id 
         GROUP BY rs.id, rs.source_name
     `
-	rows, err := d.db.Query(sourceQuery)
+	rows, err := d.queryRows(ctx, sourceQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -387,15 +684,52 @@ func (d *Database) GetRepeaterStats() (map[string]interface{}, error) {
 
 	// Online status
 	var online int
-	err = d.db.QueryRow("SELECT COUNT(*) FROM repeaters WHERE online_status = true").Scan(&online)
+	err = d.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM repeaters WHERE online_status = true").Scan(&online)
 	if err != nil {
 		return nil, err
 	}
 	stats["online_repeaters"] = online
 
+	freshness, err := d.getSourceFreshness(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats["freshness"] = freshness
+
 	return stats, nil
 }
 
+// SourceFreshness is how recent a source's data is
+type SourceFreshness struct {
+	LastSync time.Time     `json:"last_sync"` // Zero when the source was never synced
+	Age      time.Duration `json:"age"`       // Time since LastSync, zero when never synced
+}
+
+// getSourceFreshness reads each source's last sync time from repeater_sources
+func (d *Database) getSourceFreshness(ctx context.Context) (map[string]SourceFreshness, error) {
+	rows, err := d.queryRows(ctx, "SELECT source_name, last_sync FROM repeater_sources")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	now := time.Now()
+	freshness := make(map[string]SourceFreshness)
+	for rows.Next() {
+		var source string
+		var lastSync sql.NullTime
+		if err := rows.Scan(&source, &lastSync); err != nil {
+			return nil, err
+		}
+		var f SourceFreshness
+		if lastSync.Valid {
+			f = SourceFreshness{LastSync: lastSync.Time, Age: now.Sub(lastSync.Time)}
+		}
+		freshness[source] = f
+	}
+	return freshness, rows.Err()
+}
+
 // Helper methods for RepeaterRecord to safely handle nullable fields
 
 // GetFrequencyString returns a formatted frequency string
@@ -437,10 +771,20 @@ func (r *RepeaterRecord) GetHardwareString() string {
 	return "Unknown hardware"
 }
 
-// GetPowerString returns power info or default
+// GetPowerString returns power info or default, with the antenna height in meters when known
 func (r *RepeaterRecord) GetPowerString() string {
-	if r.PowerWatts != nil {
+	return r.GetPowerStringIn(config.Meters)
+}
+
+// GetPowerStringIn is GetPowerString with the antenna height shown in the given units
+func (r *RepeaterRecord) GetPowerStringIn(units config.HeightUnits) string {
+	switch {
+	case r.PowerWatts != nil && r.AntennaHeightAGL != nil:
+		return fmt.Sprintf("%d watts, %s AGL", *r.PowerWatts, units.Format(*r.AntennaHeightAGL))
+	case r.PowerWatts != nil:
 		return fmt.Sprintf("%d watts", *r.PowerWatts)
+	case r.AntennaHeightAGL != nil:
+		return fmt.Sprintf("Unknown power, %s AGL", units.Format(*r.AntennaHeightAGL))
 	}
 	return "Unknown power"
 }
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// frequencyUnits are the suffixes ParseFrequency accepts, with how many Hz one of each is
+var frequencyUnits = []struct {
+	suffix string
+	hz     float64
+}{
+	{"ghz", 1e9},
+	{"mhz", 1e6},
+	{"khz", 1e3},
+	{"hz", 1}, // Last, so "mhz" isn't taken for "hz"
+}
+
+// ParseFrequency reads a frequency as people type it and returns it in MHz:
+// "146.52", "146,52", "146,520", "146520 kHz", "146.52 MHz" and "146520000" are all 146.52
+// A single comma is a decimal separator, European style; with both separators, or several commas,
+// the last separator is the decimal point and the others group thousands
+// Without a unit the magnitude decides: under 10000 is MHz, under 10 million kHz, anything larger Hz
+func ParseFrequency(s string) (float64, error) {
+	text := strings.ToLower(strings.TrimSpace(s))
+
+	unit := 0.0
+	for _, u := range frequencyUnits {
+		if strings.HasSuffix(text, u.suffix) {
+			text = strings.TrimSpace(strings.TrimSuffix(text, u.suffix))
+			unit = u.hz
+			break
+		}
+	}
+
+	number, err := normalizeDecimal(text)
+	if err != nil {
+		return 0, fmt.Errorf("invalid frequency %q: %v", s, err)
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid frequency %q", s)
+	}
+
+	if unit == 0 {
+		switch {
+		case value < 10000:
+			unit = 1e6
+		case value < 10000000:
+			unit = 1e3
+		default:
+			unit = 1
+		}
+	}
+	if unit == 1e6 {
+		return value, nil
+	}
+	// Dividing rather than multiplying by a fraction keeps 146520000 Hz an exact 146.52
+	return value * unit / 1e6, nil
+}
+
+// normalizeDecimal rewrites a number with comma or dot separators into the plain form ParseFloat reads
+func normalizeDecimal(text string) (string, error) {
+	commas := strings.Count(text, ",")
+	dots := strings.Count(text, ".")
+
+	switch {
+	case commas == 0:
+		if dots > 1 {
+			// "1.296.000" only makes sense as grouped thousands
+			return strings.ReplaceAll(text, ".", ""), nil
+		}
+		return text, nil
+	case dots == 0 && commas == 1:
+		return strings.Replace(text, ",", ".", 1), nil
+	case dots == 0:
+		return strings.ReplaceAll(text, ",", ""), nil
+	}
+
+	// Both kinds: whichever comes last is the decimal point
+	lastComma, lastDot := strings.LastIndex(text, ","), strings.LastIndex(text, ".")
+	if lastComma > lastDot {
+		if dots > 0 && strings.Count(text[lastDot:], ",") != 1 {
+			return "", fmt.Errorf("mixed separators")
+		}
+		return strings.Replace(strings.ReplaceAll(text, ".", ""), ",", ".", 1), nil
+	}
+	if dots != 1 {
+		return "", fmt.Errorf("mixed separators")
+	}
+	return strings.ReplaceAll(text, ",", ""), nil
+}
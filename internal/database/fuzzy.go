@@ -0,0 +1,198 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// fuzzyMinScore is how close a row has to be to the query to be returned (1 is an exact match)
+const fuzzyMinScore = 0.75
+
+// SearchRepeatersFuzzy searches callsign, city, state, country and description allowing for typos
+// ("Phildelphia" finds Philadelphia). Results are ordered best match first
+func (d *Database) SearchRepeatersFuzzy(query string, limit int, devices DeviceFilter) ([]RepeaterRecord, error) {
+	return d.SearchRepeatersFuzzyContext(context.Background(), query, limit, devices)
+}
+
+// SearchRepeatersFuzzyContext is SearchRepeatersFuzzy, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) SearchRepeatersFuzzyContext(ctx context.Context, query string, limit int, devices DeviceFilter) ([]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	// Score on the text columns only, then load the full records for the best matches
+	// fuzzyPrefilter narrows the rows in SQL first, so only likely matches are scored
+	prefilter, args := fuzzyPrefilter(terms)
+	rows, err := d.queryRows(ctx, `
+        SELECT r.id, r.callsign, l.city, l.state, l.country, r.description
+        FROM repeaters r
+        LEFT JOIN locations l ON r.location_id = l.id
+        WHERE `+deviceFilter+prefilter+`
+    `, append(deviceFilterArgs(devices), args...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search repeaters: %v", err)
+	}
+	defer rows.Close()
+
+	type match struct {
+		id       int
+		callsign string
+		score    float64
+	}
+	var matches []match
+
+	for rows.Next() {
+		var id int
+		var callsign string
+		var city, state, country, description *string
+		if err := rows.Scan(&id, &callsign, &city, &state, &country, &description); err != nil {
+			return nil, fmt.Errorf("failed to scan repeater: %v", err)
+		}
+
+		words := strings.Fields(strings.ToLower(callsign))
+		for _, field := range []*string{city, state, country, description} {
+			if field != nil {
+				words = append(words, strings.Fields(strings.ToLower(*field))...)
+			}
+		}
+
+		if score := fuzzyScore(terms, words); score >= fuzzyMinScore {
+			matches = append(matches, match{id: id, callsign: callsign, score: score})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		if matches[i].callsign != matches[j].callsign {
+			return matches[i].callsign < matches[j].callsign
+		}
+		return matches[i].id < matches[j].id
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	// Load the winners, GetRepeatersByIDs keeps them in score order
+	ids := make([]int, len(matches))
+	for i, m := range matches {
+		ids[i] = m.id
+	}
+	return d.GetRepeatersByIDsContext(ctx, ids)
+}
+
+// fuzzySearchText is every column SearchRepeatersFuzzy matches, as one string for LIKE
+const fuzzySearchText = `(r.callsign || ' ' || COALESCE(l.city, '') || ' ' || COALESCE(l.state, '') || ' ' ||
+            COALESCE(l.country, '') || ' ' || COALESCE(r.description, ''))`
+
+// fuzzyPrefilter returns the SQL conditions, starting with AND, a row needs to have a chance of scoring
+// fuzzyMinScore, and their args. A word within fuzzyMinScore of a term is at most a third of the term's
+// length of edits away, so splitting the term into one more piece than that many edits leaves at least
+// one piece untouched, and the row's text has to contain it
+func fuzzyPrefilter(terms []string) (string, []interface{}) {
+	var sb strings.Builder
+	var args []interface{}
+	for _, term := range terms {
+		runes := []rune(term)
+		pieces := len(runes)/3 + 1
+		sb.WriteString("\n          AND (")
+		for i := 0; i < pieces; i++ {
+			if i > 0 {
+				sb.WriteString(" OR ")
+			}
+			sb.WriteString(fuzzySearchText + ` LIKE ? ESCAPE '\'`)
+			piece := string(runes[i*len(runes)/pieces : (i+1)*len(runes)/pieces])
+			args = append(args, "%"+escapeLike(piece)+"%")
+		}
+		sb.WriteString(")")
+	}
+	return sb.String(), args
+}
+
+// fuzzyScore rates how well the query terms match a row's words, from 0 to 1
+// Each term takes its best word and must reach fuzzyMinScore on its own, the row scores
+// the average over the terms
+func fuzzyScore(terms, words []string) float64 {
+	if len(words) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, term := range terms {
+		best := 0.0
+		for _, word := range words {
+			if s := termSimilarity(term, word); s > best {
+				best = s
+				if best == 1 {
+					break
+				}
+			}
+		}
+		if best < fuzzyMinScore {
+			return 0
+		}
+		total += best
+	}
+	return total / float64(len(terms))
+}
+
+// termSimilarity compares one query term with one word
+// Prefixes of three or more letters count as exact, so "phil" still finds Philadelphia
+func termSimilarity(term, word string) float64 {
+	if term == word || (len(term) >= 3 && strings.HasPrefix(word, term)) {
+		return 1
+	}
+
+	longest := utf8.RuneCountInString(term)
+	if n := utf8.RuneCountInString(word); n > longest {
+		longest = n
+	}
+	return 1 - float64(levenshtein(term, word))/float64(longest)
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
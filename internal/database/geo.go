@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// BoundingBox is a latitude/longitude rectangle used to narrow geographic queries
+type BoundingBox struct {
+	MinLat float64
+	MinLng float64
+	MaxLat float64
+	MaxLng float64
+}
+
+// WorldBoundingBox covers every valid coordinate
+var WorldBoundingBox = BoundingBox{MinLat: -90, MinLng: -180, MaxLat: 90, MaxLng: 180}
+
+// BoundingBoxAround returns a box that contains every point within radiusKm of lat/lng
+// It is a cheap SQL pre-filter; callers refine the result with HaversineKm
+func BoundingBoxAround(lat, lng, radiusKm float64) BoundingBox {
+	latDelta := radiusKm / 111.0 // ~111 km per degree of latitude
+
+	// Degrees of longitude shrink towards the poles
+	lngDelta := 180.0
+	if cosLat := math.Cos(lat * math.Pi / 180); cosLat > 0.01 {
+		lngDelta = math.Min(180, radiusKm/(111.0*cosLat))
+	}
+
+	return BoundingBox{
+		MinLat: math.Max(-90, lat-latDelta),
+		MinLng: math.Max(-180, lng-lngDelta),
+		MaxLat: math.Min(90, lat+latDelta),
+		MaxLng: math.Min(180, lng+lngDelta),
+	}
+}
+
+// HaversineKm calculates the great-circle distance between two points in kilometers
+func HaversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	const earthRadius = 6371 // Earth's radius in kilometers
+
+	lat1Rad := lat1 * math.Pi / 180
+	lat2Rad := lat2 * math.Pi / 180
+	dlat := (lat2 - lat1) * math.Pi / 180
+	dlng := (lng2 - lng1) * math.Pi / 180
+
+	a := math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(lat1Rad)*math.Cos(lat2Rad)*math.Sin(dlng/2)*math.Sin(dlng/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return earthRadius * c
+}
+
+// GetRepeatersInBoundingBox returns repeaters whose location falls inside box
+// Records without coordinates (stored as 0,0) are excluded, limit <= 0 means no limit
+func (d *Database) GetRepeatersInBoundingBox(box BoundingBox, limit int, devices DeviceFilter) ([]RepeaterRecord, error) {
+	return d.GetRepeatersInBoundingBoxContext(context.Background(), box, limit, devices)
+}
+
+// GetRepeatersInBoundingBoxContext is GetRepeatersInBoundingBox, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetRepeatersInBoundingBoxContext(ctx context.Context, box BoundingBox, limit int, devices DeviceFilter) ([]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	query := repeaterSelectColumns + `
+        WHERE l.latitude BETWEEN ? AND ?
+          AND l.longitude BETWEEN ? AND ?
+          AND NOT (l.latitude = 0 AND l.longitude = 0)
+          AND ` + deviceFilter + `
+        ORDER BY r.callsign, r.id
+        LIMIT ?
+    `
+	if limit <= 0 {
+		limit = -1 // SQLite: no limit
+	}
+
+	args := append([]interface{}{box.MinLat, box.MaxLat, box.MinLng, box.MaxLng}, deviceFilterArgs(devices)...)
+	rows, err := d.queryRows(ctx, query, append(args, limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search by bounding box: %v", err)
+	}
+	defer rows.Close()
+
+	var repeaters []RepeaterRecord
+	for rows.Next() {
+		r, err := scanRepeaterRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		repeaters = append(repeaters, r)
+	}
+
+	return repeaters, rows.Err()
+}
+
+// NearbyRepeater is a repeater found by a radius search with its distance from the search point
+type NearbyRepeater struct {
+	RepeaterRecord
+	DistanceKm float64 `json:"distance_km"`
+}
+
+// GetRepeatersNear returns repeaters within radiusKm of lat/lng, closest first
+// limit <= 0 means no limit
+func (d *Database) GetRepeatersNear(lat, lng, radiusKm float64, limit int, devices DeviceFilter) ([]NearbyRepeater, error) {
+	return d.GetRepeatersNearContext(context.Background(), lat, lng, radiusKm, limit, devices)
+}
+
+// GetRepeatersNearContext is GetRepeatersNear, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetRepeatersNearContext(ctx context.Context, lat, lng, radiusKm float64, limit int, devices DeviceFilter) ([]NearbyRepeater, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	candidates, err := d.GetRepeatersInBoundingBoxContext(ctx, BoundingBoxAround(lat, lng, radiusKm), 0, devices)
+	if err != nil {
+		return nil, err
+	}
+
+	// The box is a superset of the circle, keep only what is really in range
+	var nearby []NearbyRepeater
+	for _, r := range candidates {
+		distance := HaversineKm(lat, lng, *r.Latitude, *r.Longitude)
+		if distance <= radiusKm {
+			nearby = append(nearby, NearbyRepeater{RepeaterRecord: r, DistanceKm: distance})
+		}
+	}
+
+	sort.SliceStable(nearby, func(i, j int) bool {
+		return nearby[i].DistanceKm < nearby[j].DistanceKm
+	})
+	if limit > 0 && len(nearby) > limit {
+		nearby = nearby[:limit]
+	}
+
+	return nearby, nil
+}
+
+// nearestSearchRadiiKm are the widening radii GetNearestByBand tries, it gives up beyond the last
+var nearestSearchRadiiKm = []float64{25, 50, 100, 250, 500, 1000, 2000}
+
+// GetNearestByBand returns the nearest operational repeater for each requested band
+// A band is a name from Bands ("2m", "70cm") or a mode ("DMR", "D-STAR"), matched without case
+// Bands with nothing within 2000 km are left out of the map
+func (d *Database) GetNearestByBand(lat, lng float64, bands []string, devices DeviceFilter) (map[string]RepeaterRecord, error) {
+	return d.GetNearestByBandContext(context.Background(), lat, lng, bands, devices)
+}
+
+// GetNearestByBandContext is GetNearestByBand, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetNearestByBandContext(ctx context.Context, lat, lng float64, bands []string, devices DeviceFilter) (map[string]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	nearest := make(map[string]RepeaterRecord, len(bands))
+
+	for _, radius := range nearestSearchRadiiKm {
+		candidates, err := d.GetRepeatersNearContext(ctx, lat, lng, radius, 0, devices)
+		if err != nil {
+			return nil, err
+		}
+
+		// Candidates are closest first, so the first match for a band is its nearest
+		for _, c := range candidates {
+			if !c.Operational {
+				continue
+			}
+			for _, band := range bands {
+				if _, found := nearest[band]; !found && c.matchesBand(band) {
+					nearest[band] = c.RepeaterRecord
+				}
+			}
+		}
+
+		if len(nearest) == len(bands) {
+			break
+		}
+	}
+
+	return nearest, nil
+}
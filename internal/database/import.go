@@ -0,0 +1,229 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// UnifiedRepeater is a source-neutral repeater record for bulk imports
+// Frequencies are MHz, zero values mean unknown
+type UnifiedRepeater struct {
+	ExternalID    string  `json:"external_id"` // Generated from callsign and frequency when empty
+	Callsign      string  `json:"callsign"`
+	City          string  `json:"city"`
+	State         string  `json:"state"`
+	Country       string  `json:"country"`
+	Latitude      float64 `json:"latitude"`
+	Longitude     float64 `json:"longitude"`
+	TxFrequency   float64 `json:"tx_frequency"` // Repeater output
+	RxFrequency   float64 `json:"rx_frequency"` // Repeater input
+	ToneFrequency float64 `json:"tone_frequency"`
+	Mode          string  `json:"mode"`
+	ColorCode     int     `json:"color_code"`
+	PowerWatts    int     `json:"power_watts"`
+	Website       string  `json:"website"`
+	Description   string  `json:"description"`
+}
+
+// importExternalID identifies records that came without an ID of their own, so importing
+// the same list again updates them instead of adding copies
+func importExternalID(rep UnifiedRepeater) string {
+	if rep.ExternalID != "" {
+		return rep.ExternalID
+	}
+	return fmt.Sprintf("%s-%.4f", strings.ToUpper(rep.Callsign), rep.TxFrequency)
+}
+
+// ImportRepeaters adds repeaters from a user-supplied list under sourceName, creating the source if needed
+// Re-importing updates existing records in place. Records without a callsign are skipped
+// Returns how many repeaters were imported
+func (d *Database) ImportRepeaters(repeaters []UnifiedRepeater, sourceName string) (int, error) {
+	if sourceName == "" {
+		return 0, fmt.Errorf("source name is required")
+	}
+
+	tx, err := d.beginWrite()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("INSERT OR IGNORE INTO repeater_sources (source_name) VALUES (?)", sourceName); err != nil {
+		return 0, fmt.Errorf("failed to create source %s: %v", sourceName, err)
+	}
+	var sourceID int
+	if err := tx.QueryRow("SELECT id FROM repeater_sources WHERE source_name = ?", sourceName).Scan(&sourceID); err != nil {
+		return 0, fmt.Errorf("failed to get source ID for %s: %v", sourceName, err)
+	}
+
+	runID, err := startSyncRun(tx, sourceName)
+	if err != nil {
+		return 0, err
+	}
+	detachable, err := sourceLocations(tx, sourceID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Cache location IDs, many repeaters share a city
+	locationCache := make(map[string]int)
+
+	locationStmt, err := tx.Prepare(`
+        INSERT OR IGNORE INTO locations (city, state, country, latitude, longitude)
+        VALUES (?, ?, ?, ?, ?)
+    `)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare location statement: %v", err)
+	}
+	defer locationStmt.Close()
+
+	locationLookupStmt, err := tx.Prepare(locationLookupSQL)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare location lookup statement: %v", err)
+	}
+	defer locationLookupStmt.Close()
+
+	repeaterStmt, err := tx.Prepare(`
+        INSERT INTO repeaters (
+            callsign, source_id, external_id, location_id,
+            tx_frequency, rx_frequency, offset_frequency, tone_frequency,
+            mode, color_code, power_watts, website, description, last_api_sync,
+            added_run_id, changed_run_id
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT(source_id, external_id) DO UPDATE SET
+            ` + trackChanges("callsign", "location_id", "tx_frequency", "rx_frequency", "offset_frequency",
+		"tone_frequency", "mode", "color_code", "power_watts", "website", "description") + `,
+            callsign = excluded.callsign, location_id = excluded.location_id,
+            tx_frequency = excluded.tx_frequency, rx_frequency = excluded.rx_frequency,
+            offset_frequency = excluded.offset_frequency, tone_frequency = excluded.tone_frequency,
+            mode = excluded.mode, color_code = excluded.color_code,
+            power_watts = excluded.power_watts, website = excluded.website,
+            description = excluded.description, last_api_sync = excluded.last_api_sync,
+            updated_at = CURRENT_TIMESTAMP
+    `)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare repeater statement: %v", err)
+	}
+	defer repeaterStmt.Close()
+
+	imported := 0
+	for _, rep := range repeaters {
+		rep.Callsign = strings.TrimSpace(rep.Callsign)
+		if rep.Callsign == "" {
+			fmt.Printf("Warning: skipping record without a callsign (external ID %q)\n", rep.ExternalID)
+			continue
+		}
+
+		var locationID sql.NullInt64
+		if !blankLocation(rep.City, rep.State, rep.Country, rep.Latitude, rep.Longitude) {
+			key := locationKey(rep.City, rep.State, rep.Country, rep.Latitude, rep.Longitude)
+			if cachedID, exists := locationCache[key]; exists {
+				locationID.Int64 = int64(cachedID)
+				locationID.Valid = true
+			} else if _, err := locationStmt.Exec(rep.City, rep.State, rep.Country, rep.Latitude, rep.Longitude); err != nil {
+				fmt.Printf("Warning: failed to insert location for %s: %v\n", rep.Callsign, err)
+			} else {
+				var locID int
+				if err := locationLookupStmt.QueryRow(rep.City, rep.State, rep.Country, rep.Latitude, rep.Longitude).Scan(&locID); err == nil {
+					locationID.Int64 = int64(locID)
+					locationID.Valid = true
+					locationCache[key] = locID
+				}
+			}
+		}
+
+		var txFreq, rxFreq, offsetFreq, toneFreq sql.NullFloat64
+		if rep.TxFrequency > 0 {
+			txFreq.Float64 = rep.TxFrequency
+			txFreq.Valid = true
+		}
+		if rep.RxFrequency > 0 {
+			rxFreq.Float64 = rep.RxFrequency
+			rxFreq.Valid = true
+		}
+		if txFreq.Valid && rxFreq.Valid {
+			offsetFreq.Float64 = math.Round((rxFreq.Float64-txFreq.Float64)*10000) / 10000 // Drop float noise
+			offsetFreq.Valid = true
+		}
+		if rep.ToneFrequency > 0 {
+			toneFreq.Float64 = rep.ToneFrequency
+			toneFreq.Valid = true
+		}
+
+		mode := rep.Mode
+		if mode == "" {
+			mode = "FM"
+		}
+
+		var colorCode, power sql.NullInt64
+		if rep.ColorCode > 0 {
+			colorCode.Int64 = int64(rep.ColorCode)
+			colorCode.Valid = true
+		}
+		if rep.PowerWatts > 0 {
+			power.Int64 = int64(rep.PowerWatts)
+			power.Valid = true
+		}
+
+		var website, description sql.NullString
+		if rep.Website != "" {
+			website.String = rep.Website
+			website.Valid = true
+		}
+		if rep.Description != "" {
+			description.String = rep.Description
+			description.Valid = true
+		}
+
+		_, err = repeaterStmt.Exec(
+			rep.Callsign,
+			sourceID,
+			importExternalID(rep),
+			locationID,
+			txFreq,
+			rxFreq,
+			offsetFreq,
+			toneFreq,
+			mode,
+			colorCode,
+			power,
+			website,
+			description,
+			time.Now(),
+			runID,
+			runID,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("failed to import repeater %s: %v", rep.Callsign, err)
+		}
+		imported++
+	}
+
+	// Drop the locations repeaters moved off
+	if err := deleteDetachedLocations(tx, detachable); err != nil {
+		return 0, err
+	}
+
+	if _, _, err := finishSyncRun(tx, runID); err != nil {
+		return 0, err
+	}
+
+	// total_records counts everything held for the source, earlier imports included
+	_, err = tx.Exec(`
+        UPDATE repeater_sources
+        SET last_sync = ?, total_records = (SELECT COUNT(*) FROM repeaters WHERE source_id = ?)
+        WHERE id = ?
+    `, time.Now(), sourceID, sourceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update source sync time: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return imported, nil
+}
@@ -1,30 +1,158 @@
 package database
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/unklstewy/digiLogRT/internal/api" // Fixed module path
+	"github.com/unklstewy/digiLogRT/internal/geocode"
+	"github.com/unklstewy/digiLogRT/internal/metrics"
 )
 
-// ...existing code...
+// countSyncFailure records a failed sync in the metrics (deferred with the named error result)
+func countSyncFailure(source string, err *error) {
+	if *err != nil && !errors.Is(*err, context.Canceled) {
+		metrics.SyncErrors.Inc(source)
+	}
+}
+
+// inferRegion fills in a missing state and country from the coordinates, using the bundled boundaries
+// A state is only inferred when it belongs to the country the source reported
+func inferRegion(state, country string, lat, lng float64) (string, string) {
+	if state != "" && country != "" {
+		return state, country
+	}
+	inferredState, inferredCountry, ok := geocode.Lookup(lat, lng)
+	if !ok {
+		return state, country
+	}
+	if country == "" {
+		country = inferredCountry
+	}
+	if state == "" && country == inferredCountry {
+		state = inferredState
+	}
+	return state, country
+}
+
+// inferCity names the nearest bundled city for a record with coordinates but no city, inferred says it did
+// Only cities in the record's country, and state when it has one, count, so a repeater near a border isn't
+// labeled after a city across it
+func inferCity(city, state, country string, lat, lng float64) (name string, inferred bool) {
+	if city != "" || country == "" {
+		return city, false
+	}
+	if nearest, ok := geocode.Nearest(lat, lng, country, state); ok {
+		return nearest.Name, true
+	}
+	return city, false
+}
+
+// SetAllowEmptySync lets the Sync* methods accept an empty dataset for a source that already has records
+// By default they refuse, since an empty API response usually means an outage or an auth problem
+func (d *Database) SetAllowEmptySync(allow bool) {
+	d.allowEmptySync = allow
+}
+
+// DefaultSyncBatchSize is how many repeaters a sync writes between progress reports unless SyncOptions says otherwise
+const DefaultSyncBatchSize = 100
+
+// SyncOptions tunes how the Sync* methods write to the database
+type SyncOptions struct {
+	BatchSize int             // Repeaters per batch, each batch reports progress once; DefaultSyncBatchSize when 0
+	Context   context.Context // Cancelling it stops a sync and rolls it back; nil never cancels
+
+	// RateLimit caps the records per second each sync writes, so a background sync doesn't saturate the
+	// disk and starve the GUI; 0 is unlimited. The sync is still one transaction, WAL keeps readers going
+	// but other writers wait for it, up to busy_timeout
+	RateLimit float64
+}
+
+// SetSyncOptions changes how the Sync* methods write to the database
+// The whole sync stays one transaction, so the batch size changes how often progress prints rather than
+// throughput; cmd/bench_sync measures it
+func (d *Database) SetSyncOptions(opts SyncOptions) {
+	d.syncOptions = opts
+}
+
+// syncBatchSize is the configured batch size, or the default
+func (d *Database) syncBatchSize() int {
+	if d.syncOptions.BatchSize > 0 {
+		return d.syncOptions.BatchSize
+	}
+	return DefaultSyncBatchSize
+}
+
+// syncInterrupted reports a cancelled SyncOptions.Context, the sync returning it rolls its transaction back
+// Check it with errors.Is(err, context.Canceled)
+func (d *Database) syncInterrupted(source string) error {
+	if d.syncOptions.Context == nil {
+		return nil
+	}
+	if err := d.syncOptions.Context.Err(); err != nil {
+		return fmt.Errorf("%s sync interrupted: %w", source, err)
+	}
+	return nil
+}
+
+// checkNotEmpty refuses to sync an empty dataset over a source that already has records, so an upstream
+// hiccup can't blank its data and stats
+func (d *Database) checkNotEmpty(source string, count int) error {
+	if count > 0 || d.allowEmptySync {
+		return nil
+	}
+
+	// TGIF holds talkgroups, every other source holds repeaters
+	query := `SELECT COUNT(*) FROM repeaters r JOIN repeater_sources s ON r.source_id = s.id WHERE s.source_name = ?`
+	if source == "tgif" {
+		query = `SELECT COUNT(*) FROM talkgroups WHERE network = ?`
+	}
+	var existing int
+	if err := d.db.QueryRow(query, source).Scan(&existing); err != nil {
+		return fmt.Errorf("failed to count existing %s records: %v", source, err)
+	}
+	if existing > 0 {
+		return fmt.Errorf("refusing to sync empty dataset for %s over %d existing records (the API may be down, allow empty syncs to override)", source, existing)
+	}
+	return nil
+}
 
 // SyncBrandmeisterData imports Brandmeister repeaters into the database (optimized)
-func (d *Database) SyncBrandmeisterData(repeaters []api.BrandmeisterRepeater) error {
+func (d *Database) SyncBrandmeisterData(repeaters []api.BrandmeisterRepeater) (err error) {
+	defer countSyncFailure("brandmeister", &err)
+
+	if err := d.checkNotEmpty("brandmeister", len(repeaters)); err != nil {
+		return err
+	}
+
 	sourceID, err := d.GetSourceID("brandmeister")
 	if err != nil {
 		return fmt.Errorf("failed to get Brandmeister source ID: %v", err)
 	}
 
 	// Begin transaction for better performance
-	tx, err := d.db.Begin()
+	tx, err := d.beginWrite()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %v", err)
 	}
 	defer tx.Rollback()
 
+	runID, err := startSyncRun(tx, "brandmeister")
+	if err != nil {
+		return err
+	}
+	detachable, err := sourceLocations(tx, sourceID)
+	if err != nil {
+		return err
+	}
+
 	// Create a map to cache location IDs and avoid duplicate lookups
 	locationCache := make(map[string]int)
 
@@ -38,21 +166,34 @@ func (d *Database) SyncBrandmeisterData(repeaters []api.BrandmeisterRepeater) er
 	}
 	defer locationStmt.Close()
 
-	locationLookupStmt, err := tx.Prepare(`
-        SELECT id FROM locations WHERE city = ? AND state = ? AND country = ? AND latitude = ? AND longitude = ?
-    `)
+	locationLookupStmt, err := tx.Prepare(locationLookupSQL)
 	if err != nil {
 		return fmt.Errorf("failed to prepare location lookup statement: %v", err)
 	}
 	defer locationLookupStmt.Close()
 
 	repeaterStmt, err := tx.Prepare(`
-        INSERT OR REPLACE INTO repeaters (
-            callsign, source_id, external_id, location_id,
+        INSERT INTO repeaters (
+            callsign, source_id, external_id, location_id, city_inferred,
             tx_frequency, rx_frequency, mode, color_code,
             operational, online_status, power_watts, antenna_height_agl,
-            hardware, website, description, last_api_sync
-        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+            hardware, website, description, description_html, last_master, device_type, last_api_sync,
+            added_run_id, changed_run_id
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT(source_id, external_id) DO UPDATE SET
+            ` + trackChanges("callsign", "location_id", "tx_frequency", "rx_frequency", "mode", "color_code",
+		"operational", "power_watts", "antenna_height_agl", "hardware", "website", "description", "last_master",
+		"device_type") + `,
+            callsign = excluded.callsign, location_id = excluded.location_id, city_inferred = excluded.city_inferred,
+            tx_frequency = excluded.tx_frequency, rx_frequency = excluded.rx_frequency,
+            mode = excluded.mode, color_code = excluded.color_code,
+            operational = excluded.operational, online_status = excluded.online_status,
+            power_watts = excluded.power_watts, antenna_height_agl = excluded.antenna_height_agl,
+            hardware = excluded.hardware, website = excluded.website,
+            description = excluded.description, description_html = excluded.description_html,
+            last_master = excluded.last_master, device_type = excluded.device_type,
+            last_api_sync = excluded.last_api_sync,
+            updated_at = CURRENT_TIMESTAMP
     `)
 	if err != nil {
 		return fmt.Errorf("failed to prepare repeater statement: %v", err)
@@ -61,85 +202,74 @@ func (d *Database) SyncBrandmeisterData(repeaters []api.BrandmeisterRepeater) er
 
 	fmt.Printf("Syncing %d Brandmeister repeaters to database...\n", len(repeaters))
 
-	// Process in smaller batches to show progress and avoid locks
-	batchSize := 100
+	// Process in batches to show progress, see SetSyncOptions
+	batchSize := d.syncBatchSize()
+	throttle := d.syncThrottle()
 	totalProcessed := 0
 
 	for i := 0; i < len(repeaters); i += batchSize {
-		end := i + batchSize
-		if end > len(repeaters) {
-			end = len(repeaters)
+		if err := d.syncInterrupted("brandmeister"); err != nil {
+			return err
 		}
 
-		batch := repeaters[i:end]
-		fmt.Printf("  Processing batch %d-%d of %d repeaters...\n", i+1, end, len(repeaters))
-
-		for _, rep := range batch {
-			// Create location cache key
-			locationKey := fmt.Sprintf("%s|%s|%s|%.6f|%.6f", rep.City, "", rep.Country, rep.Latitude, rep.Longitude)
+		batch := repeaters[i:min(i+batchSize, len(repeaters))]
+		fmt.Printf("  Processing batch %d-%d of %d repeaters...\n", i+1, i+len(batch), len(repeaters))
 
+		for _, repeater := range batch {
+			throttle.wait()
+			row := prepareBrandmeisterRow(repeater)
+			rep := &row.rep
 			var locationID sql.NullInt64
 
 			// Check cache first
-			if cachedID, exists := locationCache[locationKey]; exists {
+			if cachedID, exists := locationCache[row.locationKey]; exists {
 				locationID.Int64 = int64(cachedID)
 				locationID.Valid = true
-			} else if rep.City != "" || rep.Country != "" || rep.Latitude != 0 || rep.Longitude != 0 {
+			} else if row.hasLocation {
 				// Insert location
-				_, err = locationStmt.Exec(rep.City, "", rep.Country, rep.Latitude, rep.Longitude)
+				_, err = locationStmt.Exec(rep.City, row.state, row.country, rep.Latitude, rep.Longitude)
 				if err != nil {
 					fmt.Printf("Warning: failed to insert location for %s: %v\n", rep.Callsign, err)
 				} else {
 					// Look up the location ID
 					var locID int
-					err = locationLookupStmt.QueryRow(rep.City, "", rep.Country, rep.Latitude, rep.Longitude).Scan(&locID)
+					err = locationLookupStmt.QueryRow(rep.City, row.state, row.country, rep.Latitude, rep.Longitude).Scan(&locID)
 					if err == nil {
 						locationID.Int64 = int64(locID)
 						locationID.Valid = true
-						locationCache[locationKey] = locID
+						locationCache[row.locationKey] = locID
 					}
 				}
 			}
 
-			// Parse frequencies
-			var txFreq, rxFreq sql.NullFloat64
-			if rep.TxFreq != "" {
-				if freq, err := strconv.ParseFloat(rep.TxFreq, 64); err == nil {
-					txFreq.Float64 = freq
-					txFreq.Valid = true
-				}
-			}
-			if rep.RxFreq != "" {
-				if freq, err := strconv.ParseFloat(rep.RxFreq, 64); err == nil {
-					rxFreq.Float64 = freq
-					rxFreq.Valid = true
-				}
-			}
-
-			// Determine if online (status > 0 in Brandmeister)
-			isOnline := rep.Status > 0
-
 			// Insert repeater
 			_, err = repeaterStmt.Exec(
 				rep.Callsign,
 				sourceID,
 				rep.ID,
 				locationID,
-				txFreq,
-				rxFreq,
+				row.cityInferred,
+				row.txFreq,
+				row.rxFreq,
 				"DMR", // Brandmeister is DMR
 				rep.ColorCode,
 				true, // Assume operational if in database
-				isOnline,
-				rep.PEP,
-				rep.AGL,
+				row.online,
+				row.power,
+				row.agl,
 				rep.Hardware,
 				rep.Website,
 				rep.Description,
+				row.descriptionHTML,
+				row.lastMaster,
+				row.deviceType,
 				time.Now(),
+				runID,
+				runID,
 			)
 			if err != nil {
 				fmt.Printf("Warning: failed to insert repeater %s: %v\n", rep.Callsign, err)
+				metrics.SyncErrors.Inc("brandmeister")
 				continue
 			}
 
@@ -152,6 +282,16 @@ func (d *Database) SyncBrandmeisterData(repeaters []api.BrandmeisterRepeater) er
 			float64(totalProcessed)/float64(len(repeaters))*100)
 	}
 
+	// Drop the locations repeaters moved off
+	if err := deleteDetachedLocations(tx, detachable); err != nil {
+		return err
+	}
+
+	inserted, updated, err := finishSyncRun(tx, runID)
+	if err != nil {
+		return err
+	}
+
 	// Update source sync time
 	_, err = tx.Exec(
 		"UPDATE repeater_sources SET last_sync = ?, total_records = ? WHERE source_name = ?",
@@ -166,16 +306,25 @@ func (d *Database) SyncBrandmeisterData(repeaters []api.BrandmeisterRepeater) er
 		return fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
+	metrics.RecordsSynced.Add(float64(totalProcessed), "brandmeister")
 	fmt.Printf("✓ Successfully synced %d Brandmeister repeaters to database\n", len(repeaters))
+	throttle.printRate()
+	fmt.Printf("  %d new, %d changed (sync run %d)\n", inserted, updated, runID)
 	return nil
 }
 
 // ...existing code...
 
 // SyncTGIFData imports TGIF talkgroups into the database
-func (d *Database) SyncTGIFData(talkgroups []api.TGIFTalkgroup) error {
+func (d *Database) SyncTGIFData(talkgroups []api.TGIFTalkgroup) (err error) {
+	defer countSyncFailure("tgif", &err)
+
+	if err := d.checkNotEmpty("tgif", len(talkgroups)); err != nil {
+		return err
+	}
+
 	// Begin transaction
-	tx, err := d.db.Begin()
+	tx, err := d.beginWrite()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %v", err)
 	}
@@ -183,9 +332,12 @@ func (d *Database) SyncTGIFData(talkgroups []api.TGIFTalkgroup) error {
 
 	// Prepare statement
 	stmt, err := tx.Prepare(`
-        INSERT OR REPLACE INTO talkgroups (
+        INSERT INTO talkgroups (
             talkgroup_id, name, description, network, active
         ) VALUES (?, ?, ?, ?, ?)
+        ON CONFLICT(talkgroup_id, network) DO UPDATE SET
+            name = excluded.name, description = excluded.description,
+            active = excluded.active, updated_at = CURRENT_TIMESTAMP
     `)
 	if err != nil {
 		return fmt.Errorf("failed to prepare talkgroup statement: %v", err)
@@ -194,19 +346,37 @@ func (d *Database) SyncTGIFData(talkgroups []api.TGIFTalkgroup) error {
 
 	fmt.Printf("Syncing %d TGIF talkgroups to database...\n", len(talkgroups))
 
+	synced := 0
+	throttle := d.syncThrottle()
 	for _, tg := range talkgroups {
+		throttle.wait()
+		if err := d.syncInterrupted("tgif"); err != nil {
+			return err
+		}
+
+		tg.Name, tg.Description = cleanText(tg.Name), cleanText(tg.Description)
+
 		// Parse talkgroup ID
 		tgID, err := strconv.Atoi(tg.ID)
 		if err != nil {
 			fmt.Printf("Warning: invalid talkgroup ID %s: %v\n", tg.ID, err)
+			metrics.SyncErrors.Inc("tgif")
 			continue
 		}
 
-		_, err = stmt.Exec(tgID, tg.Name, tg.Description, "tgif", true)
+		// Store NULL rather than guessing when TGIF doesn't report the status
+		var active sql.NullBool
+		if value, known := tg.IsActive(); known {
+			active = sql.NullBool{Bool: value, Valid: true}
+		}
+
+		_, err = stmt.Exec(tgID, tg.Name, tg.Description, "tgif", active)
 		if err != nil {
 			fmt.Printf("Warning: failed to insert talkgroup %s: %v\n", tg.ID, err)
+			metrics.SyncErrors.Inc("tgif")
 			continue
 		}
+		synced++
 	}
 
 	// Commit transaction
@@ -214,24 +384,44 @@ func (d *Database) SyncTGIFData(talkgroups []api.TGIFTalkgroup) error {
 		return fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
+	metrics.RecordsSynced.Add(float64(synced), "tgif")
 	fmt.Printf("✓ Successfully synced %d TGIF talkgroups to database\n", len(talkgroups))
+	throttle.printRate()
 	return nil
 }
 
 // SyncHearhamData imports hearham repeaters into the database
-func (d *Database) SyncHearhamData(repeaters []api.HearhamRepeater) error {
+func (d *Database) SyncHearhamData(repeaters []api.HearhamRepeater) (err error) {
+	defer countSyncFailure("hearham", &err)
+
+	if err := d.checkNotEmpty("hearham", len(repeaters)); err != nil {
+		return err
+	}
+
 	sourceID, err := d.GetSourceID("hearham")
 	if err != nil {
 		return fmt.Errorf("failed to get hearham source ID: %v", err)
 	}
 
 	// Begin transaction
-	tx, err := d.db.Begin()
+	tx, err := d.beginWrite()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %v", err)
 	}
 	defer tx.Rollback()
 
+	runID, err := startSyncRun(tx, "hearham")
+	if err != nil {
+		return err
+	}
+	detachable, err := sourceLocations(tx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	// Cache location IDs, some listings share a site
+	locationCache := make(map[string]int)
+
 	// Prepare statements
 	locationStmt, err := tx.Prepare(`
         INSERT OR IGNORE INTO locations (city, state, country, latitude, longitude)
@@ -242,11 +432,26 @@ func (d *Database) SyncHearhamData(repeaters []api.HearhamRepeater) error {
 	}
 	defer locationStmt.Close()
 
+	locationLookupStmt, err := tx.Prepare(locationLookupSQL)
+	if err != nil {
+		return fmt.Errorf("failed to prepare location lookup statement: %v", err)
+	}
+	defer locationLookupStmt.Close()
+
 	repeaterStmt, err := tx.Prepare(`
-        INSERT OR REPLACE INTO repeaters (
-            callsign, source_id, external_id, location_id,
-            tx_frequency, rx_frequency, mode, operational, last_api_sync
-        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+        INSERT INTO repeaters (
+            callsign, source_id, external_id, location_id, city_inferred,
+            tx_frequency, rx_frequency, offset_frequency, offset_inferred, mode, operational, last_api_sync,
+            added_run_id, changed_run_id
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT(source_id, external_id) DO UPDATE SET
+            ` + trackChanges("callsign", "location_id", "tx_frequency", "rx_frequency", "offset_frequency",
+		"mode", "operational") + `,
+            callsign = excluded.callsign, location_id = excluded.location_id, city_inferred = excluded.city_inferred,
+            tx_frequency = excluded.tx_frequency, rx_frequency = excluded.rx_frequency,
+            offset_frequency = excluded.offset_frequency, offset_inferred = excluded.offset_inferred,
+            mode = excluded.mode, operational = excluded.operational,
+            last_api_sync = excluded.last_api_sync, updated_at = CURRENT_TIMESTAMP
     `)
 	if err != nil {
 		return fmt.Errorf("failed to prepare repeater statement: %v", err)
@@ -255,33 +460,70 @@ func (d *Database) SyncHearhamData(repeaters []api.HearhamRepeater) error {
 
 	fmt.Printf("Syncing %d hearham repeaters to database...\n", len(repeaters))
 
+	synced := 0
+	throttle := d.syncThrottle()
 	for i, rep := range repeaters {
+		throttle.wait()
+		if err := d.syncInterrupted("hearham"); err != nil {
+			return err
+		}
 		if i%1000 == 0 {
 			fmt.Printf("  Processed %d/%d repeaters...\n", i, len(repeaters))
 		}
 
-		// Insert location
-		_, err = locationStmt.Exec(rep.City, "", "", 0, 0) // hearham doesn't have coordinates
-		if err != nil {
-			fmt.Printf("Warning: failed to insert location for %s: %v\n", rep.Callsign, err)
-			continue
+		rep.Callsign, rep.City, rep.Mode = cleanText(rep.Callsign), cleanText(rep.City), cleanText(rep.Mode)
+
+		// hearham has no state or country fields, work them out from the coordinates
+		state, country := inferRegion("", "", rep.Latitude, rep.Longitude)
+		city := rep.City
+		if state != "" {
+			city = strings.TrimSuffix(city, ", "+state) // Some cities are written "Bryn Mawr, Pennsylvania"
 		}
+		city, cityInferred := inferCity(city, state, country, rep.Latitude, rep.Longitude)
 
-		// Get location ID
+		// Insert location, listings without any place get none
 		var locationID sql.NullInt64
-		err = tx.QueryRow(`
-			SELECT id FROM locations WHERE city = ? AND state = ? AND country = ?
-		`, rep.City, "", "").Scan(&locationID)
+		key := locationKey(city, state, country, rep.Latitude, rep.Longitude)
+		if cachedID, exists := locationCache[key]; exists {
+			locationID.Int64 = int64(cachedID)
+			locationID.Valid = true
+		} else if !blankLocation(city, state, country, rep.Latitude, rep.Longitude) {
+			_, err = locationStmt.Exec(city, state, country, rep.Latitude, rep.Longitude)
+			if err != nil {
+				fmt.Printf("Warning: failed to insert location for %s: %v\n", rep.Callsign, err)
+				metrics.SyncErrors.Inc("hearham")
+				continue
+			}
 
-		if err != nil {
-			fmt.Printf("Warning: failed to get location ID for %s: %v\n", rep.Callsign, err)
+			var locID int
+			if err := locationLookupStmt.QueryRow(city, state, country, rep.Latitude, rep.Longitude).Scan(&locID); err != nil {
+				fmt.Printf("Warning: failed to get location ID for %s: %v\n", rep.Callsign, err)
+			} else {
+				locationID.Int64 = int64(locID)
+				locationID.Valid = true
+				locationCache[key] = locID
+			}
 		}
 
-		// Parse frequency
-		var txFreq sql.NullFloat64
+		// Parse frequency (hearham reports Hz, the database stores MHz like the other sources)
+		// Many listings have no offset at all, US ones get the band plan's split instead of reading as simplex
+		var txFreq, rxFreq, offsetFreq sql.NullFloat64
+		offsetInferred := false
 		if rep.Frequency != 0 {
-			txFreq.Float64 = float64(rep.Frequency)
+			txFreq.Float64 = rep.GetFrequencyMHz()
 			txFreq.Valid = true
+			rxFreq.Float64 = rep.GetInputFrequencyMHz()
+			rxFreq.Valid = true
+			if rep.Offset == 0 {
+				if rx, offset, ok := inferOffset(txFreq.Float64, country); ok {
+					rxFreq.Float64 = rx
+					offsetFreq.Float64 = offset
+					offsetInferred = true
+				}
+			} else {
+				offsetFreq.Float64 = math.Round(rep.GetOffsetMHz()*10000) / 10000 // Drop float noise
+			}
+			offsetFreq.Valid = true
 		}
 
 		// Insert repeater
@@ -290,16 +532,33 @@ func (d *Database) SyncHearhamData(repeaters []api.HearhamRepeater) error {
 			sourceID,
 			rep.Callsign, // Use callsign as external ID for hearham
 			locationID,
+			cityInferred,
 			txFreq,
-			nil, // No RX frequency from hearham
+			rxFreq, // Output frequency plus offset
+			offsetFreq,
+			offsetInferred,
 			rep.Mode,
 			true, // Assume operational
 			time.Now(),
+			runID,
+			runID,
 		)
 		if err != nil {
 			fmt.Printf("Warning: failed to insert repeater %s: %v\n", rep.Callsign, err)
+			metrics.SyncErrors.Inc("hearham")
 			continue
 		}
+		synced++
+	}
+
+	// Drop the locations repeaters moved off
+	if err := deleteDetachedLocations(tx, detachable); err != nil {
+		return err
+	}
+
+	inserted, updated, err := finishSyncRun(tx, runID)
+	if err != nil {
+		return err
 	}
 
 	// Update source sync time
@@ -316,33 +575,248 @@ func (d *Database) SyncHearhamData(repeaters []api.HearhamRepeater) error {
 		return fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
+	metrics.RecordsSynced.Add(float64(synced), "hearham")
 	fmt.Printf("✓ Successfully synced %d hearham repeaters to database\n", len(repeaters))
+	throttle.printRate()
+	fmt.Printf("  %d new, %d changed (sync run %d)\n", inserted, updated, runID)
+	return nil
+}
+
+// SyncRepeaterBookData imports RepeaterBook repeaters into the database
+// External IDs are "<State_ID>-<Rptr_ID>", which is unique across RepeaterBook's exports
+func (d *Database) SyncRepeaterBookData(repeaters []api.RepeaterBookRepeater) (err error) {
+	defer countSyncFailure("repeaterbook", &err)
+
+	if err := d.checkNotEmpty("repeaterbook", len(repeaters)); err != nil {
+		return err
+	}
+
+	sourceID, err := d.GetSourceID("repeaterbook")
+	if err != nil {
+		return fmt.Errorf("failed to get RepeaterBook source ID: %v", err)
+	}
+
+	// Begin transaction
+	tx, err := d.beginWrite()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	runID, err := startSyncRun(tx, "repeaterbook")
+	if err != nil {
+		return err
+	}
+	detachable, err := sourceLocations(tx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	// Cache location IDs, many repeaters share a city
+	locationCache := make(map[string]int)
+
+	// Prepare statements
+	locationStmt, err := tx.Prepare(`
+        INSERT OR IGNORE INTO locations (city, state, country, latitude, longitude)
+        VALUES (?, ?, ?, ?, ?)
+    `)
+	if err != nil {
+		return fmt.Errorf("failed to prepare location statement: %v", err)
+	}
+	defer locationStmt.Close()
+
+	locationLookupStmt, err := tx.Prepare(locationLookupSQL)
+	if err != nil {
+		return fmt.Errorf("failed to prepare location lookup statement: %v", err)
+	}
+	defer locationLookupStmt.Close()
+
+	repeaterStmt, err := tx.Prepare(`
+        INSERT INTO repeaters (
+            callsign, source_id, external_id, location_id,
+            tx_frequency, rx_frequency, offset_frequency, offset_inferred, tone_frequency,
+            mode, digital_modes, operational, description, last_api_sync,
+            added_run_id, changed_run_id
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT(source_id, external_id) DO UPDATE SET
+            ` + trackChanges("callsign", "location_id", "tx_frequency", "rx_frequency", "offset_frequency",
+		"tone_frequency", "mode", "digital_modes", "operational", "description") + `,
+            callsign = excluded.callsign, location_id = excluded.location_id,
+            tx_frequency = excluded.tx_frequency, rx_frequency = excluded.rx_frequency,
+            offset_frequency = excluded.offset_frequency, offset_inferred = excluded.offset_inferred,
+            tone_frequency = excluded.tone_frequency,
+            mode = excluded.mode, digital_modes = excluded.digital_modes,
+            operational = excluded.operational, description = excluded.description,
+            last_api_sync = excluded.last_api_sync, updated_at = CURRENT_TIMESTAMP
+    `)
+	if err != nil {
+		return fmt.Errorf("failed to prepare repeater statement: %v", err)
+	}
+	defer repeaterStmt.Close()
+
+	fmt.Printf("Syncing %d RepeaterBook repeaters to database...\n", len(repeaters))
+
+	synced := 0
+	throttle := d.syncThrottle()
+	for i, rep := range repeaters {
+		throttle.wait()
+		if err := d.syncInterrupted("repeaterbook"); err != nil {
+			return err
+		}
+		if i%1000 == 0 {
+			fmt.Printf("  Processed %d/%d repeaters...\n", i, len(repeaters))
+		}
+
+		rep.Callsign, rep.Nearest, rep.Notes = cleanText(rep.Callsign), cleanText(rep.Nearest), cleanText(rep.Notes)
+		rep.State, rep.Country = cleanText(rep.State), cleanText(rep.Country)
+
+		// Insert location
+		var locationID sql.NullInt64
+		lat, _ := rep.GetLatitude()
+		lng, _ := rep.GetLongitude()
+		key := locationKey(rep.Nearest, rep.State, rep.Country, lat, lng)
+		if cachedID, exists := locationCache[key]; exists {
+			locationID.Int64 = int64(cachedID)
+			locationID.Valid = true
+		} else if !blankLocation(rep.Nearest, rep.State, rep.Country, lat, lng) {
+			if _, err := locationStmt.Exec(rep.Nearest, rep.State, rep.Country, lat, lng); err != nil {
+				fmt.Printf("Warning: failed to insert location for %s: %v\n", rep.Callsign, err)
+			} else {
+				var locID int
+				if err := locationLookupStmt.QueryRow(rep.Nearest, rep.State, rep.Country, lat, lng).Scan(&locID); err == nil {
+					locationID.Int64 = int64(locID)
+					locationID.Valid = true
+					locationCache[key] = locID
+				}
+			}
+		}
+
+		// Frequencies are MHz strings, the offset is input minus output like the other sources
+		var txFreq, rxFreq, offsetFreq, toneFreq sql.NullFloat64
+		if freq, err := rep.GetFrequencyFloat(); err == nil {
+			txFreq.Float64 = freq
+			txFreq.Valid = true
+		}
+		if freq, err := rep.GetInputFrequencyFloat(); err == nil {
+			rxFreq.Float64 = freq
+			rxFreq.Valid = true
+		}
+		offsetInferred := false
+		if txFreq.Valid && rxFreq.Valid {
+			offsetFreq.Float64 = math.Round((rxFreq.Float64-txFreq.Float64)*10000) / 10000 // Drop float noise
+			offsetFreq.Valid = true
+		} else if txFreq.Valid {
+			// No input frequency listed, a US repeater gets the band plan's split
+			if rx, offset, ok := inferOffset(txFreq.Float64, rep.Country); ok {
+				rxFreq = sql.NullFloat64{Float64: rx, Valid: true}
+				offsetFreq = sql.NullFloat64{Float64: offset, Valid: true}
+				offsetInferred = true
+			}
+		}
+		if tone, err := rep.GetToneFrequency(); err == nil {
+			toneFreq.Float64 = tone
+			toneFreq.Valid = true
+		}
+
+		// Analog unless a digital mode is listed
+		mode := "FM"
+		var digitalModes sql.NullString
+		if modes := rep.GetDigitalModes(); len(modes) > 0 {
+			mode = modes[0]
+			if encoded, err := json.Marshal(modes); err == nil {
+				digitalModes.String = string(encoded)
+				digitalModes.Valid = true
+			}
+		}
+
+		var description sql.NullString
+		if rep.Notes != "" {
+			description.String = rep.Notes
+			description.Valid = true
+		}
+
+		// Insert repeater
+		_, err = repeaterStmt.Exec(
+			rep.Callsign,
+			sourceID,
+			rep.StateID+"-"+rep.Rptr_ID,
+			locationID,
+			txFreq,
+			rxFreq,
+			offsetFreq,
+			offsetInferred,
+			toneFreq,
+			mode,
+			digitalModes,
+			rep.IsOperational(),
+			description,
+			time.Now(),
+			runID,
+			runID,
+		)
+		if err != nil {
+			fmt.Printf("Warning: failed to insert repeater %s: %v\n", rep.Callsign, err)
+			metrics.SyncErrors.Inc("repeaterbook")
+			continue
+		}
+		synced++
+	}
+
+	// Drop the locations repeaters moved off
+	if err := deleteDetachedLocations(tx, detachable); err != nil {
+		return err
+	}
+
+	inserted, updated, err := finishSyncRun(tx, runID)
+	if err != nil {
+		return err
+	}
+
+	// Update source sync time
+	_, err = tx.Exec(
+		"UPDATE repeater_sources SET last_sync = ?, total_records = ? WHERE source_name = ?",
+		time.Now(), len(repeaters), "repeaterbook",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update source sync time: %v", err)
+	}
+
+	// Commit transaction
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	metrics.RecordsSynced.Add(float64(synced), "repeaterbook")
+	fmt.Printf("✓ Successfully synced %d RepeaterBook repeaters to database\n", synced)
+	throttle.printRate()
+	fmt.Printf("  %d new, %d changed (sync run %d)\n", inserted, updated, runID)
 	return nil
 }
 
 // ...existing code...
 
 // GetRepeatersByFrequency finds repeaters near a specific frequency
-func (d *Database) GetRepeatersByFrequency(frequency float64, rangeMHz float64, limit int) ([]RepeaterRecord, error) {
-	query := `
-        SELECT r.id, r.callsign, r.source_id, r.external_id, r.location_id,
-               r.tx_frequency, r.rx_frequency, r.offset_frequency, r.tone_frequency,
-               r.mode, r.color_code, r.digital_modes, r.operational, r.online_status,
-               r.last_seen, r.power_watts, r.antenna_height_agl, r.antenna_height_msl,
-               r.hardware, r.firmware, r.website, r.description,
-               r.created_at, r.updated_at, r.last_api_sync,
-               l.city, l.state, l.country, l.latitude, l.longitude
-        FROM repeaters r
-        LEFT JOIN locations l ON r.location_id = l.id
+func (d *Database) GetRepeatersByFrequency(frequency float64, rangeMHz float64, limit int, devices DeviceFilter) ([]RepeaterRecord, error) {
+	return d.GetRepeatersByFrequencyContext(context.Background(), frequency, rangeMHz, limit, devices)
+}
+
+// GetRepeatersByFrequencyContext is GetRepeatersByFrequency, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetRepeatersByFrequencyContext(ctx context.Context, frequency float64, rangeMHz float64, limit int, devices DeviceFilter) ([]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	query := repeaterSelectColumns + `
         WHERE r.tx_frequency BETWEEN ? AND ?
-        ORDER BY ABS(r.tx_frequency - ?) ASC
+          AND ` + deviceFilter + `
+        ORDER BY ABS(r.tx_frequency - ?) ASC, r.id
         LIMIT ?
     `
 
 	minFreq := frequency - rangeMHz
 	maxFreq := frequency + rangeMHz
 
-	rows, err := d.db.Query(query, minFreq, maxFreq, frequency, limit)
+	args := append([]interface{}{minFreq, maxFreq}, deviceFilterArgs(devices)...)
+	rows, err := d.queryRows(ctx, query, append(args, frequency, limit)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search by frequency: %v", err)
 	}
@@ -350,108 +824,48 @@ func (d *Database) GetRepeatersByFrequency(frequency float64, rangeMHz float64,
 
 	var repeaters []RepeaterRecord
 	for rows.Next() {
-		var r RepeaterRecord
-
-		// Use the same nullable scanning logic as SearchRepeaters
-		var locationID sql.NullInt64
-		var txFreq, rxFreq, offsetFreq, toneFreq sql.NullFloat64
-		var colorCode sql.NullInt64
-		var digitalModes, hardware, firmware, website, description sql.NullString
-		var lastSeen sql.NullTime
-		var powerWatts, antennaHeightAGL, antennaHeightMSL sql.NullInt64
-		var city, state, country sql.NullString
-		var lat, lng sql.NullFloat64
-
-		err := rows.Scan(
-			&r.ID, &r.Callsign, &r.SourceID, &r.ExternalID, &locationID,
-			&txFreq, &rxFreq, &offsetFreq, &toneFreq,
-			&r.Mode, &colorCode, &digitalModes, &r.Operational, &r.OnlineStatus,
-			&lastSeen, &powerWatts, &antennaHeightAGL, &antennaHeightMSL,
-			&hardware, &firmware, &website, &description,
-			&r.CreatedAt, &r.UpdatedAt, &r.LastAPISync,
-			&city, &state, &country, &lat, &lng,
-		)
+		r, err := scanRepeaterRow(rows)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan repeater: %v", err)
+			return nil, err
 		}
+		repeaters = append(repeaters, r)
+	}
+
+	return repeaters, rows.Err()
+}
 
-		// ...existing code...
+// GetRepeatersByMaster returns the Brandmeister repeaters last connected to a master server,
+// online ones first, so an outage at one master shows which repeaters it affects
+func (d *Database) GetRepeatersByMaster(masterID int) ([]RepeaterRecord, error) {
+	return d.GetRepeatersByMasterContext(context.Background(), masterID)
+}
 
-		// Convert nullable fields (complete all conversions from SearchRepeaters)
-		if locationID.Valid {
-			id := int(locationID.Int64)
-			r.LocationID = &id
-		}
-		if txFreq.Valid {
-			r.TxFrequency = &txFreq.Float64
-		}
-		if rxFreq.Valid {
-			r.RxFrequency = &rxFreq.Float64
-		}
-		if offsetFreq.Valid {
-			r.OffsetFrequency = &offsetFreq.Float64
-		}
-		if toneFreq.Valid {
-			r.ToneFrequency = &toneFreq.Float64
-		}
-		if colorCode.Valid {
-			cc := int(colorCode.Int64)
-			r.ColorCode = &cc
-		}
-		if digitalModes.Valid {
-			r.DigitalModes = &digitalModes.String
-		}
-		if lastSeen.Valid {
-			r.LastSeen = &lastSeen.Time
-		}
-		if powerWatts.Valid {
-			pw := int(powerWatts.Int64)
-			r.PowerWatts = &pw
-		}
-		if antennaHeightAGL.Valid {
-			agl := int(antennaHeightAGL.Int64)
-			r.AntennaHeightAGL = &agl
-		}
-		if antennaHeightMSL.Valid {
-			msl := int(antennaHeightMSL.Int64)
-			r.AntennaHeightMSL = &msl
-		}
-		if hardware.Valid {
-			r.Hardware = &hardware.String
-		}
-		if firmware.Valid {
-			r.Firmware = &firmware.String
-		}
-		if website.Valid {
-			r.Website = &website.String
-		}
-		if description.Valid {
-			r.Description = &description.String
-		}
-		if city.Valid {
-			r.City = &city.String
-		}
-		if state.Valid {
-			r.State = &state.String
-		}
-		if country.Valid {
-			r.Country = &country.String
-		}
-		if lat.Valid {
-			r.Latitude = &lat.Float64
-		}
-		if lng.Valid {
-			r.Longitude = &lng.Float64
-		}
+// GetRepeatersByMasterContext is GetRepeatersByMaster, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetRepeatersByMasterContext(ctx context.Context, masterID int) ([]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
 
-		repeaters = append(repeaters, r)
+	query := repeaterSelectColumns + `
+        WHERE r.last_master = ?
+        ORDER BY r.online_status DESC, r.callsign, r.id
+    `
 
-		// ...existing code...
+	rows, err := d.queryRows(ctx, query, masterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query repeaters for master %d: %v", masterID, err)
+	}
+	defer rows.Close()
 
+	var repeaters []RepeaterRecord
+	for rows.Next() {
+		r, err := scanRepeaterRow(rows)
+		if err != nil {
+			return nil, err
+		}
 		repeaters = append(repeaters, r)
 	}
 
-	return repeaters, nil
+	return repeaters, rows.Err()
 }
 
 // ...existing code...
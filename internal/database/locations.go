@@ -0,0 +1,79 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// A location row is one exact place, coordinates included, so repeaters in the same city keep their own position
+// and repeaters at the same spot share a row
+
+// locationLookupSQL finds the row for an exact place, the columns of the locations UNIQUE key
+const locationLookupSQL = `
+        SELECT id FROM locations
+        WHERE city = ? AND state = ? AND country = ? AND latitude = ? AND longitude = ?
+    `
+
+// locationKey identifies an exact place in a sync's location cache
+func locationKey(city, state, country string, lat, lng float64) string {
+	return fmt.Sprintf("%s|%s|%s|%.6f|%.6f", city, state, country, lat, lng)
+}
+
+// blankLocation reports whether a listing carries no place at all
+// Those get a NULL location_id rather than one empty row shared by every such repeater
+func blankLocation(city, state, country string, lat, lng float64) bool {
+	return city == "" && state == "" && country == "" && lat == 0 && lng == 0
+}
+
+// sourceLocations lists the locations a source's repeaters point at, read before a sync moves any of them
+func sourceLocations(tx *sql.Tx, sourceID int) ([]int, error) {
+	rows, err := tx.Query("SELECT DISTINCT location_id FROM repeaters WHERE source_id = ? AND location_id IS NOT NULL", sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list source locations: %v", err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan source location: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list source locations: %v", err)
+	}
+	return ids, nil
+}
+
+// deleteDetachedLocations removes those of ids, from sourceLocations, that no repeater points at any more:
+// the rows a sync's listings moved off. Other unused rows are left alone, UpsertLocation may have just
+// created one for a repeater its caller hasn't stored yet
+func deleteDetachedLocations(tx *sql.Tx, ids []int) error {
+	stmt, err := tx.Prepare("DELETE FROM locations WHERE id = ? AND NOT EXISTS (SELECT 1 FROM repeaters WHERE location_id = ?)")
+	if err != nil {
+		return fmt.Errorf("failed to prepare location cleanup: %v", err)
+	}
+	defer stmt.Close()
+
+	for _, id := range ids {
+		if _, err := stmt.Exec(id, id); err != nil {
+			return fmt.Errorf("failed to delete unused location %d: %v", id, err)
+		}
+	}
+	return nil
+}
+
+// deleteOrphanLocations removes every location no repeater points at, for the migration that rebuilds the
+// table. Syncs use deleteDetachedLocations, which can't take a row another caller is about to use
+func deleteOrphanLocations(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+        DELETE FROM locations
+        WHERE id NOT IN (SELECT location_id FROM repeaters WHERE location_id IS NOT NULL)
+    `)
+	if err != nil {
+		return fmt.Errorf("failed to delete unused locations: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// migrations upgrade databases created by older versions of the schema
+// They run in order inside one transaction each, and PRAGMA user_version records how many have been applied
+// Append new migrations to the end, never reorder or remove them
+var migrations = []func(tx *sql.Tx) error{
+	migrateStableRepeaterIDs,
+	migrateUnknownPowerAndHeight,
+	migrateBrandmeisterMaster,
+	migrateSyncRunTracking,
+	migrateDeviceType,
+	migrateOffsetInferred,
+	migrateDescriptionHTML,
+	migrateLocationKey,
+	migrateCityInferred,
+}
+
+// migrate applies any migrations the database hasn't had yet
+func (d *Database) migrate() error {
+	var version int
+	if err := d.db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("failed to read schema version: %v", err)
+	}
+
+	if version >= len(migrations) {
+		return nil
+	}
+
+	// Migrations that rebuild a table need foreign keys off, which SQLite can't change inside a transaction
+	// Each migration leaves its references valid itself
+	if _, err := d.db.Exec("PRAGMA foreign_keys = OFF"); err != nil {
+		return fmt.Errorf("failed to disable foreign keys: %v", err)
+	}
+	defer d.db.Exec("PRAGMA foreign_keys = ON")
+
+	for i := version; i < len(migrations); i++ {
+		tx, err := d.beginWrite()
+		if err != nil {
+			return fmt.Errorf("failed to begin migration %d: %v", i+1, err)
+		}
+
+		if err := migrations[i](tx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d failed: %v", i+1, err)
+		}
+
+		// PRAGMA doesn't take bound parameters
+		if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", i+1)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record schema version %d: %v", i+1, err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit migration %d: %v", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// migrateStableRepeaterIDs adds the unique keys the sync UPSERTs rely on
+// Databases whose tables predate the UNIQUE constraints may hold duplicates, the oldest row
+// of each is kept so existing IDs stay valid
+func migrateStableRepeaterIDs(tx *sql.Tx) error {
+	statements := []string{
+		// NULL keys never conflict, so those rows are left alone
+		`DELETE FROM repeaters
+         WHERE source_id IS NOT NULL AND external_id IS NOT NULL
+           AND id NOT IN (SELECT MIN(id) FROM repeaters GROUP BY source_id, external_id)`,
+		`DELETE FROM talkgroups
+         WHERE network IS NOT NULL
+           AND id NOT IN (SELECT MIN(id) FROM talkgroups GROUP BY talkgroup_id, network)`,
+		// Drop links left pointing at removed rows
+		`DELETE FROM repeater_talkgroups
+         WHERE repeater_id NOT IN (SELECT id FROM repeaters)
+            OR talkgroup_id NOT IN (SELECT id FROM talkgroups)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_repeaters_source_external ON repeaters(source_id, external_id)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_talkgroups_number_network ON talkgroups(talkgroup_id, network)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateUnknownPowerAndHeight clears the zero power and antenna heights older syncs stored
+// for "not reported", so they read as unknown rather than 0 watts at ground level
+func migrateUnknownPowerAndHeight(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+        UPDATE repeaters SET
+            power_watts = CASE WHEN power_watts <= 0 THEN NULL ELSE power_watts END,
+            antenna_height_agl = CASE WHEN antenna_height_agl <= 0 THEN NULL ELSE antenna_height_agl END
+        WHERE power_watts <= 0 OR antenna_height_agl <= 0
+    `)
+	return err
+}
+
+// migrateBrandmeisterMaster adds the last_master column, which schema.sql already has on new databases
+func migrateBrandmeisterMaster(tx *sql.Tx) error {
+	if err := addColumnIfMissing(tx, "repeaters", "last_master", "INTEGER"); err != nil {
+		return err
+	}
+	_, err := tx.Exec(`CREATE INDEX IF NOT EXISTS idx_repeaters_last_master ON repeaters(last_master)`)
+	return err
+}
+
+// migrateSyncRunTracking adds the columns that tie repeaters to the sync_runs that added and changed them
+func migrateSyncRunTracking(tx *sql.Tx) error {
+	for _, column := range []string{"added_run_id", "changed_run_id"} {
+		if err := addColumnIfMissing(tx, "repeaters", column, "INTEGER"); err != nil {
+			return err
+		}
+	}
+	statements := []string{
+		`CREATE INDEX IF NOT EXISTS idx_repeaters_added_run ON repeaters(added_run_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_repeaters_changed_run ON repeaters(changed_run_id)`,
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateDeviceType adds the device_type column, existing Brandmeister rows are classified by their next sync
+func migrateDeviceType(tx *sql.Tx) error {
+	return addColumnIfMissing(tx, "repeaters", "device_type", "TEXT")
+}
+
+// migrateOffsetInferred adds the offset_inferred column, existing rows count as reported until their next sync
+func migrateOffsetInferred(tx *sql.Tx) error {
+	return addColumnIfMissing(tx, "repeaters", "offset_inferred", "BOOLEAN DEFAULT false")
+}
+
+// migrateDescriptionHTML adds the description_html column, existing Brandmeister descriptions stay HTML until
+// their next sync stores the plain text and markup apart
+func migrateDescriptionHTML(tx *sql.Tx) error {
+	return addColumnIfMissing(tx, "repeaters", "description_html", "TEXT")
+}
+
+// migrateLocationKey rebuilds locations keyed on the exact place, coordinates included
+// The old key was city, state and country alone, so every repeater in a city shared the first one's coordinates
+// and Brandmeister repeaters elsewhere in an already-seen city got no location. Exact duplicates are merged into
+// their oldest row, and blank and unused rows are dropped. Repeaters pick up their own coordinates on their next sync
+func migrateLocationKey(tx *sql.Tx) error {
+	statements := []string{
+		`CREATE TABLE locations_new (
+            id INTEGER PRIMARY KEY AUTOINCREMENT,
+            city TEXT,
+            state TEXT,
+            country TEXT,
+            latitude REAL,
+            longitude REAL,
+            created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+            UNIQUE(city, state, country, latitude, longitude)
+        )`,
+		// The new key is looser than the old one, so every row copies across with its ID
+		`INSERT INTO locations_new (id, city, state, country, latitude, longitude, created_at)
+         SELECT id, city, state, country, latitude, longitude, created_at FROM locations`,
+		`DROP TABLE locations`,
+		`ALTER TABLE locations_new RENAME TO locations`,
+		`CREATE INDEX IF NOT EXISTS idx_locations_coords ON locations(latitude, longitude)`,
+		// NULL columns never conflict, IS treats them as equal. References to missing rows become NULL
+		`UPDATE repeaters SET location_id = (
+            SELECT MIN(keep.id) FROM locations dup
+            JOIN locations keep ON keep.city IS dup.city AND keep.state IS dup.state AND keep.country IS dup.country
+                AND keep.latitude IS dup.latitude AND keep.longitude IS dup.longitude
+            WHERE dup.id = repeaters.location_id)
+         WHERE location_id IS NOT NULL`,
+		// Blank rows leave their repeaters without a location
+		`UPDATE repeaters SET location_id = NULL
+         WHERE location_id IN (
+            SELECT id FROM locations
+            WHERE COALESCE(city, '') = '' AND COALESCE(state, '') = '' AND COALESCE(country, '') = ''
+              AND COALESCE(latitude, 0) = 0 AND COALESCE(longitude, 0) = 0)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return deleteOrphanLocations(tx)
+}
+
+// addColumnIfMissing adds a column to a table unless it is already there
+// schema.sql creates new databases with the current columns, so column migrations only apply to older ones
+func addColumnIfMissing(tx *sql.Tx, table, column, definition string) error {
+	var count int
+	if err := tx.QueryRow(`SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, table, column).Scan(&count); err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	_, err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	return err
+}
+
+// migrateCityInferred adds the city_inferred column, existing rows count as reported until their next sync
+func migrateCityInferred(tx *sql.Tx) error {
+	return addColumnIfMissing(tx, "repeaters", "city_inferred", "BOOLEAN DEFAULT false")
+}
@@ -0,0 +1,49 @@
+package database
+
+import "math"
+
+// standardOffsets are the common US repeater splits by output frequency, from the ARRL band plan
+// Offsets are input minus output in MHz like offset_frequency; ranges are inclusive
+var standardOffsets = []struct {
+	minOutput, maxOutput float64
+	offset               float64
+}{
+	{29.62, 29.68, -0.1},
+	{51.62, 51.98, -1.0},
+	{52.56, 52.98, -1.0}, // Above the 52.525 and 52.540 simplex channels
+	{53.52, 53.98, -1.0},
+	{145.10, 145.50, -0.6},
+	{146.61, 146.99, -0.6},
+	{147.00, 147.39, 0.6},
+	{223.85, 224.98, -1.6},
+	{442.00, 445.00, 5.0},
+	{447.00, 450.00, -5.0},
+	{927.00, 928.00, -25.0},
+	{1282.00, 1288.00, -12.0},
+}
+
+// StandardOffsetForFrequency returns the split a US repeater with output frequency mhz conventionally uses,
+// e.g. -0.6 for 146.94 and +0.6 for 147.03, or 0 when the band plan has no repeater outputs there
+// It is only a guess for sources that give an output frequency alone, other countries use other plans
+func StandardOffsetForFrequency(mhz float64) (offsetMHz float64) {
+	mhz = math.Round(mhz*10000) / 10000 // Drop float noise so band edges match
+	for _, s := range standardOffsets {
+		if mhz >= s.minOutput && mhz <= s.maxOutput {
+			return s.offset
+		}
+	}
+	return 0
+}
+
+// inferOffset fills in a missing split from the US band plan, returning the input frequency and split
+// ok is false when the repeater isn't in the US or isn't on a standard output frequency
+func inferOffset(txMHz float64, country string) (rxMHz, offsetMHz float64, ok bool) {
+	if country != "United States" {
+		return 0, 0, false
+	}
+	offsetMHz = StandardOffsetForFrequency(txMHz)
+	if offsetMHz == 0 {
+		return 0, 0, false
+	}
+	return math.Round((txMHz+offsetMHz)*10000) / 10000, offsetMHz, true
+}
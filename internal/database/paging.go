@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"fmt"
+)
+
+// SearchPage is one page of SearchRepeatersPage results
+type SearchPage struct {
+	Repeaters []RepeaterRecord `json:"repeaters"`
+	Total     int              `json:"total"` // Matches across every page
+	Offset    int              `json:"offset"`
+}
+
+// Remaining returns how many matches come after this page
+func (p SearchPage) Remaining() int {
+	return max(0, p.Total-p.Offset-len(p.Repeaters))
+}
+
+// SearchRepeatersPage is SearchRepeaters one page at a time, for scripts working through large result sets:
+// up to limit matches after skipping offset, along with the total. Results are in callsign then ID order,
+// so consecutive pages neither repeat nor skip a repeater while the data is unchanged
+func (d *Database) SearchRepeatersPage(query string, limit, offset int, onlineOnly bool, devices DeviceFilter) (SearchPage, error) {
+	return d.SearchRepeatersPageContext(context.Background(), query, limit, offset, onlineOnly, devices)
+}
+
+// SearchRepeatersPageContext is SearchRepeatersPage, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) SearchRepeatersPageContext(ctx context.Context, query string, limit, offset int, onlineOnly bool, devices DeviceFilter) (SearchPage, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	page := SearchPage{Offset: max(0, offset)}
+	args := d.repeaterSearchArgs(query, onlineOnly, devices)
+
+	// One read transaction, so a sync committing in between can't make the total disagree with the page
+	// It's begun by hand: the connection string makes Begin a BEGIN IMMEDIATE, which would wait for the
+	// write lock of a sync running in another process
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return page, fmt.Errorf("failed to search repeaters: %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "BEGIN DEFERRED"); err != nil {
+		return page, fmt.Errorf("failed to search repeaters: %v", err)
+	}
+	defer conn.ExecContext(context.Background(), "ROLLBACK")
+
+	err = conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM repeaters r
+        LEFT JOIN locations l ON r.location_id = l.id`+repeaterSearchCondition, args...).Scan(&page.Total)
+	if err != nil {
+		return page, fmt.Errorf("failed to count search results: %v", err)
+	}
+
+	if limit <= 0 {
+		limit = -1 // SQLite: no limit
+	}
+	rows, err := conn.QueryContext(ctx, repeaterSelectColumns+repeaterSearchCondition+`
+        ORDER BY r.callsign, r.id
+        LIMIT ? OFFSET ?
+    `, append(args, limit, page.Offset)...)
+	if err != nil {
+		return page, fmt.Errorf("failed to search repeaters: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		r, err := scanRepeaterRow(rows)
+		if err != nil {
+			return page, err
+		}
+		page.Repeaters = append(page.Repeaters, r)
+	}
+	return page, rows.Err()
+}
@@ -0,0 +1,34 @@
+package database
+
+import (
+	"regexp"
+	"time"
+)
+
+// operationalPeriodPattern matches an explicit date range such as "2025-06-28 to 2025-06-29" or
+// "2025-06-01 – 2025-09-30". Lone dates are left alone: descriptions are full of changelog entries
+// ("2024-02-06 new antenna") that say nothing about when the repeater is on the air
+var operationalPeriodPattern = regexp.MustCompile(
+	`(?i)\b(\d{4}-\d{2}-\d{2})\s*(?:to|through|thru|until|till|-|–|—|\.\.)\s*(\d{4}-\d{2}-\d{2})\b`)
+
+// OperationalPeriod returns the dates a seasonal or event repeater is on the air, from a date range in
+// its description (see operationalPeriodPattern). Both days are included, ok is false without a range
+func (r *RepeaterRecord) OperationalPeriod() (begin, end time.Time, ok bool) {
+	if r.Description == nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	match := operationalPeriodPattern.FindStringSubmatch(*r.Description)
+	if match == nil {
+		return time.Time{}, time.Time{}, false
+	}
+	begin, err := time.Parse(time.DateOnly, match[1])
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err = time.Parse(time.DateOnly, match[2])
+	if err != nil || end.Before(begin) {
+		return time.Time{}, time.Time{}, false
+	}
+	return begin, end, true
+}
@@ -0,0 +1,112 @@
+package database
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ProgrammingInfo is what a radio needs to work a repeater, from the radio's point of view
+type ProgrammingInfo struct {
+	Callsign    string   `json:"callsign"`
+	RxFrequency float64  `json:"rx_frequency_mhz"`     // Radio receive = repeater output
+	TxFrequency float64  `json:"tx_frequency_mhz"`     // Radio transmit = repeater input
+	Duplex      string   `json:"duplex"`               // "+", "-" or "" for simplex
+	Offset      float64  `json:"offset_mhz"`           // Size of the split, always positive
+	Inferred    bool     `json:"offset_inferred"`      // The split is the band plan's, the source didn't list one
+	Tone        *float64 `json:"tone_hz,omitempty"`    // CTCSS access tone
+	ColorCode   *int     `json:"color_code,omitempty"` // DMR color code
+	Mode        string   `json:"mode"`
+	Location    string   `json:"location"`
+}
+
+// GetProgrammingInfo derives the radio settings for the repeater
+// The split comes from offset_frequency when stored, otherwise from the input/output pair
+func (r *RepeaterRecord) GetProgrammingInfo() (ProgrammingInfo, error) {
+	if r.TxFrequency == nil || *r.TxFrequency <= 0 {
+		return ProgrammingInfo{}, fmt.Errorf("repeater %s has no output frequency", r.Callsign)
+	}
+
+	info := ProgrammingInfo{
+		Callsign:    r.Callsign,
+		RxFrequency: *r.TxFrequency,
+		TxFrequency: *r.TxFrequency,
+		Tone:        r.ToneFrequency,
+		Mode:        r.Mode,
+		Location:    r.shortLocation(),
+	}
+	if strings.Contains(strings.ToUpper(r.Mode), "DMR") {
+		info.ColorCode = r.ColorCode
+	}
+
+	var offset float64
+	switch {
+	case r.OffsetFrequency != nil:
+		offset = *r.OffsetFrequency
+		info.Inferred = r.OffsetInferred
+	case r.RxFrequency != nil && *r.RxFrequency > 0:
+		offset = *r.RxFrequency - *r.TxFrequency
+	}
+	offset = math.Round(offset*10000) / 10000 // Drop float noise below 100 Hz
+
+	info.TxFrequency = info.RxFrequency + offset
+	info.Offset = math.Abs(offset)
+	switch {
+	case offset > 0:
+		info.Duplex = "+"
+	case offset < 0:
+		info.Duplex = "-"
+	}
+
+	return info, nil
+}
+
+// ProgrammingString returns a compact line for entering the repeater by hand,
+// e.g. "W3ABC 146.940 -0.600 T103.5 FM Philadelphia, PA"
+func (r *RepeaterRecord) ProgrammingString() string {
+	info, err := r.GetProgrammingInfo()
+	if err != nil {
+		return fmt.Sprintf("%s (frequency unknown)", r.Callsign)
+	}
+
+	parts := []string{info.Callsign, formatMHz(info.RxFrequency)}
+	if info.Duplex != "" {
+		parts = append(parts, info.Duplex+formatMHz(info.Offset))
+	} else {
+		parts = append(parts, "simplex")
+	}
+	if info.Tone != nil && *info.Tone > 0 {
+		parts = append(parts, fmt.Sprintf("T%.1f", *info.Tone))
+	}
+	if info.ColorCode != nil {
+		parts = append(parts, fmt.Sprintf("CC%d", *info.ColorCode))
+	}
+	if info.Mode != "" {
+		parts = append(parts, info.Mode)
+	}
+	if info.Location != "" {
+		parts = append(parts, info.Location)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// shortLocation is "City, State" (or country when there's no state), as used on a programming line
+func (r *RepeaterRecord) shortLocation() string {
+	var parts []string
+	if r.City != nil && *r.City != "" {
+		parts = append(parts, *r.City)
+	}
+	if r.State != nil && *r.State != "" {
+		parts = append(parts, *r.State)
+	} else if r.Country != nil && *r.Country != "" {
+		parts = append(parts, *r.Country)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatMHz shows at least three decimals and a fourth only when it's needed (12.5 kHz steps)
+func formatMHz(mhz float64) string {
+	s := fmt.Sprintf("%.4f", mhz)
+	return strings.TrimSuffix(s, "0")
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SearchFilter narrows a result set already on screen, see RefineRepeaters. Zero values don't filter
+type SearchFilter struct {
+	Query         string       // Matched against callsign, city, state, country and description like SearchRepeaters
+	Band          string       // A band from Bands ("2m", "70cm") or a mode ("DMR"), matched without case
+	Box           *BoundingBox // Only repeaters with coordinates inside the box
+	OnlineOnly    bool
+	FavoritesOnly bool // Only repeaters the user marked as a favorite
+	MinPowerWatts int  // Only repeaters reporting at least this much power
+	// ExcludeUnknownPower leaves out repeaters with no power listed under MinPowerWatts, see DeviceFilter
+	ExcludeUnknownPower bool
+}
+
+// RefineRepeaters applies filter to the repeaters with the given IDs, typically the results of an earlier
+// search, so the GUI can drill down ("online only", then "2m only") without running that search again
+// Results keep the order of ids; IDs that no longer exist are dropped
+func (d *Database) RefineRepeaters(ids []int, filter SearchFilter) ([]RepeaterRecord, error) {
+	return d.RefineRepeatersContext(context.Background(), ids, filter)
+}
+
+// RefineRepeatersContext is RefineRepeaters, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) RefineRepeatersContext(ctx context.Context, ids []int, filter SearchFilter) ([]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	repeaters, err := d.GetRepeatersByIDsContext(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var favorites map[int]bool
+	if filter.FavoritesOnly {
+		if favorites, err = d.favoriteIDs(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	query := strings.ToLower(strings.TrimSpace(filter.Query))
+	power := DeviceFilter{MinPowerWatts: filter.MinPowerWatts, ExcludeUnknownPower: filter.ExcludeUnknownPower}
+	refined := repeaters[:0]
+	for _, r := range repeaters {
+		switch {
+		case query != "" && !r.matchesQuery(query):
+		case filter.Band != "" && !r.matchesBand(filter.Band):
+		case filter.Box != nil && !r.inBox(*filter.Box):
+		case filter.OnlineOnly && !r.OnlineStatus:
+		case filter.FavoritesOnly && !favorites[r.ID]:
+		case !power.meetsMinPower(r):
+		default:
+			refined = append(refined, r)
+		}
+	}
+	return refined, nil
+}
+
+// matchesQuery reports whether a lowercased query appears in the fields SearchRepeaters matches
+func (r *RepeaterRecord) matchesQuery(query string) bool {
+	fields := []string{r.Callsign}
+	for _, field := range []*string{r.City, r.State, r.Country, r.Description} {
+		if field != nil {
+			fields = append(fields, *field)
+		}
+	}
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// inBox reports whether the repeater has coordinates inside box, 0,0 counting as none
+func (r *RepeaterRecord) inBox(box BoundingBox) bool {
+	if r.Latitude == nil || r.Longitude == nil || (*r.Latitude == 0 && *r.Longitude == 0) {
+		return false
+	}
+	return *r.Latitude >= box.MinLat && *r.Latitude <= box.MaxLat &&
+		*r.Longitude >= box.MinLng && *r.Longitude <= box.MaxLng
+}
+
+// favoriteIDs returns the IDs of the repeaters marked as favorites
+func (d *Database) favoriteIDs(ctx context.Context) (map[int]bool, error) {
+	rows, err := d.queryRows(ctx, "SELECT repeater_id FROM repeater_user_data WHERE favorite")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load favorites: %v", err)
+	}
+	defer rows.Close()
+
+	favorites := make(map[int]bool)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to load favorites: %v", err)
+		}
+		favorites[id] = true
+	}
+	return favorites, rows.Err()
+}
@@ -7,7 +7,7 @@ CREATE TABLE IF NOT EXISTS locations (
     latitude REAL,
     longitude REAL,
     created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE(city, state, country)
+    UNIQUE(city, state, country, latitude, longitude)
 );
 
 CREATE TABLE IF NOT EXISTS frequency_bands (
@@ -38,11 +38,13 @@ CREATE TABLE IF NOT EXISTS repeaters (
     
     -- Location (foreign key to locations table)
     location_id INTEGER,
+    city_inferred BOOLEAN DEFAULT false, -- The location's city is the nearest one to the coordinates, the source had none
     
     -- Frequencies
     tx_frequency REAL,
     rx_frequency REAL,
     offset_frequency REAL,
+    offset_inferred BOOLEAN DEFAULT false, -- offset_frequency and rx_frequency follow the band plan, the source had no split
     tone_frequency REAL,
     
     -- Technical details
@@ -55,16 +57,21 @@ CREATE TABLE IF NOT EXISTS repeaters (
     online_status BOOLEAN DEFAULT false,
     last_seen DATETIME,
     
-    -- Power and antenna
+    -- Power and antenna (normalized on ingest: watts and meters)
     power_watts INTEGER,
-    antenna_height_agl INTEGER,
-    antenna_height_msl INTEGER,
+    antenna_height_agl INTEGER, -- meters
+    antenna_height_msl INTEGER, -- meters
     
     -- Technical specifications
     hardware TEXT,
     firmware TEXT,
     website TEXT,
-    description TEXT,
+    description TEXT, -- plain text
+    description_html TEXT, -- the description's original markup, NULL for sources that give plain text
+    last_master INTEGER, -- Brandmeister master server the repeater last connected to
+    device_type TEXT, -- 'repeater' or 'hotspot' for Brandmeister devices, NULL for sources that only list repeaters
+    added_run_id INTEGER,   -- sync_runs row that first inserted the repeater
+    changed_run_id INTEGER, -- sync_runs row that last inserted or changed it
     
     -- Timestamps
     created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
@@ -77,6 +84,37 @@ CREATE TABLE IF NOT EXISTS repeaters (
     UNIQUE(source_id, external_id) -- Prevent duplicates from same source
 );
 
+-- What the user has added to a repeater, one row per repeater they touched
+-- Kept out of the repeaters table so no sync can overwrite it
+CREATE TABLE IF NOT EXISTS repeater_user_data (
+    repeater_id INTEGER PRIMARY KEY,
+    favorite BOOLEAN NOT NULL DEFAULT false,
+    notes TEXT NOT NULL DEFAULT '',
+    custom_name TEXT NOT NULL DEFAULT '', -- Shown instead of the callsign's source name, e.g. "Club 2m"
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (repeater_id) REFERENCES repeaters(id)
+);
+
+-- Repeaters the user wants an alert for when they come on the air, see internal/watch
+CREATE TABLE IF NOT EXISTS watchlist (
+    repeater_id INTEGER PRIMARY KEY,
+    last_online BOOLEAN NOT NULL DEFAULT false, -- Online at the last check, an alert fires when it turns true
+    added_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (repeater_id) REFERENCES repeaters(id)
+);
+
+-- Sync history, one row per completed sync of a source
+CREATE TABLE IF NOT EXISTS sync_runs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    source TEXT NOT NULL,
+    started_at DATETIME NOT NULL,
+    finished_at DATETIME,
+    inserted INTEGER DEFAULT 0, -- Repeaters added by the run
+    updated INTEGER DEFAULT 0   -- Existing repeaters whose details changed
+);
+
 -- DMR Talkgroups
 CREATE TABLE IF NOT EXISTS talkgroups (
     id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -132,6 +170,22 @@ CREATE TABLE IF NOT EXISTS aprs_stations (
     updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
 );
 
+-- APRS position history (one row per distinct position report)
+CREATE TABLE IF NOT EXISTS aprs_positions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    
+    callsign TEXT NOT NULL,
+    latitude REAL,
+    longitude REAL,
+    position_time DATETIME, -- Time the position was reported by the station
+    comment TEXT,
+    symbol TEXT,
+    
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    
+    UNIQUE(callsign, position_time) -- Repeated lookups of the same report are ignored
+);
+
 -- Indexes for performance
 CREATE INDEX IF NOT EXISTS idx_repeaters_callsign ON repeaters(callsign);
 CREATE INDEX IF NOT EXISTS idx_repeaters_tx_frequency ON repeaters(tx_frequency);
@@ -140,7 +194,9 @@ CREATE INDEX IF NOT EXISTS idx_repeaters_mode ON repeaters(mode);
 CREATE INDEX IF NOT EXISTS idx_repeaters_source ON repeaters(source_id);
 CREATE INDEX IF NOT EXISTS idx_locations_coords ON locations(latitude, longitude);
 CREATE INDEX IF NOT EXISTS idx_talkgroups_number ON talkgroups(talkgroup_id);
+CREATE INDEX IF NOT EXISTS idx_repeater_talkgroups_talkgroup ON repeater_talkgroups(talkgroup_id);
 CREATE INDEX IF NOT EXISTS idx_aprs_callsign ON aprs_stations(callsign);
+CREATE INDEX IF NOT EXISTS idx_aprs_positions_callsign ON aprs_positions(callsign, position_time);
 
 -- Insert initial frequency bands
 INSERT OR IGNORE INTO frequency_bands (name, min_frequency, max_frequency, band_type) VALUES
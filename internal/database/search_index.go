@@ -0,0 +1,194 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Full-text search index
+//
+// repeaters_fts is an SQLite FTS5 table holding each repeater's searchable text, keyed by repeater id,
+// and triggers on the repeaters and locations tables keep it current. FTS5 is optional: go-sqlite3 only includes it
+// when built with the sqlite_fts5 tag (go build -tags sqlite_fts5, as build.sh does). Without it the
+// index is never created and SearchRepeatersRanked falls back to the LIKE search.
+//
+// A build without FTS5 can't write through the triggers, so it drops them when it opens the database.
+// The next FTS5 build sees they are missing and recreates and rebuilds the index. That is also why
+// the index is set up here on every open rather than by a numbered migration, which runs only once.
+
+// searchIndexTriggers are the triggers that keep repeaters_fts in step with repeaters
+var searchIndexTriggers = []string{
+	"repeaters_fts_insert", "repeaters_fts_update", "repeaters_fts_delete", "repeaters_fts_location",
+}
+
+// searchIndexText selects a repeater's indexed text, for the index build and the triggers
+const searchIndexText = `
+        SELECT r.id, r.callsign, COALESCE(l.city, ''), COALESCE(l.state, ''),
+               COALESCE(l.country, ''), COALESCE(r.description, '')
+        FROM repeaters r
+        LEFT JOIN locations l ON r.location_id = l.id`
+
+// detectFTS5 reports whether the SQLite library was built with FTS5
+func (d *Database) detectFTS5() bool {
+	if _, err := d.db.Exec("CREATE VIRTUAL TABLE temp.fts5_probe USING fts5(x)"); err != nil {
+		return false
+	}
+	d.db.Exec("DROP TABLE temp.fts5_probe")
+	return true
+}
+
+// HasSearchIndex reports whether the full-text index is available
+func (d *Database) HasSearchIndex() bool {
+	return d.hasFTS
+}
+
+// initSearchIndex creates the full-text index when FTS5 is available, or removes its triggers when not
+func (d *Database) initSearchIndex() error {
+	d.hasFTS = d.detectFTS5()
+	if !d.hasFTS {
+		for _, trigger := range searchIndexTriggers {
+			if _, err := d.db.Exec("DROP TRIGGER IF EXISTS " + trigger); err != nil {
+				return fmt.Errorf("failed to drop search index trigger: %v", err)
+			}
+		}
+		return nil
+	}
+
+	placeholders := make([]string, len(searchIndexTriggers))
+	args := make([]interface{}, len(searchIndexTriggers))
+	for i, trigger := range searchIndexTriggers {
+		placeholders[i] = "?"
+		args[i] = trigger
+	}
+
+	var triggers int
+	err := d.db.QueryRow(
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'trigger' AND name IN ("+strings.Join(placeholders, ",")+")",
+		args...,
+	).Scan(&triggers)
+	if err != nil {
+		return fmt.Errorf("failed to check search index triggers: %v", err)
+	}
+	if triggers == len(searchIndexTriggers) {
+		return nil // Index is in place and has been kept current
+	}
+
+	statements := []string{
+		`CREATE VIRTUAL TABLE IF NOT EXISTS repeaters_fts USING fts5(
+            callsign, city, state, country, description,
+            tokenize = 'unicode61 remove_diacritics 2'
+        )`,
+		`CREATE TRIGGER IF NOT EXISTS repeaters_fts_insert AFTER INSERT ON repeaters BEGIN
+            INSERT INTO repeaters_fts (rowid, callsign, city, state, country, description)
+            ` + searchIndexText + ` WHERE r.id = new.id;
+        END`,
+		`CREATE TRIGGER IF NOT EXISTS repeaters_fts_update AFTER UPDATE ON repeaters BEGIN
+            DELETE FROM repeaters_fts WHERE rowid = old.id;
+            INSERT INTO repeaters_fts (rowid, callsign, city, state, country, description)
+            ` + searchIndexText + ` WHERE r.id = new.id;
+        END`,
+		`CREATE TRIGGER IF NOT EXISTS repeaters_fts_delete AFTER DELETE ON repeaters BEGIN
+            DELETE FROM repeaters_fts WHERE rowid = old.id;
+        END`,
+		// Corrected city or state names reach every repeater at that location
+		`CREATE TRIGGER IF NOT EXISTS repeaters_fts_location AFTER UPDATE ON locations BEGIN
+            DELETE FROM repeaters_fts WHERE rowid IN (SELECT id FROM repeaters WHERE location_id = new.id);
+            INSERT INTO repeaters_fts (rowid, callsign, city, state, country, description)
+            ` + searchIndexText + ` WHERE r.location_id = new.id;
+        END`,
+	}
+	for _, statement := range statements {
+		if _, err := d.db.Exec(statement); err != nil {
+			return fmt.Errorf("failed to create search index: %v", err)
+		}
+	}
+
+	// The triggers were missing, so anything written since isn't indexed
+	return d.RebuildSearchIndex()
+}
+
+// RebuildSearchIndex repopulates the full-text index from the repeaters table
+// It does nothing when SQLite was built without FTS5
+func (d *Database) RebuildSearchIndex() error {
+	if !d.hasFTS {
+		return nil
+	}
+
+	tx, err := d.beginWrite()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM repeaters_fts"); err != nil {
+		return fmt.Errorf("failed to clear search index: %v", err)
+	}
+	if _, err := tx.Exec(`INSERT INTO repeaters_fts (rowid, callsign, city, state, country, description)` + searchIndexText); err != nil {
+		return fmt.Errorf("failed to build search index: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit search index: %v", err)
+	}
+	return nil
+}
+
+// SearchRepeatersRanked searches the full-text index, best matches first
+// Each word matches as a prefix ("phil" finds Philadelphia) and callsign matches rank highest
+// Without FTS5 it falls back to SearchRepeaters
+func (d *Database) SearchRepeatersRanked(query string, limit int, devices DeviceFilter) ([]RepeaterRecord, error) {
+	return d.SearchRepeatersRankedContext(context.Background(), query, limit, devices)
+}
+
+// SearchRepeatersRankedContext is SearchRepeatersRanked, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) SearchRepeatersRankedContext(ctx context.Context, query string, limit int, devices DeviceFilter) ([]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	if !d.hasFTS {
+		return d.SearchRepeatersContext(ctx, query, limit, false, devices)
+	}
+
+	match := ftsMatchQuery(query)
+	if match == "" {
+		return nil, nil
+	}
+
+	// bm25 weights follow the column order: callsign, city, state, country, description
+	rows, err := d.queryRows(ctx, repeaterSelectColumns+`
+        JOIN (
+            SELECT rowid, bm25(repeaters_fts, 10.0, 5.0, 2.0, 1.0, 1.0) AS rank
+            FROM repeaters_fts WHERE repeaters_fts MATCH ?
+        ) fts ON fts.rowid = r.id
+        WHERE `+deviceFilter+`
+        ORDER BY fts.rank, r.callsign, r.id
+        LIMIT ?
+    `, append(append([]interface{}{match}, deviceFilterArgs(devices)...), limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search repeaters: %v", err)
+	}
+	defer rows.Close()
+
+	var repeaters []RepeaterRecord
+	for rows.Next() {
+		r, err := scanRepeaterRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		repeaters = append(repeaters, r)
+	}
+
+	return repeaters, rows.Err()
+}
+
+// ftsMatchQuery turns free text into an FTS5 query of quoted prefix terms, so user input
+// can't be read as FTS5 syntax
+func ftsMatchQuery(query string) string {
+	var terms []string
+	for _, word := range strings.Fields(query) {
+		word = strings.ReplaceAll(word, `"`, `""`)
+		terms = append(terms, `"`+word+`"*`)
+	}
+	return strings.Join(terms, " ")
+}
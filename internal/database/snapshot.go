@@ -0,0 +1,262 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// SnapshotVersion is the format of ExportSnapshot documents, bump it when the layout changes incompatibly
+const SnapshotVersion = 1
+
+// snapshotTables are exported in this order, which is also a safe insert order for the foreign keys
+// Sync run history isn't part of a snapshot, so the run columns of repeaters are left out
+// Snapshots are for sharing datasets, so personal tables aren't in them either
+var snapshotTables = []struct {
+	name    string
+	exclude []string
+}{
+	{name: "repeater_sources"},
+	{name: "locations"},
+	{name: "repeaters", exclude: []string{"added_run_id", "changed_run_id"}},
+	{name: "talkgroups"},
+	{name: "repeater_talkgroups"},
+}
+
+// personalTables hold one user's own data, older snapshots may carry them but import skips them
+// so the local rows are left alone
+var personalTables = []string{"repeater_user_data", "watchlist"}
+
+// Snapshot is a whole database as one portable JSON document
+type Snapshot struct {
+	SnapshotVersion int             `json:"snapshot_version"`
+	SchemaVersion   int             `json:"schema_version"` // Migrations applied to the exporting database
+	CreatedAt       time.Time       `json:"created_at"`
+	Tables          []SnapshotTable `json:"tables"`
+}
+
+// SnapshotTable holds a table's rows as column-ordered values, IDs included so references survive
+type SnapshotTable struct {
+	Name    string          `json:"name"`
+	Columns []string        `json:"columns"`
+	Rows    [][]interface{} `json:"rows"`
+}
+
+// ExportSnapshot writes every repeater, location, talkgroup and source to w as a versioned JSON snapshot
+func (d *Database) ExportSnapshot(w io.Writer) error {
+	return d.ExportSnapshotContext(context.Background(), w)
+}
+
+// ExportSnapshotContext is ExportSnapshot, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) ExportSnapshotContext(ctx context.Context, w io.Writer) error {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	snapshot := Snapshot{
+		SnapshotVersion: SnapshotVersion,
+		SchemaVersion:   len(migrations),
+		CreatedAt:       time.Now().UTC(),
+	}
+
+	for _, table := range snapshotTables {
+		exported, err := d.exportTable(ctx, table.name, table.exclude)
+		if err != nil {
+			return err
+		}
+		snapshot.Tables = append(snapshot.Tables, *exported)
+	}
+
+	if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+		return fmt.Errorf("failed to write snapshot: %v", err)
+	}
+	return nil
+}
+
+func (d *Database) exportTable(ctx context.Context, name string, exclude []string) (*SnapshotTable, error) {
+	columns, err := d.tableColumns(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	kept := columns[:0]
+	for _, column := range columns {
+		if !containsString(exclude, column) {
+			kept = append(kept, column)
+		}
+	}
+
+	// Unary + hides the declared types, so timestamps come back as the stored text rather than
+	// time.Time, which would export in a different format than SQLite writes and compares
+	selects := make([]string, len(kept))
+	for i, column := range kept {
+		selects[i] = "+" + column
+	}
+	rows, err := d.queryRows(ctx, fmt.Sprintf("SELECT %s FROM %s ORDER BY rowid", strings.Join(selects, ", "), name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", name, err)
+	}
+	defer rows.Close()
+
+	table := &SnapshotTable{Name: name, Columns: kept, Rows: [][]interface{}{}}
+	for rows.Next() {
+		values := make([]interface{}, len(kept))
+		pointers := make([]interface{}, len(kept))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, fmt.Errorf("failed to read %s row: %v", name, err)
+		}
+		for i, value := range values {
+			// Text comes back as bytes from some columns, which would export as base64
+			if b, ok := value.([]byte); ok {
+				values[i] = string(b)
+			}
+		}
+		table.Rows = append(table.Rows, values)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", name, err)
+	}
+	return table, nil
+}
+
+// ImportSnapshot loads a snapshot written by ExportSnapshot into a fresh database, keeping its IDs
+// It refuses snapshots from newer versions and databases that already have repeaters in them
+func (d *Database) ImportSnapshot(r io.Reader) error {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var snapshot Snapshot
+	if err := decoder.Decode(&snapshot); err != nil {
+		return fmt.Errorf("failed to read snapshot: %v", err)
+	}
+	if snapshot.SnapshotVersion == 0 || snapshot.SnapshotVersion > SnapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %d (this build reads up to %d)", snapshot.SnapshotVersion, SnapshotVersion)
+	}
+	if snapshot.SchemaVersion > len(migrations) {
+		return fmt.Errorf("snapshot is from a newer schema (version %d, this build has %d), update digiLogRT first",
+			snapshot.SchemaVersion, len(migrations))
+	}
+
+	// The checks are bounded like any lookup, the import itself isn't
+	ctx, cancel := d.queryContext(context.Background())
+	defer cancel()
+
+	var existing int
+	if err := d.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM repeaters").Scan(&existing); err != nil {
+		return fmt.Errorf("failed to check for existing repeaters: %v", err)
+	}
+	if existing > 0 {
+		return fmt.Errorf("database already has %d repeaters, import snapshots into a fresh database", existing)
+	}
+
+	// Check every column exists before writing anything, the connection is busy once the transaction starts
+	tables := snapshot.Tables[:0]
+	for _, table := range snapshot.Tables {
+		if containsString(personalTables, table.Name) {
+			continue
+		}
+		tables = append(tables, table)
+		if !isSnapshotTable(table.Name) {
+			return fmt.Errorf("snapshot has unknown table %q", table.Name)
+		}
+		columns, err := d.tableColumns(ctx, table.Name)
+		if err != nil {
+			return err
+		}
+		for _, column := range table.Columns {
+			if !containsString(columns, column) {
+				return fmt.Errorf("snapshot column %s.%s doesn't exist in this database", table.Name, column)
+			}
+		}
+	}
+
+	tx, err := d.beginWrite()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range tables {
+		// A fresh database comes with the built-in sources, the snapshot's rows replace them
+		stmt, err := tx.Prepare(fmt.Sprintf("INSERT OR REPLACE INTO %s (%s) VALUES (%s)",
+			table.Name, strings.Join(table.Columns, ", "), strings.TrimSuffix(strings.Repeat("?, ", len(table.Columns)), ", ")))
+		if err != nil {
+			return fmt.Errorf("failed to prepare %s insert: %v", table.Name, err)
+		}
+		for i, row := range table.Rows {
+			if len(row) != len(table.Columns) {
+				stmt.Close()
+				return fmt.Errorf("%s row %d has %d values for %d columns", table.Name, i+1, len(row), len(table.Columns))
+			}
+			for j, value := range row {
+				row[j] = snapshotValue(value)
+			}
+			if _, err := stmt.Exec(row...); err != nil {
+				stmt.Close()
+				return fmt.Errorf("failed to import %s row %d: %v", table.Name, i+1, err)
+			}
+		}
+		stmt.Close()
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit snapshot: %v", err)
+	}
+	return nil
+}
+
+// snapshotValue turns a decoded JSON number back into an integer or float for SQLite
+func snapshotValue(value interface{}) interface{} {
+	number, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+	if i, err := number.Int64(); err == nil {
+		return i
+	}
+	f, _ := number.Float64()
+	return f
+}
+
+// tableColumns lists a table's columns in schema order
+func (d *Database) tableColumns(ctx context.Context, table string) ([]string, error) {
+	rows, err := d.queryRows(ctx, fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read columns of %s: %v", table, err)
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, columnType string
+		var defaultValue interface{}
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultValue, &pk); err != nil {
+			return nil, fmt.Errorf("failed to read columns of %s: %v", table, err)
+		}
+		columns = append(columns, name)
+	}
+	return columns, rows.Err()
+}
+
+func isSnapshotTable(name string) bool {
+	for _, table := range snapshotTables {
+		if table.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SearchOptions filters SearchRepeatersStream, zero values don't filter
+type SearchOptions struct {
+	Query      string       // Matched against callsign, city, state, country and description like SearchRepeaters
+	Box        *BoundingBox // Only repeaters with coordinates inside the box
+	OnlineOnly bool
+	Limit      int // <= 0 means no limit
+	Devices    DeviceFilter
+}
+
+// streamChunkSize is how many rows SearchRepeatersStream reads before handing them to its callback
+const streamChunkSize = 500
+
+// SearchRepeatersStream runs a search and hands each repeater to fn in callsign order, so exports of very
+// large result sets don't hold them all in memory
+// Rows are read streamChunkSize at a time and the connection is released before fn sees them, so a slow fn
+// doesn't hold up the GUI or a sync, and fn may query d itself. Each chunk continues after the last callsign
+// and ID seen, so a sync committing in between can't repeat or skip a repeater, though later chunks show
+// its changes
+// An error from fn, or ctx being cancelled, stops the search and is returned
+func (d *Database) SearchRepeatersStream(ctx context.Context, opts SearchOptions, fn func(RepeaterRecord) error) error {
+	var conditions []string
+	var args []interface{}
+
+	if opts.Query != "" {
+		conditions = append(conditions, `(r.callsign LIKE ? OR l.city LIKE ? OR l.state LIKE ?
+            OR l.country LIKE ? OR r.description LIKE ?)`)
+		searchTerm := "%" + opts.Query + "%"
+		args = append(args, searchTerm, searchTerm, searchTerm, searchTerm, searchTerm)
+	}
+	if opts.Box != nil {
+		conditions = append(conditions, `l.latitude BETWEEN ? AND ? AND l.longitude BETWEEN ? AND ?
+            AND NOT (l.latitude = 0 AND l.longitude = 0)`)
+		args = append(args, opts.Box.MinLat, opts.Box.MaxLat, opts.Box.MinLng, opts.Box.MaxLng)
+	}
+	if opts.OnlineOnly {
+		conditions = append(conditions, "r.online_status = true")
+	}
+	conditions = append(conditions, deviceFilter)
+	args = append(args, deviceFilterArgs(opts.Devices)...)
+
+	var last *RepeaterRecord
+	for remaining := opts.Limit; opts.Limit <= 0 || remaining > 0; {
+		chunkConditions, chunkArgs := conditions, args
+		if last != nil {
+			chunkConditions = append(chunkConditions[:len(chunkConditions):len(chunkConditions)], "(r.callsign, r.id) > (?, ?)")
+			chunkArgs = append(chunkArgs[:len(chunkArgs):len(chunkArgs)], last.Callsign, last.ID)
+		}
+		size := streamChunkSize
+		if opts.Limit > 0 {
+			size = min(size, remaining)
+		}
+
+		chunk, err := d.searchChunk(ctx, chunkConditions, append(chunkArgs, size))
+		if err != nil {
+			return err
+		}
+		for _, r := range chunk {
+			if err := fn(r); err != nil {
+				return err
+			}
+		}
+		if len(chunk) < size {
+			break
+		}
+		last = &chunk[len(chunk)-1]
+		remaining -= len(chunk)
+	}
+	return ctx.Err()
+}
+
+// searchChunk reads one chunk of SearchRepeatersStream, the last arg is the chunk size
+func (d *Database) searchChunk(ctx context.Context, conditions []string, args []interface{}) ([]RepeaterRecord, error) {
+	query := repeaterSelectColumns + "\n        WHERE " + strings.Join(conditions, "\n          AND ") +
+		"\n        ORDER BY r.callsign, r.id\n        LIMIT ?"
+
+	rows, err := d.queryRows(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search repeaters: %v", err)
+	}
+	defer rows.Close()
+
+	var chunk []RepeaterRecord
+	for rows.Next() {
+		r, err := scanRepeaterRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		chunk = append(chunk, r)
+	}
+
+	// A cancelled context surfaces here as the reason the rows stopped
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search repeaters: %v", err)
+	}
+	return chunk, nil
+}
@@ -0,0 +1,153 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SyncRun is one completed sync of a source, with how many repeaters it added and changed
+type SyncRun struct {
+	ID         int        `json:"id"`
+	Source     string     `json:"source"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+	Inserted   int        `json:"inserted"`
+	Updated    int        `json:"updated"`
+}
+
+// RepeaterChange is a repeater a sync run added (Inserted) or changed the details of
+type RepeaterChange struct {
+	RepeaterRecord
+	Inserted bool `json:"inserted"`
+}
+
+// startSyncRun records the start of a sync inside its transaction, so a sync that fails leaves no run behind
+func startSyncRun(tx *sql.Tx, source string) (int64, error) {
+	result, err := tx.Exec("INSERT INTO sync_runs (source, started_at) VALUES (?, ?)", source, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to record sync run: %v", err)
+	}
+	return result.LastInsertId()
+}
+
+// finishSyncRun counts what the run added and changed and stores the totals with its finish time
+func finishSyncRun(tx *sql.Tx, runID int64) (inserted, updated int, err error) {
+	err = tx.QueryRow(`
+        SELECT
+            (SELECT COUNT(*) FROM repeaters WHERE added_run_id = ?),
+            (SELECT COUNT(*) FROM repeaters WHERE changed_run_id = ? AND added_run_id IS NOT ?)
+    `, runID, runID, runID).Scan(&inserted, &updated)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count sync changes: %v", err)
+	}
+
+	_, err = tx.Exec("UPDATE sync_runs SET finished_at = ?, inserted = ?, updated = ? WHERE id = ?",
+		time.Now(), inserted, updated, runID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to record sync run: %v", err)
+	}
+	return inserted, updated, nil
+}
+
+// trackChanges is the DO UPDATE SET assignment that stamps a repeater with the run that changed it
+// columns are the details the sync writes, a row is only stamped when one of them differs. Bookkeeping
+// like last_api_sync and online_status is left out so routine refreshes don't show up as changes
+func trackChanges(columns ...string) string {
+	differences := make([]string, len(columns))
+	for i, column := range columns {
+		differences[i] = fmt.Sprintf("repeaters.%s IS NOT excluded.%s", column, column)
+	}
+	return "changed_run_id = CASE WHEN " + strings.Join(differences, " OR ") +
+		" THEN excluded.changed_run_id ELSE repeaters.changed_run_id END"
+}
+
+// GetSyncRuns returns the most recent sync runs, newest first
+// An empty source returns runs of every source, limit <= 0 means no limit
+func (d *Database) GetSyncRuns(source string, limit int) ([]SyncRun, error) {
+	return d.GetSyncRunsContext(context.Background(), source, limit)
+}
+
+// GetSyncRunsContext is GetSyncRuns, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetSyncRunsContext(ctx context.Context, source string, limit int) ([]SyncRun, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	if limit <= 0 {
+		limit = -1 // SQLite: no limit
+	}
+
+	rows, err := d.queryRows(ctx, `
+        SELECT id, source, started_at, finished_at, inserted, updated
+        FROM sync_runs
+        WHERE ? = '' OR source = ?
+        ORDER BY started_at DESC, id DESC
+        LIMIT ?
+    `, source, source, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sync runs: %v", err)
+	}
+	defer rows.Close()
+
+	var runs []SyncRun
+	for rows.Next() {
+		var run SyncRun
+		var finishedAt sql.NullTime
+		if err := rows.Scan(&run.ID, &run.Source, &run.StartedAt, &finishedAt, &run.Inserted, &run.Updated); err != nil {
+			return nil, fmt.Errorf("failed to scan sync run: %v", err)
+		}
+		if finishedAt.Valid {
+			run.FinishedAt = &finishedAt.Time
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, rows.Err()
+}
+
+// GetChangesForRun returns the repeaters a sync run added, then those it changed, each by callsign
+// A repeater changed again by a later run is reported under the later run only
+func (d *Database) GetChangesForRun(runID int) ([]RepeaterChange, error) {
+	return d.GetChangesForRunContext(context.Background(), runID)
+}
+
+// GetChangesForRunContext is GetChangesForRun, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetChangesForRunContext(ctx context.Context, runID int) ([]RepeaterChange, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	var changes []RepeaterChange
+
+	for _, inserted := range []bool{true, false} {
+		condition := "r.added_run_id = ?"
+		if !inserted {
+			condition = "r.added_run_id IS NOT ?"
+		}
+
+		rows, err := d.queryRows(ctx, repeaterSelectColumns+`
+        WHERE r.changed_run_id = ? AND `+condition+`
+        ORDER BY r.callsign, r.id
+    `, runID, runID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query changes for sync run %d: %v", runID, err)
+		}
+
+		for rows.Next() {
+			r, err := scanRepeaterRow(rows)
+			if err != nil {
+				rows.Close()
+				return nil, err
+			}
+			changes = append(changes, RepeaterChange{RepeaterRecord: r, Inserted: inserted})
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to query changes for sync run %d: %v", runID, err)
+		}
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,130 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// anytoneNameLength is the longest contact name Anytone radios take
+const anytoneNameLength = 16
+
+// exportedTalkgroup is a talkgroups row as the exports write it
+type exportedTalkgroup struct {
+	talkgroupID int
+	name        string
+	description string // Plain text
+	network     string
+	active      bool
+}
+
+// talkgroupCSVColumns are the columns ExportTalkgroupsCSV can write, in their default order
+var talkgroupCSVColumns = []CSVColumn[exportedTalkgroup]{
+	{"ID", func(tg exportedTalkgroup, _ ExportOptions) string { return fmt.Sprint(tg.talkgroupID) }},
+	{"Name", func(tg exportedTalkgroup, _ ExportOptions) string { return tg.name }},
+	{"Description", func(tg exportedTalkgroup, _ ExportOptions) string { return tg.description }},
+	{"Network", func(tg exportedTalkgroup, _ ExportOptions) string { return tg.network }},
+}
+
+// TalkgroupCSVColumns lists the columns ExportTalkgroupsCSV can write, for ExportOptions.Columns
+func TalkgroupCSVColumns() []string {
+	return CSVHeaders(talkgroupCSVColumns)
+}
+
+// ExportTalkgroupsCSV writes every synced talkgroup as CSV for spreadsheets and codeplug tools: by default a
+// header row, then talkgroup number, name, description as plain text and network, in talkgroup number order
+// opts picks other columns or leaves out the header. A talkgroup listed by more than one network gets a row for each
+func (d *Database) ExportTalkgroupsCSV(w io.Writer, opts ExportOptions) error {
+	return d.ExportTalkgroupsCSVContext(context.Background(), w, opts)
+}
+
+// ExportTalkgroupsCSVContext is ExportTalkgroupsCSV, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) ExportTalkgroupsCSVContext(ctx context.Context, w io.Writer, opts ExportOptions) error {
+	talkgroups, err := d.exportedTalkgroups(ctx)
+	if err != nil {
+		return err
+	}
+	return WriteCSV(w, talkgroupCSVColumns, talkgroups, opts)
+}
+
+// ExportTalkgroupsAnytoneCSV writes the active talkgroups in the talkgroup list layout Anytone's CPS imports
+// (D878UV, D578UV and relatives): numbered group call contacts with every field quoted and CRLF line ends
+// A talkgroup number is listed once, under the first name a network gives it, names are cut to what the
+// radio shows and talkgroups without one are named "TG <number>"
+func (d *Database) ExportTalkgroupsAnytoneCSV(w io.Writer) error {
+	return d.ExportTalkgroupsAnytoneCSVContext(context.Background(), w)
+}
+
+// ExportTalkgroupsAnytoneCSVContext is ExportTalkgroupsAnytoneCSV, aborting when ctx is cancelled or the query
+// timeout passes
+func (d *Database) ExportTalkgroupsAnytoneCSVContext(ctx context.Context, w io.Writer) error {
+	talkgroups, err := d.exportedTalkgroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	lines := []string{anytoneLine("No.", "Radio ID", "Name", "Call Type", "Call Alert")}
+	seen := make(map[int]bool)
+	for _, tg := range talkgroups {
+		if !tg.active || seen[tg.talkgroupID] {
+			continue
+		}
+		seen[tg.talkgroupID] = true
+
+		name := tg.name
+		if name == "" {
+			name = fmt.Sprintf("TG %d", tg.talkgroupID)
+		}
+		if runes := []rune(name); len(runes) > anytoneNameLength {
+			name = strings.TrimSpace(string(runes[:anytoneNameLength]))
+		}
+		lines = append(lines, anytoneLine(fmt.Sprint(len(lines)), fmt.Sprint(tg.talkgroupID), name, "Group Call", "None"))
+	}
+
+	if _, err := io.WriteString(w, strings.Join(lines, "\r\n")+"\r\n"); err != nil {
+		return fmt.Errorf("failed to write CSV: %v", err)
+	}
+	return nil
+}
+
+// anytoneLine quotes every field the way Anytone's CPS writes its own exports
+func anytoneLine(fields ...string) string {
+	for i, field := range fields {
+		fields[i] = `"` + strings.ReplaceAll(field, `"`, `""`) + `"`
+	}
+	return strings.Join(fields, ",")
+}
+
+// exportedTalkgroups loads the talkgroups table in talkgroup number order, named rows first within a number
+func (d *Database) exportedTalkgroups(ctx context.Context) ([]exportedTalkgroup, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	rows, err := d.queryRows(ctx, `
+        SELECT talkgroup_id, name, description, network, active
+        FROM talkgroups
+        ORDER BY talkgroup_id, COALESCE(name, '') = '', network
+    `)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load talkgroups: %v", err)
+	}
+	defer rows.Close()
+
+	var talkgroups []exportedTalkgroup
+	for rows.Next() {
+		var tg exportedTalkgroup
+		var name, description, network sql.NullString
+		var active sql.NullBool
+		if err := rows.Scan(&tg.talkgroupID, &name, &description, &network, &active); err != nil {
+			return nil, fmt.Errorf("failed to scan talkgroup: %v", err)
+		}
+		tg.name = strings.TrimSpace(name.String)
+		tg.description = plainText(description.String)
+		tg.network = network.String
+		tg.active = !active.Valid || active.Bool // Unknown status: the network still lists it
+		talkgroups = append(talkgroups, tg)
+	}
+	return talkgroups, rows.Err()
+}
@@ -0,0 +1,239 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/metrics"
+)
+
+// RepeaterTalkgroup is a talkgroup as linked to one repeater timeslot
+type RepeaterTalkgroup struct {
+	TalkgroupRecord
+	Timeslot   int  `db:"timeslot"`
+	StaticLink bool `db:"static_link"`
+}
+
+// SyncBrandmeisterStaticTalkgroups stores static talkgroup links keyed by Brandmeister device ID
+// Each listed device has its links replaced, devices not in the map are left alone
+// Run it after SyncBrandmeisterData so the repeater rows exist
+func (d *Database) SyncBrandmeisterStaticTalkgroups(links map[int][]api.BrandmeisterStaticTalkgroup) error {
+	assignments := make(map[int][]api.BrandmeisterTGAssignment, len(links))
+	for deviceID, talkgroups := range links {
+		assignments[deviceID] = nil // Listed even without talkgroups, so its old links are cleared
+		for _, tg := range talkgroups {
+			assignments[deviceID] = append(assignments[deviceID],
+				api.BrandmeisterTGAssignment{Talkgroup: tg.TalkgroupID(), Timeslot: tg.Timeslot(), Static: true})
+		}
+	}
+	return d.SyncBrandmeisterTalkgroups(assignments)
+}
+
+// SyncBrandmeisterTalkgroups stores static and dynamic talkgroup links keyed by Brandmeister device ID,
+// the same way as SyncBrandmeisterStaticTalkgroups. A talkgroup both static and dynamic on a timeslot
+// is stored as static
+func (d *Database) SyncBrandmeisterTalkgroups(links map[int][]api.BrandmeisterTGAssignment) (err error) {
+	defer countSyncFailure("brandmeister", &err)
+
+	sourceID, err := d.GetSourceID("brandmeister")
+	if err != nil {
+		return fmt.Errorf("failed to get Brandmeister source ID: %v", err)
+	}
+
+	tx, err := d.beginWrite()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	repeaterLookupStmt, err := tx.Prepare(`
+        SELECT id FROM repeaters WHERE source_id = ? AND external_id = ?
+    `)
+	if err != nil {
+		return fmt.Errorf("failed to prepare repeater lookup statement: %v", err)
+	}
+	defer repeaterLookupStmt.Close()
+
+	// Brandmeister talkgroups aren't synced on their own, so add placeholder rows as needed
+	talkgroupStmt, err := tx.Prepare(`
+        INSERT OR IGNORE INTO talkgroups (talkgroup_id, network, active) VALUES (?, 'brandmeister', true)
+    `)
+	if err != nil {
+		return fmt.Errorf("failed to prepare talkgroup statement: %v", err)
+	}
+	defer talkgroupStmt.Close()
+
+	talkgroupLookupStmt, err := tx.Prepare(`
+        SELECT id FROM talkgroups WHERE talkgroup_id = ? AND network = 'brandmeister'
+    `)
+	if err != nil {
+		return fmt.Errorf("failed to prepare talkgroup lookup statement: %v", err)
+	}
+	defer talkgroupLookupStmt.Close()
+
+	deleteStmt, err := tx.Prepare("DELETE FROM repeater_talkgroups WHERE repeater_id = ?")
+	if err != nil {
+		return fmt.Errorf("failed to prepare delete statement: %v", err)
+	}
+	defer deleteStmt.Close()
+
+	linkStmt, err := tx.Prepare(`
+        INSERT INTO repeater_talkgroups (repeater_id, talkgroup_id, timeslot, static_link)
+        VALUES (?, ?, ?, ?)
+        ON CONFLICT(repeater_id, talkgroup_id, timeslot) DO UPDATE SET
+            static_link = static_link OR excluded.static_link
+    `)
+	if err != nil {
+		return fmt.Errorf("failed to prepare link statement: %v", err)
+	}
+	defer linkStmt.Close()
+
+	fmt.Printf("Syncing talkgroups for %d Brandmeister devices...\n", len(links))
+
+	// Cache talkgroup row IDs, the same few talkgroups appear on most repeaters
+	talkgroupIDs := make(map[int]int)
+	linked, static, devices := 0, 0, 0
+
+	for deviceID, talkgroups := range links {
+		var repeaterID int
+		err := repeaterLookupStmt.QueryRow(sourceID, strconv.Itoa(deviceID)).Scan(&repeaterID)
+		if err == sql.ErrNoRows {
+			continue // Device isn't in the repeater table (not synced yet)
+		} else if err != nil {
+			return fmt.Errorf("failed to look up repeater %d: %v", deviceID, err)
+		}
+
+		if _, err := deleteStmt.Exec(repeaterID); err != nil {
+			return fmt.Errorf("failed to clear talkgroups for repeater %d: %v", deviceID, err)
+		}
+		devices++
+
+		for _, tg := range talkgroups {
+			tgNumber := tg.Talkgroup
+			if tgNumber <= 0 {
+				continue
+			}
+
+			rowID, ok := talkgroupIDs[tgNumber]
+			if !ok {
+				if _, err := talkgroupStmt.Exec(tgNumber); err != nil {
+					fmt.Printf("Warning: failed to insert talkgroup %d: %v\n", tgNumber, err)
+					metrics.SyncErrors.Inc("brandmeister")
+					continue
+				}
+				if err := talkgroupLookupStmt.QueryRow(tgNumber).Scan(&rowID); err != nil {
+					return fmt.Errorf("failed to look up talkgroup %d: %v", tgNumber, err)
+				}
+				talkgroupIDs[tgNumber] = rowID
+			}
+
+			if _, err := linkStmt.Exec(repeaterID, rowID, tg.Timeslot, tg.Static); err != nil {
+				fmt.Printf("Warning: failed to link TG %d to repeater %d: %v\n", tgNumber, deviceID, err)
+				metrics.SyncErrors.Inc("brandmeister")
+				continue
+			}
+			linked++
+			if tg.Static {
+				static++
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	fmt.Printf("✓ Linked %d talkgroups (%d static) across %d devices\n", linked, static, devices)
+	return nil
+}
+
+// GetRepeatersForTalkgroup returns the repeaters linked to a DMR talkgroup number on any network
+func (d *Database) GetRepeatersForTalkgroup(tgid int) ([]RepeaterRecord, error) {
+	return d.GetRepeatersForTalkgroupContext(context.Background(), tgid)
+}
+
+// GetRepeatersForTalkgroupContext is GetRepeatersForTalkgroup, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetRepeatersForTalkgroupContext(ctx context.Context, tgid int) ([]RepeaterRecord, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	query := repeaterSelectColumns + `
+        JOIN repeater_talkgroups rt ON rt.repeater_id = r.id
+        JOIN talkgroups t ON rt.talkgroup_id = t.id
+        WHERE t.talkgroup_id = ?
+        GROUP BY r.id
+        ORDER BY r.callsign, r.id
+    `
+
+	rows, err := d.queryRows(ctx, query, tgid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query repeaters for talkgroup %d: %v", tgid, err)
+	}
+	defer rows.Close()
+
+	var repeaters []RepeaterRecord
+	for rows.Next() {
+		r, err := scanRepeaterRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		repeaters = append(repeaters, r)
+	}
+
+	return repeaters, rows.Err()
+}
+
+// GetTalkgroupsForRepeater returns the talkgroups linked to a repeater (by database ID), ordered by timeslot
+func (d *Database) GetTalkgroupsForRepeater(repeaterID int) ([]RepeaterTalkgroup, error) {
+	return d.GetTalkgroupsForRepeaterContext(context.Background(), repeaterID)
+}
+
+// GetTalkgroupsForRepeaterContext is GetTalkgroupsForRepeater, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetTalkgroupsForRepeaterContext(ctx context.Context, repeaterID int) ([]RepeaterTalkgroup, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	query := `
+        SELECT t.id, t.talkgroup_id, t.name, t.description, t.network, t.active,
+               t.created_at, t.updated_at, rt.timeslot, rt.static_link
+        FROM repeater_talkgroups rt
+        JOIN talkgroups t ON rt.talkgroup_id = t.id
+        WHERE rt.repeater_id = ?
+        ORDER BY rt.timeslot, t.talkgroup_id
+    `
+
+	rows, err := d.queryRows(ctx, query, repeaterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query talkgroups for repeater %d: %v", repeaterID, err)
+	}
+	defer rows.Close()
+
+	var talkgroups []RepeaterTalkgroup
+	for rows.Next() {
+		var tg RepeaterTalkgroup
+		var name, description, network sql.NullString
+		var timeslot sql.NullInt64
+		var active, staticLink sql.NullBool
+
+		err := rows.Scan(
+			&tg.ID, &tg.TalkgroupID, &name, &description, &network, &active,
+			&tg.CreatedAt, &tg.UpdatedAt, &timeslot, &staticLink,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan talkgroup: %v", err)
+		}
+
+		tg.Name = name.String
+		tg.Description = description.String
+		tg.Network = network.String
+		tg.Active = !active.Valid || active.Bool // Unknown status: the network still lists it
+		tg.Timeslot = int(timeslot.Int64)
+		tg.StaticLink = staticLink.Bool
+		talkgroups = append(talkgroups, tg)
+	}
+
+	return talkgroups, rows.Err()
+}
@@ -0,0 +1,83 @@
+package database
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// cleanText makes free text from an API safe to store, re-encode and display
+// Text that isn't UTF-8 at all is almost always Latin-1 from an older system ("Montr\xe9al"), so it is
+// decoded as that; stray bad bytes in otherwise UTF-8 text become U+FFFD. Control characters other than
+// newlines and tabs are dropped
+func cleanText(s string) string {
+	if utf8.ValidString(s) && strings.IndexFunc(s, unwantedControl) < 0 {
+		return s
+	}
+
+	if !utf8.ValidString(s) {
+		if isLatin1(s) {
+			runes := make([]rune, len(s))
+			for i := 0; i < len(s); i++ {
+				runes[i] = rune(s[i]) // Latin-1 bytes are the first 256 code points
+			}
+			s = string(runes)
+		} else {
+			s = strings.ToValidUTF8(s, "\uFFFD")
+		}
+	}
+
+	return strings.Map(func(r rune) rune {
+		if unwantedControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// isLatin1 reports whether invalid UTF-8 text has no valid multi-byte sequences, so reads as Latin-1
+func isLatin1(s string) bool {
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		if r != utf8.RuneError && size > 1 {
+			return false
+		}
+		s = s[size:]
+	}
+	return true
+}
+
+// unwantedControl matches control characters that only garble display, newlines and tabs are kept
+func unwantedControl(r rune) bool {
+	return unicode.IsControl(r) && r != '\n' && r != '\t'
+}
+
+var (
+	// htmlLineBreak matches the tags that end a line of text
+	htmlLineBreak = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|tr|h[1-6])\s*>`)
+	// htmlMarkup matches the remaining tags and comments, "<" followed by a digit or space isn't a tag
+	htmlMarkup = regexp.MustCompile(`(?s)<!--.*?-->|</?[a-zA-Z][^>]*>`)
+	// blankLines matches runs of empty lines left where markup was
+	blankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+// plainText turns an HTML description into plain text for terminals and search
+// Line-ending tags become newlines, other markup goes and entities are decoded. Text without markup is
+// returned unchanged
+func plainText(s string) string {
+	if !strings.ContainsAny(s, "<&") {
+		return s
+	}
+
+	s = htmlLineBreak.ReplaceAllString(s, "\n")
+	s = htmlMarkup.ReplaceAllString(s, "")
+	s = strings.ReplaceAll(html.UnescapeString(s), "\u00a0", " ")
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
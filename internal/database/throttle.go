@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// recordThrottle is a token bucket pacing a sync's record writes to SyncOptions.RateLimit
+// It holds a tenth of a second's worth of tokens, enough to ride out sleep overshoot without letting a
+// paused sync burst. A nil throttle never waits
+type recordThrottle struct {
+	ctx     context.Context
+	rate    float64 // Tokens added per second
+	burst   float64 // Most tokens the bucket holds
+	tokens  float64
+	last    time.Time // When tokens was last topped up
+	start   time.Time
+	records int
+}
+
+// syncThrottle returns a throttle for one sync run, or nil when SyncOptions.RateLimit is unlimited
+func (d *Database) syncThrottle() *recordThrottle {
+	if d.syncOptions.RateLimit <= 0 {
+		return nil
+	}
+	ctx := d.syncOptions.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	now := time.Now()
+	return &recordThrottle{
+		ctx:    ctx,
+		rate:   d.syncOptions.RateLimit,
+		burst:  max(1, d.syncOptions.RateLimit/10),
+		tokens: 1,
+		last:   now,
+		start:  now,
+	}
+}
+
+// wait blocks until the next record may be written, returning early when the sync's context is cancelled
+// so the caller's syncInterrupted check picks the cancellation up
+func (t *recordThrottle) wait() {
+	if t == nil {
+		return
+	}
+	t.records++
+
+	t.refill()
+	if t.tokens < 1 {
+		// Sleep off the deficit, whatever the sleep overshoots by is credited on the refill
+		timer := time.NewTimer(time.Duration((1 - t.tokens) / t.rate * float64(time.Second)))
+		select {
+		case <-timer.C:
+		case <-t.ctx.Done():
+			timer.Stop()
+		}
+		t.refill()
+	}
+	t.tokens--
+}
+
+// refill adds the tokens earned since the last refill
+func (t *recordThrottle) refill() {
+	now := time.Now()
+	t.tokens = min(t.burst, t.tokens+now.Sub(t.last).Seconds()*t.rate)
+	t.last = now
+}
+
+// printRate reports the limit and the rate the sync actually achieved
+func (t *recordThrottle) printRate() {
+	if t == nil {
+		return
+	}
+	achieved := float64(t.records) / time.Since(t.start).Seconds()
+	fmt.Printf("  Rate limited to %.0f records/second, achieved %.0f records/second\n", t.rate, achieved)
+}
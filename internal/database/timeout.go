@@ -0,0 +1,24 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultQueryTimeout bounds every search and lookup, so a pathological query (a huge bounding box with no
+// limit) fails instead of hanging the GUI
+const DefaultQueryTimeout = 30 * time.Second
+
+// SetQueryTimeout changes how long a search or lookup may run before it is cancelled, 0 means no limit
+// It applies to the *Context methods and their plain wrappers alike, on top of any deadline of their own
+func (d *Database) SetQueryTimeout(timeout time.Duration) {
+	d.queryTimeout.Store(int64(timeout))
+}
+
+// queryContext bounds ctx by the query timeout, the caller must call cancel when the query is done
+func (d *Database) queryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout := time.Duration(d.queryTimeout.Load()); timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
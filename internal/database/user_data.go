@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// RepeaterUserData is what the user has added to a repeater: a favorite flag, notes and a name of their own
+// It lives in the repeater_user_data table, which the Sync* methods never write, so it survives every re-sync
+type RepeaterUserData struct {
+	RepeaterID int
+	Favorite   bool
+	Notes      string
+	CustomName string
+	UpdatedAt  *time.Time // nil when the user hasn't touched the repeater
+}
+
+// GetRepeaterUserData returns the user's data for a repeater, empty when they haven't added any
+func (d *Database) GetRepeaterUserData(repeaterID int) (RepeaterUserData, error) {
+	return d.GetRepeaterUserDataContext(context.Background(), repeaterID)
+}
+
+// GetRepeaterUserDataContext is GetRepeaterUserData, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetRepeaterUserDataContext(ctx context.Context, repeaterID int) (RepeaterUserData, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	data := RepeaterUserData{RepeaterID: repeaterID}
+	var updatedAt time.Time
+	err := d.db.QueryRowContext(ctx, `
+        SELECT favorite, notes, custom_name, updated_at FROM repeater_user_data WHERE repeater_id = ?
+    `, repeaterID).Scan(&data.Favorite, &data.Notes, &data.CustomName, &updatedAt)
+	if err == sql.ErrNoRows {
+		return data, nil
+	}
+	if err != nil {
+		return data, fmt.Errorf("failed to get user data for repeater %d: %v", repeaterID, err)
+	}
+	data.UpdatedAt = &updatedAt
+	return data, nil
+}
+
+// SetRepeaterFavorite marks or unmarks a repeater as a favorite
+func (d *Database) SetRepeaterFavorite(repeaterID int, favorite bool) error {
+	return d.setUserField(repeaterID, "favorite", favorite)
+}
+
+// SetRepeaterNotes replaces the user's notes on a repeater, "" clears them
+func (d *Database) SetRepeaterNotes(repeaterID int, notes string) error {
+	return d.setUserField(repeaterID, "notes", notes)
+}
+
+// SetRepeaterCustomName gives a repeater a name of the user's choosing, "" clears it
+func (d *Database) SetRepeaterCustomName(repeaterID int, name string) error {
+	return d.setUserField(repeaterID, "custom_name", name)
+}
+
+// setUserField writes one repeater_user_data column, creating the repeater's row on first use
+// column is always one of the constants above, never user input
+func (d *Database) setUserField(repeaterID int, column string, value interface{}) error {
+	_, err := d.exec(fmt.Sprintf(`
+        INSERT INTO repeater_user_data (repeater_id, %[1]s) VALUES (?, ?)
+        ON CONFLICT(repeater_id) DO UPDATE SET %[1]s = excluded.%[1]s, updated_at = CURRENT_TIMESTAMP
+    `, column), repeaterID, value)
+	if err != nil {
+		return fmt.Errorf("failed to save %s for repeater %d: %v", column, repeaterID, err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WatchedRepeater is a repeater on the watchlist with the online state the last check saw
+type WatchedRepeater struct {
+	Repeater   RepeaterRecord
+	LastOnline bool // An alert fires when a check finds the repeater online and this false
+	AddedAt    time.Time
+}
+
+// AddToWatchlist puts a repeater on the watchlist, see internal/watch for the checker that alerts on it
+// The repeater starts in its current online state, so one that is already up doesn't alert at once
+// Adding a repeater that is already watched does nothing
+func (d *Database) AddToWatchlist(repeaterID int) error {
+	result, err := d.exec(`
+        INSERT INTO watchlist (repeater_id, last_online)
+        SELECT id, COALESCE(online_status, false) FROM repeaters WHERE id = ?
+        ON CONFLICT(repeater_id) DO NOTHING
+    `, repeaterID)
+	if err != nil {
+		return fmt.Errorf("failed to watch repeater %d: %v", repeaterID, err)
+	}
+
+	if added, err := result.RowsAffected(); err == nil && added == 0 {
+		if watched, err := d.IsWatched(repeaterID); err != nil || !watched {
+			return fmt.Errorf("repeater %d not found", repeaterID)
+		}
+	}
+	return nil
+}
+
+// RemoveFromWatchlist takes a repeater off the watchlist, removing one that isn't watched does nothing
+func (d *Database) RemoveFromWatchlist(repeaterID int) error {
+	if _, err := d.exec("DELETE FROM watchlist WHERE repeater_id = ?", repeaterID); err != nil {
+		return fmt.Errorf("failed to stop watching repeater %d: %v", repeaterID, err)
+	}
+	return nil
+}
+
+// IsWatched reports whether a repeater is on the watchlist
+func (d *Database) IsWatched(repeaterID int) (bool, error) {
+	return d.IsWatchedContext(context.Background(), repeaterID)
+}
+
+// IsWatchedContext is IsWatched, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) IsWatchedContext(ctx context.Context, repeaterID int) (bool, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	var count int
+	if err := d.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM watchlist WHERE repeater_id = ?", repeaterID).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to check watchlist for repeater %d: %v", repeaterID, err)
+	}
+	return count > 0, nil
+}
+
+// GetWatchlist returns the watched repeaters, oldest first
+// Repeaters a sync has since removed are left out
+func (d *Database) GetWatchlist() ([]WatchedRepeater, error) {
+	return d.GetWatchlistContext(context.Background())
+}
+
+// GetWatchlistContext is GetWatchlist, aborting when ctx is cancelled or the query timeout passes
+func (d *Database) GetWatchlistContext(ctx context.Context) ([]WatchedRepeater, error) {
+	ctx, cancel := d.queryContext(ctx)
+	defer cancel()
+
+	rows, err := d.queryRows(ctx, "SELECT repeater_id, last_online, added_at FROM watchlist ORDER BY added_at, repeater_id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load watchlist: %v", err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	entries := make(map[int]WatchedRepeater)
+	for rows.Next() {
+		var id int
+		var w WatchedRepeater
+		if err := rows.Scan(&id, &w.LastOnline, &w.AddedAt); err != nil {
+			return nil, fmt.Errorf("failed to load watchlist: %v", err)
+		}
+		ids = append(ids, id)
+		entries[id] = w
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load watchlist: %v", err)
+	}
+	rows.Close() // The connection is needed for the repeaters
+
+	repeaters, err := d.GetRepeatersByIDsContext(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	watched := make([]WatchedRepeater, len(repeaters))
+	for i, r := range repeaters {
+		watched[i] = entries[r.ID]
+		watched[i].Repeater = r
+	}
+	return watched, nil
+}
+
+// SetWatchedOnline records the online state a check saw for a watched repeater
+func (d *Database) SetWatchedOnline(repeaterID int, online bool) error {
+	if _, err := d.exec("UPDATE watchlist SET last_online = ? WHERE repeater_id = ?", online, repeaterID); err != nil {
+		return fmt.Errorf("failed to update watchlist for repeater %d: %v", repeaterID, err)
+	}
+	return nil
+}
@@ -0,0 +1,1564 @@
+// Code generated by gen_boundaries.go from Natural Earth; DO NOT EDIT.
+
+package geocode
+
+// usStates are the state outlines
+var usStates = []region{
+	usState("Washington", "{f_yGngcpV_dB|uBgiFrgE{x@|dAev@dpCtaBtgW_yGdpP`iKpme@saMz}XzaGnsBbFbqFiziAckB|zOexGlyb@wU~i@y}IsaKkbEtnG}tNqvB{c@m`JzqQuaMwuFyjNjvJj~Bg_KqwIlkD{tEyyGy@k|HdyDizA}aBijHgnL`qYtf@xzMvwFmTieGfqVoi]~oEkHs_FxcJ{qBbaA}~HalFb_Abr@f~CqtDg~@}fLutl@wyCz_f@qtGr{Ak{AhlPvrBtbErwPchGlp@jeMidr@rdCqk^jlR`b@ckDe{A`_HkbjA|nS_{LlzJabKvrWasUneKabb@jfKalGiyGijEncBujMerGgiLv{KfkB_y\\`_g@k}tBdyHkllCekLwaZnfEnjInuEceRhcMkoEdh@mlE{jInMcT{~L|xKuuLx~E|bBkbGsmHk}LriIkvEm_TptCqzBbuEzbG~ag@eq`@z_B|~I`rb@reWwwAhsDqd\\grCv~JppEerGzjBloN{jB~_y@dvu@hbQ~dGhkAw_Pw`Pm{l@ztKrrh@iAtvFwjFlsBynd@ap^maMmfc@_jMeyDy}Q}hRj{BqnKssW~fGx{F_sOz{UiuCbeEy}EvcB~nPzmHxkFsoFjvKdz[qmL|t@zyCwcNrrKpqDj_Bz~GchJntHvgGe|IkaXdiKqyG`aXzlH`eC}sBrtErmHzbM{kBegF~~UeeSckItxK`cQrhJrhDtnHwqD`sJzlHgeN|eGiqKw_EwbOffC|~Nh{FnkFjpMn|QvuGz|HpeU?g{JjtEf{JqhNg_\\v}PntNqiHgtMxeOefGyeOrp@exEmmHdxEvi@ug@qsDysDmtAr|MqnQ_mV_n\\_ySatEr}HqkSedBokEmxEhfC{`GugQkpi@pvNwcCqaErrE}gE{cFac@a_KziPqtJaiH}bNnpCaf[ukOovGs_T}uFwbCgmKv~[y`Wn|EyvBvkLrxI||Cn~VcvVkeQvu^}}RjrCafAgePd`D_yGgkLk|A_zLl{[arJl|Cf|DxbPadDjeFsyC{|BilGbsFeqBipLbiJafIyaEilAdwEuoNkpMp`@}|IveMv_EgiRybDulDixTfqOekKgfB{yC|zKziJlvKgz@tkDa{Ggx@caA~bHuuFsEmbHhqK{oG{jCktEdfLc{CucMiaBjiA?}d{a@~vzBcSpkz@bq@rmzGcHb_b@eQn}Gba@`~A_l@llGkgHttF_pE~bC{uAfqHweApiDafC|aCIh_Cnx@jgFrgGbaAsa@piHaiI|wGsqCdK`w}K~yEtfGx`BzoHvO~lo@`tBf_ZoMvoLlL`dDfg@teBr~CfsDrsCbeGrrEhat@|lGtp[pb@xcHft@npBpeCn~DpqA~aDvu@rlD{ShwJh|C~cMvEpfA_i@faIbbAnuGo`Cpm\\fJh`A~lA~dDpqAnaA`sAdeHp_Ap|LzgDdbIhbA|~J}VfiGwb@l~CfGzlEqSvjFhuDljQ`K`iCyz@znBekI`cEajAz|B{hAtgHg`Czgy@nxBpw[cfAptMpWjoDdgBpbGj}FrkL|mBnyFdzMlsw@{`CpvN{EboOojAzyDs{@t~HmiAxiEiAf|Azo@`{FueA~gNsoC`nHivAzjBegB~x@}jCtf@msFj]eaGye@s|@zWalD`mComClC{pFwg@oyEdrBwyGjq@mfBpd@qoEvnGomFhbAojC~qAe_ClmBg_BfrBcmDd`JmvB`aNcmDdnHoV|xBvp@bwJ^z|EhaBzxFhkAdhCaF`qDqvAh`E"),
+	usState("Washington", "gz_jHtbhnVB_xIzq@h}@vjA`mG"),
+	usState("Washington", "yswzGnttsVrrDk`DveJrbBibHfqE"),
+	usState("Washington", "yym`HpbhjVvfG_jLveHb_Sw_JsvD_^|oCv~JppEy|@v~DmsYanF}pBooE"),
+	usState("Washington", "ogqaHzdtjVh_FlJo|DjmNs|Ts|BboBmcH"),
+	usState("Washington", "u{pdHfqalV~qCghGf{Kq`@wzE|rHoyFzq@`oE{eF"),
+	usState("Washington", "}lycH~hyiVvaDqmAj|GnxF{`AftIi}LdxE`{AviKcgP|wL{eQwNxRn}JsxI~wMmrGfk@qkYonQghB_{JlaTwgN_vAp|MjiJreJ~cBbcMhzGc}Zxsb@ayGgqOgeC"),
+	usState("Washington", "iq}fH`n{lVpaE}mI`~FhxBy~FrmTmqS{zH"),
+	usState("Washington", "_mpgHvrzkV`jByvBxfDvwF{mHtbC"),
+	usState("Washington", "{o_gH`kzmVbjHaxIanFtd_@yhT`jHwcCsmHxdJalSxcEwdH"),
+	usState("Washington", "iw_hHnxdlVhxIltMquPfvM|fGfvAvmKq_FbEnoJs}GdcHdbG`iBsvDbwDoqOoeT"),
+	usState("Washington", "gq`hHxnlkVblC{DotBtwEsoMbvJ"),
+	usState("Washington", "ef~~Gt{|kV`iBqsEh_GrxD{xDhzG"),
+	usState("Washington", "{ao_H|t|lVtsMa{B~}Ct~CkpLrkHs_GiiBqzBuiJ"),
+	usState("Washington", "yr~xGnpepVd`HmwNfe@eu@eKvfGgb@~uBmuBltEqnAliAmiBuc@"),
+	usState("Idaho", "gz_jHl_jiU?mj`Edc`EzCzaBojAhvFa`IhoI_nHl~EoyH`|AwdEdwAe`ArmIiqCfgCgkG~oDwhBhrActD`pAesAruAoV`zClOlhFghEjwBsw@b|BzmAn}BxvF~T~Yz^gA|oCo}F`cAon@zeCmg@fwA{xE~g@qWtx@fg@f[flAzb@``Jhq@z_At[pJz`@}OjcA_qC`q@ytFtuEktMvfEm`GlfBkeB~hDsrB~t@erAnaAiuKvtD{fRvkHqmG|}Bu}@fhAgtC|_Cq{Cz`CerFreDejDtpCmxAdkLomPzkDmy@vp@alAxaAe}Fp|E{`GhzBivFlpDz`@|kBqKvzEebBdvCw~GjzCq~EncAqwGjm@wkAfuAq[n`F~m@jt@_r@tIs|@}VswAayBejC_kA}hCiJ{zApRu|AfoAe}Cd`Bi}A`pAwAhiBbfBdbBoPbmA}oBzaAmuC|h@ufDy|@slJ`_@wnFogBshG_kAegLt@ggBle@mtArpAkb@xxC}A~nEhrAteCyNlvAp}@z_AbQfuBes@pmAx~@j~ApwGrl@bg@~vDakDflBqGflAnXvxEtpCtfApYxlHxRnaD|sCboDvn@llCxgBfqEwdB`pEic@tfAxDlbAlvAde@bbHb^nh@zy@fPjeAcb@b{D}vFl{@eh@~eBhQzbD~rC`aAl^lvAwSx|@mp@pk@qGxfAbSdg@aRpe@sw@``B{eJdnAq\\b~CryAplBvH~pE_cDln@}Hr~@xa@~{BvwD`b@d{I~o@pgBfdHjzEbxAhzBlkAsBl{@os@|rBum@vuCodEtuAgr@jz@hZt~AuW`aBhVndBbnBteBrzCvfAOx~BseAdbAhXdnAlhArVhBzUa[lGopEjv@kbJloBmmErhCgcBzsBmrGnyAkgBrUogEcIgdAujBqkGybEqpBecAmyAqNyqFspAwuDczAkxGirBknAwzAsjDkxDu}Baf@w`AcmAkvFmw@kGaeB|c@mdA?uo@aVmUk{@~}@whEtuEc`Kr_Cme@h`@ej@nxC}nN~k@eu@xeAge@b`@~CnpBfuAp}Dln@hbAsiA||@qaEbk@y_A~}Doa@thDbdAbhAjIjmD{_AjgBsnDrrAafA|vIzZzcBaYjcE{tG`gCyXd^}[rgHchLzrA}w@jbC_c@hhBywAdjCqWxd@euAeBsqEdO}m@vn@gh@|hC{w@vkE{cEzgF{gCxiC_h@z~Ez{@|lAjlAhy@xvCpt@v^zlEwmDhkFoyBt~AubCnbBocHrgAwgCzgCo]jWmb@pK_z@kTwmDkyBulEkVwpAt@ekBzYcpCr|@kcDvmDgeGbaCukBhqBijC`vCudAtaBu`B|xBm{@h`De}Bn~A_ObqBbjCby@jXdu@m\\fgCghDb{@eZbfA[f^y]faAswCnaAm~@jlEn|AplB}~@nbB_M|_@qYhoA_tEhsC_gDldBshJxjDmmDxOyf@fAsu@{c@edBea@mZya@oD{bDlxBmaBm\\gjIc|Ly_@oqDq_AkrCoGevBfg@gsE~cAu|VriAq`CsJ{mHjVgwBf}Au_DvCuyBoXebBcw@}wAunEclBkrCam@uv@sl@yq@ghB_iAknHugAe}B`]mpCjaAglCvGueIdqAieKkmA{rQiCeyEigAatF~EqfGfv@sjDn_CodCx]ulAcy@smFeX_jFcwCi}HiHgzAnBaiTrJmkAzqBksHoOmaF_Soe@qr@fRmdAjwEaoAdeAqdCvZgkD{iAw`AgGao@go@}l@meDol@gv@wkBwCecC|{Ai|@eX_mAweCai@}mG{bC}aClLadCl~B{_HniBez@xoJ{mHn`B}lDtk@eSj{CuGtwA}l@r}BquFrnJeqJxt@mdBxu@oqGx{fNeFx@zhlc@uv{IfUooGfeA{cBy`BmoAyhCoqAqdAkkCagAmtCoVwiE_nAe_Gqi@ucD}kAyfAqGmbCzk@asE|iD{eBr@mu@k_AuGmiD_U{bA{}@uq@afAeXmpCsHarB{{Aux@|]gxAx{B_qBzrFu`@da@{qAfn@ebEf[gUhmAlkAniJ{\\d_Ce~CjfCgN|pAfWbtAduCziDy}BlpFepAbdB}rBnh@erEqi@unAlPwfElmB_oCmSakF_sBigDf_AarBa~BenAuT_gAs~DctDchDetBq}@{rFgt@gxVupMaeBusBipD_yJiiAcsBelA}wA{kKmlIwzEioCmsBeq@wbHwbAabCaIibBjjAgfAzLs~@sSytWyfUooFw_Ck_KwnBqoEcnCmgK}j@qpBqoAssDkwEkpEw{AmkG}gHi`Egt@kqEstBi`HopHytBwp@icCrDe_Cpo@inHjzEw~FfrB_zBtoA{bAdnAm{@pzAad@leGm~CpiJyoCt|DkeAn~LkqDxxB_nGdtJk~I~uDa`E~t@_tHb`DqiH`iIcaAra@kgFsgGi_Cox@}aCHqiD`fCgqHveA_cCzuAutF~oEmlGjgHa~A~k@o}Gca@c_b@dQsmzGbHqkz@cq@"),
+	usState("Montana", "gz_jH~shcUSuzfhAnqmPeGxf|Eoj@Szlxi@laaBtByu@nqGyt@ldBsnJdqJs}BpuFuwA|l@k{CtGuk@dSo`B|lDyoJzmHoiBdz@m~Bz_HmL`dCzbC|aC`i@|mG~lAveCh|@dXdcC}{AvkBvCnl@fv@|l@leD`o@fo@v`AfGfkDziApdCwZ`oAeeAldAkwEpr@gR~Rne@nOlaF{qBjsHsJlkAoB`iThHfzAbwCh}HdX~iFby@rmFy]tlAo_CndCgv@rjD_FpfGhgA`tFhCdyEjmAzrQeqAheKwGteIkaAflCa]lpCtgAd}B~hAjnHxq@fhBtv@rl@jrC`m@tnEblBbw@|wAnXdbBwCtyBg}At_DkVfwBrJzmHsiAp`C_dAt|Vgg@fsEnGdvBp_AjrCx_@nqDfjIb|LlaBl\\zbDmxBxa@nDda@lZzc@ddBgAru@yOxf@yjDlmDmdBrhJisC~fDioA~sE}_@pYobB~LqlB|~@klEo|AoaAl~@gaArwCg^x]cfAZc{@dZggCfhDeu@l\\cy@kXcqBcjCo~A~Ni`Dd}B}xBl{@uaBt`BavCtdAiqBhjCcaCtkBwmDfeGs|@jcD{YbpCu@dkBjVvpAjyBtlEjTvmDqK~y@kWlb@{gCn]sgAvgCobBncHu~AtbCikFnyB{lEvmDqt@w^iy@yvC}lAklA{~E{{@yiC~g@{gFzgCwkEzcE}hCzw@wn@fh@eO|m@dBrqEyd@duAejCpWihBxwAkbC~b@{rA|w@sgHbhLe^|[agCxXkcEztG{cB`Y}vI{ZsrA`fAkgBrnDkmDz_AchAkIuhDcdA_~Dna@ck@x_A}|@paEibAriAq}Dmn@opBguAc`@_DyeAfe@_l@du@oxC|nNi`@dj@s_Cle@uuEb`K_~@vhElUj{@to@`VldA?`eB}c@lw@jGbmAjvF`f@v`AjxDt}BvzArjDhrBjnAbzAjxGrpAvuDpNxqFdcAlyAxbEppBtjBpkGbIfdAsUngEoyAjgB{sBlrGshCfcBmoBlmEkv@jbJmGnpE{U`[yeBwkAebAiXy~BreAwfANueBszCodBcnBaaBiVu~AtWkz@iZuuAfr@wuCndE}rBtm@m{@ns@mkArBcxAizBgdHkzE_p@qgBab@e{I_|BwwDs~@ya@mn@|H_qE~bDqlBwHc~CsyAenAp\\a`BzeJqe@rw@eg@`RyfAcSqk@pGy|@lp@mvAvSaaAm^{bD_sC_fBiQm{@dh@c{D|vFkeAbb@{y@gPc^oh@ee@cbHmbAmvAufAyDapEhc@gqEvdBmlCygBcoDwn@oaD}sCylHyRufAqYwxEupCglAoXglBpG_wD`kDsl@cg@k~AqwGqmAy~@guBds@{_AcQmvAq}@ueCxN_oEirAcaEpKi`Ar~@cOxvC~jAdgLngBrhGa_@vnFx|@rlJ}h@tfD{aAluCcmA|oBebBnPiiBcfBapAvAe`Bh}AgoAd}CqRt|AhJzzA~jA|hC`yBdjC|VrwAuIr|@kt@~q@o`F_n@guAp[km@vkAocApwGkzCp~EevCv~GwzEdbB}kBpKmpD{`@izBhvFq|Ez`GyaAd}Fwp@`lA{kDly@ekLnmPupClxAseDdjD{`CdrF}_Cp{CghAftC}}Bt}@wkHpmGwtDzfRoaAhuK_u@drA_iDrrBmfBjeBwfEl`GuuEjtMaq@xtFkcA~pC{`@|Ou[qJiq@{_A{b@a`Jg[glAux@gg@_h@pWgwAzxE{eClg@acAnn@}oCn}F{^fA_U_Zo}ByvFc|B{mAkwBrw@mhFfhEazCmOsuAnVapAdsAirAbtD_pDvhBggCfkGsmIhqCewAd`Aa|AvdEm~EnyHioI~mHivF``I{aBnjA"),
+	usState("North Dakota", "{z_jHhx`zRIgish@fuCpRzfEsqB~nE}o@heDeoBfxEom@~nIawDzpF}l@b}HycEvkHos@jmFdaCt`F~dDhfG`v@viJoqC`hHecAldAdDbtDlhB`jAlUdwK{lB`zD}eAvoLtzA|aGe@nkFqo@l_Fi`B~kMcpGpgFoeAvrE}dBvmDeyEntCnzArlCaPjqHw~Cnc@g@dm@ta@xZaKzW{{@`fDspDvmAwn@d{EokA~jIaiFf|Emc@~jDwiAfxAa_Cxf@qAdwAhl@nqGxBzlBcEniByz@bzAMzzHpaBbaCvAd_CemCb}@sWbzWpm@~`QyfCfbDfo@fhAq@d_GmtAt{BuElyKll@jqAgLlyC}mBd_CiXEhX~lAqrF|`Ac~@rzCbbAztApvAp_AdSjvG?jpEfxAn`BqPfzEqxAdhC}StkChUbxErkAzeCsLpuAy|@`cEieEtyBmi@rfFwc@lcCsCx}Bjh@pmDqmDrcDmQnzMksKtiDstE`kB}{AhcCwe@xsKpNr}BqN`wBwn@phDinCn_DlSfb@}[nfFmi@z|Ffm@viCpiAlsC\\|qMa{Bu@lrul@"),
+	usState("Minnesota", "e{_jH`nlpQ?ceoK{Mst@ce@oh@oaA_OszdAvFd`B}gJ{L{nVdaAu_JpiDysLvy@siBbcBgiAd~Fam@``GauAbtGt^ryFuvBdkh@cxNdvPzK`eByMxkB_x@leAsoAnfIgwQfhCqkMpV{vFuqAox_@rKuuBvo@apB~qAicBrpEim@|_@auBxaB{wdAdvBusFvjMucDtuCa_EfQwgCe{A_mZ~]qeBsiAeoEeXsrMi}A_fEw~A}n@kyCyCyh@otEebAodEq_B{~W|mAshu@`a@yuCdiEe}LroGo_m@|gBcrBhgB}OvoAjnKrj@lwBjoCtr@jzA{{Ahj@wdHus@y{OnEmoGbmA{aFnlCscBfdBmj@p_GgBptCu[xfBidAp~DenEduH}pEj|@}nA}@uoB{g@eaDo|@ivCg_A}mA{`ClE}}Bny@w}BnIiaCkeCcp@okCq`AmkSjTmmOtjAghDzdCerAz_Duu@~zCorA|`DejEpr@upBdiEsoS|t@i`Plo@iaCjeAofBd_EmgEp~CxaCrwAqtCrz@uaSdm@st@h}@aG~qBzk@fZ{jA}_@mcPliBypJudCyeKij@okPwnBinFi{CetE_jKk}XkvAkkGegGioSel@msH|wA_nEvz@cP~hAbcAbx@nDtmA_i@|bBy~DjuAyx@d{Dwf@xfB}~@zeBw`FqBoqHkuB}rPgGovCxbBayC~^c}BemA_aIvf@e{B_eD}_x@vE_kJnwAqeHfcOafNdt@y|AllCeuJtHqoBamDuiRoTmkCSomB~uAuzHg`@itEnC}hCvfAwkKvGmkQdniCvqxAehC`_gC`_jAp`~Ctce@n~_BoxFzi[rrA|~DhyD|{Bzm@liDvl@tx@xeAl`@h~Cz\\b~@br@zd@xdFg`@dfFvSxMbqoB`EjPvxBvg@|kAbdAhtAfsAldAhtAj[jt@p{@_a@vwKbw@vzAbdDbjCra@b|AlL||H~fAtkBxbCrmBxe@htEntChoIjuA`uHdzCllDbqIzyFztD{YtxDvkDlrJp|O~jAtx@t`Qv`A~zEyrBnl@aoGyDmxHfaAkpFd~AyeAljIuiCf{GguHnvBq\\loBlZ`uExsDjaG~jAlmE`hGjeBxs@fsB~WvtGnFvkEtr@ppF_hCxkCsFbzBtb@~uAtoA|_@v~Bfz@rtA`iCwg@zvGqtCxhGux@vjGsZvpCzjBlbAdSjtGwi@|gDzg@zdKz`GzjBofDftDi`Kl_IivLzt@}mB`vCs~AfyB{mGpsBwk]v{@i{EpzBqsEreDwrDdlGm`DlxBovBf]wrAtxAswU|yAcmInwRax\\dcHclFp|SsuMvvBqzBtpA_qFb|MqrUbn@}zCVg{QtrFatLtXyxG`x@krBlrEi`BveCooCfqA_p@doB{|Ilp@m}@lcCgbAdvCs\\dfItI`jByO|_Bqy@xyBu{BrrD}f@`uBYnrIljAp~Eko@xdGhVdfIqp@d`Fvl@dDjhw^uz}IHuRn_@wiHhtEi_EjtGi~AduDsy@xhGyd@tmNanAr|BgxDtiCyzJlaFooCt~BcyKvyM_cCqYubColA{e@wm@yxCqdHyzC{mE}yDofMokCkbFulAahAqsHazDeaDy}B{jByh@{{Juv@kyCmaB}qM`{BmsC]wiCqiAumDgk@uuHlg@gb@|[o_DmSqhDhnCawBvn@s}BpNysKqNicCve@akB|{AuiDrtEozMjsKscDlQqmDpmDy}Bkh@mcCrCsfFvc@uyBli@acEheEquAx|@{eCrLcxEskAukCiUehC|SgzEpxAo`BpPkpEgxAkvG?q_AeS{tAqvAszCcbA}`Ab~@_mAprFDiXe_ChXmyC|mBkqAfLmyKml@u{BtEe_GltAghAp@gbDgo@_aQxfCczWqm@c}@rWe_CdmCcaCwA{zHqaBczALoiBxz@{lBbEoqGyBewAil@yf@pAgxA`_C_kDviAg|Elc@_kI`iFe{EnkAwmAvn@afDrpD{Wz{@yZ`Kem@ua@oc@f@kqHv~CslC`PotCozAwmDdyEwrE|dBqgFneA_lMbpGm_Fh`BokFpo@}aGd@woLuzAazD|eAewKzlBajAmUctDmhBmdAeDahHdcAwiJnqCifGav@u`F_eDkmFeaCwkHns@c}HxcE{pF|l@_oI`wDgxEnm@ieDdoB_oE|o@{fErqB"),
+	usState("Michigan", "k`}cHnggaPnB}fFnvAerMxDsqCk[kpCwet@kggCugHqzl@sEqcEjg@ouD~erBkbdHvwWak|@`ih@kheBx|oAmhbE`jJikXvbBo|Az`Cqz@|yf@c`LlsCkwAl|]m|b@~uAwmChs@}|ClFgtDw_@w|B{tI{mLgu@spEoNugF`cCqeHuvGibTh^auH`|AwgF~dQhmHbpEqdAzdIagGlpEltA~jKe{BvaDkNrrCi}@~xDs`E`{BkxEn}E}i@t{AuqBjnDmxB`}@whAbOakBwf@ofBw~F{iJcp@qdBsTajBlnAweMcnAszR|To}HjzCwmHveL{yP|~AqeAvzA`RpbWtsPfuQbwNnKsz\\zmXcrv@|ts@yfvBpgFa|Fv_G}cBlsiIyojAnvCf@rfDvp@li`Bfhq@jtEf`@zrEiJpfE~aB`iEbmCxvIz{AjsDhxAtfBjQtmDixAv|LtlApdM`mDhfDn|A`tD|nCrvBbeDzaA~jEnSb_FvpF~wHfwRxsSx{BfkExhGxrTrrCf|QlfDpvF|kEtyD~hEvsB`wFjm@rnOfVpqL~zBv}GoN`eKmbDfrf@bgv@vmDtulDr`AhfaBc_K?wGhatMsvpBolZ}b]enHkroCr`Um_RxpAy}GvMigjA_lEw|f@ijIyte@c_KgwTcdHy`PavCynRgjFkzQktFwrOmtFiqV{yK_~MwhEepHavGq|HqiEi}G{hGwrOm_RmtFyqH{iNqeY{hGymKwqg@|dcB?nf_AfgPdkMnkEnkE`tNr`UfjFt{PjrWnkEy`AxySibErsT_|@fhBceCxmBw_EvoFoz@dj@yj@nFotGuq@m{FczBy{FszCeeDuoDa_AjE{v@bg@kzAxyAyVzoAxuDtqNhFjpCo_@v}Dfm@hcD_u@vyCsmApv@usE{|As_CnKowAeT_|CseGyrA_iAa~Ayo@_~@_AkaEds@c~B`x@cw@sDkcAclC{vAyf@wqAc@srAl`@o~DluC{l@zCea@qNo|@g}Aub@oOqd@pGinDhiEarB|rEkyBjbCec@diAso@`hLcrCf|Ebb@n{Hsl@vdEoeAtkCmy@v{@_vAfNy~@uf@g~Cq`DmzAoZajHjxDej@|p@ycAhbEmhBboElE|vFkuAnhG_UtvJwzBt{FhJzhHi_B|dG[hfEccBbhFu@dbBjo@h`DyMxmCvqAjoDzCdu@olD`iQRjiB~b@ltDmt@rxCbEbgGq~Tfav@{qd@ldbEc{@du@{|Bxo@yqUfuL{lCv|Cs\\p{@oc@feEqdAhmBo_@zrD_vBn`BcPbk@v_AjeEkDbzB}Qbi@wX`YeuDly@"),
+	usState("Ohio", "u_~~FznkzNbdKgpEfnDatDvu_@axdAvdAisGqPmbi@u]kdDc{fBgg_FmnUuvrCfpfI?lSv_BlwCpvG_n@fhB|Bx|Cjh@~_Dv`A`pBl|Bl`AvjB}l@~uEonEb_I{aE|yAqUnkCd_@`tFt~BvpCdJxD_y@`d@eeA~~B?b~Eyo@vc@lZ|`Eb{@`aEtfGbdKh|Dh}Erl@rcNf_G|vBhVdaGcE`mC~R~kGnuCbwCyh@j|@xh@`GzmA}h@huC|~A~YrkEmjAlr@hNhcDf{ClcI~wBhcAbgC`s@vq@jjAob@d~CycDfrAsSfwB`a@lxA~~Bz~B?xiFhtA|~DvmLhhLzuT|nDbhExlEroCj~An}AffAzwF``Fv~GxyAf|E~g@rjGjf@b~Bt}ClxElNdsCqdAlzB}aF`mCyo@ly@u@jiGfd@laBh_J`vMfnB~p@bqFfk@flArgAgk@~uAfk@lvAqm@zi@jh@bgCnBpyCt[d}B|rEt`BhpGbkHbaBrn@jcMhDjx@hp@`eBd}ItmCwp@zjAgwAl|@uwBbnBqJn`F|]vhEaaBlgAgBf[zyBkTzbA}{AdmBiT~jAp^p`Ar_FrtAnwAnjAtcBnxBtv@nfCin@rtBicApk@s`Ail@erAsQml@ujAec@wLunEbhAaaBtx@gjA|rAa_AncEoaC~sEgAzrCvtBjeEpwEnrEdpF`qDr_EtaBz~Fvi@dwH~vDhjCr_FzpC~PzfHiuC`}CgSjxC`r@~zDqrAnrCgI~eBn~AvSxjCsa@|jHxx@rvAjfCfdArdPfdCvmBtm@nkBxzAlb@pnBn]rsMntC`oOrUzoGuqA~aFmmAr`AgaHbfDugJ|`M{uB`oFynAraHgwB|nGunFxuD_}KzuC_oIjf@mi@hiAnSdaDnvCb{Jlr@n~GrbF|qGhpAhxEtoFjtCbw@xnBdD~bC}h@r|GvHtqCz}AfiDnh@``FiO|n@wbAjlA_`EdtCmvAdzBmoAziGtBj|E}gD`vG_h@~`C_JthCvoB|wLpg@|eA|sBd\\zdBdlAzhAflBh^v|B{m@rnGwcCvvFmoIlfL_oHdsGiz@biGk|Ab|FoObrChtArmPcLfiC}kEnxTwoAlhCmkBvy@wtGp[coBtf@{eBrz@o|Dh_FowH`qAwhBh`AwmA~|A}kBjfG}|@vrGyq@reAgeFbyCg{@bgCrh@tiGb_EjyOiSnhGe}Fz}H_}@z|Be|AnnAkTzv@bNxjAtwBz}CdhDp|Is}kLyoCqsMzV_b_AzJs`AifaBwmDuulD"),
+	usState("Pennsylvania", "imjrFloqjMfqCp_Kru@dlEbmEtkE}dDdwLi\\dvE?r`MbmAz|Hr~@l`DllEhkIp}DdjDf_EprBwA~f}[ig{NdDscGemt@ms`@mm}Ap`hBSlNq`_ZvrA|AduAutCviCyfI~xK}uAjz@cfBsi@exItRinAjwAehCtm@yhDdtBwxAxq@ijC~x@vX~eClnClqAimFzw@ajAfgC}Z`z@jRbt@btAly@pRtkFitAnbMnf@n_AiQ|y@_nAb`OqzHd`BosAbfA_jBzr@wuGf|DabF`WkvC}MspHboB{hE\\s`Awc@wcClS_n@~tCepAlbEavC`fC}c@h|@`{BlE~iCbQb_A|w@dsAjVraBrgBz`AxmFppIhrAnm@vxEd`AxqGhsCrkIjcIdaCzyFz\\|Xr{ASj{FxuGngA~sBvy@~eFnlDl_H~aB~aAxj@aMx}B_mBxjAx@le@mzBfd@gIvsFshFxvBqUpjB~}@niBz_DjhG`jAf}@~eBtIp~Bmg@pqB`yCdbBdl@hkApr@aG|n@oj@`fGlmBhlCsl@zjBpWpdEiV||@h\\voHusAxXanAyZozDjlAg|HldDcjBpeNel@lsBc~@h}BkrB`iAqiCkeAwsFdiBopA|oF{tAn}EqeI|dIc`HvwD_bEp|EglIxdCm}CdfCcbAbyBjlAruAxsDf|AzfLhuC|xEhpAviHln@x~AlsEttF`k@nsAx|BdiJzlC`pEhkAdwF|oBrqAzkCjc@vaCi`@`~@xxAhjBzvJjpBx}FzRpwC"),
+	usState("New York", "qw~xF|ot`MpcP`yYphJmvAls@bfXqpWasDkcGkt@k`G_wAsiZOalSr}NklIo{@jbGfjF~uSkbLjw[_gAd~CzCckfA`tyCg{Bda@mbE`vC_uCdpAmS~m@vc@vcC]r`AcoBzhE|MrpHaWjvCg|D`bF{r@vuGcfA~iBe`BnsAc`OpzH}y@~mAo_AhQobMof@ukFhtAmy@qRct@ctAaz@kRggC|Z{w@`jAmqAhmF_fCmnC_y@wXyq@hjCetBvxAum@xhDkwAdhCuRhnAri@dxIkz@bfB_yK|uAwiCxfIeuAttCwrA}AmNp`_Zq`hBRcwr@ufpC_iF__J}qHurGc_G{|@ukBxe@ulAvbCscBzeHe|AdzCyjCrrCybCgVe|A}e@au@vFkyDthEau@jm@oy@nMsxA|AieDkwAqsCuQ{oPh_@_lB`\\osKnnEqyVvcOix\\yuqAe{AagKlf@_tgJqzBctGqvtA_|aAcvBwxCumHsrNqhCapAmo@my@csGqvZkgBueBuaFly@y`DqsDsnGybO{yJ{me@}rAkzAggGuyCijKa|McqIivIcbv@c{rAw~JsmZcbBizL_{C{pG_gC{qMclB{bFeYujB`fAmfd@gh@kreGhsHwvAjzAwA~dC|l@|gElyCh{Dnv@beC_j@lyDe`E|dBcUdmC`YrcGzaBxtE`TrsGhoBnlDaBjvGeaAloPc}KlzA{b@x~A}H`}Fbt@pvH`aE|}A?lvIs`BpyBhA|gE~k@zk@`_@luCdoGxz@hn@xzFnv@zuNjwEl`CnwAjaBmZbpBskBhcAea@~~HiFjoGsy@nbC_u@vmAw`ApdAyQraClLt`Brc@jjA]jsDebC~qByK~eEj]xjRzfI|{B~[`fC_AvkAqHvfAya@|nAeeAsZu|As|@_aBm}@ok@clCsn@mp@iy@wWuyBlPwqCjNmm@||@o}@|_FywAz`Ds{DrleCvxBvaIdtAtzAzp@x_IiiAdB_bAjeaCxen@twC}eDrjsC~oKh`NwyJbjU|sm@fxKypIb_AusAzeDcmA`[dm@~Z_Mh}@`_@"),
+	usState("New York", "qalwFvlwbMe_E{]m`Dcv@weR_oKopCmdApqEdIt`B|w@rhUjfF"),
+	usState("New York", "ai`wFzyb~LvnKjb}@kqLczl@"),
+	usState("New York", "yf}vFrdbcM|_CyqBxkHhnHpwInoZgK`p@ed@dL}z@usA{iCxLyu@gv@klAw~CayCcy@smGce@gx@sy@md@yfA~c@ckB~WckDecB}hGzCsi@|aA}_@~WvR"),
+	usState("New York", "_}rwFlty{L`rPr}r@|xAnw]"),
+	usState("New York", "qnsyFjt}uLui@q_KncDfkDxxb@p{dBbz@bqIqdDkrAg^drHnxGzb@~kLhhj@_hD_jMq_Av|M~oBpvRxzFzyC_@hc^nvHln[i{ApuMfbUd}pAc}BfkG`dDrzAlaAacXe_@`kc@~hHjn`@o{Niy_@{gB|_RniJtv@pnAxpTczHbcIq~_@etXxlBgo\\o`KoK|mDy~FyiBsmBggC`aF}kCkoAngGaaL}wMa`CqoBg|TheD~qD|tA}oLmjLrc@`lA}rInlEscA?ybKkpIzoGbbHep_@gyKuzV|{CqgCorCg}AwgAinvA}}a@}{cAzhD_rD|wL~h_@t~BkiDftB`fIjbG~@cEheOnbNvhSv|@qa[_xRmc]ycFk^voEk`IgcGmbFdyEodHo}G_cE|mI}`H"),
+	usState("New York", "}}wzF`gmvLzLlyFijDegB{yAwxR"),
+	usState("New York", "qoxyFvepxLlhAw|JdeH}]woCruQ"),
+	usState("New York", "}i~yFzagwLd_CugIt|G|jC"),
+	usState("Vermont", "}}tqGzlu~L{w@imgJtXhaBxz@r~@zbD`TfpCol@n~AwtAhaBss@hxCtv@~gQn{Pt`F`xCl{CxYvbBc~@htAyuAbjEacAnfJ{_E|vCqwBj|@uBl~@tz@fIjxCb^p}@pwBfk@boB{RxkBtDhoBbxB~~BntE`~DtbDto@~aD~wFfaP|`ApaB`_EtnEnz@juBdQ~tCwH|pHpk@r}HleCndF``FpiCn|Hjm@`ePwApnFva@rfE`{Ab|ItgGzaBzd@~r@eZ|iAq}Arl@fLffLxwKdaCh{AvrKduAd}EtuB`|EvhI|pA`pAlcB`hD~_Ave@dyEjTjbGnv@lzBjq@|hBdwAhxCtwEpxAd~@jcDbe@vi`@pm@dkFkXvlCbNzuBhkA`pBfnBf_Av\\poAjIltA{i@xc@bLz`AhgA`_Gve@rmFwe@pmCbN~~I|kBp}GfYrfBxz@ftAlaGhmBvi@nnGhj@|bFvzBjpBlChgDme@laCwxAblE}jGd`CwxBxuDqg@{kApkuAi[hufAy_IhiAuzA{p@waIetAsleCwxB{`Dr{D}_FxwA}|@n}@kNlm@mPvqCvWtyBlp@hy@blCrn@l}@nk@r|@~`BrZt|A}nAdeAwfAxa@wkApHafC~@}{B_\\yjR{fI_fEk]_rBxKksDdbCkjA\\u`Bsc@saCmLqdAxQwmAv`AobC~t@koGry@__IhFicAda@cpBrkBkaBlZm`CowA{uNkwEyzFov@yz@in@muCeoG{k@a_@}gE_l@qyBiAmvIr`B}}A?qvHaaEa}Fct@y~A|HmzAzb@moPb}KkvGdaAolD`BssGioBytEaTscG{aBemCaY}dBbUmyDd`EceC~i@i{Dov@}gEmyC_eC}l@kzAvA"),
+	usState("New Hampshire", "idzeGrmqnLfr`@r~R|aB~Mnh@`~Ec{AzsIaFpbCteEz|Q|{Er_InMnz@oMbwFnThoBdvBdtCx`FfbCvt@t_A`hAhcGhiDt~E~r@d{AdX~nBspDbtaFyuDpg@e`CvxBclE|jGmaCvxAigDle@kpBmC}bFwzBonGij@imBwi@gtAmaGsfByz@q}GgY__J}kBqmCcNsmFve@a_Gwe@{`AigAyc@cLmtAzi@qoAkIg_Aw\\apBgnB{uBikAwlCcNekFjXwi`@qm@kcDce@qxAe~@ixCuwE}hBewAmzBkq@kbGov@eyEkT_`Awe@mcBahD}pAapAa|EwhIe}EuuBwrKeuAeaCi{AgfLywKsl@gL}iAp}A_s@dZ{aB{d@c|IugGsfEa{AqnFwa@aePvAo|Hkm@a`FqiCmeCodFqk@s}HvH}pHeQ_uCoz@kuBa_EunE}`AqaB_xFgaPuo@_bDa~DubD__CotEioBcxBykBuDcoBzRqwBgk@c^q}@gIkxCm~@uz@k|@tB}vCpwBofJz_EcjE`cAitAxuAwbBb~@m{CyYu`FaxC_hQo{PixCuv@iaBrs@o~AvtAgpCnl@{bDaTyz@s~@wXklBupCxNmbBsJ}sAym@wtGe}FawFu|A{|EqqDgtBkg@qkAvcBw{@vcD_h@__Akq@oqG}dFmjG}_AcnB}`@mmChJipBxiFelOdw@oqH_h@isBkxEy~DguAuaBcTi_A~fsIoyWf~L`|@|xFaXv}EboAd_CvAffAkY~eBoiA|cDm`EloLiiKdtBupFnlAkcAtoCyVhmFbfAhjB`BtyC{sBpiIqdKbzCb`A"),
+	usState("Maine", "kyrrGhwdyK?ivCvMql@bb@sWbfKsvIzlBkqAhcElYzsKyqKfiA`kAh_FhbEmnChrEdoIlZq_At}NlmPauIqiHui@ltAubGqoFwhAnzKsjC_hAskCwkK|`B`{FsrKbH{S`kAx@jyCikA~aa@fki@esAlxKpcD~rBw`Dj`HqeIh_@raAfmK`}LgqDzdEtfGgkK||Cqa@jkCzvJoPuuFhpRllD|tFtg@emDp{LxRbaAbvEcpFl{IvkK`mLyaQnfBviLrbGkmMsTxjBr|GnhGtaHfvGkiDz`ArbGnmPrpAq|FvcC}oHwcC~eNlxEqtPr~F|jEzbAuKfyFlnCmrGhyHq}@tHvzFzwKr{Gqs\\r}He`CnjFlcGliEo{Kt~BlnJr|B_sIhbFcs@huHlaNg|E_xAd_O~~JftIe_OblCmi@t`HdfS}rA}eGt}BvbClmPdvGybEzuJznAnbDwyEzb@f_DlmDypEnw@vpDuwPvfd@ntAriPitIihBte@a`GktEjTcmAcaLg_F~uBxnGjbRalYyjMunCxoJjzNnaDre@e_CnbIv`b@llP}qHlgFf_Kh|]nmPpmLmoCnmLb_Nbb@nsHx~F?bjCbaMajNuqGx`LdxPmvFam@tt@p}KozEsjCyhI`jBrmNz}MhmXrkIddBntBsfKriDrfKhm@azS`{BcoNopEldM|bIrnZfh@saGvMywC~uM{{Ho`Cti@ieDokFjtEcaM{iDloZfiXhhBmiDxwIpxAukD}bGvbOdlIutj@|rH{nMsyCpwNrqRr`Bo}Jlok@o`E~sCboH_gTfKagInlFnvAbyC||IuaCxnMn{Ry}R}lJ{oA|aBvyRhtOiiTc|M|~BpmLktEstEtsSzle@hcJf`Jh_Fwi@ltA`tIr_ByyMzlMed@ffCnbZtbMbyC`{AecHpa]rro@rmTfjApmQvcNulDvsIhsE|kD|X|IqiIpdKuyCzsBijBaBimFcfAuoCxVyk@n]{tCpvGmoLhiK}cDl`E_fBniAgfAjYe_CwAw}EcoA}xF`Xg~La|@_gsInyW}d@y}DykEwkFk\\cqCtSo{CdmAefBbwOkvGtgAsyAcdA{oBc{@kVeuBvJ{v@aMapBamDqt@qk@gtB{RmuGdT_|BeIokBcp@apAepAsj@gpBfAunCbo@ywBt{C}vE|t@{cCI}aCii@wiCmpAukAouBzv@kgAznBokBdtFwyAp_Be`Chx@keBoIusKcoGemNk~Qq`AojBmxBmgJaoBkzDqWwiAoMmqEycAyzBmzAyiAipBu]keCjHolCtqAeaAcKe`C{}CciGgaNwmCgwDojE_`Co`Deh@up@`l@qN~jBcy@~qCwxAdlAccBtBabFebAirAiwAox@nXqvAl~Aco@|Fu{@{kAekBi_FqvAo|Aq~Bga@_gCx_@{~B~vA}t@deC_|BkWuvFyjDieFwjBylA_mAm\\ggC_cEk]ueC}t@cyA}{E_cHakNs`DcmAgdi@umFmjGebBounCypoCwpAogByc@gtAoDs~AvlD}v\\hsAw`BduD|@jsJbiAhoEsE|fDwn@p~BakBvnJidYmAefBeaHk~Wer@erGI}jMqgFooJokB{{Gu]ifCmPmaDrJ}|C`yAkcEe`AwuHcbDgU{~DytEgy@ukHjl@_lIxvBwsH`xEc_G~~BktI~~F}bKphCw_Hp~Cc_EbdAsu@ptAgVns@_h@~_DysGjcEgqDlz@ebBrbBk`Ghi@k]|uF_Ux`qEy_C`{@iJfhCkoCzw@iJz_BjgAdvA`oBxxAfiArgBih@`pBkfBvnAiSl`H|oEf~Aj]fnIoXjpAij@jzBsnFpM}tAapAcmAkHen@|i@eaAlcComA|_A{vAteBe}DdkBciI`{@yzIzBg{GuRe~DpLo`DloAqsBbuDox@|{EnX|zFzaLbjFccA|jLgmIrdFxb@|mHdtExxBtf@lcBgVtxDgpGrnGqfFzhDcuEfjAqfFyaBawEmy@u^ccByMom@u|@{NelA|cDqlNxAfpDnk@bd@"),
+	usState("Maine", "aqgjGhgehLwmK~w@r`BwaD"),
+	usState("Maine", "wbhkGzgfhLfyQx{GclHzo@"),
+	usState("Maine", "y`blGfwvaLzuKbGlpAblHytIq_@"),
+	usState("Maine", "ewmlG|t~bLhbE|tXwmKcxO"),
+	usState("Maine", "upplGhpl`LrrElcGibEznMsyC}cH"),
+	usState("Maine", "q`_mGn`tcLslIjiCgaCmcG"),
+	usState("Maine", "gttmGxz_aLprEq_@yhBz{GalH}pB"),
+	usState("Maine", "{g{mG~zicLreKtzE}cHzo@"),
+	usState("Maine", "ixjnG~pk{KxhB|pBmvLbzD?mjE"),
+	usState("Maine", "cgkqGnkdxK``Aeu@|wEknG`kAzT}IzkC"),
+	usState("Maine", "alclGx{ecLhqEgjMdzD`~FweBdoIw`EsF_dBnfG"),
+	usState("Maine", "gpcmG~s}aL~eHypEntA`jH|lDypE|WtgQgbIrr@?ktEc{H_gA"),
+	usState("Maine", "etcnGbap_LdxQwiLbrExpKwuAnfMtkJqkB`vCrxDmkE`eP}lIzzBg~PejJolEauJ"),
+	usState("Arizona", "}qe~Dd|odTig@h{JkrZnwtA{fgEdsfSgdAcnAu~F{uAwgCmGm~B~s@wpEccC_vC{|DihB_hAylBur@alFa`AarCukFroBsmS_r@qgGy|Am{@_cFo@wkBoVqqA{oAofCggFqoA}eAggCcPenKlaBegEwWanAvWmtEzmDybBpxEya@nvPoqAvuFa{E`zDcxEaTqiFs}A_nGs_@chJl~@_tAdj@azDhbEk|ArLklDqz@wbAkjAut@sV}bEtvCghA`[a_@oz@aRqqHge@yxC_fAunBykJsfFyxCaz@_gDsyDweCmhBgqAqUggCha@_rA?ibAojAikEk@axCq]a_DglCi{Aoh@euDv}Ao_Aiu@oqC?qcBjaBeuKvb@ceC}bD_w@pGe|DdlDugA|SuiCaf@yuAo`BehCovFaxD_hEexBwgAkiG{r@qsB_`AivAqcBkz@waFcyJu|RiZkyBmtE{dEsiDopE{|BaeEusAas@waCxc@itAd@_kBlfEemBjdC{iJ`sRc{Aj|A}lD~bGgVhpDa[h_@aoDhr@c}Dg}@aaAlgAg~Bj]qrBhuCoeBfjAqnFnG{t@to@yrTnwKgT`hD_cAnbCkvBjnAmnDzJanD|gAouAffAoaAjzDqhAnj@qqYflAsiErz@k}CsqC{y@yVyuAlWcnBhtBcy@t[itA{SsVa~@cN}jDwp@ucBixFkx@edCiCqyFj]ajHrpAofIlEqsCz{@odI`eE}fPr{EumH_cCinGve@}fCwn@uiP~bG_iHhp@}xIuEs{Am`@q~CulBwdAoVqfAfLk|Hx|GkoC|yA{dDt_A}lDtIcxAshBscAwZsoIxFucBwvA}iEmvLcwB{_OhtAsgKr{A{mDhZubCoOg|B_dBifFmCk}BzPsx@j[wv@~uBy~B`yHsyDhzCwgCpqAitBdq@cnCnF}`Dwg@uqHucBgxEgyTiwFgkGerFqwBiS}~wCiJvSyhn]r{ra@~C"),
+	usState("California", "k}weEdk|iU_yRrtEelDztJ_IijJ|fRajI}nPfpBoeF|aG|xB|iK~iF}FagC~sBcq[~oDmgHgzDksj@fkL}gk@vmc@ownAlzuBk}BjpPrgEuxAysF`rDja@nmEtcKvdFmoFpqVigE``BycKcaF{jc@v}V}gBfx\\bvFjlZcwRny}@}wGvgP_aWloKcp[rav@dr@rr|@e|Khwp@|yDjs}@ajNf|ImbGnvWyuZ{pE}nIngFulRgyCotH|dJk|WssFyjS|dAuxEf_KjvAvpJelKtuTwuHduBogPmoEl{Fsh@azAavCg|Ql|IilDv`Vsla@f`_@mmDdfVswVvpHgqRhrWiwUvnGkhBjvJ_zZzuTwtZzhj@ssh@|dJ}e@wvDobEf^uqDtnHazIknGfgEcfLynX_sK|`BccGmpDtuFwuOnE}~XlxRfTtxk@iz\\z{f@uyMhvKqg_@wXwyUbpHguIt|LicYy_DksUt~B{uDyxSjuNcqI~yB~kF|kNqu@doB}wDfy@x{Bx`Fs`WfaUom\\{r@ehLitIr_Nsrb@rdOmkCluJk}BbaAy_BsxGktCzhUqyCdx@avCgyG{sI~qBogClaNu`JhpFzq@yfEk~IkcEbFmcLcfH_aMlxEkmNejEub[zdHei|@wnC`~DecLagUn{Co{D~lArrGb{Fcp@etGqnGdbKqeWy{Rz~]~rLlba@yuTuwQ|mO~oVj{@hqQo_@p~Ds~Bc_C}yBnySekAatDieDlxAjU|uIl{PntMqiDfoXwjOtnYdwFftS~cF}zBdtJfrChgEmzJx~A~eHbxGbcA`jG_sKlyBh}BkaEbyF~`K_rDr_BviJibOxxUkYzbOmnQphQogF~mRg}DaEl|HhoJlkCqfBgN|tKke\\geNchRpbHvt[e`_@qze@pe`@oE~vJy}\\h}Ke`Vptg@ajV|zQugl@|cx@ofFheBwbMqmIgk}@~oYugi@wyIcus@roOuyd@tjj@krNjwFwc]fko@}eJe~AukWv{Jao|@cb`@zwJnzBxkA{qEcfU{kGooGsqR}pB`dMhqQteNypo@e|VazUfnI}tHuwF}rp@ccKaeSte@uiDdxEgf`@f_JmwFneS{jMkrG{tXocAuoBnh@?a_vX~~hQqCfm~Fg~lJbivEwm}Gdy_F}g}GfysAymmBtgWkeAphAoj@naAkzDnuAgfA`nD}gAlnD{JjvBknA~bAobCfTahDxrTowKzt@uo@pnFoGneBgjAprBiuCf~Bk]`aAmgAb}Df}@`oDir@`[i_@fVipD|lD_cGb{Ak|AziJasRdmBkdC~jBmfEhtAe@vaCyc@tsA`s@z|B`eEriDnpEltEzdEhZjyBbyJt|Rjz@vaFhvApcBpsB~_AjiGzr@dxBvgA`xD~gEdhCnvFxuAn`BtiC`f@tgA}Sd|DelD~v@qGbeC|bDduKwb@pcBkaBnqC?n_Ahu@duDw}Ah{Anh@`_DflC`xCp]hkEj@hbAnjA~qA?fgCia@fqApUveClhB~fDryDxxC`z@xkJrfF~eAtnBfe@xxC`RpqH`_@nz@fhAa[|bEuvCtt@rVvbAjjAjlDpz@j|AsL`zDibE~sAej@bhJm~@~mGr_@piFr}AbxE`T`{EazDnqAwuFxa@ovPxbBqxEltE{mD`nAwWdgEvWdnKmaBfgCbPpoA|eAnfCfgFpqAzoAvkBnV~bFn@x|Al{@~q@pgGsoBrmS`rCtkFhhAxThkb@d|sM"),
+	usState("California", "}tjgEh}kqU~zCdvLoiHduLmeb@lbTjk@yjG"),
+	usState("California", "}n`jEvrhxUzlGcrIvh@l|JinJfiO"),
+	usState("California", "y|~jExqjrU{mHl{Oic@c}KpmLqp_@|kRmtKmyAhb^{`QfmC"),
+	usState("California", "}{vnEzbu{UbpEzPb~Be_LtgGkgAzjHrpZ}aTlaZ"),
+	usState("California", "quxnErxo}UxoDwaFmmBroZg{FysN"),
+	usState("California", "yptnE|qpxUj`E`{DpyEpiq@m`CrbJufIlRmlDv{IrZ_}`@pfG_sWqcGqiL"),
+	usState("California", "ujamEvuspUfbHlnCo}Dv|@"),
+	usState("New Mexico", "up}`Ejr_sSg@bdNl}vA}@HzraDs{ra@_D?my{c@pq`BoBxBtdGznmZxqCtGrm}TrhBwCt`FabC~rGoq@zlBgm@~}D}xCpm@nm@ngAak@ru@ux@va@wfAtKouAjm@}pA`eG{dHgUrdA"),
+	usState("Texas", "sd_vDfav{Pvua@|eSvbOedKp_EmbBplBdbBvZndk@|ui@`{jBptO~dTmsAdmDidOgoP__KwmVeJq|MoyFwrBlaFzfx@ko_@mzO{wOfaGp@feM|tUjpLt`A~nGcmQf|XleFvhAxkFghNl}D~yD~nF_bErnKfuFt}GwtUveD~dJphHkiCu}Be_D~iLexQmKbfLjxJpuCaSxkFfc[vf_@stEntI`{^mgA`~]bxg@paWpvl@ttMzjcAthKwhA_pP}cj@lck@tq`BscWaul@ieExPrgJxrm@}uF_oPaP`jNg_KcjDlLdcMtlIntOulIvtGkaGgj@irEn_K`xIc_CiT{eDfjL`wBpiBoeHfiHbcWerBumHinFnM|nCviF{gEjnA_N`eJstK~sCkmBdcMlm\\_gGviLepUjaGvrIqvCcnMvmJsrLdpU~xo@meBtuGynKdcAerNrlOxfCz{BnfHs`Hq]lcNjjOujKbsPjFrwPzbR`vCbtKixYexE`jHlqHl~NltAke@veHqmLse@zxIlxQq`@|hKb|CmbBhpJbwJl{DejKjsFq_@}wKqkG{nHc_MfwBkrAvlKdg@laf@pq]`iArmHqm@c_CweH`xIre@tqp@`lFssEkaA}xQ`uPguBtwJm|TbieApr\\fvGzmXelIytNuj@nnDwtHgNxoRt~Zqz_@fc[`_XwbOlmC`|Ati@{rFvzCtmEciAzjMthKk|h@isDwaYjqX`{BzfEpeIjkNklBnoDf`OdtMckBj_GipKpwB~hAubGjxIpbK|sAl_HanLtqw@cpMgcCzpAziDz~Fz`ActEdvKfy@`jHgdN|wEvi@eXmeF|{IlaCtaC_zG~sZy_@lgE}fRb_CzwEvdNh`@|u@saBcc@_[orOmrNdsRqxAju@|Ih_@lyKwPtkHvPdrAvdBvPdaBhrB~g@xpCaeAdlB~{ArlCdb@~Wx_Gc}@vxBnB~r@r}BwPt}@guAjk@__@hi@]pp@xaA|KfVxYsTvcCcyAl_Ay`Bza@em@lj@iTzlFsiBdsEgeGtwGugAbuDwiG~oD_sAptB_r@brB{WjzBi^z`PiUnrAg_BpK}^`zB?`{Mfj@xkK}i@d}LjeCnfIyZp{@yvC~q@eXtzBleApeHuHtxEs~@~iFc`F`eNsmHtqF}@tdDiTzfA_~@?g~Bqp@qk@`mDufBzdEkr@`lDjqB~aBmnCp_CwmB~tJevAfsDz~BhoJoNzpAevCtdEuR|hBi^rK}sA}nAqv@b}@oYnhATbfD_hB{WqjAl`AayBfvE}~B`_C}@`w@nd@~zAqb@bdAlf@`f@|Idt@kq@`xBxYrmCmwCleFdvAv_B{`Bvu@{a@ju@qBtuCzm@hrCsJ|c@uhApfAjHloFaQtvBg_BxaCaeAnNseFcyBmoAfLknDzfAssAvjAu{A~uH}qC`g@c{Kp|@mtSlzFazPfgDmaRjiPciFnrHecAbYsdEdNmp@zg@s_@f}CcdAb~A_nDrgCwaGqjCcx@rKolB|cA_}BgLceKsqBcxBvKmnB|i@keBhcA}~AhxAii@hxAmxApe@gtAjxEekDqsCadPeyCicG?okCxm@eWfp@yDf_ByZhc@{kMkMu{Axo@mdBb|BgtAtgFcZhd@iwBbjAiZ~|@nrAnoEbAzeAueDd{Bh@t}EeoDxlCyZln@q|Df|@mtAhtEabDhaCq`ArnAeZtyC{`@HgfAxhAgdAbsBi_G?av@hn@q_AviBceAnSi`D_mA{[xNkg@l_ByoEdnEg{A~{@uzAefAym@tlAox@h_F}~AvyAymA`z@yzDrsAcrBoW}Kdm@cwCxjDmiGjfAafB~qByrGhcRmP`eBoc@ts@e~E~r@si@hxEsbApjBogBfjBowBxx@yqBag@gdBzBy{InrGmzEpw@ocF`\\syBbwBqdAvFem@i_@stAetD}xAtaGilAh}Bs~BrsAszEeCqz@bZovCtvG_kBaGegG~bFqjCnmAszQ|{C}|Cf_Bwu@zaAmAvyAoR~g@mk@tT_mAwf@co@`Qo}@|}BayBts@w}ClvDsrCzM{yA`eAaeCew@eaCdW{vFlxBqhJ|t@ssAvq@om@tmEmiAbo@{mFvhImsAnn@qdCaq@avEvbCgzA`pA{pAt{Ba{BviHqmDziDsrB|qC}uDbeMexAd`Ci{BdwAshGnm@wiB~q@okDf}QgtAzrDyfDbmDmT~dCox@~|@mtAbV{xDmu@cbDvFilAd|ArNjzCdlA~yCltAtkBgV|dAyaIidAo^lu@l@jdD_qA~dCc~Hp_D}uEzoCqoAfPcnAiY|j@nvBfAvgCk]|z@kpAbi@eO~s@hd@bxBpyB~cE}^vbC}|DixAk{@tS}i@jl@sLpl@tqC~m@v~@hcAt]`_B~c@rcIi}Blu^{a@xs@q`AvQS~h@xi@dfA?lpEmk@jyDnlArqCpL`tBaeBnjDyX~}AfpA~`Bkc@teDpv@boQ}sA|aFgv@ngA_fCdaHwQr`Fme@jtAupBf]pWznB_{BnaDm[ttBh^tx@jqBpz@tf@rqCtg@xm@z`Hhy@dtExaDom@liT~Mro@nlAjq@fjAp_BqFhhAc_Ap[sIfWpsClsIfUllB?pvGbj@hn@jiD~h@rxGdtD|uAf[vEnYb_Hn`DldAnqBfnA}a@xo@xhAvsAc_@jvA`{A`lM|eE|rBnhAftAzKhlCme@biAh@nvB|_A~qBmp@p[pv@zL~qBnaBdpB`tDdNzpFzuAfkA|@lz@in@nvAevCfeAix@jv@dmF~zDrzGdr@|_@ubAziCdaBlsBb|Jh_FvyCrlHve@jr@xiDhbAcN|VbNfy@rvEh[bgDvsA~eAr|C}^nsF_rAptI`FttJco@zzA}bCcf@pm@`_CklBvjAhJtw@|z@jv@be@dgAu^|yAutDbfFcvChvIqvG`_IwhCviAoc@ht@?dxEeuBzePixAlvDmzEvdH_j@`dCaa@lj@_jC{r@k}@`]ol@|y@ah@zuNygA~nKuiD|wP}sBnmFobHtkHc|AxuEcdBrgCahEjlEi~EbnBqtC`qFkoB|cBaf@z~CnB`mDpr@vnBysA|`AsaIrpQevC`eAajAnwAwsDjfH}sArwAy|@jY_wCzKsmGxvCafGpv@e}AdrAekAbmBiqBlrBkvI|nBg}BftB_|Apk@ewBfiBotE?svIlsBetCnNu{BwQe_FevAunC_YmaFiJclC`Q{_BhxAg}EbxBir@ny@qkA~yCu}LbuFk{AxuCiyDp`@uh@nd@}i@~qAapBdaB_fDeDu|@|L_rAh|@ycA|LmvCqm@__@rV}s@hgBm_G|i@olBjgAi{D|zDycAbe@}T~h@uh@rcGev@vf@ghErh@g~AnnAaqAvxBcx@jeC}`AviFukH~aHnm@ti@mnA`oAyDxn@obAhJaf@v[ii@`nDmyA`{@wo@bwCldA`{Ap@r^yXf`AueBnlBmwCdeJah@`wDox@nlAakGbuFypI`zN}zEdtEecAh|CyvA~{@{|EvoAowBzkAohEliIevClvCa|@nvCygGlyMmnAj{@kqBrj@obNdaNcmAppB__@fhB}T^}hBb`Ey`DvtAssBlxBcaDboGs_@peBoN|{Ckf@~uBaoB`tEguBxtKylAl~@siBjxEih@pe@e`Ctm@}~A`|@wiG`fGolBdgA}jLlaDspEzuBg|EzhDipD~nEomAjgFhTdsGyWzyBekItwJkm@|pAuKnuAwa@vfAc~BvdBqm@om@_~D|xC{lBfm@_sGnq@u`F`bCshBvCuGsm}T{nmZyqCu@gupQlzvJkRwg@q{J~q@oxCjTmiChgZ{za@~`FeiEz\\}rBj]gyJk]cqBkaBqrBsi@smBbb@slHpwCooJxKmrCod@g}Ao~Aaq@evHkd@ieAw}@vAu|AjcAsrB|}B{hAxj@iu@xlAshJfqHc}CnmCq_EplBikBpzB|n@lqIeOlcBsXrgA_jB`V}jCkIoaHdMy_C`jAwhJogAclIbYekCz~FqtMhrDul[ba@qjA_oCaaL{UqkCze@evGz`@oeAjtCccBboAayBniEwoEdm@i}AWowHeV{uCiqGqhEmzAcaBad@g}CfxA{bYyRg_EkwAcdHyRq}C`fA_bBb~Ib\\lcC}_@vmEkInbBykFds@wqHj_Ay|EyKm}@hp@}l@x_AeKlhA|e@naAuh@h{@W~cIhpAr|@i|@p}@olCzfAqiGyf@qpG}zBwuFebMewOsqCuyEycAytF``BikFlhBuv@|fFdDpsBiw@`m@o{A{U{pKzSolCvk@c{AjoAaq@nqG}u@ns@ccBcUisKuRueAqtAwlCcgCucBkv@ebBzNgjA|{B{eDqrG}`Ew`AkvCljBasCleAso@~lAsOddBv\\v|CfuBvhBpYdvCi^ldDwrAxkCuyBxlAoqCaEmdEodB{sB{~Ckv@ouDgMmbAiZoy@wvBuk@mZq}@rVqhAt~Aq_BtGjq@m|E_^kiCygB{_BwiDu`B{gFcHwn@_l@k[ucAy_@stEtGupB|^ctApy@chAviHs~AxfCooCnOehBeQ_qGvpCscGtIgyBqm@qeFoeA{dAo~Es`Bit@uf@cc@az@dOwsC`t@wyCdpAkcAtrCjnAr`AiH`vBivBz`Ai`G|y@ygCnY{`@txCaz@zSon@uRkfLtR_qBnsCkqCpgFwpDlsCmyDyeBgkDaaC{b@k|AlAcw@uf@wQmbD~WgiGgIwoBs|@stBuhHyjDyj@w{@ctAaaGiHqvK_kAcrE{lByrE`Bmk@whBo|Ace@qmAzNm_A~v@kaAb{Dq`JlNyfAwWonDkfAsiAaMqp@~fAmbAlLaj@cg@ucA}nDvYcg@kj@?uiJkqAueB}|BalHv`@avCivBcrA{t@ifAxXeqA`kCmeFv_B_p@pxAnz@hc@_nA~@mdEfxAys@oq@w_B~PmjBffAuoArDkmQme@_xBccCad@|c@woBcmAujDwp@{d@ha@mgAacAm`@_mBcHa|Aee@onAkuBeMueBdtBm{Kle@oeAzsBa{Atf@wbE`}@{lBphDufEnx@caB|X__BxDcxE~f@s}An_Aww@v}AgNj~AizCpmDg{Bt~DoiFt{@kxKxnAobInqCebB`m@}kB~cAcxA{b@krB|Ju`C`{@e|C~s@qn@kVcbDhJydCpy@vg@f{B}bK{i@?ln@opBr_@}iHlsFoiBfwA{qBbe@iwCcSu{Cze@?|~@fmAp{@uk@hSwqBuv@ccCvy@q|Bsu@{_AobBqk@kjAw~@cA}hBhaCelHy_@wtA_{@opAa]_}AxfBsrE~YmxBoDu`C_p@_qB`pB_tDfz@cyC`biBud@rxdEjKxcAmr@~xCegFd`FgtCrzB_cG`rCgoDjqDakChfEubBriE}_D|qJgqAbnCasAt_E`i@fbFxQ~nCt{@huCqSreDatBd~CkfDreAgwBft@m{D`j@aq@f`AlNxwAdmBjkBcUpk@wi@j}@_cDz{Ge{AjaDq}CxaAo`B~zDp~BfoA|QtaAoKpaAwn@vkEwwF~}Cux@pmAws@~eE_b@|u@q\\rc@cdA{YwnClWcoBdeB{`@xuG~Pn|@lLnsApy@hdAlLtgF}eDtaAwvAxk@oX`~@~Vf\\vp@js@xbFr`A|yAzlC}s@fdGk`Et|Apo@p|B~tCp_ApW~`Gqm@p`CbJ~gBlb@hhJbaFhiHfr@bxAb{@jrChtEn`Brs@xvJpeB`]~yBlmAj~AvlB|u@bzBbAfxAmtA~~B?pkFetA|rBpPdcKr~GtwBdTbrG}Vb_Bgk@|eDuxDnkC}\\`_GhxAvbEixAblEub@nbBmCpnCbg@hzBroArx@`IbjAao@niJ~nJfaFpjCeFl}B"),
+	usState("Texas", "u`k_Dl`gpQvo]suBy~bAnyN_|eAfxY}mx@xm@gcOumDf}t@{C`da@woE"),
+	usState("Texas", "wowhDl`ooQrd}@p|b@h~q@v_Qbf@x}Gi_lAsw\\ybFlw@xeDsmH"),
+	usState("Texas", "edujDn|hnQlv@}xExwHn\\txi@~~b@knH}[ehQu~McaB`yB"),
+	usState("Texas", "cqhlDfppkQte@otCtkJrpAd~Z`yv@riUj_Y{vUgnDw_Dy_RqbWgde@o[i_M"),
+	usState("Texas", "y~crDfluaQpzBupP`un@xqkA"),
+	usState("Alaska", "{jvsIpabyWnxMxmLzsQafHpzSd_C||T}mP|mPim@`_Km{JhgOdlVdx@th@jaAraArmBn~Chkd@xxZ`}Wb~d@fkFd{PlYxvCwrH{oGyB`vC|{O|tQin@djFqwCb~@yiBs{H}f]rqMr|YofB?b_Gn|KpvIyo@bvEm_IlgLmdS|kDabEyiCeaHjtLe|IqpFudGkyUbiCmcY{~o@epq@trGrwPjfPdhKz_Sxe[_LhbEyiHr{Bf_Krfc@{iJviLcxIwl@yrQs}XcRq~{@qcE~bJosCkpAfjF|sLnNjnYmu{@leAgoJjtEyeDe_CmpMltMkjDkbG{p@jdQe{\\rvb@m|O{t\\?rtEatIymLbs\\f{_A_sHddMxbWpx}@liDd|a@xsEouYfnDgwAdgLvuGpAdlJn}JssFhoL`iIymLzbWphNgtMvnJyBzvDvmEyoKry`@oid@duX~oEkeRsoN`Es|Ao}V_qDvwFckHiwHc|HdaCkkDa|S}dc@rqAipFyc\\phBiwl@cnGqqPhkBdhWkkDllx@k_YzbMgxAtib@exP~fG{gJ~sZazHh`@}zJiyGf~E~p`@{gDtjKqnG}pBG}wKypEjpMerRwcVoz@{hImg@rrFbqLxtUqsPbdo@ywY`k^lqAsc]kxOdfGufF_yAh}LfaOksK|cw@ckJurGm}ClaHfqDho@sDjaFyeEhvCzyJf_E|aAzzHslNr{l@muF}jKo|D~eBw~DqnEbD_li@m|Hije@e{Ec~BxiHbgc@cyCvfe@ceF|eFcyCyxTwkEmv@|f@fbVmsGt_HczIqQw~Ja_c@tqBnju@m`HbdL}~N{`AmtAe_OpxYsulA~wMi_WgxAsbGqxBveNgpPfuNixYl|iAom\\re@lxJk~nAuxE`mGgxFx~fA`~LpnFczDdeGfcO?cRjmMu~[tx\\ugEezOncHyhOwvM{hCsmAjyO{gEfz@{dQgcOd_CpxMnrDgz@n~Jf_KpoEedApwCdwKiSruKe_OhmXk_G|pAwwA}iDqmLx~FcjPa{Bw}D}lOcdQbiCavCshI{sKceEihDu}[xzBd_g@hvIeKdlDldRhvIs{Bp`KziPbhJa_GznGhdJq}@jjOygZbaq@mkDzlq@axTzeDsad@pvg@ziDoxM{b_@dxE`gDnwMrzIaBovSzbW}`I}~Bu`l@lvR{h[hyXu_f@msAne`@~{KwvBffZnuSa}d@lwWaeIugF`{LixNvwGm}ChnXl_[m|a@`|XrbAhpFktKxkFp{@nvSqmRjbGvz@ztQweNziCvkKb|_@uuWvgYucHnk@nkFrtKpR|~BvbIcvJd_g@umg@ngYdrBruDloIwuH~kF|sAagIhe\\`xGz`v@}~NopE?jpMwi@{~Rc}MwOooEtsGu~BqmLikAf}Kuaf@hoc@qmLgc[unCcs]koBvhd@z~BstEj_GjxUg_K}sAecS|~N_jAvhGfdOoeB|hEjjOmbAi{SdpNunBj~LhzF`cEzqL{zIhv`@c{Ba_Wc_Abci@egLlkEg~K{`BxwIt~NsmTr|Yd|UggS~eBj|Ccia@h{lAuHtuAh`@trAzr@tZ~fAyDh`@{nAfvSor[`}@`jTdxQjxUcbLyjY|bAg{\\vhG{bGctEm{J~_Dcs\\joHkaSvmDjxIkmHrmTx~FoxMpm@jcGntCkeQpzGei@~mEahNurN~qIhgJyc\\vcNtwb@vbC{zH_lEsmMt}C_jMqmF`vC~x@qxHgyFmu@jxIsmTltAdmDjiDipEnMesH`aKtvLqm@qtUnfGc}BjyOq}ExqD~}D|aHbbi@obHiAeqHrxItiEz~Aw~Kvx^`gJab@teAd{JbgDaxNapF_k@be@gjKrgDetG`iIp]wrI|xo@~wAjaF|~NktQ|wEtgFxO~}KenHlbHkdFqtEobCdq`@i{ByfCi_F~mElkEdxD{}HpeJxb@|d_@cgh@xkdBkqGw`b@siCpb@zrF`rh@{jIbtEqjTnfj@_bI_KudMbwJyk^dhz@gfHd|}@rSecSkaLshJbzCj`e@rxCurAy{X~qcAwwGfxj@vi@mmPmxEz{UapEdf@n~DvlKufFbzZgpD~}JpqEacVkqAa_LaiBjmZucYv|kAue@ybWufGqtI`hCisE}uXge_@wgLt_O_`VvoJudSc{[tdXky_@xd[leFtiR}qMd_CpxMryCkkIyyG_lKitVnzKamGqsDkjHpiBkqActn@pqEwcZcmHjqX_hBxch@ky`@z`v@dsG`pJpeIn]~nE`tVz~Rd}ZnaVto{AkhDt|tAy_]v_fCswErUeqAqyG|iD|bG~Na~FkzIwn[ijMpB}hFnfG?wmDqgHb`RooDuJhaEpoRlkPgpOt{Jnxu@yaLrvx@mmOr~`DbhKnl}CxfIfj{@m{Exs_Aqm@ego@}lUnyo@{}Hu}I`vDvfp@mxFlfGd_DpuN{sE|{Upm@mmHwmDd_CifJrjTuWlp`@smHitI{fE~}Cs}AmpMeeB|lC}`HusLkuEhn@hvDhuIc`BprEg{FixIsu[y{LbnAilRu}BqmGcdFn]fmEb`YjxU`xYp~FjtWh}QnmQdcM~j]ox@b_YgqO~d`@}]pofAwzOmr_@ouYk{ZdjJhbi@ao@p}EstEwtM?vtMmxEk_GxbK|b_@wiLmmPuY`xChjMxqy@emDzkC_aO}|l@e~IjkBd~Ix_RmbBdfLh~EryI{zBpkHmxEemD`bQvte@cbA|r^oeH|`IltAwiLg|Eu~BriC{qRgfBy}AgiA|aHyl@{jCw{Fmp}@uyDv~Dvy@s~XwaIxmXnvLxiYdJ`mG}iD_xAbvDbgWezEzxIvcDh_KovHqhByOraMmrGncIo{FzmBhyBut_@{gEhgUgkK_jFvz@m|PilGdcNyn@ccfAkoAwfHwpDrbGaq@tv_A`cc@lvfAoiAxuIajH{iHziDftMqdEogAca@|lIrqKxrKmdArrEygIrd@fn@fdOboLg~DlbHr}S}lCvqBnfA`aLqfOqmLtmDvkLqtIqgDhiAb`Ht_PnhM{mZ_{@c{B{mHk}HhmGczCmbBuf@hvHf{Ql`C~uGhhOdeAshJnqDjfDdtAw|Dn~P_]nZheOq~EebBtcAhqKkxI}iDyPjxCnaCf|Jj~AwrBv}ExjGf_Ea`KjmBdsGknBztPwoJnvGc~GjjV{cKcgQe_O{qFafVsdi@y_@diKw~JexExmp@ph}@_]beFu{ObtJbhJdnr@|sAumH~eHtiPzoAuuFuoO{tb@zbAclNtqS|cS_gBjzNj|HocIdgLnaDev@zhPnfGkhKgbC|ed@hiHpeb@qm@sqp@tgFro^zkN~`Qnz@iaFwbOc}YclCsf]lxEgtAzzBr~FtxQtmDgc[c|e@vqIpvCnpPukJxePllZccGvq\\flWzif@ekPa|i@dvFccXnaDvi@gxAs_MbqGcoA}f@_cDstKkTo|EwtHvcIp_@nYgqCkkNs{CcxCsgJ`_EokFlxEtbCus@awI~yEwcIxzHrzCrEepHpbJ~uRikAbl[~}JexFosCz{Td}Dh_@zDajG~vHhtCrqF|kP}rBd{J|pHk|BnvGbbMc~Mgli@`~Ai`FlpAdtMtbCetMjxIr~Ba|MomLdeAc_GryObhPvm@hpPg|Evl@om@veHpxTpuIxbD`wIvgBi_Gl~IvoEegMnrTrfKlJlaHzaR_kExyBnrB~}g@i{F~wMlu@ho]{jCxyA__KgcOa`@xpKvuHx}QxbQfaEz~FhtIw~VzbGixAktEkaAziHkjPvfC{lAj}Gf{Jh~EtwK{yIxzBhxIh{FltAlmDntSxyNhpKddG{ePxmLkpA{iDpiTkiDa_GowGl`N{qQygCwbIjqMlhWr`G{r@p}R~~JwiR|hWqmAczU`|^k}BryTvrC|v@riJ}fWnmPfxQsaM~xSlsSicE`o@tqThvIecCeJ`tJx_Frt@lmDr_SmmDn{Ee_CsmHmmDd_CorCgcIml[kmNvpCfsIp{j@fef@gjLplMmvKe{JrmH|b_@htIgxMdxE`xIxwCshD~eBthVvwFnaDz{I~y^rnLu_DdoC`mMf_Eol@mgQrkOwcCxff@ja@lyDvyA}`B~sHg|]tzDrcAc`Gnmy@h_FloTxm@yeHpiB~eBlLnkWxfCq}G|t@zz`@qcJpgCbU~iXgjFrWsvE~nP_}Jg`K?smTgoJxkNwgMshn@b`Gkts@}jKbpLquCq}@egL{es@{_GdiDs|Ysvg@ym@`vU~nVrz}@v}DbcGvmDacK_oOnigAydJlwPufFjdAwpm@}y]ykd@ytx@c}ZeuF}jDumOei\\}oCmf_@zdXq_Fq{AyfH_q~@mrZme{@qpVknhAtnT{q^r~Bomb@gcJyxw@rgVi_mCy_Qt_[dqAvyc@cxTx`rA}pTbov@k{HgbIu_DsjVqkRitO}gLgzWqmRuotAwwAjtb@xtJth_A~_O`jZhpRjbGtzCfwGw{Epq_A~~Db}BcbAnf_@sbKn|Ja|Gm~BleFtwJfkFm`BixBbaMjvJbmMh}Mfj{@zbWnxYhzGjmbAt|P`zj@|nNvqJhyTapQooEh}]t~Bqm@lxKh|c@jtKjiJvrUj_j@bzItf@b_GqqPfmFtzVrvJ`jHhjMfbf@sbAp~c@c~@|sGmtAqbKoz@fbI`iBl_IadJh{Atu@nqa@zlCfbC_hB{u[faJyrEjbLyxj@tjPelV~i_@zkZdkIbmeBfhGnvGti@agw@|bSbxI`dKllb@myEn`IveH`_GmtAdxQi}Lg}KxRudFetMzmXhyMyk@x_L`zG~xA~iLasJvlKfwFrF|qCvbO|lCklZldGj~BfcCbxw@jnHmqYbeEgeBf`D`fDfpJf|kAflE{lNcpA}_Ut_Jp}]bdFfoEjvJ_yAltFbyUpm@{iDf`EljEniAyxNriOb_B}pHqh_@jqAcs{@prJybP|u@ouYplLgk]neIkdFpm@niLp_@_gSfeC~fS|bSn_KlmIpq\\baH|jEllDpww@ryNrcEjUnhG|sAyiHb`BfhB~}Cpy_@reL{`GpqDktKj_Ctgc@xwMn`a@jxDmeAo}Kwnb@teGywHhhCrtWtqBgaOr`GtlC|JbiUz~Fy~KxsDtcAa~E`sPfrNjaBqrVfrZ~bUqvC__E|oZ~sHevK|iF|_CeKriNkeFb|CjcL`hTe_Crun@|iK`{Fjb@paK~qJaaJpiB`lc@xmF_mDtv@|lTlxEuiD?rmH~oCs~GlxEeJvzAtlOo~Kxch@|yKppEl}HyiHn_Ezgb@zlBugFdtMpm@|XpoK{dEd`DblH`bQyzGvxQxyGy^?nmPpvCsbKz|Hc_CxbDhoFs~G`a]rtF`vCbiUtvz@j_Gp}@inEww^xQqhHxdDmZhnExkBxiCpqPdcGexQnxGryCzvDr}T|iDexEupA|bGvnIdmDqnEb_S|~Bj{BfxMmtQg}Kt{l@`dJodChtOr~GetL`t\\`cKs~BliDnkR~~J|`IatCxkR|vEylGzqFzbGmxPd~k@hoF|oHlvKkcK?jaS|bG~yQveHukVpeEg~D|mCtjD|v@npb@egMncTt}DjmZhkStyh@ayBsoq@hgImgEhnFpzFioFjto@rzB|bGpCa`Fn~D|f@y|@lcf@xsDl`NpvN|`Td_C}sAxcE`oRhmGzcFfaCqiTgtHnpCsnGabP`uCw{Kb_Cpm@{iDymLlcGqxYthFhoKz_AraXbtEltAm_BiyGhjGc}H__Kqye@xiTl|m@}oI`wH`iHn\\?jiDvtI{sE}oC|tQdzDkqExrDz|A?{zHhbFug@w`EplLrbGtdB}wEvnJ{iD__Kzb@~nQaxBm`DqsDzlCcwPm{VcaAni^jtEgtMpxI|bSgoExBeHjtErvOa`F|dB~zKfpDisOnmJ`_FsThxNgxAmxEkeEdkDlpB~tG_pCdlJp~PfmLmcApfNbrF~cCg|Eh_MhmL~yi@c|BvgFb|BlvG`nLvbCk_GnpIimLme@zaAnvM`~VkaG~yc@`_W|dAvmQy]riCqpE{iHvi@h_KccFkiZuiEjbQipEc|MccGjmHetLg`JyoFjaj@tzJ|cAwmD|kVz~F{Tc{B|tKziDz~BtpGc{Cfk@h_LroBmxEg|EpqPxbKyfCjkB||DbaA~hu@h_GixMliDpqPv~PjqFiQjlI{vUztKtmItqGdh@~rTvqCsbQxdJbq@xgBnqUe|CvtHi{Giq@qBblO|{PrrRldMtsp@}}DvnP~eCb}YysVkjDkfQcyT`_G_vMnMqw[}uRrlr@lnCxoh@hoRrtQ|kV?jga@lic@{mBn`[~~JmtArmHixMdjEfvG_|@|vW{tKafDxwIniXnfMisDr}BdeM}`IvwGzvExjGcVl}OqnFzcKq`Frc@vy@kr]}uLjhV_{PtbNaTboGigCapE{o@veNf`OfgMlaIgjSruDowAxAjhEtxKbaAym@goDl|UotImgFxuOepB`lBjD{qG_hFv}DnbTldSbd@lkWulI`pPkkDqiHmoNjvVv@|yVqeDcxI}rAdtMfdHxm@spAxiHxeDktEpsJdGr|Ge_ZpcEskBbpX||w@qiHjmHqtIsmTsiDbfLcuDgzRc}Bp`FugX`gI{rWio{@xRapE`aKxsOvgAsjUmxJcaX}gFrfKusG{b^kpL{qQrtEslIemDuyPi~Knk@ug@rhI~xFpqKmnCggBcjs@ywhA{md@wkrAwzWc||Bx~Jfmh@vyAak|@gdIu~a@lmJotAiQzeVvbHlrGbcAinQhtIbmBrmTcx[qlG_`E|yJcjNgyEclDclIvoQwyR~lDzmBcet@joMadI{~AwoJjtEmx]smH~wMciDmiLyxMrq|@w~IshDg_@z}Fa_We|UitJ_k@alSw}O}nTadb@sqd@uouAytUmzgB{pA`tIslGomKy|Yes{@lfMlpYnmDiyArWmwE{iJ_aEtmImwQd_De{g@yqV~sHqzYoj@iex@megAoeH_jXw^uiWmtA`_GoeSc|YosNawf@kr@}`NtjPotC~aCcmZuiDc_SsmCwsBotB|hEexE_|E~wAzfKhtIa_GlY~mb@kpXdi@zc@dsLcvEflFglwAikSoxLqbKfk@g}PqnFkdLzpFuxn@oyLfsg@|u@rkY{lGbgK_rPfgBgz]arWswa@yt}@ykFu`a@veAhsVyaEtcBgcOgxMwqCdpCyqPmrHg{FysUkjTalB_dIctJ|qG|~N|gRzxChoFbsWhaP~kLnmt@bpyEypEd~TwsT`qKuqHpta@sxZmlJ_pDigNghBj|MwuG{hUt~Cqbh@~~I_lQddNsmHquDmxEyRj~GkuJtcAktKpdPk|Htzy@}iPb_Sp_W}~Nr_B~oJ`jSxcPhsJ~oVihBhtCanFkwCexEh_KacEmrGrmBzkNv{KsxIfrZjoBhpE~xFgk@~jKu_Iw~OsyCtmCbrGvaPvdq@yf[vbDg~JxaP`sVd_@ldIy{Cpb`@koBj~BotAy~FceKvnVmhm@ful@qwJdbRybEnlLvp@bkTchLkmMctC|}DdR~pVjaPl{Agw@z{GttHyU|xEnvTgfTxwP~nAvm]quIsDnuD~kQyqJz~@~\\tbMmpHilMguNp}Q|_a@`ipAfkOrvKsyFeZqcEzpJpxRtzw@_zDfuW`uCf[tiAeiHxpP|bUb^isM~pVzinAdJheJitIqtIiaEbvIbqIwLqhElxEofCx{hAqlFwkXruDeg]alFslH{xCblC}dJcvc@ylEigCyjAxuDnkHzpPy{IwcBe}[xaFiqJt{JkGqoXwrNan]{hDplLpfCnqf@nbD`jH|lDc_GktK|vWez]rrKgrCy{FveHxzGloDmwEedB_aDudYccFaeUcml@ephA~yfAo`PpgD{wJvvRaqK|{D_lKccFukH`jHm|AqpPcsDgmAf}D`oRmlDjaGpbKx|@byEe}LhjEdhPojKtgJoiGaoEuzMvyU{uEshLsrZeaPimFgwM{kNkT}iKgx^p`@_lQquCyuCui@|~B}iD}~NzpF~fd@_{KftTdgFi_KfzElIj~G~z\\zja@fvStsAlnJj_G}|Cp}@dpHorCwAxbK~b[|~B}bGti@jtEt~B_fHr{Clf@m[t~ZjqMqlp@ffMnhH|wKc_CrxIzmHqm@tbk@jdFzEn_DxwIphO|ru@hsDfuk@~~DdolBktElej@khJbiPukK||C}lCm|UmtArbG}sAwfCu}O`ey@onJnwMgnEqQkrKp}]ivI~nE__EmmDtcH`kSysJ~hp@quJrsQuqGldGuqBkhVm`IkyHadDfiAxiH`cm@{pA|{[{~Ff_Ko|]_ksAwwMzgEaaKqp]k~FrcLucH_]vhLs`SaxGmce@nmJziJxsDwpDnkFoxlAzaRyfIdmDhzRppQ|gWrpAytCwiRim^i`AugWyyLk|H_zGccXryCifZx|Ld`BtvAuzCv{DbvD`|Gs{CdY_jWu}Oqkj@q{FuzE_|EvhG?sfKigJnhYgvGfpjAokFcvV`vCyvTmaB{gVsuDy{AsfEdtd@mbHe}Oqo@bvE~dCtvQ`tU|lg@`n@pzkBgdNnlKdyDnb`@avI|~Nv|Fjv]{yCfdY{cFdlD}yEauNeh@}t_@ynKebA{yC{{`@ke@tml@z~G~\\bc@xtIyjM~yXm_OymXc_GfcOb_Gm{J`lBtnIxyGt`Cte@ptIgjKfp\\ofIsh\\a`KgZyn@~`IybEa}RkiI`EqCwbOuuLgeTwy@ssWc{IuiQeuE`_@ylAybKavCfoEyR~gGj{Be_CndO|uX`vHxsg@zsFeh@zfDhoWjiDetAbnH|bShiBmvKdtGiRbnH|b_@btDny@brFukIrkDv{DjfJyPsiI~dl@g}Q`oFgzKopE_sHnbs@ogNrbGimG{~YmhJqRs`Hhu[dzDbr^~cDqbEpl@vbIewQ|hQsqXczIhmLf{@j_C_jFwtIqjcAurGzgm@syOflRk_GwncBclHtvRinMn|Jk`Gdx@swPepIi}lAeynBsjCkxr@rvJkzB~{Fqvm@~mJ|jWogHis\\arD~[ayB`uPsqFweHbtEntMmtA|sY{~FoeHezDflKkpH_}\\}`Ycak@xpWjg`Acq_@z~MixAacW_{FjxUufc@sqd@bpA_rhAm_J|fKknBnp[edGbcM{kNuo`@kkImiu@pzG{gpArhJwcO~gG~hBykAswV`{Sfzo@p~Eh|A{nXoju@rwOqwa@ydDobq@kiOish@ijNkpMadJo~g@onh@ay|@kfJzvDtzJc|w@htItm\\atDegmCqzGetp@imL_jXodZyxk@erT{vJgwj@jr^mof@fvGaeUhzk@k{B{iD_cCl_OkpAymL}sAhqs@zsEpxN}|ToyLkkDoqIbyDe_iAk}B}}DsxCb|Ncf@q~WwlJefWoeT}gGg|QrmTk_Szqp@h}@}kPivCgvMqo@bvDhzAded@zmCsTvjFdsRxsOvbPh_Fvk\\c`Mz{q@dmDiqPr~Fhcb@t{En|EoiGgyXvtHlvQcF|dk@|sAaxIldA~sTpmLhcQ|yJhan@fcItvQb}Tv|Eb|HtaZwsUbfQc_GweHaxItbk@otAemDagJtzVqaElGv~Jv_n@|~Nufo@ue@}bS~qTjsW_cO|cYkeQd~hA~gB`xiC{e@__WgxAvwMn|Qp|mA_nDmmPq~@lzEvwFh{d@cmGxdIliDjiDdxEqmLrpAtmDe_Cmx]bfEnps@ovYrl`FahIbhb@w|P~aUicMiRi}ColQ}hDruC{qGu{B}iOnnx@q_KzfD{oJbt_@uaIfeOqsC{XohSeaN|XujDphTtnNl|Iozi@cxCmza@_cI{tKgxGxxBanF}|U|vDslaAeiGriT_{H|weAepHz`tAbkJgiw@coMxeqCk}a@xu~BoiLjkNwnJssJcmHo_d@xgNlba@baGghBqhHw{b@sdFioEsc@{|w@utFuwE{qAjfKw{DnJaDgcTu~BviLwjLyea@mh@cgJvdHerSixBo_WsoLewb@{iDdxQq~FoxYriDh_G?e_Oc{Bd_CunIatPqgU}zoA|t@ot`@fyAgsH?ftMrgD_xLb|B}caAwdH_xp@_iMr_hAokF{EmwJgxSgcUi~xAcs@idf@j~Gy_M}eLuiPmmP_gk@c~GituAkFwahB`pEm_TmaBtzy@laBiaWdsG}tGn{FlXbyOdfX`cK?piH{~F~_JfpT~vFldk@gtA_cg@fqCcr@shDcvJ|_NseKxnGevQgm@s`nAjiDmml@y~Fo|eArbG_xMdjKkd@{fEkiCejE_kc@n|E_vN_{Fsvg@fpEuor@|iDf_Kwi@ymLjtEhxAxm@i{R_uAemD}sAftIghButAgpIqjSg^|{IgxLkfVetRu{OavCylM|Umn[`jHw~J}nCoud@pxBqgC~tCv}D~iLqm@fxAhxM?omPixUmxQg}PrxU{aF`s[yaFxq}A~zFle^hoFtv@haEs~GvgBfvGi}LdvGgpP_wN}mOplAsub@`x}@giGfqx@cg\\leY_bCieV_xFwzExkFefLg}DmqTxt[ugc@d|CqiSvkQ`~KpqEyjBprVube@rnMshm@s`Am_f@muLk{k@hz_@wutAccGkzgB_mVgy@ueM~vScvDap@wgA{mMmv@pcE`|@toNhdDviAocBhcVzmGz`Lc{Nzh_Ad_Chyp@dpIlwRxvI|wEdf@tsj@sxDzbLkdEudBa|AdeS}zVngp@}nC}wE_aJbrAurFouNq}G|e@wqC}bMo}DkTok@gnh@c`AqxBc~BxcDglK`p{@vxFpsoA}{InzFjaGjyCd`Cs{BhxAb__@wk\\cgVsjCbjCro@x{Mr~B__KdgWfcg@_nEpceAvjG{wKeuStdeDirD~|Fg|h@tkJwc[j{Y{rVrad@}`uAxbzFk~M~z~AmbS`ga@itJfvd@obIt~fBa~Lsz}@hsJ|zm@viAocOwgH_~g@brA_hNmmDzmHxm@umTuxEbxIgdZweZu_f@ugFgvS~rDpvCs}kB}fLmn`GykMwqiAqtUoxaAqmd@uu`AqsUaiT|d@wcCf_WjxUg~Pqs[guSscA__@sdEkvIerBgvHlmOe_CccWqiHz~FmgFy~WewE~tNaeh@_}|AcdLsxJ}iDltAke@qmX_nD~wAkcGemIowd@qepAnbJuof@vi@hxe@ziD}~ZrmBluRneBouBtcBj{CucBtf^zbGdxQ{bGqupAc}BfaDy{Fq_RyOgrw@gsJk}|@lmDetM}wEbtEusu@qp}Cv`D_wGupBcfb@laB{lCzcFxvC?yeDdnCpgChzGxkq@kpAktu@dc_@|ih@azGkdc@ryO{}F|iPetYimd@ptUumTg_KliDqtUwiLsupAh_Gn|qA_fZrhl@|zDvr`@c{Bnm@mmPuq|@_nPwb[}~Nw`bBb_Gvmh@{p@yze@~pDsqEz~F~f{@b~Aq}Wt_DrUptIc_SyxMn`JalAcow@umDjtQegAuzCr|CikbAyeDwuhAexEtfc@lbBez|A{wDcxa@w_g@}wzBs{l@kzoAovSsobAbhEcoRmtAjxU`{FptUrmIuuLrp@ksRvsC~z@fiAa`z@x|FueRj_GniLi{Gyl_@ftCqsDzoAm{w@fnEu_IhmLmi@t`Nzwa@v}EdieAxeDa|Qt~Bh`b@ziD{fg@|bGa_GziD~eH~yKwy@ggAqnLy|FhjBczCmxEbmAeml@mqGkqAjiCwjLybEwhG_sMqgBy}Me{g@pqEkyU}`Hh^gvGv`Qa_G{bS`_GuiPotInmDowAy}E~tCmaH~tM{VhoFriJW}kJuvQwbD}h@c`GfjRa_fAhsDke@`~Lv|KnxFhuZsa@uoT|zJskTq`@q|u@pfIakN{oAalRa|S}jm@tfA}biAs}Gsmq@ryCary@|}HarP}yDovHvzKguRszCafJexEnmPq{@muEhpEiejAttGucTpmL|ih@~{Dwz@dwAzoNv{Ezr@pkFm}JtdHgxgAmtA`iuArtEv`Ucr@}zhDbr@khDvwFbtLxEh_Lj~AmoN`hCvz\\jeEhbF`|@mseBdjKihl@mXiyGoiGbeEicDiaE_rCcnoAgaE|lDim@ics@btEctEm~B_bUbkBsnBjvKbxI_pDacy@{sLyxk@c_AojmAxkAkxCltAziDkpAyt[lbGyqy@rtFurNfx@ebv@b}Hsa_@||Huf@pc@anXucHo{]zhJm~q@ce@uiVxiBkrGdpInm@pvC{uWfvGmqkBw`Dcut@hm@yvwBt|Du{[xPg_h@iiC`n@lfSo_s@ubCsfQlnJ{t\\y_A_|\\dtGjxOdh@qdDj}Aib`Ai]gscAm}Jczf@_sCubq@~rCmxKftIfm@ybKkmHktEb_Gcs@}e~AekEo`DbzDwyF}v@yp]zcKw`Jkq@gcCmvKntC{HyrKzoSy~zBthFrbAte@ymX?tbGdaB_aDjbRock@fk@i}^`hIg_QivBanQtkIies@pyTo}m@rc@mm[swDmbH}~BrtQ`{Fkxy@hSi{@vf}w@oZhgB}e@rt@a_BxaNkavAg`@}|A}vKo|Ig`@u|A`GasCbtS_wnAcEekHwxBu|HkwReyc@cnBwnHql@u`I}^wzh@em@k_|@za@kiCnuBkbAziCb[~~e@vnThfDwEriN{nXlmBajHdoKi_r@`{AsjFhgA}nAt{N}jF`eCshB|{D_nFpncBa}_ExvAkjB|sBqjAt|h@saRpcI~pCr{ChEt{AwgCxDyoEqt@w|DmoNqqZkm^wcuAqw@wxI`g@}dUiTclJaoB_rGi}NkpOosE_qBo}`@hEvy@oaIxO_rHmz@wcHejD_xG}cGm|DmmDztAwyBn~F}fBr}HouD}|PiyGwj`AypWscuAlPcmBjbFuzJhwIef[lnCklF~nDuyDzsC_uEh~@m~Hjh@qtB|oEivI|tAcyAlbBoMfyHnSbcBpVf`B~wAhpC|_GvxBdjB|eFs_S||DlcC|~A~IdqFmaFveBkiCp_Aw}Cz}Deik@j]_sApkAsx@xuDkfAxlAcdArdFauHdaB{mDdc@}aDr@yhH`p@ycEriBaeDb~GejIh}AybAtoB{HfqGfuA`e@y|Inc@auAr_Aa{@zl@f_A`eA~OlmBgb@bnAk`Ax|JeoQpzSopcAvnBsyFvxCaxExjQ_yQ`jIglMbmO}z^rkJyyLreEvkFtbGi}AjvF{{Fz`[cyg@~eDsqCxaPqfHr|CazBb{Woz]zniAkffAr}\\mcd@na\\usXtvU|oTzcIol{@jc`@~vM`wK{`m@lo@qb@|jFbiCbcAcKjuIaeHnlBot@||BaWv`Gpa@t}@ug@nb@odDdOszSk}@_xSbEq~Cfv@otCr{C{vGbx\\mkkAz_CsiOfzAova@jiDgkNhgO_kU~{A}nGlzAczSbn@epC~wA_xAx_Lg{DbxAa|@vo@uwAlwDal^dNmfEaaBqmQ~XekCpkBmmCfpFkhEzdCinAvgCsn@prF_ZbtGbLvlBtd@bzD`eD"),
+	usState("Alaska", "srqmI`wrhXjgJycEgrC|xKqiNprPexKjoA{pA}sMfaDtcBzbAwzQ|}IqCrSlzJ"),
+	usState("Alaska", "_lhnIfp``XliDmqHz~G~sYkqKvn\\o|Lusi@bHkkD"),
+	usState("Alaska", "}x_oIptuiXy`E}iDmtAzeJivI`nAccF}aH`eJioRvbOatIjxIvbOy~Fz~FbmCOcmC|_O"),
+	usState("Alaska", "wyzlIlp}hXjiDmcByzB`n]yiH|aCqBiwCi_KjxUswEyiHfA`tPk}HxyH{xCcdAoi@rfKifDcaFwl@bhOilG{pAyyRt_O?exQotIdxQ?smHu~BrmHe_CacKy~FziD{pAotMzeR{bGrxCwiL~~Ja_GevGtgRngBbeEtmDwmPt~BjmHd_CqdPttMk}A?kmHtwEhm@tkPgcZhjHwyF|uEfzEhvC{oL"),
+	usState("Alaska", "ilvnIfrnaXu}HvwAadE~pIdmJogB|xEfcIyhCjbG_cTccG{t@gyK_oObpL{hJoyEftMm|a@z~_@kaB"),
+	usState("Alaska", "iphpI`nplXwm@m_OxwHf_E~tB`zMqgCh}KpgC|wFuoHlkDc}B{nHerGv`E{xCihU~bCglE`hHhsE"),
+	usState("Alaska", "__fqIlxpcXjjUyrb@hbFqOktEnkFj`Lz{A}qBldNtpI__K`tId_C{hJ`pQqca@tqHckI_qD"),
+	usState("Alaska", "itdqIbkomXtgFrr@pvC|yVf_Di{AlmOdfGqxM|~NghBiiH_nEepBwfA|hE}lImtM"),
+	usState("Alaska", "qmsqI~u`nXwxFekE~dHo`PptDf{@fvBpgO{~BtkP"),
+	usState("Alaska", "ktlqIdg{lXoxQd_OaoAqiSpdDifIhpFnj@"),
+	usState("Alaska", "axtqIdomnXtbGrkHe_CngNd_CxmLyrJ{dRioE`vDqiBkiDdtAqtU"),
+	usState("Alaska", "m|psIhgslX~nJl|T_dIleMjjOkT~hAhvIktQrkZ?y~FajGydDvpDedGu~Cap@p}@utLlyDwoD"),
+	usState("Alaska", "yjftIdgolXp~Fe_C?qiHpsP`sEw~JzsPqzAivHemDtbG"),
+	usState("Alaska", "sjbtI`z_u\\wmDmxQjhUbFhyFbyFcyA|uVujGvaE"),
+	usState("Alaska", "kygtIl{}qXse@wdNz~BboA|~Nfc[}|DniLuzCacKugFfcO"),
+	usState("Alaska", "g`itIzckpXaeCukKpyMmsAjUluL"),
+	usState("Alaska", "}mgrIzni~Wd`f@`|@}{CjyIvuYnqD`dPfsYovHbiVm_O{tQuiPcc@f{E~gMp`KiwBdlEtvEdtAltSm}D`pJa`FcyN_`VywTave@nsNjgDlaCfq`@c`HbmUnuYevGs~Fo`OhiHq_MiiH~`ExsOduFn{@pcPqzHnfM|zJkvIp_^ikThjYm{Ooc`@gxGg_EgaCzmNufLojAgyG}a`@a^pxTxrDzfK{uEblBcnG_gk@_jGpkH~iGnzQ}pHrxJ?omi@euAgyE}e@`jRywIdtGmiJeiy@dcGktR`mj@sdh@liJstE"),
+	usState("Alaska", "wyguIpdnnXmtA}fW}u@pcU_~Db_C{`Bcx_Ahd\\n`DixBza^rjUjkl@a~Ff_QyoWagU"),
+	usState("Alaska", "u}svIpj_hXkqFkiD_l@suVdmUi|@x~FliQ}pCchWjaGwt_@luLsoRdxEvoJe_Ct|Hxz^qkLyzF~bIlxEnkF~dBt}Tm~HjdQclIe`H_wAnqN_oDcpAyfCxg[`qDfaIu}NtfLwcOc_AmtAqmR"),
+	usState("Alaska", "ahtmInuodXtbGomDze@~~JhqP{e@i]do[evGx~Q~pDv}DwfHfgBmbGggN?d_[q~FipQyfC|zJspAyiHcxIxtUcf@stE}wErtEajHmtApzSt|IpPbwK_sHaeJ_xFz}G~mJ`sDquCzpM{lHceKsiDz~F_j@q{Swl@t{KytIa{B}eAboHxtJzjIodCvtAeaOwiLcfPvbO|fFp|A|xK_|Etg@xwMekJzaS{vK^`cKti\\ikBpvOisJwmWrd@zez@a}K~aI{rFuiJdhE}jeAa_Fjp^utFbgJ{~B{ePa~En_\\wnQajIitIec_@rkHh~aAixGp}WewFpRkr@q}FayG`|FtsAmiJ_tImqBntBanLqiMsiPsjJ{q@gk@veNa`K~eGurAuyDu~UtrHyzLoyAuiF|f{@ooO|zJeyEmeLsmHtmHhxBojt@`p@wpDbmHkDuh@ihDuyD|v@}rB{zTv{KmbGtpCu_OrmH?|kCqqEfrJzjHui@reLv~Jw~JfxAg_WmmDe_Cz_HwwF}sA{lInhGouS|~_@epk@j|Hq|Gti\\omDc_GypKpmXodObe@ofM~zK{nMxlAvjEiyH|aNweH~~b@msMliPrkIfgGbzCm_If}Plt_@btEkiDauOkmYi~@m_VzpGgwFt}B}oO`}Gzkg@w[odOv|DaaEujE}kVxiHarQ|hRqsJtcAjz[~nJqP{fE}fFfvBmkVepHekEmx@o_JdjKc{CptOftI`}AkkCv`DpbJyeDrvExeDoxAnxA|bG`vCgvGd}Hbz[ltAkqXgfH_bJqm@ecMhcK~eHz_AsmHvtH~wAvz@pzLxxGaiN"),
+	usState("Alaska", "{vfwIr}khXpiHrxIdwLebCd`Hvt`@_fTn_m@{|S}qXi~@}ej@"),
+	usState("Alaska", "{vzvIb`udXxf[axMtd@fgL~eHniBxgChpJsnGxfDapQbum@mi\\q]gvNneGrwDmjUh{SqjT"),
+	usState("Alaska", "sa`xIz_hk\\mxEu{a@byJjhP`Bdg]"),
+	usState("Alaska", "{ejxIz{r}\\taIubMgeCpo\\lmDpmd@gqJ{`M"),
+	usState("Alaska", "oilxIfdpm\\`{GyyAhQfsHkcFajBqhBbgEpp@tdG`mF`kAn{EkaGxpEhaW~qOn{WujEjjIylRmxPkcLy~^"),
+	usState("Alaska", "midxIdgmk\\rmHv{WmfAj{T{wDvmDekIi{Lq{Aeg]nrI}sMc_CntG"),
+	usState("Alaska", "}kryIjtojXokFuiF~pDwsSnki@}fv@bkI|k\\eiEdiKz{AhkBrtEccGdfAb}Hsp@zh[gnQ`qEapFq{L"),
+	usState("Alaska", "{exwIdjdpX`jHgoJhm@zfOhvCypEtgFh_GpvC}dMz|HjVlsAheCq_LfvS~dMjT~M_wL|aHedBjnBncIbjI}kDj|Id}CfrBzqFwmDnoJedMuzDcbY`lGluLr|B`b@lzEjyHakB~yA`oRpmFmmDaAemJrkHkjBziDnxEfqIotByDr_HugEgj@exE~bOk|B_fBayBbuDuqGgwF}dBhxHu_OzjBfPwwFiuIksKq{Bpz@`tEn_WimB}jE_dHxhCwi@c}HevGze@dJobJisDwKnvM}nCwfGc_IdxEctKqjSlnD{r@_mEmyK`gHxtIluFkuJ~{QmHxePsiPi|i@jgD`j^kgDrqFqzAi_KmkEkHjnBviYcjI|uFq`Wtt@ccAeuGnyLytNuvQ`gHgx@ciCfaIixe@ctQlt]naJeep@diWu`TnmPhvIxkBjvKpzAibE_xMw_WqpQms@xpFsj[vpO|iKnpCalHvoJqdDjfF|{DotHxmc@~`IotM|`IbaM"),
+	usState("Alaska", "e~k{IdyipXptOwxoBraMc`Ma|@ydDutMrbKziD{bo@`tUkxUdlJjyItq_@adJv|Ej_N_qUxca@wtNxjH_~E~_Up}RmaGrVqgOr`Rl_CzpAilYjjCQim@`_K|~BefLrxIifC?jxI`pFs`AzsE`aFc~Gn{t@lmD{iDbhClkElgArcb@ihCnmFiaQy_@k~A~yEktEitIomLviLg_Vq~@tIw_EmfKxkBlbGpvOwlP`aWxl@}k\\qbWnxu@e_HguA"),
+	usState("Alaska", "s~{{Idygd\\h{A_qC`uCdcGddHfhv@roLvcDteAtcFaa@|wLimLyeD}e@jtQ{lC_iM_\\xhI{lSat[b`F}kKbaGxcEqiH__W"),
+	usState("Alaska", "ki}{IttszXn}DkxIbqXf_Od_Cpqh@arPmoBciPc}To[diRgmKwz@dtAbxIe|Je{Dy|FlrBoYsfVvtGskT~sI__Kmi@lpM"),
+	usState("Alaska", "_xiyIhgttXd|a@mw@ffB|aB}uF~~D`yH`_AnaIamG|sMo[`a]|bGeYmdFbxPz_GgoJr_Bvi@~bOmcSh_K}WryIqtg@`h`@yiHipEmIecMoxRqmRjcK`eUcuEt_C{wIwvGzGhjHbgOjpGzwDb{N}_NfNoeOocUesA~lE|eRpn]csKwMg[y_EorI|WseE}eFxkGdy[oxFbeRumJol@o[_zKwuH`vNgqDc{CcmGgic@unDbGcb@zh[qzGjsK_aJsyC_hA{jOrRnr[aaQykNltArbe@}xV~m[|Yu~e@_oElbYs`MkqRm}Idh@qlFweY`iG_{RfjLbxUy}EepNwMmrYleAylFr~F~~JsmAwiMzmG{mYjiJ_tOmi@jiPtbCqiH|iDbtEmGa{GxzFm`BzGeoOtbGdbB|xEebHvzFjzCnmPyePzmf@cxI"),
+	usState("Alaska", "op~_J|vtf\\a_Sd|m@ofGatJ|~Hok]txQqeU"),
+	usState("Alaska", "shv~In|u_\\hxe@dnf@?x`Ws~FitCbiDnkLgmLroj@wi@h|i@tmDsfKntAd|UhiHltA_gGqan@f|Q}}f@zoMbbL`sD|`a@knHteLonEmnCrdFprm@y}EdpIl`HmJ^clZpiHgjMbrFnxYmrBdt]rmHfcO_cC`xI`cEqkBn}Jr|N_oJn_EWt{Ihm@kbGzcGpdDpfIirD|kCbjr@jUgd`@flFfuN~rCczTryHzaLmH~yFkrF~fApmRrrRa_Aa{MdoCcGb|B|rYbrRpe\\?~k\\yiGao@ich@eldAvmDdtMisIhOzgJduGhQr|MsmTwtMm{J_|]e_CfzKp~c@jkx@ssF`nFctE}iDm_Omm\\}wEf|u@j`Gdsp@dkKm_O{bLkmZbUk}_@bg[ftIriDnmPffBw`Df`DfdBxkAzpGmiDfxA`iHhRcpAukJhuCpoKylMfqCkbKlbf@svb@lg@}mOp~KovAfnb@}qBhxBqvCmtGw`E~bIli@}bS}~BjiDo|Ee`DelEdrBymFgcO{tJswDsoB}wKxeDgmDw~J}g@bpAivU{zBjmHicKiqPkiDutY~oDukf@zqM_zGnmD~wY~z@oda@lxWwvGzlSo_[djEywUckHtaU{fQleLurSwNy~F`_GwoCol@vhFk`_@_gShgg@dg@gki@teGseFakBe_H}vKziUqsDlkg@gkR~tB_mKchPe_Cqi`@pwIexPpnh@ccLexQ|~BntC{ePutGtpMqiBa|AgfBsiIlfLifOwcCudGk`MpzYkaFbmBap@kiD~}Jgom@d}IbGj{BqpEsx[b{BaxGdcGpmLcnd@o|\\|do@guCecAj{Nw_t@{jCe{D`mGw`a@btEvbO?ktE|tAbeF~~PjeEdvF}vFehAqsDkmMtKs~Gq}FppEa{M}{Jw_JziDw~JecMxBjiCc_ZniGmiJb_H_l@szCshJ~bCstEd|Vxjj@iyBkePtfLv}D_pEexQ|sMxsD"),
+	usState("Alaska", "i_x`Jfsm_YjsKxO~cIlnJyiHbpAim@lcGopE{iDp`AboMikNoyFetAjmHkmHmqBufE}dLp_LwcU`vC}{C"),
+	usState("Alaska", "m_laJxokg\\axNumZxb\\oqjAltAxiTy|Gnqf@kzIpuJsE~}CbtEmxE"),
+	usState("Alaska", "_nqbJ~i|}[rpA}dMjqLphIkmBb}H"),
+	usState("Alaska", "wyh}Ixxc|Xw~Dzc@icQ`~]tHmyKx|MotYv[w}Dw}DncCosZdit@xxA_cOwmDa_GmkD|eYzfD``EmoCziDspGgtIktJjiVkcFxiBfz@hgIctKhsO`yA|pI}bSmiLwdHtcBizByfI~_\\qnu@mol@lqhA_iGvyA}nBsqGhpEe`TgaUxfOhQiaQnyQ}rNreFz`A|{CiaQknGllKwaPznAaqEa_G~oJmm`@mgLohG}rGc|Yt`H{tL~oEm}_@rtE__KzsJbpFl|JfhSaCnl]psD_|]`uD`|_@dxEgxYyiT}mZobCkmNyiHvbCmtAoiLd_C}~Nb|Bvy@jWcfi@zwCvm@fvGmxb@f}Pq~AvtHjzIzsFwsHexQ~fw@xmXomt@pfDrjOa~@`~n@{kf@pazBflKi_RtdX__qA~eHo`IzeEhkGhzBq`]a{GmtYmWmd^|mq@cpR~m@l{Jd_C{iDrs@`uCab@bfc@qcy@dvrCbrFkjPxvHm~B`tE|bSui@exQj`GguSbwE{uEt~BpvOkTiiN~mPkcExiBntMrxDkjC`RrhJxqDoyAt_CzlH"),
+	usState("Alaska", "ymmbJ~ootXcaM~bOo{EcwUddAk|NnxYmsj@"),
+	usState("Alaska", "o|m{Il_`sXvbOz{q@qtJucBk~FpwCsqGwkG{pLrlIrs@m`T}aC}`C_kE`iNohGksWy~Fz~RgxGkR|wKwon@}bGftIu~B__K?vx]iaRbqSqgIy_i@ppEpqh@w_Pt|Omy[reKy_LijByua@`fImvKjxIchJg_K}wEltAw`E}iDpn@zoG|zJlu@cvPreWemI_uCotC~}J{sWtcBznMir\\|t^quUn]gycBx`Fq{A?|bGbg_A}q|@viWuaB~qOygOhQjeFaxS~uYciUxhHstF|bNwsTdnIoiLvmPdeA}kJenO|dY~gMufG}e@ntIh_KqpQkgDp`QptP_iGjqLh}AiSwuNpq]cq^xdC~mE`nGyxZjnM_hAd~NsyOsmHdnl@lxE}iD?bxI`hD{cWzqEvcCayBsoSdrNooDl`IzdQckIrmTxbEfaDacKd_OhfOqpExfDiqLvcCviAkr@niGvgMvxFfw@xgNreK~jMetAvfGriDxeD"),
+	usState("Alaska", "ec`cJpzo~[jtQ|e@lmPpf[axIb_SevKhgDdX~|DpgHhgIpxBkvEcc@nvMviLgxQtmDpmLo}D||Tl}HbeQccWti@jS`fCvgYlfLntBljPybDxsEpaJkHxbEjsFgpI|{g@_nEtqGwoDq|@uYzfV{gJhcKu}Ha`Gv`Iajv@ubSd_g@i_FitPb|Bwwj@jwDgnDcoMdJahCdiE}e@a_GsmH~~V}w@smTcbFmtF`hC{`G}`IwgGjiDweHui@ufSrmHjiDltA__KtvFdeA}Iq|LxzF~vAshCouGa{M}_Dtf@glPfjGiTvrBqwGqhHiqQlkDaqEzzTnzLdlDkaAagNsoGza@kuElnDpP_kMueL"),
+	usState("Alaska", "kkddJnjtb\\mmDj{Be~Nkch@_@imSh|MfxApiNdbZ"),
+	usState("Alaska", "ieoeJvaju]uyNawq@joo@|_h@jqCtla@{dOn`@a|Jp`Qt`IuiQu}JeeB"),
+	usState("Alaska", "uhsfJtgg`\\li@vdUywBi`F__FtJvwAsm`@"),
+	usState("Alaska", "qtoiJ`lyv[fxAktQv~JpmLpQdnOyjBtoB"),
+	usState("Alaska", "e}biJhg`h\\{cFd_DyoFwiMkRskN~|Di|FdvFzmH"),
+	usState("Alaska", "cu{iJtgqt[qn@gzF~gGpzBj{BteX}qG_mE"),
+	usState("Alaska", "gvbmJdw~oZoeAcsVjtb@`bjAkvJ{iI"),
+	usState("Alaska", "cznmJnfjf[dsMxzMvnDz~^e_CufKsiDz~FnuBaiN"),
+	usState("Alaska", "_apmJpkge[zdKfkLfoE`p\\khVgd`@mH_dH"),
+	usState("Alaska", "_etmJrdbf[rU~vNjlBr|A?uiDh_GriTyg[yca@dyEsqAfxA{`M"),
+	usState("Alaska", "wdnmJ`khg[|bAjd]ixAkfW{e@rmTexEsmTwi@dxQmmIcj[ntBsfDi_L_oKl|TtrH"),
+	usState("Alaska", "ws}nJh{z`[u~Bccc@hdm@`hnA`uIrmHviGa{@bvDpoVxhHkrEbtEbqFcD`q\\zmGr{CheDjdo@{tKyuDwwGya\\mnBdfLyzH{pYieDptEyeUoku@yrVeeXq}@omQgmVegAqxB{mMbe@yrDvmDhpE?qiT"),
+	usState("Alaska", "gbooJ`zdf[dkPzsKsqApcQ{qLyrJ"),
+	usState("Alaska", "}lpoJdkauZcsE}nNrlSfoDsiChm^"),
+	usState("Alaska", "qhtoJ|mg{Z?smTj|Hrj[iDblT`|S~`s@i_Gt~NetMimd@wwA~fTtxE~_J{{GbbLkbRygYroA{{l@h_KltAceDcim@"),
+	usState("Alaska", "iwuoJdr|d[ltAhmLayHmlD}sF{_GhtIpxAagCqwMwqIeKv}Eu~C{`AskHnvG{|Bb_Gv~V|f@azT~iXvcUrs@goDjhJ~~DvqCzeJgrCrbGgvGqiH?ptUstEc_Gu~Bb_GygCa`XydDulCzp@|{UwwAimLeyDfsI"),
+	usState("Alaska", "{pdpJja}}[hqJlhQh{HvNveBzcWew@ycKklZcoM"),
+	usState("Alaska", "qp`qJtesxZb}GdbBvnQnsw@~pCpss@qfIs_HoLsn_@ajHcl`@e_Cb_C"),
+	usState("Alaska", "g_fqJlcgc[ytIqmLtaHgsIyvBa`KhoFrgD"),
+	usState("Alaska", "q_rqJtk{e[qeDiwBr|HcgKhaEbjPacKz{MdtAufKc_GtbA"),
+	usState("Alaska", "efgqJ`ejg[amG|mI{pFg_DgxAt~BdbCutS~tMcT"),
+	usState("Alaska", "w_brJ|hzf[kpApiH_xGui@k_H}kJgtBvkFxkB{jZ~sNsxI"),
+	usState("Alaska", "ojecK`ez}]t~I|rBatD~}dA}bG}`Iu}Conm@"),
+	usState("Alaska", "cwzgLpc_c^q|Lu~I`lGrqFpo]bs@"),
+	usState("Alaska", "eu`iLryeb^amXo|h@b_Spi`@xmXd|U"),
+	usState("Alaska", "ofkjL`u``^wgLck[b|Tjhb@txDjoL"),
+	usState("Alaska", "guhkLhgv~]w{Qkz}@vuHljb@rhUdfi@"),
+	usState("Alaska", "opflLr}_y]ykBz~v@hbEneZedGekP"),
+	usState("Alaska", "cbnhI|iwt^l}C`rJu_DvfG"),
+	usState("Alaska", "krlmIdpsl]tkEbb@p]~fG{gJ|xE"),
+	usState("Alaska", "uqzoIxmuw]vpD`gDkuEdnNavCitO"),
+	usState("Alaska", "k{fpI|nay]|aHp^eVv|W"),
+	usState("Alaska", "esssIpwjk]`dIkaAchDbwJ"),
+	usState("Alaska", "yb`uIpsh{\\jxDxyAu~Cb_IibEowG"),
+	usState("Alaska", "gp}uIja|{\\zcF~jA}fFzjBscFy`E"),
+	usState("Alaska", "_n{vI`veb]puDdbCu}CtuKeoCmdG"),
+	usState("Alaska", "sz}}Iht|i\\dzK`|@ueMlsG{uEk{B"),
+	usState("Alaska", "ycp`Jjty`\\dbB}tAnbCbtFutFrmYa_FojAon@uxJpqFc~A"),
+	usState("Alaska", "}}{`Jh{gc\\|nIjzBsxCvzQ"),
+	usState("Alaska", "uywbJ|}tc\\ymFjhVmw@moOt}Cc{HfrCspA"),
+	usState("Alaska", "ktceJbpcq]tbBa|AjaDhoEocHfsFs}G{|A"),
+	usState("Alaska", "{zmeJtb|q]z_HdnHo_LkhD"),
+	usState("Alaska", "ozjtJx`ys[~gAanEj|BvrB|uAftN"),
+	usState("Alaska", "wg|_Kb{pk^lpBi{BqA~vG"),
+	usState("Alaska", "at}wHz}kda@obCixIjmHseD|hEzeJ"),
+	usState("Alaska", "k~rxHldmca@ucAicEraGqxHl`HncO"),
+	usState("Alaska", "{_j{Hxffba@b`Hu`HbbFvhLsmHb{H"),
+	usState("Alaska", "ocf{Hnuyr`@fmLtmDdXzcQ}{OnsCpm@c_CmxEptEcoB}kVr|C{`AnuA`_K"),
+	usState("Alaska", "{_j{Hrfhq`@ziDcpAotClaTf_E_lAstErbGftIrpAuiLdtMslGaqEzkHwgNc|B}oB"),
+	usState("Alaska", "eut{Hnfdq`@etAu~BneHmtAipE~m\\woDm}O"),
+	usState("Alaska", "gjc{Hvre|`@r~BkiDnrNlhFdzDhySf_K_cCx_@~iFmaH~yFs}Bp}WeyE_eCe}BmyUamGao@{|Tdnq@spFo{Ky}@gee@`ePqxTs~Bmti@bdFt|C"),
+	usState("Alaska", "yc~{Hrdax`@x~F_vMjoLhrP~uMmx@vfHxsDmtAveHpqEhlMc}Gp}]~oCdv^vuH~~JweHfvGypEukJltAqbWcgKe_l@a`F{_NavT_cC"),
+	usState("Alaska", "scf|Htbpo`@~wAybKr{CfdN"),
+	usState("Alaska", "w{a{Hxk{s`@hpEifCdxEbxIstEx{BfjLh_R?f{VhmL?u~Bx~FxgCliKalSbnApcVfqPzFf{JimLoeA_lRwq[oi@tiPshEmYnwBydf@y}F`xMo~DslMeqH`iMwvGe_CyeDqqh@n{KbU`cJf~PfpEgtY{iDa_GngB{aF"),
+	usState("Alaska", "ycj|Hbbar`@oaJt{Ie}Im_IiB{pMzqM_hM_NmfEtkC`_LdtHznA"),
+	usState("Alaska", "{i`}Hfdt_`@puCwa\\dkDded@ixBpkM~pIrcp@{iHhxe@hbDzv[k{Nlsj@mtA_r`@peDltAcpAgtIlxEc_GgiF_{FcyCky`@fk@e{JbhDltA~mDqmd@"),
+	usState("Alaska", "_pv~Hzgh{_@``G_sS~lDfy@~|Ktti@edAvfSmmPgtMumHkxU"),
+	usState("Alaska", "}gz}Htoie`@tmD~m\\boRghSghA|gWlxEwi@apExlSnz@`_QveBrjCui@w~J~~JtgFitI~dUxbKmxE_bD`dI~aDuUvi@djPe_C|~NdjEjd@gcDxv`@hoFpu[s`Altz@qqE__b@piBgsZalGVddBqzGo`JehLfaD{nAcbB{pFlmDcqLyiHsfKj|B{uVigHohSsiD~~Jzq@_r[ecGjcFreDsmTk{NiuTcjHbxUxpEtJugApnL{uDbzBcoAy|QasDbr@smIaq]ptJw_b@p{GivB"),
+	usState("Alaska", "atr_Irogt_@~~D}oHx}ExyAkFfnP"),
+	usState("Alaska", "gmq`I~ehp_@f`EaqFbdKd}I~uGpai@ozE~x@olQmbS"),
+	usState("Alaska", "sbdaInofm_@rjC{lHvaDxl@vi@rqXcxIqm@"),
+	usState("Alaska", "cquaIn|tj_@jmHam@frHtrG}`H~rZ`cEnkRktEvdH}nJal^"),
+	usState("Alaska", "{w~aIbz_m_@|sA_xMdnHn_KcdEplL"),
+	usState("Alaska", "gqubIdgvj_@|wEkiDptI|~NihNh_@"),
+	usState("Alaska", "yg{dIhto__@|iVfyu@d|Dzd\\vkc@jza@pbDp`VlvLhrQrVbrQnhG}s@qyBpkGrmT``z@gtIqmXacKwmDotA}~NeiLc_CwvGsiNa}LhtC}iE}_CoeS{t\\vaDyqQmoCgu_@ycEq|@kiD|~N}dFegFoiG|bAelJgeGscKw}WgzFgsk@boGakMth@_}WnfGzEr}HdiK"),
+	usState("Alaska", "oergI|r_u^ui@qiHjzCfjF`aFab@pl@|vL~pDc|Bj{B`uIh{BmtAwdInsOwzEwl@i{BchIrgEi`@epI{wE"),
+	usState("Alaska", "yv|fIjj~u^hkH_]haKzft@hpEy~Fww@htIjfJ`{BqbKpmL|wKumDiiBh|M~nJeoI}_C|tMgnJdyJliVwiG}|B`iIhaEhuC{bGpiHzbGb_GgtC|bCjeFpiHe_Dn`CxxNbpv@|bGd{Dje@~wY`oK~rH_aCjbRjqF~oJefLzbc@oxMkv[dj@cei@stExeDodC{rFzlBw{\\o`EhzAyqDeuG{Ec~XyzGpeDmtA{~j@u~B`_c@y|GrmB{vDwoDxpEavOcvDx`@vy@kvJkmHhmLinFsuEmcA{xTtkOskIemDe_CdmDy~F{{MziDrjBicKa|@}~Bqm@j_GspAe_OkmHzml@~wAjoRomLtkJcb@xsJmkD~k@ghSguZuf@ah_@smHa_Sj_Gqm@ipEweHlWksK`mGgyGvkFveH|`IkRgm@}`IjmHtmDynLwoVvyA}|Dg_WuiPfbI`RmpBg{QpbK_z@_cCsmHpqDzd@bcFxoJorBxgIp_Fz|Bd_CdtM~bDin@`{A~|Ud_CkmHbxIz~NriD{~Ba_G{~F||DehFibFa_@{yC{}H|iFkpGw_Kw|D"),
+	usState("Alaska", "atciI|suo^btEmbCrpAjmHypEpmd@"),
+	usState("Alaska", "mijiIf|qr^?{~NfbDpjC~oDlxPgwAvjL~`Ip`KwkFh_MlxEviL}~N|~NkcKy~F{bGmrYzt@wzKdsFw\\{oAkvJrtEixMb_C~bO"),
+	usState("Alaska", "ixsiIlm`q^f}Jd`Osc@veGcfD|uAoqDgoD}bBr`FyzGsdJwuAtpS_hHshIzyD}wFkyDscQtoIjcLt~BgxA|{Dk{Z"),
+	usState("Alaska", "csinIjgek]jjCixMlcHfeNy`FdvF{lAumD_xA`_G"),
+	usState("Alaska", "cwynI`{yk]`jHe}HziDbcWypEqm@?_fH{mHngBltAtkJiiHs~B|sAe|U"),
+	usState("Alaska", "macoI~`tl]rpAstEj`BpyA_vHboMrbG?gyAfvNkhDu`HucG|iD`hCmiKocCzlCyiBaxIamB~~JqfOucM`jItcArgDwiF"),
+	usState("Alaska", "oppoIfw}n]qxHuqG|x@ieE`xMdpIorCrrLjyDxoEzvDsdFcH~rOd`DwwAtn`@hkd@a{AvqDqoFxRscW{x[_m@|~NspAotIczHrrMjkCg}V_aKxnFbzIidQexQxiHd_DsyT}iE|nCa_AgxGkrEpiBgvGw~Jz}GkjCzuDhcJ"),
+	usState("Alaska", "}~cqI|avb^gx@d}IopDyeI"),
+	usState("Alaska", "uuwxHoa~ea@vkFsbMngBhxk@ugFkTseKlb`@shP|xUijGh}j@igI~gRweC}_H~eHukm@dtX_rf@v|@scMnoIwvGoMwqIb_C~wA"),
+	usState("Alaska", "{_j{Hi{}~`@v~Jwb[?xtI"),
+	usState("Alaska", "yxh|He|t`a@fvHmvLjbGvaPilH`bJmbGkkB"),
+	usState("Alaska", "}xd|H{mhha@tmItuEttF`lS_gGfb[afJvjAi_K}m\\"),
+	usState("Alaska", "krc}Heyf{`@lxEajHbvV|pYh|FcgJaPnwe@|rBphH`tO~gGmvKpkRwcOw}[i{BkpYklTyzG"),
+	usState("Alaska", "oau~Hq|np`@j{BaiTvtBbcK"),
+	usState("Alaska", "qem_Imrvc`@j}HxgNnmPw|DbaAvbOuqG~nEox@xrm@w|Qm_m@"),
+	usState("Alaska", "atv`Iigre`@kiDyjB`tEgjL"),
+	usState("Alaska", "mmmaI_sea`@pyBjwI_mE|hRbzI`{MgyFhnLriNb_Yq]leFsaGltAhAxmXi_QqhHpvBri`@kzIylAgMtoa@e_O__c@gj@iqVz_G}wc@e_Cgy]ziDqfJz~FtuGdtA{iDacEwaOnpDeeMzqGxrJhxA_}a@"),
+	usState("Alaska", "mijiIfe|m^|dAuwF`nFpmGs~A~wo@}bGybQvmDkcQ"),
+	usState("Alaska", "_gakI`pg~]d_Ck_GtuArcLudGpwH"),
+	usState("Alaska", "}_hkIdij_^bxIugFue@lrSd}BumC_lPzug@ymGuiD"),
+	usState("Alaska", "ahlnIlz`~]mxEs|T|~NkeEpxIxiHxlAdkKsrM~}P"),
+	usState("Alaska", "idxlInjib^vmD{`Agh@h_QodCi}FykAd}CrzBxdP}nOvrZrwD~_Kx}L|oBfbIrz`@ibDvt_@xzAp}aArgPjne@|aHv\\xeIp{LzqGtfo@ycElsd@wbIprKsmTjmHceQyhq@}|f@g}\\y`FksL`vCwhp@cdKoiLeeS}dmAffBual@riDfxA|gK{qQvoa@itPdh@y`h@"),
+	usState("Alaska", "mptoIr_cz]j_GmmPf_KrmT}iDxbK_sJuzDu`BtzDf_KrpAmtG|~NemDiqK"),
+	usState("Alaska", "}e{pI|ppq]tmTzbAc|M|be@goDmuG"),
+	usState("Alaska", "qe{oItcrr]|e@~~JfxAqiHx~FjiD{wEpxYsnGxo@||DjgP`_K~eHanh@|wEsiJ{qLqiBkePhlGugFd`HlrGzbAoqCmqBqnLqiH{`Ac~Bg|F~rDasC`nKzzHxwHgyFx{AjrE"),
+	usState("Alaska", "ct`qIr{mp]aaEhxe@i~FedGn[ebY"),
+	usState("Alaska", "yqwsIpwtg]ycEjg\\xcExsDd_CubGzb@plFk`FrzCuiEcs@unCmbM"),
+	usState("Alaska", "opoxIng`j_@ffBwxSlaIj~TklNlnf@"),
+	usState("Alaska", "q`f|Ixhan_@ktEaxYr{NxoKjfKzuVubGgxAab@hkTibEbgD"),
+	usState("Alaska", "wd|lJbgsq^feB{hDd{CbpGu_Bho]vgGdoTu`CxgTziDx_@~yEd`[{nAbfWj}Bt_C~eAq~XpmGagIdmCbrR{nM`vUw`Djo|@qjYtqnAuaCoy@dgAnqTu}OvyXrlBrhIgmJ`gC{hPrfWafCm{[vcCcs}AwkL}sB}pBk~FsbAswb@esGgtBelDinRdvF{xIatIuti@fbCirEu{Irc@|~BsmH~bOipEwiAiwr@b_H{rW~qI`rEjvEoxFdxEhtId_CitIrtEui@zwIftIh_G{bSvmDz~BpgCe{Dmv@{bM"),
+	usState("Alaska", "sjjoJdyrz_@nkFsiPti@ftIa_GvzVbnBf|h@{|_@fkxAkaLs{BcaG}~T`iNuqBjyHyoQj{Owiu@}Zi`]"),
+	usState("Alaska", "im~`Kh~kn_@qvO`~\\euLplq@zzHylj@"),
+	usState("Alaska", "eqfaKld|d_@veH_iAvzLdyVu|Cvxc@noCj~w@{~Fmjc@jxDjhg@fqOxfa@j{Hr}HveHmxEjmHbcWs~BlmP}mH|e@_bD_`Je|Oz{TkmNjbu@pm@fv_@kwD{oGmzIxfB_xMl{g@kvKxwcAy_F`tJr_Bbnk@~lWtldA}}Itzx@gzKhvOuoNzdEsgVqxGgsCe~IoxXwrCvtBy_Qr_NmJv~Iwgk@gx@~}QbcFluFzo@w`JwvAwoa@mtAhmLbuKkkuAqcUaxw@etAct`AzhOkmk@hgOmqGb_Hj~Gt_H_mrAt|OsoYuf@}|k@rhJymSk{BsvUmmDvm\\?kuh@dhFwbx@r`AytD"),
+	usState("Alaska", "e{pmI`e}mX~fH|GgzExtCsyDcE"),
+	usState("Alaska", "mkioId{u_XhwIfDsaCttK"),
+	usState("Alaska", "eriqInqslXeq@c|Lr_FdoFu@l}F"),
+	usState("Alaska", "eji}Idxn`\\tnAuhE~bC~pJ"),
+	usState("Alaska", "y{rbJxj|}XonFe~D}}AabKtrC}iB"),
+	usState("Alaska", "kvbcJroj{XkqEolKph@oxOnnFw~C"),
+	usState("Alaska", "a_yfJdk}~[~hAciCv~Cp_K"),
+	usState("Alaska", "kw{gJbpy|[v_D{~F{J``L"),
+	usState("Alaska", "ul}gJ``n{[bQidE~mDp`@_dCxqL"),
+	usState("Alaska", "embhJt}z}[rbAyuLhu@pkS"),
+	usState("Alaska", "i`cnJzndb\\~iG`iBivJjiJ"),
+	usState("Alaska", "qn`oJrilb[rhDymAvvG~b[kuE~K"),
+	usState("Alaska", "wfcqJ~rh_`@qfCfeHshC}vF"),
+	usState("Alaska", "qcjrJ|yb_[wiFymJnwFwfJpiFn_K"),
+	usState("Alaska", "ccurJlix`[pxBucA|mC~_JifCn_b@swD_~PbFe`T|gEhpE"),
+	usState("Alaska", "qcryJv{zs^dh@v{JurHwjF"),
+	usState("Alaska", "cmskK|lr`_@xcDroAmg@bmH"),
+	usState("Alaska", "{jlpKly|e_@prDdK}sAtdG"),
+	usState("Alaska", "qiqpKpfk~^_sCsjUvaDrvPbzHxkHw\\nzE}gEoiA"),
+	usState("Louisiana", "my`wDrezaPymA~hBgi@{iDghAj`AzF|tAj`AlgRmtAvxEsmHhr@}iDroi@ktQlmPw}DjqXxiBd}Ix~\\dd^j_NtmDdhKcjIn`Jszl@yqDo`\\a_SatU||Iu{Ps|MqfIfxMvi@_|F{tJifCb{CoMi_GnqC}lDr}SxhNeoBvbOvwFtxFppKyuDmw@eaShtEd_CfbHiqKs~AicKmoTeeFmyDseKa|GghBhnFgoJcaA_~J`vCflLpkHz{Bf_D}nOpgC`rKziDubCyiBurGjqFpvC?acK|aHrmHdcGmxE}e@h_GppEtiDsiDue@ecGdtMdcNpwHfbCxmLj_HmbBtGmkKrkIovF_xMh|i@tbO_cOui@pxYbcAktErrQovGdkJ{d]b`MfeB~k@itf@tkD|rGh`@imRbcGseFeiFagHdmP}nO|iDdxEhsEazMeuAcrFtdGkjCmtAb_G`sDr}GfkAklMv~JdcGopEjtE`_GoxA_cCdcC~`InvGncIghBklTpgUh_G~KxzAxtJlxVpiSigl@czYfnE~nDyvIzkBbeKhR`eEnnJei]|hRgm@{iDow@nxFd{DzEa~Ldk_@e~A|n]onIsoMu_AnpEy|FmJjV|sGaeJ{Tsb@h|EzaFjt@aoEngHzmAbsEotNny]r~BtmDkxIhtIjqKnoDr{Oylc@hdCjfDdhL}pAe_CvbCf_Ky~F_iAvvHn~CwOxCd|DdlJ}dA}WukExtI`uDhePhb\\ywN|eGy|Rs`AjuEreDqzMtaIfiLhtIshUp}F`~KznHogBdkVngNyjBmtA|wEj_Sd_Ce_CjtE|bG~`Eim@xkRa_G_nDixAxmLrmHnkFwcIx{LbUryIafDe_CizAl}C|kDpoKkuKxcg@yhNvsIdcB}aH_cJmuLjeFiq@lX}lI|iPqm@e_Cy~FsbLzs@kqXdpZwcZstEqa@bvD~iXb_GohFvmW}uG`_@ziDhmX_iSbHyO`hOw|Q{e@}f@bmZzvDfsNgpJtbBgrC{kHswDvcCsCxvY|uQri\\g_Evi@vNt{JpmLe{DgxA{bMtbCrbGviLctEwi@wbOldAnhG~oDcHnpIbch@ivL`lnAg}_@h{cBp{@fbeArhKfft@{uHxkHy~FzyAuyKoyTgxVqmAql@ptDgaFqjCoiJ_oJcjA`o@sx@aIizBsoAqnCcg@soHbg@wbEhxAa_GixAokC|\\}eDtxDc_Bfk@crG|VuwBeTecKs~G}rBqPqkFdtA__C?gxAltAczBcAwlB}u@mmAk~Aa]_zByvJqeBo`Bss@krCitEcxAc{@iiHgr@ihJcaF_hBmb@q`CcJ_aGpm@q_AqWq|B_uCu|Aqo@gdGj`E{lC|s@s`A}yAks@ybFg\\wp@a~@_Wyk@nXuaAvvAugF|eDidAmLosAqy@o|@mLyuG_QeeBz`@mWboBzYvnCsc@bdA}u@p\\_fE~a@qmAvs@_~Ctx@wkEvwFqaAvn@uaAnKgoA}Q_{Dq~ByaAn`BkaDp}C{{Gd{Ak}@~bDqk@vi@kkBbUywAemBg`AmNaj@`q@gt@l{DseAfwBe~CjfDseD`tBiuCpS_oCu{@gbFyQu_Eai@cnC`sA}qJfqAsiE|_DifEtbBkqD`kCarCfoDszB~bGe`FftC_yCdgFycAlr@sxdEkKgTknpPblEpI~{@sa@pm@yrAtaAizGf}DsfEpzEhuCnwDlhFrkHhfBnvFfm@n|A}Ovr@klA|j@usHjjA_oBzlB_\\bcCxbBzy@d{LnwA`cAxfCmJn}E_vAzvAogAz|B_tEto@ym@phAmLzjE|z@n_G~KdeBiUhxA}jA~hBcoEpcAw_E`sBslCpwFav@|l@ij@`a@{eBn[oyE|l@ykC``B_i@bxAfTbSbp@vi@?tnF|zGbmKpcE`zHnuEkI|oIbp@tx@l_A`VzfBVpxDo|DfcFn@`tEdjCblCj~Etr@bbEv`@`|@nkF`vCd|Gt}GnnApwBjwA~}J~y@zjBnkBt~AblBfy@jgFzoAdiH`~DtcWbyCptClCbmBfe@|lGtuLvvDjoCvnGk[juBeyAhgD_yA`qDzU|w@py@`sKltA~_Bvg@pcAlkA`YvcCLvxLbi@p|AzcBjI`}B{_AtjBo`B~lAquBhdFvdAxkB`aA|z@zEjnDahAffE}}Dx_Dwe@r|InhNV}kvJj|AeRbxEfi@h_FhpArdF~oCdjJv`Bp~CdzC~iBpv@ntB_g@n`FjvBxfCjm@~nBxGt|Ds|CzjBcp@``CzmAhmHkbDvtAs_BlbAqfEx|@wdB`aEcsA|iB}nDldDqfBr`BiU|bDlC~aBsh@lqForDvhB{\\x_GnIztDwvB||JsuDdoCwnCy_Akq@cW}iArSelAbl@sg@xlAz_AhhB`uD"),
+	usState("Louisiana", "{w~rD|aioP|e@`oFe}Tdae@wcIibV`~Ksme@"),
+	usState("Louisiana", "auytD|u~`PhiHemDmiDpbEdxE`cEaeJxB"),
+	usState("Louisiana", "qj|tDdga`P}h@{hDh~FlsAg|Et`H"),
+	usState("Louisiana", "symvDt{l}OltMqtI~iLwi@vo[roGcl_@{dF"),
+	usState("Louisiana", "k}qvDbrj_Pj~GzqEssFhnFti@~qOi_Sec_@v~KxnLzmGcuE"),
+	usState("Louisiana", "yfxrDjchdPupHvkWbEenJ"),
+	usState("Mississippi", "}ogxDt_tzOt{DjkIsxDh~Q}aGvqCx{FvzL`B~qN{ePzfc@j{BptIjhDwvSjpMxbfAm_Jf|PpfDvrCnk@ueFpaEvJfrIbsRdhLheC`AdkKuf@bbA?vi@xQnNcl@rg@sSdlAbW|iAx_Ajq@eoCvnC}|JruD{tDvvBy_GoIwhBz\\mqFnrD_bBrh@}bDmCs`BhUmdDpfB}iB|nDaaEbsAy|@vdBmbApfEwtAr_BimHjbDa`C{mA{jBbp@u|Dr|C_oByGyfCkm@o`FkvBotB~f@_jBqv@q~CezCejJw`BsdF_pCi_FipAcxEgi@k|AdRW|kvJs|IohNy_Dve@gfE|}DknD`hA}z@{EykBaaAidFwdA_mApuBujBn`Ba}Bz_A{cBkIci@q|AMwxLaYwcCqcAmkA_`Bwg@asKmtA}w@qy@aqD{UigD~xAkuBdyAwnGj[wvDkoC}lGuuLcmBge@qtCmCucWcyCeiHa~DkgF{oAclBgy@okBu~A_z@{jBkwA_~JonAqwBe|Gu}GokFavCw`@a|@ur@cbEclCk~EatEejCgcFo@qxDn|D{fBWm_AaVcp@ux@jI}oIazHouEcmKqcEunF}zGwi@?cScp@cxAgTa`B~h@}l@xkCo[nyEaa@zeB}l@hj@qwF`v@asBrlCqcAv_E_iBboEixA|jAeeBhUo_G_L{jE}z@qhAlLuo@xm@{|B~sE_~Df}BkvBf`@yfClJowAacA{y@e{LccCybB{lB~[kjA~nB}j@tsHwr@jlAo|A|OovFgm@skHifBowDmhFqzEiuCg}DrfEuaAhzGqm@xrA_|@ra@mxY{i@elAwt@wsBcmIwmBse@{jBbNcoE~dAivHtDqkAf`@gx@hrAseA~dGwxBbvA_eCcbAs_GohFenHwu@aeH`KwiGdnIkqApyE{eBv}AyeB`[km@qaBiSi~Gk}@sjB}aC{u@}zC~W_rAxbBgiB`bGsiBjyBgx@gPopB}pEcrGatFmpDidFee@}{@{Q_oFuVk}@_cCmoAkuBle@gcBbfAstEjf@{kCwuBsvCqzAgjDzeBonBhfFkuAvWuk@os@c{@qnIwuCo{Iq]i{Kgp@mdBymBgoA}oFhMkvD`dM{oD|Dm`AusA{pFyfNaoNolGiJe{Bfo@_bD_Z_Zi~@oRmfDokBslArHemExjAqw@a@y_@k[rgAi_CmSwjIosC{rJ}sD{hEgvDmp@qwEvAcoOc`AcmLfnBwgGqt@uzEekB{|BmxAcRylA`~@_vBdDukCq`@_eCigC}qCorBz^o{B~eAikBtYqoAigA{`FaxTszCmdH_tEaqEyfGkFsxE~pE`Mea~Kj{FglBjsDkvCbsBeiEdKmhB|plQnvlAnhdHskO~`@`_@doCf@"),
+	usState("Mississippi", "gvtwDhcq~OpbKreD}mD~m@me@v~J"),
+	usState("Alabama", "ilqxDn{utOh{GbyD`_@d|ClxEtkJk_GhtIptI}e@ym@i_Gn|JfqP~oDpjhAa~FwlQdoCmzJqlFwsT}nOp_Li}F_{@xiBdtGgaDz|G{qLrvDusRwoDazM|zDmnDfmKyvHftCjkoAdxQutMhta@fm@bmMnd@zwCghdHpkO{liQqnlAacB}FeKlhBcsBdiEksDjvC{eIptCvYgbbC`aB{zxCWabzBh\\yx~Apll@qkGl{|AkhS|a}Dmfd@vfLicAr}v@kmJ`x@|OlaM{jEprDlQjgBgYxoF}dFrz@vr@xz@sVpgCmlB|xEsc@h_Cq}@j|D|H~y@_e@bzAqyBjqAhc@jxMqmLffB_gAxwG_w@vuG_dDlbDhAlaChbAffAu_@t{BtfClqBuT|dBkyBh{DieJveA}d@fP{v@|l@uKxq@lPv`@rj@lStlBbmA_c@j}@nQfm@ho@j]ju@wg@foB~Rb|Avr@fUlu@obBtwAloDdf@b{DpoAhtA`lE`cBf_H~VzlB~}@nmCxRxjCom@bzChThsBl}@nqGphEhoFhxBxlNlgCpuXevExgB}q@|zF{uEdtByv@nxFcNvkDycAbtAyKzrCjd@juBldAb`Chc@xqDo|@jtCnbCzcFxj@b~Mzd@jxC|kBv~@`RzgFipAx~E|_@vaB_HtoAseApt@m|B`iEwiDpeG}uCldHumAx`Agz@l`AiKntBxlAlAbhxNnuArh@ldHsK|sJdlDvvAiC~r@aYl`DydC|_GglHhgJk{FjeIgnQ||@_fAdzCiBnwE~g@bdDtq@~uFxhCpcBuMtqHqxBcUhl@je@?lbBa|AnfAc{B?pm@nz@zH"),
+	usState("Alabama", "cazwDtp~tOfm@z~FmmDipEym@kmHf|AqEh[xyC"),
+	usState("Alabama", "ehswDnkpxOltA|{m@}tF}nf@"),
+	usState("Florida", "exdxDboqtO}vEp`@{jIszN_jFheCnKx{B_eBdjEuqHpxBu~BhF{zEmaCcdDuq@owE_h@ezChB}|@~eAkeIfnQigJj{F}_GflHmtEz~C_|BoDunIkbDmdHrKouAsh@mAchxNrbBrF~~BqmD`_GmtAtm@_t@frCmtAjrCom@zfG}FdzK}{Az~FkvGrd]syaO`[yKjgBhu@lr@gEbxEeuDvzB_`Ab}AhD`cD~m@zzBcJh|Gc`Cjh@cw@`PkgBq\\u_H`h@qxEmg@{jDye@{w@wwAoMqyEpGawMgmCseN}uAi{AfP_bExrCgbDb}@ijB`@gzE_u@_vEpNinAyf@i}A{zA{yBoh@hEwjEin@km@_|BkWeo@go@oOsdBzNedFx{Be`FdiEayP~P}fErfBmcDlqBiiKaBgoDpfAurCdq@}aGmu@{dGdd@u|Cbp@yCzr@orCwi@omDfLwm@|na@gN}hQjdGh_Lf`ChoQstP~y]a|A|rlBin]dtaCyz|@djsBw|kAvzLnzKpyd@wJtuaA}qZ{oaAj_`@eykAayB_jApi_@eqHlqBawNkfEeoHruQffXi{Bvfw@iqPfdiCcxdAxq\\o}Eff}@ey[ebHbwU`kSqi_@nf_@whMpoFdvFzmBmtGftS}iE|uj@sc@pm~AlyQvc~@?ooW~hAxu[lzInnEjyIz~RhxIr|Z~hFpiSmzDheVffTn|Kc_CwaJzlHllC`jH|dAoxF``Fyo@_fHdtMomDmtAfMjkIheJj_GmZfwGxpE`b@feB~{KklGnwXb_GjxId_Chmd@}bSttMkxUweHr~LodI~mFyaQt~Bd_CtJ_}J_pEkjDodMdlKg~Kpj^s{ZfiM_kE{gDkTdrGkmZ`jHgsHvdNquDg}EktEhfD_cO`xYym@`_SwzF|wExeD`jTefFz`AxiBqkHa_G}zDqwBrcLmcZbzI`_GltAclcAbwIodHxfDd~Hf[mwFjuVspFmt@rnAe_DklH}fKygIyzAggSixUbcMrtWviRfxGlaBv`PwmEhuCkhVvuBufc@s{CkoGvxFm}OkdVfkL~oa@ovGv~C~k@zbG{|H|`IzxUkdR`dUa~@esFfjAxfC|~B{_HfrUc{Tf~I~sIwbC}sAvbCizw@jtW{sKlxKuhEs{B}{OjeEitJtjWqxMjtExmLetMumJrSxiBk~Le_CpiHgrCukJy_@|sF{nq@}nk@_vSreE{R`xHviRdcAiTdtGq`VvvA}rBtuMyfCs~BitIppQr`LaCdLc~Gz~FjpMbcGct]~oC`_Gxv[hsCv`CrjDixYpm^pu`@mxQ{ch@`kYe_m@e|OadPb|Busp@o`W_io@uhEskTxhI|~BgtImdSzTa~LrgQkbi@pkMq`@|ym@o~PtrGwlEtfMerSliJysVx|c@y~Vym@yjMvr[mvd@vuYcec@tabA`rEhc]cuEp_EttMnaa@~fG?xzBghFyzB~kVhkGq`WfrJruEs~BmtAubAnjFsTx{Sh{c@ttfAi}L_LzhPrqXfjFnwaAgjFh{GcmYxgCyiCa}Azk[}_C|xEydJylS_rBsvPxrIwoUbsd@emDlf@jmHutMom\\b|e@pm@qmL`zS{z_@g|E_gHi|Ax_Fn}EhaE_jMj{HmhE}oBpeDdtMu~Nvm\\mmPof_@c_GpbEjmNtxVsbMz~GfsIxmRxsDclC~YyxMr~MouH~[~nDbbB_vAep`@bnbAwyRr{uAxmAi~^cbGw|EbbG}rLa_G|e@~`JcpXwgSdaCnxAtpGi`Fz{CrnGrhf@a{ApcEmdGshDzmBpiHitClmDlrGkV`aKl~UeXreb@pbKbkoA_wHg{c@e`HsuKzlB}`HmcMlkVklHkiIyiHxmLnx]piHumThiHc|CryIbbR{jDb}HziD|wEvbOtrGhRvqDh|Fr|DjhZg|ApEcpA|wEuXikAeLeeF"),
+	usState("Florida", "w__uC`a}qNj{BaxAltA`cO"),
+	usState("Florida", "igcuCdauqNpfCkxIj{B`xM"),
+	usState("Florida", "sgkuCpcnpNjxIhnXqiHe_O}~BreDriDopIymLhpE"),
+	usState("Florida", "cntuCfm|mNwbCjmH{iDexQ"),
+	usState("Florida", "u|avChj_pN`lLurMxuI~k@"),
+	usState("Florida", "igsvCfidlNpqEdJdh@biI"),
+	usState("Florida", "whbyClrfiN|iPx~Ft{g@hem@c|_@wk^_jFfdC|u@iyGurNm}IgnJvgAukJm|U"),
+	usState("Florida", "gfu{CdyghNa_Gt~B_cCkiD"),
+	usState("Florida", "aeo`DrzgtNltA{~RvmDx~JmxE~wMexQh_G"),
+	usState("Florida", "cigaDliitNcxUx~FndH_oKvx^sdE"),
+	usState("Florida", "sxdhDz|siNhmiBubk@yqn@niX"),
+	usState("Florida", "iylmDfilkNa{B{mHdzDihBzsXr~G~mh@ss@_vq@xbPqtJa_@"),
+	usState("Florida", "kf|sD|ftfOajH`{LpQ}vWfbIjlC"),
+	usState("Florida", "_|ntDrgedOnjWpil@}_B`~Wvi@smT"),
+	usState("Florida", "uarxD~jcpOjnAi~\\diKlurC"),
+	usState("Florida", "i}suC`pipNvoE{EivCzqF_sCi_@"),
+	usState("Florida", "ufnyCvqtmN|jEfxB{zCvfA"),
+	usState("Florida", "y~syCju|mNvaDtIqsCxcE"),
+	usState("Florida", "}cr|C~ruoN`uDhwBojA~wFyoFxD"),
+	usState("Florida", "m`s|CnzcpNcuEflKinA_iG"),
+	usState("Florida", "}ya}CzjoqN`{AwcBt`Cz`FolEnkA"),
+	usState("Florida", "gt_}Ch~tpNzbFv\\k`Gj~A"),
+	usState("Georgia", "oimbExsmlNfwBylApfIdfLkiDt~B`tDx~FnxFy~FznG~`JggGb|YrfEomD|`C`hIvmDccS|mOdyK}mCnw_@pjHk`Nhg_@piYnsObAjvDprE~jPsvFatBfq_@pfI{`_@f`JuyJbzUhbVgl@zfEoxLn^dtAzeEtjPiR_x@trGzhIuxKajHieDlxQz~BwiLvmPh}LcgKnkFnmLd_ComLjiJzmH~mJwi@wi@z~F|~N{~FpgHlv@~jA{Fed@t|Clu@zdGeq@|aGqfAtrC`BfoDanAbmH}cAzyCaeAvdB_Q|fEeiE`yPy{Bd`F{NddFnOrdBdo@fo@~{BjWhn@jm@iEvjEzyBnh@h}AzzAhnAxf@~uEqNfzE~t@hjBa@fbDc}@~aEyrC`cDyMz}KnsA`wMfmCpyEqGvwAnMxe@zw@pg@hfEeh@b}DhX|{Ieu@fcBitIhnCohGww@_yAdI_qI~tFg`Cuv@a[xKsd]ryaO{~FjvGezK|{A{fG|FkrCnm@grCltAum@~s@a_GltA__CpmDwmA|DkiCkzAm`AhKy`Afz@mdHtmAqeG|uCaiEviDqt@l|BuoAreAwaB~Gy~E}_@{gFhpAw~@aRkxC}kBc~M{d@{cFyj@ktCobCyqDn|@c`Cic@kuBmdA{rCkd@ctAxKwkDxcAoxFbNetBxv@}zFzuEygB|q@quXdvEylNmgCioFixBoqGqhEisBm}@czCiTyjCnm@omCyR{lB_~@g_H_WalEacBqoAitAef@c{DuwAmoDmu@nbBwr@gU_Sc|Avg@goBk]ku@gm@io@k}@oQcmA~b@mSulBw`@sj@yq@mP}l@tKgPzv@weA|d@i{DheJ}dBjyBmqBtTu{BufCgfAt_@maCibAmbDiAwuG~cDywG~v@gfB~fAkxMpmLkqAic@czApyB_z@~d@k|D}Hi_Cp}@}xErc@qgCllByz@rVsz@wr@yoF|dFkgBfYqrDmQmaMzjEax@}Os}v@jmJwfLhcA}a}Dlfd@m{|AjhSqll@pkGvH}pl@g\\kbaBzFgpiBqrAuhkFtqAvEnvGnsBpl@ftCpmHpdK`y@fwBfxD`cDxlDveGh|BflA`rCbXtq@vY`nBdiBnfGb{@ndFodEtqHoyPntAopEgb@isCbHck@fsAuJrlAwm@nkBugFlrC_cCzdAybCjrC{cB`|AycBf|AwhEwFqpUljC{fG~aD{dAtiDy]vhEmtAv`Oa|KnyVajE`oC_pC|q@urCvy@unAbeCguBjdGivBv`AgrCpcAos@|`IydCbbDom@tdAqlAd^ycB?g{BvcJ{cJf}H_{R`zKkuP|iDwiDfvGulA`{BucB~_I_nCtpBk{BnfC{wGliAchHbr@gzBvzAiaCruGufGldBi_CnoC_zFllBgwA|dClk@h_CitAlLzaBzm@nOvwCskArf@bxAj|A_j@jpH{|Haz@ut@lr@syDpi@?`f@htAvdAuiBtfA}j@~_ArSlp@fvAfkCe~A|lAcaBda@woBnFckCpr@ih@~wFwb@lvAk^`zCo}Ez}DgePhzBm~FnoC}nDzp@w}A`RkgCtk@_mA`iBylAb~Baz@diB}T|tEpr@zwBI`t@sgAntMc_IdlDaz@p|DcYbyG}dCjsAxDti@`f@hlCxf@hvAgqApzAaj@nqH|{@peB}{@leCs~Dpt@o_Cru@urFv{De~Edx@{_DnVu`@fzD{aApfA}jDngBwZhbEtEb_O}|D~Y{|AjhAqkArkBsp@ncCsKxhAxKpsAdo@v_C~]xaDuInoC~fAxcAhLnoF{~BdnAnP~k@oPhtAe_Cx|DusDnZ_u@uXshJtXwgC`eBizFtbCwrAj}@u~Anc@}pBtFo`Cje@meA"),
+	usState("Georgia", "kcpzDjnepN{Txm@qh[lpCexQmtM"),
+	usState("Georgia", "sfh_Epk|nNghB_~MrpY~nSwaDl`C"),
+	usState("Georgia", "yoh`EntjnNioCcvDjqKiR`~J|kE"),
+	usState("South Carolina", "q`vmE`qv~M|Gyz@jWgn@laByz@vL|cGbcLv}[|sYnba@`{j@tbe@|}e@pyHidO|{DwfBtkJwdUebOdtBviGvoUzbLfrIauO~mJ_~DrbGneH_tCpnFrpGrSqpEliLneHyiHfvGzkN|bHb`B~`DxjSivHhrDdjDbtKltL|vFjkDyiBc_CypEjlCb|A`q\\|zm@ojFlcNy}FacE}sA`lBxSn`IfhLq{@eJxpEneHqmRbyDbT`wIjoMf[~rNlgFu_CfuG|q`@yrIdjP~zJg_KxnMdwWmdH~jFhxInrCubOhv[zbGui@eL~vY|oC`o@`{Bs|e@fcC`jTbrE}eXhzMtiP}fKe|Uf_P`_Gt|Izl_@mnUyo@zr@`_G}kPinAuIxcDltAe_Cd|[p}A}iJryNspYvbDijGzpLpob@ueL~kFngAboSysVhySj|ZotYy~Fvhc@rkUvjAdHvvAsb@uFn`Coc@|pBk}@t~AubCvrAaeBhzFuXvgCtXrhJoZ~t@y|DtsDitAd_C_l@nPenAoPooFz~BycAiLooC_gAyaDtIw_C_^qsAeo@yhAyKocCrKskBrp@khApkA_Zz|Ac_O||DibEuEogBvZqfA|jDgzDzaAoVt`@ex@z_Dw{Dd~Esu@trFqt@n_CmeCr~DqeB|{@oqH}{@qzA`j@ivAfqAilCyf@ui@af@ksAyDcyG|dCq|DbYelD`z@otMb_Iat@rgA{wBH}tEqr@eiB|Tc~B`z@aiBxlAuk@~lAaRjgC{p@v}AooC|nDizBl~F{}DfePazCn}EmvAj^_xFvb@qr@hh@oFbkCea@voB}lAbaBgkCd~Amp@gvA_`AsSufA|j@wdAtiBaf@itAqi@?mr@ryD`z@tt@kpHz|Hk|A~i@sf@cxAwwCrkA{m@oOmL{aBi_ChtA}dCmk@mlBfwAooC~yFmdBh_CsuGtfGwzAhaCcr@fzBmiAbhHofCzwGupBj{B_`I~mCa{BtcBgvGtlA}iDviDazKjuPg}H~zRwcJzcJ?f{Be^xcBudAplAcbDnm@}`IxdCqcAns@w`AfrCkdGhvBceCfuBwy@tnA}q@trCaoC~oCoyV`jEw`O`|KwhEltAuiDx]_bDzdAmjCzfGvFppUg|AvhEa|AxcBkrCzcB{dAxbCmrC~bCokBtgFslAvm@gsAtJcHbk@fb@hsCotAnpEuqHnyPodFndEofGc{@anBeiBuq@wYarCcXi|BglAylDweGgxDacDay@gwBqmHqdKql@gtCovGosBuqAwEg@cqBctN{cx@cc@agEbkAcr@hFqmAigD}qIkd@qk@whBom@mZ_i@oBomDekBqhGguAy~HmQ{}HqvAwuCmNmpGsXa_@q~Bk_Ae~A}pDzAmi@dyActAzSaq@ic@qwMjnIwnnF`z@qdB`pAwg@jaA`@zfEnbBhrAqKby@kk@x[}h@eGobAioHudOwOeeAbn\\qnZxlAuYlpTvUffAqr@`gCm~qE`~@iyH`jqDe_rE~h@xfD"),
+	usState("North Carolina", "ud{}Ez}aoMvsZkfPngAeaCctEfcC{dEo}DtdMxRtvt@mrYi{GveNm|If\\seWlhQkr@lvEpiTipEb_GqtIqdDd}T}rXbza@txEd_CjqWgm]~aE`mS{~NbeWjbSozQb_AjyCixS~ln@rmTyq\\bjO|{r@wtHvtHfrChhBsmBxmGapWxjGmtSkyHozEjyH~kFemD~xLpyHf~On]voV_jLv~Jb_Gvi@oq`@yjGq{Yzq@_mEz_Ggk@nl@{vUwmE`lGs~AklCcaA_am@x`E{kBa}@riCtrGvtBupAkmHh_KpbKj~M{uDkFfgLpfD_{K`nLwbC?~fSpiHenTknNi`F{q]o]glFawIdsGijM{gJlbHti@agOmtA~fG}wEaxAnm\\{qXv~JfcCdgKiiCpq@pgIxpKkrEpaJ~sGfm@hxIavCofBqQzpGucGj{BlkJ|bAnoIhwNttSpyHiiClmD`eJ|v@lfF`tOceDlcStnCxeDcgKvtMlvLdqBqpEveH?k_GwcP`pFbyJ`vC|e@b_GtbC}~BozA~{EgvSd_Ngy@avN{uEceEmt@dyVhtDzdKvmDk_GxeDntAxbKc_Gtv@t`CilGhhHxwBfuT_qUzix@dxQ_xMyb@mcM`sPo~g@lfGkne@x~FyeDp{FjkCgy@ddMdvFgzEmx@tiJxoFd~OlaCidP_pDeaBdnIcdAblYryf@yxBhiTw}[pdg@hra@gdZzsLewb@eaNonWrvIw}Pg}JnrD|_CslN}tR__F|}OgyE|oBh{GdmDwNwzE}tY}`ItmDptIigOvlEtwLt_Jrc@d_CpiHe_C~tApa\\boSyhBnyLswDyiAg{EftGb{ShiBg{PneIffH`vOspAa_Gb_GemDetAh|d@xcKboe@ytOhoFfsNh_@}w@xlF`wCsp@teMd|O`oEpeOwmDztK}zJ_jLybK~~Ju~BinAgcCtnIbvVkkIvoCbdLr}NgzLfmJbt]ly\\b_e@fgTx}Rh_u@rjTcxa@u~B_cOx~Fnxc@{pAfxSfjLeuA~s|@a~Fh`Gf{F`qEjbG~l\\_xA}sAcf@?lj@nlDajqDd_rEa~@hyHagCl~qEgfApr@mpTwUylAtYcn\\pnZvOdeAhoHtdOdGnbAy[|h@cy@jk@irApK{fEobBkaAa@apAvg@az@pdBknIvnnFhc@pwM{S`q@eyAbtA{Ali@d~A|pDp~Bj_ArX`_@lNlpGpvAvuClQz}HfuAx~HdkBphGnBnmDlZ~h@vhBnm@jd@pk@hgD|qIiFpmAckAbr@bc@`gEbtNzcx@xsAxznF_fj@wuC}eC}|@cyDe}Eqm@crCjKgzAtfAyiBbGmcCia@qhOe`A}gBybBayBekB_oAcjFiQa|Akk@e_CmlB{}@o]}jDpW}kA_e@izCuzD_zBshJwiAylAghDaeBis@mzAg_AinH{vDqeJ{}@iwFfModG_\\osC|IkmD_h@c_EfN}iBnz@{xApLstA_bA_{K}j@irAckEotF}qCufK_yDssFi`@awBrJodBuPwwAaoCo_Cq`CexCuyBapHiqCe~DeeAsjGwsCwhJjv@}hMmHie@usDwqEa|D}xCktCiy@qeBkzAqdAeOocDxeAqdAyGkwAuq@uk@guA}Mq`D`f@ydCb_B{wCdBuhAoRqd@_gCwoA_}AkzA_vB{Gwp@}]y|DujLafDiaMgBogBx|C{iCnnExz@`tBig@d|As~A`XevBeZg~AsiE{`KaaDy}CuzDimBufA}~@uxDexEqcAcoB_nDuxO_AgxDivCyiHoDww@py@s}BhZogEdc@el@ppCin@j}@auAri@cwDoSmaBu`Cq|Eq_BknBmb]ctRslCsdE_qGecOfxAehJvOw}Ecr@sA_hFrgDeeAjE}~HiwDodFsbBiw@jBauDrbB}}Bax@ahK{dHncAqi@j[o`ArwCc|oAtm@umjBzjCawqBqNw{jL{`AingHxNqdcA`yAcD"),
+	usState("North Carolina", "mfz}EjghnMfsBncHewEfcD_vA~nDcs@th@dAq`Hxi@y]oAakAef@wi@f@qsE"),
+	usState("North Carolina", "qca}Ef_vmMbpp@caRpo`Aeed@qcg@`r\\a~@trGyeD}vFymFr}HyizA|h\\}h@g@l@ekD"),
+	usState("North Carolina", "u`zmEfo{zMmiDmmDhmLlmDmmD`jH"),
+	usState("North Carolina", "{eqqEdlrwMklC{nNfkLts^lyPtjV}gKsyI"),
+	usState("North Carolina", "qtrrEjdirMbtEmtAgtIlcS"),
+	usState("North Carolina", "}~wrE``jtMgj@{di@rxIhupA"),
+	usState("North Carolina", "gabtErxlpMzrWhhZvwe@nhY{oH{a@sq]{{U"),
+	usState("North Carolina", "o|`uEd_joM`_Ay~FhmLrmT"),
+	usState("North Carolina", "o`kuEjxxnMspMi|i@f_Wdgo@"),
+	usState("North Carolina", "cwzxEjltkMl`UqvCl}}@vmKddMz~i@{oGyzGysJm~`@apt@iiHich@hfJ"),
+	usState("North Carolina", "upgzE`fcmM{iCqOziC_dIf_KsmH~mErnAsc@`sE"),
+	usState("Virginia", "kki~EtmcoM|tAaCd~CikAyNpdcAz`AhngHpNv{jL{jC`wqBum@tmjBswCb|oAk[n`AocApi@wp@dco@bN~lBdpBxwD}j@x}mIi~D_sG}rEqiUko@suQuf@srB}eDofIylDaaScoAy~R_Teu@sr@ym@mqBpEwjC_eCeiE}pB}iBc|Bc[smA[yhGcvG}~Yar@cn@_}A_e@iiHoD}p@oa@gpCkqEqxEehPyx@{xAqdCiu@iuGdZutCieDufD}pH{xRqdq@gqA}vCcyq@oljAj`B}wAbcB_Ahj@zSr}AhuCjr@vc@f[y@xm@ud@vqBs}EtaAqy@r}A_Uv}E~YxwAud@`fFa_Kp_EiqBpfBetCjTgeIv\\azAjrEqyEphEswKdm@ueMgHwsCwg@uyAcmHykJmi@_qCoTwwF}Qml@ueBm|Bu|Ey{B{r@_rAsCwmAp[ayAtcEgxDjxDgcJfv@oiAhtAav@jPm^m_Fc~P{aAufSwrAiaFj@glB|z@cvAy@mmAw`EyzK}~@ycA{jBao@qb@jCkcDxfB{hBcLmxAegAqr@_eCuB}tB~d@{_Ev\\{^lfAaMnj@ehDdnAeeAjIii@iQgmAsnGkpPgyFydUyKgrCzNukBhn@g^llB}D`v@}Z`_@oq@sCobB_zCkbFivIcxVo~@iq@_bAcAgtAbxByu@`TaeAgNu|BwjCw|BwpG}h@iy@gcA_c@ww@xDmw@nm@ygAjsDe`BrqCsq@dVk~@e[kyQosKioEwqAcnc@mlg@__Pg`Jy}IckCqwCdFy|@wa@usE_bHmgCehCgsBsgEg~BieCof@qNc}Cjm@{y@oKekBs}AymC{oAayA_rE{`@}ZyxDg}@ifEscDoaKse@_~DisCytEa[yzC_iAeYcg@Zwe@~mDclPxjKqtGraCr\\bwIa~g@uKqoAw~QghPgtGw~BmrGksAksAsl@ixBevBcnCgjFg_LkyAsiDaiCe{Em~BgwG{gGwSw`AznJq~SvHqk@}Qwc@ouZkiWoO{`AnrAikAhVww@g}@{gFou@usAeuEqrC_Wia@wu@czEks@w}AwtEaaEm}AxBi|CqrBofFevFyxCwxFmbEoiE}wAsj@ayDtd@}pEgcAkuExDieKc{HwmDkq@wzFbg@ypBwJeiDywBqc@quAr{z@c_~Aaoc@qvRtk@ixB\\{|HpPsrBhrAmgGzt@wyGlp@kcAn}CgwBh~@kuB`iAuhGn`B{bAx~BzUj~A|{AlnCbmErhBjm@b_Bic@~qAuqAxtFs{Ht_AarC~h@mePbl@ufElmAcvDbqBqgC~jG{hBxgCi~Of`JesHdf@ssDvaA_\\tnCeyCjxAkd@ju@o@|`@|XxA|i@bwCaf@htBzCzmAdxApl@y]rgHg_@lqAv_@r^tiCrZrfH~uBdi@p}@|j@f@~eAuMhgDrsDybEnwAqRt\\jnD`bAlvCmQxtAul@ns@isE_n@ds@vnDjqR`vIprShdG~vGclAsoC|tGvvMsgLzdAhiH_E{{\\_ZusBgwBqnE_GgjH{jDawGjrC{aDljBrLjnFr~E~tHs`PtcJmeAbjMw}h@mtAojWzhFsm@dkFqeMvwHuVjwV_fv@`wNnLw`DlrMdhLy`EbbFzbEnxGk_G~|DxtIx`EstEoi@xoRwnKppKdbIp`Fct]|yUunCrlN{a]t~a@aaSbvIwpC|`OeqHpzG?`}LfeBiiNp|GotC`yGosSlfNoSljPwzWbhS_mNnnHyaSz_U{~J|NqdWntIgc[rbFpiTttFw~P_rC_bEjgJ~l@p~@olErgUzpAnwAboC}tK|~FtI|tGcjJlqHdcImtAlcAb_HvxFc_HvgBxpEnzEuiKdrFxfWi|n@jtl@k_Gd_IhaExiBtvg@und@j~Nm~YwrBkbOf`JylA`cEmsM`kGn{FlXmiL~tH|XjvEnfC~gFb~XwoIvsC{lPv{]ywRtmC|oCzlT{tKzyTmzJ~[nqIboBfsCdlIweNraMx_FncIa}Ff}EqhCdd^hpEfsCnvH}mg@orDawHhxI{qGhpE}m`@~_JekOwyEekHrtZ}~@h`B{ePb{B`xAxoIqxP`_HctBdvGrmHuhFea[b~HmkPiqNldFo|DuqB~jHmhl@u`CojMxziAyq\\m@dkDkqAv^cjCia@aiY|cNpw_@b`AjHfxG"),
+	usState("Virginia", "era~ErronMwiA|d@gxAexEfdDi`@"),
+	usState("Virginia", "ehxfFrtjkMt`Jwg@fcDroDxrB{nCleqAh|kApqIetBlO`wI|{FzhEbil@faKi}X`gLube@u_Ie~CuuFoiF|~CmcHkiJmtAx~FckImjKyaEtYgiAqcJ_vYyeEfk@y_Qk_Gpm@?c_GurH`}@}rAbaMkqFszNw\\mbBs`AqnAqb@`{AoqDyvCkHcl@kxBqtm@hmA~sA``@dbB~y@hzA"),
+	usState("Virginia", "obidFj|plMlu@uzBltOtjL"),
+	usState("Virginia", "kamfFzttjMv`IzpFqg@`jHSqdG_yEp{@}fR{mPw|A_`ByKazB"),
+	usState("Virginia", "qxraFp}gnMspShIu|IqcEpyB{iCjvKt}Cz`@w}K"),
+	usState("District of Columbia", "itykFhpbuMcjBzZyuEsfAmqBp}@gaB`sCgbAh`Acx@dgFq`D|kC_cKmeNvcS}{X"),
+	usState("Maryland", "{rxfFxnlmMfeHxoQqsDz~A{sE}mDle@`_Ga_W}~Zd_CrmTstKghMzzB`l^mjJgjA_zFemT}gFzdPs`YgkKhp]tiVmvKhpEokFs~F}cAvzEpgI|bHlcGc{Bv{Et_Ced^vp`@vtMze@st]|bSybDujElwEmlC}zIwdCoxGuvLjTxgUewEcyD{iDfcCef@ytUt`C`aAzfGebV}oFehOyeIwm@t{Kn_KkgE|wNisE}mAze@xbKypEkmHx\\jvJe`HlKdhIx}D}aO~zEz`F`jJ{qIkWv~RxkIye\\ycFshE}`I|eRksNwrGmoKz}ApgLc}J~dAubEc`OsmHbxIvjHtcFmdJaE}_BdyHtwEl}Nx_IaBlrC|`Hutc@qrJpxFm{D`~@mbP_yDabHqhH`jB}mP}bSxhCtnTvkTxtDatB`oF}tKcj@csLteFo}[maR{{I_rLnBs~r@udChbe@e}NemR|tActQajHhtIqxMacKluXh_`@gq\\m{KzyIdkM_x@pqM|gKlhF|fFaiIjeQpwZsmTjmCztQtuE|wEe}BppEniFa_QziDb_AhqPrbGimLfkFbcFw]p`K`cKkvDgtIl_O|dMg|OzkG|eImnCsWly@ndIwuKjjM`vAjfI`bX_bd@~nKy@}qChnCh}AnyJf~Hg{Vz~Ep~J_hPrwQbpF~xBjoQgeVfeEzk@o{FfhT|mI}vMnl@htGvfI}b@ntA}hFfwL||Iz{DurDfoe@uz@t}Z}kX|gK`iFsMvtE}gL|yDg`Oh{\\uag@dxGhwd@}o@vtd@ypj@}b@yaNpiIn{Bb}S}eMjpQdT{qK~yP_hRl~FlgLpnAagD~yEztEzqAa{PjmRm|Dtrc@wiTjqCa`FrjIhtZebGyuEleOqmNdrKezLziDowFtlBduLhkMw_JtKrlNnlFxo@|kCtf@x`Af_Af}Ca|@voDamVlzEmgK}uFs|BqrJdcApkF}~CqlAgrAww@o|@yxBkRsoFsc@aa@ooEi|AygB{Uuv@}d@eeAc`Bsh@wzEd[{r@hCw_ByYdM}s@|`Aom@g[kaBuAmy@g^_RysAip@hs@iQ`]saAfVgdAqJywAir@akAx]yrPaeUwcS|{X~bKleNu~DffDygCh~O_kGzhBcqBpgCmmAbvDcl@tfE_i@lePu_A`rCytFr{H_rAtqAc_Bhc@shBkm@mnCcmEk~A}{Ay~B{Uo`BzbAaiAthGi~@juBo}CfwBmp@jcA{t@vyGirAlgGqPrrB]z|Hwn@x`CwxBj~AmoB`@}fFmmAwhBdu@c_Bv}Gw{@vnAsnAydCklApd@ecARuo@wg@gRquAui@?|]~wHqhAvmAgg@aDuqAez@yeA`h@sQlw@rh@~m@`_Cvn@?ve@qmHd_Cs^qyCujAcqBc_Bk_@k~AjzA{`@vrC|HtjAxcAzbAie@bmDt|@rcDsC|dC}f@rlCm}@puB{wJ`{MkoCx~GcQr`IncAltD`iJ|~KhAn{Bui@b{FtUj{Bp}@haD_FpiFz^h~@~zDmkDxo@`nHjwAfS|_@o{Bj_@o]h~@h\\loBnaEoo@r_Mrs@htE|VbNqbAd|Nkf@`xCo~Av~CiaBpwFev@ft@us@~@{t@w`@cn@gxAkdCr|Csc@phAzYrh@~dGlyDd`AlRvnAkf@~sA~yFfnE~vDnhHhuJp{Br}BfWdeA{ClcBcuBhwDenAboEx@~gBd}AtlF`hAbhA||CdnAv`Arw@nyArmCpcBt`B`kCfaI~gB~oDjwB`}Br|CpaBv~@`dJrgNpyT|~@pdDoQjLi~bBq`AvA_noUzlMUdzfFuoQlh@axi@VgikAje@aEj~Anj@sf@zjArEbdBxwDk}BeBp|NtgM_tOfzRjrLbF`qEytDa~@zlCh|G`cVxxH|{DveMtkJhiBxgBbZvaCtao@nqDxvCef@ryC"),
+	usState("Maryland", "orhiFrlaiMz}HtkDkxUobCgA}tAvrDxC"),
+	usState("Maryland", "av{fFjxdoM}i@kmHc}BrbFulBeiCh{Bk{Dh|G~NdvB`Xs@lqA}f@zRqA`eAhh@gPNh~Cg_DhF"),
+	usState("Maryland", "m{ygFtfaoMwjAzyHqiCw[ylB_}D"),
+	usState("Maryland", "yojgFfhdjMuml@oxYpyp@tsYrmClyAxK`zBur@ot@"),
+	usState("Delaware", "}acqF``blMtmInrJxeJusHpaFp`@lnBtuAbhGnoA|th@_bd@`l`@bTxkQ}{Or~Ig[~`Y_c[}Hm_O~{^ayBszJ~eCb`@zhIhuDixB?ppE|~BitIheE|yPdgFtnBc_Cu~NvrCo}Dm_Hw|E`vYwnA`hC}SfA|tAin@|oBXtx@nl@cF~@hmNwA|z{@mh@`xi@ezfFtoQ{lMT}FmrAsuD{gBq}DejDmlEikIs~@m`DcmA{|H?s`Mh\\evE|dDewLnhCroF`mA~tAv{FjcAzkBrpA"),
+	usState("New Jersey", "wmbpFjk`lM_`JigD_`K|dHcbL}~Imt@}sAwyA}bAsSufAypEcTwrDgfC_aD{sEoaCsqFixBo_Jin@giGsVetFmpBkwJsc@qq@zUyfBadEa{Gu`Br|AusB|g@_iAuY~^{jBetAmtAxm@cf@mqH}tFmtA}aB{{BgnHmsEutFmn@y~AipAwiH{mDgtGucAqkJsuAysDcyBklAefCbbAydCl}Cq|EflIwwD~aE}dIb`Ho}EpeI}oFztAeiBnpAjeAvsFaiApiCi}BjrBmsBb~@qeNdl@mdDbjBklAf|HxZnzDyX`nAwoHtsA}|@i\\qdEhV{jBqWilCrl@afGmmB}n@nj@qr@`Gel@ikAayCebBlg@qqBuIq~Bg}@_fBkhGajAoiB{_DqjB_~@yvBpUwsFrhFgd@fIme@lzByjAy@y}B~lByj@`M_bB_bAolDm_Hwy@_fFogA_tBk{FyuGs{AR{\\}XeaC{yFskIkcIyqGisCwxEe`AirAom@ymFqpIsgB{`AkVsaB}w@esAcQc_AmE_jCcgAixBbkfAatyCfuBnBp{Fz_AlfMbdFtbQhsJzaIbmBtsA~cB`cEx`GryAth@zz@xyEcFbdAagAiR{fH{oEh_GxtGvIbbAvpAvwDt}AxwB`iGfb@hyBzf@|w@`jDvdApfAxoFnThs@vb@nn@npAbxAcqA|nCqqAbgJ}lr@igNt|ChbEcsF```@pCzkaB~aUslu@ajGhVv{Df_CciBfrHdfKraAysCdgFf~DfjAanC~hM~jBd|MhqJreMquBz`Wzl^jeLsaEsmHtmT`xO_rCptCxqKxiHd_C~fGcxItaHjxUwpIkmHwwAdmDniLj_G|bCjmTvi@kmT~{]vm\\df@x~Ft~BctEtmJnqNl`I`~An}Jhq\\azNiLkpRijJcaMfiAgyEddYtjDvvSivIbvDusRhkd@cn_@l_a@"),
+	usState("New Jersey", "yowoFnezdM}nCyk@b`A}bHlxLzgP__EhuId_CkmH"),
+	usState("Connecticut", "}bj{FnbwuLhfD~m\\stEveHz~FtiDknAriOxcDxb@nyAhdPygOhhSz{M{~FxpEjt]}iDp|QlqFrdw@}vJtlB~eR`hSh_B~vNfiGrvAerBx~TldHl_GkxA~vCfaUvfm@tnA~wQnI`~And@xr@{eDbmAc_AtsAgxKxpIcjU}sm@i`NvyJsjsC_pKbnBozwBhVcqIxaDsFcSolGumCub@rH{xYfk@y`Fwl@qfDz}@yrrCjeaBmc@xcSlu@dOrpA~dC|kAtuLr}AhEp_BoKiWa~@pc@"),
+	usState("Rhode Island", "auk}Fj_zqLruDd`BazBrmHgxLomDqm@fLgl@taBlZxRhfCfNmdB|~HedMh~E`zS}iDnkFt~ByQrdKtsGccFlkJdjEpfPaxBxrUjtK`nIjpaA`yAthG{v@dg@ew@ilBiEq_BuuLs}A_eC}kAeOspAycSmu@cb_Bp^s]wxoAhjUkq@|_@eRpCqfDnFaq@nVgUreAoK~wLbl@xgAcLlvBqhBzmDw|IpzFs`EbpDbY"),
+	usState("Rhode Island", "sjb|FznnqLwsg@jq@faBwgKfuIjcApcAkcA~aOsx@"),
+	usState("Rhode Island", "_gx|FhvgrLk|HlcGcsFkpAtsGg{F"),
+	usState("Rhode Island", "kanzFtmwsLbqH{_AsRbsK}vL}vE"),
+	usState("Rhode Island", "qys|FjaprLtxQ`_GywNce@o{Ey|A"),
+	usState("Rhode Island", "ylx|Fl}arLskNstK|m`@jpA~_ExrV}fFy|GkxCngB"),
+	usState("Massachusetts", "_kz}FhtdqLubGczCfwLlxKw\\~vGlgCvaBqzFr`E{mDv|ImvBphBygAbL_xLcl@seAnKoVfUoF`q@qCpfD}_@dRijUjq@r]vxoAgbAzC{}@xrrCvl@pfDgk@x`FsHzxYtmCtb@bSnlGyaDrFiVbqIcnBnzwBuwC|eDmd_Cijm@}_AoZbXirdAn}Fu`xHeX_oB_s@e{AiiDu~EahAicGwt@u_Ay`FgbCevBetCoTioBnMcwFoMoz@}{Es_IueE{|Q`FqbCb{A{sIoh@a~E|j@rEv{Esq@lu@f`Jb{CkiJvqOy~DopEdnHnaE}W|fFofNvi@fpEzlBkoBj{CurMq~EaPqeDyiHzvJ{wEx|Q~zu@lxEltAwaCwiFbxCkbB`qEjkO|gFg}Dq}@`}LzkN{`AkpAfnOnpEkjCx`EftCaR_xG|hLuqGqhCu`O}mIxaEhdOkr]rsi@wn\\th@vdHke@e_C{mH`aEftIvgBzp@vuHx{GiuJ~eBenTlhWgiAzvJ}iQzzB}b_@piBzxJ_rI}vr@yjGiuLkuPndCgxAmxEu~Bd_IbmHf_Eqc[zaEidD`zKj~GxrCqoGtfJuxCgtIdlD{tThrJ}bLjl^kmP{E`gHdfGxo@tXc|GbeV~[ltAbco@hwHxaPy}EghAzgDxnXeaCpdDn|K`aKhgIt|a@gz@jgDixe@qbKesBt}OjwJfNdkJpxYrfFq]ghHdwJnfS|fG`vDhaQq}@jxDazBgaD}jDvrBd_CjmH|~B}sAx_AryC_bOrx@qcAjcAguIkcAgaBvgKuu@cV"),
+	usState("Massachusetts", "ios{FlmqjLzyUmyQjcDty@jMfi`@wzJflZ~~Aaie@odImgLppExbKaaK{qF"),
+	usState("Massachusetts", "cus{FvshmLa`FowGxcKmw@"),
+	usState("Massachusetts", "wjz{FnmwmLfxAuoJb}Hi}@ldAf{c@neHtpNggGlvLgn@qpKwkVgpa@f`DcHu_DynE`|BayC"),
+	usState("Massachusetts", "unf}FhrajLtbCetA|~N`cK"),
+	usState("Massachusetts", "ksj|FdjtnL`CgyGb}H~kPjw@d~L"),
+	usState("Oregon", "ob~xGdykpV~eCyj@z}D{uGpl@gsJcLceD`k@{fCzBocC}VqoCk~@wkAgkBy_BqyA_~Ccm@siLnV}xBxnEwdKbW{}DvpC{mK~xA}}Cf_BgrBd_CmmBnjC_rAnmFibApoEwnGlfBqd@vyGkq@nyEerBzpFvg@nmCmC`lDamCr|@{WdaGxe@lsFk]|jCuf@dgB_y@hvA{jBroCanHteA_hN{o@a{FhAg|AliAyiEr{@u~HnjA{yDzEcoOz`CqvNezMmsw@}mBoyFk}FskLegBqbGqWkoDbfAqtMoxBqw[f`C{gy@zhAugH`jA{|BdkIacExz@{nBaKaiCiuDmjQpSwjFgG{lEvb@m~C|VgiGibA}~J{gDebIq_Aq|LasAeeHqqAoaA_mA_eDgJi`An`Cqm\\cbAouG~h@gaIwEqfAi|C_dMzSiwJwu@slDqqA_bDqeCo~Dgt@opBqb@ycH}lGup[srEiat@ssCceGs~CgsDgg@ueBmLadDnMwoLatBg_ZwO_mo@y`B{oH_zEufGeKaw}Kb|EocAj~I_vD~mGetJjqDyxBjeAo~LxoCu|Dl~CqiJ`d@meGh_CwiD~yBuoAv~FgrBhnHkzEd_Cqo@hcCsDxtBvp@h`HnpHjqErtBh`Eft@lkG|gHjpEv{ArsDjwEppBpoAlgK|j@poEbnCj_KvnBnoFv_CxtWxfUr~@rSffA{LhbBkjA`bC`IvbHvbAlsBdq@vzEhoCzkKllIdlA|wAhiAbsBhpD~xJ`eBtsBfxVtpMzrFft@dtBp}@btDbhD~fAr~DdnAtT`rB`~BhgDg_A`kF~rB~nClSvfEmmBtnAmPdrEpi@|rBoh@dpAcdBx}BmpFeuC{iDgWctAfN}pAd~CkfCz\\e_CmkAoiJfUimAdbEg[zqAgn@t`@ea@~pB{rFfxAy{Btx@}]`rBz{AlpCrH`fAdXz}@tq@~TzbAtGliDlu@j_AzeBs@`sE}iDlbC{k@xfApGtcD|kAd_Gpi@viE~mAltCnVjkC`gAnqApdAloAxhCzcBx`BnoGgeAtv{IgU?zy|j@oh@hNskDlqG_nNvgR}fi@xhMwmTrj@a{WmzGcvQr|Beo`@jv\\ehe@wxV{ol@ekJwdH|zAoeG_qJteIa_DgwIwo@ioL_yMvsH_|BeCefM{bBlbHk{JthC|}OxuWu{w@_oW_}MktL`eC}qLq_FdjNr`LjqIyr~@yhOkwy@s`@{jSixDgdC_`EvoC{}E_rFfeE|aCjrEovLvDqwTgcCii@elJcHbkKidJp|@m~[_oA{eQ{gHez[cU}mPalGgq[cbA_uGzaGuwLkqGhbFhQcsFwgGkuE`hIupSkjCreJ_{@`wCeoH_qOra@ebBhaKycUyvFas@ueHch@t`HfqGx~Bu}KvVkoEruF}`UixCisKbsEizMccLkji@xkRxlMsqe@tsI{cD}_G|J`@sjCm}GfeNc|AkmPboEc{J{qKwmc@"),
+	usState("Oregon", "i_byGbznpVaaE~xDgh@mIdFuz@`uB{fDz_B{n@tk@~X"),
+	usState("Hawaii", "{tlvBrylp\\vxJwuOrhQfzJtvWhsi@xeDbue@nxV|ih@ffl@vq`@qkGxvD{rNf~`@qkTfdGadj@ojEyqgA|v`@_wn@k{j@mtGcfAwcXrfMycScfBjr@qyZxhHocDphMenWtkEgj_@~~Zgjp@h{OsxN`~XaJjCqsQjtPmnC"),
+	usState("Hawaii", "q`v|B`nqz\\|pOqjBikA~m]"),
+	usState("Hawaii", "col~Bjmi|\\rpKgwGtoHflDjcEzqXegUryDirGvzL_gFqeMprAqaR"),
+	usState("Hawaii", "wq}}Bradw\\xdOgiBz_LfmKtrLpsp@ud@roSinH`rGgm[bnAu_Dlm]mfTrxMwbPswDquDkkLhzGgaMfeOksGs}Gk_f@thUqw^"),
+	usState("Hawaii", "q}e`Ch`v{\\dyAomG`lElvBvvLrg[g~IvysA}xL{hI_cGvt@|rFsvs@m}DytCxkHolL"),
+	usState("Hawaii", "_iiaCrbqa]x{GysJbdHtbJmjCleAxkDtsQkcNjlO|sDvdIeyGrbCqsCiaFgjBvtDaM~|DnhEkJ_xCr~D|sKcvFtzCneUk_v@z`c@maAsy]oqUkySgn@_wGxda@o_YfgMnb@jiH{zKq~F}O}\\yiHrmIdpA"),
+	usState("Hawaii", "u|geC~egp]rjBodKjbMx`CrcKtlRvjLpvFmxLptEcrSoxV"),
+	usState("Hawaii", "}jefCzohk]`ud@llE`mP|cSinCzv_@i|W|qb@gcHiCwzPmtLyvMmz]~yCozHyyAui\\"),
+	usState("Utah", "}aj_G~nxdT~hbE_A?mifKbglWz@wSxhn]}}n]tM"),
+	usState("Wyoming", "iyfyFlmxdT{flW`DMk`ui@nynMiXdn|H~E"),
+	usState("Nevada", "ocj_G||`wTz}gb@kBpwBhSfkGdrFfyThwFtcBfxEvg@tqHoF|`Deq@bnCk_DxaEqfKztF_vBx~Bgm@jpBlCj}B~cBhfFnOf|BiZtbCs{AzmDitArgKbwBz_OvuDp{KzwBrqBroIyFrcAvZbxArhB|lDuIzdDu_AjoC}yA~dGcuFjv@uf@pfAgLvdAnVp~CtlBr{Al`@|xItE~hHip@tiP_cG|fCvn@hnGwe@tmH~bC|fPs{EndIaeEpsC{{@nfImE`jHspApyFk]ddChChxFjx@vp@tcBbN|jDrV`~@htAzSby@u[bnBitBxuAmWzy@xVj}CrqCnsGoaAgysAxmmBey_F|g}GcivEvm}Ggm~Ff~lJ__iQpC"),
+	usState("Colorado", "}wfyFpbqxSn@ybui@xebEkBx~hQahA?bqwi@"),
+	usState("South Dakota", "q`tqGrd_zRa_wD|a@t@mrul@jyClaBz{Jtv@zjBxh@daDx}BxoIfqE~|AzlB|~AjfD|yDnfMxzCzmExxCpdHze@vm@tbCnlA~bCpYbyKwyMnoCu~BxzJmaFfxDuiC`nAs|Bxd@umNry@yhGh~AeuDh_EktGviHitEtRo_@tz}IIrFvtZhNlSdrBmp@biDzNrmBwa@~mGcmHtzAwe@baIlAtxClSfy@~hA`B|nDt[xPvi@gEvbA_s@|jA{vAp~Aj|AzbAgBnl@auAb\\izMtwBw|@lwMa`BreHnyAfvDniFh\\nKxa@M~hBm`AtfD`uAp_DkIroBxo@lmB`{BzsEjTvkAhZb`A~_AryCrX|VtNrh@nxBbr@heAn}B~}@zzEhgD~oCvi@`sBqm@zdDk{Cv|E_~J~jBc|BrXiFplAf_Bpg@hSdrAkrC|gBcxAphAmYpxA[lr@o~@jjAqk@|{E`BybB~bXur@tjBetAfcAywBrVgcBlw@qrC`aG_iAjhA_pA`BuxCkwAmn@f_@}s@b`DfE~cIwk@~yCcvEncDycAdtAcp@bmBs^jrPa]hqBkuBvgD}XjhDew@lhCiTjcKcYxrCs}BltGm~CfcFstDjzD}iAljB}o@d_CqPf}CliDf|e@aBdhDgqApbMhWhjCp_BzsFhFfrCklApfEaKtnApm@~~FahAljLnYlyC~z@hoCxs@ns@hrArSvbEteBddBxhDxYtcA?npI_rBj~Lkc@ngAyhAxYmr@z_AqrGr~YujD~_GybC|cGqNbqCu~HroSw~C~_Ea@bo~`@iqeKbU"),
+	usState("Nebraska", "}wfyFx~azRkveKyB`@co~`@v~C_`Et~HsoSpNcqCxbC}cGtjD_`GprGs~Ylr@{_AxhAyYjc@ogA~qBk~L~IwgD_JwgD__ComFwbEueBirAsSys@os@_{@ioCoYmyC`hAmjLqm@__G`KunAjlAqfEiFgrCq_B{sFiWijCfqAqbM`BehDmiDg|e@pPg}C|o@e_C|iAmjBrtDkzDl~CgcFr}BmtGbYyrChTkcKdw@mhC|XkhDjuBwgD`]iqBr^krPbp@cmBxcAetAbvEocDvk@_zCgE_dI|s@c`Dln@g_@txCjwA~oAaB~hAkhAprCaaGfcBmw@xwBsVdtAgcAtr@ujBbeCiw`@`f@ktC~|@_jB~`G_vAprGrtAlgG~]`~DwqE~cBwFtv@ewAfqAebAlhBsc@noCyIlsIrfBbwCax@j`BmxA~tDy~HfjBcdAruFqGxiCeXxsDamDjtBcdGrjC{aFzwFcfAhuDdrAphA|Dhw@sVzfEq~BxeHuoJlnCi|@lxBxVrfF`sAxvBwN~nCjyBnn@nObiGlCzjC{PvoBum@vm@euA`qDfb@laC}FpjA_cCqEmmA}iAwpDnRoiAdtAy~Bzp@pg@|uCt_Adz@|JdjAgd@tf@uuAdh@}rHvNgg@t_A_g@teIl}@zmK|qCti@odBhtAafKfx@wy@zxB~zAxoBleC`]~cAzgGro@xeBaEhmAmtAzdA_wGjq@wmAlu@jpCv}A__@hfEsLzxAof@foD`uA~g@be@xe@?dM}[xyBwqA~aAtz@~r@|KbhAu^xwBaaDd`Ami@z}DzUhrAgJhxCylAb{CaQfiFvqA|qCjWliAuLjsAu|@rs@uLj_GdtFnvCbNf}FuxCpjB_hIp_A_vBrfCc}@nhCvE|hGjv@n_Da]zx@aqA~{@}nGffAq_BpbFbJl{A_w@bhBymB~cBom@pmD?xeDa_ChtEgxAfk@t_@vvAfiCtqAcg@rAcwIzy@imBtrFc_E|c@w{A|KebFr^_jBfdAcbAjlAhF|zCpeB~mGgdGtzAkd@jqDkpEnwDdtApiCx[`lBgNvfAseAzi@ibHnm@}kBxvBmgD?nfbh@yebEjB"),
+	usState("Kansas", "yoy`FhrxmRy~hQ`hA?ofbh@n{F}aJr_BspAll@ikEhwC_}Axd@gv@jm@a_Gh}DyiFvp@_cCg]_qCihFe{K{Ui}Fd_@epA|iAqi@rtA~Ah~@ru@~uAi{A~~BesGf_Acy@z`Gvi@fkAkVvvAaoAxeAdM~lArbBwG|hCgdAtlHzS`r@rqB|r@pwCpQvyF|cJhiBnbBljBpi@lkEq@rr@|U~b@heArFzdDlQdl@p~BdIv|BghAh_BmoBfe@{hBjtIomFr`A}cDtrC{dGviDitAnm@qrGppBpv@dxFmn@hxFkhCjpBauEf{@w{@tfGea@b{@kuBn]e}BbnCksGtj@oiC?g{J`Rw^|u@wLfJiSrHwsCt]geB|s@}K~hAnwA`q@yMjGg[zu}Kz@"),
+	usState("Oklahoma", "g}w}EnftsRqq`BnB?ggdr@zs`Bx@boxEi_b@lrrIjxGqJn~Dmn@npBzi@?g{B|bKqy@wg@iJxdCjVbbD_t@pn@a{@d|C}Jt`Czb@jrB_dAbxAam@|kBoqCdbBynAnbIu{@jxKu~DniFqmDf{Bk~AhzCw}AfNo_Avw@_g@r}AyDbxE}X~~Aox@baBqhDtfEa}@zlBuf@vbE{sB`{Ame@neAetBl{KdMteBnnAjuB`|Ade@~lBbH`cAl`@ia@lgAvp@zd@bmAtjD}c@voBbcC`d@le@~wBsDjmQgfAtoA_QljBnq@v_BgxAxs@_AldEic@~mAqxAoz@w_B~o@akCleFyXdqAzt@hfAhvBbrAw`@`vC||B`lHjqAteB?tiJbg@jj@|nDwYbg@tcAmL`j@_gAlbA`Mpp@jfAriAvWnnDmNxfAc{Dp`J_w@jaA{Nl_Abe@pmAvhBn|AaBlk@zlBxrE~jAbrEhHpvKbtA`aGxj@v{@thHxjDr|@rtBfIvoB_XfiGvQlbDbw@tf@j|AmA`aCzb@xeBfkDmsClyDqgFvpDosCjqCuR~pBtRjfL{Snn@uxC`z@oYz`@}y@xgC{`Ah`GavBhvBs`AhHurCknAepAjcAat@vyCeOvsCbc@`z@ht@tf@n~Er`BneAzdApm@peFuIfyBwpCrcGdQ~pGoOdhByfCnoCwiHr~Aqy@bhA}^btAuGtpBx_@rtEj[tcAvn@~k@zgFbHviDt`BxgBz_B~]jiCkq@l|Ep_BuGphAu~Ap}@sVtk@lZny@vvBlbAhZnuDfMz~Cjv@ndBzsB`EldEylAnqCykCtyBmdDvrAevCh^whBqYw|CguBedBw\\_mArOmeAro@mjB`sCv`AjvCprG|`E}{BzeD{NfjAjv@dbBbgCtcBptAvlCtRteAbUhsKos@bcBoqG|u@koA`q@wk@b{A{SnlCzUzpKam@n{AqsBhw@}fFeDmhBtv@a`BhkFxcAxtFrqCtyEdbMdwO|zBvuFxf@ppG{fApiGq}@nlCs|@h|@_dIipAi{@VoaAth@mhA}e@y_AdKip@|l@xKl}@k_Ax|Ees@vqHobBxkFwmEjImcC|_@c~Ic\\afA~aBxRp}CjwAbdHxRf_EgxAzbY`d@f}ClzAbaBhqGphEdVzuCVnwHem@h}AoiEvoEcoA`yBktCbcB{`@neA{e@dvGzUpkC~nC`aLca@pjAirDtl[{~FptMcYdkCngAblIajAvhJeMx_CjInaHaV|jCsgA~iBmcBrXmqIdOqzB}n@qlBhkBomCp_EgqHb}CylArhJyj@hu@}}BzhAkcArrBwAt|AheAv}@dvHjd@n~A`q@nd@f}AyKlrCqwCnoJcb@rlHri@rmBjaBprBj]bqBk]fyJ{\\|rB_aFdiE_eYh~_@ia@p{@kTliC_r@nxCvg@p{JmzvJjR"),
+	usState("Iowa", "}xegGrnilQsTwkA{i@gg@uxCmScaImAeaAxNudGhfHg|Aj{@_pDaNo_Dvs@iNmSyKc~r_@vvA~IvuCwWz`GmsCdmF}n@x`Aeo@hj@chA`aD_yOvlCkxCxgFuIp_EvsBfpIpjI`yEneAztLu`@xrEko@ndFaBnqCqg@h~G}F`iC{]|hB_cArcDqqDzvAi|@hoBqd@~{Jyh@|vHusEjoAcmAboAqkCl{AoqGryCyj`@lpAo|Gft@_eHj|A_aB`}FqxBnxFioEx~Awr@bfAbNjmGr~CnwAmkAvmAs}Df}C}|Db_C{fEdaGywOdqC}`EpsE{lBqi@?~bJwN~nC_w@`{AwkEhqC_eCvn@wgDzpFeuOtzDy{E~k@cyC~k@ki@z`Gs\\|uGgyCzqI`x@bzE~lBx_Kfm@|wGnwAlcCdnAxuAnrEx|CheGz`DtmDlgG|oCrfCfW~yBzxAh{Agn@dsC|@dbCpk@tjA`~AluBnqGlg@fx@nuAdo@faAbsBbmAleGftArkRri@dbCnuE`mFdwAf~BlbA|jGzlBx`w@h|BluF`_E|sCruFp`CtoGd`BdmGbr@n_Cq@t{Cg^hcCsgA`qAkwEbzDoyHd~DasDd}FyiBj_Haf@deHnVx_F~lBdbPjjRryBhmBl_Bh~@`bB~[brG`TtgCle@rzFxxBhuDv`D|vBbxEr`A~uF~RzyFtf@f_Ep_BzvGrvB`{FtkBzlBbj@fLbaAvy@l|@hLlt@kVdvBwgD`eB}w@`nByBh_Enf@niBD~`Gwi@x~@dw@vrAlqJyq@r`Aiu@htDmi@pkAokFfxGqsAmNauA~x@yiBxgFw}A~m@g`@jeDgtCz`A}jAvrC_w@v`EmzAdgAilC|Oyd@|X_{@`rBg}AbiA~qA~sh@da@pvmAdtBjaqCcYtczAncAfhnBxH~vbAyjDpxzFyoFyZsfCb}@q_A~uBqjB~gIg}FtxCovCcNk_GetFss@tLksAt|@miAtL}qCkWgiFwqAc{C`QixCxlAirAfJ{}D{Ue`Ali@ywB`aDchAt^_s@}K_bAuz@yyBvqAeM|[ye@?_h@ce@goDauA{xAnf@ifErLw}A~^mu@kpCkq@vmA{dA~vGimAltAyeB`E{gGso@a]_dAyoBmeC{xB_{Agx@vy@itA`fKui@ndB{mK}qCueIm}@u_A~f@wNfg@eh@|rHuf@tuAejAfd@ez@}J}uCu_A{p@qg@qi@~p@c}@hwC|iAvpDpElmAqjA~bCmaC|FaqDgb@wm@duAwoBtm@{jCzPciGmCon@oO_oCkyByvBvNsfFasAmxByVmnCh|@yeHtoJ{fEp~Biw@rVqhA}DiuDerA{wFbfAsjCzaFktBbdGysD`mDyiCdXsuFpGgjBbdA_uDx~Hk`BlxAcwC`x@msIsfBooCxImhBrc@gqAdbAuv@dwA_dBvFa~DvqEmgG_^qrGstA_aG~uA_}@~iBaf@jtCia@hsG}{EaBkjApk@mr@n~@qxAZqhAlY}gBbxAerAjrCqg@iSqlAg_BsXhF_kBb|Bw|E~}J{dDj{CasBpm@_pCwi@{zEigDo}B_~@cr@ieAsh@oxB}VuNsyCsXc`A_`AwkAiZ{sEkTmmBa{BsoByo@q_DjIufDauA_iBl`Aya@Li\\oKgvDoiFseHoyAmwM``BuwBv|@c\\hzMol@`uA{bAfBq~Ak|A}jAzvAwbA~r@wi@fEu[yP"),
+	usState("Missouri", "k{_uFfmufQsJ|dBuqAbg@wvAgiCgk@u_@itEfxAyeD`_CqmD?_dBnm@chBxmBm{A~v@qbFcJgfAp_B_|@|nG{x@`qAo_D`]yaDu`@~jDeyzFyH_wbAocAghnBbYuczAetBkaqCea@qvmA_rA_th@f}AciA~z@arBxd@}XhlC}OlzAegA~v@w`E|jAwrCftC{`Af`@keDv}A_n@xiBygF`uA_y@psAlNp{@su@|nDsaFli@qkAhu@itDxq@s`AxdCfaEjvGtoC~~Hp}Az~Ftj@v`HpCdh_@cdD|}Au`@|tF{cFp|A_n@rpEaFbvDvr@|gA_DtrBomBtqA{g@l|BwiD`_Ayf@dtEgYpqE}kAxzCa{Brua@e~o@t|AcaB|sByiAjzEecAdiAw{AzyX}dl@vhB{iCzuC{pC~mJchF|xBgi@htI?`lAea@rfCeoBncAgWrxE~cB~}CiwAhfBq`@pfBsF~lAn[roM_yGn~Ds`E`lAu`GibBumG{tKkhKit@k|Hbd@}vBlmBytElb@kfGvgAc{HzpGegRr`DgyFzdDwdBfnBzm@jhHz}Ef`C~jDrxCvOhzFu^`aG|DdcEjiB`nKhyHfwTz}HfdK|kHvdEx|A|fFdXz`KoyArdEmcCxkFcxIjx@cg@noCcoDluCwtAviAqlBdkBogFtbCaaDf{CsuCnaCciDzu@g|EpzGwvLkBsfFh`A}yG~`CksEdfHabJroAatCraC}~J~sAmyCfmDqwCtuIqqD|aCkiC~~Ew~QhtBecDdkApm@bhIixA~cIr`Ax}B{UxwEybC|gEqjD`wEurCneG_|@t~EzjBhjDfzExpDpvDtpFj@htAkpEp`AacB|pAtIjgB{RzqHm`EhwGkxFprDkzAfsD|h@j~AmdBvmEqxHvtAk{FHygAgaAq^i}AxPkmCv{CcjCpbAy|@ih@oKafGt]sg@bkEum@ftCoiFxgEkeAfv@ux@nM_eAit@kpE`l@mzBvmBa]~mGzg@zbG?f`DptClhAdXjbCeVfzEs}A`kCgIxcDfo@voLjyFzgJlyC`hDvaCffAvgDct@dbEoxJhoM~]teAvnC`cBdxUz~FzkC~kC`oAteBbb@hoA_c@jbDi{A~IqbGkiBoxFloAgsA`uEzfAxkE~yEpr@h|CijCjzAcr@~rBzi@~_B`IdaGshCllCyRvgCjaA||@vqBnMvtHrp@hvBpqBwLn_Ck|ApzA_vBzg@m{Crh@o|@xcA~Vtf@~}@nObkA~PtdQn]dpB`sK}rI||FycDbqBrw@fSfaBnoBp|Edz@zi@~eBbYtbGh~DxtEtq@jxAbz}BqH~d@{WdIsvAkRo{Ia|EwbI_uGog@qt@wc@g}C{k@{_BowAcv@ywBmZ}mA{}@gqBg|HakBw_FmtAgv@{wCed@we@ks@wt@}~DyaAimAux@{\\m~@eHywDl[kzD{i@ekBfCodBbrAsqCldGiz@bZgxDz[mhAl_B_yEbqB?|ieZwj_OuBkGf[aq@xM_iAowA}s@|Ku]feBsHvsCgJhS}u@vLaRv^?f{Juj@niCcnCjsGo]d}Bc{@juBufGda@g{@v{@kpB`uEixFjhCexFln@qpBqv@om@prGwiDhtAurCzdGs`A|cDktInmFge@zhBi_BloBw|BfhAq~BeImQel@sF{dD_c@ieAsr@}UmkEp@mjBqi@iiBobBwyF}cJqwCqQsqB}r@{Sar@fdAulHvG}hC_mAsbByeAeMwvA`oAgkAjV{`Gwi@g_Aby@__CdsG_vAh{Ai~@su@stA_B}iApi@e_@dpAzUh}FhhFd{Kf]~pCwp@~bCi}DxiFkm@`_Gyd@fv@iwC~|Aml@hkEs_BrpAisJjjOom@|kB{i@hbHwfAreAalBfNqiCy[owDetAkqDjpEuzAjd@_nGfdG}zCqeBklAiFgdAbbAs^~iB}KdbF}c@v{AurFb_E{y@hmB"),
+	usState("Wisconsin", "awjtGh_qpOvqg@}dcBzhGxmKziNpeYltFxqHvrOl_Rh}GzhGp|HpiEdpH`vG~}MvhEhqVzyKvrOltFjzQjtFxnRfjFx`P`vCfwTbdHxte@b_Kv|f@hjIhgjA~kEx}GwMl_RypAjroCs`UzZvjmA?bliA}{BnviPkdCwmAcfAcNy~Avr@oxFhoEa}FpxBk|A~`Bgt@~dHmpAn|GsyCxj`@m{AnqGcoApkCkoAbmA}vHtsE_|Jxh@ioBpd@{vAh|@scDpqD}hB~bAaiCz]i~G|FoqCpg@odF`ByrEjo@{tLt`@ayEoeAgpIqjIq_EwsBygFtIwlCjxCaaD~xOij@bhAy`Ado@emF|n@{`GlsCwuCvW}wHww@efIpp@ydGiVq~Ejo@orImjAauBXsrD|f@yyBt{B}_Bpy@ajBxOefIuIevCr\\mcCfbAwvAlpC{hAziGgqA~o@weCnoCaoD~fAi`Av`AcZ|iAuXxxGurF`tLWf{QmO|lAu]~lAc|MprUupA~pFwvBpzBq|SruMecHblFowR`x\\}yAbmIuxArwUg]vrAmxBnvBelGl`DseDvrDqzBpsEw{@h{EqsBvk]gyBzmGavCr~A{t@|mBm_IhvLgtDh`K{jBnfD{dK{`G}gD{g@ktGvi@mbAeSwpC{jBwjGrZyhGtx@{vGptCaiCvg@gz@stA}_@w~B_vAuoAczBub@ykCrFqpF~gCwkEur@wtGoFgsB_XkeBys@mmEahGkaG_kAauEysDmoBmZovBp\\g{GfuHmjItiCe~AxeAgaAjpFxDlxHol@`oG_{ExrBu`Qw`A_kAux@mrJq|OuxDwkD{tDzYcqI{yFezCmlDkuAauHotCioIye@itEybCsmB_gAukBmL}|Hsa@c|AcdDcjCcw@wzA~`@wwKkt@q{@itAk[gsAmdAcdAitAwg@}kAkPwxBcqoBaEwSyMf`@efF{d@ydFc~@cr@i~C{\\yeAm`@wl@ux@{m@miDiyD}{BsrA}~DnxF{i[uce@o~_Ba_jAq`~CdhCa_gCvahCbzwAduDmy@tk@ecAjDczBw_AkeEbPck@~uBo`Bn_@{rDpdAimBnc@geEd~AamChkAgkAxqUguLz|Byo@b{@eu@zqd@mdbEp~Tgav@cEcgGlt@sxC_c@mtDSkiBnlDaiQ{Ceu@wqAkoDxMymCko@i`Dt@ebBbcBchFZifEh_B}dGiJ{hHvzBu{F~TuvJjuAohGmE}vFlhBcoExcAibEdj@}p@`jHkxDlzAnZf~Cp`Dx~@tf@~uAgNly@w{@neAukCrl@wdEcb@o{HbrCg|Ero@ahLdc@eiAjyBkbC`rB}rEhnDiiEpd@qGtb@nOn|@f}Ada@pNzl@{Cn~DmuCrrAm`@vqAb@zvAxf@jcAblCbw@rDb~Bax@jaEes@~}@~@`~Axo@xrA~hA~{CreGnwAdTr_CoKtsEz|ArmAqv@~t@wyCgm@icDn_@w}DiFkpCyuDuqNxV{oAjzAyyAzv@cg@`_AkEdeDtoDx{FrzCl{FbzBntGtq@xj@oFnz@ej@v_EwoFbeCymB~{@ghBhbEssTx`AyySkrWokEgjFu{PatNs`UokEokEggPekM"),
+	usState("Illinois", "_khlFbm{gPalAt`Go~Dr`EsoM~xG_mAo[qfBrFifBp`@_~ChwAsxE_dBocAfWsfCdoBalAda@itI?}xBfi@_nJbhF{uCzpCwhBziC{yX|dl@eiAv{AkzEdcA}sBxiAu|AbaBsua@d~o@yzC`{BqqE|kAetEfYa_Axf@m|BviDuqAzg@urBnmB}gA~CcvDwr@spE`Fq|A~m@}tFzcF}}At`@eh_@bdDw`HqC{~Fuj@__Iq}AkvGuoCydCgaEwrAmqJy~@ew@_aGvi@oiBEi_Eof@anBxBaeB|w@evBvgDmt@jVm|@iLcaAwy@cj@gLukB{lBsvBa{Fq_B{vGuf@g_E_S{yFs`A_vF}vBcxEiuDw`DszFyxBugCme@crGaTabB_\\m_Bi~@syBimBebPkjRy_F_mBeeHoVk_H`f@e}FxiBe~D`sDczDnyHaqAjwEicCrgAu{Cf^o_Cp@emGcr@uoGe`BsuFq`Ca_E}sCi|BmuF{lBy`w@mbA}jGewAg~BouEamFsi@ebCgtAskRcmAmeGgaAcsBouAeo@mg@gx@muBoqGujAa~AebCqk@esC}@i{Afn@_zB{xAsfCgWmgG}oC{`DumDy|CieGyuAorEmcCenA}wGowAy_Kgm@czE_mB{qIax@}uGfyC{`Gr\\_l@ji@_l@byCuzDx{E{pFduOwn@vgDiqC~dCa{AvkE_oC~v@_cJvNpi@?qsEzlBeqC|`EeaGxwOc_CzfEg}C||DwmAr}DowAlkA_hC{oA|{BoviP?cliA{ZwjmA|b]dnHrvpBnlZIde|@bksMvgAlZxVljCbyJf|AroBx}Bt_AnkBi\\zqEemCpkGdDtqHlrKfnF~Tds@mb@xjA_jCjvCg}DtnBo~@hqIqzA`cEc`GjnAud@~~F?~cAtRjaBvvAbhAlSpeFmdAfpF_fC`tFkpAxvNlcFvSh_F`cAtcBhhBj~An{Bvr@|{Bo]bxAbtA?we@fzAv`D|eAv~@~|Ap]x`BuTjbCihBlhA[xcA~i@_`@teA|Azw@z~B|eDpoA~cEpn@z}Dpm@?~hA{}Av|Bz^zaF~}DhtAdxEbdAjr@fqEb_BrmE|l@d]dh@cZ|y@wrChbAbaAv}AbgD|wA}_@zbAugAjz@ef@dFbWtwBvkAvy@thEfoAleCbuC`jArSnbA_O|zD{hDzt@cvBffAqlA~nBl_Alt@xaE~|EvsIjf@}bBiO_dEdaAgzAvsB|~DpqAxcHrxCqvAzhAbkAncDso@n~AaDpi@n]|_@nz@tjAz@jqG{fA~k@~Rjh@~aBqi@ntIbhBwfBtgF{qHldAkf@`[jaA{C|sGfLfVvy@sUxV{bD|n@eqCtqAnZd{Bt@`kCx_AxbIbaMtsA`gAhhC|nAbtCxh@|vBmi@piDugBb_EmxEllGwhCnpD`q@jgBngEjdDd}b@reDzlJnVrhBdKvyK`j@tgBfaAjqA|sAd`AreGfwBxtFt[dbFow@fnE_fCfzEo{D|{DimBzfEsB~{FnbCnrCnyDt]dgFmy@tvFmlFn`MgqSjot@mn@dnHd[`aH`{AjdGnoC`wE~}JftG~aEntEx~DzgCrcDiNpwCspBhfE{uEal@lzBht@jpEoM~dAgv@tx@ygEjeAgtCniFckEtm@u]rg@nK`fGx|@hh@bjCqbAjmCw{Ch}AyPfaAp^IxgAwtAj{FwmEpxHk~AldBgsD}h@qrDjzAiwGjxF{qHl`EkgBzR}pAuIq`A`cBitAjpEupFk@ypDqvDijDgzEu~E{jBoeG~{@awEtrC}gEpjDywExbCy}BzU_dIs`AchIhxAekAqm@itBdcD__Fv~Q}aCjiCuuIpqDgmDpwC_tAlyCsaC|~JsoA`tCefH`bJ_aCjsEi`A|yGjBrfFqzGvvL{u@f|EoaCbiDg{CruCubC`aDekBngFwiAplBmuCvtAooCboDkx@bg@ykFbxIsdElcC{`KnyA}fFeXwdEy|AgdK}kHgwT{}HanKiyHecEkiBaaG}DizFt^sxCwOg`C_kDkhH{}EgnB{m@{dDvdBs`DfyF{pGdgRwgAb{Hmb@jfGmmBxtEcd@|vBht@j|HztKjhK"),
+	usState("Kentucky", "_le~Edhw_PgfAwgDahDwaC{gJmyCwoLkyFycDgo@akCfIgzEr}AkbCdVmhAeXg`DqtC{bG?_nG{g@wmB`]ifEzuEqwCrpBscDhNy~D{gC_bEotE_~JgtGooCawEa{AkdGe[aaHln@enHfqSkot@llFo`Mly@uvFu]egForCoyD_|FobC{fErB}{DhmBgzEn{DgnE~eCebFnw@ytFu[seGgwB}sAe`AgaAkqAaj@ugBeKwyKoVshBseD{lJkdDe}b@kgBogEopDaq@mlGvhCc_ElxEqiDtgB}vBli@ctCyh@_}E_wCybIcaMakCy_Ae{Bu@uqAoZ{NomC~`@}kBvoBk~EbWurBwiHc_I{pJr{D}rAtWabC{k@wr@}kBrQ}dCbsFkuHhp@grB~TakCitAmmLkuA_zCwFugBpr@ypAptAqJljE~_A`~AuMviAomCiy@gzDekCs_DgjDg_A}_C|]ktDlqBsjCjXcmAeSkf@qn@cGew@|Oym@~m@{`@pgBzEx|@qRzbDgxEcg@grFsoB_aGwt@}eGdZ_eCp~Be_HpiCk`FjdCcfL`pCs~G~o@qtGx`E}iAx`Bo~A`hAyxBkgBkcEiqCmdBkhKojD}iDcrCctAeeEy]sjJg`@ksAanB_nC_pC{nFeeAe`F|oBybB`dDwr@ngDwfCn{Bo~Dx_@oyEkj@usGbYmvBniFhvBz{@syAdAeuCebAiaCo}Bsq@czBZk|Aed@yx@ioIi{@{mA_~AoQsyKzRadCk]ew@yrAuG}dCqb@inCslAyiBceC_XcdAfb@{aBpnBqaAxVmy@y_@xBagA~]_gAvrAi`AjR_kAqJkrCmxAufGgaB{lBsl@~q@o_@f`Cc{@p|BisBhkAki@cdAtIicCdj@qpBliEwbI|gAow@`fGjTv_BkIhyAox@fcAabBn`F{wM~PkzHr\\ukCngEokF~sA{eC_n@kz@ihBs|@oj@wpCej@qoHybCctDuwEkeBcuQyx@y_Co\\oiC}x@kmBasA_l@whBiw@o|@u`GofC{hAqsA?s~@bg@e_DlmA}~@x@igAex@gkFsu@y{BwgCk|EusE_gCegPyrCstDwxDao@gkKww@g_B}yDstDs`EwkIm{AawAqsBq[_sFfbCq_Kpt@_yEb_BcuBeBwr@csB{t@aoUbNklKjh@alBv`EkuEtz@gyBsj@w|FqzL_o[q`ColKkVwvEvi@c_Oqb@oqAenE|sA}xByk@{}DsiD_{AqGaf@hgCuKpnGud@bcBulAlkAmhFiuGakBe~@}iBf`@amFnhG}gElN}cDk|DybF}tMuwB{}CcNyjAjT{v@d|AonA~|@{|Bd}F{}HhSohGc_EkyOsh@uiGf{@cgCfeFcyCxq@seA||@wrG|kBkfGvmA_}AvhBi`AnwHaqAn|Di_FzeBsz@boBuf@vtGq[lkBwy@voAmhC|kEoxTbLgiCitAsmPnOcrCj|Ac|Fhz@ciG~nHesGloImfLvcCwvFzm@snGi^w|B{hAglB{dBelA}sBe\\qg@}eAwoB}wL~IuhC~g@_aC|gDavGuBk|EloA{iGlvAezB~_EetCvbAklAhO}n@oh@a`F{}AgiDwHuqC|h@s|GeD_cCcw@ynBuoFktCipAixEsbF}qGmr@o~GovCc{JoSeaDli@iiA~nIkf@~|K{uCtnFyuDfwB}nGxnAsaHzuBaoFtgJ}`MfaHcfDziAu}@`uHvX|sF{eC`dCqYxyEp]beDaDbd@fi@dd@t}Bf|EvS|`Ah`AfoAvcCb_BrQxfQkxN`iE{~AxzGm`K~y@ov@pgB}XbnCphB`]sDjqDy|Gl~@cfAtkBacAxpFeh@vzA{`@pxIcyKtwAimAjaAmj@naAiHzjH_uBb~@qrA|vBmqId`Em_EeTmy@tGao@lyDqrFru@gb@vcBo@tjA}}Bp|BkmNfE_kAsa@eyD`EycAbr@qsBbyq@nljAfqA|vCzxRpdq@tfD|pHttCheDhuGeZpdChu@xx@zxApxEdhPfpCjqE|p@na@hiHnD~|A~d@`r@bn@bvG|~YZxhGb[rmA|iBb|BdiE|pBvjC~dClqBqErr@xm@~Sdu@boAx~RxlD`aS|eDnfItf@rrBjo@ruQndDhqQlm@fwBh~D~rGz_AniDbp@l}EisFztnGve@drz@w|@jauAkuAficAceCxvw@hj@|uGhvAjjzFusD~eCwLvp@}`Bfwe@lJdh@~]L~cJs}B`bIuqA|dIaBtpClQ}@vzlGmuTmnFukBi|@aaAmlBnxJioM"),
+	usState("Kentucky", "{dx}EzjfaP|FjxKmcC|oB_zEqr@{fAykEfsAauEnxFmoA"),
+	usState("Arkansas", "o}rtEjpdfPrxE_qExfGjF~sE`qErzCldHz`F`xTpoAhgAhkBuYn{B_fAnrB{^hgC|qCp`@~dCeDtkCa~@~uBbRxlAz|BlxAtzEdkBvgGpt@bmLgnBboOb`ApwEwAfvDlp@|sDzhEnsCzrJlSvjIsgAh_Cx_@j[pw@`@dmEyjArlAsHlfDnkBh~@nR~Y~Ygo@~aDhJd{B`oNnlGzpFxfNl`AtsAzoD}DjvDadM|oFiMxmBfoAfp@ldBp]h{KvuCn{Ib{@pnItk@ns@juAwWnnBifFfjD{eBrvCpzAzkCvuBrtEkf@fcBcfAjuBme@~bCloAtVj}@zQ~nFde@|{@lpDhdFbrG`tFnpB|pEfx@fPriBkyBfiBabG~qAybB|zC_X|aCzu@j}@rjBhSh~Gjm@paBxeBa[zeBw}AjqAqyEviGenI`eHaKdnHvu@r_GnhF~dCbbAvxBcvAreA_eGfx@irApkAg`@hvHuDboE_eAzjBcNvmBre@vsBbmIdlAvt@hkSh_@fTjnpPabiBtd@gz@byCapB~sD~o@~pBnDt`C_ZlxByfBrrE`]~|A~z@npAx_@vtAiaCdlHbA|hBjjAv~@nbBpk@ru@z_Awy@p|Btv@bcCiSvqBq{@tk@}~@gmA{e@?bSt{Cce@hwCgwAzqBmsFniBaTljBmrrIkxGcoxEh_b@?}ieZ~xEcqBlhAm_BfxD{[hz@cZrqCmdGndBcrAdkBgCjzDzi@xwDm[l~@dHtx@z\\xaAhmAvt@|~Dve@js@zwCdd@ltAfv@`kBv_FfqBf|H|mAz}@xwBlZnwAbv@zk@z_Bvc@f}Cng@pt@vbI~tGn{I`|Exz@vSts@qJynAc`_Cxk@tI|~C_sB~gCocD`~DsrAluBbi@wSh_B{uBtvDnMzgCdm@ntBnnAh{A~rBp{@`_A{bDn`BafDrqB{v@liG`hKpsIpz\\rrApvAnnBfElpCq|ApxDsnDvpAp@~hDxwEhy@`h@fhA_ZxrBo`BxvCmsAfmAp_AtsAr_Mj_AzkArhB|Zh~CtBhfBx]~_E|jDvaFxkIxbBbkA`pCj[d|GcHj}B_XptH_zBreG{}@||FvC~yC~`Cwi@~hHvAttC`kBf_AdyBzLrjBvg@f}AtaAdpAb{AxiB|dFvbAliGfm@~cA"),
+	usState("Tennessee", "y~azEvmibPmuBci@a~DrrA_hCncD}~C~rBsaGk|@ubGi~D_fBcYez@{i@ooBq|EgSgaBcqBsw@}|FxcDasK|rIo]epB_QudQoOckAuf@_~@ycA_Wsh@n|@{g@l{CqzA~uBo_Cj|AqqBvLsp@ivBoMwtH}|@wqBwgCkaAmlCxReaGrhC_`BaI_sB{i@gsBnlA}FkxK|pDxlAh{A_J~b@kbDcb@ioAaoAueBsnDm|C|@wzlGupCmQ}dI`BabItqAufHpnBwdBjC|~Aevf@vLwp@tsD_fCivAkjzFij@}uGbeCyvw@juAgicAv|@kauAwe@erz@hsF{tnGcp@m}E{_AoiD|j@y}mIepBywDcN_mBvp@eco@`hKzdH|}B`x@`uDsbBhw@kBndFrbB|~HhwDdeAkE~gFsgDbr@rAwOv}EgxAdhJ~pGdcOrlCrdElb]btRp_BjnBt`Cp|EnSlaBsi@bwDk}@`uAqpChn@ec@dl@iZngEqy@r}BnDvw@hvCxiH~@fxD~mDtxOpcAboBtxDdxEtfA|~@tzDhmB`aDx}CriEz`KdZf~AaXdvBe|Ar~AatBhg@asAyKggBsn@_c@`I_lCl`CfBngB`fDhaMx|DtjLvp@|]~uBzG~|AjzA~fCvoAnRpd@eBthAc_BzwCaf@xdC|Mp`Dtk@fuAjwAtq@pdAxGncDyeApdAdOpeBjzAjtChy@`|D|xCtsDvqElHhe@kv@|hMvsCvhJdeArjGhqCd~DtyB`pHp`CdxC`oCn_CtPvwAsJndBh`@`wB~xDrsF|qCtfKbkEntF|j@hrA~aA~zKqLrtAoz@zxAgN|iB~g@b_E}IjmD~[nsCgMndGz}@hwFzdDfqH`s@d{Fbw@z{CjY~d@fhD`eBviAxlA~yBrhJhzCtzD|kA~d@|jDqWz}@n]d_CllB`|Ajk@bjFhQdkB~nAxbB`yBd`A|gBha@phOcGlcCufAxiBkKfzApm@brCbyDd}E|eC||@~ej@vuC{FfpiBf\\bsyBaf@~ysBV`bzBaaBzzxCwYz{_C?jeAniAig@aMda~KolAxs@gm@_dAwbAmiGyiB}dFepAc{Ag}AuaAsjBwg@eyB{LakBg_AwAutCvi@_iH_zC_aC}|FwCseGz}@qtH~yBk}B~We|GbHapCk[ybBckAwaFykI_`E}jDifBy]i~CuBshB}Zk_A{kAusAs_MgmAq_AyvClsAyrBn`BghA~Yiy@ah@_iDywEwpAq@qxDrnDmpCp|AonBgEsrAqvAqsIqz\\miGahKsqBzv@o`B`fDa_AzbD_sBq{@onAi{Aem@otBoM{gCzuBuvD"),
+	usState("West Virginia", "wwniF`wawNfuA}dFsU{oGotCaoOo]ssMmb@qnBokByzAwmBum@sdPgdCkfCgdAyx@svAra@}jHwSyjC_fBo~AorCfI_{DprAkxCar@a}CfS{fHhuC{pC_QijCs_FewH_wD{~Fwi@s_EuaBepFaqDqwEorEwtBkeEfA{rCnaC_tE`_AocEfjA}rA`aBux@tnEchAdc@vLll@tjAdrArQr`Ahl@hcAqk@hn@stBuv@ofCucBoxBowAojAs_FstAq^q`AhT_kA|{AemBjT{bAg[{yBmgAfBwhE`aBo`F}]cnBpJm|@twB{jAfwAumCvp@aeBe}Ikx@ip@kcMiDcaBsn@ipGckH}rEu`Bu[e}BoBqyCkh@cgCpm@{i@gk@mvAfk@_vAglAsgAcqFgk@gnB_q@i_JavMgd@maBt@kiGxo@my@|aFamCpdAmzBmNesCu}CmxEkyBigOyo@u~Aa`Fw~GgfA{wFk~Ao}AylEsoC}nDchEihL{uT}~DwmLyiFitA{~B?mxA__CgwBaa@grArSe~CxcDkjAnb@as@wq@icAcgCmcI_xBicDg{Cmr@iNskEljA}~A_Z|h@iuCaG{mAk|@yh@cwCxh@_lGouCamC_SeaGbE}vBiVscNg_Gi}Esl@cdKi|DaaEufG}`Ec{@wc@mZc~Exo@__C?ad@deAyD~x@wpCeJatFu~BokCe_@}yApUc_IzaE_vEnnEwjB|l@m|Bm`Aw`AapBkh@_`D}By|C~m@ghBmwCqvGmSw_B`vsDeDu@gojEh~bBp`AnQkL}~@qdDsgNqyTw~@adJs|CqaBkwBa}B_hB_pDakCgaIqcBu`BoyAsmCw`Asw@}|CenAahAchAe}AulFy@_hBdnAcoEbuBiwDzCmcBgWeeAq{Bs}BohHiuJgnE_wD_tA_zFwnAjf@e`AmR_eGmyD{Ysh@rc@qhAjdCs|CtlAtpBh`AnIhw@{i@zjBceGn~Aw~Cjf@axCpbAe|N}VcNss@itEno@s_MmoBoaEi~@i\\k_@n]}_@n{BkwAgSyo@anH_{DlkD{^i~@~EqiFq}@iaDuUk{Bti@c{FiAo{BafKwqMof@saCbQs`IjoCy~GzwJa{Ml}@quB|f@slCrC}dCu|@scDhe@cmDycA{bA}HujAz`@wrCj~AkzAb_Bj_@tjAbqBr^pyCpmHe_C?we@a_Cwn@sh@_n@rQmw@xeAah@tqAdz@fg@`DphAwmA}]_xHti@?fRpuAto@vg@dcASjlAqd@rnAxdCv{@wnAb_Bw}GvhBeu@|fFlmAloBa@x{B{fB`oc@pvRs{z@b_~Apc@puAnkBvwAnoBpm@nyF_g@vmDbRhwDjsBfmGbcFjuEyD|pEfcA`yDud@|wArj@lbEniExxCvxFnfFdvFh|CprBl}AyBvtE`aEjs@v}Avu@bzE~Vha@duEprCnu@tsAf}@zgFiVvw@orAhkAnOz`AnuZjiW|Qvc@wHpk@{nJp~SvSv`AfwGzgGd{El~BriD`iCf_LjyAbnCfjFhxBdvBjsArl@lrGjsAftGv~BjiQxlOfd@||@izIbli@saCs\\yjKptG_nDblP[ve@dYbg@xzC~hAxtE`[~}DhsCnaKre@hfErcDxxDf}@z`@|Z`yA~qExmCzoAdkBr}Azy@nKb}Ckm@nf@pNf~BheCfsBrgElgCdhCtsE~aHx|@va@pwCeFx}IbkC~~Of`Jbnc@llg@hoEvqAjyQnsKj~@d[rq@eVd`BsqCxgAksDlw@om@vw@yDfcA~b@|h@hy@v|BvpGt|BvjC`eAfNxu@aTftAcxB~aAbAzb@bUrZd[hvIbxVtdC~zDb\\`hAeBx`Aa_@nq@av@|ZmlB|Din@f^{NtkBxKfrCne@`pCvrEvsPrnGjpPhQfmAkIhi@enAdeAoj@dhDmfA`Mqc@~r@q`@ddFt^frClt@pqAh`Bjq@vcBuIbeCo{AzmApBp_Abg@|~@xcAv`ExzKx@lmA}z@bvAk@flBvrAhaFzaAtfSl_Fb~PkPl^itA`v@gv@niAkxDfcJucEfxDq[`yArCvmAzr@~qAt|Ex{BteBl|B|Qll@nTvwFli@~pCbmHxkJvg@tyAfHvsCem@teMqhErwKkrEpyEw\\`zA}OpmHyf@j~AecAnlAq_EhqBafF`_KywAtd@w}E_Zs}A~TuaApy@}fCtvF{t@lM_\\yNueC_vD{|A_OksArj@{k@du@cr@psBhU~iIq|BjmNujA|}BwcBn@su@fb@myDprFuG`o@dTly@e`El_EatCfgK_a@v\\{jH~tBoaAhHkaAlj@uwAhmAqxIbyKwzAz`@ypFdh@ukB`cAm~@bfAkqDx|Ga]rDcnCqhBqgB|X_z@nv@yzGl`KaiEz~AipObuMou@fb@q{@Zqb@oRgoAwcC}`Ai`Ag|EwSed@u}Bcd@gi@ceD`DyyEq]adCpY}sFzeC"),
+	usState("Indiana", "a|n}F~trdO~a_A{JpsM{Vr}kLxoCry@jwB|cDj|D|gEmN`mFohG|iBg`@`kBd~@lhFhuGtlAmkAtd@ccBtKqnG`f@igC~zApGz}DriD|xBxk@dnE}sApb@nqAwi@b_OjVvvEp`CnlKpzL~n[rj@v|Fuz@fyBw`EjuEkh@`lBcNjlKzt@`oUvr@bsBbuBdB~xEc_Bp_Kqt@~rFgbCpsBp[l{A`wAr`EvkI|yDrtDvw@f_B`o@fkKrtDvxDdgPxrCtsE~fCvgCj|Eru@x{Bdx@fkFy@hgAmmA|~@cg@d_D?r~@zhApsAt`GnfChw@n|@~k@vhBjmB`sAniC|x@x_Cn\\buQxx@twEjeBxbCbtDdj@poHnj@vpChhBr|@~m@jz@_tAzeCogEnkFs\\tkC_QjzHo`FzwMgcA`bBiyAnx@w_BjIafGkT}gAnw@miEvbIej@ppBuIhcCji@bdAhsBikAb{@q|Bn_@g`Crl@_r@faBzlBlxAtfGpJjrCkR~jAwrAh`A_^~fAyB`gAly@x_@paAyVzaBqnBbdAgb@beC~WrlAxiBpb@hnCtG|dCdw@xrA`dCj]ryK{R~}AnQh{@zmAxx@hoIj|Add@bzB[n}Brq@dbAhaCeAduC{{@ryAoiFivBcYlvBjj@tsGy_@nyEo{Bn~DogDvfCadDvr@}oBxbBdeAd`F~oCznF`nB~mCf`@jsAx]rjJbtAdeE|iDbrCjhKnjDhqCldBjgBjcEahAxxBy`Bn~Ay`E|iA_p@ptGapCr~GkdCbfLqiCj`Fq~Bd_HeZ~dCvt@|eGroB~`Gbg@frF{bDfxEy|@pRqgB{E_n@z`@}Oxm@bGdw@jf@pn@bmAdSrjCkXjtDmqB|_C}]fjDf_AdkCr_Dhy@fzDwiAnmCa~AtMmjE_`AqtApJqr@xpAvFtgBjuA~yChtAlmL_U`kCip@frBcsFjuHsQ|dCvr@|kB`bCzk@|rAuWzpJs{DviHb_IcWtrBwoBj~E_a@|kBzNnmC}n@dqCyVzbDwy@rUgLgVzC}sGa[kaAmdAjf@ugFzqHchBvfBpi@otIkh@_bB_l@_SkqGzfAujA{@}_@oz@qi@o]o~A`DocDro@{hAckAsxCpvAqqAycHwsB}~DeaAfzAhO~cEkf@|bB_}EwsImt@yaE_oBm_AgfAplA{t@bvB}zDzhDobA~NajAsSmeCcuCuhEgoAwkAwy@cWuwBdf@eFtgAkz@|_@{bAcgD}wAcaAw}AvrCibAbZ}y@e]eh@smE}l@gqEc_BcdAkr@itAexE{aF_~Dw|B{^_iAz}Aqm@?qn@{}DqoA_dE{~B}eD}A{w@~_@ueAycA_j@mhAZkbChhBy`BtT_}Aq]}eAw~@gzAw`D?ve@cxActA}{Bn]o{Bwr@ihBk~AacAucBwSi_FyvNmcFatFjpAgpF~eCqeFldAchAmSkaBwvA_dAuR__G?knAtd@acEb`GiqIpzAunBn~@kvCf}DyjA~iCes@lb@gnF_UuqHmrKqkGeD{qEdmCokBh\\y}Bu_Ag|AsoBmjCcyJmZyVcksMwgA`HogqO"),
+}
+
+// canadaProvinces are the province and territory outlines
+var canadaProvinces = []region{
+	province("British Columbia", "gudjHhyklVv@`|LmkK`{Aap@zqFtjFzsWhoEdeA`iCajAxOjSC~wIerDj}@mwFnhGarPi~RvzE~cO_wAthP_xMotA~|@mzJilNbjTca@_hSi{B~tBow@gqCrhDqua@wbIgjLubT}dFc`BdlC|tX`uJvuBvbOgqDluo@exEptCc_G}mHqyTjb@_zWssFakGuiJalApiBvdHr|M|vKdw@fbPbbo@fzLcwDr~GbxJ{iP`wcAq~\\bha@whH~OoqIcpGucAixNjlTajSzbXsiJikSiiBsqGqg`@waIm~Iv}Jl~q@mjVp~XizY{qGspGn~J_wGzt@e`I}m[chIclIasKhlMw~J~f_@xwUegc@h`Qbec@l`N}X~nKggNb_GziD~hBdhKebUbbFhxUzrGjtElqd@lkDlw@k}Hz}k@_dHpuB{mTt`f@kwO~pPacEuIfrOymYosIhyB}cGwjE}~BppEw|Ks`NgzLu}CexPtqTmzJenIa`@aqWkmHy~V}kKgeInyLzj[pn@hw_@vbUn{]ubAbwV}jKhAa{FxuIz_MwhAp}ErzOux\\quCorCu~O}_OusReiPsc@chKg{FipJt}OswPaaWgiG`eDq@fcHrjOpgJh|EfiLdgMm{OdaTbr@zaXh{d@`bKn_DveBpnLcyOvnJ~lErrL{pLhbEt`NfwM_\\bh[g}Ey~Eif[wsC{eKsbQ_k@tyDlqIdvRlae@rcKhoEpuOuzI~}PyeDcxIefAveHzvJbaj@sjHtht@szCkiIpuCupHkxI?ojGsbj@whAyxBu_BvcCbjHvcf@xEphOyuDhpE}{IqsnB_kRk`RsmM~gF{aA{vOyjMifIytUjxIkmHjpMhxYupIziD{~FpmLpxYlbG|f@z`HilFpyMfkVdoCft`@{yDtfLbbGf~a@_bCdyFwfBwfBqvOaha@nwA~d[g~O`^t~Hhpz@e`CtmJmwFb}AqyGwkKhkB}yt@arEqxH{oAx|v@}iWjxIp|L|hF|jKyiBflKvdZ}vKvq`@bcFbjIuhEfas@jcEmgFxaE}pf@tnI`vUwlJtlb@sdFbcE{zC|}`@wyFi`@e_C`|SwvSouHxlAlib@{rLdnUq|KhmAaxCckIajG_f_@~eAcmcAwtH_mQctJ_y@bcKvmJbtEltSixAnql@a_G__EzgDvsx@uuEsFmuS_gk@sf\\cuJckH_rvA|mCipfAmhLklMuiP|fKvbOyfChtIhvIcqFfklAlqBvy^umDf{PdeG~gMovAfsCymL_xA}}CmvLiqPykAd{OrpGnx@hrJ~xMduF{Gtz\\nkFkxa@rnGdzPtfGxcEdnH_tBfwHl~Ht~BtxPcbFpiHc_C{~RotA`xY_xMze@x_@xsC}qMnfHs{ZdqBghSwgG}uF}yb@ufW_nDasJcvPwl@mvLrqG}Hfh@jsP`~LtmJ}nI}rM}g@cgVk_Be|CgdN|`HapK}uc@ewLsxPnuBqda@ltGitIvzFtUrl_@yam@xqJaoFcuJO_vNpjN{_LffYwvT|lIqeIinKspAuoZ{iCpwB~pI|to@q}@vtZqzSzxNa`LvOuxVg|n@whL{zHqwU~jF}zIzzSh}LaqJlaSm|D|w]pat@|mOx~@pdJlzb@vbUt`_@ngB|bZy{Ma_GbVpiCddRhnKrdFj`Mw|QbyJyuIauOklN`|LktKg}DtiLxkFp_KgrCjfKzuKiuCrlHwpJpjHmnNkbA{dFwkLdcBcyHggNm`JnyLv|h@llm@b|f@}~BbtQ}b_@ql_@smT|aBisb@{vUwnVlh@coGmeSq}@rkTxbWrmTixCj`e@{aLpsIufc@hqFyiBa_GacEf_KiiBgxMw[`pQ}|PhwOlsHvrByvOj~_@qiHvzEizGevFaa@~jGqvH{Fw~JsxIjxDd|OccRhbJt~BumTctEutMltMy~F|bGaxMexEvbC|sAqtIpbPwtZhlG{UgpIwhAqzAfcDuxEkdXqsEmkDn~Ds|j@fcIobJv~JbtEo~Ds`MpmFq}L`|M}pBt}Cq{LigCbb@srFz{I{mH?gvGx~Q_vSpxNc~Adkr@||Hz`HnuBpgTgnb@nju@evb@ujEknCm}NamFs`BjnBuzf@khJb_j@cqXstQrwDphO`yj@j`p@_jLyeDrtE`_GqtIui@stEpmLtiEkcEfmPm[r_BnqDkmHxbKd|J_~DzxUdbe@toTbtEntMu~BpbKpiHkmd@xq~@kxa@jtc@mxQtud@eiFuxEbrAtkJo}DtrGy~Fz~FudYupAgtMsbGkaA}iPvtY__Wj|MybWgrl@vmh@exE_lPx~Fihf@}oIcq^akA~hG~cHtaNedGptl@ndO~hv@smHvf_@sxDt`Bw~CmdR_tCfvGejVh}@zlHtmChwCb~MuxFjbY_xLajBygBqvOezK~~Jc_Ga}@_}@}`I}_H`uJ~gAk_Nztt@yn{@r~A_kQmtLjrW}}OagCccFk}NlsAtaOnwMnhFyaLtqTck`@xk^weHk}YliF{e\\vpIwhMqCoxXa}@lpTugKxwOuzEbqWexQkmH_jL}bSzmHbcWegXsr\\veBuwKzfFvjEmtMub_@rc@uyb@{|BmaBsnApihAkwpAkteAmkD}b_@~j@lf^b~FhhNawe@bzTphZyiHdlOzwJ`tDuZiqEyjGxzBd_CpxAcfDpvO|rGrdu@~`m@_Nn|JwsN~hG}iLwbIcxCqfDug@mWap@ef@usRufXwtq@xf[gzX{qAm_IxvHm~Ty|Q_aJ}pHc_BgqCsmAlwE`_@fuAb~Br|@sxIy]qrF~YwgCrn@{dChnAgpFjhEqkBlmC_YdkC`aBpmQeNlfEmwD`l^wo@twAcxA`|@y_Lf{D_xA~wAcn@dpCmzAbzS_|A|nGigO~jUkiDfkNgzAnva@{_CriOcx\\lkkAs{CzvGgv@ntCcEp~Cj}@~wSeOrzSob@ndDu}@tg@w`Gqa@}|B`WolBnt@kuI`eHccAbK}jFciCmo@pb@awKz`m@kc`@_wM{cInl{@uvU}oToa\\tsXs}\\lcd@{niAjffAc{Wnz]s|C`zByaPpfH_fDrqC{`[byg@kvFz{FubGh}AseEwkFskJxyLcmO|z^ajIflMyjQ~xQwxC`xEwnBryFqzSnpcAy|JdoQcnAj`AmmBfb@aeA_P{l@g_As_A`{@oc@`uAae@x|IgqGguAuoBzHi}AxbAc~GdjIsiB`eDap@xcEs@xhHec@|aDeaBzmDsdF`uHylAbdAyuDjfAqkArx@k]~rA{}Ddik@q_Av}CweBjiCeqFlaF}~A_J}|DmcC}eFr_SwxBejBipC}_Gg`B_xAccBqVgyHoSmbBnM}tAbyA}oEhvIkh@ptBi~@l~H{sC~tE_oDtyDmnCjlFiwIdf[kbFtzJmPbmBxpWrcuAhyGvj`AnuD||P|fBs}HvyBo~FlmD{tA|cGl|DdjD~wGlz@vcHyO~qHwy@naIn}`@iEnsE~pBh}NjpO`oB~qGhTblJag@|dUpw@vxIjm^vcuAloNpqZpt@v|DyDxoEu{AvgCs{CiEqcI_qCu|h@raR}sBpjAyvAjjBqncB`}_E}{D~mFaeCrhBu{N|jFigA|nAa{ArjFeoKh_r@wmCx_J?quisBxnud@rEtkBs_@n{Aa|Ar`@ktBrJ_mGjq@wyAln@wFtmFnqBdd@}@`o@qg@`^ohAvE}eDviAufCe{@{wCyIcjAj[g_AbrCwoBjoCi~ExbByhBnuBq[jqBlcCpLbyFkpAp_PrCr|Gp|@rZroFoqG~{@yE|zA~pBh~@`^b~@kYbx@y}@jb@okA{e@}vE|_@_`CtzActBnkC{nBv~I{sEh}Am}AdwAqoCndEm_NaDc}@wqC{~CaCgjBtqA{zIfv@gbJs~@y|HyDolBrSo{Aht@klAxjEw`@rxF{dCbgJadJzjDwpEnXm`Gh}@suE~IycE`_BorBjb@klA~Xe|EtuBesG|UejBcn@_sAco@cE{_DvdAejBkzAq}DyEyo@eS{`@_}@uH_qBj\\a`BptCakGxkAyhGluAgiDf_IweMdaAa_C`g@_b@fdA}@jv@pa@nlBxvBt}@vZvxAqUtIgaAi~@{nDpAciAhhCssA`F}pDdXyhAhrBuv@`dCrIvzAjs@ptAjAdqBskCvnBowA|jG}nAvy@ywBmwBebMyPwvEfk@a_Bvz@ie@d`Cyc@xe@g`A`q@_~Dvo@ox@hwAoT~cAbP~dBzeAvp@hF`lAiy@`jBwtHtpA_qBrhIq~DnpBmrB|~@wF`rAxgA~zAn`DdbA~|@fjAcZ~oDkuBftBsiGh~@ap@||Cza@h}@sItq@ah@vnCkgE|[gyCpe@mhAvdAfFnn@fiAlo@jyD|~@|t@bzAgB~cEcaDpsDoc@pU}~AcuDuyIwQk_Qyx@w}ByaBa\\o`Cdh@g`A}sA{fBmo@orA}iArAk{BjoAsbCr|DohFxbBgjGj|@wgPdaAkrGfuAwhChqBsx@bbCtM|gCvnAzzHppNhhB?xxGeqGhkBivC|k@o{DvP_oEzu@_`DbdBqnC~zCg|C~|@w~C_gBi~KoNwbEx|AawMlAeaGewAw}D}oEmwA}@enAz`@ci@r|Bs~@`{Bq[hdEdClnCu}@`yBilBhlBqBxTwoAljAs|BxnE{lFht@mxAh|Aa~Ilo@afBhqEquF`dBu{CdaA{`AdsAm_@vpDuIjr@uSnvBuhE`}Dkk@fqKotEp`Cm_BtfAopCxO_eD{BsuEoh@o_Ew`BgcB{\\dMkk@rcAym@qLgqGopImd@egAa\\muF_j@qlDxTyrAzl@gjA`{@ys@j}De^hvEkwFh}Ag_A`tGmPl~BshBrs@wyB~~@kkGdv@ovBry@w`AdfDsdAfiAujAbxA{qGpl@iiAth@a\\bkCuHvnCebAzvAaiC~zA}}Hv`B_wBnpChAz~Cfd@blCkLztBupC~eDaKrwBwjBfeAqrCqCkjIp]e}Anq@mj@xxBmi@nr@}e@hbA{mDxdA__LreCmsCdwBg`@fzF|@hnBmZxrAk|AfyAabDlbCagH|d@qaDdSqdPpeAkgFzb@mj@fcD_o@xh@gk@xn@uzBdw@gfGdbAupBtdCqdAve@si@pw@ooFzzFekBrz@wo@hmAcrBhn@q]nm@fv@xt@`eI|y@zkAfmCdHxm@_qCzM{}D`m@m}BnzCauB|~@ugAdl@yvA~hAsoGl|By{BpGc`E~]}xA~eEubBbd@}hAcOklA{uA_fCbNewAh`@gt@r{B{yClqFgmC~aA`Fbi@zhDfpAuQdqIwjMtcEuqBdb@kqAmQ}wBwe@{v@g{@u\\m}Ai}Aor@{}DaM}kEp`A_jLxaBqmDpvMwpLt`Hi|HhoEmrC~qCweG|zDqqF|t@pLjm@tqAl_AqChxF_yDz{G{f@pqFybC|cGh@d~Eis@vz@e^v{@m~Ax_EmxBxYmnBzl@_c@~m@~NlcGsTnjEmeBj{@}Ej|@`m@gBjnCdYl_AzoAp\\~tFeIh}AgVfhCakAfkGkhEdzCaaAzhCgQ~h@`\\tq@v`Bbf@x]bvDctAd`Bno@lmBvhCzaAeSlsCpj@fxBbdAbk@c@`\\fR`KxzCldAhgCrgCrrAht@]dvA}cBxt@sgDrwAeh@hUu\\ec@ozDj{@geFgL{kAmdAwmC|AodAdkB}mAd{Cn^fiGfmCfiD`g@`cD}`@rpCgyAnqBoaCtXmxB~pBqjBhe@kkAl`@maDfu@mjAreDaz@`{A_|BdjCom@xc@sk@bf@_aHbn@yn@vv@hOvhAvoAloAsE|jA}~@`e@wdB?cnHhcAm{EdIs}HtmEmjKl\\}p@p_AuGdaCpk@rrAaSp|@{v@tqEeqGncAezIt{AubA`gAHrlCrlCfn@~U?jb`t@au@pg@"),
+	province("British Columbia", "w{myHxbwkWlxK_fHhfDvaDu{Dty\\qx_@pwS{uQat[f|WwnP"),
+	province("British Columbia", "_up{Hr_bmWoiAl_U_zF{vIamFxbDawNuhKwwAieg@p_K?nb[~bOh}LuqBjzC|}U"),
+	province("British Columbia", "gaa}H`hp~W~gB{}GrgD~cBuaBrcRdmCdg@phHkmNs}A~dIjmHexEdf@dxEjiDs~BkxIjiPquD{zCavNbsFeuGwnD"),
+	province("British Columbia", "wrk}Hfq|kW{e@wmL|kPzjO~NndTi}LpjI"),
+	province("British Columbia", "mno|HfnwmW}bSnhGkfKgqIef@uyPnoVjkB~`IfvG"),
+	province("British Columbia", "gyt}HpnsmW|~NpxMwhBfcDdkLb}@wsBduGuaHcdDbgCbkOsbEwgA{Th`Q{uNstM"),
+	province("British Columbia", "}gz}Hv_zlWgxApxMwdHmuc@nwRwcN`yCbwCce@feZqqEb_BuwEkuK"),
+	province("British Columbia", "cp~~HdvrgW|xJaSr~Ag`EvjFxbEdwWjcaA`dVzwUboNbzCz{Bn}b@e`f@kxJ}_OcvP{fKswm@coM_rN"),
+	province("British Columbia", "ozc_I~v_pW`cWv]rbKv_JatIbnHuwWf~EqnFswPd_C~wA"),
+	province("British Columbia", "gtb`Ir}toWi~FuIGm{Cb`_@_pKncId`Dc~Nhgf@byDfaCaqExaRs{CucBr{CwwRyjMtfE"),
+	province("British Columbia", "oiy`Ine_bXb_CtmTvi@mkVstEsvOlkPmdB|rAxdJktEvbOl_IglFlw@pqKoqUhhO"),
+	province("British Columbia", "}b__IxrcnWl`IfiLo\\`dP}oOmgLk}^rlImmOsjDu`DjkC}yIszI`hTgeMh~c@kfF"),
+	province("British Columbia", "igc`I~s|qWvpU`N`zGzoHa{MljE`lAtwDcmHfyMs{Gg@a~AzuKenIboG?ipE_fH|~Nq~QsEzzNyy`@"),
+	province("British Columbia", "oiy`IxrcnWu_IzmAqwGkg\\|dj@f{R"),
+	province("British Columbia", "oiy`IdaooWsf[__EpeO}aH|f@d~CzcXhsD"),
+	province("British Columbia", "ixnbI~cwuWraF{jDaiB`dK~rC~pD_oK~lE"),
+	province("British Columbia", "cqybInnxuWz~BcmHliDdmDkxI~b["),
+	province("British Columbia", "mjhcId}ivWroFwsIhgCa~@hp@ryDgiLdgW"),
+	province("British Columbia", "gqubIluetW`_KeVgaD`nQkk_@~j]c_ComP"),
+	province("British Columbia", "syl}Hj}f_Xs~FtxEbgPljDyuU`~RrmSgyFzUvhGs}RnzKrdEtvEozWxyY?`_GemDe_CbVniFstEbcAs{BcrM_yA~~PivIvpImeRh|j@meMp|GytOnx]w~Dy}FniFqyMcxI|wEbxI}~Ns|GfgBuoObw[frIe~Cj`HvuHuqHj`S}lDbiDw}DaiBerB~yKqrJtlIs~AgbOf}DmoNyzF`fCbtEy~JctEt~BypEweHkbGj_k@tcAbf\\{gU|_Nc`Byu`BcbLegWkiD}~Z~aJejEtjEvtBzwI}~ZxnLqzGeyE~ql@bpAxda@rxC}fKvbI_wBmgKauO`eIibh@veCiaExvH|nBfvHj}NvKlqSmxK|yVti@xeD|~BqtIlxEvmPwi@smT|nB_dChbF||CtkDeoH_Oyy_@lhFzpd@ltAkmHz~F|wEzpAumT|aH}gF`uDhhBvbIuiPf_E`{F|wE{md@zkC~vAzcEglLpbKrtEtJk|HbeEf|@}e@sxIacKei@e_Cy}QnwL|zIfcC_uHzbArpSjfKsbGzyBouMhD{hDmfGh_G{nAqhU`}FadJh_GjtQpm@}m`@zwI~mD"),
+	province("British Columbia", "ebt`Ir~cqWlcGbhIguA~yLmiLniX_cCe_C_bOnpOqqEsc@fuBwfRonEnoI{`Gm_OmvK{~FueFcmTy`FduFykMb~YnsHoqCbyJg{WntA`xMbhVde^}aHjjO{xIh`AapLm{E|XgxFs_GoyFimd@kD~y@yx`@xlXuxs@l_[svQp~WyzAlbk@xyNti@mmDfvGpkBz`Axk^}e^ykAcw\\onQquIdcBthKn]a_@|mNfpJg`CzdW||Hn|JmqB"),
+	province("British Columbia", "ksccIpsctWevLxyG{mSomPikHpcDfy@_fNnji@ekJ"),
+	province("British Columbia", "g}}dIhmyqWvbOvcDjTlza@cmGrxDieb@on\\"),
+	province("British Columbia", "so|cIll{vWhyMpeDsiDpiHriDz~Fgz@`zNunNnvL}`ClzK}mInrBubNnel@mgRjgPsmBqxMaBbyTi_F|aNodNwhAxuHmrp@n}Vubw@xtJ}yP"),
+	province("British Columbia", "wyydI`xjsWcen@ef@ctKe{VodB}xh@h|F~uGtyP|bBss@txQfxMfcUvmJgN"),
+	province("British Columbia", "wvtfIv~|zWwiLopUq|Gba@pRy|EheCyqFbkJy_@lkCi~PfnWwmEunIf|m@"),
+	province("British Columbia", "_{|gI~~~yWu~Bk_Mhta@ckcAvam@q~z@doBfpUjqAym^d`T_tZh~Q{o@|nBfkEcrPdiL`~FtwDebBdoId|O}yDpAp|RqqV|qM{sE~wYstEbtEumJa}@gi@pxMcjJkzCyeDrxIyfC{~Fme@htIc_CwmDtqCfuYynG_eBsp@qo^s{CboM|pBbl[sbKvi@yiCfhS__PhkAe_C`|M"),
+	province("British Columbia", "oinhIb_e{WvbOtdGa~KdgXn\\pdJ`}Fd~CpnK{lNsFzeQugKzq@wlLwuHosTwgp@`sD{hO``RgySrpYvnV?~sIktEtoBfnD~qIypE`oA_xMowNayAmoN{eElpB"),
+	province("British Columbia", "c`wcIjlehXwmDuiDieDnx]kvK}m`@x|@nxi@y~JitIxgBo}b@bvKwhSyqEi{Au~ObdXmlCpzX{}Hlx@fyFx_Fs~@|}HaxI_cC~sH`oW}zIg|E?x~RoxGeaCjcAxoLonElrApDatPg_E_x@scLdkVqResNujDxiIocIg@yiBdxW__EgxGmpNrlN}qS{oGsbGsfK}zJzmHcuJs~GgMdiE|mHob`BjpMw|@duMxjTvlDmuAc_G{eQukJoqCwpJk|Y_x@w~VvdNenIbjCkpLdtYerGhpQrcWlzOfopApzBisJ|sFjs@}wEqtIt~BotI{eDsf[rtQbqXa|Gq{d@_fB_uB_y@jeJqvCwy@bkBkwUwtGqlGanMtwEqcVwoUicV~bI_mE~bIrVm|r@_hNumg@}mHsmHhda@|iEjnd@jl`@llb@`nLr{q@m~CltM`xIviLzm|@ltAom\\|bGxmLspFzgV~kEvy@pwBbfVcd@vbDojEi}@piB|pG"),
+	province("British Columbia", "u~hiIfkzyWmxEh}FoiFqiBrxDisP"),
+	province("British Columbia", "u~hiIvjm|WsxInm\\s~FkmHdtLc|S"),
+	province("British Columbia", "ycfjIzrrzWd|CxjA__KfgSomD}mH"),
+	province("British Columbia", "idfjIvkrzWg{KgeHrjC_dIrgJxzG"),
+	province("British Columbia", "qqxjI~ab}WcfE}Xyb@wjLdjEzT"),
+	province("British Columbia", "cdhlIlu~|WjxIjtEjgPbpd@qk^ykF{cA}_a@"),
+	province("British Columbia", "qybmItfa|WenCtoCazGeuXp~FurHreJrjDzd@nfM"),
+	province("British Columbia", "khpmIfivyWnvNrdEdiEr{Y"),
+	province("British Columbia", "ahtmIzwg{WaxBy{Fah`@_li@v\\{cFneHkkBxzGtiDjyZvwd@nwAbqF"),
+	province("British Columbia", "ktugHjnnoVdaBtbBm`IbwE"),
+	province("British Columbia", "_fuhHho~oVvi@tmPnkFg_EnwApoEouBduLi_L~MqcExaFezDklC_nPjmHrmTec_@kUztK"),
+	province("British Columbia", "o{gjH~cvqVsmHlrGidDucA`zXskZvhMwmh@fnEikB_OtbN"),
+	province("British Columbia", "}u`kHzhcrV~rCavCzVlpNqdJzdQ"),
+	province("British Columbia", "uohkHbrv_W~cInuBsjIf|K"),
+	province("British Columbia", "ay~kHvsp`W`jHuxE~fAvaCyeChb]oyRjp@upBknRpqEa{G"),
+	province("British Columbia", "mcjlHv_woVp~EpyGi{@rmIoyLsoN|XmxE"),
+	province("British Columbia", "{ybmH`bguVfzEuxJxjBhyAubBlvX}sG~|J"),
+	province("British Columbia", "sgxlHhsnpVchKrEmmCuvEq|@u|IxtI}vFplGb}BaxC|bH"),
+	province("British Columbia", "e_imHhkpwVrcFzyI{fDj~FmnDc|B"),
+	province("British Columbia", "kkbmHpddxVlt@njFwaIzlNagJjaA"),
+	province("British Columbia", "ivenHhcotVlpBvqIa{GraGgxM{bc@"),
+	province("British Columbia", "aesnHro{wVitIwi@_@{zCpjHoxLvtHeyh@d|f@q{j@k{T|s|@kuJxqE"),
+	province("British Columbia", "}d{nHv_adWjt]i_G|rF~`Kq}EvzWweTft_@cqGibVo{E|sX_jLuti@pcDwfN"),
+	province("British Columbia", "wzhpH|trgWlpGbeEafCb`HgiFujE"),
+	province("British Columbia", "uw`qHzlbzVgvGqiHqmGtbBzfKa_LbrRimFd`IrjUgtIfKcmGffI"),
+	province("British Columbia", "wslpHv{c{Vs}RnkWcsXxkAayBgfM|sMe|P|rMttAtpCnhG`mFgsHtpNcnB"),
+	province("British Columbia", "ifjqHrwgyVgaJrnM_rIskHbxDexR`dKQrmGyaKb}HbjN}pHjfF_tBw{F"),
+	province("British Columbia", "sgqqHnovxVmoH}cBciDw~JnsNsoMl}JdtMeiXwNfjMnoE"),
+	province("British Columbia", "{p_rH`u~{V`a@r_Hi_FrhCklBstPicP_`Dl`HyvUtfc@ccW?lxElqNprJaySce@wpPh|M"),
+	province("British Columbia", "gmurHdd_}VpbEraYc|Ady[s~Gcem@"),
+	province("British Columbia", "au~rH||d|VdjDwyFn}KjrKa`Ajw\\"),
+	province("British Columbia", "k_wrHbko~VoeBzfi@ceFsd^"),
+	province("British Columbia", "ysvsHtkicWfsBan@hmAnpOueF||I"),
+	province("British Columbia", "snzsHjbbbWrtEumT|wErkN?xh}@"),
+	province("British Columbia", "ms_tHvfobWtcAwzFd|DhcKizAh}X"),
+	province("British Columbia", "{vbtHneheW`tCwcC}oBb_|@qaEakG"),
+	province("British Columbia", "}|gtHl_ycWuzVs~c@{sEubZ~dNgrTdxQriT"),
+	province("British Columbia", "a}{tHjcydWueAleMi_F`yHilAe_DrgDsxOitDmmCesAoiXhiHajN"),
+	province("British Columbia", "_z~fHnduoVpgHq|FbtEpgCttA`tImrGvtNluX~bUsFtdSkgh@zbxCewF{bFj{ClmOgmEhbb@cs]rurAgzL|xP}wPu|`@_oK{iDyqK_xYouXmeLwmQhtD|vWpyB~cU|pN|iDlxa@qxBl|J`lGlgQygBrtQ{mHwtM_cCldM|fWlqYesGxwY}xP|fX}fFbpYamGo`b@ggMsnN|jJzbYkhVke@nvAv_KkxU{iDbcS`nLom@`fJzbGxuIa_GdaM}`HeaNgaVkrFhtU`_Sw~JbcKy_KydCr_GjoXe`HxnLxcP`oEumJzyU}~HjhDrtEjxI|kEoqCrgCnqC_~D~sOgdYtXqiHumDwzFuxQ}tAkm|@ubAsmHckCjoB~yEnji@waDldXu`CjtE{fOc{M}vA~}IniMvbD~iAdpNk|CrgE{k_@fuGt`Bb~MexPt{UhzGc_GvxFdeFyPvdIgbOllDpzS`hB?`eg@yqQrtQyjTwhM_vFgwMgzF`r@rxIzbQsxIjtQbhPeK|yDbnN_cJrnS{~FsmH?nmLsmHg_Ksq@lfF~hL~l\\ieD~dCwvF{yCvj@bsFzrQpr[acEvzRqsD{FsqRsg\\qlF~hSg|F}eG}~Bza^elVogGyrCej`AhgTqd[_d[fqU_bOu_[vnElwWwnEvlLqeDlkr@x~FygNnz@}c_@h`F_gGp}K|{dAwrB`mRbmGh~@gdNny]a~Kt{DkqAp~LmhF~tBkhC}{JkqAbuQwnPtpGefRqmaAjTs~d@n_PsdfA`xNwtGsmHwiLdrBvbBbzHa|L`vP_}eAdzCy{@zVelVptDnj@oxA_hRhpKwf`@u`C_wFhbE{xeAzqMo_m@||I}{|AtrGadInm\\mtMpa@r{BbmM}pTxgZcxNlaf@szx@vgH}e@vy@lrRvde@gmb@bsQowo@``L{jjAt|DouHt|CbyIn_D__h@hvOavC|sAc_SxcPq}LftIxp@anA|uLd{a@wyp@xvCpjCxRqsEjjHn\\_j@feNdtMufWb}Y|u@eyEmkK__Kti@etAsbGmxE|wE}e@cxI"),
+	province("British Columbia", "g{xuHxyhkWljEzvDwcDdbT"),
+	province("British Columbia", "enyvHfeniWfjF{SmnCrrL"),
+	province("British Columbia", "evoaIz~ubXngA_bDvvBbgPcxDfkQ"),
+	province("British Columbia", "}rmbIfbafX||BevAbs@faPwhFtoN"),
+	province("British Columbia", "o|ajItjsjXl|IhhCmcGb{NaxCm~B"),
+	province("British Columbia", "aw|hHbpnoVp|MksRooD~nValHr`B"),
+	province("British Columbia", "_|shHjygnVgk@txQ}iJxmFdeFwd`@"),
+	province("British Columbia", "utokHdje_Wt_A}rKtfOdZd~@j{FwhGzhMefFQ"),
+	province("British Columbia", "exauHfycpWw}A}{EdyCuaE"),
+	province("British Columbia", "o_huHjcppWnlDqeHzH|sH"),
+	province("British Columbia", "outuHnz{jWzBiwP`iKghEc_Evo^m_CzkB"),
+	province("British Columbia", "_og|Hzq|nWylC_aC`~ByW~wNluB_[daHscHzq@"),
+	province("British Columbia", "otp`I|y~tWlUikEplEtMnOneH"),
+	province("British Columbia", "uusaIrqotWzoCoiGfwFrhBa|A|gF"),
+	province("British Columbia", "cnoeIvga|WlzDe_@khEzqH"),
+	province("British Columbia", "ifjgI~qd|WsbDrq@usA_jHdmI{d\\zcD`oB"),
+	province("British Columbia", "ozpkIvhm}Wim@ekDxfMwxJevAzlZ"),
+	province("British Columbia", "wollIffd_X`hCapHtsC~~F"),
+	province("Alberta", "{z_jHvfk~SRfzxWgn@_VslCslCagAIu{AtbAocAdzIuqEdqGq|@zv@srA`SeaCqk@q_AtGckFj|LeIr}HicAl{E?bnHae@vdB}jA|~@moArEwhAwoAwv@iOcn@xn@cf@~`Hyc@rk@ejCnm@a{A~{BseD`z@gu@ljAm`@laDie@jkA_qBpjBuXlxBoqBnaCspCfyAacD|`@giDag@giGgmCe{Co^ekB|mA}AndAldAvmCfLzkAk{@feFdc@nzDiUt\\swAdh@yt@rgDevA|cBit@\\sgCsrAmdAigCaKyzCa\\gRck@b@gxBcdAmsCqj@{aAdSmmBwhCe`Boo@cvDbtAcf@y]uq@w`B_i@a\\{hCfQezC`aAgkGjhEghC`kAi}AfV_uFdI{oAq\\eYm_AfBknCk|@am@k{@|EojEleBmcGrT_n@_O{l@~b@yYlnBy_ElxBw{@l~Awz@d^e~Ehs@}cGi@qqFxbC{{Gzf@ixF~xDm_ApCkm@uqA}t@qL}zDpqF_rCveGioElrCu`Hh|HqvMvpLyaBpmDq`A~iL`M|kEnr@z}Dl}Ah}Af{@t\\ve@zv@lQ|wBeb@jqAucEtqBeqIvjMgpAtQci@{hD_bAaFmqFfmCs{BzyCi`@ft@cNdwAzuA~eCbOjlAcd@|hA_fEtbB_^|xAqGb`Em|Bx{B_iAroGel@xvA}~@tgAozC`uBam@l}B{Mz}Dym@~pCgmCeH}y@{kAyt@aeIom@gv@in@p]imAbrBsz@vo@{zFdkBqw@noFwe@ri@udCpdAebAtpBew@ffGyn@tzByh@fk@gcD~n@{b@lj@qeAjgFeSpdP}d@paDmbC`gHgyA`bDyrAj|AinBlZgzF}@ewBf`@seClsCydA~~KibAzmDor@|e@yxBli@oq@lj@q]d}ApCjjIgeAprCswBvjB_fD`K{tBtpCclCjL{~Cgd@opCiAw`B~vB_{A|}H{vA`iCwnCdbAckCtHuh@`\\ql@hiAcxAzqGgiAtjAefDrdAsy@v`Aev@nvB__AjkGss@vyBm~BrhBatGlPi}Af_AivEjwFk}Dd^a{@xs@{l@fjAyTxrA~i@plD`\\luFld@dgAfqGnpIxm@pLjk@scAz\\eMv`BfcBnh@n_EzBruEyO~dDufAnpCq`Cl_BgqKntEa}Djk@ovBthEkr@tSwpDtIesAl_@eaAz`AadBt{CiqEpuFmo@`fBi|A`~Iit@lxAynEzlFmjAr|ByTvoAilBpBayBhlBmnCt}@idEeCa{Bp[s|Br~@{`@bi@|@dnA|oElwAdwAv}DmAdaGy|A`wMnNvbE~fBh~K_}@v~C_{Cf|CcdBpnC{u@~_DwP~nE}k@n{DikBhvCyxGdqGihB?{zHqpN}gCwnAcbCuMiqBrx@guAvhCeaAjrGk|@vgPybBfjGs|DnhFkoArbCsAj{BnrA|iAzfBlo@f`A|sAn`Ceh@xaB`\\xx@v}BvQj_QbuDtyIqU|~AqsDnc@_dEbaDczAfB}~@}t@mo@kyDon@giAwdAgFqe@lhA}[fyCwnCjgEuq@`h@i}@rI}|C{a@i~@`p@gtBriG_pDjuBgjAbZebA_}@_{Ao`DarAygA}~@vFopBlrBshIp~DupA~pBajBvtHalAhy@wp@iF_eB{eA_dAcPiwAnTwo@nx@aq@~}Dye@f`Ae`Cxc@wz@he@gk@`_BxPvvElwBdbMwy@xwB}jG|nAwnBnwAeqBrkCqtAkAwzAks@adCsIirBtv@eXxhAaF|pDihCrsAqAbiAh~@znDuIfaAwxApUu}@wZolByvBkv@qa@gdA|@ag@~a@eaA`_Cg_IveMmuAfiDykAxhGqtC`kGk\\``BtH~pBz`@~|@xo@dSp}DxEdjBjzAz_DwdAbo@bEbn@~rA}UdjBuuBdsG_Yd|Ekb@jlAa_BnrB_JxcEi}@ruEoXl`G{jDvpEcgJ`dJsxFzdCyjEv`@it@jlAsSn{AxDnlBr~@x|Hgv@fbJuqAzzI`CfjBvqCz~C`Db}@odEl_NewApoCi}Al}Aw~IzsEokCznBuzAbtB}_@~_Cze@|vEkb@nkAcx@x}@c~@jYi~@a^}zA_qB_|@xEsoFnqGq|@sZsCs|GjpAq_PqLcyFkqBmcCouBp[ybBxhBkoCh~EcrCvoBk[f_AxIbjAd{@zwCwiAtfCwE|eDa^nhAao@pg@ed@|@umFoqBmn@vFkq@vyAsJ~lGs`@jtBo{A`|AukBr_@ynud@sE?}b`|@"),
+	province("Saskatchewan", "{z_jHhx`zR?lmic@o{dbA`C?ydyo@hbpXkBzwsh@oj{B"),
+	province("Manitoba", "_o~lJh}uaQzy`@cpGneZrnHjiO}gKz~FbtEl_OqmLtwQhxA}cAyhNlzJj{Tj{f@taCjp@n_a@~mEjfEc_Aube@xwGhT|uAkjOloIw{F|{UzfKsmHk|Yh_K__WlxQkmHn]cpM_bPy|]bcMrtEryHe|D~eTbsRlaZygChwIpiH`}]ziDcxa@miXs`d@vzAmo[_hSogGtvE`b@wadC||IqbEzlAszIcvIgcOl`Bcj`@vel@}hEbktA}s|@n~g@k|HpdD`sDltAcxIrxm@}m`@|bG?jtQy~RvmQaoEfwRtbB|_g@fx]zcQl`Th~Fvqa@soAao]mrT{zl@{cFkpo@|`CovB~zLryUb_GpmXkmBc_YabKyfUynn@k{gF|wE_`vAfhGmla@~yb@qdvAvxMidnBhvOuydA{aAwlPzw~@bhgBpz{@jq`BnevAz{gCxkwAzbgCfyoAr{vBxoqAjvuBly|A~ubCfhp@`obAp_NbmVji~B`juEvle@xv|@tbsQbA|elAhk@bveAuJnaA~Nbe@nh@zMrt@?dmwd@{wsh@nj{BibpXjBAm}|j@tu@edB"),
+	province("Ontario", "_i`zInrr}OhuCqhNlnCb|AheVk}d@jeEgnVzcWonh@xqQux_Avfv@sys@ftN_lVt`NjvDsnGevFjGygN|lDimA|rAc_YjyIz~@hzA{fjAprg@c|eCnlKwejAdkJ}qg@|fa@_gq@ljNgx{@ffHcG~rBddMdq`@zad@v`UvoDaon@sri@gbImj[|pH}auAgcImkjAryB}|[uqHch\\nyGkp^hgC_jFlyEth@ouCojF~eBwaPzaGwuG`sExdCvrCshDwkRxfCelDrpSgeBd|C{r@isCbhO}oaB_jFm{g@|rBcyI|`H}lCv~JvpIqiHkpMb_BciUitDycV~bD_eNazB}gF~yQcug@stExfCerAacEesHttGnwHefS`pPd}DnxG_cD`zGldBfhSq|LdozAhnn@l{\\ijBhaK}zIt}CffAhQwoJpdJe|Nhkp@iwUnqg@jeQlr|@cnShzM|qYn|n@j{HvjWwsq@lv^{cWxwj@yedArcSgy@huHoxM{~@x}WreKzw[kr@f}KfzKjfK{lH{pY~Nmzl@jkYcs]`_SsmhAp`Q{jJrzCspRby`@}bk@dma@ixMzgJykNvmVs`An_K~ue@b}Sd}Ul{\\hds@k}BudMxgHtdAgpU}bSylFw|]efS_|Vs{Marb@dzOq}lAluXadn@voJkpFbwV{cc@d`aRCtnj@gp@laElUf~CjvAneDjsCzwD~rBfhEbn@buEuw@laDejB||OmsOr{DcrCnlB{g@lgEeNlfQdbAtvGcbB|fFoyEtwEexFljF{~DfoHwqEntFobGnyKk~OjjVedLjmDqmCrlCiuFl_Bc}B|tKuiCf{FujD~nEyiElxD{{FdlLkxYz`BogBxaC_h@xmBscAdaA_`D`lGkat@iAmtH`]glD`oCiwCfA}lDci@msHz{@k|XlwC}vKbO_jW|dGqwe@j|@_oKdmLq~c@|eFi{JnwBwxHvhCylC`iBuoDvxBmmBppBiZxxEiN`pBqaA~nFuzGjhEmzHttBmiFhoG}xVld@uoChJuxDcd@qmD}iBinBomAuBmtGvjA{~Aye@su@waCiTkzClFq_Cfv@gtFp_As~BpuAafAloBo]fsGmOhuFwpAtuBybA`{@wrDrz@k`AdvAes@taHct@fzFqgExuDafA`eA_n@zaBwxCby@oeDxlB}zQhhA}kFnYusDvcAcuD`r@iJlbDy}GrS{lAhJshGmmAk|Fii@w`AigCqyAgk@gkAmz@}iLc@kdDrwAqpNz}@u_DrkBcsAjIubAlmBkuFfiB{{BtpBmxAjg@ulAdkDe_Ebj@omA`a@mpDuh@axKwtAooCglDeqCs}BwgCkpAktCij@yfDcYwlKooKmkl@mwCovHit@mgDswBm`l@qgF_|Og[ksDh_@mv^s~@slOctA{_Dl@yeCffH_fR~eAm~FdNafHzjC{lKv}p@`oOzxQ}vXhyAjnBxSvfAbwf@f|`AlAvqDkeAdxWt]l{EblBzbF~fCzqM~zCzpGbbBhzLv~JrmZbbv@b{rAbqIhvIhjK`|MfgGtyC|rAjzAzyJzme@rnGxbOx`DpsDtaFmy@jgBteBbsGpvZlo@ly@phC`pAtmHrrNbvBvxCpvtA~{aApzBbtGmf@~sgJd{A`gKhx\\xuqApyVwcOnsKonE~kBa\\zoPi_@psCtQheDjwArxA}Any@oM`u@km@jyDuhE`u@wFd|A|e@xbCfVxjCsrCd|AezCrcB{eHtlAwbCtkBye@b_Gz|@|qHtrG~hF~~IpktAbunF`s]zdhEb{fBfg_Ft]jdDpPlbi@wdAhsGwu_@`xdAgnD`tDquG|aDssNvpEw}GnNqqL_{BsnOgVawFkm@_iEwsB}kEuyDmfDqvFsrCg|QyhGyrTy{BgkEgwRysSwpF_xHoSc_F{aA_kEsvBceDatD}nCifDo|AqdMamDw|LulAumDhxAufBkQksDixAyvI{{AaiEcmCqfE_bB{rEhJktEg`@mi`Bghq@sfDwp@iyE~JspgIpbjAw_G|cBqgF`|F}ts@xfvB{mXbrv@oKrz\\guQcwNqbWusPwzAaR}~ApeAweLzyPkzCvmH}Tn}HbnArzRmnAveMrT`jBbp@pdBv~FziJvf@nfBcO`kBa}@vhAknDlxBu{AtqBo}E|i@a{BjxE_yDr`EsrCh}@waDjN_kKd{BmpEmtA{dI`gGcpEpdA_eQimHa|AvgFi^`uHtvGhbTacCpeHnNtgFfu@rpEztIzmLv_@v|BmFftDis@||C_vAvmCm|]l|b@msCjwA}yf@b`L{`Cpz@wbBn|AajJhkXy|oAlhbEyaaAltbD_frBjbdHkg@nuDrEpcEtgHpzl@vet@jggCj[jpCyDrqCovAdrMgKjsXwfAvkKoC|hCf`@htE_vAtzHbU|yF`mDtiRuHpoBmlCduJet@x|AgcO`fNowApeHwE~jJ~dD|_x@wf@d{BdmA~`I__@b}BybB`yCfGnvCjuB|rPpBnqH{eBv`FyfB|~@e{Dvf@kuAxx@}bBx~DumA~h@cx@oD_iAccAwz@bP}wA~mEdl@lsHdgGhoSjvAjkG~iKj}Xh{CdtEvnBhnFhj@nkPtdCxeKmiBxpJ|_@lcPgZzjA_rB{k@i}@`Gem@rt@sz@taSswAptCq~CyaCe_ElgEkeAnfBmo@haC}t@h`PeiEroSqr@tpB}`DdjE_{CnrA{_Dtu@{dCdrAujAfhDkTlmOp`AlkSbp@nkChaCjeCv}BoI|}Boy@z`CmEf_A|mAn|@hvCzg@daD|@toBk|@|nAeuH|pEq~DdnEyfBhdAqtCt[q_GfBgdBlj@olCrcBcmAzaFoEloGts@x{Oij@vdHkzAz{AkoCur@sj@mwBwoAknKigB|O}gBbrBsoGn_m@eiEd}Laa@xuC}mArhu@p_Bz~WdbAndExh@ntEjyCxCv~A|n@h}A~eEdXrrMriAdoE_^peBd{A~lZgQvgCuuC`_EwjMtcDevBtsFyaBzwdA}_@`uBspEhm@_rAhcBwo@`pBsKtuBtqAnx_@qVzvFghCpkMofIfwQmeAroAykB~w@aeBxMevP{Kekh@bxNsyFtvBctGu^a`G`uAe~F`m@ccBfiAwy@riBqiDxsLeaAt_JzLznVe`B|gJoZ|B}elAik@ubsQcAwle@yv|@ki~BajuEq_NcmVghp@aobAmy|A_vbCyoqAkvuBgyoAs{vBykwA{bgCywtBarxDca}AidxCV}bD"),
+	province("Ontario", "izfvHxhodNktQtx]erSboGo~UueLitOzjBejKoe_@_^edAvy`BC"),
+	province("Quebec", "sxvqGfcpfM}bM_ue@elJ}gFioE}tLkbL_o~@{z`@qts@yn@kci@oeUhRwhL__KwyQohAq}d@gfl@_n\\g}JgtC_qUqyL}aTkhE{jf@g|b@e|l@kvJghj@msSmnOiyGysb@irQ{kZjvEwz\\gaUcloAstQif[ubCa_c@i`\\_utAooz@_laAmnIseVcnXgxM{_HqmRmdv@}daAo_KwuAk~Ry_S{pFmvVgn`A{n`BcaA_hRkzr@ssdBktJqqn@ok^ibpAknbAugbGkkIgw_DzcGsosAvxQckcAx~^qm|@jtKwcNh|KmZlpIa_K_fTj|mA`_Ge_Od_Cf_KFwtZlxDooCdeArvIxxC_wRzsWak_@b`AzsQbiJncCtwEyqPhdJjxUhvHfrCjmOp~kAnmDiwBleS~uMfeBdjb@nkF{`AhtCvlFfcUpcy@ny@ps[}oH`|L_lKtwu@yvIhySivI``@`cWtm`@amAx|i@`mAigCdeFb{CmqHbyO{c@h~Q~~JjqLiOdgGffBb|AeKnjAtlCd}Oze@xuH`|@`xCbUzvJpa@`{AnLo]yDhyCjb@ndDdaA~zCltAbxBbwB~bAtcEo~@t{ArOlo@jzBkv@t_DmrCjgG{Kn`Cnq@`wGvxC~pHffAjqG_At{Aws@zbEpPloFs_@`fH`l@ltA|tFjkBl_A`oAkaAnkDwbC``Ag_AdqAgjApvFudD~_LaqBxpO}_@pQcrByRu~Edk@?zw`BnoL??nsr@rp}@?x|B`GjxD~n@lhCzcCr{ErbJxoK`cPjzAvaEpsJ|hb@ztBx_MrqDzt[lwCrtN{qFlOksJciAeuD}@isAv`BwlD|v\\nDr~Axc@ftAvpAngBnunCxpoCljGdbBfdi@tmFr`DbmA~bH`kNbyA|{EteC|t@~bEj]l\\fgCxlA~lAheFvjBtvFxjD~{BjW|t@eeCz~B_wA~fCy_@p~Bfa@pvAn|AdkBh_Ft{@zkAbo@}FpvAm~Anx@oXhrAhwA`bFdbAbcBuBvxAelAby@_rCpN_kBtp@al@n`Ddh@njE~_CvmCfwDbiGfaNd`Cz}CdaAbKnlCuqAjeCkHhpBt]lzAxiAxcAxzBnMlqEpWviA`oBjzDlxBlgJp`AnjBdmNj~QtsKboGjeBnId`Cix@vyAq_BnkBetFjgA{nBnuB{v@lpAtkAhi@viCH|aC}t@zcCu{C|vEco@xwBgAtnCrj@fpB`pAdpAnkBbp@~{BdIluGeTftBzRpt@pk@`pB`mDzv@`MduBwJb{@jVbdAzoBugAryAcwOjvGemAdfBuSn{Cj\\bqCxkEvkF`z@b~FrnHnaH~g@hsBew@nqHyiFdlOiJhpB|`@lmC|_AbnB|dFljGjq@nqG~g@~~@v{@wcDpkAwcBftBjg@z|EpqD`wFt|AvtGd}F|sAxm@lbBrJtpCyNdaBvknRsFviB"),
+	province("Quebec", "_}kuHvhwcNrcWwyMonJqm@ywZn|]qcEdnCuddBuIwbDbaAk}Gn}DgwSmyh@doC{tPr|Sz|BmcAsx[vtMywUdhc@c}MzdKlpB~tIovS_kLteMi}L_fBq|Gs~MmkIjzI_|LcgPcoAwgSsiDx~VgxQ}~BouHnvY}vFnk@gjLb_SalM_ve@alS~cIe{Duk[uaGs}HwmFltAwL{vJu{Cpa@ckCwnKchQisJ_dHx|A}H}`Zi{Bbg[acP}kI}uF|gEwaDqgCwsBnnEkdFkhDmdArzHkiDwbCqvC~|Dy`Eh~c@{iDotM{zBz~FexEgi@wi@n~JseFmoC_\\`eJcqAkaGciDvk@geBzrKseEunU{`GmXjbA~sH}wK{sPjF`h_@_jGz`Hc_GstEgxAe_O}aHpxe@g|EwiLse@`_KgxQa_Kc_C`eCixAa~Ei{BjiD}bAy~FoeB|wE{~BsiD}mCv`J}tRgk@g~JdcHvgA`~LivBcbAyiH`cK_cDmoOntBldBlsBefGmsBgfHatIjfK|XuyQkoHtmJpjBlnO_tHemJu{CxpFocCqgDowAfvYylBs}SkqKaOetA|iDc_CytIotAxpEd_Com\\_kF|rL_O~nQ{cFpA_iAj`Goi@itU{mHd_[nwAqbQowAajB}e@z~HojAopDgLriI_pDocIxo@_pOwhBb~AomKtw]kq@~j]wbCqbc@oeBtaCkcAmlD}e@rbGmxEexQntBbod@miEva[qtIqiHui@smHs~A|zVcnAf|EmqCywBcSvwFylGyBguBbcGctEui@mtAajHoi@vtIo~EwxAocHdgKe_DmsAsiCf~JstEixMy|AfaJhrDjkIcxI{bG?~{QahB_gGavCrjChxAppQc`G}yV{aGa}AzjDggAiyYiz}B{zIqaQelVmg}Ak`o@mgvB{fQqySuf{@{wlB{qFivChbEb{NwbDse@azj@{z}@myJwwGkoHopUgyt@en[a{yAegEwio@vqIklOu|Csmw@leW}yg@~a\\ybKrRkjiAzwkAc|Tjmw@odBa}G{iDt~C_hMlak@ug@nuBqkLpan@ayCj{I}sFhNqqQfkp@yoQrlSwi@riJlpMsmTxp@`xCakM``Wev@~hRotIti@w}DqmS_qJxlMg|D}XfeBm{D{iDyyBmtQnbJy~FmtAi{ArsEsb@aiHhiHgtMaqFss@a^_eNxyGh^}eAyzGoeMgeMvi@b|M_`Di}Fw}Ezc@aCguMqtItqAf@_}PwfH`vC{e@ymL}mHj{BqfO_cw@i{B`xM}sAumTavCy{AclBx_FktEsqXe|Df_VnaEhaRamB|yCy`E_jL}xE`tEgmKum\\weMowAglFnlLwqCduAgxAotIkpAjtE`pEklTqgB}dRj|GgdOtuLouGrbAamX_rD~aUszNlfFspAfcOomDqtI{iDptIitIuqp@}tRvsNouBdlIcaAcaMmtArtE{iDkfP}sArtEkpAg|QwmDp|MmxEacWjxC~sZibEjrFj~BdpHi{Brbi@qoKu`Nw\\ia\\kmH~xW}iDhwCse@gqPyxHnmQgtBybDd_C`xMcxIu~Bg_Kh_WsnG~aD{vVuce@xB_wXisD|vWpkBhd[kwP|`[smT{oj@qm@pxYkpMwql@jiPn`jAg{FmxE?rmT}wE}lPip@`qFfiGzbu@_xMw`Eyob@wox@ui@o`KwgRu_CmaI}vQkyCnnJk_G_nDgnb@x`K|mCacPgmEq|Ae_CaxYogG`qVujEkzCt}Bod_@ofGfx]iwBa_Ka|ArnYwrOdnOesAlne@sy`@hqQcjPdx@}cFpkGylv@doBomQ{pXiuOoy`AutF}|DuaNcbc@zaLwj`A_gBmt_@|jEwlh@drH{pXdqMad|AzaG}_Iy|@ie\\~oJivw@txDi~K|bSf|y@}~Nag}@{xH{|NweCyj_@|iEyra@bjIpPefFcw[ryUgjo@pkGwcf@azMtvb@wpDfvAglE{cEce@w{z@ozLm`ZmqG_xu@y_RypWikBajf@n`PqkRt_O{teAneImsAd|Um_y@d_CjmHdtAec_@yeD`_GgxAmmPnnEmqSbzIlfFltAgiL`wMh|@laClbN|pHre@haLbmSqiHgjXxmEiQliFo~JafOjyDikHqdDveNqomAjvKa}Lpm@jpMjpAqwBdzDjdFh`@kx[lfLqyNvyLvpP}eAx|]~uHpsJqpE}`OgNscn@sbLmrGwzFpdCx_R{khAbiIk|CjiDd|y@vi@ctKf|EjTplAyiHjmHd_[d_C}f{@hxIbxm@d_Ckt]veHf_KlmDqmXbxIjtEgeBsiJzsKI_xAqtKy}FjjD{|BwjFx{RcsWymFg|]|tGooDmw@_q[b|CoeSflElX~uBqe\\_aEwnDbfE_fSjhD~y@vyA_zXstEppEguB_gGrqFewRceEjmBmJkqFumD~bCd_CgcOglEmnBheDyhNmuAezPzjD_yGjoFnvHlJgaJtmJmtAnmJ?b_Cf_K|e@exQd~CdV`{Fu{ZgvGw}KnyAllPsnN{q^sTcdFleF{DlhAsdFccAyzFkdEefAmeGx}FerSi}Fk_BcjUrr]k|Tje@qtI|yUngA~rUjbaAbyUsjIdbGdu^|kKknNvjFfbD|pHm}InlJp|Gn`Q_z^b_GbnH~\\cyIp}R|iEl{DxoQk{CdeS~iGneBjxCcrLr}AtnHilGvncBb_CafDzb@b|BddAljn@y}LpmyAtlPmixA_y@gqcA~|E_nb@owBap{@bmHyl@rf@vwFd}H{fg@`xNr{HnvBaqK~~JrmTzeD_xYziDeZj_Yzjl@d_Dn{@jb@yrJf`JwuH|vFjkCzTeuFldL``WrcAi_XquI}|f@pgHinKebAohSbkH|dA~|EapEt|CjdKopD|vL`yHxsCkuEbyJnoJjdQt|JyfD_sDqwHpnGiaWfjLxcPajH~hMbxa@gcIjjCjhDaiBdsGjhOngGfxAhoRgtMptIcwJsjHp_@|dFuzJl`ClIzaLyhNh`L|bMhQntCbfEntGomQxbQuIyiHrmT`_G_cC`_Kh`fAhtEqqh@{bGwmVpbQiQ_rDgbJfxAaoKd}IdoNjlHmjKc__@gbg@obZivcA~cCyreA{pGkuVbbRw|eAn{FmoHj`MxgCz`FgtIttM`{B|sAkpMbcK?yfCagOxbKrwDhyHi{Alwb@rjTzfVl{DjtRvba@nyLd|vAteRbpp@f{Pno[{h`@g`qAaaRu|fBgaOe`TmuRakBa{Ai_G_{WqpK_zWurAa_G{zHp_AmwQkhJ}bCvzEkhIfaDvgA`zBgvM|hQzrEduQ_|JzlIviFdsRpqb@dcMneH}iJimXgzLk~G}|CcuP__KybKmsRz|BoyLirEjD{mHx|GeiKt_ZnbJ`b@_bJzmG}jDvnQtnIjpLqcEndTrsLbdFqeDgmKwi@ukJatKq`Lr_CkjU_nQmg\\urnAblBiiMirJcf@oxAmpMqaK_sJvhAuhKlfF`nA`xCurG}fMizG?qmd@meGnqI_fAyyGqqW_rD_wG}vW{jCskB}~B~vFqiHawBa_GmmPnzWaas@rfLcfFnjFyhYf}VzmMnoPqxBjmHdxQ|iD{bGsnG}pB_~K}{TigHn`JgpPsuP?w~J_cTneYkt@vfN}zJjTyfClxK}_O}mPdyEcmHsiDum`@olKztb@g`DypVmvK`tClpNysg@_zGlfAabEhlSiiHsmThsKmma@{hJ~~OeqBobIdqBknj@sgKvij@~|EnuRgrOttM{oFstEwpEf_KizFab@nh@gpJbqMkyOefLdv@kFivTerHzoMaeJvxAoiA_eCnmQ{if@woO~nPaaF_xA`Ck~HctEntUmtA{iDa_GnmPlmD}bhAd_Oi|X?qmLinQbpe@efGf`l@sth@|fY_zFerNwnDd}C?}fW}{DnfAupB{yI`gO_iaAi|Ml|m@spAgcCymFzeDvbC_mPqsDoOumCbaMut@qlLc{Ckd@brAipKsuEjgJ?ybKymLxbKcr@wbOoaDwbCgrChmFui@ytIgaOobJm~Cy{LoxFt_N_tIwpTajHtsAjVi~RlrAg|Edx@i_Fkt@cUeXzqAqm@?h^k}BpSmpHjkBkwDjmCoeI`PwxAvNvwHnl@dhHgB~lMj^`~Jdv@rrG~fBfbFxlDnrAblBi~AbcCunEnlBqaFdh@qwCg|@onNdx@aqEjhEwhDhvCk{@dr@rUzR`zApBrxS|ZfjBj|@}t@j_BilDviA|Ajb@peAl\\r|Df_AhxExYv}E}~B`wJdNvnBvz@dw@jr@k[|hB{iC~mGufClcAa`AbZi}@wo@iyGvcBusF`h@a|Jfu@_eD`sCax@doE|dBaCx~D}o@v}ErhAt~DbeAUnkB_qC~r@xT`{A~|H`z@z_B|k@{{@`g@cQdvArAr~@ns@ly@B~pB{fA`}EupAfuBmeAvsBd{HrgB|uJnjCrgBljFvGdsFa|@`yBcsF|o@gdDrVoGlsAmwCxJmrCtWyh@~]uI|iAv\\rlCevBnqBmxCnw@cvDaRyzDqgDubQq|F{nQaq@caE_S}tFza@}kF~qBsfDhqAeSbo@|z@lmE|pRlfCbhHxs@lQteC}~Bfl@~i@tq@~oDtfApcBr`EgfEtuBpWr}CdiDhhAhJn|@kc@h}Ai|BjaA_^ftA`\\x{Cz`ChcB|Ef{@ce@n{B_yDnuBwv@r_G|@jkBq~Btg@wxCbUqoIb^y_Drk@ihBreE_mGzhDcoA~|AbcDri@n|FnNzlNg[bpCkwAx_GcD~gFg`BvgF}j@xiKg_@jaB}sChtDihA`bEzBtnFp`AhoFlnBtcEpbFzqErh@jb@hn@_IpuBktCtbAdMzcCrdG`|@se@dvAclIqM{bEeiDslJ{GqbEnrAwvEljEmbKdx@qfF}t@c{QfjActLeDswPlz@qhE~{CqyB~{ANnjD`eA~{AuCbdAin@fkAyzCptBapNuI__E{~AczE{bGyzLavA_mFoXs_G`MsyM{[opCum@ywAyzD}pEshAqqEnBotEnaDekPtr@eqGdw@yeCz{GskK`aE}xC`eC~vAptAn~Enn@`|Fvf@l~IldArd`@ah@xvNlPxwBp`Bl{E~SlsBuHluFnNrzE~qAx_E`bEreDrrCl`AfiClOd`C}c@bxBm~AztBi}C`g@{iCmFktCeY{}DaeAgmDihBurDoh@atDrwBgqDxjEm{@hrBydDf`A_n@piArIfzAtbArcCtrE|SzbFcOroFbUdxFdqAtoC~hFlkDhdAd`CjtAh~Nv`AzsCly@jaAbz@d]p|EpuAjxDv`@puAlf@dlAhhAtpB`lEnrAdgT`tBpqFruFbcCf_IfnBziCyDf_AcqCuHefJltB{vLvK_wIziB{p@nzCfQt_D}JxaCgpAfpFedF|~Bi{AbjFg_ChpIs{EzlFqzF}~@_zE_dDwQex@}_@lnAu{Lf`@ufBplAihAliFyx@b_Bk}@d]}rAxIkcEnh@{aAvnCscAdb@}o@_SazAa{AmzAsiBis@{j@g`At_Be`CdmGqgEloAcaCa{Ae`C{_Hi}AgeAocA_j@qsBeH{{B|_@o{ArbD_iAdhDvfArdGtpGvyA`nDzdCrvKbiB|uChgCds@bmI}YxlH~WfgCqk@nvBuqA`cFebF`{Az{@xuBjlLlbDraI~r@pXhfC|Oz{GrwCdkCjg@hfDgl@zu@_qBqhD{zAozIu|AaGozBvnAajDpuBooCnkBij@jfBxx@`aGnjFd`AjM|tBg|@ngAsJjhDxxAr_ApcDjf@jrDj|A`eCf~@|Y`wFxt@bbCchBhi@cKtgBvvElmB|iAt`CvQxzBwk@lbDycFdmAs@t]zjAgMjtJp`CrqI`nBxrA|}C}iAjnCmd@bdAfgDriApmKxwAzdB||BjbBlhCh}@pzB_Il|B{oB`~CqoHjgBw~BnlF}gC|dAiiBl~B}wG|mAg`Ar~ByDvhCbe@rvBkCxhA{dCbu@eiE~eAmsBzkAnNjgAx_E~q@hmIpBjtH{iBjgYhInhBfv@~l@vdAic@rcB_yFznCeaBhkDwQraDvz@~oBrgClsBwvD`jCscBzg@{oB`AqoBem@ynFvGwvDzv@k{Gp~A}kGr~BggCdoCbcAfeAvvExhAbpLj`BxwBhwBrm@xaHzSh|BmfAqp@qqEweF_wMgu@stFjQ_wA~r@qf@ve@t\\~gAjiCjv@tx@zh@jAnXqj@pLwgDjuBk|@l~@eyDeXgwRdYeuB~eCy{IpRcbCe@g|FwcAweM}FwlE|p@aeBnaApb@boA~sErSvzLbZhwB`{HntUl{@`eRzpBx}Hf|BjJ~rCoeBrgCgpGzpBazAj~C?jcAea@zw@y}Hpl@azA~rCyUbnFzpBl~CneB`zA`zAf|Bpl@?mrHvU{pBxiDunAxiDda@lyF?vbFhcAf|BviDleB~_JtgCkJrgCeuD`zAozQjJwrXzpBonVql@euD?_sC`sCql@viDvnAzpBz`UwUjwE?nmKnl@~rCvnAkJvUoyF`zAda@pl@p`E?viD`zAt{GneB`zAj~CazAzpBaoQsgC}lPwUy}HviD|w@f|BhwElJhpGzw@tbF|w@`zAnmKnyFlrHvnArgCoeB`{L}w@zpBf|BleBjJl~CwUneBhcA?ptIczAp`Ek~Cj_OjJzdGrgCda@bnFj~ChwE}w@neBunAleB`zAneBt{G`zAf|BzpBql@leBk~CvbFk_OnmKwvJ~rChcAypBbbKtnA|_JxiDnyFea@hdL?bvO_sCbbK_tNfdLsgCpmK}kEroLasCrhNca@zxKptI~sNtnAbnFol@hkJkJptInl@l~C}w@diIcuD|w@ugCicAwiDql@{pB~rCazA|xKasCpl@meBleBoyFtoLyiDht^xiDvvJlrHp`EbuDxiDbzAdiIhcArgCicArgChcAjwEjJ~fH}w@j~C}w@`gHl~C|w@f|B_sCleBunAjwE?`zAhwEda@zxKczAduD}w@x}HduDtbFn_@`sB}p@tkBkcFpaFecJ~pOq~CbcCsxAtg@_~FfKq`Avl@oc@|lBcyAr`RpMlrCtvAzsEjAvdAwo@fxBakFr|Iqv@ztBweAdiKgdC`dJczE``H{{FjhG}lKhlNuoClbJycAlyAmhH~gGgdC~oCc}AztDqKp}DdRrjEoTn_DcsHrmC{}B|eEafAzrFsAdsEl[|kD`a@ve@xiAwElvCw}CfdIakO`~BwgD`uBekA~x@l^dbAjbGb~@|o@hdLhwBzlAq]vnHq{Fn}EmdG`fAa`AriAeX`|@vxAp`@fsDxx@nvClcCzBp~OktPliHgbEbmCz{EcQfbK|t@~fBrt@oCpsB_{Blq@hDdf@z}EmiAdkHsjEfnOi`Ch`Li~Ap|DylCnfDajHblCwdAby@qv@rwB}gB~dKwiItpPmxAboEsiBfaLk{@tlAmdAyCspDodFce@aRk\\|_@qjCdaOgAdkCjq@xuDfjBvgDtnArs@|aB?lkF_`C|kHmvG|vI}aKllJmaIzcSy|a@laI?lwK|lLnTlkFluClvGnjBnuCnuC_j@l`E_`Cn`E~uE_kDjbMmlJlxOmuClbM|_CljB~uE}_ClaI|tAnTnkF|tAjlJ}tA~jDluC|uEn_A~uEluC|kH|bOoTlkF}vI|`GojB~uE}uE|`G?~_C|uE|tAn`E?lkF~jDl`E|jDnjBlwKnjB~jD_kDluC}uEn_A}uE~jD_aG|_C}vIlwK}_C~_CmbMluCo_AnjBlwK~tAnjBljB_`C~i@mvG~jDojBlkFknRllJ_uA|lL{wMluC{zYnjBkbMn_A}bOjnRipZnjBlvG|tAlaIn`EluC|jD}tAn`E_vEvuTpp@pcB|nCnuCo_AlkF?nuC~tA~i@laIojBn_Ao`E_j@?zlLm`E~kH?|`G_uAl`En_AllJnuC~jDnTl`EluC~jD|mPojBlkF_kD|uE_j@~jD~tA|kHmvG~i@}`G|uEmlJ~i@mkFmkFo`E_uA}uEoTmkFlwKo_A|uEo_AnjB}vI|_CmkF~`G_aG|uE}`G}jDo_A_aGoT~i@}jDn`Eo_A|tA_`C?muC}jDo_AmlJn_Ao_A_`CnT}`GouCmaIm`Eo_A}aK?}kH}_CoT}wMojB}`GmkFmvG}uE_j@_j@o`E|uE}aKlwK}kH|uEmuC|vIoTnTluCm_AnjBojB|aK|jDn_AnvGouCzaKmvGlnRmkF|jD_j@?luCmlJ|aK~_Cn`EllJlT|kH}uEjbMn_A~jD_`CllJ}tAluC?m_AlkF_j@|kH|`GlvG|jD_uA~`Gm_AlkFouCn_AouClvGmuCl`Eo_An_AmuC?}kHmuCokFmwK~tAo`E~tA}uEn_A_`CojBn_A_kDlvGmvGn`EojB~_CkbMl`EmvGlmNl_Al_A{aK~_C_vE_`C}`G}tAo_A|tAmkFmjBo`Eo_A}kHnjBkwKlT_kDmjB}uEoT_aGlvG~i@lkF~_CnkF_`CovG}kH?}aKmuCmuC}`G_kDjbM}`G~`GmvG|i@_`C}i@mjB|uEojBl`E~tA~_C|_ClmN}vI_vE}_C_uA_aG~_CmuC~_Co`EnTmkFl`E_j@njB~i@ljBmaI~i@maIo_A}kHnuC}`G~tA_vE_j@}uEo`EoT}tA}aKl_AkxO~`G}bOl`E}uElmN}wM|_C}kHmjBmaIo`E}`G_uAo`E}tA}aK_kD}_CmvGmvGmvG|tA}uE~`G}vInTmcQluCmvGmuClvG}aK_uA}`Gm`EljB}jD~_C}wM_`C_uA}`G~tAmkFojBojBm`EnuCmlJl`EmvGn_AokF_uAmjB}`G_vEouCm`E}tA}`G?}aK|tA}`G~_C_`C|_C_`C~i@muC}jD}`GoTokF~i@}_C}`GouCojB_uA{cSmkFg~g@_j@}wM}uEmaIouC_uAmuC}`G|`Go_A|uEnT~x@mgAp`@xL~Mi}BtjD}uJb|Ci~MnrBsqDbvCgbD|hCy^dkAlvEwo@vhPlcD|a|@fjA`|Jj{@vkEptBxzGzhCnaG|kF|kH~~Cf}CxaCnkAtzByb@fdAmsBhxCusBj}Byw@poD}nD|bBooD|zBkeOcSgo@tJyeTlnAgtCnw@jDhdAxxE|cAqKfwFqiHzmCobCf{FeaCnsGupA``Hqu@zeHeeEftAdXvFlcD{}E`_VpSdcEj~CefAdtD}qEjuAe~Ew@ylog@tnpBaCee@|sFyzAlpO_kL~_DnwMt_dA{wE{iDfmEr_Ss~G~l@hkBlfKpuIxiCibEl{CpqWjvn@tdFxzd@qeIt`Cgl@zuEv~J?}bGdc_@lxKntY_cDtiErqGg[sbAxqJjiJg`PdxEf|E`_K|~f@dxEd_CltAe_CveHzbKmyJxQfyF|}T~hAulIfuHb{BpmLktEhn@biDd_Jue@fwF~iLj{BofCwpC`zNkxPifDikA|}C`vOviFluLdcNauDfeI`xOvmPb_AltMz~BmtAncIbfKl|B}hJniEfsAaf@hyOjaLfnOxpEzvg@hmLvbOnxG_`E{yCwjEpqD`_@raLtzUnk@biV`_Gx{Lc{BjqFczIe}BtnIjdQab@~pCstEuxEu~Bh_GjmHjmB}h@|}IbiDd`BmxEnxMttGh_^}iF~sBdsHjga@q}A|sGk_G__Kef@t_DlfLnwXmmDd{PtfGnxWgxAf|]pkRzknAy|@~}KmoIoqf@qrD|bAtkJn~b@ewQd{OogA~ecAqhIjva@htDrwJihC`sy@wmD{bGvmDxbWwmDjcc@vmDdxEyOtgWjvDc}AdaBz`Gq_@nwXxbEpwOouGjqKxz@pcVa_Kfcg@piHrq`AqxI`vy@hyHfgsAqzApl|@myEraLbtK~o|Aq~E`jk@zbLnaO}oBfbJnaIebBsiDdc_@|qBloSwbCffI}cG|UuYhwItuLr{Nx}FukU}jDpef@btEspA`_R`bWbcLf`g@`{Lx|Ad_PrlNqQ|{Or|M`xHr_N_gAtmTftMxiT?tjVbsX`wCpceAtwEjjNowA|}m@jtEfmDmdAtxFvyKneGnm@dtY~~DupTxkGgz@lyJjgh@fM`dIgpI}pMymLppEmtAj_GvtM_hF`iOhsg@bnMfbCd_CzfKbiDkxI`_Ars\\dhVn~a@vzAb{Nl|ZpcKhoFhxT~{WjuJj{_@|u]j|HjbXbtQduRgjAfb[cwUng_@p^jb^utRh|j@wsBpf~@ufGxkMo{Dbaj@d_CfcOui@exQbrGgw_@dlDouAhiBpmRbpFwaDyaEatV{Eqcs@`wTcm|@~wA_ph@hePwfSt}B_sT~fcAphe@jxU~mh@xk^haQr|Hvyo@bvKz{Bxdr@hsg@v|Qzfn@fcr@bobA|iPvqpAu_C~h^heOr_YvrIvyh@vbOd{DfkLj{f@dvRrkZztPxpkAlwRbkDdxEftYxu`@ngS`xYfz]riPvm`Abwa@vuj@|rA`pW{}Mjst@|u@wuAxlGi_FfqJ_g|@fxQ_tI`tEpmd@rqQxy_@tZryCe_@t|@uxPfyVw}p@aoO{jCzlKeN`fH_fAl~FgfH~eRm@xeCbtAz_Dr~@rlOi_@lv^f[jsDpgF~{OrwBl`l@ht@lgDlwCnvHnoKlkl@bYvlKhj@xfDjpAjtCr}BvgCflDdqCvtAnoCth@`xKaa@lpDcj@nmAekDd_Ekg@tlAupBlxAgiBz{BmmBjuFkItbAskBbsA{}@t_DswAppNb@jdDlz@|iLfk@fkAhgCpyAhi@v`AlmAj|FiJrhGsSzlAmbDx}Gar@hJwcAbuDoYtsDihA|kFylB|zQcy@neD{aBvxCaeA~m@yuD`fAgzFpgEuaHbt@evAds@sz@j`Aa{@vrDuuBxbAiuFvpAgsGlOmoBn]quA`fAq_Ar~Bgv@ftFmFp_ChTjzCru@vaCz~Axe@ltGwjAnmAtB|iBhnBbd@pmDiJtxDmd@toCioG|xVutBliFkhElzH_oFtzGapBpaAyxEhNqpBhZwxBlmBaiBtoDwhCxlCowBvxH}eFh{JemLp~c@k|@~nK}dGpwe@cO~iWmwC|vK{{@j|Xbi@lsHgA|lDaoChwCa]flDhAltHalGjat@eaA~_DymBrcAyaC~g@{`BngBelLjxYmxDz{F_oExiEg{FtjD}tKtiCm_Bb}BslChuFkmDpmCkjVddLoyKj~OotFnbGgoHvqEmjFz~DuwEdxF}fFnyEuvGbbBmfQebAmgEdNolBzg@s{DbrC}|OlsOmaDdjBcuEtw@ghEcn@{wD_sBoeDksCg~CkvAmaEmUunj@fp@e`aRB"),
+	province("Quebec", "snzsHb|fiJ_|E|kEcb@s}GtaMsbGjuEvxGmbMijB"),
+	province("Quebec", "{sywHd}lcJjtEnxMepAliPuuGkhO}VcdL"),
+	province("Quebec", "wa|gJhnwdLbuIc}Fh`Ofm]v`EtIc_C`xMnnEzjDknA|aGwwS_bNgyLngL"),
+	province("Quebec", "{qrsG|dsbMmtA}pM|uKvaOkEts^okF{cF"),
+	province("Quebec", "opatGlm~aMj|Aq`E`|AxjM}uFriI"),
+	province("Quebec", "gpstGtxfbM}sBffA}aBqaJ"),
+	province("Quebec", "sj_uG`gxaM}lUqvUevEeka@lrYf~\\"),
+	province("Quebec", "ev~uGjir_MnZg|Drri@fwLluFfsHqwB`gg@tgF`lXqzAjoHqzMsdWewE_m]"),
+	province("Quebec", "mda~GhqkoLrzBypEp|MrrKzmMbt]_wAlgQ"),
+	province("Quebec", "{leaHxblwJ`_Wdxc@zr@mgFo}Vml\\mmC{lNrf\\hu`@zE|_JhtIz`GpiBxkLpxIrtEp{FemZgqCy}Fv_Dtu@z_Bpni@exc@orO_rCezOmed@ccu@zGc{`@xmLt}T}W`zHgzFuuL_dCalA_LnoD"),
+	province("Quebec", "iz{nH~iqgK{uEzjHx`Efl@wuAhyHisJrwO{aGqqJyjG}km@z{_@_ysE~~a@oerBtaT_wYv`Ec_Y~`IytJhtJsy}@pvf@wij@|lIb`HnqDzlSzEngtA_f_@`adDm_Oh_c@etMvj{@_eT~xFgfS`zY"),
+	province("Quebec", "aqkqHdn|dKyhCngGmjEm~CjcFuqG"),
+	province("Quebec", "u`n`HbbolLyB{}GjoGpwHvi@`aK"),
+	province("Quebec", "ormfH`aicLpgAwyBvp@z`H"),
+	province("Quebec", "qiguHligfJ`mIOqzAbxC"),
+	province("Quebec", "sjjwHlnycJbx@m{EhdDnxDdzJvhWshAhwF"),
+	province("Quebec", "ueywHje{bJxwHxxItBrkHchFymC"),
+	province("Quebec", "wkocJh~i{KbhUqjDrc@zoS}`F`aD_tMe}C"),
+	province("Quebec", "esegJxtbdL~eDyaC`iEvm[woIgpJ"),
+	province("Quebec", "_sksJbswiLjgOb{Iq_@zbDq`Kpq@{mFcxI"),
+	province("Quebec", "_ejuJbwwsL}UghMb{EgGSjoR"),
+	province("Quebec", "cywxJlj_wLv~Em`Vvs@juO{oFh`M"),
+	province("Quebec", "c_tyJz~}xLznCclOlaBtvCgbFpnT"),
+	province("New Brunswick", "ef{cH|alvKqgCyoKaSsbMalAcuDy|AifD}eFgp`@rc@e~D`jHs~@paFizSb~Rk`zA~{]wbOvpIo{JfxAh{FbtEapEgdHgvGwyAgeU}pYmjs@}yDmzb@pn@{jHbcKjtQq}Eego@dsGscFftBfyL|tGozQm_JeoDdiEkbLntI~b[l|I|GjvJfgNlcGfgB?exExmLbxImxEg_KxmXh_G_fH?zgJ~`IpzHqChvBxgOzcMlpNlmUdzn@ui@ec_@`jHjTajHe_a@dxEyiHolEyyMtdAygT|oBv`DfgN_vBxmLjiPzgJnx@tdYm|Tbp@pfHf|Fp{A}nCyeCbvDc|N}xDuKdkD_hGxuUjaAjyI_wFr|BpoEf}JsaRdvKhxApeD`|M}dAueXg}DweB`hHezE|dG~yFl|IeYsaAexz@thLq|RajB{wa@`gImvb@rcEtHdvFraS?bxa@ziDd_ChbEy~Fty@}z@t}Apt@|UrhCeSt`H`WldCzu@vrB|tA``BfuEnqCbvEj_HxdFfyBlv@px@ee@rPi`FnzGil@fxFjwCagCpdP|hWnwGfgB`R~aIg}PmeGm|Dx~FcxI?~fBz~FkqSvwSa|Ll|C}{Ct{J`gk@{qd@hiT~fSziDexE?rmHrmTt~N}uAb_YhoLvoP`oRlr}@fiXb|k@thVrdfA{~R`gk@cxOgeY}yKjp@doTnsOr~Bh{RpxMotY|fR`vZiyGffH_@dzJ`yB__K~qCh_At~N~nb@ixMjyHjyNzjf@ouBtiErtEprKumHbaAneHziPskIjiC_yAuiKa_KjtQxdCndNpbQfuBqhCj`Hm_HboFe{JbbBde@jjCaxANs~BliDjeEaOjmCehAmtC`~KoNnmAzNdlAnm@t|@bcBxMly@t^xaB`wEgjApfF{hDbuEsnGpfFuxDfpGmcBfVyxBuf@}mHetEsdFyb@}jLfmIcjFbcA}zF{aL}{EoXcuDnx@moApsBqLn`DtRd~D{Bf{Ga{@xzIekBbiIueBd}D}_AzvAmcCnmA}i@daAjHdn@`pAbmAqM|tAkzBrnFkpAhj@gnInXg~Ak]m`H}oEwnAhSapBjfBsgBhh@yxAgiAevAaoB{_BkgA{w@hJghCjoCa{@hJy`qEx_C}uF~Tii@j]sbBj`Gmz@dbBkcEfqD_`DxsGos@~g@qtAfVcdAru@q~Cb_EqhCv_H__G|bK__CjtIaxEb_GyvBvsHkl@~kIfy@tkHz~DxtEbbDfUd`AvuHayAjcEsJ||ClPlaDt]hfCnkBz{GpgFnoJH|jMdr@drGdaHj~WlAdfBwnJhdYq~B`kBkdC|d@mwCstNsqD{t[{tBy_MqsJ}hb@kzAwaEyoKacPs{EsbJmhC{cCkxD_o@mnaAaG?osr@ooL??{w`Bt~Eek@brBxR|_@qQ`qBypOtdD_`LfjAqvFf_AeqAvbCa`AjaAokDm_AaoA}tFkkBal@mtAr_@afHqPmoFvs@{bE~@u{AgfAkqGwxC_qHoq@awGzKo`ClrCkgGjv@u_Dmo@kzBu{AsOucEn~@cwB_cAmtAcxBeaA_{Ckb@odDxDiyCjWuv@tGofBaCiaE"),
+	province("New Brunswick", "uzxpGhbxwKehAtg@q_@oqCigNslIpcVnxA"),
+	province("New Brunswick", "urgqGprxwKb`Azo@iiC`yBu`B|w@s`BhOc`AghAwzEa~K"),
+	province("New Brunswick", "eu~oGf{awKe{Ji~Epa@ecO~jDpqBxgYk{Lq`GpcQ~vI|}V"),
+	province("New Brunswick", "gnhcHtquhKdyJnwAnnKdqMxnEpxIuiDxiH_aEyiHsRb_GkiDgcCgk@pcEymGacE"),
+	province("New Brunswick", "}mxcH`r}hKabKoyE|uLaoFjuJv`V"),
+	province("Yukon", "qt`hLtf~zYjsFqj_Ba{B{c~Az_TqevCtzPkg\\diK{lk@zvUc__@fm@mx]sfKweH~wAlpIebC_oQ|tMgvGnmPgji@p}c@ugiDxQgdl@xmL}fo@ypEk~v@nOkvA~hfFSjoBa]zuAy|Az~DlKza@sUdm@uoBxlBwlXzkAmqDtpBwyCrpC}qCbyAqv@|eG_s@t]_Ynl@qdBdc@uSnbA`fBdkHxm@bfE~{B`a@qAvsBwsJrjAm|ChrAxh@|Y|}AfyBqnAf}DoeDxlAiTbdCr{C|cGtv@pz@tw@n{DzuNpj@hhA|y@|j@bjAuR~{Bi_Er}A|NxmA~gBjr@bKfsB{r@|gDt^bo@u^baCy~DfoBaq@viAc`ApMm_@r@ohfMfiBfeGzVjsImOb}UbcAz~Ep`A``AhhAkSvo@u`BbfEcx[~gB_oEdfB}t@tfB}_GnhEyqCjdCmmHr_Ayh@lnBbY`dBbjAv{@daBt{Av`FxwAlPh{D_dKx`BegBlz@fg@`vAf}Bz|@ug@jf@k`Bv`@qwEry@q_BvlDgeBfk@u_BnhAecLd|@}sBt|@fu@hrC`fMbcBdvBfhCcy@x~Eq`HdgBum@n{@biCikB|wN~aBdjDrvI`f@rwCzzApyBp`CtmD|mB~gA_}BhJakFug@a}Wf`AerHvgE_|BfuFzkArtAwFxjDokBvbDbx@~zAd_EftBv|Kz`Cl}CvxAngApqArUxaAey@h[aoBxC}uE|d@qwErsAnJzdC`_BfxDzb@vqCgyBlf@ecFcFcuMdlAkvMiOqfFgdBg|EueBkk@{l@sxAdCkqHouByeJapB}|QyNsgHzM{_Icf@ehMdYuyE~jCsqAbqChhA~`JhsM~hAoaD`W_iEuX}cNgaAmyGid@yd@ivCm[sfB_aCymAatIg}E}vJihAqeGaR}dGzN}cC`p@ouBht@kw@r{CyqAdbAonFayBsvPhr@w{FbbCobBxhAwOvv@xMbc@pkAxbAdkJfiD|dT~eBbzDzvB|bCfiA`l@vdAaMzaAowAth@opBvwBwnNv`BwuG`~BihG|cIu}MtxDcbBxx@_xAtfAkcEr_AwoAxpDwxAbeDwxDdx@aRxcAb{@nvA~oCz|@b{Cjk@zcExo@bxA|gCyCt{@~Wx{DfcE~_Czv@jkChNhYpq@cAreD`uBpkBnlBb`JvkDtzKxwBrnEp~ChrC`kGlEt\\rwBvBb}I`[lhBxsBnzDzhCvrC~]`qAyDvgJtSr~@bz@ekA`RsgEr]chBflAua@|_@v`ArS|eKnnAwdBteAwfDdm@}rDzC_jCshA}ByUiwAtIitEiPkpByuBo~EzKkeB~s@eaCtgA_qBhgAmu@h}Bpk@rrBj}Ax`BnXtgAsaDnr@ajHrK_wCwVosKdXcxBriA_hBniFmxBrbA~mAfg@|`Krr@rz@xhBxl@dh@zl@bz@npJ|s@v~A`cChd@~nFc`Er{CgGh~ApdC~aBbdNnqAjwDtrAh_A|h@g\\nsAcfD`hDsxBbZwsAYi|BmsAshIpBezBbn@szFhN}|J~^w_GtbAcyFluFoxNxlAm_Gbz@snSbxAg_C~oCylBdvCq{Fv|D_`Dfp@miAvnBeqGvmCixG~Xg`B}FuiJ~c@umFn`DcxIfbExzDvkE`uJd~DxtE`vFp}Blz@sm@fA{{AsrA{wM_J}}GjWchBjzBc~Gw@g_C{`Bs_Eo^wsBvFqaG_uBslDcUkbFo^ekBwyA}}@cbD_`@ih@er@a]asBtSsmBptAuxEbnB_nNxS_gMjb@otEbwDcjGbvBszEpjBfRdfBoyFdgBeiD|rAj{@`vAx`DtqBdeChm@}bJ~n@yoDpnBqsBtnDkqAx}@k}@`oBc{Jry@ny@~hAvkEbwBx_DbcCfHhaBuwA~_FyjLd`AmP``Bpm@hy@{GoDsrBo|@osHxCy`C~^qjBdfAcd@xcAfk@vtAnlB``Bid@hfCgwDpuAinGjpB}}NbbBmqE`IsmAi_AywCrAcxAvbBkoD|sBqbIzw@efBz}DivEjoBobF~xFsf^}AwpFeq@yvCaBkfA~g@}tAhfCoeB~`Bx|@jx@~uCzKdeDiTfzFhUb`A`fA~XxOz`@bY~uJzk@juBvzAtkBvjAlPtw@k|@j|@ahE~qAso@nXudBkRwwDmkEgiQs@mgBb`@kkBd{@qu@boAxx@xx@|gAb[aFhqAe|B`|Adr@jW}^hXe_Dr@wdPxo@cwB|}@wZ`pBj`@nc@eGri@qaBt\\{aFpr@}cBnuHwvEz~EiyDpu@hfB~]h{D|y@tzCjaBtzAtpAb`@hsA}KfhB_m@hiA~If`A`kA~pAdnDrj@rwC~mAzlQhuCbmKftBbhApxDzv@j\\og@pG{|@wk@kbGaAotEzp@{rCtaBazBzpFehFjMgv@eIaaFpMmzApk@ilArsAyrA~iJytFr`AykB`dBjtAtl@cY~YcqEn`CksIdNu|BsiAouNlUggG`~CcwAl{@ln@fsCpqGnbAxmApgDdl@d`Dz}EduB~HteClnAfl@{Vd|@k{A`k@lgKzfBjq@viB}u@dkAozBf_BmzFd|@cjAvsDwrCfsCeiEnb@yThh@r~AbcC_lCbmCuHx]{jAgEc_GblByvBjfAuyEtc@oDbiAfl@`fAeTzk@oh@fp@cxBt`C`ItzAhkJloAh}A|tAshBwa@mkFmrBcfKj|@yjE`mEasIxbAs|DjqAuiPneBcnHpnDg}Cz`Anl@nd@p_C|_@rtLlZvp@fuA|@|t@j]rm@|hCb_Bwf@r~@ibBhgA_gHzr@ywBbgCdvIteBdhDjpBzgA~jDefC|pDu`AfzAowCt~C_`Ctx@anDf`@_NlzAbxApnCfbLhcArcA|t@ug@tHapHza@ghCf|CeiKj^msCcQu{BwnAmtFk\\a_JlUy_FtqAqpExScmBmE}pEfUsiBbdAcdAnuDyw@~\\aq@thAaqLgWufBcqEy|CscAqtAs|AgpF_UsuF|KeiR|o@oaAziCirBpv@emAdzBiyFrgCuhLh~@uzA`|@qMxlAnbAh}@|Alu@k|@l_BgcItnDgeIbpDo~DzgAqk@`bFij@ziA}o@rjA}`DgjAieKuDy{Dnx@ggCjvMgwMp|@ea@|mA_Dd`DhShgAo]~`C}xBl_AkiD`]c~Fft@aeBvnC}gDv~Bg|DfcBskEl{@wvE~]}bH~g@goC`uAuaBjuB_aAdc@cj@vdAeoEfb@uuG|gCsnG|i@moUoD{uGs}A}uMfa@geEpqD{sJvpAkjIby@_lCllCi~AtxEyYzaA{`@hs@aqAvz@{rEdx@eaAlz@uHhfDxm@`{@iTnjCc}BloBm_AjwQanDf`AdNvsClbC|dAyXbi@ebAlt@gUtfBblB|j@bzA~qApnHvy@t|@`}@yO~eBqsB`gAaoCkoCidLsPilC`WujCdc@}y@|lJgLhuIgqExj@h@pxCztBxiEwLxbBfRnrAc[rwBq{Ehr@|JnlBfiA`|AoNz`Aco@z_CmjEtf@woBiTaoAufAeTgq@eaBsc@gvDfK{uBxc@miBvzBwgDjp@mcBnN}sBoXq~AquHs}O_lAmwDmPemErt@ooDrgCogHt\\{iC`R_yG_TakNqv@qkGykBouCybBsIuqAztBq`Apa@egAyi@c`@yfBkHsxBsIapN~h@ixAfo@kGlhBxn@lp@pAnhAg~B~v@wuFfMsyFot@giC}jEs}A{sCq`BqdBenCo}@sfFnCihF~bAklFzrEkyLl~A_xFjf@}sFxDmjOr[}}G|~EqaZxYqpGyc@ygGwnAm_GgeBuxFydDwyGie@}hBmOs}BzGgqK{w@ydMj]khXur@anC_qBxCylCxd@ggCqk@_zEg|Fsj@yjC~lAkrGrEmxAqV_oEl[gzH{aAwuKv~C}gEldG{}BxhGijAvnBqiAtbF{qFxlAw[lbD`~ChhBdcEheDjbLbaFliBfeG_}Ft|LywVzhEejClnBog@jvEag@t]_c@}@onMuW{tJhc@}hFveBgsDr{Dk|@|eJbj@bcC{]rkCwmAl~HmyHbaKsjGbhB{sBxtFs`Kxs@os@dpBwk@b`Fc`D~|AdS~zAjoBteDjtCfiCmApU}`Eih@}zFilB}kJ`Ly_EhrAghDtzCmtB?|w_|AiiMbyVifDvE__f@wnT{iCc[ouBjbA{a@jiCbmAb{eBpl@t`IbnBvnHjwRdyc@vxBt|HbEdkHctS~vnAaG`sCf`@t|A|vKn|If`@||AyaNjavAst@`_BigB|e@wf}w@nZ``@kaB"),
+	province("Yukon", "wichLtqapY`cKoon@fi@dx]hxHdoTsxH|{VvtA~uM"),
+	province("Nunavut", "e`imJpaoaQtf@vuB|v@`^bHyO@l}|j@ernVtWkbl@ouBymHvFonRjeAmmAdnFon@teOce@rpYiz~Abf_e@}qEpr~AorErhn@ujK`am@_eDzwK}lpBdcyE_sAraFu\\dbFIljFhDhi{HhY|_Taa@hnEgqeN`~sp@yZ|{De]xr@kuAfQm~kH?hgCcaGlm`@uckBb~YoftGdwQa_d@jnSgdtApkMo{aC~bJgbg@koAi}b@ypEprD{c@{bFfmJ{uWntCgaiAweCs}NfqIswa@`hC{gm@oeH~{WclCbgDwi@_{FinLnpm@ssEgdTxpJq~z@}aAs~YcsLnr[hnFgecAnpUomwAucAevWr{Tw~n@vhLa`z@d_CtmD`tJw`QpkG}qd@`sEkqAx}EwoPdnIwoCdw@dgLn`EapFrE{yNi_GokFh_G_aKrtQfq\\~`IsbGtfAj}B_eCvp{CirD`lSh}Q_lc@hSxiNtvExdDw|DloiAxxGscKfiRdrL`fDixYxbQfgsA~nDe|Cny@yer@pvCp|@zgJsy[spAkg_A~gGucd@{jComKnlLacg@wvAux]t~Goe|@duA{xwG_iAa~Ky}FneBs`AanGqRiqy@ntIaiHdv@_nQukKz~Ge_BwbIxiGeg`AsiDf|Dm_C{~FjxD{nT}{O{}X{{BuiWz{AazArbGhrJvi@c_Gb_Cb_G_pDufW|kCioLa_Rcqu@wbOaioA}~BfxApwHy_c@ss@wpDifH~hM{yCk|C{e@yaVfkF{gPr{NfoDndI}iEsyCw~I{iD|bGxRs|NtdMat`@btEziHjtEccWkTvmVhbDwaD|lDave@_tHmnCatDgoP~wAmqCdtAvfCxwB}|P~M_|n@dbH}zJxfOwbCdmJm{ItrGbeQxcDgz@|bHqv[bfJmdGcfJccLihf@ybDmtAsvPdbCenJheUi_KjaSux@p|FusF{nBgyFcsKrnAusAqkM~f_@ywhAnzW}oTtbBzsEtwPw`EseErrp@xgIhwMynWjonAvqUogSwjFclI~nOanWz}Hq_j@tnNvfB|v@rtQfuBydm@`wH}kJ~eHltAjwK_`K}pIccEilFzcElsA_aJdya@uoe@jtFcrLslCmtGtgR{hPvvSwhd@ezDowFkei@bteAgbUpqQu{JdpZ?smTcnk@hzM`jCgz]mmPdmJmtA_rCfaJqfVdvRwvFktErqh@~hL{xNdj@aiOtrFt{DbkCy_]imLltM}e@siPoyLb}G}eAi{Fl`[ee|@y~]`bb@mbIzpYclG{s]i}FimGotCnnc@_lKhwYkdRpaKgkQf|WygCwvBt~BccKkqQesHavg@f_qA_tH|i@crQozLsnHsfn@{pGy{FkmHxzo@{bRuzDb_Barb@r~BhxUhxAoxMfpEdxQfaC{wJ{vJa`QbGwj{@mnOuck@bc@esFbxEtiD{u@qrb@ghAznBqzAetGfeCqx_@cwEqlAwjLteL}~BmqXl{DrkBj{CivIy{MurAvdHyjYfvHkjIktEo|Q{cGpzYqwMcjJkiDtvGizB{{C}cGc_e@rgDccX_}DetXqbEe_CxjBwyQgvG{zIljDc{BbFq}LcdKswDqaKzyNiePqmXhfDrzZunDzi\\jgWrpvB?sqXjq@jwU`wBd`CtzDgvHrcAjkC_cCzbSz`GgiGb|BimS|nHtiLrgJ}nD|Y`yCitIviR?dvKelKrsLzrGfpCzb@xbi@ppQnhXlGjeVywHf`Kw\\jz`@goDfjF~k@vwu@dtMxbKb_S{qt@mtArzk@lkE~tN}|Cpyj@bdK|{m@ayGvvGq~@w_JheDx]qCooDyoKycDybEbtEzwIxiHue@xiHu_OkyIg{EhyAzoLds|@utF~dOsgEwzLymF~]itIcd]sq^}xc@mzJanW}qNo_`EoiReqiAebB_{n@gtNcheAwtHmlOljKy`rApk^ez~@r`MzqLriVokX`{AieOpoEeLqp@nbUf|FlgR``Fzp@pA{dh@rtEt~BjzCg}DzrQamv@knBaiGapEnmPhQa}XuqGwoJxrJadIxbElaSbyCi|@quDmnUbyDcri@rtQxkMXelJt~Ig_DpmA_rOdeFkmCuoC}q_@dlEwa`@qwCcv]zjCwre@wuGckOpm@om\\{qLkwDkbFwdUzfJqzMrtE`_Gn|KmdBzkOgxu@bCqhY`yTehc@flEwy{@~oJkj[wk@qdI`pFeyEqxHoAwmEqcPzxCkoS{_GayGphOktb@ayB}}I`qEgjLtVqhq@urGjeEhlA{cc@iiHi~@~wAijNqaEslNniFpPW}nZodCcsKwjElzDu}Ca|AtjEazMebC}xQstEmkDtXmkUb{HwMytIyta@`yHsjsB}~CwrH`rEs_Y}Gaeh@lpNmne@wm@ezn@j|CyyGiyIkpGoJoi^emJouHtyDmfQwhA}bk@ovMtjP{R`gOuoOhld@oaUvi@m~Ccd\\xh`@s{~@pi_@ih_@p}@m`T|lI_dJnkAmsXvlPwnm@k~Ac|Zm}IklZlfGw~JuwF_eBgcBsoYktEvf_@qiNuqSmuXrcX`iHbgUum[tk_AvsHhbFkD~nVxxGl_Tg}DdrGjwJ`rKf}JqkBdHz`Hc`Rr`XuuM~mb@i_KjrG}~N~oZrpAqtIkbGqqD_Oa{HdxRgvGi|Gg~OkrFew@imLdck@mtAupIoiX|bk@yRwbOb}HqtUqpE`fD?e_OcxJh~KptVmb|@m}D_aKzbAylLqbEruEqlAodClnCghd@wdH|UtmCik^ymXzir@{jHy_EhwBqpt@lcMcrL|wEoxSsdEq~@_|@~zR{}FemJrtEo~y@`xMwm\\b_BxfInxFc|_@`_G?y|@bfKz~FrxClnC{b]cpGqdm@jeFysJzbAdrHlkD|Id~C{wJwoDbpAwzF}dGwlKmwc@jfE|xK~kQrwI~cI`fV`cDyb@jbGjv]`yGvi@n{@mhFbEoeM_dI}sMdlDwpIy|@_`VgzFbtEha@rqL_vHadJ}r^gcsBnm\\xbc@|{DydJbtz@lqd@xkMg~CviFx_EcGm~HpeJrbArpAx{Ly~KvqInj@bdF|aN}v@jfKhmX|e@ctE|vKbtE}oBghM~dIadJlqBwkWsdGahUheDomD`eVdv]zoM|kDa|AwsTx}FicEsoMcn_@f{@sbFuoOiv[o]gvGh_GanFgvHg{QjhDogHxpEb}Bn|]h|u@ekJe``@f[qd[nuBcdFpqJqOk`A}wFepHi`@b_GitItbIkT`mF`nFfnD}qN`cEym@b`Hd|Ci}@piHzyC|pBfNxpb@ebC~mEe_C}wEh|Fx{{@o|J`jNw~Jg_KuYb{CjiJzcQnhGyfCxiBjpR{bAl|De_CubGiR`c[skNb_CeoCbzTxpEvkKlvK}iJdjQmyn@ltL}{IddMmyn@ztQcaMx|Lx~FqgNita@{aMfdC|nIjiC~[rdLmrYvh]}uRv|b@sqEm|UxiBgbf@brEmfGhBkjk@_~D{vQnlEamQslMkcRmxE~bOsjCwy@gjGtnIamGgfCa}EpxC}lJ}pN{}HioAgeBidOctKlv]gfSb}G_{@nkFwqImjP{aGjqAo_P`mWmjQrvn@osMut^aiN}v@aoAh{GyoVwh^ugFfz@{e@veHwdCkdo@xkHj`GitIkxa@j`FswIhjBycn@ymWwmm@ovS_gcA_mQ`BmlJmkPmqYyulBmpHueFqcEoa\\heDvuhAagI}aH{kOsq@ssQmxWkyI`jNsfKoxAyAxlj@smAtrBstE}fGntAfxMqmLyiT_\\jhDluF`kT?itEbtEjmHltAgmD`kNvaZxpDrr@tv@exDdtAxzFchDotp@xvU|is@fxFfd}@omEltj@whYyuOylA`rEwjEslBmjE~zE`vCs`^k}N_~QasCoc`@a}AqeDodCbcFchCusAweIwr_@fnE~ho@isD`|LwhMesGu~Br~BsiDq~F_cC~sIivC{hEmrGayp@neBsfb@gqJy{S}hEcuh@xmXrov@f_Kz`Me_CimLz~FhmLfj@imLbmIbiD{{f@em|Ali@tbk@ybWixYk_Gb~SdsGrn|@dhE}ZfuB`cK{lBnokAg_Wd~e@csKttFvO~bJovHfiAvaDfgY~fGoyFz{CpeC|lClgXswIjf\\zb@pzSktKhnu@{_S|nq@`Rl_O~gGx~EkiDh_Ge_Ci_GanA|_CpjBp{_@svJftTayBrfVmtA}fW_cCj_GetAstEggG`fC}tAnq[{k_@txt@ceRldGgvSelDsbLowReg@yaRueMsnA}mOoxRrrFurGeyJqgUrnHy}u@chK`{q@mgFpAy|Lcxm@rvP`jhAa}Fdv]ohMjfQshIbp^cvElvA_vS{hPgcU}lD}pHseW__DbwExpErkHy~FjaSmtGkaSzbAwdOmxE`gOqmLwmDetApxMu}Csif@_tT_r`@h~@{yg@l~Te~r@w[uxVkxDyiB}wEpmLc{Bwm\\ggH~fTqeO_g`@frCptUgrCrq|@sbG`xYk_GwiLetArtEyfDsdc@wlP}ol@kGy}h@uoIzR_`IcqKp@{y{AqnFq{XtmD~m\\wm@~}pAmgE`yMgvHalGe_CltAq~FgenCbvEurBfgBosNfjF||TlaBkjIfqDpn@fjFf{c@x{MwpIwbO{bSn{EyxGyp@w~PjcGfvGf{PtbBuwWw~f@bfE{jPqrDmfL`nF_fSayAqzk@txJi_@vtHjnS~jc@cduBpvO{aLh`Fs{_@vpDjkB`uC_uHj~LkaAhsK{iNxdP|jDn~CsgEd|U`xIliLaxIgP~mc@vwGqpQpjGykp@hpFw~DjpAjxIltA_xMfmDbxEltAk|q@jtE_cUrtE~iFfbDueF_dIslIptDs~LxjBmoCfbCj}SxjHyoL|~Bf`Q`iBm}`@fjF~nCp~FwtY~bCj{Bze@ecGdcGfxA|~BjtQjHu|OxzGa_GsbGwkFq`@{bLg{ElbIczCqfDnoDy_QqwB{hK~hFktEltAbcG`_SstEiqEa}GfpIgoV|_CixBdeBbuEbhC||vAstKt~k@anLviMz~B~iLj_GmxQvwApqh@rtEstQniLpxe@nnEqiTmoHeo_@rb@e`f@brK{}_@`xUhc_AntAyeD|rBr|B|kJ~fv@peIr}B}wD`xNvgFf|ExkH`_c@?}j_AdxEd`jAj{B{qp@atIo|y@vwAciDzeD|wE|dGilv@_aCfsg@diPsm`@fjL}j_AgrClZaaEiaQui@liDstEa|]uiD~bC{mH}bSp`@uk_AdlCpCrr@_aKlaU~~vAifIg{fAd}HkmNgrCymh@veHw|@?qxY_uGadDwOcsLbpGqni@zmHkbLg{@siJvaDlxo@waDnqZhpE}{UntInmh@tmTxm@ti@hmXe_C{~F?ntMktEitIjtEbm`@mmPimXpmLnol@{bKxzq@`sr@acwChyNpnFbzBg|QtvFolE`dEldGnhFehc@xeDpiHti@__KtbGjxa@kiDex]twEpqEnxB_tBmj@|kIzbGb_CbaG_zGfvMjoNrtEmtMpxBpwHnjFegKptPxjXbsK{iw@tbGtt]qxAayY|mTeeLe_Cum\\igIzrGqoK{__@{mHkoBue@xfCgsIyeZe~IsoGsb@~tM_qIfvH}}IwxGgtIixS~jFg|FlaIfgB}~BqtIgc[?kiDumDmtArmHixYvi@a{Ma{L_gHo|Wgx]qqh@oqDc~XzbAwx^lxb@q_{@bw\\wo|Ah_Wsjk@bvVuwQhmRp{AhfVeqTbzYqpFnvM~eIhvI`kRohFhgOgk@lx\\{}YxzBpaQb{e@vd_@mnUp{HnrJdyDqp@laBnvGtpI{c@fe_@abQlrMo}UdxKmnCv{Ks_f@xdn@}fxAhkRsbF{SunOxiMtcZdtM~wAw~J_c[ti@mtG`xMqoFsnXg}JqCkwPxvHeoCunOsnGxq@mnTa_SlzUruEovRmrG}cBooEwnK}nB_~UhvIqoWq{HinFjvKqbEu{D}~NueLrtEgjAsxIceQ|d@}nIcsE{zNb|Mwx]ufc@u`Uiai@}jJfM|vEczHofMmza@qcJrpAeqBgwHshKrkCctD{bR_rCnrIeaIuvEsiDvxE{nMgxWioF~~DsmBgcOavCpxMmaMe_CqnMjjCs}R}qMqtDytg@umD|bSzfKc`aAsiDzbF{jBqgCf|DqbwAuiDipE{~HnsIwmEtcgA_|Dsc@ss@gs`@_cCpmLikBqmLorCpf[fyAcqaA}jEjbAsmGht`@?om\\{|CoqDfkFqfk@sfD{lIalAjkf@_dIlhFxl@zd]cpF`zSu~BacKdpAnq`@ooIsrWfiGhkq@ecB|_HktEumT{iDbcc@w~J|~NwtHxzApp@_p\\iuDrhVgsBwdB{bGviLecAa`W{vDjfDfi@ueLihCj`d@ufKoNymFe_I?qpQemP`_^}kJqiSukJlpMk}A{wUbvDue@w{EulJqpE~iFom@nzi@_`DsgDldA_nn@zsLosr@qvDqdDalAuh\\usAaiCe_Cx~F}u@g{EdoCkhP_cCqzsA~l\\_{uBrjDedhAsiDudSriDmml@ubCkfkAb|Iqrh@le@{~^jkCty\\yqDfah@~sAkiDltAviLj{BmcSnfCr|e@bp@{nYjcLuaZlw@zdFaqKl~nClpBf|F~oVoudEv`Dw}Ipp@vgGa{AlzVb_Ssco@pm@dnTntCmsAz~@~rIbpBkyCmeAgln@e_Ct~Baq@c{Ih~@yqm@fgM_wnAdxQk}LjTptUh~Rvgd@cHnfSvsHvyG~{Kf}_AqqDiceAtwEgbUr`GkkDy_Fuvb@thQ}m[rnB~`Dd|Uhn@pnXryiAqgCve_@xcDepBnkE`uDnhG||[s`@xjGgxMr`MeoCj~YfOveHleG_}QlzDnwGcnBhsg@m_I|}ZrlBvnKp|GajZxfBht[p`Fww@zEapXtcMmg]`{Bdx]nrBezDzyDa{SaiCq}Q~sHq`@h_LfcNxsDezD~eCkjZg`QymXnMk|HloOryCvsBbmShvDuaGxgM|vQrmTumPn}s@egzBfx_@eyi@thP`|Mpqt@btQtfFbzNxrJviA{o@~iX``FzhOwcDboS`cEru]rmH|fKltAomDpiHpiNhtCwz@rkI~jQ`aLqyBmtAthi@xaEbt@h|Lhv`@vrIjcFdaBnsnAxuJxrDrh[dxaAzDxid@msLh`Rk~B{}Gx}FuyEtJ{mHsaX`e\\s}Bfc`@cnHv_J|Ip}MwaDyq@otAx~Fa{McxDjEieIjuJi_FeuFktFw~Jx~RanLkpMimLxpEnoJzwCtgFttSdcGbxI~rDsc@kt@to`@mhEzqFyiCwyFmmHrm`@ui@s|e@smH|m`@uqHyRuaHtaa@nm@_nh@}~Np`nBb_GktQt~Bh_GerAo_\\xcDufRzrA|ufAdoH`tg@ltA}eLzzBl_OdqBgiAl{DoaPq`Fspc@_zEe|De_CxoKx{@igOy`F{dWr`Bu`_@nzEwjAp_@nn]h|Mitm@yfC`_c@pmLakgAjxIpmXx~FgxYui@{i`@~~P`~Ktu^c||@npUibEb_BcnNp}RybQj}Fhk_@cxImvKf{@pbb@wmVdml@daHph[siOvsM{pGxeUn~JfkRbaYm{l@nhFmqCfk@ncDo|Jz{hAsaGxcPvvAl`U}iEplRkoGtwEpbKf_KuIbdKkuPdmFehA`oEm|Spy@qaPxhQqm@xsHpoMt~|@eEtodAixIv_g@`_GdqHa_G|~f@z~Bk~BzsDxmGk~A|s^d_Owm\\ud@|ka@fwXiqc@npDonx@taQcysAb~D_|ElrKpaVxfK}A|`If}i@~}H~iErwEb~e@vbOviLnpE{~FzqFdcAxzL~sOp{AbhUdwQts^fcDts^bjHziDhhDvhR|cGczHnfB`|AlcMp_z@kV|wiBwaDlsYafIvz]_uZ|xm@w{PlfgAltAwqpAw|Q|zfCklHl~HqsPxlo@dg@vxRgkFziD}mHnm\\?nu|@vqIcgm@brE{cF`aFvyFqmLnmt@lhEp_c@u}Cjjg@}~Bqqh@ifC`zAhfCz~^_yLjspDxtDxoc@t`Zi}yA_n@{hDomP~~VepCobJleYegxEnxc@mu~ApmSsoMzcKw|g@fsTge[s~AwuSfxMqhfDe_CmrYv`Ckcd@ymEeyPlfKmtvBpdE~|@rqKqdVnlFn`J|`HgcC~`Jr|YtmDctEp`Qfu_@tgL`sJjlHuX~aJhf[sdFhtCteAteGfiR`QprKpoKvtHplk@boc@hmQnxMxwZt~Blv@pzGymLp{HrqX{TxgT~q`@jecBi_Fl_`@h{AnjRsfKxh[ggS|`TlnCvzExda@yff@fy@a_Lze@hbD{e@zcRcpAagC{yHxk^zyH{cFiyMdgX`kBhsJszIh{StdMxjAhtIwtMsqWdjh@nMnmDvtGk~AyeNbfVwmFwmPk_ShwHlpH`zBwZbzNfpD}zJ``Fbd@wcDp|YvjFtu]ryDe|O_sCyiB|f@u{PfwAupB|wEzwDh_GatPzeDd{JzoAesMleGo_DtkDvn[mtAtgF{dE}rGo`DpkGdfFv{QitIb|M?`jT~bOymd@|e@zbGtiEasE|dMq|WlxEbs@yO~m[liDi_KtxQtmTjiDwbCblCr{Tq}AxwCkkCo|FzyChwf@}bB`lGywAipEmtAzePui@i_KktE~f_@pfCeg@nwBn|Jse@muXzsFyqD`oEz}AptDjycA{bR~`}AiwCaDYyzGkrFts^zr@pk_@yvCmrBqqE`|GzfEbmHwm@hye@o~C`lGmmDa_Sg{@rmTzsLvzK}{Dxn{@gcI`{X}gEdyn@okFtzEyR`kXktEf{Fbd@jzTkvPfdiAahOfpa@ukJ`}@qeDxf[ty]wvj@ryBxyGrpAitIvm@h|i@zsEje@?w~JffNekKppKimu@lJuwEg`E`jHjUec_@qeD|bY{fKxmR}e@{iD_cCjtEcoByaJ~{Qk|x@rnHa}`ArkHeuXsdFcnYt_DuhKjmHotC?mq^ffHum\\~wAlmPnqBhxBjlCgxFziC|_TuwDlaHrxIdzJjeEto[``FadKmcHeaYrtEufSqxNgsHiaQesmAdjKg}]n|KafCvqI_ue@hlGoy@ia@knj@x|FgpPwrB}|JhjMeml@afDhmd@bfL}bw@v{Ef_KdbHcxIfuA~qCrdFydEhoFjs@jEjvEb{BaxItmDb_CjmHa_Gvi@|wEz~BufK`_K~yW`fDu|DfnDbyJuf@vho@prV`}u@rr@bmTlbC_jL`yAp{FarP~roCfgMi_F{`G`wl@j}BtjVx`EjFn`Ka~QxzFi|XjqAdnInsCyn{@dwEwrN|nN`vIfz@h|FeaBjpd@mxEstEg}DtcGxgBzsQ~bCctEfjAtdBpeChy_@owAtmHwmEe_CebAzkC~oC`nFwbHluRljPeqNr~Fr~Be~BemJdxEfxGhpEmtAqtCbx`@h}LxlAv`CmwE|bCxf[fkEopg@`sDcwD`tE`zGtmTwf_@}bAjsWa{HbyC}~BxmLybKlpIqxMpdbBlnUiytAbbFijHvzFp~Fo`D`jd@jzBoaDp}@ndCfyA~_Wn{D|qA~jAgvGjDqa]jmHtrMmqA~}Jh{Fn|@eh@zvOpbKdu@qoKbkUmY|qHrtEq~FgxA`cW~wMs|e@vi@|m`@ntIyiT{yIv}`AlhK_oWfpDtyVnxF_~r@ltAvmPhxBsec@joGjpAstD~{c@phG{dEmG}dTtqHfcg@|eX`cs@c_CxiHjxUhq\\piHmmHnm@dxEpgBwdUxgJivC|iD|{U}iDj__@fgSqtUx~J`_S?}zJ`xIr}Axl@ioLnpEjnZj~^fpU`zGpiTljQliJleFakAnfN`oQb_SlmDnvBvaIc|ChxUdsArxHriDgxQzmBf~CmX`}X~sCwoVfaOsrKdvWlxQ~jLu{DphHjbFhxImtAv`DbzInrHkD"),
+	province("Nunavut", "cyqwK~klqS{aManK|_C{oBl}PtgFfvG|kV"),
+	province("Nunavut", "miywKzzmrStmDsmTd_IpoRogYxgN"),
+	province("Nunavut", "_ctxKbyrnSfxAexQt`Br{CfAvte@qtUxvN"),
+	province("Nunavut", "{qezKxzerSsyCrxVwaDb~@v|DkbRe_Cym@}sAbnH{{BqsDs`G_zXl}BamFv~Jpm@rmHxiH`~FkwDpCh|G"),
+	province("Nunavut", "uqe{Kjx|sS`_GbpA|iD~bO_jLjxUykF{~Fh}@gc["),
+	province("Nunavut", "s`{{K`{}rSe|DggBj_B_cT~tTa{Np}@rz[nzEg|E`aF~yLmaOreEmqAapKgwBxyG"),
+	province("Nunavut", "edg|KdstqQe_CstQptIh}@fsHnr`@wbCnmEgzEopCetAbtE"),
+	province("Nunavut", "sdw|KjlqyS_dCmpZ~cCi`ExeCvxQ"),
+	province("Nunavut", "s}q}KtllsTti@mte@ryClwu@wuBryInl@uu^"),
+	province("Nunavut", "{}e}Klc`eTmtQi|u@`kLlwL"),
+	province("Nunavut", "klw}KreorS|tLppFcq@dq_@wiLu~NilBeeM"),
+	province("Nunavut", "}rp}K~rvwSfMqrDxaFbGra@bhJacJnue@adK~nJ~zF_n\\e_CziD~[ofZdbB_sH"),
+	province("Nunavut", "}an~Krl`{QxtIvi@|sAi_GjlBneCq_@||IcaLniM"),
+	province("Nunavut", "olk~KbpmzSdpHwgHzhDrtEazM~`g@vi@ixMexElxEwwA{lC"),
+	province("Nunavut", "ils~K|jw~SucG}nZhn@_wGtqG`mFxzGjnbA"),
+	province("Nunavut", "ql__Ldgo}SiqPigw@h{Slxh@"),
+	province("Nunavut", "et{_LtdohT?gx]`xAniRtpIj|G`yApzNkuErxI{}G_~J"),
+	province("Nunavut", "c_i`Lx}{aRz~N?xiAd|DyzGvaU"),
+	province("Nunavut", "yxkaLhtncTbcKixYjt@~klA"),
+	province("Nunavut", "yxkaLveibT?ccWnnD|gQokEb_ZepCg}V"),
+	province("Nunavut", "c_eaLjfw|R`zLmzJlnDjbF~tBp~c@ytJrao@kcQ`ac@cdF{ne@|u@qpg@"),
+	province("Nunavut", "k|cbL|duwTndHmfRkFfpJ{gIzwO"),
+	province("Nunavut", "quvbLfbukRxvUFjDjqXd_DgaDv~Ij}GqtUn`bB}`Nubw@yrJwxF"),
+	province("Nunavut", "kg}bLjsrbRxpEfxA?lmPjxIn_O{iHx~RmtAqiH}oC`vCdsG~xXsgVzgD_iMk{HaiHnaIm`HmhQzqFuyh@tgFqxAnoJw~b@ltA`xIxpDmv@hmAh}EtpHsuD"),
+	province("Nunavut", "mdtdLloraRjyI}|ItlP~|EsbGria@gjLzfJg|QcxU"),
+	province("Nunavut", "khheL`~tjR`oE_qCddGfuGfxAnm\\_cIgxQ}zDjxU_~DelEks@anW"),
+	province("Nunavut", "awagL~|igR`|@ciCzkCrdE_{@|uLnbIjeFfMttRvaEbpGehAp|FmxEi_GuiDf_KfZgjLkoFcmHk|Ij_e@azGgxLtzEgjMeqCapK"),
+	province("Nunavut", "}cagLfkilQwgRbec@{t@{cFvpDa`Lg[yjp@xaE_`KksFw|\\ddSugLrrFtrHnxMgm@yhCx{X}tMxiT"),
+	province("Nunavut", "gwufL`drhQkbK`aL{tEgrJagOyzFmlE}qTraBexb@ngS{do@bxUbgEdxKxmXym@~mh@se@ktEs_G~j@upCmkKa_Lf_FyoFcpBs_GrnH_NpdUtmPkxIvjWplSs_Bd`TstE?"),
+	province("Nunavut", "}eohLhninRjrGwj@pgBf{F_}Db}M{aGg{@"),
+	province("Nunavut", "yshcLftcdQrcKxuJbwVz{p@aOflFcnHeaCaeDfhHxzAbub@viArwDt{DwyA~}Dl|J~qHohAue@fx]|l[zyx@ljExzj@klNxqqAujKh~]uIngGpfIskHfbCdiKyiBrrz@ymWph~@y~Fzmp@`dD|bNcgOzk`@ceLe{E{eEnfTlu@f`KxiNgxAb|BhvTmrG~_KhQtbYqkGlm[evFr`A}nIgqI{gD|i\\h{GdpHefA|`T`uIumOx`Fzg\\{qLrro@gbIxgCowM~eYmaT}pYydDa{_@xhCc|v@qpFm`OswCujh@wrIyOeuGgbJhvCmdRy`Ekl[ovGrH}zQfbf@ccEwyE~Z}x]esG~q`@gkFpa@chDubHxgBceb@xoLqbQvmEqw_@zrFutAyn@a|LmsAunCex]lcgAaq\\uzO}pIer_@smMssQ{aA}xQhlGuti@|sGe|D`cEahf@fjLa~XdxE|m\\xlMa_S_|QazSb|e@ehkAbqLideAriUyk|@r|HuoHtbSd_Oz`GkbFxqK~v@vbCs}RoaIpnEknNmJmxKayShg[ccWfxG`wHpzLkhVjeQshD{r@}vVbkI}cNwfMozt@"),
+	province("Nunavut", "{msiLhh{qQ}oHz|BcpAq`RtkDypJriDzeDt`BswDxkAcqRd`CdrH"),
+	province("Nunavut", "_zclLn_{eRjxItmD{~F|bSyaEazG"),
+	province("Nunavut", "iofmLd{ncRabKnlz@osCjyC_wLmt@g~E`jGb_C_eYu_CujQr_MyvZ~~Del\\{e@pxe@~eHggSnjMqrJ"),
+	province("Nunavut", "inqzL~bs}SbUk|Iw_Ep`FmtAgcOmgFh~i@uHx~@azk@?fyAueRdoHizHjd@sjl@lxEviXrmT{mhApwBtmD{|Ascj@lkCsbLz~BxiHryH{pi@l}ChbPltMdtA?_cg@e_C~eHwjAgcCf{Fcxl@`aKkpGj{ZxiM`~]}cXtnIrc@?y{YfxAt{EjmH{bGrtQ|~BxwA{bWn{FxiHkhDyd\\l_IzwDt{DnhMdoHcmHbtEb_G|uG{wUpaEvwM|e@k|e@foEiuItnH}h@heDmy\\ieD}aTgtGneTywIsfEvbDi|XabLcnSi`Ei}{@yoKrcj@icEscj@{iDvf_@clHzd@yqE`qb@ybK|`IqtCmcGitCdnHui@_nDmtA~b[{lTt`B_wG|tLwi@kv[{gJhmX{iDsbG_eHtwKarD{wOgtIb|e@wi@smTsmH?_fHh|YyfCixIoeCftImtFmi@qcJ~eN}bB{hD{|TrxOysC_kFk~GliKqeDkeEcjJdgRyz@ggNc{N~tZccMs`Y~eBo_y@smNp~o@wiFpkFwi@g_[a_GlpMt_DccrA|j\\}~qB_vBqaK_fHl|UsbGi_GysCasr@nsNsx[_]cm`@nvGcsE`_Syfm@xmF}`k@kcAo|]hxIg_EhtIioh@ltAjtEjxIytUd_CjtExeDw~VrbGymL~bCbtEppEwbOhm@zfKkmH|eXjmHe_OziDlmDrnH}q_@bsEhcJf_KmmDltA{ePfvGzmBpgBagIl~DxzAqSvmQbkr@kdaApaEuVzmHj|Mb_GoxAvwAixMzhJ}iKvcZ_{LnkF}i[reo@}kz@x~K|fGb_GsiP`jH~bDp~LomKpOhdg@tfReaHl`IleFffN`h`@taH_aJ~_Vg|jAhdJa_RdrYi|SntBef]xzY_`t@hn@emr@faPe``@vfAshh@mjEbpBwtGn_b@oqO``FgfBsoMrxNyjk@ntNeu@rvPahuAn~Pwki@ogAo{Kb{Nyfg@tKefLcqGscKnoDuce@vfM`gIb|O__Qo{@qiYwsH{_HviF{h[g}JalXduFsgV~hMt`Bh{LanLf}PrgE~kFggMrmNmtAtbHjkClpBzgInpCmiDhxIftGi|A`cfAmz\\||ZofHayGuxDxjAxgr@b{l@euGhoEm|Ifb[hn@d|OgrU`kT~vBn_VmyK`|A_tBr{Gzp@dnDxsJijBd_CrmTdxE{mH|}DzpFxO~lF{kIpdCpoF|dBmdAfxLdcHzoRgaDpsPz}L_lQnl@}xPjuWbkCt_B|yJp}@fko@qgNnhaAgdNjkZ?`od@ftM|~BvnKeow@nqUarQahOi{Fh_GgkLx~J{iDntIpiHbxO}sArmNpbc@`xNq_Am`Oct\\q_c@}{g@qeD}ow@sbLqfOmw@mciArjOk}YbnHn~Pn]fgYbtE?wyAehKvwGucMhkHbjIstFlkV|cB||CzsEgxAlbHgvXcwJ{dLguByoQlfLom]vyL{RzkNj`RpkHupGxcDbyZipEhcQ`xHlnTuItym@fkFwfAd`If{WasE~ck@htJozEv|E~sf@ypFrco@nhAxw_@tpHvbIupHzbc@miDpyfBmxEbcWotI`_G~m@j_G`pEuxEkwEtma@~~KfdOr`A`oKsfEzkfBmkJjf]_fHcsXpyA}}N}`BipEmtAd_CqaEqiYqmFptCovB~h^lrAt_DjiDyePkcFtypAciI`uPskCv{`AjvDdeRiP`}i@qmFxsg@}pHq~@c~Ha}WwbUrmGk~Rt_s@~nJlu]jtK`_A`jBvlh@~|EbvDrfPy}KvpCtcArmBriPh|GrFmfArxNxfDzxJpvTjm_@|nO~luBsc@|ysBrnA|mDriDitEp`ExcEpc\\jqyAvrOllsBduAh~tB~`Ijx[ajGnwd@vxLneoAebBh_Ki|G__a@zkCft_Bdv@b|IndBofHtgAaj_@x`Ej|GquCdvu@|pBbotCbnBtuz@fvGfs[du@vt_Be_CfpJwmDsaGhxAaze@gbC}dAe_Ozjy@yvI|fQcwIrq@afUrei@szDupHcrKriIkxIkqRyrKmI?teLoxA{ePgtGj_SetGt~Bwi@exEgtCzfKacEom@|X{t\\{cQjkjCn_EtjtBieEfm\\deGpsb@sfJxnxCqeUj~jBqjHboSctEetMy~FftIkhDucB_vAlr_@zeDqtIpExfI{zInt_@{gD_sNu{Ahn@pjAq~I`GqtSxYwnJmnClwDcbIldUayb@nmfAibUu{BpEudxX|PgtA~r@uX~gZfMxmAgLxYgf@wE}|j@mmCcOkRwzBr}@ilIyDwxTsJcsAykB}nH__@i^uf@rTaq@jeAce@nlBoEfyBrj@d{NkqAznXsgAa}DgMcuGpb@{nO}nPh@?ahgNsa_NfVcV}mC~dBwaVj~AwrIcd@_nDyzAtmCynFrtb@yl@foBciDCrgEg`VinAscRi_Wzdi@qfO?"),
+	province("Nunavut", "abivK~yf|L}uGf`JqoF`A{iTozWatUetMa}MokwAyuH}tL}sMwhAycE_xMkwI|ma@cuEanAcnTh|MkiDlpMk_G}~NitIpmLazB{oAccLrib@mgFrS_pCsrLy_G~gAokQ|j]bvDplM{wIhxMumDmxElKlxKqrDqmFqbKui@cyUh|MmwQ`wB?lqH~lEbr@{sFdla@alAg_EgrCngBsmA|kPvgGtzJgrDjrXitImce@knGyCeZgsOu~BrwKpuC|aMh{FtY|KbmTv~I~p[~bCyzBze@ziD{iDhmLmiDwfSwzGxxq@inKlbSopOe{OmbCm{V_cCa|@g_EzePgvG{sQkVzbS{~F{xU{e@djPe_Ca_GwfGvjsAvaa@y`z@prJ|sRogAbsFkzDcjClcCxmi@ajOtqv@oyKvlEuvLbxb@ynFyQmjDwdZorCemDwhBfsCmU~_UnmDzfKcGg~Ph|A}|C`mApkBx{Axda@}wEvx]okLcey@khDpgBwwAwmDweBdxDn~DzqaAapEc|e@le@b|e@ctE{bc@|sA|b_@ykGyeOw`Dquy@atJb_`@hmMtjn@jvUzqK}VbxOwmWrpv@~|EblOxeIalMrmHtmTl{Jva_AluWdjkAtcBnny@_eHf`JsbFcs@qwIkqR}xJusF_}EvsTwiRug@sqGybi@dqH{nsAcbMeya@q]mfd@ynLurHyjNt{D{{YljeAi|Fxfg@du@lqj@glKd{Ju`N`ta@s~GerAeyKat[pmFovq@mpBhxBe|DyfHqQh`b@scErlIdtAfog@~`CkoSltG|jJxBnlLrhJvoJw_Dv~a@}gGwkKf_Fvf_@gtHnxi@suFw|KisDm~l@fpE`_Gqb@qlLg{EyjN{iDa_AmiDjxUyxCyjAaiB_wX{kBpxBzkBbxx@k_GutMfLhhIduFbrRysCvu^kqRuu`Ad|Uvn_C_l@ooUjaGk}Mui@ntMxnGfoDldAvkL}~N~iLub`@x|AssQ}lDyvTzsEseKrsc@dcGda_@mxEjoZhdEcfK|nCtpBkiD`jNltAhta@kbG`wBiuIryTqeDwm\\cnBrx[jcA|sGtiD_cCjWblCspFbzNyeDumD_PbtKakMnxGlw@zhP}jEllJv`EjkBrtE}bGacKjgg@kpA{iDmtAftIqxAybKs~Bj_GetAote@otA~sUg|EytUvqHsb_@}kO~|Vp]rgVw_IvaJryHxgZjqAkv\\|uRhw`@fj@ypEhtIze@v{EzrKomDr`Yug@mnIapFxR_iFq_MsiDre@ltA~bOnbCstEx|FviFow@d`TbvDw{EloC`lBeaCz_RnsHfaPdmJ_qZmaBaeVhqJavf@aoEs}Nx{FcsVdlVmc`@blSavBfnKa~LnbOdyEvbIjoR}XfpzBlzEroo@wgYr~j@fiFdrSwlJ~aJ`~@rfy@cmIrvb@m{Oz}eFldA||DziDsgDzfDoj^~mKgiXshE}_TxaFge[r_MalLbpY}sbAr~Gz~AtgEtx]{fU`{j@ufSvvmAefFufAsV`cc@g`[plfAolLbbtA}uWjn_B~fSsml@rtQcdnBloIyqc@v~J_|Pj}Gmrk@piBkhDriDhxInmDmmDim@jxUtbGxiTasDtxJdsHfbVjrFg_@erB~x^e_Ck_Gom@~iL?acWugE`_d@l~BvdHco_@ldvB}d@`bi@tuLffnAalGpuiAijBv{{BsvD~cZwjG|fFu`CqvNyvBpdC`eIrkZggG|}jAdw@lvxAh_Av~I~vFaRqqD{tn@v`OwehAu_CvqdAffCbod@gbVpa~Ay_W~uu@s~NziDyuCoqC{vClfF{gKktQyiH_ve@grH`mM|eFgoErkTpu~@pvIkxCs`Arun@_sCz}AemDkt]dLfh^klC`oF?_jLy{AfdCylA~yR_cCetMwfBpuIhjNhpt@xyBqtm@ndCw{EtfK?ubG`h|CzzBfvAlmDgob@d`H~~m@ojQ~coA_cCoiL`fIymj@}mD_iL_}Vjz`ButArmw@_rNbsh@qtUzbSyvUhhw@koXnl\\_tIc|q@ymXbcgAdbBgn_Az{Csb@t~BktQ}nCoo}AzhDkhl@`nLyuOtoCojsAaeDw_g@rnA{h_AmwJs|MrmAuuu@ydI|kkAe}P|ldA}kI~wRmkKeiEskBt_HlvKfki@oiX`byIkxUvm\\mtAyfCyiHtmDskBc}HaaK`vOox_@ufo@ktEptU}sAqpEcaAz~Lw|DjTowM~y^a`K}yVitEpm@ixAexEmkEtpCgpIual@xkAsgPerHiqQ{iDd`~@ueLxbK{pAm{DgjKjnByoL}}UunCdrNtI_oWczCbqR__Dq}QmgR}f@qjHmrHt[my[mqCkrFihCvfBwi@_|QabEngRauCtsBa_GktE}iDzeDrtEg|y@c_CvwAotAy~FioFz~^opPqqUqrJlfKioFaqJp`@{{OetF~|QknN{fQgeB{bQgm@bxI__EqoEokEowSdKsd]}wEdc_@otIgxc@d_Cyqn@cjHn|m@mmPwq`@sjx@ygsDkxO{bzBqgI_moBpmGovzGp`L_ps@lxEz~F`jGjoMli]vbyBf_h@~nwAfkc@`er@xsDgz@pkGlqH|oe@rpaAhtUbtKpiHqeJh{A|~CxiCm~CzyZiddAbzNulOlm[ned@fjMtmDvqe@qeIdtBsoM|~HivCxq\\ixe@vvX_ucAr~Yagr@bxPghjBvkEysDr_Hd|CbDadJfcJuqHqnAdmdA~yA|aOvi@ufS`sDtzP``@h`d@xkBkiJzvDldAsrAprKbyDtcMytDzs]xrDf{n@vmWn|eAzuKrrcBcaB{ehBu}ZgzcBw_Dcrv@zsEa_z@urAu|PemDlxE|`CquTug@_bDc_CjxIyn@uuLxbFyrUn`PnaC_|ExlSpfCfy^pm@sfc@xeDjmHd~Hu_NxzHfcH`dDa}@kW}}_@ckHs_H}oTx{MehRchEioFazXcqK~cYabQsfJ{iDb}BexKuol@}`OlxE{sD|~NasDrpAmxEqtIcoBxuBoy@hpo@b{B{iDzjC`kHg|EhpfAsbWxq~@knCem[g~Ot~UgbOj~yAe}DbvDf`EktPyiHtiDd_Cics@wmDf_KspAkaGumDomn@iiHnvGwi@wb[a_GrmTsrFom@kkCrbG`{BacWktEl_Oi}AcxClv@s_q@ze@z~Bl_BckCifC_jSdx@kjCzlBlsBiuC{qMvyEm}`@lbe@{jrBzxOksK{lAeyE}jEsrAsiDhtEmtAmmDkgIjya@{oGplLmtAktEktEtml@ybKpxYurA{fJqkHluo@exKwlWqtCoriAxxAznsAuxEbpAkp@knMobIgqCl`TpucAqrEovAaxMxmlA}~NfgSstEcg[evAbb^ixGxjMajIogBm`H`vCcaYi_K{jC|xD{jNqwTvx@ijp@d_g@uriG_gk@xa}E{e@miDgfGzxI`fC~oI_xA~_fAcyOkaMonDewLhyGukJlgGw_h@qkN`_SsmBobIz{Om}jCv`DqusBnbIouj@aaKlu]}gLhgvComQl_rB{iCdyEcuPupNynLwv|@lwPyk_@nlLkkw@cvPb|k@wgM`uOgcO}|{A|bGwjmAdzEewJlnNmeGr}BakMsyCex@suEtaH{cKsmAgoEn{EepHhzaAqrPspfAkaMck|AbnBotSw`Pedk@d_Ccm}Fdna@wmy@vd`@udHdaNh`FvnD_~DxkSe|mBdwKksLrmHraHt_CcaBrmHh|M~sHogB~bPeeYjtE}fc@~iLwtMtoTdc|@hkHmiEh_Wh`rAnqU`jk@~sHpdDffHrva@_fBepZaga@czcA}nNcd`AznBesHptUre@|bMlzm@jnHrnN|zDzyCfbCqgCbf@pxYzsVmanAeeAnvxAd_CktEr~BjtQeJsbo@rcFk_GpiNntG|~HjmZ}qH}cj@eaHg^q~K}yeA{yCqjGa`FnfAksLt}UqsDuxn@qiHhmLohGy`KjzUmzm@bxI{f_A_jLdqp@ymXrxm@k~LyqVo_E}~`@{fKwi@{|A}rHz`Fk`XftI|~NvtM}{a@v|Phbb@vcDqa@qhI{lj@rmNirQ{bL`dEitDhoKmoTwzh@jjUknw@n\\qsOxfIzgD|wEy~Fr`GrvJ|}Nv|En{Qpmd@j_CwgNk_CcxOf{RywlB}|DpvOwjF|eAk~Ltb|@m|PqfI{jItpBotAavHdcMgt~@hvIc`LrnSiiIjxUmja@pfIaty@en[rekAgkEnz@{kCmf]`nW}na@hlG}u]~eIcaM{yJm_IccEltd@q~Xv_m@f@dc`@u~ZxgYosTvbvAotI}fWeaH~kKowAufu@hyBczCt_C|iD~sIqm@j_MqjSgj@a`FkiJfrOspMab@{~@kcKt}T_ckC`kNiyLviFh{F{{C_}JqwHt`Ccp@w`Ekg@szw@pxIkxy@g_Kxqt@kS~{t@yjN~iu@atJvimAceP`qz@orOrgDifOuuX{s^cl`D~kAm`jCl`H_bf@dlJcfFphHmf}ApgIcG~nJsvKghA_zKqnK|iEseFgjXfnQsyoCd_O_xGh|]}ih@rdXnlz@wfBlxkAroL~yrAl}OjjOfbVfzo@pwBikBazYkgy@ewL{|N}tKczbAbwIk`tAjvKn|eA`k^fxSpvOd_Uf_Khux@e{Doqx@gxSyq\\_m\\sbSi}FkgdA_iSi}XtuFg_qCheJotZhpKm|Id_IdxPb{HcaFnkGtoN~dBzo^o`DxzYjd@heDliDkiDxtJza^feH`maCqp@gzfA~lFeuLryZlzwAixSq{_BqyMcwb@pnEwvMtkJtgFb|InkXnfAvmh@hyHjhU|lCxyBwLe~CuoHc_SgOgob@vmEwsBjtEdcSp|Aexb@l_HgzFb~e@~}kAvgAihCkig@gavAmeW`xT}zDgxAoxAyiToiS_yQ}t@ufS_kKawYqSs|`@vuHpmA|nNjbX`lGusAjhD_qPgaOnyL{eE}cSgyRw}UauCyrt@jxIypEwtHsaL|t@ixCd}DvhA|zUtls@~yLtgRrxIb_GfpD{|B|bH`z}@kiDwfw@fvGn{FbxJtIbyCbjT|yDoqOtrN|m[ybWcwm@utGjd@atOyiTunNapE{hJ{xa@dxE}fWxtUvmPncHcbLdzExhI{zBc_Uw_KssK`uIocOr`BblUzmSxx_@`v[zjI`~Krm_@glEymc@iv[caM_uNuyb@aoE~Ldg@{qWdtLaxr@neH~fk@nmLmxEptIzmHniL|b_@zmHgxMybKlbCidD}}TaoRc{U}{I{FkgDm|`@u_OeyEi}LrtEumDcxm@gtMtbOmxEgxe@kwPymFirD}_I~f^aicEryl@imrA`_Sxfk@z~RlxWyiHbgkAhrD`~b@heD`bLlgFgiA`xIl`OvsBdmUfjLfoJlzJ~~pAcxI{j{AhpEk|Yd_Onq`@lmDobCb_GjmHbxIrxq@?_jLb_CfxAo`Komn@apPm{UweTeaxBevA}hs@tzDu~[}|HjkUwiX{}Yy|Leow@~|Dmuj@tb_@kxUrpMpmd@~qOh`Ff}Df`JxkH~cr@vlQzg[ybEzbfA|vK~hBz|HlcYblHfaC|jD~yi@uXmkm@fjLr}AnqJtsS|XsgDsnNqs\\qfOktEmtAtbCayMum\\yoEti@tqGmqbArrd@fvSbvCrkHrmH{mHh_o@jgs@yjRy}c@uhRcoS_rHalFwoEdqBkrh@g}]}sAicO{~BxmLm_O_zLsao@u`pEttAc`Yt_It~Cp{]vylAfeCfmn@phC`dEjvOsmNvkF|fQniAk|B}~Cwe_@awTtlOjHe_}@ihCmoCxoFakAifJ}{JmxE_cg@mtArtEwfBsdDhrEcrRahNmyKelD{zZxiAuys@t~I{oSlbZotnCv}Di_Mx`Fq}@brQzf]~sHfpCieCb~SiaLeuA{a@jsFznGj}Np`Kc}BtuFjcLznMnoqCz{Nj`aA~iMxaQd{Czsc@xiHtyPkqFgvk@xeDqpQvsHisC{pAj{_@xeDva[ptCzmNpnFdoBxaKfdl@qm@gtYivTggv@}u@wqx@u{DkpBkvKf_Ky|LqdVmlIqs{A||Cmn`@gbCgtCinAjdRyyAvrBmYuzDjnBokqAjxI{wEj_GhxUbiDumDfjF|jJf|Kzw_Adn[`zv@iRxeiAbg]vsf@t~HngBzs@m`D_mc@u|l@d_CyqdAwtYeew@mxEaca@plAynu@wzFrjCohGsqWulDpwBk_Bepk@chJen[ebBdcYu{CacE_cC`xI?axI}wErwDysJyt[u{EiwBoi@q~Rv~Jcao@bod@krlAl|JqgIjdKc`YvjLst@pp\\_xRfjAnel@o{KtdmA|g@t|r@v{Knnh@~hG~nDtuEjtQ_tA~rhAzyTrlgB{dF~~uA`|Glv{@fuB}tXcrGcmk@fhGuxfAcjO}oeBzGcxa@leXuvpBwMmzvC|gWkztAvwMsyUzrKyiBp_Lh~Wg`IfvhArcFrjIowG|}Z|fEp`]mi\\vmeChnA|{[u|JnkaCrgJj~d@jzCpgIniAm~C{mMgev@fjKorgB}u@qxe@btKcoYuVtdkDrjCmgxAf_Knte@~`DlgfArgCnbDotAum`@`xYlgQmlDivOssQikLydP_z~Avm]ssaCdoBdgo@pqE|rHaqEjvm@neCvw{@baFmxbB`_GrmHb|Bo`PfbCwrDp}@vcDkoGbm_BvpUgokC`oAhkSakNtshCteAjgDrbGcxU|bAlwWozQphdCxvCduQliDego@ltAzml@fwAablAldBijGpaEvoItsAadDw`Ds`Rr~Aqe_AvzKup`BrpAf_WfxAwxMltAszB~bBf~Dg|DnlVv}DnjYg|Epjv@zvJzyq@hlA{zS{}Gwu_@ndBc|ZpjCsc@rtDpou@ti@u|y@kdFosNbkCe}l@viFwsC~iAcyUaaE{oLphSo`D|~Ij|HctEybKavUmhFgyAu`e@bwEeq`@dfFx{YhhTgsCspG{yImzJ`uC}UybDjkBot`@ydDygH~dHczI{bFqcEtiKu~H~wA~m\\^mii@y_Gw|DwmDdxEzqFclZunCynWn_Du_f@|x@|hWv~CxjAweBlgQl{JzcXg|EjjiAhqJbikAnpEftMzbFYgwH{iV{fDylj@nuNdgL`zAzaSwNyeVm}IohGodHk}Xra@saMt~CrV{cFepUpsEkqc@tyDbig@zaGxfNvjLbbAnkEheJkoAnki@`nFamj@i~K{nS}cH~tBopIerk@|bHccu@vzE_x@phI~yKzuDkkBxdJxkXpnF~uAhhBwwFgwGk{CywN{th@hqQubBv~Jf_K_vBoyLklZu{OfwBcis@pnEl_ClqBnb[ym@kzk@xmLkvKp~F`g[nvSjwOi}@jy_AjmGk~}@vrIevEarDstEexQcpAytIacKs{Nye~@efS`o@wtBo{EvqIm|Jd~C~|EtXajH|lVpta@`_K{ePzm`@zePn{Pm_C{vPo_KqpPtw@guNwzc@uaNbsF_sC}zdAzd^~cNftH~qO~pNwq`@hzTi{NegAkaF{iTjyIsoMd``@oqValXemI}mm@cjCkcEmcGdpBwaD}}Z~_Jswt@b`Bo{DrzBttFpaEjb{@d_CccWpbKb`Sc|AliKvuLh{FmxE{kTvmFmkDjfJru\\`pFjdFtkIcd@aBquD_eNe_IubMihf@ffAy}QhpLhn@|qAjeFbe@qtEihB{iIwcUyzArnBizp@dsG}`Hq}@iiNn~ItbBvsHteo@qpEics@mxEybK`xN{oSptDfeN~l@mxg@raLzeDnxAg|JtdLbhg@qjA|be@dkDcxUo@ek_A`cQczItzDthK`vCexQ_vGlamDjxIadnBx~FjxUui@bxa@~{Fblr@h{Ap`Elt@wcHubNyc}CbgKllJ}w@`mWb~N~iyAf_J`bPdtMjVaRsxIm`O{lHouGsqRmsHulgBenIoiM`xCki[sgD}ja@vcB_cVl_Ili@txKxfOnlKhwe@~sIbhKtjEquDomLstE_~Pyby@oyE{`Gg_K~uAqdPoqU~i@kdQz}HhdPwy@oyLcsFahIe_CrbGeaCi}GvtHeq^f|K{{Cd}I|}NxvInkj@~iQqeJvjGdrAjcLvh|@zyHf]heDfpJrnAv`g@{Es}w@}lDm|HyqJxz@ia@gtGx|SulDvyQr`GyBkjDmeRa`QmoOzoAiiNon}Aru@ycDj`^gdB`{^zkNqxGikNuiFv|@{bLwbOegK~_Dw_QqsDv[ymd@jgQmkJjhDqsOprPkrRupHylAghMx}WiiNmI{nBmpZs|MfxAcyCkhDqmAekb@lnCcxOt|Uh{Rvi@uiPglKwsT|K{cLvmDriDlv@{~BovAi_QvzEgk@rjCb{HogA~eY~nEtiLigCuky@ziCaqQx~F|fWv_Dwuj@fm\\iyk@~sHkd@tmD|~Zl|DpwCg|@xj^{sPrnY_eI`vr@vsHpzHxkBvaZ|nCykRctFulOpyBuyb@nm\\{wm@lxKvsnAwi@i`rApiHc_S|iDb_SnMx}c@veIbhb@y~Fdz[|sAdaUitIdcG}_DkkB{~@lhEmlE~ehAbfEe~B~l@jvOgk@gud@boSaeb@zhObnbAgzExpRgjMfsHow@vuNx_Q__QlcHkbSquDij^p_Lelh@dbBsaj@fuCxqKphBmcB?kxU|~B`_G`n@miLxzBzbSzc@wpVf}D{oFrqFtefA_fGn~PqzAtbhAodUd`ZxfDnuZ?qxYb_ScrS`mGa}kAl_HmdGti@xmLftAuxQzqGp|@xzGagNzvDvvi@}|CrjDpo@pzSq_Rvdk@fqCxPt~B__K|bCttAxvNmsp@ltFl}DqzGztn@jdFieD`dJ_ij@byOxpJkyHjoB|oB~oIlmDzHikBdkPmxEp{FakNdcB}cFd}Ija@n`E~`EsmH`gOcpBt|HliKmXjcGk`GplAlHbsb@csh@~bm@}|OtrGiQhqEdmJpeCxb[}b_@b_Cb_Cz~Fa_Ggi@fehAv|DidJj_Bqcy@rdFajHdlJpjHh|@~cUoiLnzWpiHmxE?piHjiDogNz`FghAzkIkum@}wEh`fApmLsfs@rpAftY?e{J~wArtEviLox]vi@b_S`nF_zR|dBdoUbcEczZxiB|}f@piHcxa@`_GfxQlxEqmLvi@dxQr~BaxMvi@|~NziDmtAdxEhxMjpAmiD~~Jv~Da{BnmV{nAe_CoyA|iDc{ByaJwhFhn@dfFf`[u`CjqLumD?uiD{i`@gObdi@isJfz@e~Na}LddAhoKviMjuQotBhkSfgLbcFaRrnGauIxlGje@|~NueMynRoqCilR?f~[|pHduRonDb_HseLb}BunH}~I~k@dwLufSct]q{FgxAfy@bzJdvLnoJnnE~kPfiFhcE`yMs~AdaU`pQgnEddBfOvhLmtAwmD{~B`dD}vEmnC~nExfU_fH{mHeaChoEkgDs`LiiCpcP~lEbrQexKt`N}wEagk@?tml@g_Pa`{@fz@yc\\qsJqvHvwF|eR_tBmw@}cAnc[n{Eh_L|lDzbo@pmFpwIszIdwVseKfkFk_Go|m@wnJqyMdYu}JavC`_G_aEqR}~BmkVmKpxZd`UlaZtKb_d@s|BraG{dFybD~dHtyU}iDlnDmaHuvFsu@}yb@c_GuiDkmHyfy@fxAxqt@paJ||UmlCjus@odTimLhkGb{Na_AbsF{ePitIggNmmUcxO{tlAvmEwcf@w~JjxU{cLyzMcwV_|J{pWg}eA|eL`rdAxfO~~VloNpjBtrGfbUl`Ilgt@eoBzjIlxEvgBt}Cv~OpqDb{aBeoCxzFqyMl`CwiFehFwbOsmv@gc[c|_@wi@niLqvDanLsqFhn@~cf@j|f@plMvm_A}oCdpYaeOpkTwzK{_T~eHjqXw_EtzD|qBd`IoaDbwJ`lAhfCxcD__JdzEtIohAluXonKv`DjVglLkbGvjF}yDuqG_cCb_SwjGsqXvjGtq`@mtAnm\\}qBifJgaJibEdpBtbT}zCv{Egk@acDebIzaAyhBv_JsmHetYhDhcWsyItih@hxMyta@xiHdc_@ef@ptUffBgcOryCjoHio@f}JoiGtYw~Cd{Um|DjoBpzBjdVk|HjnN`PfnDnsCqsD|kDviA}mDhcQvoDjiUzpG_yxAvsHisEjcFkkTp_MjoAaqKnvp@hwN{mj@v{EmmJnvAphBxhCju]{dFftNvbCd`BgnP|hRmyJluXojAzk`@wlKnoJjiD~|DkiDrkHltAntInvGosU`zHavC`jHmkPvfMgoJ~kFzmHbmYsztAdjKydJltAybQb_G~m\\pmLgc[neBfiBy~Dtqj@dlIzfKcnAzzZ{wJ`mXvhLcbGz{BtrSezC|_UmoO|gKmtApiHyoKam@snB{lIseK`qn@{{NhfJ~\\|jJzdFqQsElvLs|NhyGexE`oL`~Kc`G~sC`vCjyB|}cAj_CqxYk_Cyjk@d_Ugxj@n\\ueGg|Ex~FygBkvJ`eIav`@peDz~FbbGk~AzfDrwDm_HbbGpxBxbPz~FktQwgM`hl@d}O{r]cs@|fW`sDh{BpzB{lCeuA{ha@bdKnrIxcEggTzdFn`Wz~Amse@uzEwvd@jlI~|DxfCfeUxaE~bBrUoqO|zJe_CriDh_GcaB||NzvDknMlxEt~Zdi@kmTvtMvbO_n\\{dy@qn@{to@mwEs{Hf|Eov^beFguBzcE~_PrbGe_Oar@jeVx|AxqFd_CmmPti@jqXr{Hy`WqjBcap@vxAiuC~sAbxIbaBmzIrjIn_Jz{Bbwy@nnDgeO{tEszHx~Fso^`iHtaHvcCyePt~B~mP|qCujJ}aCsjOtnCiqE_xFidJdrXhvC?f}PpiH`xIuv@}of@uqMqoLwxF{zTluLxqE|}CflL?exEdlDnsB|qAdrSjqAuxKomEg~PbtEjiDx`LilGdlCbwPjiDyiTxiHx~F`{R}zJslAkqL_xGbxIoa[ubGyiHfvGidD_iAp_Es{ZpvIgMmkDatIhxHaRfeB_wS_`DycD|qM_zFa~EadK`~EqoQxiH~eH|cGeh@lh@fp\\rpAsqXhmLpm@lw@geHcfWetLixHeaOj~YzlC|g@whG{bGduAspAayIjjPwrHr`GetRaqQjfPasDkaGajHvbOu~BqiHivItiK|Iq_X~aCyiGxeJy_@ggGanF`gBcoHpcEs{Bz|HpmLjxIemDicKyl@otBe_JnfBqmKrqGsmC|sAziDrxHiDfpJ`l_@~xA}yDxuO{`BcaAkjf@|~BltAf[gcDwqIyvZpjB{oAjcL|tLecGykM|_BqsJiuH_y@s}BooI~rIxdDumDacWlxEv~Jmf@cyOpdJq_A`zGthc@bcFm}OndHznHasDtjy@r|BnxX|Ximj@fgByxBui@rmH~bComDh{BpmXrDsqj@hdDxiBpp@mfRz{BcmBpiHnm\\{iDwb[~mLixY`pE|~Npm@}~Zx}Fvi^luAqxw@j_Gh_G{hJoe`@fsCggG{qFg`JliKisJ`vOhxq@btDoNtxFtgRj|HabKd_Baoj@jeQy_c@xvIjVngAlzJtjE{nH`o@~cC`aKkuErkDrjDb|HoxGlWv|WvkEyuDtu@{iOxcK}bAgj@bvUxpEg_[vjExfIraBtpS{bGdtMyiHhtIatIcpAgxAx~FwvStgF}dLpoQo~JtcAafIxzYexQfiXvi@}zJ_uBntCqxBvgXtwi@_d}@lsGlnUh~QevF~bCnmPqm@c_SlxElpMhPieTbaGo|KnoVmtG}bN`~]teGczId|ClnCimFpsVjmH_cIvfA}iV|kDroAsyBbkOfeGi{Ali@c|e@hcDh_FfiGcj[ze@zwUz~FmbSdZxhHrrKq~FrpAnxMvbImhXa~EkwCz}GmzKvcH~k]a_@tfSagNfaf@gfIrbG~vBntBd|IgcD}~N|m\\blCtqAlgKi|RltGti@hq\\yxe@fmQocIamAncInpJdjPjb@bpXamRdkPk{BmxEltAlmPcyJaoFgzEq}Lui@xmXqnFwvBa~@ikSu~BlmPacKetAjiP`}LqzBtkJxeDd_CaQrtWihBzdFevL||CamA|{OetMqmLkxI`}F`_GvmEvjFc~AbkH~pOxwIypFk{I~bPmt@xbPcvQefXppEh_Wb_CupAui@hsOsaFvgGmnD{{Bz_B|yKkvJnfHccFzaQ`OfpEn{FufMrkHyiAd}JacVdlDvdBk|Izl|@msLduReeAgoDi|MxfO}|H{`A}xKtjWkeQuvGpvN`qQd_Ok|YfhHjiChdOmiKltAzbGstE~bOjdR{kZyxC|sXabKtgQ{dQg[exPpjGlmCrrFnlK{`GvjFbxDmiP|fc@}`IqmLisDloIyzMw_Ewi@jrFxrJjzCryIm}CypEvq`@z`Fg|DkSvdHokR`n]_eNbpGxAzlCz}Hw\\yrD|~NmiKjmHk~AebBayTbq]czCywBzoA~oUysIpgCuiLxwUhxUsmTbkTaiClbTe_TbpFoxAr{CziDwmF~fXu{I|dGaoQbrj@}`IpxG~oDje@{|Av_PeyEym@keLf{Knl@pcDzwIwoDobC`nR}lJ~pImyD`ePrqA|aHedHb{`@veHgsCwnDzis@lfWkxa@`fDzmHnj^oz{@~Z{}SblCdpHlhb@{qu@jnCoh@fh@ttFxnAwfBtaGi}QvzAaai@dfLniLeIseJkaGmjEdxDw}Qsr@{qc@l|DqAbd@r`SdcHv_PvmDacKziDdxEkTukJ}iFnrC{~FotMmYcvWtgFcfDzpFhgZv~DfnDd_CwbCcxI}iPuf@wc[l`B{wDzhEzmC|bGmq`@rtE`cW_cCaj`@bkC_pCtvF~vLoxAa_W`cEatExmF`tEgxA_cOrbFk{Ch{GzpF}e@g_[j_GfxQcfDuco@tpAswErtEpiH_j@}bSnxArmH|sAsmTfkE{RveNmkWzzNbfEomDaa]nmDpoFhmGqsJjqGvuYf{KykXoM}fFooJn`JgvBg~JbxDieJmnDk|BzcGilLzsJyBwsBtmTxpFkfg@yjCq}Fx|GsgPhrJ`aW|tLkqAmrAbhP{~GpxH|oB|~CpkM_yRrzD|tFd_Iaa]zsKokFjqFjh~@w{K`_gA_sCzoAyjBs~F?~bO{iHxiHmxEnte@ntAafD`yBt`CmuFpvOpjBlmOkvKzaX~qDj|Na`L|}BkaAh`c@w}DvoJrmBt~`@qzAlpN_`Ey{A|oBpgIc`Gv~g@{mBt_{AcjIvx^u~CufA}sA|oTcoF|oC`Bnzc@qfU|~q@ogHbgDgcOctEsa@frCbtQ|dMmoOlcHucAnzEniLexEpm@|_CajSvtZiuIeg]rVzfVkaAlpBqzBsdEwmDyv[wtChfm@vxGb`MstEk{BuqHrp]txQmpM_jLbxa@|mIrpXmg@zh`@c~Txkp@w{Dru`AubCpmLotAimXkiDnmt@gxAc_SatJ~h@zsFfwM|u@vnV}rGeeAtJohRi_M}zPqbEw~U|sA|mT{eDb{BwfCwpIqn@lmDjwDr_MlfFsb@jjDjbFwrC~~VxpQhtOyaFphHijAd|Us{BpuCu~CegEkjCpzA~rBnbI_hM`lp@e_CitIstEdcSqbE_cUjTnmVqxAomD}aHnmDmmDlpIim@ktQk~GrtEbr@zha@ehFzzH~kEjnBq]|sMc_CykBqtCznNvdCebfBgxGeyJc`B{qYicDy_@qfIcmYmhE`CsXc}HyfBxcKjyHncHyBrdLg`JqaFcbAdaIh{BmtAvi@ptId_Ck{Bpp@dzV}tFrcA_{@wsOotCjtQmiJyiHqo@vrThfCx}AxhHgzG_j@lvFktErtK{mHk_G{pAvsBv_Kv_JgpIndCqlMpu[nyKpuOgi@k__@jxIt}Hr~BclBtzDvbIoAdsGmsFqiHgqDhfD`fIzhPkcKpdCvv@tgL_`JrrLjaSlqAsFngSmwK|i@gfB~yFui@otUucGz{CsvJcjI}bGqmXj~@zeVgmJtjEtwE`qEp}FclBc}BpdInxMjtQzkBudF~pDxy@_l@fjYwiAlmCe_CwtMwwG`yYbbA~hLsqF_aDkeF~`DclB_rIigNeaCb_A`jNy~K}g@`aLviGn|DgeHfhBnvM{~GfvGcsFdg]ctFulJc}Ld|DsmB`v`@_yGqtCuuFdcN}sAsiD{lBryTufFjhD~bCzbL~rCy}E``Epo@obCvfSysIbc@wwGejJjaAojLmxE`xMlxEfcImxEriPuqHygIuHofSgiBclCmg@rjClJn|Pe_Uoyo@abDvm@hwBnlQimLbtEfmDrmHbsG}eG|yId[l{Ddj\\mfLbxCzlCk}MojAihBycDhcJ}fA{{Ctg@|i]soLnmKpfO|bGvi@}bGrtEtrSefLfd`@hwBjnAfxAsmH`|L{`GhwCiaKwuBjxP|uFriCe_CdxKpmL_cCd_CtqH~~Jte@?d_Oc_GdxQom@gxM}iDfxA{_BapEazG`pQj{BymXktQfcOheD}m`@atIvmh@spA}b_@ukJb{N_rOktEkhD~_IxdCjhDj~Gqz@ti@nxQdxQriDuvFhyHqrD}{DsgEflFfdIpmF}wE|~NhmLqxMb|BxvHiqE~yFajBotBbHvkWygUnv_@weTcf]~jAsaLq}F|yP_cCctE{e@b_Sh_G{e@rc@jaSpuIomE``E`fCefFnrPtmDh_E}bCdtYl|m@{qdBziDxta@`}@ceQvrCtYw\\ztJdsGixT|kOxfa@}iDrmT~bCom@}I`cEmqHpvT~fBr~Gq{Mhac@viAbfE~}DwwFjnB~wLmcNfcZwrTldyAl`CvcC~_DydJltA`xIv~D_tCj_Myqb@_iA~pP`b@r{BbwCglEc{Gtih@|mHacWti@peDhiHiiT~fAr}Ba`@rrh@eyDjWb`G`bViwCjyIjpAjmHnm@ctEz|A`fDgk@zbc@xb@biD`}EumJ`zB{~Xt_CvhXcoGfc[x_Fm~NdrB|rMoxGfsZ`cEfvGwfC|tL~nKvl@b|Bw_KdyDrhEaxAnx]}mD_cCscLjb{@xuJxni@ib@|{D}wE{bA}tAdqNszBimLqmAxsCpmAbnZmxEgtIa_Gx~^|lJao@~{@jfEawIrod@mkD~iFqzBcaM{Sv`a@ojF{~Fe`Ij~SyvC_iAamFrwUy_AmoIy~JliLajHa_Gom@d_[yvBitUmfGcs@ajHoyRstEym@enIjcL{kHjnAsjCe{C_eNct]}yQkg`A~@}|ZgdIc|`@krWhif@ooJsiJzUub_@wgNvb[esAmrGyC}h]~wAf|EroGarb@v]groAd|a@mtjDvrBy|FntIkoB|fQciy@zeQu_BnmEf}Pse@fm\\jiDw~JjyJoaD`{AonVxaKdbNvy@idPzxHqzLsuDmpOorOjmNomPsiDjkH~dUgk@||Tm}Bw_PsvE_eBgbCc|N{kC|e@d~CknXkqGvmDk_MktQomErViQzwIhqJ|dBzcGzcQao^pze@g~PtqbAi_Kz~FntAqsl@mwEyoQn}KgyL~`CwsdAeaHsiT}fQvbC`{@ii}@skIoyLjs@wwFd`DfmEb_A_zFrmBpdD`gDgoEjkBmoTaiNmjg@qmLigJ_xAmaYafDjtEk_BqoLd|DihYuoB_qg@tvKa_S?c|e@om\\~{]y~F_cCchD~cOcaF|Fqb@wgGorI`sKivNnox@}qk@funAevKvbIuaNewWssXqvtAixd@o`wA}bTqtTymLqbo@"),
+	province("Nunavut", "u{voJh_tjKywHlgFk{BuwEwi@sfc@xjG{xNplMysDieDuiRn[q}@zaAjGnwAjt@zMbmDbx@nzDftBtiCroCvi@g~EztRq|@fxS}v@`tC"),
+	province("Nunavut", "axfpJ~}h}KtaEmrIxrHl_C`kOxaUbgGnnA{VlgPd}Qhvq@mmA`fRiuHxyBglHgkC_iu@ofa@w@shn@"),
+	province("Nunavut", "qplqJbejiKvxF{oAv}EnoDxhBxdP"),
+	province("Nunavut", "}xhrJf~p|MxfC{~Bh{Fd|U?ccKtmJhqpAsdKgsN"),
+	province("Nunavut", "evmuJpdwjKdaCzvEgaEnxFxlAjxJ}xD|I_sCwtI}aBf}\\uqB~yGmbCatEcEhlMknG|hEs}Gzwb@c~HrkT}oC}HowFawIg|EaviArtE}j_Ab_GltMgtAomL|~BfxAlcAibP~qIxiMngB{_Gd_CdxEjoB_kF"),
+	province("Nunavut", "g~cxJnt_pKhjGohA{cFhwNriDjaGsaGh}Xi}Leg]~fBssFryCdhEmqAw}J"),
+	province("Nunavut", "ib|xJb`xvPriDg_KxrJhpQk}M`k_@acEg|]"),
+	province("Nunavut", "oy|xJnlemKwrCa_XltAubHznMyhHq}F{_GpxM}kDghAkjJx~LqoKzsEx|R_bJxmi@k{H|qM__JfnE"),
+	province("Nunavut", "c}{{JfzdrPdcAymXbtFp_c@__@tmDwmDutMke@dxQ"),
+	province("Nunavut", "_ba{Jjz{bNljW{oAdrM|jJt_O}I}tAjxJ`b]nwXpsShxGjh^dee@?btQ_wRrtQowPz~k@w_OhwN}mMzwCqng@cxLm}m@owu@skEqgShp@qzS`cDm~Bc_Gk__@"),
+	province("Nunavut", "wg{{J`k`tPqtDiiHziC}vWlmDvtY"),
+	province("Nunavut", "yks|Jxi}gKlmDu~B|tArfWnbCmmPndBz`Sf`EmkDxiHtfWouH`f_AazGh|FumD}m`@exE`xMk_Gq~KtxEuoUekDue^|vEksJ"),
+	province("Nunavut", "_h_}J|ydzMnaD{lB~hArvCavCvjp@"),
+	province("Nunavut", "_h_}JpgkyM?k_Sr~Gla_@}wJtoH"),
+	province("Nunavut", "ort|JdzokK}~Bvba@yhIajk@bzCimM"),
+	province("Nunavut", "mok}Jpf`nPzyCwi@evG|bS"),
+	province("Nunavut", "gar}JlsfkPd_Cmi\\ylAzws@{_B`jBsp@{yC"),
+	province("Nunavut", "wko}JlhwbMv~J_aIti@`a]cfE~dq@gqOdeu@ysEi_WdmDkxU`_GokF"),
+	province("Nunavut", "urd}JtzukLf_DclKhkCfiBd}Fpx_@wbGrev@z|AjgOwgGha@ck@`eJcwL~cDeeMnbTtIyzXkhD}sBcUj`YkeFfoPrr@~h^w_D_{VwbCvu^aaFxoFnwGsjvAbqM_zx@xuNmk_@t{BnbHlx@seR"),
+	province("Nunavut", "m~l_Kt~stNv~Jyf_A`cWx~RrxIi_GjuElcB|vQrme@|~H~qg@f_QvsNvtv@fh|BbaAvjLqyMvo[rpAhxYtvQdcw@iwZxyBs~Yp~h@meLglEm`Nwyd@qfUmz[ypWw`EumOyrUmtFmsXvnPyik@myQou}@``Aah}@a`AesGkiD?"),
+	province("Nunavut", "gjmbKlgbhPf}Dg\\lYt|O{wIhtI"),
+	province("Nunavut", "u{gaKlcgxMfhAueGtyPfmK~oMl{e@`pChpb@_fw@t_nBunMfzEyuLggSrpKszJcmFcxd@t`CwxaAp{F}nBgh@ua["),
+	province("Nunavut", "mnubK~|lhP~bCnvYytIfz]|iD{fg@_cCbtEy`@{bF"),
+	province("Nunavut", "ychcKn{fmPdqH{b_@nx@ze]smH`wO"),
+	province("Nunavut", "}bqaKb{cfKjwJ{eDo}Kxzi@_vXz|ZkpAstEkqXrmTm{JsbS|u@gyFlx\\ggMl}DvrCz~FepIze@`_G"),
+	province("Nunavut", "mqnbKlhasMpwBwb[dmDtmTxvB_cBfvH~iK}iFtuu@ivZd{s@}`Hjub@ui@ktEaiBnxFucAocTw~D~`UwzF_qNjeFwvk@w^yaEm_B~vA|{UigoAd_H}zP"),
+	province("Nunavut", "mnycKfdjyLzyDsRguBznLphB`|HzpAsmHtnBhcJunBtwb@"),
+	province("Nunavut", "gh`eKhunvPrwDkd@{aFlyV"),
+	province("Nunavut", "e}jdKbo`kKyrD|nDavJghShxIw~JexEjiDse@aiHaoEeJynA_tOrvDuyD}kCm_IvzKvuG?c_SbxI~eT`bF{|T}zD{kNjmHstE~uGrpArbAn`JklTvzn@zxBtmP"),
+	province("Nunavut", "wgleKrd|zM{rFogAk{Bm_J{RmheAltQhmd@"),
+	province("Nunavut", "elxfKb{fpNs{AltL}|D}vFfrCswU"),
+	province("Nunavut", "mz}fKfxf~LdtA}bSt~BjtQruEpm@muApbWacE}t@g\\a|M}rFdnC?axM"),
+	province("Nunavut", "imuhK`gx_MjkDexEtqGp}@vcCxyG`_GuxEtzCfqJ||DeqBkr@dcR_eT~wBgxAktQimGtjDmWujD{dEvvSkaGsi\\hcEceE"),
+	province("Nunavut", "qb~hK`_aoKf_D_hM|uAhlc@aqFnqDcyZw|n@gvHo_E|uAe{DteLjiDe_OatUziDecGveHbcKxfCggSfpIfgk@nm@gxMd_C|{D"),
+	province("Nunavut", "omhpKtr~zJlxEb_GbtEmm`@jtEftM`~Eti@grIreJznHfcIi|At}DgyEmhF?f|]}mDmmDw~DlrGe|CmcB~bCicO"),
+	province("Nunavut", "wmtpKhgt|Nw~DhnL_cC`~@wz@_kFhvC{op@"),
+	province("Nunavut", "gredKzxkhNvzFfhv@`tPtsXhzSdq}@zzH|bB~sC~`Iqq@veZszTvxgA}e@ny^gpJ|bSnZz`Y{wIpta@riDtfc@e_C|iDf_DhcEg`Dvx^saMhoLmtFonEcfE_sZuoOq`@cvJxy^}tA|j`AvqDjdi@{mH~nIymXau`@wsIpjHrI~wLtfLbhVdI`l{@vwS~t`@bjOqwNf|JlbHveHixId_InkFnNli\\`~]zsz@ihBbae@baMxsVro^vsM`hNzmTpmd@~odCsc@~`m@yfUvnWgba@b{BweSu~HagI`cD}vFoxFo_KpfDswC~lPmtMpm@pmL|uSntIp~kAmtApupAlaS~`m@wJr|p@wdCtaImtMlmHevLeaH}_f@c~u@ky`@_ihCg~JhR_pI|aSurHiQy~FxmL_zKz`AkdFsdEkiDpbKk}SkmCe~HxbFcci@ukb@iiBmvKg_Kb_GkmHqtIqqKfkLklT_oPkx`@`~EmxLc~MurF~qIal_@_iLerGawIgoJNqdRanP}w[_h_A}sAuaWtnGkvCpeGhqGveG}nBqkGaeVjzHore@pfIwvBb`AhbEz{TmuXdeMvqr@`qWgi{@vkKteApdPacPb_HhBiQiwUcqMwgLu{ImMy{Xsr\\eJypFnrCqkSrkH}dA`uDgvM`mF{wf@znNnvLdjJgwXhhBufj@fxAh_GroAo}Ea{Ak}SroGqvhAzyJyaKhzFdXpePutX|gLsk~@~gR}``@lfFgpa@{lByff@ldGwvX~pDrnAlXchVpea@u{~@h_XotBdrMjiCpmRsm_@~vMuJv{KjabAhpL|bGcsFgogBqjB_cDkiDd_CiwBycIhwBtcAziPyq|AikBigDshJdvMexE{mHqeDd_Cnm@{dKhwSamd@lbHafa@jcFjgDz_BocIhzB~sN{~Bv|WpwC}zDhjNqyzA"),
+	province("Nunavut", "wb{qK`xxeOom@wyLjmH_hM`{BbtElrL{kYpdn@opb@nwMpwU}j@|oT}~B`{FydOlzEe|P`dm@mak@p_Lq}Fk_N"),
+	province("Nunavut", "_gkrKrvqbOm|IeaBjyNsdgAclCkaLvdIu`CrmTcc{@dsGa_AplLfhSprK{tn@lxEudAwyAo|m@hhCyiNdbBj_HxoEaSfmFxyTc~Gr|p@cbGuwFndB~}K`oF}}CgcCpxYr}BfeN_sC`_Aa_AoeH_gGecBqeDetR?vb[uiDkmHegAfbCbkIzt]gaDn{b@}qHksFsvP|aCchCrdKqiH|qBccFpmd@"),
+	province("Nunavut", "e`vsKdlg{NqObjCadEgdCqB}bGdxEsvQ`{Bh_GvbC_nt@xmLj_SdI~xL}tLv~h@"),
+	province("Nunavut", "omayKr}{{J~bCqpEdkVv_y@o@hmL~oD~j@qzGje]f^mpNkrGyhCikGsoc@gmE`bD"),
+	province("Nunavut", "_g|yKxytbKqgB{bGjuDqrPz_B_aDlaC|pHozA|xqAi|K{jf@`xAc`H`cE`o@"),
+	province("Nunavut", "i`c{KfscdKdcBx|j@}mCjzIonWqgO"),
+	province("Nunavut", "_s`}Kdxv~LxbKd_IyPd|`EgeN~mm@{~SvjXkvKx^wvGguGmH{bv@{oSeoThzGseWldHrgJd{CigBsUgiXz`M{s`A{hCsnM`vCgrs@rxCynEjtEd_CzbM{iD"),
+	province("Nunavut", "g{t_LjossKvi@acU`|F~sNlzJxl@ckHzgkAsvEka_@hq@e``@"),
+	province("Nunavut", "g_y_LbrloOxdOea`@blTjkDrp^khKx_]bqSntIlkVui@~bOgtMzmd@m_IbnHioLtzD__K_cUm{DbbAkcKxfZ}mIonPydJaPe}Pkx[_qC}zJ"),
+	province("Nunavut", "abb_Ln~}hMdgKqcD|v]rb]t}OgvGfsH}dM~sOfeCfvRueF~gNrbFfeTphZx`ExoVjxIjvKkTvmVhwH`tOz{Ilo~@xiAdcnEqkGvlQgeZh}R_pDldMymLpm@e_CexEmd_@~kRgv^eqT}cv@_m`AkmT_xc@{aMybkAzfF_mi@}cHsi`@vcT_bvC"),
+	province("Nunavut", "gp_`Lp|r`NdvFogRzeEjyBfxMbza@W|kPwuGbxOy_Ro@e~CkhDmw@q}h@"),
+	province("Nunavut", "wg|_Lxo~sN{gJbgs@k~Bws`@vmEktK`EcyT"),
+	province("Nunavut", "ugl`Lvc|dMyqKxfCcbAqbQdvXoki@daNfhMjd@tfLyyGfuHquDm_J"),
+	province("Nunavut", "iccaL`jy~MrxJnd`@qtDnlc@odH}yEchKyas@oiLdnl@gxAwpJpiHe`iAxmMldGkmB`q\\vwFbeL"),
+	province("Nunavut", "_gqaLfcygMweHjmHiwH}sRplA{kT|aB{eFx~ErG~fHymX"),
+	province("Nunavut", "mir`LnxwgMnM}nIbiEzyNhxBkhJ`fClaId_CajHmtAbcc@yrJ`oFizR~fbAotCdjEcxOmdAqtUcp^lyEwjLlv@el\\blTkxOrwO_h^btE`tChlBh_S"),
+	province("Nunavut", "quvbL`ma~KrfKq|aAyPbap@qfOlfiBgtCc_G"),
+	province("Nunavut", "azzdLvtlePltAexElzI_y@ooDlnC`o@vhM{nG~hG_nEynAlmDy`Eu~BihN"),
+	province("Nunavut", "s}reLtgjgPzs@{_GpwBnlEh{AvxRe~IjxDjiDjvK{sEugFm_C~eHsiDa_G?riDsqFu_DfgMxtVgcIo@kcFa{FudAu~Uh`R}|["),
+	province("Nunavut", "q|kcLzgtaN|bHfxc@q`EjbXkpGmt@u~BtxEwhGg~QumUmwVyvCsdo@ctF_lQkhI}u@axImmPoi@o_[wzFvlPyqEdfAytIuyn@n\\gd_@lmO}gR|fSxs[syDrvQtkJxwA~kFrtQv|Dc|Az~d@xrx@"),
+	province("Nunavut", "_w}eLv_zePnoJ|aNokF~tNgqCo[ibEr{S_{EuUolLk|f@x}F}hKqqE|cAc{B}mHbcKse@rpAsmH"),
+	province("Nunavut", "_ysfLx}asMnmD_xGz`MdmDtbSlls@bdFsmBpkBmyKtiDf_K|bCe_Cw|EvoI|mCntf@qqDhzS}kV~zV}mJ_fHkrKz~BizGupT"),
+	province("Nunavut", "uewgL`vszKzsEotYlxEbco@daBioLtgAdaC{iDtfo@ieDcgD{yBu{a@qaF{hD"),
+	province("Nunavut", "_ykgLzsn}KukJlulA{kNskl@x|@_io@apExf[}bBu}PvmKal]"),
+	province("Nunavut", "_{ahLvln}MshJ_fBz}GelVc_BujDacKz~FsmBiuUn{E}dX``MunOnjL|i\\d_Cz_e@ngMb~k@wqC~uY~`Jvq`@qtD`uI{l_@ak|@"),
+	province("Nunavut", "_f_hLbjbgNmVfxSbpReqZjdFjdKi}Fxhl@weNs}Bin@|kDniLdxQkiJhkB_aI|j[qA|or@aeDtlIg_K}tQnpDqzj@bfJcxP{eE~dCspAkmH}zJj`XkxCadJaAi`n@b_GyhO`bFllDg`EoqO~rCuyPepCsgg@wlEmgAaqEmkOhxByoi@`iCmbHh_F`n@rtE}iPfxAvcC|qM`vr@r}Arrz@"),
+	province("Nunavut", "k_vhLpfu~NupGhyNgmFo_K`_Go`_AziChx`@fxFoiGrc@xkG"),
+	province("Nunavut", "ankiL|csoPupCwuStpCgwSlxEhze@"),
+	province("Nunavut", "yi{hLn{fmPjjHlhQojLdhb@ozE_ki@klIcrQbdLjkN"),
+	province("Nunavut", "yxdjLjsorOkcGdkb@mgF|tA_kFjoRu_Cq|u@}sAxiHoaCytD`lLgf|@u~Bqcg@twQgxYv_ExlGpzAvkt@wxGxxk@"),
+	province("Nunavut", "_qmpLxkmsLf{@}b_@dxPbfKb_Bbui@|lPxuhAmsAntUg_EjRmxKhzSa}@ij^odUq{GyoE}t^"),
+	province("Nunavut", "ogosL~vg|LnmLmtYbbFlmg@dxKmt@vbCsdFrvD|qMijApaEikBwcCspF`vf@wbJogAexQ`cWe{Ielg@r{BozLpzAosCtmJxzGtkDpfJzcEy}Fq~E{mB"),
+	province("Nunavut", "qkktLzr}zLh_KsmN~zLxxSyeJfju@ymLe|U"),
+	province("Nunavut", "msouLdtydQfxAwiLpsP~pJcE|{`@cmHajXej@hcOmiDti@"),
+	province("Nunavut", "ukx{LtjogQj_GatIn_EvjFifJfwS"),
+	province("Nunavut", "cr}zLtfpnQa_GvxQ_}Ugvp@hn@meLptIrtEnmEmdNb_NboS"),
+	province("Nunavut", "ixk}L`knoQepIsir@zxDagOpiH`pEkiDoeTlmPgcOfsTnuZpjAbjr@{dKvzWcaLh{F"),
+	province("Nunavut", "odd`MvzurSajGism@flEwos@`fDxkHbiDxmfA"),
+	province("Nunavut", "myk`Mrn|}RjfKkdb@fyEmeAfcO`qPdpCemDz`GzwEjjImtA|kUhra@|lD}qA~fB||CreQnsk@lrGww@pgClvE|bNv}h@mtA|eXfcOip]tcBb}NucB|`SsfPzcR}ic@h_sAe_CyfCexEluh@afDsbKqbKli\\wi@etMcvVjxjAmvLvrfBwdk@c{tAcvJs|lFd~Coju@z{Syku@"),
+	province("Nunavut", "amg`MdmxlN_fBioK}cHsdFouHbSq`E||IgcCg|y@ltAeck@v{J{vg@{`FucSfhR{vnB_}Dm`dGdg]uynDvcT_pb@lbCzwEtlC_dD`bF_q~@~cBylAnm@jmH~bCmnC~lJ_b[tsHz{Hj|MujkAhpE~wMvm@sbGzzBpiHrtEa_KtmDpmL`sDq`i@fyFgpJxtIp`Ld|Dxzd@}~NpuhJl_Hj}zAzbRbggBy_@bwkAiwTtssAw`a@n`a@yxM}mIwjFbgJm}CsbKupHj|Gy|GzsuBgxF|iPmeGyiAe_CbjHmiQ}_H}w@sw\\}xJh_^"),
+	province("Nunavut", "{thyL|v{jQvyFkmBpgJ`p]l|H||f@kq@nnh@j_G_rx@tbCkiDrpA~~Jvi@e_O~rUgsHvyEr}AjmHbcgAxiBimhAfjM~gHcu@xuOx~F|mZmx@e{r@|rTpaPpfCna\\kwDpCmdAe{JinAbcKbtF~oPlf@~xXfgSbuVzcFleX|qHjuo@iEfwj@}jDziNquC`o~AiqKnxSubNi}FkqLcrSu~B|~NzsE}wEti@xfOj|Ybt]p{A{_Sl{I{kCptV{c`AdxVrz`@t{Ujv~@z~B|xc@axTllm@jqErfs@oxW`yNymLdxQuaB_kFqxCdbA{tEtma@kv[tvXsrFtvWclIlsGmxEdtp@mc_@hc_A}wEafD{RfgNyuChhBui@siDwnJv_\\umCnvq@rrEp}L__KnmPe_CctEwi@d{JmtAajH_bJbeb@`tD`jZhdD~dHtnDsTnw@lpHqmLh_]~Lnbb@waIpuTe`Cm~B_wAl~B?kxUkpA|~ZacKlcG?kxIodBllDkdQrrxAc~N~fj@qnLh_XifCsiDgiA~{EsmH`b@coRaa]_rPgxGarOapt@{e@om`AziDfpExlAerBtwEeaf@rtExeDfxAgtI?~bOxjGahN~{EwqTeyD_rOntAhtItkDqzMzqMmnIpzA{x`@jnAusB~`EbuE}Yh~Rf}JsxdAihBgki@oeBqsDmtAxbKm}C}_Dj{C}vlAioFerRswDacEmrGxgBczC|}O}lDwrCqwBxyH}mDgtI}sArmTyxMm_Ovi@smTltAjtQfy@sxCxbEvzEh{FakS{hCsnGlgFsV`yAhsKv`EspAudAmyn@e}DfgB{kBcjIkiD~wA{iDzmXqm@qmLstE?{zBjxUsiDqtImqBdwEqPvqUqpEktQb_Gd|y@clCq~@mgEv~PcxIgc[umDdjPw~Jo_g@`gIat\\uX{qQ|~BrtEl{DaoRyiB{vs@evE|sv@ad]hl{@peEhlG|bGimLfkKtudBovFt|`@wsC_NirEnvq@q{Fv`DuqHhoo@mdGbeKeYujQshJgmQoh@qw_@c_HevFzRox{@vcJyd\\nnO}lUrlI_zh@icEqCiqJff_@uiL_}JipE|e@epBfp~@ggB`oFqpEkxIutArbd@uwEieEwi@x~R{mH_xYse@piHasCqvCsxDkgVrmBi|WoxFkyUraAofk@nxLikbAf{EmbH}lDjd@{kNiz_@cqGs|An`Jtzm@a{@|ja@a{Bx~F_pDyvCenIi`c@ze@k|w@|wEbxErtEsmHfkLmilArvJ_ca@`jGpvDlg@o`Jq_KcfKw|@wsw@ntCetH}vFenTq~FoxpBg|DujVmnIubM_gBapXx|@y|Fx~Fj_Slu@ufEq`Feed@xjH}}pAtoTejm@hzHupBv{Jf`JbtLb_k@iuDnsk@jiIqhBim@nmh@zfJrxCxvCciI_wGqi`@vmEi}QqPee_@tlCerB|sAlmPziDmmPhvHpp@ym@f|V`fP~bgBneGp}Ft{[dyeApiOvhv@j{BcqL~bCnmDr}B}bGhzL`zAtfAyqD{pGajCedMq|Wk`L}dv@pm@sj~@lqM}`nAd_D{xDrgJphH|oBxg[r~Bc|e@jwIosInNcnMjkIkvKtyJzd^loI|t@bDinWi_KmtYy{Mi`lAlmDqtCjiDntMhtIgtYr}GcUv}DwwGriDlxEuHqqJ"),
+	province("Nunavut", "aywaMlgw|QepIdtd@mbGwbuAoj@o`DsiDjmHmpHqrs@aePksgE~sHu_OflQvg_@`mRnl`AbqGbfbBw~DhaLj`HjkCvhAhbh@qfDdcj@lJupbA{|BzmB"),
+	province("Nunavut", "qcebMx}afPnbCsrQbfJvjLw~D~}JviBdmIn{Ksb@hmQpzY|pZvyt@`b@||g@|jI}qMvb[vup@d_O|~Nx~FbnhAnnDqhq@bkdAh_}AxzRnom@`bFrmw@_xMhj`DukEpqPzkIpdfEzmHmg@c}BkaX`gDkq{@o_EkgPrsQrvDdrG}xc@`|Yu`_@|rS`~]tbGnwc@jo^hbi@lzPf}JrcAhoXz|B|_BjaAq{LspG}qYb_G~eHb_Ca_G|~BriDmeGdsv@htJxh{Cgm@|jDcyCgmKjVj_SmrMswCybEmfRcaA|~TmqHofoA`^lmjAivH|fFyd\\qxHitOx~LzS__Qu~BrxIwi@egKanL|oN{oAlgR{mHdxEmtAexEmhEfsHitJhyk@sqGgM`b@ebNcnHrzHebBlqSgdOed@ovGeyF}dLfmQwgGkbLiiH`_GacE}vFq}Au_ZyqDxlLegLtg@yo@ukDmu@hsDm|I|JaaEnmJaxIkmHkoMhgO{tKgsHkcEznLwm@i_K{zBzeDao@wgFluA_aQ}zJ`cc@_dDi~FulCtwE}sAegKspAvmDutAciCk{Gi}o@pgBik_@|kPqzj@x{Ag|lAqtIfgw@egLz~^o~E|bG}iDa|]a{BhqP`sEt`TqrP`ha@m~IknBiaFyqKgdHa`kAouGmbZypFup{CjhKer^saA}hEqiHj_G{|Cq`FcjB_ixAl_Hyh{A|bHsiIsnBqjSn|F_ph@~uL{_^dqHrfKyn@_tf@kaG_rCscFqex@oZkhn@biDq~oB"),
+	province("Nunavut", "cepeM`fpsQe_CjiJemDwiF{fD_}Vq|MuuXr{Cukn@`vOjxUpfItrq@"),
+	province("Nunavut", "qogfMhm`eQdaMwySzmCljQ{cF~zfAkoHtw\\}e@qxY{gDpwBslBgxF{bAib\\"),
+	province("Nunavut", "al~iMb{pwRzjCygIpfClqBbtK`~]p_MldzAg~DzpfAu{Prd`AynWy_n@kpAt~NenIojLolR}ky@yQ{dt@vmL{r{@jkNwuMdoHtI"),
+	province("Nunavut", "_qlhMvkhyP`xImmD~kVbtE`vC`cWziDyiTveHfxMrqFfsgFmlIpkSpdCp`QmqBfo[us@ffCy~FmxE{mMlcp@ljEnwYcuEysJhQfdNlnCpzBjaF_qDjHpsJ{vDn{EafD_tIwxArmCa]rp^{kDnhGd`Db}Bw^p}]mt@jhDm_CotIe_Ovbs@mtAofSiiC`dC`{ArhP_yKyiNn\\`xOjfPjxInbCl{JuiD`xYkmHue@~j@}zKw`D_MzmAr~v@}kO}oBafVkcX~xAk{N{lBmqBmtAbtE}rAouH{jDi{fA_eB~eS{uEgm]dbBjjm@u~B?u~Bgc[aaKqxSv`Co~Dr~BpiHysEex]cjC|pq@zgDhuUw\\lmCo~C{jCu{D_b`@~zE_|u@yvBqiB_kGxb]y_Fk{Hc~LwyaAzlBayM}pH_yMjbAar[eaI{cp@`|FcwaB|e_@qknAtaNgv{@rtEkiDt~Bnm\\luFcvy@thKxxNbtLw`VnxF~uH~bC{~BbtEvm\\o\\eaT~aJsvO"),
+	province("Nunavut", "y|lkMjimlQ|sAxmX~bC_cOfyAjrE`bEzdi@muFhgUylBstFgqOb_TuhPazwBakHgiAavCorHb}Hs~bAjmH{wU|`Sxtx@ypKvrf@kuDli@kxCc|Ho_@btJxiHjxUvpDpp@`nGc`H"),
+	province("Nunavut", "cammM~l{rR?dgcBicDwpUwzAyfC?f_KelC_bJw\\wqf@_gBzwOkwIi`Qs{O_daDx~Foql@n~J|pnAtyP`sUhdDzqR"),
+	province("Nunavut", "cpvnMzcu_QtrSybKr`Mi_WrcFndBpPnmrA}pHdpNqiNf[}mHfcOsyJizhA"),
+	province("Nunavut", "ciynMxo`qR~fS|vyEixAxmj@i_GzbKamFol|A}gLodjAevFmuaC`xCasUpiGkF"),
+	province("Nunavut", "uwroMjgp`NlnCydOfkFbpAxjSlmcAfmEwmDxpLuit@zoAfeCdHza]oj@doConDsgDgAjll@vpDvl\\pwBwmP~oEraRoaDnk]orC{iDwfBb_CwcC~f_@}~Bum\\owGsmBiuOag}@cxPqke@"),
+	province("Nunavut", "y~woMtjkoNgxA`xYacEmjh@bcAupH"),
+	province("Nunavut", "giaoMltrxRajHh{pBonJvaO{}BmcActKcmr@yrPog_HtmOqcc@|cHbpGtiDqtI?jtQj}Mlhh@"),
+	province("Nunavut", "cuqqMv`p`P{e@bco@qtDyyR"),
+	province("Nunavut", "oymqMrvs_OoaDzrXaaEyzB{dErtEvfGagk@"),
+	province("Nunavut", "shvqMrfe|RsyD~zKbf@|bTemDvbC_j@kiDmmDx~RafDsiD}~B~zF_}Jqf_Awk@o__A~|DsoRbaMdaYi|@afa@wyGw}O`wIwom@r`GhgCr}Bwo_A~lEs}Alw@ca_@z_GysJn|Pns~@|i@|kjEa`AzyDstEkpMmiD|eX_uC}lJ"),
+	province("Nunavut", "g}|kMnhsqQ~fNw`IjpFiaQ`p@xuCkvDnt_@uzDpdDokFqwB}qNtxn@hxCfxXphHaqQbyCeuAj~A~cDqrJtg\\twEfaV|sAexQziCdrMb{Ccao@|t@l~q@h`Gwi]rb@pmn@lvEzcGjf@kiP|sAbxIvdByyA|dAqrJshI}vW~gAevFbmHrmTjpAmmPsrFutA~kQ}b_@ltAxmLpiHwql@jdFbtn@kaHvcf@_bIhq@gxA|~NboBupCnhFf{LbkJava@hrJysD`mFxcEvaD`gvC{`AbrFmtAufG}e@rmTw{Ek{BqoAvjKz}Bf~KnkFamAjzBp`qAa|@hbE}{DyyAaxN}oT_dCl{CthRlce@z~FotMz_Bxzi@geHzglCg~EjuDaaK{_AsuDb}G}sAmxEccG~m\\uwD{}XcX_{rA}pBzrfAgfBpeI{~Fi|]hfC`zp@odI`~n@s`A`qEooDs}Xpo@wuGdtAttQjnAqzMgnEore@cxIvqx@yxBazcCqeCvqs@{~BqudAwmDjg_Ae_CmkwAkpA~mh@svEmcaAm`ComF?hxe@k{BatIu~BltA}mDmogDe~CunIpsJ`s`Ip_Mbw|CivDhfPjdLhvxDffG|ol@cpFxfI{r@`sPsvDwgHsnGl{\\iyBswJheCexc@k{BxmLgxAwxEgmDd_CyoA_xYu_CdxEwvAgqClaCy|jBi_G~fk@}bGag[qkAtlDpkA~gk@epCxpDyqJczTa`F_zrAtyPc}hAx~Fr~Bfz@ysDfrBgf`@imLmyeAlxE|_vAymFqbEqrEftGuoBl|a@ytIzqF}wQazv@p`E`knAehFtcYclNfpJcjNoj^omLiudAtjE~akCmqBzsK{~FsmToxA~wYqrD{zZgaDtaHs~Bwb[q{AnrN{lAloCmcB{kCs~Ggvj@tsGcs~AbyOitr@hgOlsMx{Lg`pA|}Hg~PriDptUsiDwt}@jbGd_g@lbB_~s@h}Rejc@fhA{`RlxEd_Ow{@ux\\t`IagIq}Qu~aBlxEdxqAikBvkFwrCw|DedAhvBwrCpg[{iDa_G_LlyKg}Jv_JciExkScxCqxYzdFscmAozFsxr@lnCx{u@onKdpx@~yAnaa@inGzp{@wcBlhEirEa~Kr`A}wfAexEfxYwz@y`F~~DumU?ozi@wiAtz\\oqCsrLue@ftYmxEhxUi{BsbG|KrygBqoWtb|@u}Cubq@uu@ybEc_CdmD{hOeglAlxE__}A}jDyah@|lDuzPhm@hxYteAs}^`bPqhl@k~@g{W~tAe|Cn_EheVl`CudBdrGcj`@oiFhrJ}oC_k@ip@m_[lzEccXmtAx~FgxA}wE_uA~cCg~Jbwa@g_KsiDu~Bk_e@p{FgaO{tEcoB`Emgc@eeGuzJnh@fsw@shDboN}XilmA~xAofHvfG|sAreFezs@om@tqp@l~CefFdnOoghCd_CbtE~yGg_E|yCxrKfiMzyIluXk`v@~cHa`@ftIbyNb|SraMryU}mOjgKx_RxaJhat@lzKqvlB"),
+	province("Nunavut", "yjlrMjwlhRd_Czya@}iDfdcAkcLut_BeqNy{i@yvCudbAr~BckiA"),
+	province("Nunavut", "yyisMnsibP~xFfwBzzCdyVd_IplGjvKmgFfvGqv[t~Bvm\\mxDdiQ`mFbhJuaHpik@ogk@hekAcaG{tKsdEc{e@}rB{_cA"),
+	province("Nunavut", "{ppnMr~m|Su{Ighp@qiBsnGcpApiHia@{kZ{|A{yCetAd|UexE}~Ne~Obwl@ya@jv]i}@~}CczBswDq}Al_a@hlGvyFqxDdjQkvq@??u}O{~F}ih@cf@~b[ubGmmP}bCr~Bnm@wiXemDjpAetAyiT_wG_M{jHagJcfF{a^stDs~@omEcnZbc@_iv@mcHivZrmHumT`nGx|F~Xmx]zuEs|Nr`HjnBxzFlaTfPdj]j_GevGx{Fo_\\rbGxrPje@}bSzdEjvKnrCgvSv{ElqXjhDo_y@rkTgnm@hpEnm\\tmDgc[r~AzhEbbF`iq@f|LfsN`xAmtMxiHjxUuiDmdvBrbKism@tlEeK~fGbj[ltAkxI|aBza^faJp|RnhFvfHxzB{~BkT{mSqqQqff@glEu_dAhwB{zw@b`AuyDneH~~JpiHmm\\b_Cx{MvbCqfOdfGfhAf}JqwNurHnnDi_KyiHke@|bCuxEyfs@}gFd}s@_zW{cAceQqnn@aRm_x@h}LmgpBphI_|\\fqNskZj{TurGrpAz~FbnNyiMxlRr_Xn_Krml@tiDmx]fzGpsUjrKaiHjiPrqj@~bIhvI`cEqoRbkNhwl@pjHstKdnIzvqC|mN~yn@bVlbk@kcKf|wAwzF`nFijBuvEaaFxNo~CzmNdoH_oDlqBv|D`gCnni@h|F}sGneCz~LltA}pMb{Bjg{AoiLbgkAvi@wqx@cvJdnOomEzmk@ryC|lVlmD|iDtpAstEfpJv~pAdpCjoGr|B}bAjbFjyZboAb}yAxHxb@gdfC?pnCep~AjkGoauB}`IhhBwrChzSoi@kxa@mmDx~FktE__KcxIb_S{pA{iTicP~hpBq~An}Iwi@ewJke@~bw@}iDybKmtAfxMyfCyiH{`AbpGvnDzpd@idDlpTukE?kq@{jD"),
+	province("Nunavut", "_xuhM`brdNxePubk@jnBphCr~Lr}jBwsHpiq@obJoaC_pCb|HxgHcg@zoBhlH}lKdrMriDbzOzqGcqGpiG_wp@ltAxfCt~ByfClxExf[`_GcxUt~BxyMrtE_oDb_Ct~Bbc@y}FnzEfwMfxAgxAptCbj~@e_Bh_oBhpVjobCsuJhszBunDqpFacDhoFbxJhac@ssFfh|@w_V|oe@uhL`BkpFotNewF`_Gi|F~vu@ckIp}XhjBnyLhjHapFfaO}kf@viRzbWftO`jHthEtwW~MlvqAbdFtzh@sc@rf_CybKtdMkpMdj@mhEmgFunCl|HpeC}f@lxFrqMvfSa_Gz}Gjf\\ylA~t`@k}_@`_W}aBrlIjgDhgCd_VohMxfHzvVltAtudBmxKutGetGfjRotAe~CoxAd~CdaClmJd`H}uA`fDziDr~FlcXywHx}u@awHzsQumDsp@oi@vpIffMuKnfN}oe@ti@p_{@}sH|bSzcF`_Flx@l{g@i|AnvMikG~lFs~By{MxePpxbA_fBfoQ_yGrkBvlFzvVf[bwb@}vEtiPv}DrcQ{zBlzt@kbM|aHa}W_kXuaIsfDcoA`eDgxAoxYmtA`nd@s~Fi_GipLt{P`o@ttFxwIatCr|BhpKstEvi@jTv{EpyMjyPrvJ`jAzzHhfOq}@nkGiuTn{DqtIrxa@ptI}iP~mKhxHacFf_h@x~Lsm`@zmNrmHd}Bjxl@bvExgInaDpp}A}jKhrPx_F`}RgeHhgr@siD__Kz~Bdfp@_tIjn_@itIxpEe_Cg{FbpA~~VpnFxqJajBriVqmX{js@{mH?gi@bzD`xYda|@~wAjqXriTkqXwi@tjc@kpLh{@caBtzEfhBzmGr~AkuEfzFhtDdWji[krFjha@umDoiLs}AhyGr}Av}h@seDetMcvK|dq@waIeqH_tI}JuiDteL_aDmqBcrLny^uxEyfCei@vmD}iDuti@exEb_SyiBukJplA~ek@kaNvhj@stEweHc_GltAkmH}bGktEa_S?dtYwuNeaOusRccc@wwGikd@{iDftMexEueL}iPb__@qbKozLyqJ`iCygIpzv@yePdlg@apV|`HcyDdiQgtHh_GsyN~io@yfDlhEkfDe_CgdD~}[nxM~uiAaDzjNqxAcnHgkAl}IfzFzhPjiD{qXjq@`mLmjErg}Ap_A~npA{pAptJym@_cCe_C~bC?hvO~mPhz{@mtA|j_Aw{K}iEwpCiykBesHblzArmBh~^khC~wAyzAmxL}MzjvAeeFnk\\wmK`lY}nBchDo{@si~@gaO}a`@jvInfe@aAvmn@afD}wE}Ibh\\etF`lS_xAmbCiC`t[ejJ|yWom@dsgAkaF~jQctEi_WioAzgJuiDolE`sJm}f@qb@ahdAotAvmt@mtA}sMmaCl[q@kne@gmK`taBagJjdEulCaxd@iaEpk^`vCcnbAmmDqfg@wvBm}BwmDv_[ohGu~wBj~AwwbAvbOyj_Bpo@wnqA`xHcgIyBe}[haE_iMxPooVm_Im`e@pwCaqo@|mIgqOhwNypy@rjDqnAg@azMfnE{mBzqFiPptIhtOh}LtiFrmTzudAhwHcsQc~AudS{~Bf_Wk_Gcti@{fWmks@u~BfcC}dAi}EfqDgviCcvKuefA{vDu_mBxyHspdB|{IclU|eFqmt@lqCu{Ed_CfpEt~Bg_KjnB~pCfgAfk^wjKfqcAllDje@fhAngYyaF|{f@p_Arei@piHub_@pm@qgoAptJwroAizBuhXnsT}s_F~eIucSfmKlgc@ni@xeqElkDw_eAvvAmcHbdAniGghMlpoEqmLbgwArxI_jaAzkC{UtuFt`ZgxAoe_@`}Fq~jBjzIrja@ffAcx[utFwt_@lmDq`fAx_F|bStdA~}hDpxAuti@ltAdfXfvH_lWlvF`ef@rbLyuBcxJchKkgDoeYovMmdYts@cpR~iA{mCjpA~~Jdu@y_FcvD__\\duAibWbfExuPtbGyB_wMaxf@reFygf@whMmvKi`@}pOp{@y}E`vDxaEnx@ywTprK}e^psJdaOxtHfk@}nHkfi@ywBdtG`PsuP|hKc}|@haEuzEtgXvnQ|rArdkAj`G~rU{mAuc_@pxBuoIryCjqXkbF_fzAj_RgvSlkJccc@am@a_]}rG{bA_zWbx[sdFwa[}gE_@yq@csE|pZmr`BheD}kCxvBfgSdmD_xMskTcf|AbhPoyp@r~FrmTg[uaZ_wGimG}xJzjO_aK{en@xu[oh~A}H}se@hfHi_i@f_Kpmp@elIobyBxvBm~zA}~Nt`rBwmDdmDsiPcdvBpxBrvEnvAykBvm@yf}@mtAbtQkiDqeDwqIk`p@jdEeqzAggA}tMgxArt]c_Gdi@yiCgghAwtHixTyzF}xn@m_DzcLtLgsr@x_@_hFhxAjxIrs@wsl@xwH_jWjt@_~t@}eSc_yCxqKyzlFvvBuoBnxQxid@v{@stErmBkgm@}|C_~bBvvMa~qA|nB_tBb_CfmDpm@{{YvtMbdrA|sAgs{C|tAxiTbxIqmd@xwIz`GvrCfaOhgB}m`@bhJxiMdqHhxTfk@fag@jfEx{RrpG~mErfJhv{AntIybs@br@j{N|_CpgBpQm~Cgm@kad@c~Lcqu@hiAmuc@~eC__P"),
+	province("Nunavut", "atwwMzfxfOa_GotCwkE|uSsr@rey@kmHgxi@bb@ypz@rjOmnf@"),
+	province("Nunavut", "etcwM`~fbPdhE}XbmIzbYh_Lzdz@rDnw{@w{DklBgkM|zgBw_P~xp@e~C|kColE{qE}eLb_Ge_CsiDuwEriDefGy|FgzFu`w@~oD}xoBr|ZgefB|kD{tJviL_cCt~B~bC"),
+	province("Nunavut", "itcvMvpd|Rx}FzyIldGdsaAkuKf|h@h`Atvi@mtAdvF{iDe_CmtAxq\\wi@i|MkmHtm\\kpAw~JstE~w]wrHyfCynAd|Ue_CqpE_cCf_KmaB{wDs|CdqSiwHkhO{fE~}a@szBwvFafI~oCqjT|uo@anFsbc@lcHwfnA`}b@o_bB~wHkrGpbDvqIz_Hf{@aRyes@zlTo|~@d_CbxInwAknNpoFibD"),
+	province("Nunavut", "g|{xMbhdxPz_An}VpwCtuE|~BktEf}D|bS|`ItmD~uBmjDrmGjbL_vApux@fbCu_JmwFbuiB}HbrbIsnX|raAaoAonIy}EjnApBu|DikHchCupS_kmCiBsdKzqKoiG|i@{~GavDyaKtpCejQq{Gmap@|oBq|kDx`Fq_WmsG}y_AtlJscmAfnJizS"),
+	province("Nunavut", "_kezMr|mxMfyF`tCnhFb_q@qbJ~fS"),
+	province("Nunavut", "sqnyMnohpRc_Cg|EchCxuO}nIjeEgyGm`Om|CmglC|yK{wbBfxQucv@luAhgyBt_I|ll@"),
+	province("Nunavut", "okt{Mhfp|SjmGwqU~cOzlSzxFr_}@mx_@?"),
+	province("Nunavut", "w}v|Mjw`sRrmBoxR`wH{~AdqNdmr@sExoc@_oE`wHsoRoxi@"),
+	province("Nunavut", "{vy}M~umxOruD{aA~rJxeU|~N`jHz}FpoWeoBj_MkvJwaDokFkxa@maBr~GyuJo~C"),
+	province("Nunavut", "gwe_Nvsc~SrwPuz~@`A{kZpcQwgk@juEoj@h`EtrMhlBk}BzzBzbGpjBg~DxnKjiOnyA|}oAaqEzfb@}{dA?"),
+	province("Nunavut", "__z_N`cafMptI}jkAjmHl`_@kmGfpeA_aJurH"),
+	province("Nunavut", "ujyyMlxnnQkpAlud@ecGoxA{iDtxEiiHymXkxU~nwCgxAw~V{zBhpEwdCgnDdcBonkCooDc{NwwAxiHmtAuxEixMjoeBgm@vreBqsC~NqQmi]g_Kd|y@emD_tIypE`|MqqDcxIggBfnb@ggMnfd@czC_[io@}qNenCxhBuv@mo|@i~Rnlz@_aImtAs~BptI}~BqtIqeD`{FwvGmkg@veCwigCnkQgwkBjkBoqcAjtKqwk@{Qif\\liP`jTxiH{qfA{qFumg@p|Aasm@~_JyzaAjwPyxw@zmX~joB`_Gumx@hfOi|i@zkC|xEbyC}xE~mP|m`@?xz|@jrLdesA~xFpxkBilGjoXn~Ehtn@tqBcyVk|ColWb_Cr~Nb_AixH"),
+	province("Nunavut", "k|xaNniqcSa_KnbCmwLcbL_uHilYyiBtkJkhDsgDzvDiry@wkKkiwGxkXgncD`{BjmH|bGktEltMmut@fsTl_HfyF`xT?}~f@b_G~sIziDkiDidJiie@ehEmnCgfHb_Gc`NkubBx~@wcr@~eS{~aApgD{zw@~tBwaDhiTh|i@z|AemCpqEqmu@iwHwukBnaI_yMnhMoqiAd{Jtm`@duFcvaBj~AswD`_GbcWhm@qtIj}HpvNxyGfzd@|iJacWzbMftIr|B}ns@~vMgwRvb[i`~AltKntCnmF|bYxfOmrMzrFn|\\zvCr_wBbcFpuZsyNlcbCcdFbf@}kU~zh@}vFwL}mDa~K{vIn|m@{dLxeJz{Bvrf@qeIv`m@{zH~j`CdqGnisA{mGv}g@a_Gq^oyEykXghBd`NprJtsqDdcH`{p@ndCg{@p~@leGyeExkdB_rN~}_AmnOjod@_{K||IazBarDumIaxe@y`@sxa@`fO_cvEetFcxOydDh}FoqC{jUwxAbxIcpAexEmtAvy|BqmFueYqPaorAmxExiHc_Bktb@g`DqiCq}F~u`@|cGziOycDjsEtiErlf@ixI__WcbAv`DsShz_@~oDf[huClgRqwBnfe@ogA~zEq`F{nMwvNk{|@}sFpyd@efGnbImbA|b`@xnFheItIjs]dhQ`aWlvKplhA_nDr|MivOk{e@ykRyb]q{BryDmmC~yn@`_GbvvA{zC`~c@"),
+	province("Nunavut", "mrggN`~gbRkuEtbG_`Ia|QqbLs_}@twFisdAcmCsez@~vHa`o@fuA}oCltAziDlmPwq`@jdKxnL|eF{nN~vMetGt{Vf{c@mkJpdVwyFbqgAc_C{iD}~BhpEwi@s~FuhEfdBiyHpat@d_Ch`~AqpErmTymFsbG"),
+	province("Nunavut", "{ngkNhd`hQspApfy@k{BoyF{lCyqzBfeCwboAxiHexQxbDjbi@"),
+	province("Nunavut", "ctpgNnuvlQgpDzg\\{xC`rDgjGunI}Gg_jAjqLsmbAheDiadFreJsoyAcjTp`rAcxJmbr@{vDyvtAutFfp|AtlOlahAt`BpguAukIt|x@swD_oeAcfEepSo{@jdElpCl}Ts~B`em@dcHhtdA}qMn_tBoeH}bSe_Ch|i@u`C{hIdmDe_jAok@cvDc_Cf_Ky{@u~CcmBmvz@`jHe|bBc`HlaHylSfwwBcmHixe@k_GrbW{zBiekElmHoenAqP_crBpaF}aI_m@ac\\ccKf|i@usAlucBoeHhjYeuAtbCmkDobJmvA}uiB_aCpvI{c@`smBehF|j_AcmHiyYnhA|qvBucBprEm|CsiIsaFyq_Ac_C`n`AogG_jc@kaLscLkmHkf_A|cGg{rAjsEbsFz~BexKayHy{_@g^yxaAc{BpxY?ec_@s_Bt{JgyFt`hAsxCmdGrhIuj}CobCriDwi@o|eAom@|iDkpAa_KqxA`_KwsCifO}wEdsG}w@z`TixAuxEh^`khCaeIry{AuzJcl[_gCbwDekCezPdiDakeBx`FkaRycEkzN{xHjdKfgA_tw@|zUujfEv}QgttAzsEdqHrtEybKdtA~iX`lRkgkAz`G}qA|~Ts~aA~vMsoN~yGe`iAzzHkyUfmJtv]boBbbBnz@mzEzyCqnlCneNckl@}qByfDmcH|nOym@g`bBxmLacs@hmLb|e@?}{a@xpLt}H~`DzqXlyDe|CnsNuce@nnQ}}`BgiAujWctE|iLdcGar`AwmPp|u@ntB_{\\anAguB}|C`uI}u@b{e@anLr|e@{fE}|Hu{InqCwvBefFcmGyea@tiDuauCdxKahNx~^gtI|iJpz_BnzK_f_@ucAsw_C`bK_xLpwIgi^ptUzu|Aa~L_xeBjhUz_SfaJco@zgPjlNfpJdut@puIxxHfqCcfb@kqKcuVagDqz_@h_Awp[laHwqC{jCo|WsmHxbWc{C{bRcb@gcoAzlNycs@veHdxQb_GsmTtbCb|Y`sCmgRmgK{x`@ujQ}jEe{Ciz^xmFijYjuWma`@~yQscaAjyO}}Z`lSzwsBr`Rx_aE`zBfoJxmLzwEfbC`c\\epS~gj@jnMnlEp}L}{JbjTbegAx{Y`ik@pqDl~TwkKxrm@qmSblTkrQ}cGkhJxsDcpGcrc@}~Bz~FgtIumTbXt`N|tLh`WtrAf{n@~iGnjGjjH{vDzrRddG|}UibQjtD`lj@pSuu`AfmJumUhoGxO~gMnzXlaBxkc@is[jh_A?dgo@vhAy{FhuHyp@|vX}fi@nkQtbYpxSrhKr|H|pMwdBxrm@iksAhwyDd_CvpNbdEltA`gIa~FbsLwaOr_Ga}c@fbUuuc@~iR}yWnfCihBts@`|Fgi@xdVw`KhxNgYpbn@iqF~uB{gJtxb@mdAvvd@dtAbwpAjmHi`rAxpEpiHnrIy~QtdGr~Mc`HdnsFkjIpyp@nnEff_@c`Mtoa@ce@xmWyeDwm\\yhB|rGnsCr}_@emDb_k@qqDz_Aq_M`d_A{vDxoFoqCyiCkxJyvzEr|Bsbd@wzE_]wgMt~aFbnGzzzAqcJrbd@kcF{cRx|@pj_@omPvmt@urBmfo@z`HgymAemD}fWoyLdibBv[t~~@uz\\lypAmtLysdCqmRula@qyAs_Sf`Duu~AuqBcjzDelO{zfHsXjkcCh|GdpjEmuFrthBihCfkLqkGmtXbr@yy}BqtIaztCexEuiDrmHfsiCajCn{`CywBnrOyiHyjcA}zC`dJfwGpilBglAftTccKbbXnaCzwJrqMqvZrmHl|iAusMsb]yrEt|Od}P~~`@sf@rb^|pCrwD|~BcxI~kKtxy@eaB`|u@ibKs`LicKsez@}kDny{Bvk@|iEdxEgxAttAsmTpqDnrZdtMhh{AusAjwg@in@llD{iDiiH_cCpmXkpAmx]yiAjuWcxDrlBetAsiDp`@|rSdlCtYctD|hfAocDbyCixAsmTatErmT{eDetMmxEhtEmhLepdEgxM_rjA{jHtbCi_G__Ki{@~wLbaSx~lA|wEjsqAg^~~_CswPnajAeiKfeHi_E`sg@kiD}bS`m@`xe@gmDziDs|ByuIkr@}f]ds@auDx{BzoAyeDknp@keFrfqAs`LtjVqpE}bw@"),
+	province("Nunavut", "whouNfwysJ|eMnnEf}Jxyd@jhJdlkCpeD_|]llPnbjAzCrri@f~ErrFfxc@vnqGmhEvgnA~~J_cg@fuGtep@wjFfxbE~bCstE~k@l~BvxFrzdC?azhCrbL``dBkhD`tZndDfn\\bsKzyqKi}]p{tE|g]mnrD~rCgnEgm@tq`BbuEswt@sxIie_GzwIg_`Cd{m@`hnLiBvzuBh|AbmHb{B_|]tzDpvr@tpBteqBluA|mHlnBcxZmnB}usAbmMpxiAokEswjAk_Hci\\}f{@kvzUycJsjgFhmLeoTxuPd`q@buVngiCz`Gl_rBxySzw{At}N`tg@~kRr|lBia@pa_ApmAr}GdxEaxMtjDtdMxjBpik@paEfbCfbg@poyEjpAe_O~bCh|E~}Jbhn@fbUz}^tnI|g`AqPtt|Bg_KiqPksEpwHu|UpbhBpgCdfAdsYqsvA|iD|iPzbG}e@|~Nak_BjpLt~e@a{Az}qBnmPbw_EolKvdf@m`HrwuAnxAf{FltQoydBrfEjxg@w[lqv@ti@oiL~bC|qBib@uu~CuqMsbjDduF}cYp~F{iDrjCf`\\loHrrLvvMhepC~oDc|ByqDwzo@xsCics@bcSnal@fxW|vsEwdHfneCy_Qt~ZwfCi{XzFfikDk|AheIspAccWqzAreD__EztxApzG|owBhtIaca@r|BsizFfl@cfEltAlxEviF_cDdmJqmc@p_KltAzlCquJlvK`ad@bpAdlpAmdLril@~bCzdFrvI}`I~rDb`GftBdrw@a~@d{oAekKpsy@vwBniLjiNeka@tgGffZenCzr}A_kFvv^j{BhwN{rFzhK~_DbzZy|Khul@wpDx}dDyeDdjP||DdmJ`vC{vKxsCoiuDfqCihI~hBp_LdyEcxIzjHgpm@yQdjzDe}Idwh@}gK|hFdbBjbu@~~Vsxm@yeDjhnD~vMsrfC~uAutFxzBtm`@swDsf{F~xFahq@p}@i}_H`jHnqdAvmDiqh@fiBfhGjfJ_ef@rgDuh@bqAvxGn]pcsBifIn{aCgxBfuY}bGc_GywCz}F_zEtbe@~x@b|wKvqCvsMxiHuy}Kd_CajHfcDhfOkrA~_hBp`Lvr`D}{J`ylBb_Bvb{CtsAun~DvaEsub@hgB{tDzmHjmp@|z\\tm~@|bAzmBneC}lDvK}sGyuOea_@szT_ibA{aLml_E`uIm}xDp|AwnJv{Eb|MbhDhyzArxIvgd@yiHisjCrdK{p|DhvDdWhjBjcXbb@wmV`|AepCd_CpmLtwE}mTltAh_WpgCvdCvvBcvEntA`_GftIm|m@zmMjotCcfEbivE~vBxm^ryB~wAtIeteBx}Fs~LzuPcs|DbsFweNxcEfqP_N~yo@ruJxiNfhHjva@ayI~b~DbfEhkv@nfBjhDx|A_wFfnJyvdCtKy~nB`~EquDfuYbobAseKbhy@l{Dxel@~yGdtM`}FvvaA{oG|it@bd@~|lCytJjs`ArjCrwIfxA}iPhtI|fo@fnQ{vvAn~IapFziDrmTv~DirKptCn`QzTms_@zwKike@nsHpyq@vcHsuEzaG`|M~{Fpxq@|iDyiHxiBpdC|gLzmtAzUvo\\g_KcxUkq@vcHdhQrveAm|DhacBh_Gfr~@iuDfipB}vW~qpCkiIj}RkkDlxb@giFtg@_xAb~NmtAw~JgcBpbEaeD`_c@cpHf_EogAd_OrdLydD|_Ci{S`kM}qXljPhpK~nJofq@dJ}ht@bcGy`FnhGblcCu`Dbez@nwAz_HjmHgxu@jtEmxEltA|iDdzEagIczUwkrC|~Ng}_EjnSbzq@joBjvEbq@eiFsiU}qbAwrIwg{@~\\wvv@vxLeshAbbAmmC`{BxpEvjEatJhoWhmaAvcDr_HbiDyjBkt@qh{A~{@i}Ev_Op|Qp{Actn@q{AquCk_GlmD}uAst]aaJwlE_uBen[cpAjiDeqBibE}sB{ix@ngMckf@hdEbqAziDgmLxfCv~JveH}{a@j|GvjMrmHeqCtwFz`HxsCmeG~_Enar@zaM|v]btEkmHxrDdzh@nkEvgAy\\lpe@qvD~cJu~Bu{EmxEdmDspAyiHwx@nqg@byZfge@f`E`ph@hzRlqTizAneq@tkPjsiB_eBbzZlaHd|f@yAnh_@jtEvmJew@zaj@jqG`ac@eyEq_@ktEyiTs~BfxYg}\\ciy@kkHyuC_|Kb_k@}tAz_j@ypE{~Fw{DtxD`vNtde@`~@toYsnGxadB{aA`dDadJcuQkhE|tMs`Mwju@|mIph_Bgjc@hqwAdg@jpGtmPgxi@njEvi@l|DwsHvkQa}rA`jHd_[r|Nw`PxmF~a[z`AvidBgxMn|y@ktEc_G}iP`tIomPzut@a_GntAstE}mHss@xxGjtFdoUl{D`Q|lUsis@fmJa{AblCwbItgFv~JvmDw~J`yB`pFasCbp~AgbV`~`Ak|Nj|HatDpld@zpAbdFtjEgzXjyNdoIb}H}bMluFv~Iim@pa}AgwXd{s@ldA`jHxtId_Ch`KymFpaFgwMxeOg{hBhvC_iAv_Etf~C{eEp|Gjq@~yb@ubHxww@krEr{xBahC|vKo}Jn]{yIyah@sjUhlgA~[doIhjTif_AzzH~jaAtmDwl@duRz{O{bG|}nA_wAxwCe|DqsEyxAzwDgiBr_v@gvGghFugFvvSk_Gi_GkdEx|RpcOthEjuEamRvqItaIl~CiiUf{FnmLjrEesMciClaYh_FhpLeX|~MyhNxmXvoI`xN{wDlz}AcaAv~DipEoxMumDfcO{vCe`OauD_\\s~Rvg_@muFwiFmpNlrLacFmrLgcHd{CrxTzjf@dtMccWlxEvtMlaC}eXbrEexEx~Fym@~jLp|XdlD}mC_]`~QytJ_mFmdA_wGwiFbfLoiFa_GevFnyLb}G|}OhxBetBx~EhiIt_DesGbfD|_CreFbaSui@`gTgmEziDfbDjbWwwMvtr@gtGqvCybE|}HvjEdaOegEhyYmtAg_Ko{Fs|Bg{Dcu\\gpDwqDojFpaFwm@imLo}DtlOehQx_Lwjj@ohyEisCgcD?~m\\irEq|o@tg@qm^dtAdxE`l@qlFapDsfwAnvFw|PorBqno@etAzbWubHilRimA}hu@`tJsyq@{p@onE_pEz~Rqm@{jw@stEnhvBqtIo|y@wm@zjq@apEveNm|IoxlAg]|ht@dxEj_k@q`FfvgAkiDu~Bk_GvbOsmHmtYopItpI{kTdxbBg~PujJieEt}N{~F_jXyiHhxUvyFgbb@e~Ns`eDvhLeooCh`Wci|Alcf@uza@joFmbNlrHbaNmoImdw@joAelDjmCtdH`jG_mWxiBqmmApbKcxm@j{B_rx@}~N|m\\}nCcpGvNezbBbxIcksBqhGboB}iF~s|@eyD`bFsxIcjvAsb_@wtmAkxUaeg@}e@~mDcfEcfWu{JsoGw~DkoR_cCb_GmtA_jLi_K~bOs~B}bk@c_GoqHnM|uc@pgIz}p@zpFvnDdzJicJdaHfaf@hst@p_~A`fUfy_Bi{BeuAwi@phfDmnCtnOexF|cAyfTo~iAioEsrF{wDtvKh{Am|ChpJ~yLngNvylAgjLzbfA}fGcsFseDvbOotBcjZogHmaHskCsri@bzCt_iAmlDr~Mq{Fc|NwcDolwAnn@o_EbjI~rIssLezh@x}@ireAg}Drxl@dpBn`eC~kF`em@ueA~}EumDe_CatCrmd@_|WenwBlmDmpdAmwFsy_@kq@oyo@jzC~_mBqzG~|n@axIsbSe_Cp~Fx|@ovd@cuE{ti@zoGg_pAqwBeib@g{EdtvD{}T{c^sgDenl@}nBpzG~nDxgZafDrmTg~U{_SayBz{BwbOum`@o{@dzD|bNfic@d}ItxFzoGplR|aMinAzwElmPzeDu~Bhtb@jlyAtqGfxnCwcCp`KmoIyuI_cCvbCmtAc{YuwKm|a@ejJg|Jui@|iDuuFanLy}Eue@{q@tyDrmGbmHvxFtz[`zHhgDvcHf|\\k~@bus@hoQ|mr@{pAb{pCmdAb|BohGewRyyBahbAkeDxjsAuvGkiD_pUlZclBbuDqwCmoBagUwcdAhBmky@stEvyG|tA`r\\qvCvjKauIgiXo{K{lHiqQiq_A{jCkoeDqsO}ybBg^__i@z}H}m~@|fEarzDbjUu`qB`eEs_hArvOkwg@nkFadm@mxE_|Qc_SrjcAsiDkxUduAcil@mj@enD{wC`mGbmBtxbBijMbuwAswDtjEmrBy~t@xdDevpBqnFu{iAzEabo@tu@olEf{@vjLf[}mIiiH_|QomDlcSauJ__KwwLq|i@s~BniL`{Hth`Az~Rxan@`cEtnoA{zIrrsAyyAxc_GsiObj{AuuAv~DkuEihBo}Jmc|@pkBiiw@`cDqeUveCtoBdgE}zO`iCeogB_cDmmO`r@xua@ovNxaqAibEh`LipEexQenDv~DtjFbfb@o{@pnc@kxI|e@gtHtiQoqUnbJ{qMjukAkhDx_@{{Mtb_@afDumDicKhuy@c{N~e`FezJfl@eKmeScfDttM}fGnbCgwLaiN|jDqhgD~{Emmf@w`DkkNknShdyF_vSrgVikSowXmfLcoBcvDggvBlsLgyoBbmB}kiHvriAcyzHzvV{fg@j{BnbCbtEuq|@vwGfj@~tCo_KbpArm`@jjBsnYmoHgmt@`lMykyCifCjxI{qAklC_uGgygCelJiv_@rjIdo{Ea`LfduApOzhf@mdFkkBmtA`_WanFgkK}uRtwQsgEikq@kjNhltCypz@doiEyd\\wglPmaBatJk{BxmLowB{r{@mbG{keKmmDmvEe_Cpd{Jgyc@cuyIywHm}eI{lC{eEwrCtsS{UparGrmHz`eD_cCzhPmbHkpMezJjsKu`B{lBs`SaytAeeGwtMab@l_OgsBmiEckCspv@uzPm`}@qn]{luEq{AzlTli\\hhdGvcUx|~AriIdrMdtAueLngAvcHbf@lng@ycQvplBxiTyutAjiDjqd@`yGhjM~hBpzApz@ycEcUyzXxaFhkAjbFjfi@pnFzcExcJtp`@dd_@thuFh{SpbdHbr@ripCexFhjHqrJa{NeqN}bvDqpPw`kAe_Chah@jtQdyuBp{FhwrDq{FbrLmeBufG_gMutmA{_Bbi\\jyP~`jAui@|~f@r~ByeP~kE~cIt`Nc}ShcP|uWtxF|axF{qFvcpCm_Oe|y@n_Prd~Aq_LxngCayTcpe@e}k@{brCuhRgk_DpiB}eQ}_Tsg_LouHozfA`q\\~hvS``G~p_AhbVzdwAthFxvIvfSfavAffGf{E`tJvpa@mtArasAw{\\jfnFguNtzwAi_FpxMetAulDwvLomfEjkBwdzCauCmqrD_oKirpAgh@isqAcr@omD{iDdjPafCi`Ky_XmneE}wEabQaRb}_BvnJfazBtmUdb|Bt{IfniDc_At_{Ap_Lta_K_cJvodAcvOfz]soBhnA{zBotIm_Jsl|@baGq_rA_zFm_yBcRhqwAocIxuuAigDlgWogAiaEqlLm_dC}kJoip@b|A_qZchDtlOouBwwGy}EgexDltAtxEfx@scFsU_roAv_E}in@mw@o{EumCbtKq{GjsuDchD|jQtbStycFxfVldpDqkNlstAiiBocDgxXcmrDa`@gkt@o~Dm|Ize@l|aBi{B_jLejJdut@|rAjhJfvGmvK_pDv|qAryIh}t@|_CpmAdxEwgGxpE`dbB}~Bg_Kk}GnhMkkCx}lAmtA~iLmtAm_O_xAtmPwi@acc@spAh_GgxAmtMctEoi@smGibo@ni@gusAspFldSipFakZpQ{~u@whFuiVuoCeggAfxA{b{@qjBxjHm_Ce`r@h|@q|FltAzbK`_FmfRpvIyl{@geC`b@cwIoxd@e}Cjnq@odDghB_fA_wYdwEkw`@}sAqrPm{JxmL}pBi{H}rRy~uD|yDgggCn_Kc|e@xzF`c[ldAgnDp}LgulBcqFbyO{pGj~|@kpA_cw@i_F``\\{gDomD_l@yq`A|fGe~}Bdd^}dpBcr@goDkjCfyEmwR~a_AawBizHg@cfpAhcP{eo@zwEc_k@{bS~mt@y]qwf@|rMezPf_Dul`@udRxb\\_q[l{`K_zL|k\\qlLsr_HsqAazHapEfc[umDgxMwvAxuUcvEsuEouGzdmAqnF}}gFv}DmqOvy@lqCz`Btky@|kDqarAtaHky`@ayBga\\vsC_heBr~Gimo@|qMsuP~`Jw_z@j}GgaOptId|a@ziDk{sFczI|}l@oZ`rcBmwWnpiAwtItdBuqAiwIl_IycnBj`p@w|dJ~pDgyiBf`[}b_Bre@shy@m_[jk{AsiDh|i@miDsiPcdAptDm`Nl~oEo~Df|Fe}CsnSiQhpqAmui@~gtGqtD|rB}hRenl@|i@u|eEiiCz|sA{tJhjiAexEb_SyfD_`DooDkxy@xkGys~B|xQclfB{s@myz@_gXf{cButGbhKcnMrgpAcsFy}RdqGygaFrzDmmClxE{i\\|~B`_Gl~BacEdfFsqz@qz@iiHi_Gtfc@grC{kOroHsfvC}i@y~oBulPhbfGokEfpDab@dtMomDc|}@qkHhqlC_aC`rDcrFiat@qaFeu~CfeCcg\\`yBrgDrtJcwg@ymLkfi@Nqnc@ziCq}QhwIhxHj}Bg{FwcDwb[x~F}js@mxE~wYh`@g|i@{fEvqPwmEocr@npJsjjCdzJuxc@daBqfCfxAjtEjnBm}`@heOqhl@r~Mio`CndOdly@hhHvyLvdByzGatZinjBm~Csgs@elPfn}CkkHhnRatEyq`A?tulAomPtmx@smTnokCgfHsmp@ltAz~FhnAwuGilG}haE~|Da`dDotBisl@vxFijfDvzFm{b@|wQeuc@lc_@}spFmmDumPceKtsvB_`Jloq@auIlsNqwCvaf@ehQgdnAwsCsp|@msA}bH?~~Vi|A{cFsvD{iiA`xCwpP_`Emok@x`@_f}B|dAutGbaMpqh@r`Mqqh@bcKavpCccWzu`CezJabkAin@_dCwfCbtEjnB}fi@cvD{uPaa@wt{EteAcyDrpAfxMfxAwiLpeDxiT`xAe`~@rtEzfg@hoAs}F{{IuyoBnwBmhKv}Dh|@boA_jE}bB{uwCvy@_qDrbGpiHz|A_qJqlF_ogBvkFsjpAe|DwbOvx@wi^xzBbcG~w@kiO_mEepT~sCsw}AdtAlmPntAiiHzpAfdCl_Ovl}AvzWl~sHxcElyg@|sArtE~x@}nI}mD{rdFifIijaAsEy{sAeaHomFklOw|vCsCiceAngAg}EnmDxmLzpBomEz~Aq|`AwpEb|e@umHsqp@s~Bfcg@ohFa`vBvy@k_GnaChsJfk@vgj@ltAwfk@jiD`_GfgBs_GprIqes@u_No{z@xvBmfmChlHkiD~rBrkIjyDdzpAdWsjf@vfB_bUt}CzDruDam{@lxEtnZ`_G}kuAhpEpxYjxIbxIltA}iDziD|tu@caBsaaAkvJwf_@lmDmoZwbC~{EcsA{{Ceg@sgmBzt@luW|sFj~HomDqpQvbCcocCzpFmlm@dgLm{f@"),
+	province("Nunavut", "{_kcIr_ifN`tCwfGhxCpm@pQ`aWqyM}qA"),
+	province("Nunavut", "ugsdIrf~fNnrDvh@f_D}tFxcEbyDyo@h{L_aJ|fLy~EimGaa@_jR"),
+	province("Nunavut", "y}_vIbucgNrH|xWohSyxGiaE}vc@gwH}Y`Eeud@mn[kbX`{e@p}X"),
+	province("Nunavut", "}boyIzsxdNudHm~B`kN_rPxxMtZhlGtaN_pD|qBsjNg~E"),
+	province("Nunavut", "ecgzIr_ufNkfJu`TvzEqqFzjUo}Cjc@juJssRxfC"),
+	province("Nunavut", "ioo}I~p_fNmiJriJzFstFwhGkeEmeAx|GqeOchPhaQy}FlkDxcDex@hdJ"),
+	province("Nunavut", "}ibjJh}cjNdkVr}LedMgoCef@jtE"),
+	province("Nunavut", "mgqkJ`}shN~bCixMriDvi@|}IppRpk@rxOycJmfG?itI"),
+	province("Nunavut", "_ywkJtc}gN}gFozEmdA~dCv|KhsJsdA~_DujV_wYngB{n^djPpxY"),
+	province("Nunavut", "ir{|HhzqcNwcC_|KnnDebN`nGhmLvuLtbBvjGpmv@yaLv{EstEu~BnOweN"),
+	province("Nunavut", "{w~aI|h|kNhra@_pP~dHpkHsqu@x_pFcmHftTyrVadVubGkzOkoGussA~eBqzo@x|Ryke@"),
+	province("Nunavut", "yrteIpwxeNavCnm@~|DqbK"),
+	province("Nunavut", "ohdnIvo|bN}rA_yo@|}ZzhpC{iDi|Qgi@tmHscF_dN"),
+	province("Nunavut", "acnrIn_{vMe_Ci_Svmh@|qtA"),
+	province("Nunavut", "oy{tI~ueaNom@qmLnyLfrObiVpbJ|eF~oKitJihImmf@y|MqxBmfKb_G?"),
+	province("Nunavut", "mhyuIfju_NzmHd|a@mbGufA_bPwnOaaK}`DuzEltBcqLszIgoDq{Sdlg@}_BftIt~B"),
+	province("Nunavut", "q`auIxhodN}aNetMz_AvbUvvv@dn~@ymKnyFmeAr}M{~q@kjw@iyXujh@akYshJabWrSzwIcxIvcOhyBxy_@rqLpxTj`SaePote@aqh@ssR}ak@{sCstCayNvsLu|LavExsPnaPxtCxnBctUb}SoiM~h@cvVfqOkhDjnCxlGqR}vLblH~wMz}Y`mSltu@~pZcmk@i_Qsha@asV_hXy|@bcEjoLvdZpiI{o@gvHliDvi@pq`A~fk@oqx@wx]uyKboA|sAlpH|}l@ff_@lfRrjUbcA|nOsvEfsNd~Trv\\"),
+	province("Nunavut", "yxazI|rufNf{EkhPvbOvxLy`F~lK"),
+	province("Nunavut", "o`qbJzffyMf\\{sD`BdtL_bOzfh@cEe`D"),
+	province("Nunavut", "_zydJ|t~~M|mP{bc@e_Oxfk@{~BomDsxIz~F`cK}iP"),
+	province("Nunavut", "}la}Hr`jaNbaB_aCpA~rN"),
+	province("Nunavut", "af~}Hl|scNddBqwC~gAdmV"),
+	province("Nunavut", "ceb_I~wp_NbFo`DfkF|aG_eC|bN"),
+	province("Nunavut", "wtw_I|zvdNzkCfuIovGqgD"),
+	province("Nunavut", "e|wuIjlisMn|Eq{@hkBhsJmgLy{A"),
+	province("Nunavut", "iznwI`e}wM|zIz_Bfj@flE"),
+	province("Nunavut", "k}xxItx_`NepBrwD|u@}tR"),
+	province("Nunavut", "cr}xInotrM~mQmZnlFv}E"),
+	province("Nunavut", "_wi{IxazrMueA|pH}bT`vCz}HynLh`Ep{B"),
+	province("Nunavut", "orb|Ijxu|M`vCxdUonEo_J"),
+	province("Nunavut", "glw|Ibbc}MttAvd[mhFqnR"),
+	province("Nunavut", "qm}|IpgusMooP|sG|xKarPz{Mcd@"),
+	province("Nunavut", "akweJ`e|~Mug@rkNecBwaJ"),
+	province("Nunavut", "}uafJ|jq`NrwCa}A_aDfdIw_EaB"),
+	province("Nunavut", "acjkJzgmjNhzG~lEakAtdF"),
+	province("Nunavut", "cfbyJ`ukuPfwBmcA{|@|aP"),
+	province("Nunavut", "cfxyJramtPftEtdAskHhkH"),
+	province("Nunavut", "kf{~JjgotKt|GovQ~FhhL__Lh}]"),
+	province("Nunavut", "ol|_KhfnwKxnVy`]lxBljCcr`@l}u@"),
+	province("Nunavut", "}{daKxr|~KfsNmsQshJ|}YabDjoD"),
+	province("Nunavut", "mmjaKdyd~KjwToaXiqXhne@"),
+	province("Nunavut", "mgsaKdnc}KrtI{uEyAtjHyaV`bR"),
+	province("Nunavut", "ut}bKln{eKf~MjiHeaVjcNcsA_cE"),
+	province("Nunavut", "igtdKvl}fKxeF_lGhrGxaOzjFj[yzG|}NawMmv@"),
+	province("Nunavut", "klvfKfiyqM{yC|mO_oD|X{r@euM"),
+	province("Nunavut", "qebgKro|rM{jCd_Dh}@}jWxmElfG"),
+	province("Nunavut", "unogKnpokKryB`eCzcDu~RxxBvjInzAgqJlbFfaUcfUdmOoaCevD"),
+	province("Nunavut", "iftmKdbpwKjsEzpEudFlpH_yG}~Pd{BcfC"),
+	province("Nunavut", "wtenKd}n~OnrBybN_Y`}U}oC`uA"),
+	province("Nunavut", "ulunKnsvzOf{C_sJmPjcU"),
+	province("Nunavut", "}jgpKflqzKxg@_nGboDboP~sBbxf@iiDzhC"),
+	province("Nunavut", "ml_pKb|opK|rCt|HebSzoD~nBepQ"),
+	province("Nunavut", "myoqKddowKgpEh~Wb_DzlHwmFbkGwEiac@pfCkgG"),
+	province("Nunavut", "ezirKxx|eO|_DsvCsIzxS"),
+	province("Nunavut", "umarK~df}Nuv@jhDuwEocIvjFugA"),
+	province("Nunavut", "{cjrKnji}NlsAdfF_cIfAhnA{qM"),
+	province("Nunavut", "{attKveskOfy@krJdxAx|KyvCb{B"),
+	province("Nunavut", "q}u}K||vpT}gBqfo@pnGnwa@cFnci@"),
+	province("Nunavut", "aoa_LxmuoTpxAqgVj`Dj`Mk{A~|VwqDegC"),
+	province("Nunavut", "exqfLbsdhNxhBiwMvbCvwJy{Gr|MwyBuh@"),
+	province("Nunavut", "{ntfLn}hqNdn@izYxsJ_}B_zFb{x@xhCkzA~aCnhKkvHpcMwvFanK"),
+	province("Nunavut", "m~}hL~nqvNxkDuwAtyBr`Lw}Hxwx@meEolg@"),
+	province("Newfoundland and Labrador", "gr~nJjtmhKb}HuwK{hIluc@OhvH`{AduGvxF~cNg`Dc{Sks@swExOktJxcEekQpbKzbS_eHci\\le@wcC|~BjtEpeD{mHrxIb|UriDswEtrGdck@tzEpaWxiBtaBrb@cd@bEebBqxMi|i@{c@alYodI}kOtfFrp@x}@`nFv`EwxFf_DpxBbUnq[|xE_dZdwE|GduGjuQo}DkcX{tLokK?c{N{lAxhNiyHnqCnkFuh]`lF}nCx~F~m\\tmDytUb_GltMglE}sX|dXp|Ky|@x`LvrCh_@|~NkxUghAufc@lhExm@vi@yiHx`AblTdvWbkP{pG_wNiaK_kEjnAe~ZpnSzwU__L_ySx~FqxYrmTbjJ|U~~f@hbEd{JnvB}pcArmSjvKltBj}H{~BstQecSiiNt~BumVxxCym@btKvbCbaGzjOoPorOy{LcsWhkA}fFhtE~iL~`IvwAqdDocNndCszCndNr{NlfL`qz@qbDvek@n|Jx}K}cG}j[tsLpjAvcDe`H{rLj_AqRol`AirDumUefFstEbr@gyRd_Cre@riDk__@ltA~mh@liD}{U`cKhfOsaG}{VtaC{~A|~BpbKd_CoiL~~J|b_@e_C_|]zjIzcAnLouCazMk~Rt`CugKd_CdgKrmHc|Y~~J?b_GhmXnm@qmd@neHbUvoJxjNbeQv|m@zb@dud@agDxjMveHt~NupAic\\|iDwzElx]zmp@acKum`@c_Smx]ltAsxa@tiPnxM}kOmeRdnCenIapL}jh@bsFgiAmhF_gGsp@_g_@bfDc~BzfKztQrqG}vEx_KlXx|Fril@nrIfdIijGywOwxAcb]paFumO|mHncIhcE`uuAfxAwiLlcMbad@vO_}KhlF`rEbrGto`@maCi_j@kkIkhDurMcn_@lw@kut@{aG}tLp_FstDahIwqPhkNzcLfvGqjAmrH{~Hi_@_nPpdDfcIbvEwhFanAypK~oEqgCppEl_Oaq@tx[|tLzlZctE_aUje@moZrcQgAicU}|NpmLvi@|d@ahCaiHu`HzmHqmLmtL}gLpbEyqJjkHsaBtyKjrMh~EigCtcBixTdbMycJ|_C~rBngBmpMrtEom@jyIbcd@bbGjoAvMbhb@`wNjjNinFpac@|dLyq]i|Fsoi@|mIuqq@rmHktQ?v~VhvC}}IjvKdw@twEtrX~lEccXduFunBg_D}qNlbGcEwtMec_@daMb~MlJa{j@lrH_vSfbI~uAxmRmxEstEjmHbbAneTjsQftZbqMt`Cz}A~}m@lzPcoB_tIoiLriDacW|_CflWlmI_~[lzE~eBec_@rd~CqkGgvk@vKkzk@}_BtsGcrAtbnAvaJ~|Pzu[oz}AxvCuhc@re@zf_A~wGoufBdpGarJnpDh|Aw\\jbgAbzCfrHeXysU`vCqjBxo@j_e@fcI{b`A~z@mqCphBdwFzcEmlUrpAfmDbr@_qCuG_uNdcGctEkuDfy^j~A~~Dj|Bc`Z`eC~aPizAxfOcpA}sMurA~zLudAjeh@tnC`sDvhLowXef@ks`AdpCgeIaiCunf@ltGi{@du@~~I|fFznBk}Am{JjbFa}@bjIjxUfxAa`j@xpErjs@dtAemVj{Bh~@_bD`nLvjGirJfhAyhf@wtCseKbqG}fLf|E~l@zqAdlUrbEioQkhCm{\\z|Bqxe@tzCmqBltGljEf`Dlwc@xa@`xBbzBsyC_i@eae@{iPaqQxb@_dIjcFxuClpM}wEbhEjtKp_Fc{ChyMvwHwjKkmN{ka@glQxhCqy`@npJz~HnhFkbAt}C`cVxmGbfEjzC`_@gnFyqJpjBy~LrmT`xImpNqfUpeIkeFfcO|l\\p|GcbAxvH`mF{yZ{c]p`@_sC`tIn~J{b@e}NipFtg@w_DqaFz_ByfIpbKym@dfFb{_@deRrbGhgCdgRdxKrtEdfF`xMu}Nwhp@n}JbaTxmLlmHfmLlx]bxIltAe|U_nh@efGmpBspAoqOo{Vsri@jtQfyFi`Lme_@vnm@jis@kxUixe@{sQ_xMwi@umTsdL}zKkhCwxLbjHixA~{@udG`fIx}Et~Bu~Bp{Gbum@rqFs`AuxD{oGbtEgoVokFypKpiBq_QcpGkoSeeFyo@hxB_|E`tI?gKmvKzhOhnQhtPhdh@``EkTjfK`aVtyDvOjWmkEl}HvjF~oEvpPlqAm_DkoGqkXelPdHoic@mpbAjeE{uEcxa@qf[y|@{gIjyHba@qyLy`KlrGkvEnxFhkBli\\hqn@`eD{UafIuhVunIokF{|AowGzyC_cDcjIycDhkAatDzbFrc@ahOubNnhG_jEogAi}FnyXztPe`CicJ|uKqrQv~Jn_WcwDcmTbyCyeC~tHtxDwz@gpUxuIoeHgdHinFfyFe{Jje@usYrtEveHom@ccWdlEiiBqSg|EajHyBwLsub@jxIcrGqBupYfaDacE_oD_{WrtKadOa~@gfIfeI}pGmaCs}e@v|JwkLzr@mdLrlChPvz@rkNv}Dg`J~{Ex`FdcBhux@fxA_gw@pm@hyT`sDhAjaGbaSi}@n{n@lxEh~z@rmHttMqfCk__@|bG|i\\zsEueAblCpgl@pdPvsq@{Hvp\\pbRjndByDllb@lrHimLqjBwrg@ouSsbuB_hSkz~@n|b@vyp@~vAjipA~wAg_W~tG`uIx}Frgn@j`Sjkq@ofBbdgAd_ClxE`_ActK|_Cnw@`bEn_n@wbC`nLdtMqtU`o@vcNhcOroBt_Ic`G|fFtuK}`Zvhp@ikS`uoA_nJddNf{EsnBb|NvnJj`Aikv@xfg@gsqBrhJinA_cCjtQziTlml@_xA~{FptDyuCxB_df@lfLbwm@meFq}o@rjCa`LeoCikAujDnaIqwCaeUmbS_jXhyAiwCc`GeJ|e@gmPupBt_NioFftBdZkdt@afC}wQmuXy{d@hfCyhHemD}}Hze@wsZacKgmLme@tfGi_Gd_Cwm\\k|k@om\\ydjCreD}{_AkpLxhdAhiHtl`@qhH|hQclCug@}aH{iIpAogSy`E}lId_DeesAdiDub_@b_CdtAgpEum\\b`HywMvdCzp@ui@}bGzaM_xAfmPawN`gD_iSxiBurAlV|bMruE|Yr_Mcl[?pbKr|BmyDbgEnfH||DoaEjdQ~_`ArhJx_QmxEmcS|iDpm@jpAupYbcG~aDvw@wjFrtFxQh_KviL}e@uxQ{vDbbAqyNiq]ylA||DujJ}jEmbB_mJytPkxPrf@mwc@yaE_rNplFciV}iDv{EemDmtAe_Cc|YzbKwiLneIhby@tkJghu@_vBwbOzfE{gPltA|uLom@er`@rmHg|a@b}Gh_F}cGvqIkp@hzd@vtMqch@~vGeoBlbCanLj_GvtM}yDsvU|yDmgLb{BhpEdfAykMf_ExoQh}@a_Q|}BscA~aEbyIom@iaVnvH~rHuv@bmHxmLexQjmHt~N_cCjxUdcGwiL|}HvqIiOftl@|e@yfO|wEjxI~xAqyAibEy~LdaCsu[~pC{|Bre@|iD~|Ds}M`eOgkEbjDtla@zlBxfC?etMjnBhxHoyFrqc@bqXkke@jd@exc@`sDqmF|~Bb_Gti@seDliDjmH{qKpsx@pgBzgDdlDgp[f|FtvLd_CybKmtAroXd{DtvRafJtbv@vpJisl@oi@}ck@~{DozFwi@uo`@ziDnxAtIs_SbnGtoBtf@auTnoKoPfbCpgD{iDftY`}G}gF{|ShprAziH}~NfmL}mx@bfDrtEnm@kx[bxIhtOgxAuiPdxEumPrd@nn]~nDguNjpMqm@tmDd|UliDa_G`sEdqHjDvl]b_IoiLpyGbcFv}Jjtb@j~MptUltG`}i@huOzsW~x@~eS~}JxlBjvJnqTbeFp{ArnAfyL_sCzvD~wMh_GrSxuU`PxoBmmpBRv@xlog@kuAd~EetD|qEk~CdfAqSecEz}Ea_VwFmcDgtAeX{eHdeEa`Hpu@osGtpAg{FdaC{mCnbCgwFpiH}cApKidAyxEow@kDmnAftCuJxeTbSfo@}zBjeO}bBnoDqoD|nDk}Bxw@ixCtsBgdAlsBuzBxb@yaCokA__Dg}C}kF}kH{hCoaGqtByzGk{@wkEgjAa|JmcD}a|@vo@whPekAmvE}hCx^cvCfbDorBrqDc|Ch~MujD|uJ_Nh}Bq`@yL_y@lgA}uEoT}`Gn_AluC|`GnuC~tA|uElaI~i@|wMlkFf~g@~tAzcSnuCnjB|_C|`GnkF_j@|`GnTluC|jD~_C_j@~_C}_C|`G_`C|aK}tA|`G?l`E|tA~uEnuCljB|`GnkF~tAlvGo_AllJm`El`EouCnjBnjB_uAlkF~tA|`G|wM~_C|jD_`Cl`EmjB~tA|`GmvG|aKlvGluClcQmuC|vIoT|uE_aGlvG}tAlvGlvG~jD|_C|tA|aK~tAn`En`E|`GljBlaI}_C|kHmmN|wMm`E|uE_aG|bOm_AjxO|tA|aKn`EnT~i@|uE_uA~uEouC|`Gn_A|kH_j@laImjBlaIojB_j@m`E~i@oTlkF_`Cn`E_`CluC~tA~`G~uE|_CmmN|vI_`C}_Cm`E_uA}uEnjB|i@ljB}i@~_C_aGlvGkbM|`G|`G~jDluCluC?|aKnvG|kHokF~_CmkF_`CmvG_j@nT~`GljB|uEmT~jDojBjwKn_A|kHljBn`E}tAlkF|tAn_A~_C|`G_`C~uEm_AzaKmmNm_Am`ElvG_`CjbMo`EnjBmvGlvGo_A~jD~_CnjB|uEo_An`E_uAlwK_uAluCnkF?|kHo_AluCm`En_AmvGluCo_AnuCmkFnuC_aGl_A}jD~tA}`GmvG~i@}kHl_AmkFmuC?mlJ|tA_kD~_CkbMo_A}kH|uEmlJmT_`Co`EllJ}aK?muC}jD~i@mnRlkF{aKlvGovGnuC}jDo_AnjB}aKl_AojBoTmuC}vInT}uEluCmwK|kH}uE|aK~i@n`E|uE~i@lkFlvGnjB|`GnT|wM|kH|_C|aK?l`En_AnuClaIoT|`Gn_A~_CllJo_A|jDn_A?luC}tA~_Co`En_A_j@|jD~`GnT|jDn_A}uE|`G_aG~`G}_ClkFojB|vI}uEn_AmwKn_AnTlkF~tA|uElkFn`E_j@lkF}uEllJ_j@|`G}kHlvG_kD_uA}uE~i@mkF~jD}mPnjBmuC_kDoTm`EouC_kDo_AmlJ~tAm`E?}`Gl`E_lH?{lLn`E~i@njBo_A_j@maIouC_uAmkF?ouCn_AqcB}nCwuTqp@o`E~uE}jD|tAo`EmuC}tAmaIojBmvGknRhpZo_A|bOojBjbMmuCzzY}lLzwMmlJ~tAmkFjnR_kDnjB_j@lvGmjB~_C_uAojBojBmwKmuCn_A_`ClbMmwK|_C}_C|vI_kD~`Go_A|uEmuC|uE_kD~jDmwKojB}jDojB_kDm`E?mkF}tAo`E_`C}uE}`G?_vE|uE}`GnjBmkF|vI}bOnTmuC}kHo_A_vEmuC}uE|tA_kD}tAklJoTokFmaI}tA_vE|_C}_CmjBluCmbMllJmxO~jDkbMo`E_vEm`E~_CouC~i@ojBouCmuCmvGoTmkFmwK}lLmaI?{cSx|a@mlJlaI}vI|aK}kHlvGmkF~_C}aB?unAss@gjBwgDkq@yuDfAekCpjCeaOj\\}_@be@`RrpDndFldAxCj{@ulAriBgaLlxAcoEviIupP|gB_eKpv@swBvdAcy@`jHclCxlCofDh~Aq|Dh`Ci`LrjEgnOliAekHef@{}Emq@iDqsB~zBst@nC}t@_gBbQgbKcmC{{EmiHfbEq~OjtPmcC{Byx@ovCq`@gsDa|@wxAsiAdXafA``Ao}EldGwnHp{F{lAp]idLiwBc~@}o@ebAkbG_y@m^auBdkAa~BvgDgdI`kOmvCv}CyiAvEaa@we@m[}kDrAesE`fA{rFz}B}eEbsHsmCnTo_DeRsjEpKq}Db}A{tDfdC_pClhH_hGxcAmyAtoCmbJ|lKilNz{FkhGbzEa`HfdCadJveAeiKpv@{tB`kFs|Ivo@gxBkAwdAuvA{sEqMmrCbyAs`Rnc@}lBp`Awl@~}FgKrxAug@p~CccCdcJ_qOjcFqaF|p@ukBo_@asBeuDubF|w@y}HbzAeuDea@{xKazAiwEkwE?meBtnAg|B~rCm~C}w@|w@agH|w@k~CkJ_gHicAkwEhcAsgCicAsgCczAeiIcuDyiDmrHq`EyiDwvJxiDit^nyFuoLleBmeB`sCql@`zA}xKzpB_sCviDpl@tgChcAbuD}w@|w@eiIol@m~CjJqtInl@ikJunAcnFqtI_tNba@{xK`sCshN|kEsoLrgCqmK~sNgdL~rCcbK?cvOda@idLyiDoyFunA}_JxpBcbK_sCicAomKvvJwbFj_OmeBj~C{pBpl@azAg|BoeBu{GmeBazAoeBtnAiwE|w@cnFk~CsgCea@kJ{dGj~Ck_ObzAq`E?qtIoeBicAm~CvUmeBkJ{pBg|Ba{L|w@sgCneBmrHwnAomKoyF}w@azA{w@ubFmJipGg|BiwEwiD}w@vUx}HrgC|lP{pB`oQk~C`zAoeBazAazAu{G?wiDql@q`EazAea@wUnyFwnAjJol@_sC?omKvUkwE{pB{`UwiDwnAasCpl@?~rCpl@duD{pBnnVkJvrXazAnzQsgCduDugCjJmeB_`Jg|BwiDwbFicAmyF?yiDea@yiDtnAwUzpB?lrHg|Bql@azAazAm~CoeBcnF{pB_sCxUql@`zA{w@x}HkcAda@k~C?{pB`zAsgCfpG_sCneBg|BkJ{pBy}Hm{@aeRa{HotUcZiwBsSwzLcoA_tEoaAqb@}p@`eB|FvlEvcAveMd@f|FqRbbC_fCx{IeYduBdXfwRm~@dyDkuBj|@qLvgDoXpj@{h@kAkv@ux@_hAkiCwe@u\\_s@pf@kQ~vAfu@rtFveF~vMpp@pqEi|BlfAyaH{SiwBsm@k`BywByhAcpLgeAwvEeoCccAs~BfgCq~A|kG{v@j{GwGvvDdm@xnFaApoB{g@zoBajCrcBmsBvvD_pBsgCsaDwz@ikDvQ{nCdaBscB~xFwdAhc@gv@_m@iIohBziBkgYqBktH_r@imIkgAy_E{kAoN_fAlsBcu@diEyhAzdCsvBjCwhCce@s~BxD}mAf`Am~B|wG}dAhiBolF|gCkgBv~Ba~CpoHm|BzoBqzB~HmhCi}@}|BkbBywA{dBsiAqmKcdAggDknCld@}}C|iAanByrAq`CsqIfMktJu]{jAemAr@mbDxcFyzBvk@u`CwQmmB}iAugBwvEii@bKcbCbhBawFyt@g~@}Yk|AaeCkf@krDs_AqcDkhDyxAogArJ}tBf|@e`AkMaaGojFkfByx@okBhj@quBnoCwnA`jD`GnzBnzIt|AphDzzA{u@~pBifDfl@ekCkg@{{GswCifC}O_s@qXmbDsaIyuBklLa{A{{@acFdbFovBtqAggCpk@ylH_XcmI|YigCes@ciB}uC{dCsvKwyAanDsdGupGehDwfAsbD~hA}_@n{AdHz{B~i@psBfeAncAz_Hh}A`{Ad`CmoAbaCemGpgEu_Bd`Czj@f`AriBhs@`{AlzA~R`zAeb@|o@wnCrcAoh@zaAyIjcEe]|rAc_Bj}@miFxx@qlAhhAg`@tfBmnAt{Ldx@|_@~cDvQ|~@~yE{lFpzFipIr{EcjFf_C}~Bh{AgpFddFyaCfpAu_D|JozCgQ{iBzp@wK~vImtBzvLtHdfJg_AbqC{iCxDg_IgnBsuFccCatBqqForAegTupBalEelAihAquAmf@kxDw`@q|EquAcz@e]my@kaAw`A{sCktAi~NidAe`C_iFmkDeqAuoCcUexFbOsoF}S{bFscCurEgzAubAqiAsIg`A~m@irBxdDyjEl{@swBfqDnh@`tDhhBtrD`eAfmDdYz}DlFjtCag@ziC{tBh}CcxBl~Ae`C|c@giCmOsrCm`AabEseD_rAy_EoNszEtHmuF_TmsBq`Bm{EmPywB`h@yvNmdAsd`@wf@m~Ion@a|FqtAo~EaeC_wAaaE|xC{{GrkKew@xeCur@dqGoaDdkPoBntErhApqExzD|pEtm@xwAz[npCaMryMnXr_G`vA~lFzbGxzLz~AbzEtI~~DqtB`pNgkAxzCcdAhn@_|AtCojDaeA_|AO_|CpyBmz@phEdDrwPgjAbtL|t@b{Qex@pfFmjElbKorAvvEzGpbEdiDrlJpMzbEevAblIa|@re@{cCsdGubAeMquBjtCin@~HelGguFmnBucEq`AioF{BunFhhAabE|sCitDf_@kaB|j@yiKf`BwgFbD_hFjwAy_Gf[cpCoN{lNsi@o|F_}AccD{hDboAseE~lGsk@hhBc^x_DcUpoIug@vxCkkBp~Bs_G}@ouBvv@o{B~xDg{@be@icB}Ey{C{`CgtAa\\kaA~]i}Ah|Bo|@jc@ihAiJs}CeiDuuBqWs`EffEufAqcBuq@_pDgl@_j@ueC|~Bys@mQmfCchHmmE}pRco@}z@iqAdS_rBrfD{a@|kF~R|tF`q@baEp|FznQpgDtbQ`RxzDow@bvDoqBlxCslCdvB}iAw\\_^tIuWxh@yJlrCmsAlwCsVnG}o@fdDayBbsFesF`|@mjFwGojCsgBsgB}uJwsBe{HguBleAa}EtpA_qBzfAmy@Cs~@os@evAsAag@bQ}k@z{@az@{_Ba{A_}H_s@yTokB~pCceATshAu~D|o@w}E`Cy~DeoE}dBasC`x@gu@~dDah@`|JwcBtsFvo@hyGcZh}@mcA``A_nGtfC}hBziCkr@j[wz@ew@eNwnB|~BawJyYw}Eg_AixEm\\s|Dkb@qeAwiA}Ak_BhlDk|@|t@}ZgjBqBsxS{RazAer@sUivCj{@khEvhDex@`qEf|@nnNeh@pwColBpaFccCtnEclBh~AylDorA_gBgbFkpA_wNfFqpMuu@i_MwNwwH"),
+	province("Newfoundland and Labrador", "ssn_Hlo`tIeLteMkfDyaKheC_xG"),
+	province("Newfoundland and Labrador", "afxaH|xiiI|{l@jvVgMpdVqxM}bSepI}fGkyCbkCshJqmLrtE?u_OwwG"),
+	province("Newfoundland and Labrador", "g__bHnzitIbqFsD|lCmdH|v@hdDcwDrhg@cxDqdD"),
+	province("Newfoundland and Labrador", "qrsaHnmdiIcaM}sAdxEs~Brxa@r~B"),
+	province("Newfoundland and Labrador", "sgceHbm}eIlXmnIxiNxxBfbD~sOqwCtaq@a|R~}IdmJ}qp@"),
+	province("Newfoundland and Labrador", "yg|lHpdrlI|iJlcGwfB|_IalLmvL"),
+	province("Newfoundland and Labrador", "_aomHz|bsI|~BalLtmDzzM"),
+	province("Newfoundland and Labrador", "qkxmHpgfrIbpFtyPsaAryIkxIopIxpEufG"),
+	province("Newfoundland and Labrador", "gvmmHpl~mIwyFtHkc@ulPthFaBlt@kfK{jNoiRjmBsyBwwG{qMx_Fa~@xzFvgBpxHxw`@`~Fl{Cf@x{XqhC}rL"),
+	province("Newfoundland and Labrador", "mvqmHv|gjIexQ`_Eue@qtIcxEdxEyyA{mB|rHiiNsmHgi@mnDcpMprPevX"),
+	province("Newfoundland and Labrador", "_{auHxzxqItv@}iJ|tMp|LzhCnnJiwCbxJakLmmC"),
+	province("Newfoundland and Labrador", "orbvHzg|qInuHxyGjhEe~C}bCnxMwtHtaB"),
+	province("Newfoundland and Labrador", "wpaaH`af`Ix~Fw@fcCxbK|jDisEzvUb~NltAzbKti@{~FzeD`tIlq`@vtIltAhmLtoBsgP`}@v_DppEu~Bn_Ex|FttGsoAte^fpJrxIxqPdwLf}EhlFjrWczI|zJ}{I`lAbxIzbWcxIktE`qVz|e@giFdiLczI|sAowRibFqsP{iUxzRza^{mSu_H{pFucNy}F|zVexQg_KwpIagOctEd_C|sA|bIkmH{bApsO`dJtcAvlE{iHgcCfw@l`CzxO~qItbCtgKxob@djb@jgJlm\\wmD`qP_lRsR}wz@wda@aBsjNooDheJitIpk@|WcmM{lHy{GeK~iF{vDydCg_EorOg}DnqUsgKo}E}~Nn\\q`FnfHgic@n{Kd_CxvImtMd`C~fHboG_gHz}G_nDbtQrtEqmLx_FxBbmY|~NikAneHreKiCl}[tvXdnHwtIjiDjmHkiDltAflL~fG|qA|aTo|m@ixe@vql@dgo@afDbpAtaHppE{oAfuTytIexE`jHb_GsnGf{@tgb@z_d@f|KaBj`BtcH}rHvwA`cFrkH?weHtiP_xAnjLlrNqcJtbG|kPdXp`Kz{H`|@bhVzuEedArq@nnJi`EjyI_oEin@|w@llJiiCzU~jGnqCtaH|vc@_wHbeh@o{KjxD{mSeoTevKyviA_{W}hc@ua[k~GupHq|Lk}G_uk@gpIq|RumDmeAvi@|wEmxE}wEitI}~Z{pAdiLhrK|uWjlB|be@egc@}j\\zlf@hh`@}dBldGybKe_CbjDztKstEp|W~eBvxFofN_h@lxE~zE{iCzaF`bQ~jG|bAwiMtmDdxEqm@{~F~jQveBjeFpfU|vK~cNelJ_y@d_CftMwtMgtMwlEnrHnsBjoA?omDlxEz~FgxArmHr~B_xA~mD~wMwbOu~BvfN~`I}bB|`Ib_Gd{Jwm\\yi`@ruV~aeAmfFvlK}|`@emhBbxIruh@efFx|Lo{F}bAutFkrMmtRikAmmOeaNstEb_G|q_@tpN~{Pr|e@g[|`IgyM{yIj}Bx|GgaOxvH||OurBdeFlvF~bCexE`{BftIdxEopEhjAbkCu{CpdEpeDnmPybWti@ntYliDuaAbt`AvZbkDrtEqbKbbAbpF_qJfgNxmLkmHliF`vOobJlqd@pzA~w^ipKt}C~gGdyEh`A|_T_pJtqBgm@vge@jmHniRqtInvSh{FhrEctEnm\\_fHctE`yClvL{kCl|ZrqFtgXkmB|wKxbEzkHqvO{iDavCqdIzoArgPlcShzMcuDfvSdnIztQd~HnidBo_Kdt^yvY}~Ca}AtfGmzVxwNet^mkm@yfOwue@chy@camAudAwxMraHd{PelE{zTqrJuhLwrCucYveChm^ywIrvs@|~N~elBadOczIa~j@eptAnzRzf\\jfK~yFbdKo|KohGq|GoyFrWjtKokFjT_lRgx]weHeo[uyVqhYghGimX_gSir@guHhgOju@kmAwc[zpQ{g[lj@o}b@avCrq^i_Gn}JonP~mDyQkpj@atI{bKvjFzyk@a`Fd`Cf[cwOwiL{iPpsDjlTu}Clx]sbG|cG}zOug@obUw{PwdN{oYlzDyxNpbQumC|r@oaDaiGzyConE{yCzpGsl`@edH`_RaeId~CdxDhtIu~Nf}KmwK|Xq}}Aa_aA{jf@c{Nyvm@{{`@n{@us]yrDqoG|IfrVkiDebCcfJpxZ}tGoel@}pHozQmqTy_Rcb@s~Gw`EjtLskB}zEitI|eX?cjNpoEo`Eg}D{mN}fFjoNq`@}bNqiIapFqvCqmLitI~wAwi@sfK{bGrmHiiN_tMuf{@er`DjW}uLzgIxsIz`BssEbvJbyDr}A{kr@eqM``XawIusS~hBxhBhdDcqFehFuxQb_GsmT?|iLhvCa_GnkF`vCqm@zkNxxG{|MpwOvgGylAdzKtuFss@`A~bIx~FetAw~JnqdA|~BmtAzTnoDgjFdhLpeDte@cpArmHhiHli@ltAetMbtEwi@xm@btE`{BctEnxAx~J|oMeoT`Ck}B{~FltAieEwrTrsA{kOhnEqzGtwRtvAnvAb~LpqEw}DtfK~~Jx~FyzByfCfpEz{BxxBfqD~L?mmDtbGbtEipEx|AhlAflEr__@nk]mf@loCatE_xAotApiHimXqpEjfDbgJtv]`DneIacK|oZji\\?daMpdC}{ItgXjj[wwAtiP`tDyyLzgJpzAtaTlnNn{K~kQ?ftIhbEgaDdjJxvNpsPdpHtjEjc@}|DccKfbV|pHj}MfuYxvCacWlkb@ltMgqy@eky@otHcwPvcI}bGacJlGh^okKmoHncCqcQ{cLsgVobf@~wMqtIvx]viXyvSgob@|`BqiBh_FtdGjq@ycEkqLusGpvCsrQnqO}|UgbO{np@~bImmDhnGl{Dx|Qh|i@xxNzdo@eeAvzFteXrr]|oHfi@c`X{me@ol@ocNzgE~hLmtAccWd_O|bSltA__K`qEnrCvwLrka@bgKnoIqcQoh{@|`HkaSnrCvkLe_CyiTxiHj{Bj_Gk_G}hPetMwcCi_KbqXtmH}{UqfOxwB{oAs~GucSrpAe_ChmLd_OsiDkxUdxQxmXutAunIztEks@ufLk{HvMuqGvwG|mI`_GsiDetMyiNag[xiNgtAitI|bGw~JumDdtAme@i|MzoMsp@leRh|Q~eSgLv_QpwZj}A_jGjtExzBvcCgoDnsIjoLgxMqxe@hiBjeQcxOqvCiO|yEynRawl@_jLtmD`oEivIsyBwvAlvKs~BdnIz`GmvLe}O|fAk`Lo`PpQgoEwuHldYwgBs~LslGtjDg`Em{g@oue@aiBovM`O}~CjeRpsP{iD}bSn_Q~rItcGcxDnzL|kDcfLm|OubBhyByzL{nGh^w~\\ftIbpAkmH}wEriD_fCqnL}dFysDomQ|mConJd_CptImtAcxUdc_@ggsApzG{qFvrI~|OpaK|~C|u@|~IhsDo{FpaFbq@|qB`oo@zzBgaUfoEjl`@vrHytOdxEhv_@?mtQftIn_Od_Cdq`@~eHd{Jc`Gwoa@vx@{yDjfErjCrc@ulCm|IqhHi{A}fRzwCmrGdtAd|Uh_GumDgxAkmHhcDjcFdIxwZjmBmsMziJzd@d_CixIz~Bf|EsmHk|Y``@h`WedNe|Nh|GrzBgm@smSlbCs~GpaEjhCsqFqpQbiCkhD`ePtmy@bcGbxImtAwjo@d_C~bO|~BgvGteXfbpAf|E{iDitIimL?}mPmwKq`W`~@kaGjxIrfKlbGe`C}}ScyOwyLwaUtf@ksEpeD`{F~wAyiHlfLrmTi{By`QotMc}HpiHze@}sAi_GvbOviLuvRklZ{nS_kFxRcqQhoEg|FjhDtbBus@ohF|uEsdFcnS}xP{_BgrIqbJwcC`lR}iQhuDlnCd_CwbCvsBpkGxoR~~DpvN|dXacEvu_@`cQfwAzq@j~MhvU`zd@geC~cg@u_HftN|wJ|bBl{Pq`F`uCkgn@fyFf{n@ffCqzXyuIgu_@fx]|iPwi@nqPltAacKr`MdcBjmGryHimLfyMew@~~J`wCuaIf`QkmHbkBvaDxbQw|PukJg}QbnMx{GvmEirJpxHrmBctEweHpeCinLw~Ic~B_pDrhDzo@yhHitIte@ahC{qFooItqBclUyiN{q@ctKw}Dnl@{wUkyOevG_vSlx@qjTcgVs{YlxKe|OdbBndNlt]vdZxkNxiBf_VnuY`eIm_IrqLduSmJyfJlxExiHwmD}~Nr{HnmP_MqxMvmPd_CkuDmdGrjCurAb`Gb~G_@_iMr~Bnm@sn`@ilp@qph@_hSfxG_jLptOvtAx}@mxJpySnmE"),
+	province("Newfoundland and Labrador", "eut{HhazpIfN`cJscFlv@m~Oo~["),
+	province("Newfoundland and Labrador", "srqmIp_f`J~~Jvm\\om@niYe_TfvLjmAw`IefLe`l@f_W~fG"),
+	province("Newfoundland and Labrador", "wuktIh`xqJ|e@__KraGpeJh~@|f]a}@e_OitE~~Jk_GkiD"),
+	province("Newfoundland and Labrador", "q`auIl}jsJbzCinLx{@hbD_pC|ng@cdLtjJpn@iuO}`BtbGmgAitIkxIw{EdtAqtIn{E`|@`qE}_U"),
+	province("Newfoundland and Labrador", "olawIxzyuJvmDrmHxzBsmHyn@lxb@idPdcf@jpA_n`A"),
+	province("Newfoundland and Labrador", "mlqwIdwutJgxAhxq@lkDz`AmkDtdY{iDte@ltAmih@kiDvbg@otAybKktEjtErr@e~Z|wE_yXfrBotBdcCbxI?c|e@"),
+	province("Newfoundland and Labrador", "g{jxIhestJq~FhwBmxEowRw}DpaE~uAaeOd`I`tCqm@kmH"),
+	province("Newfoundland and Labrador", "sx}yI|`dwJy~FivC{fDyiNewEok@ltAokb@bxIfiLj{BitIjoAnrBbzChbKwcC~tHz_Mz~RaB_cf@va\\dXoPzuKgqT`yd@"),
+	province("Newfoundland and Labrador", "u}l}I|w`wJfA~am@qrEl`I{wOg|]wOegKpdD{}G|sAh_KvxAyiHxiHjxa@"),
+	province("Newfoundland and Labrador", "ihn~IxqoxJpwHmeMzaG~lFbpFhuTaaK`ePa~LiwHcUsfEp~Fje@q~FweH"),
+	province("Newfoundland and Labrador", "}aq_Jj|xxJyvN{oRfhAcrSjmHdmDg_@ilLxbK`tC`|AbcLmtL~rO"),
+	province("Newfoundland and Labrador", "_dw_Jd~~xJamG|`a@wxLomQsc@mrNz~F~|@t_D{qL"),
+	province("Newfoundland and Labrador", "er~kJplbfKxqD{eQvvBumC`uCvpDbgDdz[mrGnoKkiDc_C|sA{wIomDlmDy|M{lI|u@w_JzmHetA"),
+	province("Newfoundland and Labrador", "a~foJ`gphKhiHk|HanEraQsoCwi@gtBuiCcx@ozD{McmD~x@p|@rs@nbCltAvwA"),
+	province("Newfoundland and Labrador", "egq_H|~lmIphHppB}{EhmB"),
+	province("Newfoundland and Labrador", "qng`HnaxiIc{CwlBzmBapE|{FpoEir@~fG"),
+	province("Newfoundland and Labrador", "qqzaH~`fbId~BgyFr{EzfU"),
+	province("Newfoundland and Labrador", "s|qqHpejsIzmFybL}tAvhQymCl`A"),
+	province("Newfoundland and Labrador", "_yhuHdul|IfmBemKbcD|zK{_CtxC"),
+	province("Newfoundland and Labrador", "}bbaI`t{sIrM}{F~rF{My{BxpMisChV"),
+	province("Newfoundland and Labrador", "_zobI|assI~qBw|FjoGlvEmoJlkH"),
+	province("Newfoundland and Labrador", "o`~bIvwvsIe}@k}IrwIamAnn@nzTe~DlV"),
+	province("Newfoundland and Labrador", "ouneIljjtIbfBui^j|DuvCc}@~sJ|jCrbAt|DmqGsp@~fHbgBi`EioFtab@wlFlwC"),
+	province("Newfoundland and Labrador", "_}ufIpsy{Ie{BayVntLneO_gBzkJ"),
+	province("Newfoundland and Labrador", "wijgIntlzI~m@wmSflFbiXsl@|eFy}Hj~A"),
+	province("Newfoundland and Labrador", "g`jjItz{|IzVq|E`rG~gCuwBrkI"),
+	province("Newfoundland and Labrador", "ampkI~pk|IvxEwcHowBbuQ"),
+	province("Newfoundland and Labrador", "_cblI~bw|Iy]wqDxzM_k@kVnkQcjHy{A"),
+	province("Nova Scotia", "mjxwGfoteK~hGazYhlMiqKd_CqqP~bC|bG}d@{bLtxDm_CkzHsaA}u@ws_@~nDygO{e@zpMhtEgtI|`BfaPf]a{v@btE~m\\piH{bQitIgjRdcGntAyfCaxYyfIf{EduFscrArpAl_OtbGccWfkGhxYdhEvbCigCakG|~HbEajGslG~lDgvHidIhp@{bAwbItmDgxYhiHxoE`B{pFwnJyfT|oBvrN_tCm~C{xCgn[{dc@waeAxie@_{EvhNebq@ipLaaKwj@exKj|Yq`]feHgyR`hIveAxhNhvl@j{B_cCnfCalyAp_KmzD_nD~iLzcFsoAvtHztQquChoEatEqpExp@fyGl{J`~@yxH~gFiEn~KhtPfrTveB|{a@ooDjhVhePwgHghAdgc@pvBetA?fiHppEs~Bwi@lxQpqDje@ltAbjTvaDaaKd_Dtyn@lfF|qS|iD?{~A~qIz~MpvIueL|iDvjFhcEeYdtGziD`xArsEexEg`EnxMtuGveHxm@hq\\hpEd_C`gComDfjAdjEkjCznRptDfqJkxJpyAwi@ftIteLmuGf|EluGwpU|zJh{R~bCy~FhxArnHvpJm}IbmCrmT|mHcaBnhSwwF|lD`zAbrFdxEg{F|gF|qCwoVdpj@d_OmeRjxUyzFvwGd}CowAphHlbHzcFqtDo^ltAxePkmHdqHp`F`qDokKut@xnEtlPsnAjyIiiT|sAayMgtIbcKxta@f_WqtImeA|yDj~GdwEsbAjcGywHzFofGjnGzr@fsHtuEdbBctDngNnmPd_Cvi@siDltAxwI`_GwwAw@qqKbvEtfFltAi{R?vtYdxEqm@?utM`pEpvC?_aIjgEjeEhPzmG_xMjxUdiLqmL|qXx{YmnBvcTlnBpzA|qCetMbkH~~Db`AjmZ|pCqhH~iFgNxlLrfb@ftI__KfwGjjIc~HhzMnmLqpEvi@|{C_lLbwJbdLkuEv`E|eBrkBvyEkpM`xIjpSclCxtChlGytItiD`gOzbGi|MjpA`_GleF{sQfrJtyDhhBnbJe{DxzG~_DunCj_NqnLmYxgCluGvhMwnEb`LnsCtmJyjBgtIptUbtQcxI_`JrmHjhPjtEi}R|}Nr_N|cSgeBjxJmgMlmC`eE_\\krAf}D}~ToZvaDxnErmHqm@c|e@`cKx_Fd`Bo_JxuJz}GrtEimMd}Ct|[qiBe|C`jAnmEbhEcmHtqBv{D|oB_~I`wB`sDdgGwhSy`AphC`oFexEb_Cki\\yzBikMfnEmHzgDyjw@wpUc|e@oup@q}@z|R~zc@lxn@}wFgj@uj[wmb@kxUqqh@`uOasDg|@{sKow]}lr@~pTdb|@{{AvgBuvGezEou_@qs~@exn@ckgBiuUwlqAs}Gk}G}uFfbOhdEe|[nbZjiDhhC`aE`_Ge_Ca_GqbKn{F}_OfmJc`M~{KjV_xAsmH__K{e@_mPlgRcdLumOglK}qw@icEitCdfAarKyeDobCym@ymd@lcGitIshIibFedBofMctEh~wAfgGdtMc|Gb}G_gBj}_@dqA|lg@zwE~wMcmHtvc@jeDfyQvpDrvEbuEc`AxeDzbKcmHnoKhfC`yS_gSsiDi~F{~ReqAhpEetMa_c@kiDiTg|Qgxc@siTq|MeqCibPbbArGrxJdsFc_CymLotA`_GcxEmxEiiCvOmv@qx@ydFgyBcvEk_HguEoqC}tAa`B{u@wrBaWmdCdSu`H}UshCu}Aqt@srAg|C"),
+	province("Nova Scotia", "uzkhGr_moKqwC|x@iQ_dJriPnmLa_GtiD"),
+	province("Nova Scotia", "qyikGll}kJqvHqzOtnMbvRhuCrmR`NrtT{bHfwTflCezX"),
+	province("Nova Scotia", "}j|mGfzfsKrd@khD``WpaW"),
+	province("Nova Scotia", "_vbuGhhxrJ~v@syDfaDfjLvoEi^fiFl|Ii~@l~BgrCigCorI`lTegEy|R"),
+	province("Nova Scotia", "uynvGnpumJ|g\\rl|@gh@drNnvMhke@_`Epu`@{_G`fDtkPhxv@}`IlaIrxJr{MeeYdw]alaA`kT_cIy{Ma_ModBkqRsya@}cj@k~d@oyRs{IaeJhnAihBieD|pHvl@kha@miWkpY_te@_jRgqJiaE{mHh~Eu`TkiDwoPbqXxmRh_Gccc@vi^btEhtCptIfxAubGltAlmDr~FatE`dkAjrp@gfTynXa|@o_Jb}Gjq@pl_@fbg@l}I`~b@tu@vaJ}~B_cCqa@biCp}QjgVlkEvnWrzBho@ohAyvU{eQkrWchC}{[tjVjmNdbGt_q@ti@}iDrtEptIstEqiTrtEotMef@t|HjtE_}DhiHxz_@pzLvvGxjB}xKmxKm``@f|EerSpiNx`Es{H}dMlt@ynEomPn\\gxj@o}`Ax{Snoy@ssFnbPivCw^qsr@}z|AhB`nRptZrhg@q_@hkHss\\abb@}jQind@pvO{kNhvO`vO{|B}aHzsEaqFcqLohAgyFadJvmDwiRbpAdpIhzAau[tjFq_A}eAqaWvmPdtM}iDetM`uNbx[vlEmgFmt@}hPleLqfDd`D~x^twExm@bb@`s\\xcEnoDjkC_tN"),
+	province("Nova Scotia", "uouvG~xv|J|kAwjEl~@jvP"),
+	province("Nova Scotia", "{ivwGnvlkJo{@vfMcxDkwO"),
+	province("Northwest Territories", "y}nfLf{lzXwbCi}A`~@ive@hjBuiEd_C|iDz`AixM|vF~yE"),
+	province("Northwest Territories", "ultgL|ampXaq@`bPk_G_qU~bCmiP"),
+	province("Northwest Territories", "ultgLxinzXicEti@ksF{tLkc@gbTlsMbxO"),
+	province("Northwest Territories", "ergkLbbuyVz~F|b_@anFmsF"),
+	province("Northwest Territories", "_zclLnsuqTxfCoxi@vj@fki@ayAbpG"),
+	province("Northwest Territories", "ckflLlwhjTbpAq|u@deA`jtAkxIlyh@"),
+	province("Northwest Territories", "s}gmLh}`gUliDicQkr@vnb@okEzlN"),
+	province("Northwest Territories", "csrmLjurdUdtAk|Y~wA`kgA"),
+	province("Northwest Territories", "sqxmLj~hbUbt@uuLtjDlkKia@rbc@"),
+	province("Northwest Territories", "oovmLvvmiU`_@ahZh{AgkFjt@pqDFng|@_qDdaO_cCirK"),
+	province("Northwest Territories", "ckmcLpep~X`aFezdA~tM}|a@z{Bq}b@`nMwfTd_Cs|q@on\\ru_AssEj{Cvi@}b_@e`Cxxk@ksEdiJcpBsiCtZ`gr@a_GdtY_xA}~NgoI|dL}iEsbFpbJo~b@anFc_p@~xAtja@sqGfhHstEnpa@ccXbmHkwDwhMbwWozz@xbDwr`@gpI~{g@ucTheh@{kH|HocC_l]nwRulUiaQb}GeoC{pGj}NusmA_dIfhMamRduGkvK{dXznByfHy~F}{y@noJon_AmnIkuJu~BlkOngGp_GghFzjIgnF_cCti@a}Rc{Bb{BeeMavOiuPnvMjWqm^teG`tErr@_wBsr@_rTtmHetMj|IzeQa|Ae}IpiHexKutAqvUziDxiH`vBk{Y}uFoqU_j@xeD}oBsoB|zCw_JzjOr`L~|V~ot@|cHdt@d}CrvVniRfbO`zBzr@mXccFiaRydUuxEubq@avIksK|Lkd^oy@c}BmtAjxE_mEqsKkF}bnAstE_lb@ugGdXazFeqN__Rjc@gcHkgP`yAwaaBilFodIfrCviXyfC{dEk{CpxB`rEfoa@ymLkkNybEw|s@pmFzhg@?sqd@~bC|iDjhDybb@owFurFeqHwzu@atJ{qLgyFegXk{Be~_@kvKqkGvpDgw_@|bTwdIa_GmxE?lxEwbCe_Csb@qfOsiVkwO}pB_|L?dxQ_xM{mXvjFny@imKaeb@veGpvCdfAquCyxBgpJynFyQq}F}l[~LunIxhCb}MrkHmqBe`BmfFcf@h_GqkAaxBnOmoOcwDexEzsJokQr~B~eHs~Bkxa@bmIbgD{wJciPzsEv@qtCch[lkDrqFcE}zJ{oSotZwbCx~FseLkt]xiH}~BpeD__KlmDz~Fd_C{wa@bvDduGpgDibE}|Dq`KriIbtEpvCjxUltAybKrsFhlSvng@xbtD`{MhoKl{Dvwe@`}L`dJxzFvlQiqElkPesGbnBflFxby@lmDmtA?ru`A~eGg]{tE|vQfnEvwj@ftIppQrjBkeE|oCpqJfbCwuAti@rmHvmDacKpxCx~E~oIbkl@tkJigCaaKpcKti@piHd_I?hiB~_IpxMteAqmX~eHjtE~fk@hoWnuYrnBwuMzcFzbLdhQyo@o~JheJoiF}~BhdDthQihBpgNbtEp|RtqH`Bd_CneHt~Bc_GjtEziD`jH|{U}iDhfH}rBsnF|rBb}SlxE{bGtbBjgK~_IwzFpzMken@tbC}bw@kyD~hk@czIv`UjV{oSouBr|`@}|HzgJ{~Bqq\\jvJmfXk{BuvLpgC_dTy}FnzEgsB_sCldMg|h@qzBayAm`CvjEcc@soFhdEokj@cyJomPwqI`p]jqAruD~bCutQse@frYhnGd}IgNfeTmxEerMqgCh|SkxDkd@~|@ilY}kJt_a@meH|u@}dA{gEsuD~}E}rB_ff@kxI{ta@cvPgkLppQtaB|yDpoFcbFw{QmnOi}G~oDabEr`Ab}HzoAc}GmeFceRjtKltAw~PivIkqAwde@ypJqtO``EvlE`QgmEarUgf_@ptCloZmvEviFjRxdOe~BgxK_@wqr@g|EhdDilAkyJ~tBywYxhCkmHlxE`bKb_Gui@r~BhxIzQ}nCufFcsRavHe`C~JksEipEd_Cre@kmHi|Gq{ArxOiiMg{P|zDe[yfC|qSq~FbsR`uf@stQ{mp@{~R?s{OrtQlyK_tZlvQgNlxEtxEa_@evFaaWssFwdNpqW{iDafPdx]c|MntYnmP}bGaiNwdTgxLilGpeD{gEmqBdsBasEt_Tee@ntOt}TtxD_qDi{LmxKi`@o_Jxab@fnWt{JoxA`a@klOssFvcD{bRmw@ayMez[itDnoDu~Hca_@auDa|Axm@tfS{qXycz@oiFbVwmJ_aUcpA|bGymXkhvCabEsd}AkvVuqe@rjCqaLk~AdiExsJ`jCxgBxwGloHu{CrpAh`n@hxIc_GxjMvaCvbCwlh@a`@qfCgvGxiHgaDeb_@otTwrf@sfQ_tw@yhYbuJuiEgmEwnJodk@_pEcksBk~Lh_dBwgMxjN~rBey\\{}G_eInwAxbt@{aLrmBknH}iEq~Qcq^pnc@_vkBl{\\oh{@~in@}dp@bxEsmTf~CphBz_BooCx`FvgFdsXaiSzgn@kdxAxvTg{z@|~Bsmp@zoMqem@qoEakNg_K|mPvmDkgy@mxEmtGotAxeDyzB_tIdi@pu`@wcD|eGzjIlq}@cxIi_G?wbO_jFgfBdcAifa@{yCxo@nkF}~e@g_Khta@stE}sMmiDfxi@eLidIo`DlhEgpI{mH?otYu_Jyi_@zmBsbRhgOonEsuKemUwiFpiSmrBfh|@_zFbbGe_HeuRjxImgAxOakSq~K}fF{oLm}Ts~Hmsv@okFptIdcIpyNijB`sPidDefGr`@t{a@~lFzuV`uCggAroAxzSwjFqxCuyDfdCwbOmqd@xl@u|DlkEdYb~AzwJx_AudGunJwk`AvbJy|LhQwrI}pBuqB?lxEwsCssEnz@mrTwnD~}DjUhzTszDsjI{_Ax`PxtCto[u~BaxI}tAnmL}wEa|e@zc@ir[b~AqvCptDjvJtwFszNd}H|kDh}@hvU~wAumPxeD`cWfxAmrSn|E`zAzT`cJ~`J}cSliKvaPtxJb`BpmFchb@w~Dsap@leHi{FtmH|i\\d_ObxItdNx}c@prJohA|e@fgS``G}dR~rB_qr@a{GqlSrmHyiHexEltAaxCefFfxGkl}@qtIozu@alA`uIyzHjc@grC}eu@soMaaF{`G`tEyqJmiKgjLtqAydDzoG_jLshg@bpGmza@eiFyeDd|Dqur@wfBu|uA~bIexuBt~ZaexBx`L{uWl~kH?juAgQd]yr@xZ}{DfqeNa~sp@`a@inEiY}_TiDii{HHmjFt\\ebF~rAsaF|lpBecyE~dD{wKtjKaam@nrEshn@|qEqr~Ahz~Acf_e@be@spYnn@ueOlmAenFnnRkeAxmHwFjbl@nuBdrnVuW?jfddCuzCltBirAfhDaLx_EhlB|kJhh@|zFqU|`EgiClAueDktC_{AkoB_}AeSc`Fb`DepBvk@ys@ns@ytFr`KchBzsBcaKrjGm~HlyHskCvmAccCz]}eJcj@s{Dj|@weBfsDic@|hFtWztJ|@nnMu]~b@kvE`g@mnBng@{hEdjCu|LxwVgeG~|FcaFmiBieDkbLihBecEmbDa~CylAv[ubFzqFwnBpiAyhGhjAmdGz}Bw~C|gEzaAvuKm[fzHpV~nEsElxA_mAjrGrj@xjC~yEf|FfgCpk@xlCyd@~pByCtr@`nCk]jhXzw@xdM{GfqKlOr}Bhe@|hBxdDvyGfeBtxFvnAl_Gxc@xgGyYppG}~EpaZs[|}GyDljOkf@|sFm~A~wF{rEjyL_cAjlFoChhFn}@rfFpdBdnCzsCp`B|jEr}Ant@fiCgMryF_w@vuFohAf~Bmp@qAmhByn@go@jG_i@hxArI`pNjHrxBb`@xfBdgAxi@p`Aqa@tqA{tBxbBrIxkBnuCpv@pkG~S`kNaR~xGu\\ziCsgCngHst@noDlPdmE~kAlwDpuHr}OnXp~AoN|sBkp@lcBwzBvgDyc@liBgKzuBrc@fvDfq@daBtfAdThT`oAuf@voB{_CljE{`Abo@a|AnNolBgiAir@}JswBp{EorAb[ybBgRyiEvLqxC{tByj@i@iuIfqE}lJfLec@|y@aWtjCrPhlCjoChdLagA`oC_fBpsBa}@xOwy@u|@_rAqnH}j@czAufBclBmt@fUci@dbA}dAxXwsCmbCg`AeNkwQ`nDmoBl_AojCb}Ba{@hTifDym@mz@tHex@daAwz@zrEis@`qA{aAz`@uxExYmlCh~Acy@~kCwpAjjIqqDzsJga@feEr}A|uMnDzuG}i@loU}gCrnGgb@tuGwdAdoEec@bj@kuB~`AauAtaB_h@foC_^|bHm{@vvEgcBrkEw~Bf|DwnC|gDgt@`eBa]b~Fm_AjiD_aC|xBigAn]e`DiS}mA~Cq|@da@kvMfwMox@fgCtDx{DfjAheKsjA|`D{iA|o@abFhj@{gApk@cpDn~DunDfeIm_BfcImu@j|@i}@}AylAobAa|@pMi~@tzAsgCthLezBhyFqv@dmA{iChrB}o@naA}KdiR~TruFr|AfpFrcAptAbqEx|CfWtfBuhA`qL_]`q@ouDxw@cdAbdAgUriBlE|pEySbmBuqAppEmUx_Fj\\`_JvnAltFbQt{Bk^lsCg|CdiK{a@fhCuH`pH}t@tg@icAscAqnCgbLmzAcxAg`@~Mux@`nDu~C~_CgzAnwC}pDt`A_kDdfCkpB{gAueBehDcgCevI{r@xwBigA~fHs~@hbBc_Bvf@sm@}hC}t@k]guA}@mZwp@}_@stLod@q_C{`Aol@qnDf}CoeBbnHkqAtiPybAr|DamE`sIk|@xjElrBbfKva@lkF}tArhBmoAi}AuzAikJu`CaIgp@bxB{k@nh@afAdTciAgl@uc@nDkfAtyEclBxvBfEb_Gy]zjAcmCtHccC~kCih@s~Aob@xTgsCdiEwsDvrCe|@bjAg_BlzFekAnzBwiB|u@{fBkq@ak@mgKe|@j{Agl@zVueCmnAeuB_Ie`D{}EqgDel@obAymAgsCqqGm{@mn@a~CbwAmUfgGriAnuNeNt|Bo`CjsI_ZbqEul@bYadBktAs`AxkB_jJxtFssAxrAqk@hlAqMlzAdI`aFkMfv@{pFdhFuaB`zB{p@zrC`AntEvk@jbGqGz|@k\\ng@qxD{v@gtBchAiuCcmK_nA{lQsj@swC_qAenDg`AakAiiA_JghB~l@isA|KupAc`@kaBuzA}y@uzC_^i{Dqu@ifB{~EhyDouHvvEqr@|cBu\\zaFsi@paBoc@dGapBk`@}}@vZyo@bwBs@vdPiXd_DkW|^a|Aer@iqAd|Bc[`Fyx@}gAcoAyx@e{@pu@c`@jkBr@lgBlkEfiQjRvwDoXtdB_rAro@k|@`hEuw@j|@wjAmPwzAukB{k@kuBcY_vJyO{`@afA_YiUc`AhTgzF{KeeDkx@_vC_aBy|@ifCneB_h@|tA`BjfAdq@xvC|AvpF_yFrf^koBnbF{}DhvE{w@dfB}sBpbIwbBjoDsAbxAh_AxwCaIrmAcbBlqEkpB|}NquAhnGifCfwDa`Bhd@wtAolBycAgk@efAbd@__@pjByCx`Cn|@nsHnDrrBiy@zGa`Bqm@e`AlP_`FxjLiaBtwAccCgHcwBy_D_iAwkEsy@oy@aoBb{Jy}@j}@unDjqAqnBpsB_o@xoDim@|bJuqBeeCavAy`D}rAk{@egBdiDefBnyFqjBgRcvBrzEcwDbjGkb@ntEyS~fMcnB~mNqtAtxEuSrmB`]`sBhh@dr@bbD~_@vyA|}@n^dkBbUjbF~tBrlDwFpaGn^vsBz`Br_Ev@f_CkzBb~GkWbhB~I|}GrrAzwMgAz{Amz@rm@avFq}Be~DytEwkEauJgbEyzDo`DbxI_d@tmF|FtiJ_Yf`BwmChxGwnBdqGgp@liAw|D~_DevCp{F_pCxlBcxAf_Ccz@rnSylAl_GmuFnxNubAbyF__@v_GiN||Jcn@rzFqBdzBlsArhIXh|BcZvsAahDrxBosAbfD}h@f\\urAi_AoqAkwD_bBcdNi~AqdCs{CfG_oFb`EacCid@}s@w~Acz@opJeh@{l@yhByl@sr@sz@gg@}`KsbA_nAoiFlxBsiA~gBeXbxBvVnsKsK~vCor@`jHugAraDy`BoXsrBk}Ai}Bqk@igAlu@ugA~pB_t@daC{KjeBxuBn~EhPjpBuIhtExUhwArhA|B{C~iCem@|rDueAvfDonAvdBsS}eK}_@w`AglAta@s]bhBaRrgEcz@dkAuSs~@xDwgJ_^aqA{hCwrCysBozDa[mhBwBc}Iu\\swBakGmEq~CirCywBsnEwkDuzKolBc`JauBqkBbAseDiYqq@kkCiN_`C{v@y{DgcEu{@_X}gCxCyo@cxAkk@{cE{|@c{CovA_pCycAc{@ex@`RceDvxDypDvxAs_AvoAufAjcEyx@~wAuxDbbB}cIt}Ma~BhhGw`BvuGwwBvnNuh@npB{aAnwAwdA`MgiAal@{vB}bC_fBczDgiD}dTybAekJcc@qkAwv@yMyhAvOcbCnbBir@v{F`yBrvPebAnnFs{CxqAit@jw@ap@nuB{N|cCpl@jiKxm@baCf}E|vJxmA`tIrfB~`ChvCl[hd@xd@bt@ruD|_@rvJzDbpEaW~hE_iAnaD_aJisMcqCihA_kCrqAeYtyEbf@dhM{Mz_IxNrgH`pB||QnuBxeJeCjqHzl@rxAteBjk@fdBf|EhOpfFelAjvMbFbuMmf@dcFwqCfyBgxD{b@{dCa_BssAoJ}d@pwEyC|uEi[`oByaAdy@qqAsUwxAogA{`Cm}CgtBw|K_{Ae_EwbDcx@yjDnkBstAvFguF{kAwgE~{Bg`AdrHtg@`}WiJ`kF_hA~|BumD}mBqyBq`CswC{zAsvIaf@_bBejDhkB}wNo{@ciCegBtm@y~Ep`HghCby@ccBevBirCafMu|@gu@e|@|sBohAdcLgk@t_BwlDfeBsy@p_Bw`@pwEkf@j`B{|@tg@avAg}Bmz@gg@y`BdgBi{D~cKywAmPu{Aw`Fw{@eaBadBcjAmnBcYs_Axh@kdClmHohExqCufB|_GefB|t@_hB~nEcfEbx[wo@t`BihAjSq`Aa`AccA{~ElOc}U{VksIgiBgeGs@nhfMqMl_@wiAb`AgoB`q@caCx~Dco@t^}gDu^gsBzr@kr@cKymA_hBs}A}N_|Bh_EcjAtR}y@}j@qj@ihAo{D{uNqz@uw@}cGuv@cdCs{CylAhTg}DneDgyBpnA}Y}}AirAyh@sjAl|CwsBvsJaa@pAcfE_|BekHym@obAafBec@tSol@pdBu]~X}eG~r@cyApv@spC|qCupBvyC{kAlqDylBvlXem@toB{a@rU{~DmK{uAx|AkoB`]_ifFRx`@kpD"),
+	province("Northwest Territories", "_~gnLhytmWpiH_n\\fkFblIguCpjS|lDzC`fD`_S"),
+	province("Northwest Territories", "knazLdlnaTd_CexErF|uLoqDdlI"),
+	province("Northwest Territories", "cnmzLxyh`Tni@mxE`~AtoCmw@|`T"),
+	province("Northwest Territories", "wkb{L~bu~SbzBdji@q`FcwQs{GpsJc{Cziy@cnYz}j@yvCazHhuCgvgC`zk@?"),
+	province("Northwest Territories", "ovlzL|kw~Sp^`j^kvDbbMmtAacWwjFhfDfgBizd@pfO?"),
+	province("Northwest Territories", "umqyLxks~SukJdde@t`H}ml@biDB"),
+	province("Northwest Territories", "e_fhLpashUoxQzu_Aqpc@dfXqmFd{JocJ}kOgcOcwkAeyPkbuDqpQmohHaBml~@rvEgq[vtBmhlAocCik_@heIwl}AihCqrDjlIc|hA{s@kuKetA`cKgoCe_Jt`BqmWgj@mmJk|Cx`E}`Bkem@_k@b`YesAxlFufAkkHh|Gwd~@ifDs`_ByfCvb[{zB}bSk~BptDcfDb|yAkgW~jd@im@dxm@{iDttGpxBz|N{zHzqc@qCxqVxeD|~BouMbwm@zr@d_P_kF~vA__Frxx@`}FlkJuoH~{]awC~ns@rmH|_e@_tBx}^leG|c^dxKf}lE{iDhz_@biDhn{@_uBxa\\}oBngBkWufFczB`}KvgFhorCi@~waAwaD`oGzfDfna@crEbw[ifDnlFokE{fDirEh`Kae\\|maB}wQtrYyhHsxCg}[{|qBcsLeknDc|H}hQggMm~gBkV}maByxBi~EesFpc|AmqBc`BW{yZw{Kuuz@v`Dhm@cqAwuYb_Hgyd@_hMeweA~|Ez}gAwcCtvWumDs~Roz@z`gAhm^p~pHyl@rgDshJ{cFcVjtQmtAuiD{~A~sBotBh_Rb}HrfJrdEqfZveH~iLg|Dnna@v|Dx~LqwBnsuAsqFnyKwjFqBejE{yIiaFaoW~{@mcbA_|@obDc{Bd_Oo@gt_@uhKn`y@mbHqot@bTjliAv~K|bXfuB|vj@_zGw~DooD|hKq|@drgAvgGltMriDy~Fj_AvdC}pGlu^ky[||Oaf[w_KwfYad_AukPkpLerS}ynBaaKpwBisD~tHks@lup@{`G~tYi}F|lD{}NovNetXk`bAysJi`z@g{Qaat@ujVwek@guH_t{A{bQmyy@_tZgzfEkub@gr_EtpHyi}@zdWgjn@j_MaqKftZdmO~nKsoBr}GrhEtoNgxMngBxxq@deFrsQreKyObVaxO|~NfqdA|vFejJtv@{ec@geNkyl@k}Cqbn@vfCckO{fKoax@|iPz~j@`FkpM{aMq|i@_yGciDqeCv~De_Dnk^wdYqrh@{}Nqwe@}lCata@hdUannCd[qtf@~{PspeBxcJmwQb|Ciem@z{HmbAj~SxuNzxIrzf@hwBkxI`yHzsWxiMvb~@dbCxgBtmCqfOo}Ewu^v|EjFfeCv|J|nNrbLtcB}xEorO}hPitIcco@jSx}]_sCtiEyxAyyBelPunx@fl@qpPjxI|b_@te@siPxiHjtQclBaxYtzO}ih@q_W|kJgfGqzSsrA|nCecB{fEvO{ub@qiHjmH}oIwwXb_Ceui@`oKncTraLotq@u`Ntaf@swIy`]~lVm`~@yiBgvGmHoy@ra_NgV?`hgN|nPi@qb@znOfMbuGrgA`}DjqA{nXsj@e{NnEgyBbe@olB`q@keAtf@sT~^h^xkB|nHrJbsAxDvxTs}@hlIjRvzBlmCbOvE||j@yYff@ymAfL_hZgM_s@tX}PftAqEtdxXhbUt{B`yb@omfAbbImdUlnCmwDyYvnJaGptSqjAl_Jah@fTme@tqA"),
+	province("Northwest Territories", "idt_Mvc{~TdnDiRj|HnxYlcMbyx@|xQ`whCxld@nuoCvvAddd@~se@zqdD`oRpmv@|pB``o@jlT~ilAlyPjoB`fPrbW~zKpvC`yA{yCtpC~{EbcWh|yA_jFzuvAf`Jq|LpyMu}Cj`_@n{t@n{EfqC|bHcpLz~XppEhfJ{sFf_\\x~XnbIdkVnuTp{xC_cC`cc@}rB{gKeiEneCmaB|bY|oe@`oqAdvQd}aBt_Phz_@zRn_z@k}G~i]oaVrfb@evh@|tc@_uNhac@_sTrsXggw@xn{FygC}~f@d_CqsJal@mkDogBdsBfOrlrDe{J{pY_lLqdC}~BftMyqEamGmlDstb@mqBf`PueFjlCgfHozc@eiEyR~hAfxGaxC_jAkmNajXotAriHui@etQgeB|}ByxHynKk}Mwqm@eXwcTokFltAciDntY_jLst]?ltMwmJerGktK||Hy~F`xOb`Acs^yyM}z{AysCd_IkiDshDkcGxaWfiBdbSs|NbbY}`IeeM_nD}~Z_tCbhD}i\\_nb@g_EivOuoIf~DgrHeiVcf@ubYm{I_cUytI{iD}kJvnEcmHskl@oqD~j@q_F}qMghMphTy{Fhp_AmrGu`BczJlv]kyIpsDmxEd_Oy~JafDuhX~xScaAv}[qlFswPgOt~[}nBh_FwxAidEeuR}rzGmtAc{cCybQ_rjEx}GoqvAfvFarVtcH{sEpf[_j_DnhGo_y@p`@usaAle@nb[byDi{AjwD~iFv~Jx}XtpHdXj|BwaJkdAozE}|H`hBmfFypWu|PgrZxiBap`AvvG}`Hf}EjdF|u@{hJzvDan@tmC~vL~vMnuHu}C}jm@}jOqt[wbDwz@m~HxbP}eG_y]suJkx_CjlHkz}Bzh[gijBjydAakwDn}Jujz@"),
+	province("Northwest Territories", "iemnMfloaVltAoiX~|PjtE`cKjiPpsDdvRo`Djif@}kKw{s@u`HayCioFx|G"),
+	province("Northwest Territories", "}ofnMry{mUx~FhtIntA_cCftIxbWpgIcf@jeV~m`AfxAwiLzbRbkoA{bRphzCwpJ}_NutRglaAij^ylgAqxHcgvAejKgaPa{Miiw@_xAocZ~iFuoe@bnT|vV"),
+	province("Northwest Territories", "}uppMnah_TivCbfm@c_GkxIyfCrmHseLvi@sxI}qhA?sRjvq@?"),
+	province("Northwest Territories", "}ofnMf`v~Sq|Ae}HtkE?"),
+	province("Northwest Territories", "y`vgMrxu~SmoClup@rxC|bSr~BexQjaA`vDx_@l|}@v{K}`Nz{BllDrzf@xgjE`cPdiwCtv@x`xCgxFvd{Am|V|y|AkwP`gq@_`Dlw@y`E_wGujVur|BmgAcjgB_fBsyCe_C`xYq}R}|fBqdCevkFg{F_vS}sAdxQ{iDyfw@e_C`xMymLkq`A}sAz~FecGom\\gwBlkEc|Axdx@lnNbcX~sC~fp@|sA{fK?hxUy~FvtMnrIfe`@`|AhpqAwoDf{WetGww^yiBpwNgxAitIe_C~gLfpCf`Jv|Eu{CbXf|\\kzCrmH}cGajGcxDziOtpMbcKzyCixG~kFjT|iEvx]xlAlqe@qfIhiObcKviLrwDbzcCwdBvzLksLwqDmaHclv@nm@tmx@{mZ{uWkqRmt~A}sAvm\\vnKrbmAewRrle@~{KrnB~gG}eG|qZhd\\u|Plr|@pqEnhSrzCy}c@r}Mg}VpfUrzYnwRjnwBa~Eb_Zg|KruDo`EobIujOvmPzrEdeAlaHdvX{{Gd}q@nlEgvM`xMopIduGveHt{CjrXqqP`qhBgjLomh@fbD`vr@kpAlzDmcHejPepHvi@naDbmw@|iJ~|V~]~jRclNvapC}lJ|ya@{~GmfFmt@{eQqjC|rBaeCckC_~VqwcAeX_saFucSeiwAmtAcz~@m~Cl`CciCarDurHwjp@`hIdyfCdhLhtpAfMvmrEypEz{Hqcb@w_fAyzGozrE{iDqnFziDi~b@_xGasqCmmCa|A{t@xyG~iG`teGycExvaBykFfoDqqFgnVoLmf]}wExmLmtAatEmtAvbg@wrNq|LewFgiScpGa_fA|wFiccHcrAdf{@ktJvjz@o@~t`BwtHby[g|EmtMkfD~~Jy|SgimA}mCyz~Ac`Gob\\v~JkhjCxmL_jLbtEdjP`oReae@nhFjkNtmDusRovMg{uB_l@}ieAviLkxm@jtEcg_AnvHrpRdvLk{SvdSqz}Bl}DohGbaGbqbA~|Jjc]{iDkqd@lxEsvOmxE{t~AziDztE?tcSv_DszYzlCigD~sCvgBh{@{kNntIz~FdzCctEtgFbtErbGitUxeDziDf`Di|Lm`I{~nC`pEapQ_]w`pAfdfC?`[fdB"),
+	province("Northwest Territories", "}cosMlb|{Tqm@rbGstE{zBcwJyjk@ugFolxCzeKaq`ArgKqbJzoL`|i@"),
+	province("Northwest Territories", "kxapMhegwUmkK`wZ{aRbfD~zF~nPwyA|oZnkF__K||DhxIbrQ}o[xo@laZj_Gwm\\~l@xmp@diKkcv@daB{dE`bK{b@}_Crd\\l_IlgLziDoxQg]zpQrfKxl^veBtlb@{zIjwU|xKlhRvaDp}Wg{FvyRo~Ow{EymFkvKw|@n~JivCwwA_x@byCpqDbpYqwBhbPupHroHqgHygO?b_Sa_GomPkfJfrf@jcK~p[|aN~vAnkFitItxKqjBxo@dzJo|EeyD{mB`wHjTzhOdxEtoZhtI`_Ge_N|be@agJjxcAbX~ju@hn@`xCbxI_xA`_Glxm@e[hqn@{bFd_UkmHmm`@je@b|Yy~Fwq`@y}FqlG}qA`jj@emDanh@g_EvbUz{Bzmj@pdJn}Q|u@noUqmLymXe_CrtEmeA{zBdh@mhKivI}zDhb@opVa_Gk~MmmDj~AyiGmiEe|Juxh@erG_bmDozK}bSgzFn\\aaLsfc@{aLi}FblBkcRsyCgd`@cgVgvjB_xAd_C}sAe_CcfD~~JypEiif@auDyqJcjCdh@_iA{}NewFoNqyA_i^{hD}s@koBqvOioEo{@ejQ{ztAuxQcaRyrD}jQ_Lixk@saG}m[xCaj|Bu{Daw~@ptD~bOsaAmoYzdEksLf[wxp@pxHion@gk@ind@uv@cuDufEjzIwaDnbThlG_meAylAq~EetAppEqiHk__@}w@`xbAykLffZaSure@meG}|a@unCnb[yaDsn_B`xAd_Cb_CueLz~Fyux@nxAf_KxjAkrFd}Dkq{@byNie_Ax~FkxIjtQfpgBneBhk}@`iHhtItcA|gRt}CmrT|uLoiLzdQcs~A|`I~da@exEzqxAxqP}gd@zrAiiYxjX{w[jxU`ksAzwDjldCljEtrLpdJ}xPteGdh@rrK}mJtxKrmf@n~Dtei@gjAlwi@}lC|kPuhElaBcW~nb@orNncf@{~B{iDixAb_Cc_Cg_Ke_Cb_G_uB_bC_pD{_S}wExiHmtAyiH}~BpmLk_GgxMgxHjnBw~Oqrb@qkGthXd}Ibh[eXrh_AfoDcvErdElv@im@xrb@dxEc_CdvFq~W~nPqjG`|@faa@zaG`wIkzIlnx@vaDu`Ir`Aj|_@t}Dl{Dz|Gg_z@vxRmqSfqIncO|bBxmo@xzGlgFnyGgsH|~BpxMvoD^fy@rqQ`mFbdFmzCpe\\y`FrgJ"),
+	province("Northwest Territories", "gigzMdj{tTlaIyiTb}Gbl[t|Ix|oBcoG~r}@sUupSelCxgC}na@pipB_pCyb~@uaIepUm`HsycA~sB}tGziDdiL`zGkeQscA}}Zz|Nq{^hjBuxh@"),
+	province("Northwest Territories", "q~qzMpqc_T|gLthwC~~Oo{b@q}@kpvB~rCkhDjvJ|yDpkHsrLdsGv}VtpBc~LbhDjTz{H`iZv|Pd`}B_vBlemAtsSvv`DgwGlieAegQxy_@qCntw@c`HrsKyfBnng@gtIjcGc_Gar\\kmHdc_@qiCwgHa}Fh{Bka@_}FseFbjVabK|g@_dIuhRwwA|mDslBcqRrzCkd^ctEmsLyrVglsEt|CmpNkfKmbzAwi@eys@vnDsv[gzEw`\\f[ihiClx_@?"),
+	province("Northwest Territories", "gfy|Mthl`TquDhebB_vMhk|@t_C`k^xpEwtM|g@dn`@fdC|~BffB}iDrkBrvDlZ~gbAeyKndYubGbkr@|bSj`lDkaAhnc@e`IhdP}iDgpUwjG}g@g|Pc}fBenJ}{fBxq@sf`A{gJeilAajBsnG{~B~~JeYsdWszHch\\_pJsuqAks@yi|@hlManlA`b@aeD|{dA?"),
+	province("Northwest Territories", "uqfeLr{j~XlkPmdg@g_Bvbi@qbAwuGexIdwL"),
+	province("Northwest Territories", "u|fgLdah{X`xJ}rT_mEp~n@"),
+	province("Prince Edward Island", "k_rzGbdmyJdbBieDfpPlwc@bmAhbKsaF~~ItgFs~@ucBbxOxiHmmD?fmLddGeyEiD|rXbiDghM`sD|sAemCfdZzyI}oN{iDp~Rd_CsiDti@txEdtAoxMdcGbaAti@ozKumDzbGsS_`EpxGonEdsGhxBf_EnkRpm@atUrlIfmPvbCfcg@itId_[kpAc_CewEl|Jg@_gHgtB`gC`_@zvVkvEwaUevEm`Ik{BxjAlkDbrL}wKkCnwAzxHccFjiVojSi`]z~N|m`@qtEn~JluGgbC~}D|wQ{F{dXbjItzPyhNnfq@nj@`xTwmU~se@wjGrc@d_CqqPwmDdqHgxAumDyvBjrlA{cXacD}~Cc_HebMrbAdtGdaMmiFpvOxm@tx\\kvI`rEkaj@mfc@c`_@awl@jz`@{iCpgU`cVlxEqbWnmD|sAzoMi|RdyEt|CszCwbId_CocCtdYh|Ry~FumTlmJqR~eByeUy~Kl}Ci{A{fEym@dmDmzE{kIreFiqb@tsFxOrxCc~FuzD}kDev@luEnm@cqXlzP_lQgsCcnGef@ikbAjjC_aDasEoqJvvBg}a@{iDbxU_cCcxU"),
+}
+
+// countries are outlines of countries outside the US and Canada with active repeater networks
+var countries = []region{
+	country("Chile", "zmhjCzxbyKpza@qcb@bbEza@de|Dnp}@`yDblCr|C~vHtxEjcR|}f@xs`BpsP`wl@`fA`oBf}EbyArvIngFncAxnAtH~oBiu@jyExy@lnBxmNfyF`uHfuAod@veAaxCzuBvQptAz`A~q@jhKdmB`nQ`~HfgCve@riBoB|lIwmCr~A_tA~oB_cE|@s}BfVqaAjs@af@nwA{l@tzA~SdmA}}A~yCafB|dHle@|zD_oCvcDadEn`D_fCh|BoChrAnmAjq@|{BpWteCuS~kIf`AzuBblDvnCznp@|pPpgFzW~bF}j@l_G_bFnaCkgA|}AfuAz`Cox@jsIs@xvCuoBhqBmxBjpCoxAvaDyw@h}NsjAneq@apLn{Hmq@~yEzbAhpP~sWbvEntDvvEfkAz|F~g@pjNvGl}Hmp@zgG{jBppFcaD`zu@mwj@lmDk{AzqDiShjMzgDhwGlGeB|cHxlB~vG`\\xaCfKv~Htr@psC~rA~fCpiBbmBfiCrhA~zBve@~rAp`A`QjzCmyAdqFyvCtoEq}Bn~E}Kn|G~q@|dFdn@tqAdaBzzAbeKxcG`oC`w@pcJqAteBjVrqRnmHftA|dAdkA|bBxlD~`IhgAjl@voAjRhfDoYbvCbPrgDvz@hmHb}CjxD~dExbAli@`eBnDh{CoqAvzAeYxn@|_@hqB|fFzkAfe@h`d@foHpiDjjBdjBt_DxxAhqJtg@lbBh~@`kAt|At|@phCkGnbBdvB`fN`bFbaOdkIyXv~AmnAv~Bcp@r|Bf`ArxA|cI~aAdjBjv@ryHnnGfoFdoItbGt|G~tJxwBzqSnNdbAfUz`AnkCbcDlcChzDltBvkEhmAluExNjsKayB|gCsU`pBdRv_HdtDd_DnNtcFllDnvBho@t{BzVp}B}@htL{`A~dDdHrhCzh@tdC|qBnx@vhCvp@hxHld@zeB`zC|cA|zFdiK`e@xZza@wG~rAsiAzl@{Gnx@za@xl@jw@`{BbdGf~Brz@rnHzVzhBsJlaGeoC|aIsz@lz@}o@xkC_aA|qQwoCldOp\\lxBq{@bwBe_DrsA{u@x`PisAddYdh@ngFdc@vaFbrAz{GruEpuAt\\|hBqk@tSk`B_^urFziAelF~fDs`Ef{EkvApgFnqAxvAtnDzaBltAlkDzl@v}Ip\\hhBhy@vaDx`DdwB~dDhrAtyDdw@h~Eap@~sE}{ErcOdbAndDxlBfA~bFs{CnaC{\\||Bjl@f`AtmA~fBnnE~oDx|B~|MhqB~{Nt|Fh|BfWziEcVdkAfe@blDbmC|{EhyAlyYjzBvnBaXp`B_~@deIsfJxmAmEvQnhAec@liGjq@vtBvbE|lCnvBx|@`wDbt@bcB`kAplAj`BfVf{AqlAjkAepG~lAur@`zBzl@`jAbyMv}In`Bfa@fmJjHbnBjW`wFpiCptB_JhxFmmAptBnRbbE~lBxuBrUreFyE|dFrTpqGn}@~oB_IvxCmz@|}PojIf|Dg}D~fBm`AviGpLrsCa[x|Fa{B~eEc_Fbn@iuEcFwdFza@kqGtzConF|sBywBjfBaeAbwBiP`qAhd@tcDbyBheFhr@dw@~x@xDl}CyxAdgGkQfbCteAnwA`fA~Hpv@}ZdtCiqC|hDaG`{@}YdbA_gBhh@gV`xEf_AdkAeNxkE_bBb_FnNvxCqp@~q@qjAdbAg|F`bEsfDpkMlQz~D{oBduBaqF`fAicBf~BygAfdJmQ~dD}|BjRry@zBrqCldBdr@fsBpMllCaChcTkyCtyDeaBtvIwhIxjD}lErgByxFzw@iwAnlAIlkD`iBfxJ|iBdaBnr@fu@nvAhj@tiEr}@tvA~|Bnr@jlEeaB|~BsSlgJ|lB||B}@zjB}t@lyAs}AztC{rEftAuaA|vJmaEa\\mbDiaIsmGa\\m{E`fAq}EvcBglEpsCknCjuIkr@rzEqoB~zByDf_Bho@|nGtbFjqM`aAh~@vlDxZrd@naAbU`nDyc@raJbbC`yB`HveBst@bkEkhDr|AfAzrEhaH~gAno@~eCdBleNilBh`JhbAvlDjHtSyx@k]ccB~TwsBfhDe|@~`HgAj|Mft@xiE~x@tgBa\\vgIqbEztCss@~|@dW|}CpqLpjAxUzVdb@ebAl_Fu\\~cGvp@l~HnwAtjBnjCfj@daOic@ptBpv@vkE|bHfiC`wAfoHtjCr}BpiBvwDzaGnkBlsBdtEfzAh|Md|@bbExaClz@x{B~R`eBxc@xi@dlB}u@hpCsfDhiA{W|g]f`Nh}L`uBxcMoXvqLdpBbiGto]fzH|_FppFmrBleAgwFvZ{zG`pBa}EtzCafAt{@lkAnYnzBhs@ffAr~AeOh~KgsChzDotDh}Aer@laGt|@hxFge@t{Bv@~bFl`AxaBmFrzRcyGtfCu{AzgEoY~jIdhKp|ElBbZus@eMcfCzl@ck@vp@bFdjBh}BzuBbn@hgA^~_KqtD|_B}SlnAhh@daBnkBby@|f@llEfjAjzBhlAlbBjeB|~@|yBbFzmDoaCp_HgVznCzXzpAzj@bk@nxBn|@h{Cxd@z`A|}BbuF|eF`p@vrAxn@pnDdc@dm@vy@hZh{C{Lr|CjiB|u@zNxmAmRtmEe_ChpC|@`fAz{@ptBlhPssAbfC}}ApwFoYbgCv\\zkBnwA|s@xhFbk@xaBxgA`}@tpBtQvnBwp@jrFti@boEj`FjpBn~Gxm@xhFrExdLqzA`eBfLnx@j`BkqBbfFiIr{B`fAf_BdaBhUzfF}KlxBj\\xrIh|CxvChrBxw@rIh~@s_@lo@}rBnd@ap@z`Akg@ltFf_Ap`BosDdvAc[dvAxn@t}@jpBkRn|GlyAkzBlbBacEdbAem@~kHyw@nbBenAn`DakF~fBb`FtqAnwAheC~r@hvJcFnaCldAxsFvvFbnBhs@bmCcEtnDu|@jkHkdD|aIsyGjpCq`@~zBtRxfJlaClcAoLbnBqkArhAzBve@h_@hiAnuC|iAf~A`fAtfAnwA~i@laCjHhyGgl@lzXsbIfoJsnFjjGwl@~mFidDrdGwzA`eBkw@bvEszDfhB~NtgBrsAhqB}AtvG}xAl`Frx@f~DrJz|DaR|f^kkG`xEopCfrCknEh|BciFvxCwaE|dFyxAj|BnSfmJn}GblBzMtr@sPboAoaAj|@jHr|Ab~ElvDt{BvfAd`B|TviCwZbjG|@foFd`A|{DtzEzjJhh@xbBboA~fOvbCvmBdlBlaDjkFlwPxkCnuD`zCbbBpmKnyA|fDxCjqMsqBdmY{aAf_O~}@dvC~q@h{Cf{FxaBxbBbwBvEtdEybBlsIvwFbrGd|F~nEk`Hl`F`p@jrA`fBqLryE|h@d}GaQpiByw@`zAesFhxGSdlA|}Az`B`nBzv@rhClZtdCwOrrDshC`iI|}BlcCeWlhGsrB~nGem@vxAs_@`fAi~@b_FkkHj{Am[lcCjr@noKngFxxAhrBpt@pMxiGiiA~mFxnAi_@jlF}hBrxGlPdsEtqAjrA~h@dBteB_hAhmH|sAh~@r@h~@a\\z}C~gAriBmo@|}A{vA~yCabEnvDknDzKmnA{kAufAcZmpAjh@etBf_BagA||BcFvcBxZveMn|Fjs@~~@u}@pyI`S|gAfiAno@`oPhdFpqEbbCv{@wFxbAm{@by@_S|dFp`@z~Dr}BzcKv{MbdAbd@b~SyuEnjC{`Ap|GuwFlmBycAtzCss@vwB?laGldBjcCgBffFmxAtfC`Fn~E|t@n~EjS`eBfa@jpA~oBp_CcE~nEemAn`BcOp_CbZl|KlxCheErIlnLyuCxxAcExqHt|@xmA{B|hBem@`cD{sC`fAsUfjB|~@f`AxwAp~AntEnnAdwA|zDIxeK}bJtmE}_AniFsIzhDcoBbxCwvCjxD{_Ch_MwyBdfH`f@d{Gw_G|cIuSz~BjqAp`B|rCr_AhoDvy@pwLl[xlA`nDr|C`\\nxAa{@lvCgeGbwD}BdiEt{B|zDn`DvfAfhDbn@|rC~pBtyBn`DdkCrfCxvCfw@z}C}jA|eF}aIfuBox@jsIxbBf`AaHngHc`Fj|@bPjzBb}HnvBjyDzhDn_Cf}Er_@hcEghBtdEt|@nwA_IjvHisB`d[st@`xEti@|uKllC~yE`g@|nGewBvaFsnE|fDkcGr|AuuGboAu{[joDi|P`dA_|BnmB{k@f{EvzAfrEwq@`aFbFjgAr~@ij@nsGhTlwBnwAvvFxZvjFvn@db@~zDhJjq@tRt|AzkCdw@`e@hyEcYhhBrqB`{@co@fV}}@yn@maEzXu|Bnx@ekB~{Aq`AlcC|@l`DbnClyAco@|_@ahMh_@ccAnlCsrCr{DgcI`qLoaAlnAna@jpAjfB`{BdzJzu@hhB~rAr~@fsBbc@`eBgV`g@sqBi_@_fC_i@isAnN}j@|iC}KzrCveAxdAeN`e@wd@~gAghDrsNemL~rA{Bh|Bp_AvjSvaQ|gChpEhs@`vF~rA~qCniFwzAlhGkdEpkBcm@nkBjFvwDjfC`pBdW~fBap@ztA{kAbxAyn@ptB`g@lyAdgFbdAtfBloBgUvaD}oFgBmaGcy@akG?snGnx@w~HmEobg@fgCwn\\fB}bIfVs|Cf`AsqCnwAs|A`aHobBfeEwkEnvBs@lcCxx@|~@daBpW~aGpjCvxCheEjeCvwBxb@``Ia{@rnFpk@xuD`yCrrB~yEnd@jmFw{@`kGawF~gNcy@|xFdn@tmGvwDlvPaH`bGoaCxcLuSxlCbQnvC`dCjcD`{@tpBlPtoCsrBt~XmmBfwJld@rpDrhCdmAbrImzAjtHri@p`Bom@`\\g}BeMsnGbbA}yFrrBizF~rAiyF{VawFowAorGsJ_pCvZclC|u@_}ApsCwbDby@wdBfa@e}FoYwjF~Rg{EblDspEtuJiyFv}GapB~gAu|@z}E_nFrgDqmIvmC}gEz}CayC`mE_eCphEgb@t|LleNbjFnfVt}@pKf~Bqu@buDs}Bj{A_SxaBlcA|~BtdF~]b_FqWlcNrUllE`{@hcGbcBd_FtoCz_B~{A}rB|j@gVjh@bNn`BxwBr|ClnBblDpa@t{Bom@xw@tRhsBdbBfj@dMnbBkSlcAaz@bnBwbD`pBquAf}AdNduD|rAfjBag@vy@q~AncAqgGz_BsyErfCmxCtpD}hBjbFqjAn_P`vTvkGxiE|iAxwAby@fiCj]nuChh@nnM`QdvAdc@~q@|~@|KdZs_@jf@upBjnEimHfqD}vJ`{BmxBrpDoXz{G|UvuFmG|yEu{@vsWqaLduBoZx`EnnAjoBnMxcM}~Ap}Drj@p~CjmExkCx{IvoApjBjmDvcD`{@vyAzc@rfCpA~aI`\\reDlyAxbB`{@zBxkC}~A`r@dDz~BfiBbqJteBlyApC|tAi~@~eCspEtzAgiAnbBh{BvlD|vKjzBr_@hsBoaC`cDeoK`zAsfDlcCwwBtcDkgAblDnCf}CzkBxmA|eEsi@~nEwwDdoK{Bz~Bve@vdArjA|UpiBgKhfDymAr}@cFpkBru@rzCtzBtsLnaPx_Df|C`}@~gBlPfgCag@foIbFr|CtfAn|Fj|@vnBtzAbzAhrCby@pv@gkAj[y`Cf`A_gBrsAwFznI`oBtcF|i@vkE~qBdhUjmQpqE|iB|tLvaD`yDrsCzgE~yBx`E}t@zhDm`Dx`C}fEzaBikJ`zAufBhfDqk@fj@om@xmA_xGf~Bk|@poVgsCjzBrKbjFvmA|hBjHp}D}gCzjB}t@bxAfl@lPtpAih@bwDqCduAhTxnAtvGfzGnx@jeBj{A~jKxcBtaGlaCdrFvhJ`lFl}Huq@tvI{jC`uSwe@rpFxXd~Dr~@hqDjdDrmGjkIx|HlzLh|BhnFpaAfpGlo@d_RpjAbgHvaDtyF~iLdmLnjCptA|zFj]rsA~}@lsIxoKtzCjpA~yCmFnvBq_Chs@}tKve@zBnd@niEtzCvxArsAdu~@zvLmZfzFtkMjjKlLjtH`{GrjLiaLfjO~qBncLapMzhDgLvxCpvBb_FlfIfvAbZ~lEjRp~CjdDzkAbZj{AiqB~cE_rIdmAsnAhqBov@tqApLzxJb|Hf~Dj}@dhDecAb{MujWr|Ao}F~gAetBjyCiqC`bEkeChpE}iBjcEi~@v|JvHhjMgvAvfJHveBmYteBonAffFyfG~{Ag`A|fFsj@~mF`HvkEgw@x`C_nFrAgnGcoActGudCicGc~H__MqtBe}EgdHwhWaSmlCbp@gjCf{Ew|Htr@k|BmEauG_bIenLquAulGzuBm}VpiBimFnYupCzl@evBbcB|K|xHl`FpuA~h@nwA}@blByx@tnDsgD|gCeiDf_D{~BzqF_i@xuOnx@jbHldA|gCjeBlo@fyGfwI`iJ|tAjq@r}BkHvvEmaCrpCwkEllE{vMpuAmeB`zAxFxxA|gAhoFlkG`zA|j@~gNjRjoBz`@rfCxwB`}@hTjmDiTxn@`Q~`Hl{Lh|DllD|pCyaCpm@cdNlbB{_ChhBuq@pxI}t@t{B_sAr}@kyBdwByjQ|sB_xGxsFmqLlrHkgKfjBunD`oCkpC~mDi}@rfEhhAxiGh^lxBchHnkB}w~JrlWshzAda@mit@b`Gi~f@nqIua\\~|@omFz~Bkye@~fBqnHvyBqdVn`Aif@laFnAuy@doCbVbiQuhFf``AosQzfl@_^tlSzqLlcm@|~TnaDnvAjmHvkLrwDdhKh`R_tG|_a@xoRvsz@`rKj{IioA|gU|rOrge@kBt}DsyGmaHylBtuCljAfdJfgGrVd|C~vTolFb_Fr{BviXreKwkWliF~hMx_O`uDv~@vjHtsAekNbvEeGnam@vrZdfc@vfGtzg@elIf_PfyFr~Msc@tnI|yJp{Mh~o@_aJt`e@sb@|d_@qtPbaj@{cF~x@jhExvIwtIbaMlkEj_G{mBv~J}`Tjeb@}}`@l``@y|GocHk{Hrc@giLwkXnvAaw_@rbMstEn|JxvBotBx`FdjKfuSgiAic\\cxDubNmm[dh@ihCcm_@zdi@i~KpxN|}f@lgEpwAaqEazd@ujJitJyqVlbCqbVwmEk}SwsiAopd@gq^sY{qNuzSxzHiuChrNtqBjbSelNtbH~eS_@wi@htOzuKveQtrVlg_AxjUhrUzRhqQ_hR|~_@zTtkD`mFgvGwbChuT~pDjwb@cbAghe@vnKa{GdnHamXvbD`cKwaDfuTfaD{oHb`BzoHswErbW~rDshIlsAzeDmjEh{MfpJsqWf~CtwEwhAljPrpGugEpfIfrBgMp|L}_HtaIh}Fap@hrJyfN}u@oxMp}WcfVp_@vs_@mpNr`d@}qMzcG`fIzcEelI`|Amo[pakAgaPvhSrtEk__@{gDkub@zqMmre@bjHhkBvk@cgKorCdeAycDw{EcbLhzSpxA_jLenHd_CpxAktQcjHv~JvuGjrXyzGdiPydPkxIxo@pjH{dRvcCwxF_sCqhH`gIinLuxh@acKooJrtEo}PjhEo@rrQ|eSyiMkvWjp@u{Ct|DnkE}lDuxVxxHajGghMonE{sXmim@xiCi`VmsDggKxrHaiXqnHuvgAkqOlgQsKvaxAajG~sLwOn|b@roSht\\?g|EbtEncC__KvjX}kO{c^|v@j{Th{FviLg{EjyO`yBdcZsrFthKn{WtaHlqMzjO__Ve``@n{Kck[bqRzyUlkPx{j@laIqbKjcEfuBl|Jc|ChnLbhEvtG~g^ewE~wG_pDoaDwbCloB`lFxcE~lFonDltA~qBu|Pnrm@ieDvtB|x@ilSu}Dd_Cy`E}iJziD{bMstEb_Cvi@itEmhF_pCucAxs[{_G`bEqiHtjb@yfCqoLpz@y}Et`D~x@atEmmJzhDe}CmqAqo\\{cFtlDtHvfGwyFjUggAydJwsIgqCc_B_iMmkO_~Djr@oaVe~CpgUsrFycDfeCs{f@g|FtdY`~AlzV{vJn`Ij|GiBd_Cp~FntBgsItpHwcCrmTxiTkgDhmLqbKcvDjTnpIuqGxaEh`QbV`yB`wT`wCnAx|Fj}S~nDNewEhfOwbOceXdeA~iRyzGdqBmcHogYg~DtzKkbG}nIkToxL_sBh`F{cFs`B~mDnzKawCnrOyyF{c@fM{dKujKeyEyzGnuHinLsgEseK`oRqvNrrKg|Ei}@zpAuiJhzRceKs_AonEpgIibE{nBe~NezDhsIwk@chDppPesd@_eG{zNq_MsmBhlB}mJmzJmy[hgNq_Arc@zlIhfPxnFixHmpOlsLs_Xu`f@ixTtpHlzOxaQvhGkfE~lKkxU?nxAqxGajTeiFkkBybKl}HytUr`M~gBlaIa}RtmTmxKl}UnzKwdBdbNhmWzbp@mbG{yg@lcGc|e@moCsFmkDpwUgaOslZguTu{Dmi]vnb@{dPfbCmtA_jFlmDctEswEqvCueAsmSgcBcaBa{MhwI_gH{aGc~MhwIm`Hb`SgiMrqKk|Hrim@esG`mGc{BlfRchJ|nChyHta`@x|Ei{A}Wojd@lkEk_BzpL`rJggNyhT~xLurXuFipKx{R{vPzdEcz[rnHvk@rjI~cOvi@rlNmuLdsSrFhu[qiH~gAsxC|xJte@h|R}xKxdEibEz`R`mSczIltAcd]lvK_wMgwGxwT`wC~mE?kkN`cKcaApiHfx]ui@imX{zHsmSleFcjIb|N|bk@lqZzbEb_A|dH_cItpHea|@nqIkpMprJbqRwwGftoA{mHypW|zs@esSpjHgm@ipEygNleLkcFcyC~sf@a`c@`B{cFk_Sl_OylB}_C_|JbqLybEap@eoI~}KehKcbXdxE~mPk_G?j_GrbGolE~iK`wNt~Cdh@poFg`PzdQwuHy_RmtAzbc@soF{r@ixHcoM_{@v_QyrVt{CamFgpIrvCo}KhdPujUjk[wbPmjPhgDorC{tE{oBbyIccKacKntAfyF}fEfrIirWd~CunIdvKwwFgpC`yNk_NyfPgx@kq@jxIg{FexEgcCpxMx|Gwj@zmMhwUyxHdvQc~MhkHvzQfh@s{BpmR}vWlqCk}Sk|NntNl~TdIrlHoqN_k@ueB`bEa_LuqSb~Bs|e@asDszCfxQ{bWk_GmtAqtOxqPkeF}{C|oCu_UqtCxiHyqWogBg_EmrYrcL{hOexEykN_aU`~o@ctFmaBqvBemOexQ__Kt`N~kQpqE~xRj~^f|KhiNaQhoLrjUo^|rSisJbhOkzH_{Ku|CllJjiD{e@pzA|wEeiWbjPgyGugFyhNlgRiiBmmP|wW}~Nk~SfsCxsJcws@gyF~uYumImHt}BtkJmaHdzIwoJe_Ugi@reLjiDvi@fbCz}YfzEvsCo}IplLcdLukJ_eIpzBnAdlD~kQvtGgN|nO}zJp}QaiHitIjoAhtIi~FupAnxAltQmcGu~BfcCpdJicErrFkjOyrV|jEkpL{xI}v@nz@sz[hyG{gJjbGlxErvCinRrfK_~D{cF_gMhsK_y^tIetR_iHvqZcwDvcDc`AixBkTz|_@qcVtdLooPv}VyvIk_BiPifOxgO}wWjpAgag@`iHumHgmLtiDgeBnmQ}zJygHggA_vN{SxwCunJvLlaT~hX}gQrkw@kmNfrCeqGiyp@swEauDlmDtbk@ahTdiEq~KkgPyeDkdb@xiNo_EemJgtC`_A}bM`vNe~CvdNon[dpI}kDo~J}xFcbLdd_@ujWzhDybEjgh@}pMnjEam^wqHjpAmyKjwIyyGb|BqdVs|MjkZoeH~rCqbKqtDc{NvmKirJ_xRppE_bPszCqgCazBxzj@~zXaeCb|M~vL{pAb{OliQodH|dj@jnLe`Z~qg@ccLsuDmgRxvBmhEa~F{~GrrFweMq`@llCn{EgnJdmDutGmxKqeIxwBiQibJibFhnLkdP|qA{hPazMh_FufFi_FgfYfcC}~ZmfFz{TrwCnvYa{AjyCe_Ci_G_nDtmDlnBlaHs_Mn|EqxYoqf@reWywf@khEifCkcE|oHavOpp@kdFcpY?pxYmyJ~rg@evGapXrwDfy^grIiQ{oLz{HsxD|iWy`QzqLqcKinGjeIbiVybCjqFutIyGcwGadTn_Kiu`@h}^mhL|aHwyLylAiqEavf@fm]czC{nBp}@muo@`iH_jRw`DowLbzBonEtpIhgCeiLsmTrwEan@j|GtdGycDm}IrmHqt[~wMjTj_G}mHkoMssLndHqjYczNerMgpJ`_@piHznAv|EntNctFp{SihZni^quOlcAvhAwcNthLgkLfbDcpu@smHh}Whm@xpWy`EnwGumImy@ozAp}R}{IqmLkiCt}[ojGppEgyFnw@e}OmrMlsA~{Eo~JwmDwi@j_GstEvi@n_K~sIdxEoxGxsU|mJtwFogBfjKdm\\owAxkRchJnvGzmBngv@gaCf`Jwk@_kKcaB|vEtxFph`@_|Kxmc@{pM{kNzdF}kPwhBaiBajGr|NaiIk|B_rBkyIb_A{uE~jGmIsmHcrF?urGp_KwkF}iDemD`iH}iDrcKpxY_nDqxYxsIcoHhbEsvPa~@yzG_vAdoTgrIhrEoxA{i`@ue@bjTydDfhAigCyah@mpC|yQocIodOvtIrfWvi@b}XayMd}Dp{ApiHunIn}JsvEopJopDfzL|`Iz`G_lFhhC_mPiuVacWwbOziD{~FctEykBliDctEy~Dwk@yTygHhmXkvKklNdlDwlKsyCe`BwjRvcNajGibPlcBq`AteLeeXqsUm}IxkR_\\|~TwhMjyI}wK_cOx`Ft{UqkGdbHgyFeoC}}Izl~@dmJ{`TmXfo]hnLhpEvi@itm@|`HvuG_rBjlf@epUffYxzGx_GmxJli\\}~Tj_NsfWwtZ{mNqm@vbOfrNwi@|hFxjMzvJplG`rPuxEte@pcKfgYqxCddMxwIdh^lnNjxOzpBsxDe`PcvPraBgaVjvJzmIrd@mmPzgO~eHjTqbPxsKppKxgBnqZotMlfRu|O~aJ}vXbGu}NsbM}sBsfVk_G~qCmcGgv_@gaElnD{|Gs{ImiE}qM|bGkmHwsSgaDdpHstPa_@_zMmmDngTkcRyjHmWu{J~~JyzRskJ|zDikLajN|wKs~RubCdtA}pAuxE}yKlvKs`MqgHim@sbM_|@hbFmmN}w@gqVuiKzkCwnJqyBmcHb_NwJf_EqoR|pMlmCxp@mkDcxO}mIyrKnnKiaE`^vi@ctEydDjiDsyCy}RzaGqnFwxFwn\\xxGsbLvxGfoPnxAknMrtEjiDqB}|O`dInaC~rCt~O`vCagOxyS`gOyzFkvPeoUi|AdpHsnNfsIwfAhdPjdQveNdwo@syOtja@f_DpCp}Lmz[{rLwbx@zbGxfC`mFcyDl_Ih`Fw{Em_IhxIwmb@a{Y~`a@ysJm~HiQszOxbQ{zTtzO`Sxsm@tei@nhGooUsoS}{OzcFi{Go}JzxCoaIyuCy_SmpZklBe_Uw}DdqNarQutGi`\\y_b@zRrvO~ax@xhq@_yKrWolFwpJytUetAmvWrbGqrPspM_|@mjKk~G|hFa~Wg{]euF}fi@inAdqTi_E`aEh_ErdQxmXzm`@yc_AaxNanFcuK~jFuwFaxMgtAd~BomLyvI|XgyF_xRfb[wlh@`zBkf]}`HqsDkfEbpGp{Az|Suy\\rvt@~gG`pPudAfzR}rNlK}u@ekQ{}G_aIwxRpoQ{wIcHe}DotH_z@wcl@sgb@a~z@ox@_bOqpE}~Bk|HbwC{oX_bIanFu~NeyFb}BgiW{gD`k^jpXqfI~oDouM~`a@qaLr~AgmJhqn@gdClt@soGyiM}hF~y@j~HjdQutGxmL_hGykAhiBauI}xKwcCpvCivOusR|nCoxLqlLqxZygJpjHyzGqlGgjLh`EmoMsaX|kChkMvuHxkAzmMivH|mO_lQ_aOmtA{bLgbCfnVipW`tOig[cfDqxNot`@qxYbjC}oN{qL_]cwJ~yKuuLoxL`eDqkR_vAa_M`qQu}OtlCytI?ibEk_GwtGj_GcwQkxCy`E_wGvLwpO`dK{m`@}qN~cZqsPpkGwi@h_G_xM~|D}iDb}HuyEssEo}Jy_i@lpq@{_ZjiCm{D_qC}e@oo\\n_Km~HanLiR`iHwzE~cCocIqxIazBpbKaq\\asCu|CjiIntN~jKqsEfvSvgBh{HmcSpp\\}uc@qpn@cf@ui\\gjLand@g{E~zFmjP_{Fy~FziD{~R{iD}x@x`FxbFs`Bhqb@tqHzlMlmI~iGlxb@awCjwJedY|~NktKpdg@j`Sje]fvTkhIreJvbObzD`tJeeAdr^d{Dbt\\ouIryn@gyTwjK}qDuuZ{cRkuJ~mPfoVstElvb@i`[|zIe}ZbEyvk@`wO_aNlf@gaJs_QkfIdpFey]cnOotFvjEy|VeaUqjVprImxo@yiHmvK_tMmoZ|~HijYyjp@b`LupNgyQvoCo~JwoCajHtiDk|TwbTai_@gjM_jFhxCuhc@{sFkoAl~Ch{F`~@ujgB|uc@lpMvgAspaAzdRedgA_uNw`g@`oQwpVngToyW~rBsxOkyNirKg]cpRbzNkqd@gfMnbIofZdxEqm@omDcxa@eoIojMqv~@_mKgmJxbKcrFixMcwD~bCsmTstEjxUsmHeeAu`Ou~NqqDokR`fDtLepIqem@_vS{_T|eA_jFumD{}^wy@_pg@uhi@czf@|hKa{M}yUo_n@icVcoSyp]gkK{`Gwfe@m_D_hk@coXaxp@ex@coHyoJg{h@bwD}sXqzTokj@arP}iPcg[st]_fHk|SzzNoeT{sEwuj@pqPeeBa~Lq_LwuG}u@ofMqwY_tI{fP||D{oBghHm|I`b@iyGelJinXppDwa\\ylLgkb@teYcnk@cvEm{Jb}BifDifDaeIpoFqcKcyCghuB`p\\ozd@eeAkke@`fImc_@re@a}gA_xMbqGutQ{rAi`FskTcwJnxAoeHkbRsjCe_Pb`F_|JaaKxQ{iIunI{}Acu\\llIglWajHggNxjHytUciD_cUndIkjOrrWula@xBssQbvEqpQstEk|GuyQuiWgnPegL{oActEriDwu_@w|Wc`|@{_BuhrA_gYq`@uyOetFoyFscXu`C{tVfqIibh@nwAytIw~DtdAelJksLciCobIqvJinX`rEkwUeqPw}EbsC{i[m{Jcha@|WcdIkpL_cFx`IqlLknEmsM`sCejc@y_FyeZzoM{d@dxDs|I_wFgqSetA_iGkcI_hSrjAemIodTs~NczCmWsoHoiFu|Boq\\yo@mcRnlKgnVemDm~IrlH{qc@hyIiqW_fCqyNdnDcydAq~Mml`@r}B}ih@}lDqoR_bNm`Tu}DmyKrjCcdGjkOngKrgGe~Ax_Q_gQ{aHa`b@`}AarG_iEmlZua@ivHo}I`zMcgCrNqoJqmJunLq~W{sKyvTfbCwyj@ceEkjNwzF}f^pn@}flAs}MaiHd_C{jC_fGavg@`@gdK}pIoy]aiFghLnsEaxXymAevi@`yPsnN_qGemN|dD}uG|tJwzBkqCaor@b_BcsNmsF}zN~}CmbF}`HulKy|AuiJjtEmtY}bGuqj@|sAcxg@j_GueG`sKoJwnDobKy{Awql@bfP_}VoeCmbnAxsPm{y@kiDcdDc`Da`TrxPshAu_m@{uMqzc@wuSgoSuy^_aOqsw@b`LyiEueEkRmeYae\\i}`@plUapAl_c@_y^bh`@}eEekAg{Fr`KckS{MiyKjtJwPfdJz|KxjQiwIhc_@ykCvoNmnGvl`@mBrpi@}aOxmNnmF~uV}yNpzGydQzh^qu\\lm^aci@ffFbfKlsn@hud@zcXewZlzMcyBzkYhcHb~Hp{f@btGo}KvdN~RroRm}Hz}EvmNzbJho@ntQerNlaTs}f@haV||P`l`@omBx}GobZfk~@ywg@`dw@{w@`pi@wvP`b_@afAxa\\onY|bJxbBf}PidGxaOziBrsh@kbQf_t@jmDhtLyqUrt@ue]"),
+	country("Chile", "xr}mIlgfbLeuRdjn@fkA|fFtqS}nk@z~GrrkAujVredBuxEv}DyfDgsIylLxOdeMlbI_xMtgFbhU`{A}t@|dFklNti@bsQdwExn@j`SgzFxvIyyRhQ~_Jp_ExzRgk@lvAfmRmwLqb@bdLvdNgm@pf[k_GvxA_eTknNxdP|tStjJb|Am~Izci@~_K`ua@ovBn|KerGfNmqNk`v@ynKibQa{BpmFtoIpiZfdCkmH~oDjzInmEzua@ikAxvTe_Da_@y`Euz[_z@ph`@vrHr`@zzItbNw_Ex|AgjX{wP_sCnsThsKalA_sCblIhmLb|MgbDjnTauCvvAu~CwtTkpAdiKyyG~z@}gFkeE|mD`qKmfG~qHbmH}cA_z@ppKloC`kAzhDs|MgNdmJ~fHjkCjUfwLljE~@wcDpnEgjLkfDpcKdjPinL{iDo]leGxlMfgHc}BfxFwzEomEui@j_SfeCovArc@hzMtxEje@_AlwVmaGzcF?axMwxG{_BmpBbjJxfC|mIg{Fc_CyaDhfDra@efLobIrdKc`AisDrtE{ePzxIo}EppE{va@m{Jb|MrtEwf_@axMlqTiTjv[m_Ih}@klBoyRpeU_ic@uxEymL_vAptO}~HlvE~hFqsOcqFuv@`iHcpXcxDw|K{eDrmT}mD_jLzyIyfa@vaO_jRaxMptIrrEau`@sjIanFeaNtqBmnCat\\bwDswIug@akYljPjxUrjIk`BdkKreKjyIq{@cmHavCtqHwvSq`LrbLqpFmYqjSkyT`bc@qq`AavCwdBwtIhmLbnHkm`@{}St~x@wx^|h\\ghArzr@c{NjxIltAqxMe_OdnTljh@udvBz~Regc@{aFpo@weCksLwrHlgWcdLigTjVc{Ih{Fi_KvbOke@ud@wfG~eHgvHvaO|u@uyEonJtaBuvFrlIffBhkBycDs_N{sE_h_@|mZ}hEumC|hFgt_@tgWgm]sxOzuDmuA{sKzvV}ft@|c^b`d@bkJwzFmyK}X{hOofS__@gxGj}HysIgmWylA|qBuz[gyFe`Ckjg@|dpBivTn_vAydD~iGgzt@jbXudMcmHsaNiej@y|Ccsr@}jUgww@w{L}fGmoQvsEd`EhniBv_Oppz@{dQnji@o}KdpIsby@}sI}oGwiShgOh~Bd`Au|PcvHsiTquRqxGy}GxlX~uHj|NmbHapGqsLb~CogEd~ZatE}`y@`kTy{Wpe@yoPswKw~Okq@yqPu{X{~Zu|NvjEusFkzBsrLkkc@ttc@uld@duGqvOmoGm{i@q}PetZxwPkl\\duaLdsAq}ArjJd|Dg`JbzIoFvjEvhA^bhA"),
+	country("Chile", "jabmE|h|kN}hEjoBgsHccFd}Ny`F"),
+	country("Chile", "rgclE`n{_NrtEybEntAxul@c{NqzS"),
+	country("Chile", "znjdDnhuySjdFviAp}Knqg@exQmmDwwFspG"),
+	country("Chile", "pdysI|xlyKj_G{~FxhBr{BynK|td@moCulJ"),
+	country("Chile", "bpvsIljoxK|kOebB{aFl{P}_JkxD"),
+	country("Chile", "rpusIb|~zKqrAi|BdyEwz@aJbsJjqH|kAe`@n{HfsEziD}xAv{CmvGa|Af{Dp_K_{FxuIvoH~rIwrCxtR}jFx_A{uCccUnnDeli@wN_nCo_FxpD"),
+	country("Chile", "jzksIpgjyKti@otMd}Bb~L"),
+	country("Chile", "dzgsIpkfzKfhAbqLskIzpY{xI_xMrlIqsOisJqjBqfIpqDa{BsgOhiN}lD~dIz~Fp}@g}P`iHskB~mDdjPqoEdI"),
+	country("Chile", "hivqIrpz{Kx~EihHphIyo@x`Evm\\_aIqtIiuJj_B"),
+	country("Chile", "dqxpItbohL`nGmaNa{At~HpqEgN`BfrU{iD}iDajH|iD"),
+	country("Chile", "~|ppItrijLgm@qmLnyMf~J{yIncCyeD~bO"),
+	country("Chile", "xcjpIzt`wKxbEmaBlgElfRkr@dzVcmHfxAgsHu`O~YmxW"),
+	country("Chile", "flyoId~ltKzkNriPd_C|bMe_CjxIukJ}bAcmGq_R"),
+	country("Chile", "r{ynIhjcwK|iPkcKoeSfqr@giBudH"),
+	country("Chile", "bworIjb`~KpsEbtEntAqiHjeFl_U{zHlbI{vKjSluA|sYavO}gFwrH~vRpqDh}LkoAnvMsdLrxIvjArtF}lDxfCxsIgxAfOdeXsoGbrLp|LglEhnAp}Qi`RbrR{iDwmh@e_CjxI{iDumHduGbfh@gsH_jMolQaqEgwGmiKr}Mrfc@isJ`kXsiJzd@|oCxrDfgM_hGlWr{CibEjjNomPjiDdxEpxe@df@om\\pbKe_Cti@vmh@xmLc_CgeC}kIrvE_oWntAzwEziDui@~z@}eLfmJrwJltA{~^|mDz~FfuGgeBguGzsKp^d`UcxOvrCnjRx{FjS`rEgbOhyAa|ApnLk~FalSvi@nzLe_C_cC{S|lDrvDxfUy_FjmAorChx_@exEoxi@q{BtvRs{Mge`@heOz}bAwzEz}Mgl@sbMqxB~pJmmDymLxQlc^_rC`tDmlO{i[{jCuhy@bxOgaDziDd_Cmy@i|F_bU}uAs~A}jPx_Fev@aCmvRw{EntIexDcbRdxDe~OwaDnvAzoAsq^a_KkpfAgNiqn@~xL_vS~cIo@wgB`yS~mEk~@`qEdpNk`Hv|Pb_CbmBdpHk|HhhBlbH}fFn~g@uvQlck@|bGx^pkS}xs@nwBwxmAj|GqgTti@htIv`DujVpsD|qBbzBzet@sa@rxUsmH|rGltAfrO{W_{KlbIkxDhm@}iP`qEz~FaqE{d]jmBs`SzyI_bCe~BykHt|CajXdiJ``Eth@elVdmJjqGdkJsnMxm@ybK"),
+	country("Chile", "|xdpIxli}Kwi@qf[uiLzc^xuIxaPo\\he\\mmChgCwbCy~F}wLzaMytHavCc~MbkCzlAryZ{qLveBxo@sxjErsFceVhpWuiXh|QlzEhvUhqb@w{Ejgb@iwImmDgtG|pNffBvtSffM`pF"),
+	country("Chile", "hheoIto`lLf}DmmDr`B`|LwuHtpN|lDe_CsGhvIxgCmg@dxEoxMpiHdgW_gGzF{oAfjGtuFgyAryBhuCyhM|bBqqEczH}}Ir|MdcMxpW}nCbfEk}GwsBlhEtzO}bGxmd@i_GmtA{oAkpMg_DliQstEw~[dxEw@bbAalLurGgj@ldFebO?w_[eaBpfIayBahCwxFwkn@~wAmxErtEjxa@htI`_E}sA|be@t{Dh~@jTajj@f{EynRquCugRt_CceEt_ClvK"),
+	country("Chile", "vptnI~ctiLcqGt_HebA}_TmmDl_O{iDuw@qzAmnNbuE{{HdsHeeAuj@u{JewEz{B?}bGexEvbOq|A{~GpuDg|h@`fIvdBmaHwfSl~I_er@?pmd@|iDqiBwi@pm^bwD{G`sDdgu@jVnrZ"),
+	country("Chile", "fbwmIbgylL|iD}`CpxChcb@qxClq_@k_GsmZ"),
+	country("Chile", "~egnIv_gpLrb@nx_@ybDg_KkqApiBvgAp`RsoFh^wNpoL|jPr`ModIeeBf{FdiLuiKvaJu~BotIquDcDfN_sm@`jHabPui@sqXrwEpwB|pAqtI"),
+	country("Chile", "`b{lIxbcvLxO__DxcE`_@feBh{LwqIcd@smHroHntCnxFcxDxuCs_ByzM"),
+	country("Chile", "vrrjI|zoxLbGioFliEtWkmAfjM|hDkoBkpA|`Il{JtkJ_pO`{LkoA}lUwaDucA"),
+	country("Chile", "nrviIt~qlL?g|Q|aHynLzbFj|HuzEj`d@q_KpbKwaD{vD"),
+	country("Chile", "lgaiI|ngxLxmLsuPpDh}FtpHzUjTb_HjzHv\\mkDl`Nv_ExjHgiA~tM{iDm~B{pAzsEajGai`@qwByxAufApqJs~AybQ_vBdxQ"),
+	country("Chile", "t|_iIn~s}LjxIcIsd@hjY{xI~tCbe@`wYcmHqtU"),
+	country("Chile", "lgaiIhletLstQfbDwzEowGse@khJphHevFcjH}`IjtVkaLgnJixBooDpgC`Be{JpqEe|IhqK}aCzeEtlDba@_}EvgMeiE_jRsaGzrLkxCoqOwiGrzCmxQj{Sj_Hi_F{eJpeJ}gA|sAzwEnpDokFd}ClbMbrF{c@mkEfyRnkFotMjbG`sCzRbdGqdIhqDlbGv~Ds`Mlj\\c{B}{UcmH{iDilAd}CfvG`dKseJh`Eh|FloCeeAd~IakHlyEkcEspMeKleG}dGxRd|D~yPx`EseDlgRd{Ji~FptIofHweHxxHx{RozKzlHaaL{tQ"),
+	country("Chile", "pkyiIpmpvLumHjtQ}sA_jLui@l_Os{Bm_O{wIf_KftGlvKubApaFqpE|lCacKczCa~Kj|I~YcrXvsHmjKgzElsB|sAi_G_xMctEfm@qiHmmD|wE{pAotHtfLgqUbmIxfCc`NqqJbvDahZp~LxeZimAw{PvyG|Gv`Cp_Ldj@ubGliDltAhT{iPnsM`hUkzS`uZesHjmBvj@hfDx{R{dF`iUcl`@lgExkBotAntMrtE`tEntAy~FnxAjiDb{BkiDotAtx]kiPgcO"),
+	country("Chile", "n_ffI~v_mL|nIfpBveg@_mI|`BbjEhqZ}jTbiEzyQ}zThm`@~JrrJjlUovRxmXc{Br{Bg|Ere@jfDqpEjj[{pM~wY{lBudBymLvdNsuQjrAsaAarE_dHzdFmtAykGcnBztC{lB{nSx{Fkh`@{_Fya@ceGdhRcwO|sAcvMer[yxKc`Enc@s{K"),
+	country("Chile", "rwjeIxyqaMl~HutYzuKfk^m`IquCcaAdgLe_CooJagJheC"),
+	country("Chile", "nl_eIvm}~Ln_KbxU{Uq`GtnJqvMj_GnmPo~Jf_KrsE|wEumTj_k@~`Jmrk@y`FlrG?m_Ow`El{JqmFa`@"),
+	country("Chile", "`r`iIh}tyLr|BnvGc`M~|J~pNh}LubAdoNz_G~aJ}tAxfOi_Fk`Gjt@rdW{lBjoBq_AqjCue@kvg@otCvpOkmBszBltAufRstEv~Je_CyiHc_C|pMutQacg@|pAzbWctEc_GdbC|~N}lDyeDoxAntBfvHbtQdg@boT}{JloHzuVjmH~bIgiL~bIzbKioKxch@}rB`sDwmJoxRodIb~MelJkwJepGbgJieD}uKxzFlsbA{wIqzSi_F~hA{q@j{TojGfh@ibEo~DayByc\\jbSidl@uI{ap@ibEbb@r|Al{VwkK}`In_KtrScdAzdWcvDtjEajHkuDa~@`fNinL~tNygB_mEblHanWudA_aQxcEy`QsmTrcRw{EazYl`Ta~EcxO|sAbzBy~RryPllDhfBwjLhgPh}FdjJytD{vUkbL{{TzaGvmK{eW~lDltAvtI_jLznM{FkrAskHa`WvhGvsIwfZudBkpLtkJaaLh|FfaPxcEmbHjeFb~AgtIotNh_GobZtwEjtErtEwiXnrCpiN~~IzqE}_HxaR~vAbmG~wA{~FjzHui@kpAfxMl`IkmHfbCjmHr{BexQfyGlf@zUzgWcpHyDwi@`cK"),
+	country("Chile", "h~}cIzrpcMxm@ycP`dJtiJpuC~cNe_CzmH"),
+	country("Chile", "xgtaIzme_MvwGqtI`gHh|FylAhcJopCddBygC{eEowAz`G|lDfi{@goK{sWbaBigOstExbEoz@waC"),
+	country("Chile", "fsldIliy}LnoCi|R|mDvMwdBd|g@iqL|bSjbGbhJwi@fvSqxChxBiOyfTc_CpfOwbOhpE~uBplGykMznMrtE_xAcGdzDqaJd}C?`rK}_Ib_HllCpiG}kCdeY|iDa_GpzBztLseLnw^h|Guw@`yApiNkvJpmAim@z{Mm{J?cEv~PwxGp~Qa|Lu}CexQ|{a@cuEovMnxM{dc@nmDltArpGimLjW}hKz_GraFrtEsmH_tCmfEx`F}wKazBghMdrHizGonDopJ{iDlqHltAmkJrlHesYjjD~pCntAybKctEwrCd_[ufo@}iPfaEvKkdFtrHihCny@ixHjoLpfDhlMg`KusFklH{lNzwDq_AqmFfuSc{[jWrwJnpD{c@gNimR"),
+	country("Chile", "jct`ItjtaMvi@l|UumTsmTzwIhxYmbSumTnq]fap@o}@brC{~D{vBioB`oIe}JrPcoCshY}xNusSoi@xrP}~G_[o|Conz@nuNclHjoLtpT`pFizHtyEvgA"),
+	country("Chile", "|ow~HpnwfM{[l`EklG~{@rn@h}Ry|Kuu@_tHzmR}fE``@ebB}jEhuHwmK}hEs~d@vdGs{Mb`\\zsH"),
+	country("Chile", "xiu~HpdgaM{RraSojFklHwcObxa@mlPxrPe}CyzH`oRaik@xzG}tFxxGd_CvaDweH"),
+	country("Chile", "v|x~H|`lcMw|DzsKamG^in@rhJkcFuaIieE|fGkhCisDjhCi}Rq~JjxUvi@qxMajHrzY|pBp|MwzEhcJutG}iVpmj@msbArzC}eAhkAhnR"),
+	country("Chile", "rhn}HvqngM{yIxQkuJiod@niRluL"),
+	country("Chile", "jww|HllwdMjqAjmGstEhxB"),
+	country("Chile", "xag|HzgteMvtSpon@ekJypDesSy{^"),
+	country("Chile", "jwo}H|_{`Ml}HqCu|Z|a_@oqOtwi@cF}bHuxFx_LowAejEmlDfgBkmAirJlj[q_p@"),
+	country("Chile", "bts|HtqfgM`iHxePduFrmAyiB`}GajSeaUui@`cKymXuxKi{FyqEz~F{kBexEimX|sXfhXqAodH"),
+	country("Chile", "bgt{HdkciMfiAb{Io~UgeCkvJmzIp^adJ~{V~pB"),
+	country("Chile", "r}l{H~~heMiaFszCgcChiHe|Cwx@ywIxtIdzEwxWjgDsWwi@wpIxrJu_Ct`BabWzoBsbAhgBdmJ~^qnWltL~tHhQdpH"),
+	country("Chile", "~nwzHj`kaMj~GcpBmZxzG|gFyeCcGbnG{d\\vzRd`C_qPdtGbF"),
+	country("Chile", "xsk|Hf|ahMh_GkT|pMneNo|JgeB`hHh|KegLsqFgqIlxQywIc_Sef@niLsoSeqNtkK__Q{bGipEsb@v}EopDc~Au|DbtEuaAsbGywInm@ef@piHmmDmtAijB`_FoiFobJj_Gm_O`cWu~Bvi@lmDdx]znG"),
+	country("Chile", "dvxzHndibMtyEc_BnqCrxOwwR|ck@keEwyRcfKbaApBygNm`IppEs}AumCflWenPl`ItcB"),
+	country("Chile", "xg{xH`szcMyiBdbGg_EyhBw_DpnFqdKq`@mVonPv|PihIvkLlj@asE`iH"),
+	country("Chile", "tqhxHl`|hMf_E{iDnbIp{BkoAhtItdMhyG}aH~vGt_ZyuBe`BhkMpeI_{@jjDj|SyaQdJy}GomPgoU_lFmtLtiEe{EeiQv|QjmCozLcaH"),
+	country("Chile", "~mzwHvukaMbrFymFxiMrqFy~Ex{RkdEq{L?dxQwwGk|I"),
+	country("Chile", "t|oxHvmdgMfmKzsJ|fGfnc@mqBbqF{sWmbk@pqEpbWc|BneGgyF}wD}lDexQyrKwwGaxAsh\\dmIw`PbsEgi@{iClbN|bSwbOruEbzHwj@~cIqiTx~Ff_Eh_E{nBjmB"),
+	country("Chile", "nt_wHlc}gMzeFlgAnxAf`[ejL_aI"),
+	country("Chile", "jt}vHvefeM|iEgyE`cK|oHk}HjkZkqMv_EyoJsgVj~Fe`DkoAtzEneHpuC"),
+	country("Chile", "nxuwHxvddM`Dx}Eqvf@zlHcdLmdAesFt{DlsAk{H`cm@qkS"),
+	country("Chile", "pfdvHxl_eMftSpnAy_QlaNx{Gb`^ndHkq@loBnjRufKrwCstEqmLa|A|gQj|Gpb@|hFnaI{hDn~J}sMcxIu_BjeKpuCvvG_mEncCu|Dy}KszCx_Eox@elDtuFufu@uuFi}F?zzI_jFhOg_EarOaa@doHmwK`|L}rBklBntCkmTzbQ_n\\`{NlxE"),
+	country("Chile", "ne`uHncehM{iDjxIy~FiOp{BihUcbMt~IxaEyoL_fGcTbFytCz~FacK`jH|sApiHy~F?hcKvmJ_pJ_jFsgJn|KljEkq@|jPw`E`d\\khD{aM{pAraLaxAb`B"),
+	country("Chile", "bm`uHnyshMowAx~RqdKzuD_wAynLafIfjFfMwxQ"),
+	country("Chile", "tdisHl_kfMtcByxCzgE|rBnuAvyLacKxm@"),
+	country("Chile", "ndesHx`piMhQarDhbFjkCjbF|zJ}sAdxEh|F?kaFplXztJ}qMt|CzrEb_C}bGpiH|bGfyFe{DllDtbBxk@jvEclHdeAhbEpxH_{@~uMe_CmmDcxDvbCcpFsjHcyChzSemJkd]exQziTstEctKh_G}~HswEqfCrwE_qI"),
+	country("Chile", "npguH`wpdMei@~jK_{Kz}H}qAk{CwfBd|UibE_xAq`LxhNglKisUctEzSgm@kmHytIpmp@yvHisVpfIyxMc~BsgQjuVkzH|hRmhQorCtvc@hnL{|f@lzJmaGn{K|nBaaKluLnwAj_H"),
+	country("Chile", "`obsH~bmgMagTobH`_Ayod@j}Sbia@"),
+	country("Chile", "fd}qH~j_iM|iDrmNtjVlcS_w@deR{hD|KkbRaaXxwGl`UmgE{kCa~@`xBvmJrj\\kkf@arK}U_}Vv}CuIvgBd{O`jHm_Ovk@uyPwk@glEsmTyeDt}CfiLcsFzxUcxOrtQ{aFeeShpKo~Ci|GcjUzq@_pDtoIf\\wi@kmHtnHwlEciI}}OzbF{pAfdNjhV_oDcmf@i}Fs_Axo@irJjgPfgAtf@jrXzcFp|@"),
+	country("Chile", "vtxnHt}nkMrlP~``@gaTvy@gmFetUibA|hI_tKboBef@dgEoiI_tUydDti@vsEeoTxkf@mvb@|~To~DoyLf`Oq^neChkLuuAsoF`pL}{J``FygBezDo_Kt~B"),
+	country("Chile", "jjklHdg}kMl`Pg}Pn`ItcA{tKfhSkyA_jGkhDh~G"),
+	country("Chile", "vhpjH|odhM`xf@djE{kOtgWavCntBwi@obCvi@~b[k_Ge_OltAxrU}iQeaNgMktJ{iD|iDtkJktWskHelD"),
+	country("Chile", "xrqkHhldlM}bGdcSvi@y~Fo_K{nAyzFc~MrtE|~BilAm_HmuL{wPui@htDajHa`F_rBsrLbaLp~Eym@qiHnkFqkF~cH`qDow@ryDrcKtiJ_bIdfAbbX`jN"),
+	country("Chile", "bddjHfoajM~`IrnAyPhjHskT`|FcFf`Jd|OjtWczB`sEaaLasKvzEzcXgaChwH{{Ixm@qfIwyFl[uaNswDxRnw@kaM|zKfhGmpNuyUzwItjDrtEcdKo|Kj{BhnA_iM"),
+	country("Chile", "zl{iHjbliMnw@l{UocTroAklBfwGukJljD`yMwii@rpGiCxl@tpHxdD_uM"),
+	country("Chile", "x|wnHld~iMcgK|t@ovMhsPeaNg~Jui@t~N{wI__KhkBxkN}`I_}@vi@piHstEotAycC~mKalSlqFgbD{jNznYgnQnrOuwVqbKt~BojFrbMcyOn{J}zJqyAnz@k`H`eUe_Tim@qtJc_C~iLitIpeDpvCq{M{_HrG{nAwuM}zJbxIlb_@c|e@ckT{q@qzAozFmgFjtEorCktEgjLfxAui@itIi|Qti\\e~DcbRd~DaqFoaJ`a@jaFjk[awBjkBeqHsdEieDdyJuqHcyZt}D~cZedB|vFi{Flg@igCqbErtEorOwtIviLs~AszCkqL}e]rgJ_}EyrJufAkp@u|OtxDq}ExiBr{B}VmkJhaQzUlcGj_GeJejEg}PwrBqdJedMpk^mtA`tExqEn\\siJh}Fw|Dt~NptIdxPgjLzvb@}bBzkZx`Eb~MjfKfiLq~EzrWvyRtKmlDsrQioL~iFygNv~Ocg@dLl_UlcGi{RdxQb{BmcGtbCxbD~uAimAxrEhxHoeIliFxRfcBxdJgqH`wNxfOoxM|{IrgWyhCfyFcrE{hDfmJhpQcvD`jH{dFohAjcG|iDinAh_FszCwfAkqL{wUhbE{mNcyCj|BcvD~pIztJhs[omE|iEuiJkkCraAekPw{EijMui@xiTimLqpKbwD`gTuhKqjBgtIxwIti@_xMy|FhiIwaEyb@ntCwzb@ikBdkJgvGcaAziD~bI_xA|}IyuIlnCm|I_iSfyFuvFm|Jtx@u}Co~P?ntMwbOomPrlHttRe~BxpK~eH{dKvhMbaSba@xqDuxDje@viLtkJehKpkGtuRj}Ap|@z`G|lDktEtjJrEwzEejJvwRrrLgMkbGxzGayBz_G|~NtcAibEl}JgMgzFbfW"),
+	country("Chile", "bnzgHl`hiMbcKiwCbmT|oHswC|tY"),
+	country("Chile", "rodhHl`|hMmVsmHaiHdxEvbCccc@d`Ox{^yn@t{I"),
+	country("Chile", "f|wgHjsyjMlgFjTxgBocIdqH|g@aCtrNuzDhl@jmNphTxSje]ucNsd@ddBgfY"),
+	country("Chile", "bzigHfqwjMrfKfgArxOzkf@uwFrtKcE{|SwtH_nJhfDlcSaqEotIfNfhHxaEbdKeqIp|MmdRqyBk}GesHpqE_nJcrGa~QryDqeDw_E}cGlhEkTokEcvEbiUpgC"),
+	country("Chile", "|`wfHnipeMaiHnmFiPooEzoM}|`@z}GzqW"),
+	country("Chile", "lt{fHxyecMwyRtg^azAwcJ`cKgtY"),
+	country("Chile", "tibfHl{teMr~B}tY_dJzuEmhEcmNfbOwhAr|N{lNzmBrmH"),
+	country("Chile", "|{ogHrrueMnkGwuAvxF`sD|lDzeQyqKl~Zg`Dh~n@_kRbeFe~OoaP}iDanRpoQiqKycEsqKytTvyQ}ii@qyMxdEaaFfsHjhDdsG}|I`gInm@fhMirQzfDdL`{Ad|Oh_GwiL`lSac@djJamLqnFuX"),
+	country("Chile", "d{|eHxi~eMyiNfxAupHiaFz~Ft~Na|LouBwi@r~BezE}dHzpXufc@zdFpbE"),
+	country("Chile", "fhxeHvyviM_iFc_GupHzePifDe_CkxUxmLh_G{bc@a{BdtAgi@_eLtzPgrh@bbc@|dF|nOjad@"),
+	country("Chile", "zq~gHxutiMrkHdqMk~FvpD_pDopCei@lkIc~YnkFkoMywHqtCptIizGyl@jnMz|_@w`DteLcuQsug@~wAp~J}bGepA`jHbf\\uxFwMyeO{~SjiDvvSkmZpyGezDstEcwDizMdtFosBm`HiyHuIccLlcGp~@hzSgtNvtHnbCkTkfDlmDpm@vhXotS|cGllCwMy}E`cJsjIxiB|~HryCq~KjhP_jA"),
+	country("Chile", "plcdHjhljMqeYyaSicDerNfeBai[{hLkuLaS}nOh`MclCxtIffHdeEw~EbhFpgJpsAiwLxnOofFyqKdwv@{wI|uFtnI|m["),
+	country("Chile", "`}qcHx|deMe~B|kPg_PeaZ|~GwoUytIl_Oq{Ai_G`}MqtiAygDwxLt}D}mDlvKjjCddAt|PaiHvJ~kFxmR|iDblx@"),
+	country("Chile", "va}bHv`{iMayIvjOdi@l|M_cHnrAwwD{hFfjBafMimGwa]vpLuhEdgE|mKxdC_mR"),
+	country("Chile", "jwebHt_ahMz~HmjBfgAnoPusOj{QajDwpI"),
+	country("Chile", "pii~GbtoeMezD{zTnmEq~FnoPdcZimLfiF"),
+	country("Chile", "vs~}G`ubcMziDwkRppPv~PheEdpS_oEs`@_vAxqJerS}qHnhFq|K"),
+	country("Chile", "lfaxGxl~`MhvIk_G~jQdzP_lExuIoqD}_CmtLrpX_aJerG"),
+	country("Chile", "nmhwG|_{`M|jQaxCouB|rYapR_gH"),
+	country("Chile", "dxqvGvdggMfM{eE`pFsd@hz^lyKhbQfcZq_Are]ocHreEs}ByfConD{~j@mtAx{i@e_CeqH_xAnoJqzM`yBsaA_pInzKsj`@cc@qwCc~AntBi{GxaQ~uBczN}kDku@n[gvGm_I}X"),
+	country("Chile", "vbovGpuaaMppQqiH{pAjtQt|PbaLnZ~dN_dHdfAgvHcuE"),
+	country("Chile", "rsevGtyebMtxErmHfgNym@|zI`jHoiRtmHqAhgD{{HrU"),
+	country("Chile", "brouGvqngMlvKa_Ghn@j|CawNhjSmmC_zF"),
+	country("Chile", "niduGtk`fMlnNlqBxjByiCvnK~oEjq@h{R_hGr{BotNgaD"),
+	country("Chile", "x~zuGnabcMsxP`mGkwIgpK|`HkkZxyS``AntCloN"),
+	country("Chile", "dbcvGviveMivIocHcvEip@g~D|rGsxC}rGkqLtaBgoKyiGh_Gcz[xzFszCjeE~oDrtEsxIdbCniLjpA}~Nx{FhrExfCdzUzhDgmDndIxfT"),
+	country("Chile", "zkitGp|r`Mv`CuhKhvIouBjxUjiDwi@xmLfjLymL|bH|qBr~AzzHupTr|YqpEs|McsFdtGciJs~A"),
+	country("Chile", "naptGxy}aM_vBm{J|lCiq@d~OjmIetGza^bgJfaUcs\\ijN"),
+	country("Chile", "pz~tGtk`fMmoCnuAmpNsmHubBcnMzoMdmIpuOtL"),
+	country("Chile", "pkatGxyqaMrvE`_R_kF~wRwcDm_Ord@}_U"),
+	country("Chile", "j_vsGzxvdMnaIy`EdhAteLy}GpuTkvJzcFmrAgtY"),
+	country("Chile", "hahsGxyqaMteLymF`zBvck@cxDt{Ua}LtiFcmHg{Qp~K}mO"),
+	country("Chile", "rt|rGtpidM~lQvbI}uLhpQs_NokE"),
+	country("Chile", "j_~rGnw_aM`gIOhkBx`FwwG|rMbe@`t[usSxmQbvDpuVktJ~uGiiOi{M{cE{b{@tjVyq\\|`HeuA"),
+	country("Chile", "tciqG`{hdMmoNwwSnmEyqa@`hI_jMt`NjtfAu{DrhO"),
+	country("Chile", "l~npGzrlbMdrGrmTajHhtUstEqmd@"),
+	country("Chile", "v{jpGxpfbMe_CizM`n^__Qx`Ed`ImkEjqLykMboGz`GimLidOrzN"),
+	country("Chile", "jflpGthiiMphH{rLzwJrz@vjBxsVakGqd@i{LxcIsnGs~H"),
+	country("Chile", "pfbpGp|f`MnqOblCr`ApwH_iFr`MclHin@"),
+	country("Chile", "pfnpGzxvdMdzEqtDt`BhzNu{D`yMkiOtkJu{C}sRjqL_pD"),
+	country("Chile", "``moGpireMjkCadD`oFrpA{gDfdf@clHucH"),
+	country("Chile", "fqkoGtyebMjvKk}HfqTpwiAacJznTstEqp@}u@qkG}rBtvQkuJ}qAhP}cSw_Du{D"),
+	country("Chile", "dgboGp|kgMfjAgvGtcMj~Azq@l}IyaQvbCmiEyeD"),
+	country("Chile", "h{toGzuq`M~@izGbrFehAf_Dxe\\mjPv|PrDorC}qMhCsrEgsZdaNiuI"),
+	country("Chile", "v{pnGv}ycMxtIg{JznAhdCr{Btr`@cpF`iGmcG}pM"),
+	country("Chile", "lbrnGfd{bMgiLzqXdqHe}g@dbMvLijBrq_@"),
+	country("Chile", "pq{nG`{`eMe_CpmXeeMlnBqzAs~R|gEurBinLcaMbaAuiPvsI{bF~kFtoe@"),
+	country("Chile", "jicnGj`kaMjeE`n@fgAjsLgcC|fFclHvi@{jDmpC"),
+	country("Chile", "zpcoGno{~L~`I_rZotBy|AkkNnrIdzD}tXydD{kBomElnTmsMf\\onDumNrtEumDkpAatIxsIkmHq|KfsBeeB``Fk_G}iP`yMoxM|sMxtI}sMafTbwDeeS~~JiiH~cT~~PuxEpv_@|sMkf\\`qEtf@zkf@ho{@~nDrkT_~Udl\\kcFqpR~lDqeIotAyiHkbGf_Qd{DdiQop[|qM"),
+	country("Chile", "rwcnGlu~`MoiG|iKkdEcnYtwErc@hRhmLz}GixH"),
+	country("Chile", "d_vmGz}ybM?ecSdwQltAaxMti\\aiHxfC"),
+	country("Chile", "vr|mGpcd~LaqFlxFqkGunIl|Jc`M"),
+	country("Chile", "~~qmGtyyaMqa@jbLcrFfjGth@egWezDyzGplGwfB"),
+	country("Chile", "`qamGls_eMzr@afOdsFusAkoAdlV"),
+	country("Chile", "todmGpuaaMosCo|KnsCoz@~jFd|Iu~B~{E"),
+	country("Chile", "td}lGljicMqnFdJho@}zJajHhzApsPyi`@|nBfrUjtLszB`BfpDizGz|M"),
+	country("Chile", "jzmlG`fjdMpuCotBvgBbnNe|DblH"),
+	country("Chile", "rzglGnf}aMniFiR~]ogHbsEhgD}s@jeQqnG`nKamF_lK"),
+	country("Chile", "jaskGlendMfm@mmPzmApsP"),
+	country("Chile", "ttgkGdibaMgeBkqEdgLedM|hEhgBpiHazGppF|oCtd@dgEwqHtg@stEnbJ"),
+	country("Chile", "jaskGri}}LmnB~eHukJlw@wqIeiWffYkq@"),
+	country("Chile", "daojGrfpcMscE{gRrkEyeN}uFg|RyeDy@jtWkaS?rmTwbCj_Gc_CyiHgh@diKjhDlcH|bGqmLjiDnmPcpG`aF{nBlaY"),
+	country("Chile", "zmiiGt|sgMydMvi@zTysNi~Ewj@|sCioPviMgvPrtH`gf@"),
+	country("Chile", "h~`eGvfh`M{lBumJdbMetc@hQ~qO"),
+	country("Chile", "ng{bGp|f`MxrJfjAmYbmSi~E`qE"),
+	country("Chile", "hxpbGbja_M~kFlv@wiL|kJqnGr~XyfNhqEp`@mcS"),
+	country("Chile", "xmz`Gv|a}LlbGayAryCfjL{rK`fI`uDknH"),
+	country("Chile", "pte`G|nwyL_iFftMocI}iJhfCizH|xKsF"),
+	country("Chile", "lmdgGlnudMz}@hrDooIjqGirR}sQiiFreDmmDg_CwrHagPwfEhbDqiD_rGmdHv_CqtEqtKs`_@id@}}CozDolUroA}nK~sHgaOuX{bU_aHmbNvdBmtTovSmec@dKadJuaHe_OjxIexE_nDj_GsyOswD_lEjvJ}aCieCh~Qh_FfNvzEoiXg|EgzFdvG}|IqnGhn@uqHe{OntC__J}mD{rL|dGkfK|oCpzGleF{kB`pFblH~hRmyVv{E_z@zeFrxIjmNqtUvbOqm@h_EjkBvxGzvs@lgRzjCfoJu`NhhC`xZcwDp_F}dH{iD`eJhcKziDumDbaL`nAf_h@s}|@fpJzlBs_Anue@pnQqwOjVwtGv_PgtI_T~jPnzHowV~mDpkAgcChvOtxEdaYuxExiBdeAtgFngTg_EldLklNnjChxKneHyeK|rDbcKklElmLjuHbBvvAxdHcmJv_OlqChdKu}Cv_KxpFhdEwvGfxGseFl|^"),
+	country("Chile", "npk~Fv|a}LixSw`Cz}SgnK"),
+	country("Chile", "z_kkIjax|LnAghOvqFz{Ey|@liT"),
+	country("Chile", "lfaiIfoulLw`DmmPnhMm}A~yD~`IqeEb}Uc~HvjF"),
+	country("Chile", "dwpaIp_mnLaXgeLp`IjcJtb@ddU"),
+	country("Chile", "zrm_IntgeMvhVsiNieIj|X"),
+	country("Chile", "lvg~HhdqeMntNfmAl]qcF~cH|yDihFbzIm}EgGysKooD"),
+	country("Chile", "jup{Hr_paM{bF_|GzcCa_Itv[o]`y@`nI{gPl~LwtC`oSsrIj~Ee}DagG"),
+	country("Chile", "b}ixH~fzdM`fJyeK|jCz{@k\\~rPw~Gb`B"),
+	country("Chile", "~w|qHfsqhMfm@kyNbkKxhMdqM~tAjlGpiLouAbyWksN_zH"),
+	country("Chile", "flymHrigeM_wNhq@anE|vEkqM}bG|xV_dZ"),
+	country("Chile", "znvbHt`sfMp}AadWvyImHwWbpL~mJxwHekPpyG"),
+	country("Chile", "xd}{GtpvjMqk@gc\\tnLeeIp`E``As{Mv_h@"),
+	country("Chile", "|zqgGnredMrhKmlC~vAbeDa{@~eD"),
+	country("Chile", "~drfGhxw`M~o@uwE~oHb]csD~dJyvC`r@"),
+	country("Chile", "j|qcGlrr|LdpFakDu`Ac_I|iKugA|cCvjF}iQx`N"),
+	country("Chile", "zsdcGzyl~LxqCmjLnRl~IuzC|`K"),
+	country("Chile", "xiciFfd}aMh}FwY|s@bsEgyQjaG"),
+	country("Chile", "~nw`Fdmz_MqwAymBjkOy}MwkBlaHlmF~`D"),
+	country("Argentina", "d}xiCxxxxK_eSktUslUbe@}|Qwjo@aub@kaFgoFc`Fj]i}McfYoe_Aetw@asOl~G{~WrrOwjH~dDofUjb`@ovc@okDod_@h~@_hlBhnT_zqAzmL_mI`fLxuDpfGsgH|zFhi@fxU_cSpsRz}ClhTkcR~lVg}Diu_AkpM{}RgsP}csA_rh@m[kgYbhHmeM_kImvRhh@_bbDrwWmaChvUq~]bhJzA`c^meq@`yBmyNlsIy~GpvKtgAvxC_uJrsNk\\hna@yw\\te\\aoNtrX{pc@leNuaF|yR{ji@vgKu|A|jMwzf@pfGcwCfhOex^lrJdw@bdZc~Tt}Ko}_@|~Becg@fcIktInbMa|m@wy@ciz@`ev@usqAxnK{f[xdYykWrq]ilaAv{Xgmd@vpMwq`AnmZwyYo{HszTh~Xy|m@bdAuaUntQ}wUfmd@_cUbzLwcOvrVpjBzxYj|e@z~BytEv}IrtN~jIgtBvvGfjMnhE{tCrwJlsIzkAa`HlvD`qApyUbwh@~qMniF{~BniD~iJgzGn_EznKjsKqMriO|zGtmEykDbyMrfEjsKofIp_CxiDjkFgtAcDrbJ|cTnlAf`A~xF`kGzBaQt`InxKovCdnK~d^pzVl`FovBzrEhsMh|BzzFvcP~cGyDvhHo|HdxLnOkkF}fQisBan~AhtLu`|@bnOahe@a\\ixa@jeNqh]sSehSgwKgq`@hePm_YexJo{]fu@__LpvX}s[auSaaSkyPgjBevPqi]x_Fegl@t}KwpFnhGmlR{oUg|^{aOslCgiN`xD}tAkkGwrIkeCaH{`MiaVcjH{{Gw`YlxB_xZgrPsmA_lJotKokBevOqeUayIcuDwwW_wUeSgrRa_Q_dl@arJmiHp~C_{OadCaeOjzB_oEe|IwoYsrBwc\\tvIugBasJofIztBilBsKq\\}yFl[cyA|iAeb@`zAaGby@ag@wZoaC`p@g~BthAuoBjdB{lAd~DeX|`AuQld@ye@qWs}@gu@eY_dExYar@ce@a{@qsBom@on@wwD{Lox@om@oWox@pk@cbDsJe`A}~BufBsi@afAoNokCzXu{AziAebAjzDwoBga@md@eaBcd@gBa{Ah_@{uBaHazBclBxZ_fEr|B}Tap@bp@yuDaHymAce@obAclDcbDmd@shAld@yx@ptBj\\bkEdwAjzBwPrrBowAhj@_~@f@yx@si@gWieE~T{u@_h@d`C{iDst@i|C`QqtA`}@evBvxAylAnmB_i@n_C}Kd_D}s@cOwyBudCkmF|~@}hAhgCgaArcFcdAjyCpw@lpA}Kz`Aag@hfDclDrrBew@`nBaRrlJbZfnIgjArpDjg@dmAmOf`Agl@dyIk}Jf~DagA`mE}h@~wEurA`~JslH~cE_rBz|Fi_@vkE~gAnqIliGlvDrt@rgQ_hAb`E^bnBhfC|j@bQp}Bgb@lf@sUuSuGzjBlP`xEvnAfsBpB`lFs^rrBhIhqBtg@pb@bo@xXloBpaAbOzkAa\\bmAigC~|@qBzvAjh@h~@cExXguBffFigAlrJihAz~DhJv_FpjAriOdqGt{@jgA|_@laC`qApXpfGUh|B|sCxl@}Av{@mz@tr@|ApiBnbA~h@|iAod@hi@sdE|{Bod@~rAfl@|t@vbErt@h|@~}@eMlbBupBf~Cve@tqAtqAfLrsAi_@naAnCnY`zBis@xaBxDbp@dc@zb@hqBdl@lfMfkMfVtbFyy@tyDigCgLcxAvbCdlBdmArjL|qDziCftAz~B{Lrt@|K`r@`xDlmBzl@phChqBfBh|Byy@`zAq|CvbDrbG?f`Ajh@fl@bx@xDvq@ieEpk@kgAr~@gV|~AtHzzFrUvdBdbAfVdkCshB~pApC`fAf}BfjB~tKsAv{@eXz`AoiDrgBkRduBf_BdbBprD~|@fsBtaAtfAbtAyDbhAgsB~]wZpSlEpj@xc@|j@xbA~RlcCfBlz@`qA?xbGbmAveGiI`g@woArrAs|A{k@cdAnb@~RboA|sBnkCpzGfz@tdCpq@fw@|}Bi@lwCvQ|s@liFiJtmG~^llCf`@j|@lf@hj@tzB~aGdeIjRptCirA`}EeXzyBdl@hhBxcB{|@|{Cu_Ed`CkzAz`AzV?bdBafA|gCdXr~@rAvhCvQvu@btEz`AyX|~BsrB|fCa\\rcBzj@b}BjnEjcD|}CfaF~Rn|@_t@~dEnEdlA~pAnaB~yEfq@t}@voFr^b[x_FjNjsKneJnm@dxEqlAdlB`^`pAjmDfxAnkBbrCvdCv{@pLdfAiTjkAkpCl[yXjoCxmAliA~fB|k@rwHjwJ`yBfnKldBtxCd_Dcd@jq@}iAnwCcdL~bFsrCxfH`uAnwAxh@rjAr~@bn@pfA_S~x@u{BdWgsBnaBcyBl`T|hBhxGvzA~pBdsD{v@jbHr@~gA~^rr@piBtSbjEdmAdkDxl@xiEpuAn|@|uM|AhpCduBdsFvcKvwQr}Nt{BzqE`p@|dH~q@d`Cp`Bfu@nvOpe@~yE|hCn~ElkLbsFrwBf|FhE`yBzVrrBjkAtr@|dBp`BnnLjdBryEpjCn_F|qDtmE~kHroExxAdb@jyAdE~rAsi@pbK~dDldBrgA|gChwDnbBj|E|gAjeBldB~bAp}DhO`r@peBbbAfo@h|DtfAbzJ~gNbmCr}AlmDdtF`zAnwAdtE|sAlkD`wDz`CpjAzkLthMzvA|sBbe@d_CnCnrGfa@pfGbxAjkGxkCzsDroEfiBfzU~~@z_B~f@fmJjzOjmS`oQx_DbhGvg@h|Bzj@fwKlz@bt@huKatBvzLdtD`o]pme@pxK|yFtn^g`M`o[anC`uHnkAfiCr{Q`gXqoHrxT~_J|vLih@p|]|rObyMhrOplNsgPdiPhJd}_@nxLbxCzgQddJ`vHtjWeeJ|jYv`Gh_XsqPlvg@dcWjdaAq}FbaM{bGjf\\lw@|dGjgJweB|{a@zhDvdHvee@mnB~n\\rjTd_q@zjDhb]afP~n\\wuAx_F_rJ`uZwx@|oOb}Nr~A|oTnfNyrU~rLjbJfvO_hFh}HksQhpOkmKxt_@ojcAt`@ojQdxb@gdcAf_y@yq}@xrX_jMv{v@`zj@pmYd}FpgU{lCnwd@_}VzzTqhZvjL{h`@btEdHy~FwbO?}iPoiFzq@p~@o|ElpNqkGxg`ByrDlgdB`rjA~|pA~gsAb|NbbG|mg@rwDzy`@tgz@pxe@trzAhgf@r_nCpqPjdnBvtIzb_@fw^v~eG}rBdrv@`tDpqPsmGt~f@jnAhuUciJj}j@}}TbfQgjA`mj@vyRkc@kR_lFxmKc`Bue@eaHdz[rjOf}PosB~~Je_OsDfjLhsJqem@tgK_{KrvEzrEeuRzsX?ltQjxUwiXr{B{bQ|iDsbAn_fAhmLb`AtmV_jFdxKjbG}bCxgBn~Dpac@lbIfmJshDjmNd_ChtUxmXxb]utMrtE}yOvhLgxGkoAayBd_O`RstErkNjsLwdBohGe}[zfE`iHfyh@neT`rt@rxjC|tAn~mBa~@|of@auPrzZquB|vQmdGsCpz@d|Twv^j{zAklCd`s@{dEamG`_@w_WcoFpxIyjC_rDmqApxC?dgc@tf@_rJv{FgtAwPp|RltMhrVlwW|qGlhh@qsDzhs@avO~wMmsLlpZr}Gr`XehFtw]jqM`xZsqLtrRcz[xrK}tp@kUwyVd`C_gB`t[z}YbyCsu]_uBeuaAkzIadIk`ShhBmhEn~s@}mDqta@spWk{w@md@e~Rblj@wi`@`fx@msBjwVdpI|cFkpAvjMhvUfoJjogA_fTl_[ygZ}pHkdQnnVw|ElnUp{A|lf@rnRlb_@lkEnvZxaQnfMplFagCjmZwtcAfiAsxZbsFsrLw`Dm~Uf}DotAhcPdl[hkNl`}@h~Pt{a@xuJrgPdxQ|h@jhs@l~w@l~_@qmA|vXe`H`eA}mGbdIprAtr@mxIznHt~H~kI_oCviBlfLx{GjbDnn_@{|PtlQzkUzyI}eIOjiQhvCeiA`gAngEjtE}xCkz@`eQtwCqDxaLhrb@`tZhkOdxJ{gAbbKw{d@f~FflOlvIoP|yEzdPesFvzJ~fBr{KufFdvCb{GpnQy{GvsNu~@`}b@fsKpzNmwAxhLxwG~t[znGlrDblB{wLxwBzrBivDrjUv~MwmKt{F|cnAzfQ`nYbnr@pds@~x`@dkE|nFlyP`x_@h}Vbk`@|qGtkm@}|Nfm_@k~UzdZugx@d~T_|Qzrc@ceo@xuGix]e`AgcW~oHuoz@iqCycj@z|D_fRn_TsrYjyYifEfy_@rlCdml@fnTwOdlc@rlVrfn@nZdoUzt@iePkyRyku@oEuqVflTqmH~hE}{PbuCroEzmAinEsk@~h_@~sSr_GbcE{vKj{ExvAjz@dkd@pbKd}HjlNjv]~bJdmF|cEuuAkNv~SfmKzfIziB`h\\r{Urkg@nbG|t@nkOllo@vf[rkOxwOpog@jxTpm\\nhU`zMxkHmlCnzQ~{Vz~Fu~BnnJ`eKf_@p`JzdAssS{_[egPjnBkuDfjJnVhfh@`iPzfo@tfElfWfaK|zP~vRd{Uzvt@jUnp`@cgeA`~{@u{DrvOtwFaxMtg^k}Or}F~}Qsc@dgf@xyGf~YyhFu~rAhzG{rKvqK{{CjlLgb[xcIlfPdjXprtA~yk@paf@dpb@`jMno^iqAvfb@~nt@qsSkmn@~spBuag@zQprOzbGbaMajHxhq@viLrxm@aeEq~{@taGky[jbIjeGucMwe`@~b@iyIrejAyiw@lyy@qk}@llEsRrdH|xIezBnrBgzB|kAmaFoAo`Ahf@wyBpdV_gBpnH{~Bjye@_}@nmFoqIta\\c`Gh~f@ea@lit@slWrhzAokB|w~JmxBbhHyiGi^sfEihA_nDh}@aoCjpCgjBtnDmrHjgKysFlqL}sB~wGewBxjQs}@jyBu{B~rAqxI|t@ihBtq@mbBz_Cqm@bdN}pCxaCi|DmlD_aHm{Lyn@aQkmDhTa}@iTsfCywBkoB{`@_hNkRazA}j@ioFmkGyxA}gAazAyFquAleBmlEzvMspCvkEwvElaCs}BjH}tAkq@gwIaiJmo@gyG}gCkeBkbHmdAyuOox@{qF~h@g_Dz~B}gCdiDunDrgDclBxx@owA|@quA_i@}xHm`FccB}K{l@dvBoYtpCqiBhmF{uBl}VpuAtlG~aIdnLlE`uGur@j|Bg{Ev|Hcp@fjC`SllCfdHvhWptBd}Eb~H~~LtdChcGboAbtGsAfnGy`C~mFwkEfw@_nFaH}fFrj@_|Af`AgfFxfGueBnnAweBlYwfJIijMfvAw|JwHkcEh~@ipE|iBabEjeCkyChqC_hAdtBs|An}Fc{MtjWehDdcAg~Dk}@{xJc|HuqAqLiqBnv@emArnA_dE~qIk{AhqB{kAcZq~CkdD_mEkRgvAcZc_FmfIwxCqvB{hDfLscNels@q{WgwYamNqMyhOt}J_kVo~Bwe@{Bis@|tKovBp_C_zClFuzCkpAmsIyoKssA_~@}zFk]ojCqtA_jLemLwaDuyFqjAcgHmo@e_RqaAgpGi|BinFy|HmzLsmGkkIiqDkdDe~Ds~@spFyXauSve@uvIzjCm}Htq@whJalFmaCerFycBuaGk{A_kKox@keBuvGgzGiTynApCeuAhh@cwDmPupAcxAgl@{jB|t@q}D|gC}hBkHcjFwmAkzBsKqoVfsCg~Bj|@ymA~wGgj@nm@ifDpk@azAtfB{aBhkJy`C|fE{hDl`Dy`E|t@{gE_zBayDssC}tLwaDqqE}iBehUkmQwkE_rBucF}i@{nIaoBssAvFg`A~fBk[x`Cqv@fkAirCcy@uzAczAk|@wnBufAo|FcFs|C`g@goImPggCa}@_hBy_Dg|CusLoaPszCuzBqkBsu@s}@bFifDxmAqiBfKsjA}Uwe@wdAzB{~BvwDeoKri@_oEymA}eEg}C{kBclDoCucDjgAmcCvwBazArfDacDdoKisBnaCkzBs_@wlD}vKobBi{BuzAfiA_fCrpE}tAh~@myAqCcqJueB{~BgiBar@eDykC|~Aa{@{BmyAybBa\\seDqA_bI{c@sfCa{@wyAkmDwcDwoAqjBykCy{Iq~CkmEq}Dsj@ycM|~AkoBoMy`EonAeuBnZwsWpaL}yEt{@wuFlG{{G}UspDnXa{BlxBgqD|vJknEhmHkf@tpBeZr_@}~@}Kec@_r@aQevAih@onMk]ouCcy@giC}iAywAwkGyiEo_PavTkbFpjAupD|hBsfClxC{_BryEocApgGwy@p~AgjB`g@euD}rAg}AeNapBpuAcnBvbDmcA`z@obBjSgj@eMisBebByw@uRu{Bnm@clDqa@s|CmnBo`BywBkh@cN}j@fV_|A|rBuoC{_BccBe_Fa{@icGsUmlEpWmcN_^c_F}~BudFyaBmcAk{A~RcuDr}Bg~Bpu@u}@qKcjFofVu|LmeNqhEfb@amE~dC{}C`yCwmC|gEsgDpmI{}E~mF_hAt|@w}G`pBuuJhyFclDrpE_Sf{EnYvjFga@d}Fcy@vdBqsCvbD}u@~|AwZblCrJ~oCnwAnrGzV`wF_sAhyFsrBhzFcbA|yFdMrnGa\\f}Bq`Bnm@ktHsi@crIlzAshCemAmd@spDlmBgwJrrBu~XmPuoCa{@upBadCkcDcQovCtSylCnaCycL`HabGwwDmvPen@umGby@}xF`wF_hNv{@akGod@kmFsrB_zEyuDayCsnFqk@a`I`{@wwByb@ieEkeCqjCwxCqW_bG}~@eaBmcCyx@ovBr@geEvkEaaHnbBowAr|Ag`ArqCgVr|CgB|bIggCvn\\lEnbg@ox@v~H?rnGby@`kGfBlaGwaD|oFmoBfUcdAufBmyAegFqtBag@cxAxn@{tAzkA_gB`p@apBeWwwDkfCokBkFqkBbm@mhGjdEoiFvzA_sA_rCis@avF}gCipEwjSwaQi|Bq_A_sAzBssNdmL_hAfhDae@vd@ydAdN{rCweA}iC|KoN|j@~h@hsAh_@~eCag@rqBaeBfVgsBcc@_sAs~@{u@ihBa{BezJkpAkfBmnAoa@aqLnaAs{DfcIolCrrCi_@bcA}_@`hMmyAbo@m`DcnCmcC}@_|Ap`Aox@dkB{Xt|Bxn@laEgV|}@a{@bo@ihBsqBiyEbYew@ae@u|A{kCkq@uR_{DiJwn@eb@yZwjFowAwvFiTmwBhj@osGkgAs~@aaFcFgrEvq@g{EwzAomBzk@adA~{BkoDh|PcoAt{[s|AtuG}fDjcGwaFrnE}nGdwB_zEag@}uKmlCaxEui@ad[rt@kvHhsBowA~HudEu|@icEfhBg}Es_@{hDo_CovBkyDkzBc}Hk|@cPogHb`Fg`A`HksIybBguBnx@}eF|aI{}C|jAyvCgw@ekCsfCuyBo`D}rC_qBghDcn@o`DwfAu{B}zD|BeiEfeGcwD`{@mvCa\\oxAanDs|Cm[ylAwy@qwLs_AioDq`B}rC{~BkqA}cItSe{Gv_GefHaf@i_MvyBkxDz_CcxCvvC{hDboBoiFrIumE|_AyeK|bJ}zDHonAewAq~AotEg`AywAgjB}~@afArUacDzsC}hBdm@ymAzByqHu|@yxAbEmnLxuCieEsIm|KmxCq_CcZo`BbO_oEdmAq_CbEkpA_pBaeBga@o~EkSo~E}t@ufCaFgfFlxAkcCfBmaGmdBwwB?uzCrs@mmBxcAq|GtwFojCz`Ac~SxuEcdAcd@{cKw{M{~Ds}B}dFq`@cy@~RybAl{@w{@vFqqEcbCaoPidFgiAoo@aS}gAt}@qyIks@__AweMo|FwcByZ}|BbFg_B`gAkh@dtBbZlpAzkAtfA{KlnAovDjnD_zC`bE}}AzvAsiBlo@{}C_hAi~@`\\i~@s@imH}sAueB~gA_i@eBuqAkrAmPesE|hBsxGh_@klF_nFynAyiGhiAqt@qMyxAirBooKogFmcCkr@k{Al[c_FjkHafAh~@wxAr_@_oGdm@mhGrrBmcCdWaiI}}BsrDrhCudCvOshCmZanB{v@}}A{`BRelAdsFixGxw@azA`QqiB}h@e}GpLsyEkrAafBm`Fap@_oEj`HcrGe|FmsIwwFudExbBcwBwEyaBybBi{Cg{FevC_r@g_O_~@emYzaAkqMrqB}fDyCqmKoyAazCcbBykCouDkkFmwPelBmaDwbCwmBcoA_gOih@ybBuzE{jJe`A}{D}@goFvZcjG}TwiCwfAe`BmvDu{Bs|Ac~Ek|@kHcoAnaAur@rPclB{MgmJo}Gk|BoS}dFxxAwxCvaEi|BbiFgrCjnEaxEnpC}f^jkG{|D`Rg~DsJm`Fsx@uvG|xAiqB|AugBssAghB_OcvErzDaeBjw@sdGvzA_nFhdDkjGvl@goJrnFmzXrbIiyGfl@maCkHowA_j@afAufA}iAg~AiiAouCwe@i_@shA{BcnBpkAmcAnLyfJmaC_{BuRkpCp`@}aIryGkkHjdDunDt|@cmCbEcnBis@ysFwvFoaCmdAivJbFieC_s@uqAowA_gBc`Fo`D`kFobBdnA_lHxw@ebAdm@mbB`cEmyAjzBjRo|Gu}@kpBevAyn@evAb[q`BnsDmtFg_A{`Ajg@od@`p@mo@|rBi~@r_@yw@sIyvCirByrIi|CmxBk\\{fF|KeaBiUafAg_BhIs{BjqBcfFox@k`BaeBgLydLpzAyhFsEo~Gym@k`FkpBui@coEvp@krFuQwnBa}@upByaBygAyhFck@owA}s@w\\{kBnYcgC|}AqwFrsAcfCqtBmhPafA{{@ipC}@umEd_CymAlR}u@{Ns|CkiBi{CzLwy@iZec@em@yn@qnDap@wrAcuF}eF{`A}}Bi{Cyd@oxBo|@{j@ck@{X{pAfV{nCnaCq_HcF{mD}~@}yBmbBkeBkzBilAmlEgjAcy@}f@eaBokBmnAih@}_B|S_`KptDigA_@{uBcn@ejBi}Bwp@cF{l@bk@dMbfCcZts@q|EmB_kIehK{gEnYufCt{AszRbyGyaBlF_cFm`Au{Bw@ixFfe@maGu|@i}Adr@izDntDi~KfsCs~AdOis@gfAoYozBu{@mkAuzC`fAapB`}EwZzzGmeAfwFqpFlrBgzH}_FciGuo]wqLepBycMnXi}LauB}g]g`NiiAzWipCrfDelB|u@yc@yi@_SaeBmz@y{BcbEyaCi|Me|@etEgzAokBmsBwwD{aGs}BqiBgoHujCgiCawAwkE}bHqtBqv@eaOhc@ojCgj@owAujBwp@m~Ht\\_dGdbAm_F{Veb@qjAyU}}CqqL_}@eW{tCrs@wgIpbEugB`\\yiE_y@k|Mgt@_aHfAghDd|@_UvsBj]bcBuSxx@wlDkHi`JibAmeNhlB_fCeB_hAoo@{rEiaHs|AgAckEjhDweBrt@ayBaHsaJcbCanDxc@oaAcUyZsd@i~@wlDkqMaaA}nGubFg_Bio@_{BxDszEpoBkuIjr@qsCjnCwcBflEafAp}E`\\l{EhaIrmG`\\lbD}vJlaEgtAtaA{tCzrEmyAr}A{jB|t@}|B|@mgJ}lB}~BrSklEdaB_}Bor@s}@uvAij@uiEgu@ovAeaBor@gxJ}iBmkDaiBolAH{w@hwAsgBxxFyjD|lEuvIvhIuyDdaBicTjyCmlC`CgsBqMmdBer@{BsqCkRsy@_eD||BgdJlQg~BxgAafAhcBeuB`qF{~DzoBqkMmQabErfDebAf|F_r@pjAwxCpp@c_FoNykE~aBekAdNaxEg_Aih@fVebA~fBa{@|Y}hD`GetChqCqv@|ZafA_IueAowAjQgbCxxAegGyDm}Cew@_y@ieFir@ucDcyBaqAid@cwBhPkfB`eA}sBxwBuzCnnF{a@jqGbFvdFcn@huE_fEb_Fy|F`{BssC`[wiGqL_gBl`Ag|Df}D}}PnjIwxClz@_pB~HqqGo}@}dFsTseFxEyuBsUcbE_mBqtBoRixFlmAqtB~IawFqiCcnBkWgmJkHo`Bga@cyMw}I{l@ajAtr@azBdpG_mAplAkkAgVg{AqlAk`BccBakAawDct@ovBy|@wbE}lCkq@wtBdc@miGwQohAymAlEeeIrfJq`B~}@wnB`XmyYkzB}{EiyAclDcmCekAge@{iEbVi|BgW_|Nu|F_}MiqB_pDy|B_gBonEg`AumA}|Bkl@oaCz\\_cFr{CylBgAebAodD|{EscO`p@_tEew@i~EirAuyDewB_eDwaDy`DihBiy@w}Iq\\mkD{l@{aBmtAyvAunDqgFoqAg{EjvA_gDr`E{iAdlF~]trFuSj`B}hBpk@quAu\\{{GsuEwaFcrAogFec@edYeh@y`PhsAssAzu@cwBd_DmxBp{@mdOq\\}qQvoCykC~`Amz@|o@}aIrz@maGdoC{hBrJsnH{Vg~Bsz@a{BcdGyl@kw@ox@{a@{l@zG_sAriA{a@vGae@yZ}zFeiKazC}cAmd@{eBwp@ixHox@whCudC}qBshC{h@_eDeHitLz`Aq}B|@u{B{VovBio@ucFmlDe_DoNw_HetDapBeR}gCrUksK`yBmuEyNwkEimAizDmtBccDmcC{`AokCebAgU{qSoN_uJywBubGu|GgoFeoIsyHonGejBkv@}cI_bAg`AsxAbp@s|BlnAw~BxXw~AcaOekIafNabFobBevBqhCjGu|Au|@i~@akAug@mbByxAiqJejBu_DqiDkjBi`d@goH{kAge@iqB}fFyn@}_@wzAdYi{CnqAaeBoDybAmi@kxD_eEimHc}CsgDwz@cvCcPifDnYwoAkRigAkl@ylD_aIekA}bBgtA}dAsqRomHueBkVqcJpAaoCaw@ceKycGeaB{zAen@uqA_r@}dF|Ko|Gp}Bo~ExvCuoElyAeqFaQkzC_sAq`A_{Bwe@giCshAqiBcmB_sA_gCur@qsCgKw~Ha\\yaCylB_wGdB}cHiwGmGijM{gD{qDhSmmDj{Aazu@lwj@qpFbaD{gGzjBm}Hlp@qjNwG{|F_h@wvEgkAcvEotDipP_tW_zE{bAo{Hlq@oeq@`pLi}NrjAwaDxw@kpCnxAiqBlxByvCtoBksIr@{`Cnx@}}AguAoaCjgAm_G~aF_cF|j@qgF{W{np@}pPclDwnCg`A{uBtS_lIqWueCkq@}{BirAomAi|BnCo`D~eCwcD`dE}zD~nC}dHme@_zC`fBemA|}AuzA_TowAzl@ks@`f@gVpaA}@r}B_pB~bEs~A~sA}lIvmCsiBnBggCwe@anQa~HkhKemB{`A_r@wQqtA`xC{uBnd@weAauHguAymNgyFyy@mnBhu@kyEuH_pBocAynAsvIogFg}EcyAafAaoBqsPawl@}}f@ys`BuxEkcRs|C_wHayDclCee|Dop}@cbE{a@qza@pcb@"),
+	country("Argentina", "xltmIngwaLz~Ga`Gjp@|wOwjEwhAosIcAre@q{@"),
+	country("Argentina", "|_k`I~ltaLrfb@eq_@tbNkxUded@avOe_PhtIvCboL|kStl`@baH~sEx}LiXzpJglR~~GukaAh`b@gmKfgNioKzz`@i|u@jyDnf@rdEyk\\`nElwDppFodBlai@wbsAfuWcbhAvdi@ipv@|mb@kr{Br|Agp[y~E}|jAd~Bgu_@pmGvbIjpXnuHtsSfqU_gGngStnHzeFbFvpU~iFfpIseK~uHal@~ho@xt@jdC|uDqdFriEvhGhlCff~@ncJ~aYwaCf`b@khPrv`@mhFdqk@ajG~}nDmvLdhgAxxHpbDp|@pod@ufLprUeHbc@gsaLcxA"),
+	country("Argentina", "|l`mIre}dK?{{M`sOte]}VfuCeoI}dA|pNrvr@ikAvbDajHwvBpB`sJ~yKtdYecB~~Jp|Mn_VyRlwKctEgcCstExqPacKiwMlmD{nNweBkzNcbAknBepHdvKug@ekDtxEwtGeh@{vPx|FslCiiNe_Ch_Gooa@icEopD?mxKtxEkiD"),
+	country("Argentina", "`{zmFbgzxJvhMk{Gf_EjfDahJt_ZcqQv{L"),
+	country("Argentina", "`wdmF`d}yJnz@zsEw~DiBjSmnNjbGufMzkBnkG"),
+	country("Argentina", "xqluFrujzJ`Sc~JbcNgxKjiTdtNoj@|gIciHlZlBn~L"),
+	country("Argentina", "~_}sFnrczJvtG{jSl{_@tg@kv^`{T"),
+	country("France", "aahMjuykIq_NumF_`La_V_cgA{bg@yvCbcCqjNipDoj^dwAybAbtEwfw@{md@uee@{}DarC`~Jyb]dvMcaDraK_jk@ro_@mqPyvBm_NbyMw}JueB_{h@haJymV{iDqoS|iAe}G~lGqz[jq@arEm_FgbPqLojf@eyY{ch@{zo@yd{@wxUzsAsdYfj\\ifx@diGezm@~jG_gHatBmjCo`HbmKxe]ofhAhwcAgfgAvqNmhW~eNkrAytI}vK`xBwuGbqFc_Cfx_@r~Num\\oeTvob@eog@bxm@clMxoVdtQukJemTkwl@mdAqoFitDdw@qkH`tIcxIblf@icKfxAs~F~`IbfDd`[_|KtfXneCxdCtcAheF`rMxeCv|Cr`ErxAziElu@ddDjlAbrC|gB|yL|pKl~A`bE|o@ho@pvFzGjv@|t@gAfzApVpz@`jBj`BdwBnj@zkGfo@~oCj{@~dKxcGnyF`zB~{@hxBf~CeD~ej@vkYz_Al~@th@`qAsJxaBdNzlAfjBtyB`iHr}BxaDx_FtyBtg@nlCqC~mGwz@p`ZpxLh{DwoAbcBdiEtdBftA|ea@tfNrnFj{@voA|qDj]dYzeHgtCjqAtHtnCp`Bn|GjdCv`G|rDnjEpeFd|FbcCzkBrrAxvA|iBddLzzTdkB`lGpuAxkB``H~|B~qBfhBzKnd@{KlbBd_Cz_ChKvcCsu@tmFyYffGe_Dv`Eom@bcCHduBhrCjrYyx@beAu|A{m@ywAwnBgk@azBw{@{AmhIhjK_yE|{Cqk@js@qWhqAhKl\\ryFtxDht@hiAjpA|yEzgDhdFj]ldArJjnFr_@p}Bk\\f`BqsB~dEmq@paLosDzbLmFdtD{X~g@sh@qByxBi~@}~@eCo`BdcAeXhfBv[rsBf_AnlB|oG~yDp_@l_FnxAnmA|gBxw@dy@xnA__@~dEh^h]bxCbEjpBdm@bnAbnBr|A|eG|tAf_BpKhh@}~@z~B|AdaC`\\dm@h{I|fCltAbpArU|qCgVtqAml@xx@{vFvaEs@jeCtrAznIuh@|xHow@lbCyjEzqF{B|qDekAjcEa]viGst@bcBo`CftBi|CxuEybA~S_ScmCylBvnBmzAf~Bmz@|qC}JphDayBmcByNmyA|~@}pE"),
+	country("France", "ymcjGelsl@xyCbxF{yA|vHv}LhcJxdEd}a@ttRjnA|uAdpa@~}TjhLrzB~x^xdJdrAr}NlxWmXo_S~bO`mEtnG~oq@zoDn}EvlDay@aoBpqb@~dFdyFxdDqhE`hAn`E{Eb{KecFq|Fwi@~|Re|EvcQu~Bwi@|sAn_Kx{Fy`MtzEhoQiuEbrMqkD_xDghMtzYrxBj_KgeItaPmShwa@usIqiCsrOrsMljDh|Iom@r{f@m_HxpDclDo_CouBez[_dIagI_sCzTrpBvdOgfHduEu~Cp}Mx~Fb{BjgPmdGzo@jvb@b_J`CsJepEl|CryGqvNfcRi}Rb_BitI`jHjq]qjHd{M_eNqsArok@_eAxaDsoJcrAy_D~~Kyp@bv_AopCdpHctKzjDpiAdw\\lf_@pr_AniQ~fTjoBvee@zr]bxf@`}^|sMvg|@yPrnMq}@dzDquOvjLqoFh{C{^lg@`pLqLp~Ck`AnjEun@t{@k~BdxA}UtaH~r@piBux@diEpuBjlE`QtwHfbFzcKhNlaC}ZxsFbF|~B~h@lmBjyCpfG|t@vp@nvB{w@v_AolAbZqAbFffDg{A`hLlAxwB|_@lo@fdBhs@p]~h@l@f`Ao|A~vH{eAvwBk`BdaB}cBzl@gb@|dHw}BrjJ{uBjxFtNjcElxAfaKqZffH~a@lbBd`DpiBfgDp}Fva@hgA|_@nhG_IrsA}rBpfEs_Blz@yhF`qA}gBftAu^z_Be]pfGypDv}Iog@bbCqf@fcKkb@~qB{a@f@yT~]_{CqU{`CcdAy{AinGsbDrbI}}Bgu@{uFb}a@jp@v{MhuFd_Brh@fiCevAlyAiuEhhBilGreFgV`nBaXt^ywAcZk\\rqCy@|`Wvp@toEmE`dAyx@fjBwoGnkDehAlmB_]naCoYzsOoaBbkElhAduDsSdbAqfBtxCgt@lxD{tB~lGeDtcFgoAzcIb@~wGxh@`oCxxAt\\ndCqL`nDzvApeAcdA~Ynm@hxAj|@jVowArdA}iAr}Asi@rcBlEtaAfu@|T`qA}Tp|ExO~iWc_@tfBdr@hgJcQ~{BmTn]agAbOukBxkD`]jpBdqAvmBxuDlnD~Hx{AqtAj`B}mD`eCoc@xbBa_ArkIrJldBry@ftBbi@j~J|aCdaNzMjwE_gAvuFqvAjkBqe@npCmsAnXa`BhlCq{AljA_kBnc@qkB{LtfAldHuMlfDgsBfiAwgDlxJuaA`_BrlBjlAzL|o@ug@fu@pmDjkHpaAbvC~Iz}DcsBdpIgBf~CvsC~IhgAroEk\\j{@ylAuHyd@dc@iSdiDglAeCsnFpqFoeDpdIwEvxDql@r~@_x@za@ciB_^o}EftBii@ffDv~@z`Ne]`qNcx@~mF_wBtxEm`AfkAyh@`_Iy{AlkExNniFgyBvjEkvAxuEin@jiJis@ptBixArhBurA`[{pAuq@oqAyaCmZblC|e@dkCbxApjBh|Bfj@vbDdNlsAht@tXfiBuIdjD_]`xCmy@fiCe}AjpBofCnmAcmI}bKsdEg~A_xFybAucFzVofCprDnYntE_cBrcGmi@laEgB`{Bnh@h|An`D|sAv[dcA?hqCgu@djBu`Dzv@sbAkQi_@t|@em@tnD`vAbqKqq@r^sxB~SilAxlAy~@frDqwGuPp|@csBom@an@cb@uqBclImz\\qzYelVi`lCuce@uqp@cwCyjT_yLuW_uZkkHl_C}zJh{Svm\\zePmngDqoVukKg{FwcBk_GzdKmsBfbJgjWdhWyb\\hld@yxHftYyu[buQ}`C{nMox@ykHpdJpeDc_S_wR~t^gdr@fmKitUnxMmxb@`iw@otHx|^wbOiiBgxAo~KnmP_oa@ssPt~Y{xJvcDiaFyhN{uJeYwpI|bGdcA_kFybEyvC_nP|iLm`HdiQivI_bE{xDimLsnG?wqCp~K|dGzgKctLhlXjbApiN{sKnkEmnCfd[i{R`}^boF|e@coRjiDy_Rf`Pa`Xjxg@ijMp}@avZ{}YoaJttFyyHjfh@u}CqyMwdS_\\ysDmo[nmP_dw@ahTfhv@tg@j}Yp`LhmXeyFjdQ`{Bb`NqpE|gQej@etSk~FttGliDvbCmfKxtIo{Fmc_@oj@|~IuyJdbHq|AspXgl@`jq@i|FowGvuHphSxB`e\\quIbmNfk@yw_@sjIaiIqnFznBx{F~Ky{FfvN~eHhmXuiLjxIzbSexE{`Hb`M~`DlWv\\jjJkjC~sHtqN?x}FcqF?veH}kVfz@sbGhrJk_G_xAgrCutMumDti@ixAvtMf|EctEntAzlHx~F~gBy~F~dYre@ywMc{BxwMa|MywMhyHlsRfsBwsBtuAd_O}uMt~NapEdae@hlAbbXoiRboTvwG|rMs{CtsLupGd_DhtIebCa_@bpMtiEolExqD|iEre@hmc@k{IcxCm_Uv`Ps{HlgRj~BbqFuwExn]qtE{iDy|FicpAcyN`iBa`Fr_Na_AvvS|wKdaMgvMk_AmvErmNqQs~H_bJaqDt}HyaE_hAga_AwwApxYk_GsxIziD`_c@exQ{~^z{N`m{@x\\j_`@qca@{VyjH_iG?sg[uxEn}DbG_dNomDhpEetAkt]gxAh{BgdCigOb~AurG`iC`m@kt@u_UmdAfwB}yDksF|e@smTkiDym@g`C_cOnuMiwBe_CcgKrmHwzFixIre@fxAu~BkfK{iD`zHk_k@azH_Zef@_eIm~HrkI}_I_}KlwEo~U{tKgx_@hhBelC|}JtrAmiLutMuv@_pIxkG_yA|iLrmH_oK{uJvNieJppEztEd_CajTrtE|sAfxj@asr@abE{e@yvOwpf@ckB_wAui@nmD?wwR{mHmuLtmDctE`_GbmH{~B{nNliL_hFysEubC~lE_sB}zDkpAjWkdF{iDd_CetAaaQdtYkaMs~B{~FytIz~FltA}iDoiLpxMqxIifO}sAy{Rn|LpjB|zC{tK}iDqytAiyB||O}mOtpT}sMbqA{hDbaF{wO{vJiqQpQ{SgkFf\\`vOykMnwAa~FanFecMx~F_{@qbKre@p{M}kQdpMogGFhkBr`BoyGxzR_jLhQ{kNzvKmjPgyG}dGlzDwdBxzMk_Hc`AzmMid_Aq_Kkoe@ncBir[h}Lo{En{KpiNz{ZaoX~oJlf@bbAqpJ}_Ii{Hf[_wXntHckfBrfKyld@wsIckl@qvNie\\keF}ro@ubGu~NleFx~\\onJd~q@{sc@k}S{a]qfoA{wVe~xByf[kyl@exPmvKjiCar\\qtOzqXy{Lo}@_fCwnJctExwIkaXquCy|AgaEilX~eHkyTohGikNr{Cy~WcqaA}kO_iqBki@o}AhnCe~AxrGu}@rwB_}@t{Co~Er|AeaBj{B_Sn_CxsDdx@dc@dqGkzBziC~|@faA_{BrqBquAdXqhCyCwaFlZaqAh|Bk{AblCogF`mEmwCt|@srB~eD}pRkg@uqA_nE_{D{lAupBcx@aoCccDewXj^eaDr~@}hBjxEo}DxuB{kAxc@wp@dXyjDxx@wp@|sCafAhgBdvAzsDkq@t~IkdDhfEuH`fAi_@vxBesDlmBm}JsJwvCs~@}pGekBqqEoCcxAlPyxAr_@ug@jq@tHldAv{@xc@qlAr@urKr}@muG|jA_{BztBmdBppF}s@tbHSl~F}u@xbCszEkg@qWkIyn@c`Ei{Cyc@kq@_SssAgLqoGhSubGj{Be{I_@mlCejBglKaHmcCbd@mxBhrCknEjzBgeGljFejDpa@}qBa\\{l@_rAnCiTcmAlyAuxEtg@ce@f`AxMbvBtoEboB|}AtpBr`@ryFbwB~oBzMv[owAgj@{~DhS_}@tlGihBpkBwoA~g@|@rqBpeHtgBp~ClwBnx@bGw\\hzD}h@}Atp@hhCaqLsk@_}MlmDmw]_TkxDwuGkfZyYicGw[{bAkfAox@cbB{j@sfEyn@wnBpk@wmB_t@aqLsySwp@qgD~{Am[`RkeAeYwbExo@ox@b{Idw@cn@dbAxDbe@heDptBjnDfB~`KfoFvbBbZpvAirAnmAyfH|qBq`BlpA_J|t@dOx_Cf`AriAdkAbe@rJ~dCwxAzhFf@ln@ew@dNmbDokAmzKgCcbCvg@apBzsCqgFzl@s{Brh@{}Edm@iiA~xFciGjpBumElZaoC_r@gyGiA_fCfsDizFt|Agj@pj@xmAnwAjRnx@{MpWk]dCqsCuqAuqAdmAsnHxbAgsBhaJ_lHbx@{X|rDxZpv@we@fV_fCkoDusLlz@wbEh@}pCceA{a@eaAiiAur@uzClnAeyK}TolAmdAwoAgVebA~gActGdiDicEpaAelBbo@_|Ct|@qkKdlAqtB|qD}tAsVokB_T}sOgUwzAa{@u{@wQqjAshAqLce@_`@iU{{Eox@tg@yXcfJfKupDrk@ejDnuCwsHlz@_eDfKiqDsiAkkFvFcdA~yDs|PnbAejBtfBqv@nd@|If{F{yIld@iTjq@bZpBbnBdx@fa@rgAmf@hxIigO|qB{Bpv@sqCjbFihBme@}gCdbBkf@rzC`p@pvAaoAtSshAp@muGbz@orF}JonA_}A{jBwkE`fA{uAcc@_Iu}@`f@}cGsJeuDvEcdAtoCceKpC_fCp{EdBnjDigA`fAshCmnAy~Du^uHcbAdXyc@{w@zsBgpGpv@icEfBwyOpv@knEkoCcmCyd@qeFi{C}hBybAsyFvEmxBvdAcZ~JamGbZ_pBlyAkh@pu@~gA`{@yvA~yEo~Exw@igCj^gpGln@{iCpuBqiBr_@ey@zKouAgk@waFrk@sdG{lA_cHdbB}fOeO_tMzzI{}]|rAieR|k@kgAbbBbbCprFbbEbbOpzGfuAnlA~eBljGr@zu@dbAvfAh|AbZjSnb@l[peHzv@|_@bvBvFlp@xZjwGhjKzu@p~Anw@joD|oGpfGbkE|s@jxETvcCuhA|i@?`dCffFtw@h_@~uB|Kj_DrrBfkFnlA`qAbFfjAaSbbDigAhvAvF|zA|tAt_BvvEdkJp~AvyAz`A|pJjjKlkAhh@`yAfKvmCnvBfxElpAz`GkRb~HamG`bEqLfjAlz@tuBteDnrAp`@n`CzBrrCt{BptA?hsBi_@zdApLf|@nvBniEbxAtuAxOxyEolA`eBfBbxCbaDzhEod@t[oWr_@k{AxnAssAf}DykCxvAomBrz@qLduAjg@ppB`tJbvCpoGxx@ckCn}@}@tb@bZdNbvCzk@eDdy@_r@`a@`H|cApiBeaA~aGnM~gAle@S~qAuqAfb@iIly@~RnlBjzBln@vlDqKbqLpVhdDpjAluG{Llo@cz@ncAaf@lvDgv@rfCaeBpb@ipDupBrJzhDu|@~vHzc@hvH_OdaDmf@tpBn~@~bFpdAoNz_D}}Ars@bn@xt@~{Atf@ldBzW`cDztBtHh_Az_D~pAj{At`BnlA|nA~]up@ofVsd@_rBhlBuyDl~@e`ArcAlo@n~@|zDxrAbzAzLlyAjqAptBd_C~f@`zCeX~rElgLl~BntDtdCxuBlaGpxKpk@hzDziBwFri@`pBvf@fVle@|{Cpf@xy@hbB~rA|rB}iA~kCvmCdxE|_LtoCl_TdwB|mHfzE`|AjdDelBbdA_SpuAhInpBdmAthFdjBbdB{_BlhBqAxr@zVn}AhgCpjAhnEvbDvaF`hDr~LtpOlgY|jA|rAhqAkRrsBcvCn_Ez{Ev`GtxE|s@`fAbeApWdv@m[dlBmPdh@rJln@tfAfnCepIncAuzAr}AgkAbdAkG`iJz}E~oDpk@h|BsArh@bZr_@xlD}JxuBf~BtuJdbAlwCfjAfVptAg`Af_BmmBf{FnuCi}@ouCym@qzGwF{{Gz`AqsEkgAyiEibGa~HyvD{nKouBo`DaoCh_@gL|j@f`@rgBaq@vzAchHrqCgtAs`@_~GauHqxByiEcj@o_EsEklE{b@{iEq{F{sO{u@ooKdHs_LneDeb\\d_@a}Ab}@ky@nuBqLtgBpk@tdCvcDdgAp`@vmCovB|aDq|EphDcmCviHp|Ez~EpkBbuEnx@|gBiu@fkAkpNf_Aq~Axn@lEvyBvyBeEmP`}@_JhdDpL|a@iqBagAwoCrj@amCzyIodMtuHeiCjpB{kAt\\vxAnx@z`Ap~CxxAbcBftAriA~|BxlAnqGpBtdEhfBnPrGze@ah@vc@k_@~}AbkA~hG`{AlnAveBp`@b~EgK`bGmeA`dC}|BdwAsgDdl@wuFlnCm`Fbc@yiExd@{kA~{Ace@jdEjfBjq@gKbwBa|AjaGea@vyAen@loBo|GjoC}nGjwEazCbxCenKxlAlEtaGvwD`nEniFdtDs^h|BebAdaB?vcBzhDvvDx_DwyAz_D~i@f{E~fAn~Epu@voAjeCnkBxy@vzAlY|zF~s@|_@r}@g`Ave@fKldAnjEew@x_FyvAhdFeY|yEvcBxtE`ItxEbwAvwEdbA`pBveAfiAzuBylBh}@wdBnuCkR`{A}iA`g@{`AnM_zErsAacDfrD_g@x|IfKxaCelB||BwkErqD{mLhTeuB}j@csHlEokBvzAixHzuBgiCteBmEbnBbn@hfC_fAtnEox@b_G}|DjpAec@r@vnByn@l`FR~qBdw@lnCbdB|qB|}Bpk@vcCkeAxm@dvAxhGluEvmDn}H|rAnaAlaEkR`nDekCfpFi`J~qA|pE|t@|j@pgFxbAbxA}TjoCmbDljFs|C`zCulFjmDowAbo@yy@pWirA}^ehFlOymAxkCqnJz`AsyFzeHuwSnmAkiJp`B{kAhh@wy@`Ic{@nCgzFw`Fc~`@kIgsDcxBa`GfXe`EbjE|tAfsBgkAxbBeiCbwCeaBdkBfKxkDtcFl_HdkC`oBnjC~cE~aItpC||BzvErmGn`Cp`Bb}Ck]~jHgyBdi@jqAbEjnA", "{h`bGakbKoOycBgdG`dEnW`fAfoBlcAtmAsJ|m@_qA"),
+	country("France", "krgwA``drJtoIdwJegL_L~x@pgf@yvHzaFsmHumTqiH|~Bvi@ttMg|JvgLgab@huJ_}DkiOdxKcbYf_Kc_G{iDm_Ob_GtxEovAbzBjaGpCje@kiDrbFt{Drc@{{HhhC~wAzuJqmL"),
+	country("France", "mvf`Bn}ntJjkJpxNsjJ`dJebNgmK"),
+	country("France", "uwkbB|xtvJneHeVtcBzcE~zc@esAfnO|dXm`ZpkMqzj@nrD{jIyrJljQ}y\\"),
+	country("France", "uvkbBbjsvJmqNeoH}lOjqFwmKs_Mx|F{jIzcWcpGzuQmnf@r{Hdiy@_aE`_M"),
+	country("France", "pwx_CaaosItmIq_Lrfc@nhGt}Cbhm@ezQz_k@sen@dgW{tKceKsjInAenHgpVfgAah`@zxJ{yU"),
+	country("France", "~y{lA{ggsGs{Bc~B~~J?"),
+	country("France", "d}slAig{rG|cFsvCpfJ|dFdxEe{Cx`QxwGrxCmpBjsAllP{jOtwEp`@kmMktK`gIcf@c_CwgMhkB_~JfvG}~HcmHdfLajH"),
+	country("France", "ozr`Ga_hs@sqEvdBw~Dc_ZmiFdbOaqDcwDksAjhCjsAlzJomFxQ{hIgqT}qNyp@gbCuqH{mHfm@hjBgeNutGmrBm{Imwn@ksLegL~y@sfVxiH{xIsoMyyHqrPjeFomPwsImtMlsAmtA_xMziDmcGltc@_tBzq^fxFv~JssEltAuyEoeHjjCvqHsoFdmpAycE~xd@pp]rco@tH~}OhvIwi@vsIfnD|sAee@o~KnpCsa@xyHbxO`cKse@d_CzvJz~F?e_CwvFrxIzhDy`E|vWqaEszCkiDhuI}~B}qA|~Bz_GsiD|bSeaMvb[oeNq}@kzIyrVkxIjgs@iiCceK}iEjs@k{BoxM_cCbtE}sA{~FyiHmtA{iDh_GhpEli\\giL~mDy{@ylLo~Eoz@gfGquNqhIz|GgvGxgZspAstE"),
+	country("France", "ollwGddmFhyGqmGhlLlrBh_A|hEio]pq\\maNheDd`Iev]"),
+	country("France", "g{uxGvgyFu`Izoj@urGfaIom@ixM|bC{e@om@btE~bC{kBotAmoNxaE{bSfuGgpI"),
+	country("France", "mgf|GtngMrtEwiL_j@j_S}iDjV"),
+	country("France", "{yw}GrulLtmDixIb_G~wAqzGf~EgN`gIqmLbmHdv@stQ"),
+	country("France", "yl{_Hpi_R|bA}bI~tCn_Ec`BnjXi{LhpF"),
+	country("France", "uo{fHfky]rdEnjFmdAfwG"),
+	country("France", "ayqeG_x`e@rzBijBpkAh~E_y@hyH"),
+	country("South Africa", "`navCas}xB`~W_uWjz\\oeq@|kYirNhcEr_AzjOg_OfgRo`D|oH_sLv`Ep`@dc@_wHvkEbaFnuPc~HduDhpEn|TgkNhoQk|@is@yuDhsMsnFnsa@k|@fvJoiFhzx@dwi@zyKzjBp|RghD~y]lmBdaZmzMq|P{ji@fwKg}h@yiGalz@p{F_nm@gmJabRktWiqBoyJybu@wzs@efd@wwD_nQo}SabTyOalQilc@_wUidFchJseQz`AarK}`JmtHh{CumR_sLuie@cvEqnb@wic@~dDm_GqnJke[t|A}nn@bxbAk|yAw{@ijKrzEomBqrD_va@vqWqj]{u@k_VztNstg@xOm__@ueOk_c@p}Bkc_@qdIyxu@}{PcdXyxtBulo@omXubGw|Yjs@_kVcdoAbZyoWm_a@swWoxX{q^c|Jaq[ayiB__XitNsbHxlBm~I}aIht@ytE}tMiyGby@gK_hNy`EtRosRwx[x`EaeAknEcsH~pCwzAgcIedWoc[opJxbAosFctEu_IniD}wI{bJ{}EspFh}AuhLcm]seQom@ofIsoRwpMw{@utKu`TerRw`GwfLesSm_Gy{}@usL_ud@ukG_yDfVc{K_iMgyIsuLbo@kzOqiN_vGk|g@ui@okv@m~G_iX~|@m_Vnu]_odAwOi|OnoI_cUwvEe~\\|~Byb[imHecg@`tI}wo@}~B_uKxxN_sYvjsCmio@|py@pu@`rXukU|us@u`UjfMgrQhpl@{uBtsq@yzUjmxDqLd|SnjCnu]mvDvka@|pPzi[gnGz_DneJ_JdeJ_ao@zqsAtdCd_Qby\\~~c@jly@hh\\|wc@dh_@hsMhfCfv`AayBidFaeNvgV_sMzgT~G~he@{wc@`jUsbjAp`@yguAmbi@lbDkbx@wjHzcImveAmwAkshBdm`@|lDzhaBpzc@|b~BhrWdbe@xvTvcCdvFlbGoqDduSx`F~qr@df{@_zA`aLfdC|mCziDiiC}mDgpIl{JtiJdkJ`dWgNthVziDotArxiAxqpAbnk@jxa@hyk@|sXhiNaAhp@xuHhcDn]_nDacKheCn^hb]vv_@ntzChi`BnnkA|j_Ahw`@pxe@z}G|yVrbXnzVtzPptg@f_s@nte@vaDn{Kr}|@zcu@dxt@`z}@pu[|ih@lmNvlb@niRn_Kbx\\jme@hmL|b_@bjpAnkzBjtm@d`yBdLb_d@{yIbv_AnkEnd`@laIvwSzuJhxG|qNnvBroRgcOgK|`w@oyLppi@lnClym@xbDdvFl|JyQzvJluMj}Gs}BziDzhDajHtmdA}bSlr|@mtMl`fB|`Izpo@v|Et_DbnG_fH{iDrnbA}bGnuA}sAj}HdmJstEiTdxQoeHlpYuxEilAijB~kFmWtrGrkI{aFsqFlwb@thKr`XhbDx{v@rkJtjJ|dFmdFxzGxef@z|TpfIxyGdtS~x@byOmcGnf_@~wM`gl@m}Jfjo@iQzh[~iFlcTdxFuhKjhDjhDieDvoa@jgEfnb@t}f@ryq@paKjg\\hcE~nD`nE}rAhlB|fGyoLtu]pwCvag@ws`@nup@tbBf{Rm|UujKuyPjsLkt@dl`@erG`tEqcKwtIbmH|`IrtEd{s@knd@o]uwEy}E}zJdjJcaBnzn@nmEb~Mj}H~lFfjLstEztVnZ{{GvmKyfOjkCm}JdeL}`SofGhm@~mD_nEr`BaiSaeJs|CmdSm|Iow@ybh@|mO_{KnqNkfPzoAabWpn^i_]nzLsqRvnVa_Ak}HpiT{eQkU_pDcuQd|OegLhTkcEbaLtvEflLwiXduFevF}rBusGnlFg~DgrIcaL}t@ijCo_KdjKmtLdf@s{N}b_@yta@et]kbGmaTjkCygOcsE_yfAddMuce@nsNg`nBzdwAypwCrnyAokcBxie@uox@veNg`_Alc_@upT~vBypn@fuj@kg[|iFybQzbQoeHyiBibUyic@`xE{~DssAscFulUskKytRrzEcDo|IicGxxAmdBc_HioF|sBwe@ajHaoNom@y{Iqyb@`eMalSv{XqhEwZw{Xvv_@epIfuMpvM`_WayQ`tT|hBouCw_b@pkOc}G}_Bc~UbkEer_@xpVsmp@}gCywZfuBojP{oJs~bAhfDmxg@vsJofI`bE_cUcpM}y_@ouPhnGivHebC{hFa}c@w~`@qeUegTqsoA", "nhmoDii{oDeoW`bTuxEfsOeqJzpGmEbtGw~UrvKd{I|ap@puNxwOm[dwZtka@rrZi{Cz`PpbKrtKsi@zfUxaOn~EbtGbdM||De`Dri@xtE`~JbnBvcOtzPvtGem@z~DnwMzyc@~bSl{Jp_P~sMl_n@pyHp}B|kLkfNdpn@{dVjuIebLheR}cHbdAskLfvLljF~cRmkCyDcxOneb@sr[hnTii[rlJym`Aq`MmPcyOwsJ}nGzaBgoSuaUasLjkF_yF{hQgeRknPe`Egr]tpBojR{eGwm]uc`@yacAmkQhoFqvMwp@_gOchHilIe|Uu~WyXqxI}xUwdPkbF{ae@bgVyfJt^oaC|dSgfS`y\\ygGh~A"),
+	country("South Africa", "ley|Gi}zdFr`Bi~]z|G{qM~xM|lPefAzsc@{|HrnG"),
+	country("South Africa", "~rs{G{vafFybFstFtbBubN`qFrsF"),
+	country("Brazil", "thxzCtkelIezWtg@imo@_n`@o|Tz}EkznAapZ}uXrqAu~YccM_}g@xcMieRmmPa{Kn{VupOdwM{sQjhe@rd_@rmz@xhHfij@{hDzkf@szEhmIcxi@`yC{wXpsOm{YriA_{OwqD_~LxlHgkNggDqrk@``Sasb@lcCyaQe}F{~Ol`@onLpqRiwc@|_Hg}P|vPxlDnrAuyFbgWsJ`ci@spQt~XulS|wJp`BzfS`o[f_[lbDyc@kkH~~KvdNhnZk~IrhHbaDldAanD~uH~RdpV_wHpcX~{C~l`@mlEplNiTfi\\k|M`uHjlGdeb@woLxxZkb`@o{JqoI|}DyuQshB}`LinGmiHbtFg~McmCe{IrfFwta@}gQy{IpoHwvRqvLaqYrrBomO_yEujHx~QomM}wIwlFxwN}jKgtN{xJvhJryFhnFgjBnvCasd@vvDqaL{_C}bJ|gQyhSpjA{`Av}Ji{EoE}iAsdFasHrzFicc@aly@yzo@pvr@s~aCwp`AwzAihMa}VvbBkgAzzHqx~Bmbi@snFwvRetEztOu|`Ax}a@cbC_oE}gPxkDmpJ~~Joc[t{AidF`|g@usq@~}jAadkBbzNybh@c{Yusd@fk@x|HrmUk~KxfjIurxCtvOibiA~q`A{_Bu_|@epwA|gCe`PfcN}~@i_Eyga@viIgkNdgM_n^hx@}zF_qIghQohEyec@`uOqhEr_Skph@~x}@mmB|mW{xLr|CrxIv{RmbDhxe@jsIdl]{eGnhQvvEfvm@koOx_Fomg@xhm@ghR|_@ciE`hJjnCjHcxApzo@ajJ`kHgoFtb`@iuK|wGx}E~cSie]bdZqLnpJajd@z~OeuBh`K~sKnkRhTd_OiuKrdUuoNlrI}_Ozq`@{~Bz`[bxAh_KrtMtkJug@|yR_lHf}Qx~D~zCibHdwL~pC~bMkcEn~@is@tcRgjOxjQavRefCknEvcCslJtga@s}Mr{CfzF`uHe{T`jIwaF|_g@y`PdCeuDd}E}vWhfEyeK`jHbcO`mFwbEjvGakTyPghB}`Dy`EvbIebLjTo_Gp|QyjQb{EatI_pD}xHb{J{mJkiD{wK`xEscHqnIglMxDsiK{gGygV~jDk_IbdNmwR}rCobMjtIwx]hxAeuB_sFwin@gsWixm@`dImrWwjFan^`{UdpIzmQtcSnhMwkGbfX_nFwRboAneKo~EvcB~_IrnBkbDxkDrwHhde@qsCnfJ|yRtobAhgC|~g@zhFdlBtz[`kn@x~^fq^`sLpoVcp@nbZfnIxwBdw@zvMnyLtuHltHrwUnfThpRtpQpfF_cHvoZ`r@`kb@neH|iLrvr@tkb@paY`~}@tnDfh^{yIf~AihMspE{jB|dHrfC`oNk{Nl~bBtyDti~@wjFtePcp@tz[zk[`b`AanDd{[ggRf|QbeMd}SwjaFaQg`[szQcyZ|bOqAiuJwaFrgAsdRw~HvnBt{Nzih@|ps@`}ZvwV`sJ`v[|~MneIpmI~cSfkAx~mDiaXykEi}L~eEsoGnwLioQgU_jYv}Hu_Hf|QqvMvzmAsJnmmAist@smn@ob\\nXgur@pwe@}xHpoU{aMnzJ}cVnc@mqVp_c@yx[heKqvMqLy{VfaLsiMvyUa}Kp{@ap@yxNkgNuHmeLnvWoqa@|ab@cyBaeHguMkhDy_QbpF|hBoxNqlLy{c@}kf@hkRyai@wiIchz@s|jAa{BywYadPewOiqOcaCch|@v`Tc{e@koQehk@iz^cgc@iTu~d@g_NagZ`dCg`N{g`@fa@kaSy|Hg}Ds`Y__f@yaOsqDszE}jYanQ_}ZopJqeF~h@q}E}fQ}`XalFy{VfhDujVekCymZkuG}eHqb@etPxvCgsCgjOgfR|{Ee|HkkHmtGdsFk`GyjFidHtfCoeI_nFgtCvjFwdFyv[crW}mJu`AmwCmiJzjBgtN}`JofHv~H_xA`lDkfHooIk}EhcGuqE_lHyxOnsEakLi{Cu_EdhS{uAlvQguTobOc`L|_@egGrtMksJ_wHsvKma~QixnBouPloHgjOc`@moMzoPydf@ruQojP_sG}lV`}E{`h@vh|@yb]`gYucqCeuAqtByro@iaKorT`pB_wMqpFo{C_oGgtUh}P_qUq`BqxJhoFy^ggEod^qkNnuIybZexFkwF|fJw`Fkb@koQboO}qDj|KhoF~iKggErvJ|K~lm@ejEzaH~dEdoPsr}BzWmuK{j|@bgIu_d@wE{fgFubT|yUifc@kePrcGi}Ah_Em{Kre_@wnNlaFkbS}iGabTq{s@iok@sgO}z_@~jHelO~giAosj@t|_@ioGnifAdmAuaHafh@vbqA{kzAj~IqyV{jBa|M|fEwoMcyM{h^}hPoaO{iNoq[cz@wd\\rwImwJnyKqtBtm`@jiLnfG_iGwy@ewGaeOukOisMuuA{hQafMssA_gWitf@e}KupAgiUypX_xb@jpCqdTgjOsvWofPkjI{dPuwZb`AstGrnSr{DtcDmdGcxNk`Iwja@ovg@aoz@a_JonGihNyYqea@qsWoj_@ilHaeg@c}UosFi_Y_gAq_CdsCkq@pevAwiG`ii@qqn@azHu{nAn_k@krx@xlC{wQmiHwpN~^_{hAn|tA{w_@fmOesLbxOqlM{dCr}Bm|P`k[woTgmEi{j@faGgsi@hpk@ohTucLwv^fUewf@b|Pwl^gnAidGawRsnGd{@qkM`bMesRjcw@msp@nnFw_TmoGicSw|IsdFy|Nex@cw]hmHeuVclCwiB{ag@sbP{tCahJogTpcQurt@}qL{cVv{@gu[gxQqvXwt@g{f@au[iyHw_Hcxg@seMxkC|}Duu[qvGujd@w{`@e|NmxMkua@ai_@{sWgaj@hcMifEdwMlmD_yw@gmEiuDbsA_}OcjMyjVnyFmeFqdBewGb`S_}I~uI}aQfleAnsHz_VfxIngGnlMxhFuHvbKwlcApoOa_WdnQhbIzjO?plL{_QlrIviBxhTqaLlaE}kL`{FjqI|{QbhBr_HnhMp{K`yAbeTboa@h_RobGv}WpAvh|@rx[rzfAdiD~di@ooPxjRwUxuQ_p^hmmApiBpiCy|TbcO|uElrIgi\\vz[{fUn~DchNvt[uyQxeLwyt@b`Q}kL|fEenKq_B{bKuqNynMfuAo|a@i_LmkFioSnjEu_IozWq|FfWkwLasLr~FsuJql@_iMhnHqpFzLuuVgoZ{fG`~AmgKqzLg|^`[kre@yjd@}uXmkLaeP|}B_cSufMedY~aSgfQdxGi~f@ojKgr_@dcEksK{`FodQrbTqgyAozBmzK}lEueEaa_@qVqid@te]uzAplMmcQwkGrKsmIsbIqeF_uXqgG|`Yaeo@qfF{sUpVowg@uePemNp~BwkLwpKihUnhFshBcmOer^b{KvxBbuQieRoaAma^h_YsiBj\\mtLme@wtB|JqhDlz@}qCfhE_nF~RbmCxbA_Th|CyuEn`CgtBrt@ccB`]wiGdkAkcEzB}qDxjE{qFnw@mbCth@}xHurA{nIr@keCzvFwaEll@yx@fVuqAsU}qCmtAcpAi{I}fCa\\em@}AeaC|~@{~BqKih@}tAg_Bs|A}eGcnAcnBkpBem@cxCcEi^i]~^_eEey@ynA}gByw@oxAomAq_@m_F}oG_zDg_AolBw[ssBdXifBn`BecA|~@dCxxBh~@rh@pBzX_h@lFetDnsD{bLlq@qaLpsB_eEj\\g`Bs_@q}BsJknFk]mdA{gDidFkpA}yEit@iiAsyFuxDiKm\\pWiqApk@ks@~xE}{ClhIijKv{@zAfk@`zBxwAvnBt|Azm@xx@ceAirCkrYIeuBnm@ccCd_Dw`ExYgfGru@umFiKwcCe_C{_CzKmbB{Kod@_rBghBa`H_}BquAykBekBalGedL{zTyvA}iB{kBsrAe|FccCojEqeFw`G}rDo|GkdCunCq`BkqAuH{eHftCk]eYwoA}qDsnFk{@}ea@ufNudBgtAccBeiEi{DvoAq`ZqxL_nGvz@olCpCuyBug@yaDy_FaiHs}BgjBuyBeN{lArJyaBuh@aqA{_Am~@_fj@wkYg~CdD_|@ixBoyFazB_eKycG_pCk{@{kGgo@ewBoj@ajBk`BqVqz@fAgzAkv@}t@qvF{G}o@io@m~AabE}yL}pKcrC}gBedDklA{iEmu@s`EsxAyeCw|CieFarM}r@sdE{|Bc{Bov_@{vJ|vz@giYoq~@rmTsiUlrAm_MeoGz`OmzWrzd@}d_@|hZixGpr~@zoBz~HmdCgzi@owFkoFc|BptCybFbyaBdoAnv[saJfyd@cwBpth@obMvpD{cGt|W}zHxeUaoEjvId_C`QajHx}H}qDbbt@gjOtgFriJ{iDjxInkF}mDjjIp}An`DunDqsOotHv{CsbOjrw@k{\\i@c~y@jlQoxh@nlTivHrjz@_nBvfKz_QzsC`aa@~_FkwD}nE_ye@poGgsEr`SlyPjGn|EezEbkBd_PhvDpb\\hef@l}Z||PrfKroRftw@nk]t|f@nnb@~vAj_ZtyEd}H~z\\vyRtdHx}n@`jHqxMbeeA|of@|`IniL{pAtkJt~B{iDzpAlxEwi@kxIrcn@nlb@hwl@zInm\\twh@pn^p_@pbJ|zJzt@fwLumJ~s`@p{Ybhm@fjLplj@phHzaAqhHkcfAstE{sK~nOikHhaQhuDq^u~IsdLqbKsvDuxb@imd@{mw@ubM_pg@ihN{dKazBiuUslTuvQmaTo}b@uyPee@rtEw~JajHvi@zWucSp{X{oA`xMltMvm@ptI|cS}sYt}f@sClsd@wgZ`hTmzCljDbbFmjDb`HboOa|BbsMhvL{zGl_QpiDxoAjzMenHttAa_DmtGa@u}A{uUmxScfG~dActHnbQ}jKtaCqvNfvHrkBkcGk_G`jHpyAnmP}cGqb_@cdSxi@gl\\_~K_aIooCkaYtfKu_TzpM|{Ci~FktEjwJa~@bnSthFmcGktErcKy~F{gg@btE`jH_xAuqHygCnqDg}Os{C_Nl[oyG|bS{gOqhH`@wmLpaKqfIyaJ|pBkyJi}QqeIldG{iCifDa_GbaNbhEtpHsjOvzEiC`lyA~~a@~cUyRdx\\z}ZbFynLmdGw`Q}pY_qOwlWuaBkzpAu`e@kzPe{OuiP_lCa~OmhUw{NmmGmnEucTv_f@feRntNldNcnJwgSqxRaqFskHgvMsxp@}|]bue@ayV`xNyyS_n\\`tIxnAyuIunIcaS}w@jm`@kdb@kuAifKycHjjNu`AzsBovOo}WuwEgcC_sCjjCabEo{J~mDq|AkxI?|`IciJ`fC}eFgeTm|U_fHamS~tBuaMqhHsgKikMn_KoxAdmJ__K_dTmvQbhBwmKy|HxmCmoMkdBx`MsqFvj@wxGj}TzTcfK_aVarU_bDhjE}~Gfa]}cGtf@qePlbGc`B}wK?m_IhxIdxEt~Bc|Ywi@?acKjiP`n@_pEgxA~oE}iDaiHpm@fbC{mHqiHb_C_xA{~HxfOqmF}{IspAxbEgvGftGb}Hb_CspAuxEetM~eHgNlhEx_F~kFqtIqfJg@lx@u}BoqO{cGnqOtj@j~Fa_GtxElxEh~FgcCcm`@qePlmP?mx@umCtlJiuCd}Dx}FzaFs`BuwF|Ia{Ao_Jq_K?|iDc{BotAsiDc_CriDimL{bGziDexEfiLdxE|mDwi@}mDctEdxQwm@u~N}iDdkJy~F|vKlxEm{UezJjaGi{GcwKhnAjfAefGhsToNvdCdyEmnCfcCdxQl_O{wI_jL`j@ulRw{OojNq~AwuM~`Dgg@j{CrpJpqWg`@{eFeoT`aLks@yvIsrEd_PnL_xMwmDpbKsmHk~Sz}DijNujNdqHycDvsIffHaCclCxpKroAndTj{HkrLa_WtgKvi@}bSotGjoMcfFtpHn~DsrEowG`iH?si]wzLh{OlL?qpEb_Ar|Hh_GtVfmJitIquc@svNrjFctA`}L~cInkF?aiHqeDdvFeg@zxIzfDyeDmxEfpId_CghAumC}uLawC`cWuiD}_I{EhQyfDu~Z}~NjxUhpEabG_vIx~SwqDnpH`xCax@ipIs|NueArc@aaEvwGf[}}IgxFxhOtf@{bGexEnfS~wAcbWsmT?riDnZipFrlI|Zf~CjvJxdPrr@ziDv~JebCy~FbwExm@}WwvGz{GcVy`Qa|@udA{lHm{VwhGyl@{sE|aSsyDfjLztQim@k_GhhOpwH~nCuzJfqVpwCz~Fa_GeaM{~RkgQxbDwyQumOxfCkxCw{FofHb|BjqAqBe_DytIubB`jH}e@zpLumPvtIftIui@gtIy_QmsAkrMabQpdJy|@vmK`aKpiIcE?dcG|`HkoFjr@~rBitI{mHbtE{wIotBd_CwzFulDpeIkmAmiDwm@zlBedA`uOddAt~BuxDdyEtoH}rBqjH}dFrH|sA{eLduQp_S}iDmrNd}Cm~HmhQqeD|iDytIppQp_Kvm@q_KatE{yCpiHiR}hDwxFfhL|xJuh@ymLf`QypE~`IdfXti@i_WlaTpdUdKlhF`|@_aJlyKr`Ldg@xfI~mDixAltAjnXmtAyvSrtEikBasD}xEzrXrxIyzGacJveMmeAwx]_cCmyW_e`@phI}gK~`UabFt|PvlE?`jNuyEjdFptCx}Ft}C~sBieD{fKdpTnwAxdQ~fYivIoqUx~]zxPvx]~rBpbK``FxgNnm@|}Ia}LhqW~gRr|B|yJ~kPu}BnwA|wEp`@cvEmdRotBkmCqdO{wl@yik@ay}@_jL`tEmtAobUxiBaxC{bGzwIwiFakRbVs|Nw{i@daBcpF|lDia@pAdjKn`Kq_FexEoxA`kGox@rzNf|P~wMznAr{BnhGwbCrtElmDmtA`cU|}[xiB}rBunHclBjyIucBimL{~F~lD_|EctEypE`kHwhAj`G|rGd`CusBshVokQx{G_lFoq[iyGcpF_eH~qBswDu~NmoCxePqyGgmK|GmeGs~X_dIkjChfOaRowAcgKmbGvuCkjDgkFdxE~bCzpAacWc`BdaCyjMw_DtoTwjGomDwmDtwEmtAaaLhmAuGmbH{bGj_GltAajH__KltMmtH_zBop@u_GjcGcik@pp_@ogiAxkBsgV~dTsgVyQ}hQbiId_Ct|DqtIwbC}xEnmDq`]xwIrR_z@yrDrvDxCstEipE?acW|iD?gcBi~KxcDcaMwnK{TuaHjtLhM}p`@|~EtYc_CitEjuTy`[~{BxbChaEm``@}pB_aIxtImtAuwEkxDdgA{lNrtE{a@lzJadQu}OxxBu}Cd_I}lDukJjbG_aa@kbGd_CebBurp@|oCm~BexQcg_A`cK}jcBn{b@uqp@xm@}`UfvS}`YbzOqqh@ji[aod@~tBcsQ`iTimL~fGggTdfX}z[zbGeaYu_C{oHvs`@alRx~P}vWdyt@whj@t`Zaga@d_CavOrfVkhJhbQgfSrmT{m|@|td@clT|iDcov@|`UeaTr|Mkco@{hC_vBqxChtInrCmiy@mu@mi\\cmHudY`xNgqvAldRki[vpdAehb@tbr@maIptZkuKt_f@otAwbCfmDl_Il{DqtCivIpmFytI|~TiiBbnHeeGvehAevFxsVcpF|iDkrGjdi@~sI_p[kjP|l[lLj_HsuEx~`A|dArtEvxAxm@bmTpj@ugQ|uJa{DpjJf{CoiKtnGraPwy@tqDxjGwdBkaGdaPvkD|nBg{GtlTobFlqYdvFqqE|dNftIamHxhq@jiJzhmBjxg@h|u@dx]nca@`qa@nf_@jsRdsGlsMdvQjpFzhD|~NukJ{eEqcJlnI~lQi^jyIdjKcmTpfHovA`gJbnHgtIfxQmxEuxEgtM`vChjAxj}@lak@zci@jun@baMtfAli\\ptUbmHb|_@`mj@rw~@l}cAd}l@ysIntCcoHv`UboHqgBd~BybK~xAt~BxOu~HhjN_fBpqPvpUd~Z|wKdf@riJmiDxxA{jO}rGa~K}yOwtItiD`jHre@ukJzoFpvOuzCe_CvmDh}F}bAzgDrnG~lPv}E{wIvmDhm@`fDhkNspMjyIx~EnqCzxJohGqcWpnoB`}v@tnrBnklAtmx@pss@ryOx}c@{kB`rDavOi|FhhBk~BkiO}IucXvnEc_Cd|UioKzjCawCj~G_iGkU~f_@veH|fR~jLgyFpeJmpZoMvbOrbKrkUurLhhCmhXxiYzdKvfM|bT|hFy_@t}CgdOlbSzmZxpWnj@sc@nsOstE?rbo@vtGt_CxbEoy@qmFarQsmBp}@ygIl``@etFrr^xjAe_C`wH{`Sv`KpvC`dJkrAonEpsE?vtSysV|WdeMhfDfm@dzPoeN~vMajBb~AtzPhpKzTtxEexE}wKnrCcqGevS_fSoZkeE|fFonEkvJfxS{e@rtg@txKrqRmXzweAd_O|{UqpEr{B|~BzUccEbhpAmaCfm}A_fNtaZ{cKbeqA~`[lmOtHdaNveHp`fA|~Nt|PayAtws@~~O`hpAm}BvwRk_Ijqc@`}Y`zNpea@~q~@tzg@xunA`uO`sfCqnGlbk@|pOfv^`_n@rm_@|wL~oDxsJzoAs{C`~LlbHreKknApl^z`L~wAdpIcmHltA?pmL|tPwS_JkxSl{a@tlPt}y@vyl@xgFjxG{aExrC|}IxwSrt`@|}Ihjf@nxY~tW`hAvhVzhQ~uFlzAtpOilIjuTte@`rk@afJb`L|mDlqXtoo@ztYlqqAzop@xpy@|b_@`fDhmLmlJwfB{sKznM|kVryOrnGhoLayBmmDfvGqyBln{AvyF|q`BytI`lRwN{qFuwElwEau[wxRuhKhSwi@veHb{Nj_e@x|Q{wD_xA}~Bn{KsiJhnMdu@bmH`au@fsH|_UszClxJbnHpxiAmdGgMtf@kwQstEotA|mDsmZkcGcx[wpUbco@jmNrgb@{eEd~CvdO~nJjeDp|Leg@v`J}`Hxm@hRp}RauDa~FcnH~vAwKjfE|bGltAqsCfxFxqJxvCvnJr|_@c{B}e@hm@xcJhrKxzGh{Sxm@_xBszNrlIrkImtAixIfvHheDaeJyoQfcCxzB`jHmtMnaJfcOq}AjuP~fHlx\\{cF~_QzzHx`Kre@xbKzxI}wExsIxbK_nDnbNlcGgMltAniLbvDupAgKljPpiHpbW~cHd_C`eVw{FnfH`qEvi@fcOy~FbtKizFfuw@poE|iWbqF`lFdbBrgVtiLlmPqiHybWjxU~sIdvFjtV_]rxDexEi_Go`JloCmaIbxOjxJpwCgm@trGtmJ}`NduFyCc`Bv~DnvNvmc@~{b@d`bAze\\`kNygBx}E~yWxmX`lj@|jwAt`Z`md@nl]xjYsb@~sHuxE}gFljDxkN`mGw{R{lCmfKdxRfyEjch@hbo@jgPz}FeyDx_GkiP}mDypJyoQ`mFv~VonDrtEfjLrmHwiXom@pkGvfHnnEq_@}~IrfP~wM}iDnxAniL~v@c}HjhEnhAf}DcnG|xKvmJiaEnw^w`Qz_TtqTqrJpa@b}G~nDurB_@cvPb~BrtE|iDajHqtD_bOptDauI|mDbtEzp@waCy_FsuQjhDm~C|`I`~Lrpj@vcTcmHliFt~B|bG|fFmeFhnAvjc@sHifg@nca@lrHd~Z{|B`eI~uMueA~zKqo]rfKbjb@ozFnuAneClmDu~BltAz~Fb_Msx[zvJakGlak@nvMflLoZh{GevRzfPr|H~~Ucu@`qEwwGjfPzfEtpI}kDojFmjKpnEoyFpbKvgB``@zdEmoCyiAuaBzuDxpKhgNzmZwhLa{BltAz{T|~Nv{QgjLjeFfj@rtE~~J|bSi_GxiBjmCxbQ_mKdaMr~F`jTqm@v_\\`uIvxFmnB`jHbgIt{g@dfMezE~fGe~N}t@_dHl{IllClqCtdM?p}@mxEz`H|`BdkIohRt_OhqJtyEvmW~hu@rpmApndBjkzAjpgAryl@pj}Brxs@xzpB`gnAhjtAbasAjxx@xjoAjc]bsy@l`l@t`g@ggYelEevFlaC_kFutGq~VdtMayBsqF|xJqpWouAe{OydDklCmmDl~Bg_Eqx_@u`e@wu_@pkFe~H}yIdbBsaMa_GldGovGehKa}Xiua@e{Ocwg@rSq|Ae{[luLubAeKciDslGgyF}fGnbIq~VibEcwDphC?irKwgNa`]{hP{dLkgr@kRevRz`Fq|@i~EtrF_wGz`Hv\\|aHwgGuyEwhGktb@c|Cc{NlsMzcEl|JprEckC~hF~rBzkDfan@djV`N_\\dbCk|IraRkzTucAw`Df_JlpBhlGq|KlqT{i\\f[mhEj~G~ki@rnGyRguG~qC{lBhfDjiD|bGgtIomDapElrMgeB{q@w}QvqH`vCsF`vOreKxwHbt^dwFtv]e_C{t@tsGaoEkmCyaRxbENhtIpgm@c~Ab_ArgQfdNf|D`gUyiGvrItgb@htV|uAdaB~lb@rrFflFvbChySzgDe`CxwH|zIlc_@otArbMdoCoyMlhEggAxlGngHnh@ffBmoHpjG`iC~tMni]xtJus@n_K`nFhmLckUf}EvgSf`P~aCjRqgHpvCjgD~^yuCeqIq{XflL`bP|qBsuDmbIyfIlhRlWjvU|t^jfi@|xV``z@btQbs`At~`@rfc@fba@ntpAphlBkkI`dNlPtuIm_GtnDutp@e_Dmwj@vmCgfSsmIghQan]koO}pD}p_@_vc@gsOnuPugMjyh@kkUnm[cjQbcOaxRvzAk|e@dhRqrExtR}aKliH{aMnb[bmChzQu{Ot~W_r\\j_d@_wStbEotSjkT_xEn|n@uo]v`b@jiJthJq`Ozt]cng@lgWm|c@prj@xmAfhJtfNfR`g@rmN~hKbeEl|KxwPbn@vr]sek@_kCyfJ~jC}fi@hxf@mvSbk`@gz`@l|c@cnB`_Jc|Hd`AgwXj_d@dXl_o@~o^fySl`Dj~JeuBjyw@mkOn`Lgn@tv@_g@alGwg@i|By_DchGkmSaoQgmJkzO{_B_g@gzU__AsoEgiBykC{sDcxAkkGga@qfGoCorGce@e_C{vA}sB{kLuhM{`CqjAmkDawDetE}sAazAowAmmDetFcmCs}AczJ_hNi|DufAcbAgo@ar@qeBq}DiOmdB_cA}gAkeB}rCkkHowAihBmdBsgAqbK_eD_sAri@kyAeEqiD}mAg{E{cD}qDumEqjCo_FkdBsyEq`BonLur@}dBsrBkkAayB{Vg|FiEcsFswBo~EmkL_zE}hCovOqe@q`Bgu@_r@e`Cap@}dHu{B{qEwwQs}NesFwcKipCeuB}uM}AquAo|@yl@yiEemAekDuScjEsr@qiB_hA__@kbHs@esDzv@wzA_qB}hBixGbyBm`TfsBoaBt{BeW~R_y@cn@qfAsjAs~@owAyh@yfHauA_cFrrCowCbdLkq@|iAe_Dbd@mdBuxCayBgnKswHkwJ_gB}k@ymAmiAxXkoCjpCm[hTkkAqLefAwdCw{@okBcrCkmDgxAa^apAplAelBom@exEksKoeJy_FkNs^c[u}@woF_zEgq@_qAoaBoEelA~s@_eE_So|@}}CgaFknEkcD{j@c}B`\\scBrrB}fCxX}~BctE{`AwQwu@sAwhCeXs~@`fA}gC?cdB{`A{Ve`CjzA}{Ct_EycBz|@el@ihBdX{yBhrAa}EkRqtC_bGeeIij@uzBk|@mf@mlCg`@umG__@miFhJwQ}s@h@mwCgw@}}BudCqq@qzGgz@}sBokC_ScoAbdAob@r|Azk@voAsrAhIag@cmAweG?ybGmz@aqAmcCgBybA_Syc@}j@mEqj@vZqSfsB_^xDchAufActAgsBuaAqrD_}@g_BebBjReuBniDsgBdX{`ArAw{@gjB_uKafAg}B_qAqCekCrhBebAgVsUwdBuH{zFfV}~AjgAs~@heEqk@yDwq@gl@cx@g`Akh@sbG?p|CwbDxy@azAgBi|BqhCiqBmmB{l@ar@axDst@}K{~BzL{iCgtAsjL}qDelBemAbxAwbChgCfLxy@uyDgVubFmfMgkMiqBel@ec@{b@yDcp@hs@yaBoYazBoaAoCssAh_@uqAgLwe@uqAtpBg~CdMmbBi|@_~@wbEst@gl@}t@nd@_sArdE}{Bnd@ii@_i@}iAqiBobAur@}Aw{@lz@yl@|Ai|B}sCqfGTaqAqX}_@maCu{@kgAsiOeqGw_FqjA{~DiJmrJhhAgfFhgAyXfuBi~@bE{vAkh@_}@pBcmAhgC{kA`\\qaAcOyXmoBqb@co@iqBug@srBiIalFr^gsBqBaxEwnA{jBmPtStGmf@rUq}Bfb@}j@cQcnBifCc`E_@sgQ~gAmvDst@oqImiGwkE_hA{|Fh_@_dE~qBa~JrlH_xEtrAamE|h@g~D`gAeyIj}Jg`Afl@emAlOspDkg@gnIfjAslJcZanB`RsrBdw@ifDblD{`A`g@mpA|KkyCqw@scFbdAigCfaA}~@|hAtdCjmFbOvyBenKzjBwxAxlAa}@dvBaQptArt@h|Ce`CziDzu@~g@heE_Uri@fWg@xx@ij@~}@srBnwAkzBvPckEewAqtBk\\md@xx@ld@rhAblDbbDbe@nbA`HxmAcp@xuD|T`p@~eEs|BblByZ`H`zBi_@zuBfB`{AdaBbd@fa@ld@kzDvoB{iAdbA{Xt{AnNnkCri@`fA|~BtfBrJd`Aqk@bbDnWnx@nx@nm@vwDzLnm@nn@`{@psB`r@be@~cEyYfu@dYpWr}@md@xe@}`AtQe~DdXkdBzlAuhAtoBap@f~BvZnaCcy@`g@azA`G}iAdb@m[byAp\\|yF"),
+	country("Brazil", "lcwpAfhulFcc@glFjsKy|@z`SxlFfqUmiEeuAvgHokJxwDuxRceAwyFxfNoyKerA"),
+	country("Brazil", "bl{mAh|tjF`bKshDpu[b{e@_wMn\\ezQmyVc{NdmD"),
+	country("Brazil", "recPd~qnGpyZaBvsTltR{kZ}wEelVjpAstQctQ"),
+	country("Brazil", "feoOxer_Gx{F}sA|uApeJwrItmBmmDpfO"),
+	country("Brazil", "hzvLt}giG|dEwiDbuUrxEz{B`dRooXklB"),
+	country("Brazil", "nmvItpykH~tYtoN}iDdtMg_Qw`EckIyqJa^gnE"),
+	country("Brazil", "llwFn__qGvsI}i@e_CrtEjfDobCrhJlwKkoA`wIgrI`Rt|DyeD{fQuaC"),
+	country("Brazil", "rhoEre`fHpbKecGb}MtdFhkA`}GshIv`O_rOq|F"),
+	country("Brazil", "l`_DjadeH~cHesF`Dt}Cd`CixCbdWfyGnx@tpSedLpa@ixTynL"),
+	country("Brazil", "`g|Bn}scH|lDi_Kp_KftMitId_C"),
+	country("Brazil", "hcxB~rduHtuQptCbaB|xP`nQ`m_@mvLpn@orN}cGeqT_cg@bmH|sAkxIipE"),
+	country("Brazil", "~bpBdu{aH`fUyiBvfBdjJgcY`uJwsE{eD"),
+	country("Brazil", "d|vBtglwHfxGk`FxofAhpWc~A|{Ivoa@|dSfql@twz@vlKzr\\koAfkFcmHvsCsm_@ksL{b^_cf@ozo@cr@olh@wtZ{`Sqmc@~~VrtE}qMmmDjnAwpIwqIzwDs{BmaC`yBoyL"),
+	country("Brazil", "|kZ|ehwH`_@gxG~g^laHxa]vpm@}{m@eoZ"),
+	country("Brazil", "rooBtixfHxv_@zsEscKpxMv|P}oHnkRtXtbX~wYzs@a`FxlMvvAthVlja@q~J`_Sr_MkmN`mFd|CxiBizLrw[tdFwlVpiTkT`jHxfBerGzvKdrGmmDgxGnfSotSftIzkN|iDdz[imXhvO}qAj`Hzua@ebBfm@hiHzpAw~JdwE`tI{UnzLerGprJwuGx~FuvGmi@tfJtiDhvQ__JqLnpOmoEzzFakSvqA?dxEjjP{iDvnJpgIjkNnLgi@luG~bIvrO_|W|fK__Kwi@jtKzzBj~S}uEftIncHny@neH_{Ld|a@ezDtzD{vJ}XbeKbeE~|JpqQwxFjqL__VbqA_tCp~FuuEysDilNlt@~wM?~iFzsErrGiuHndSzjComPtbGpmRyfCxiB|~NxbEsrL|jDdcTwnJxxe@}bGehF{~GfdCmvo@x`i@}bcAsaAf}Qenl@ujK`cJkmAubNwsIze@mtAqmLouA~bIzqLzlYinAv}PglKzdFc|e@oxAvi@{~FuhKxwHmhh@ywHzdPskInlFsnYwpI~eYgb[x~FelVkiDix_@gaU}kDeq`@unJ{mGheDket@ngTkkuA{mByzd@rtEsbMwyF~bCmkEafCwtG}hb@v}Gefk@coEoxO|hKiIbrGowyAplFukJrfKpDflWvqO"),
+	country("Brazil", "|zw@zxbvH{lBdsFgiMv`DegAcsEe_CfnDso]ouOskJ}kb@luLcsT~tNwcDjzHldHrja@fjo@"),
+	country("Brazil", "ncSd{coHkaSg}PcxOksn@tvEcqS|zJmpGtoHzlAzqLdeS`zBhkp@"),
+	country("Brazil", "o|Ldo`tH{mNfxA_mEglFjuCioVlpEgpGvqWyu@xVlyV"),
+	country("Brazil", "ewz@jjvpHjtJniXy}Ps|S"),
+	country("Brazil", "kpy@pykpHgHksa@esOg{g@xB}qPzfEgyD~iIyGvuQj|^vyd@hyYpoKpca@cjOxbQ~tB~bf@_dHdpIkpMufA|bGheDamF`iB}oOihByn@_uq@"),
+	country("Brazil", "ezn@rmnrHpsBv_F~kJn^qdH~iRieg@{lTozWjkCs`MqoKh|i@}sArzPy`F"),
+	country("Brazil", "izoAvqarHqgOhQqdPobJk}BcuPhrEgfHq~FltAmxEgjL`eKkuE|pM|eAbd\\`lg@|Z`fE"),
+	country("Brazil", "qlqCbdlqHlXhfMirCpq@qqPwhJapJifU~_DuvQ`vYzbP"),
+	country("Brazil", "iisDdzppHy~ExjFosTc~`@fwQfjF"),
+	country("Brazil", "kh{Kvj}rHaYkuIvt[uuOnzIvzCpuH`tNuoBxuF}oVnvKykQkyB"),
+	country("Brazil", "wdbL~wnsHy_GyyDdAgyH`vJelH`yBhdO"),
+	country("Brazil", "~is|B`carDonEzd@jnBmjD"),
+	country("Brazil", "nngfD~zrfHv`EytIv_[|iD`cWlmPr~Ls`ApkGvdNu{Zo\\y}FuzE_`J|oCeaCyjGotNxnEajHu~B"),
+	country("Brazil", "x{w_DrihgH|mNrqFciTfmVgxSkj[anFs`AxlLmlJ"),
+	country("Brazil", "zkwuCfu_aHgm@imLvmo@dchArqQvbNccWwiL"),
+	country("Brazil", "hc`qCtlwyGxqKq`FsnGjbXkiDafD"),
+	country("Brazil", "tf|pCzx}rG~~JtmDm}ItoH~pCjtXeqGhaEoe`@af[z}HguNdaMdi@a_@``EbxDp`@"),
+	country("Brazil", "jhhlCrmdlGd_CmsB`~@d~Cwi@pbc@dnHfzEm}IduFwyQ_xXdpHunHiQgjM"),
+	country("Brazil", "jqlVdxwdE`vC{UfeBdiK"),
+	country("Brazil", "eykDdwnrD|K_cIl{Dwk@zmBv}J"),
+	country("Brazil", "dw|yCn}|eH{|EkaLr}UbB`qCd{EklKrmH"),
+	country("Brazil", "rk~m@tdvsEzaGc}DzqM~nCez@zqF"),
+	country("Brazil", "d`mOjxv`GnoNg_W}aF|b[mzFdu@"),
+	country("Brazil", "~h~MnbpjGc{D|aBudBq|CzcHw|@g_DuoFbtBgqB|uKdfI{`@duD"),
+	country("Brazil", "`elMvv{hGr|@_mC~eIrdEmoFtlF"),
+	country("Brazil", "`ibI|}~lHdbGyyIdkHtoOaaMlhB"),
+	country("Czech Republic", "sydmHujuqBxuDjtJu|@~la@hqQdzUf`@jaIxgHnNfiOrzj@xjPzyIvlR|u@b`G|xHpsBzwXziPznIpfTzo~@icHrxfAxvOl}U|m\\d_StuBnNieDvvEw|KpuAqhCzrCeDveBfaAlgJgtCrsLeDdpIae@`qA_nFptBwoBn_Rcy@`cDxc@piDoNxsFxn@nkBbfJd|Hhi@lcA`RdaB_s@ptBbP|gCstA~hZ|Un`Q}}AjjIimHnsRgiA`HyY|_@`Q|hB{`AlEc[nm@jIftC{v@__AfAjs@ym@|qByPbaD}^~]{kAfXcQbc@`HntFnbBnfI_Ud{GosDbdNccCnbOioDvsHwq@jeCu|@vtGwcBjkHeYnjCgArcHafAnwAHxn@pXdXzsBdc@`qAxuBrsAv{Ms@~zBcxAih@}sAsAclDjgAeYjeNmmApzI|T|{C|rAzBxbCftA||OqUz`O|zDnkCwoAnlBvfAvFp}BqvA~aIu\\deIzVh}AhfC~|@jhAt}@nvBr^d~DxgI|}BduBvgDrU~}DgBnaDt^brAh|BoCteBq}CbxCpKvwDiY~RqpAjGkV`g@bDf_BthA~wGqaB~aI{sBxtCrh@bzAruFbkChbAzaBz{AzcI|nA`nDnC`{@ipCbkR{`ArtZcz@nsEcwBd_DovBfw@yYen@oy@_i@}IolAcf@sJae@bOyo@loB}{C~nCmf@|u@axCncLaeCryF}i@zuBqk@fzFsrAbyBilKrlHotCjpCi_@xvAgWzgFyc@fuBaeApiBq`Bp`BupBnaAeuBnNjGtpB{iCjaI_SjnCbYpuAtpD~zBqjCtvIwmBprD}~AvxAknDzB{kBxdAspDlhG_fD||Dgj@fkAs^h|BuJzxJybA~oD_cFntD}gOfhQm\\xmAuzCpfEqhDtfAk\\pkBqhDbiGaoA|wIvPztAloAwZhI|sBeb@doJm\\~zByaAzaBmjHvkE}sA||BowAb_HeaA`yBmyA`fAmdB~]kuHnYqWnm@wFhgCem@xbAspDp_C{rEfu@ybA|j@cn@z`Ait@dtCkq@|~@i_Aea@eWz`AqkA_t@_rApCeaAtr@_}AneH}}AlxDowBd`C_qCp~A}|AshAafAkaGg`AobB{hFgVcwAae@zWi|Bs_B{l@ckDaoCs~Akq@mxBkRel@ve@evB|wIybAcFisAgj@kzAbOs_AhhBoN|dFu{@r~AgeIbw\\o_Dfa@u|@pv@ojC~uGu}Ahu@s|AzBcmC}j@_}A`QgiAxn@upDn~EuzBvsHgiAr_AsjAemAew@qiBaoAsJexAfVov@jq@g_B`oCrTkhMpu@cwBxx@yc@fjB|@vo@qWlf@wdA`QazAvQe_Fhr@mPdy@xmAlYSzkBmwCrxGguBh~@g~@tGsiBug@evAqv@?ioC|u@cnBmRyy@q`@kdBkzBebByO{~By~Dw`Fy`EevC}cIyN_gB}UqLmy@pLwyAsr@ywBszEon@}zFgUurM|_@i~KseFc_HqVaeBbE{rEgu@yuDbjFavRvFytEmwDerG_qBug@y}Fug@_^_gB|j@etEks@}dHhKmhIihAuxCufByxAiaJ_oEbFmxBpiAmlEsi@cbCieDkdB}hBoiFew@yy@vPekAbvCyaDrj@ojCkQgsBifE}xHyd@yDaq@bc@aq@_gBmcAirAisAqW}~AjgAeaBy~F{`@qzG|@ipPafAeeJwG_fCjSy}GqMmoBufAysDuGiiAwe@si@{`CjRu{@oY}k@ox@|Ao_EgiCuaFyOcyBpLy{IgzGam]ucEkaGu\\y_FpWiiNoaBmvDuzCmd@a|@reD}i@reFybBp~Cq`AyD{b@u}@}^t{BmPfrEk]|gAs~@wdA}rDyvAe_B{tC{R}eE|n@qgF|~AywBdW}gC{o@anD`GuaFgVafAmuAgu@`_CafAdr@yy@fa@olAxYsgDbe@ykAfjBmzB~{@kR~|ArJ`dDjzBdkAfa@zb@ufAwyAqxI\\sjAveAq`@hoFbmCvnB|Txm@k]ve@_xEhqCegI|_@{fFeMmkFssBcwBsfDyaB|AadCag@caFf`@whU}t@}KuaGvQ{a@yD{_BeaBqtD`FovA~}Auq@jdBweAnY}}Ae}EioAom@~cA{uB|j@o{Ht|@_t@hhAo`DaHkeA_qC{kAyb@woAfV_hA|}Aa\\tzAwy@pB}bJhs@ueDleBccBj{@nEp`Afu@~qB|j@xwAwQbmBcy@djDekCtoCukIftB}iAxuDtSrr@ag@xPowAs}CsyD{LkcGvtGqaf@`]afAxkBo_CfL}`AwnAifOjHazCvoAq`@jtI{zHxDetEowAcqJjHifBtr@_sAxuDsfCduAga@hiAdMzaA`eBly@gK_^ohGumFqbKbPqfGr{BscHoNghB_|@gkAmmCazA_JwoA|AwaFf`@ckEbdAy~DxeJisOdvByw@jr@jcExbA}VftAhu@|sAvFqBoPntD~oFdNdjBob@fgEhSdaBvoAlo@jr@t|A~{A`mEfa@x`CnwA_i@aQt{BbbBbp@vnByy@|UyiEd`DwoAnlAylBom@mbD|JiwIby@{j@phDoNr}AcnBf`AmuGh}@{iChtLqaLr}Bg|DfuBybAbtEafAdmAyy@j{AylBp~BavEnvAieG}Jy|F}gDo_Eq_A{iCgfGm_GelAekCcEkxF{V}pCi}ByaDg`@}iArUwaFsrBs^lPe`CtdBs~AlQqjAit@ag@iqAhKcdAjpAyvC`HaoBz`AcoAtpBex@lwCelA|sBe}FzjBiqB`fA}{DfgEevAR{`A{hDrJwmC~eBwkG_JuoC~qBaaSbcC}dHqWmsGdM_hAlPpLpsCa{@hu@od@ri@k|@bxAg{ElZqsCoDgdHfb@e`Ct\\|qBnmAs|An_DqCh_Agj@jg@irAkqB{bAwEeiCteAigChsBcy@pLu^qLyaBwmCmvDmPyhHf~@wmPeWuoCew@gjBmcDkxDs|A_r@yOug@dX}hBteCkdDhdFp~A~}@eM|h@{`AtrBq`Br}Axy@jeAp}BjeBdiG~h@reDlFtlHhUlcA|}@zl@pl@}K`vFqnHl[q`Bcd@yuDjoAwrIhT?~^by@fa@~Rxb@kRbzA{~Bz{Es}BjaImzM~]ibFqk@mbF}hBawD}VikJaf@ojEmZs^k}@|Igj@|j@?hgColCvZ~]o_EvxC}kJhrBtg@v{@kpAvo@enKjnC_cH`h@g{Enb@iiAllDsgBp`A_{BsJmyAoaBanDiT`g@_S}zFnv@spFtdDmoMIipCwdBcuFdc@k{AjpBwe@|~AnNpkAcZxx@_eDx_CxjDrfDqA||DqkBjcEgrCxtDu}@zm@kf@vd@irAl[sxGfhCckEt]ymAgj@e}Gdl@s|Axo@{X`vE_i@v`FmaCniGyxAd`CaQhgBlY"),
+	country("Germany", "i|adI{`bk@w`Je{DpgB{yTwcC`uCusAxes@ucA|eFa|Rp|AacK}nDsxCssPouHzvIupNyeZkyIktlCluA}rNr_MieChfOenU|~HpiTz}GkjDxzGsgV}HgqIkfK}zIgsInrC|X|wJybPuuFxoLy}u@pdJ`cKbaXayAkxUvi@s`MgiLy_]|pNsu]u_PuxEkjNf_Kcxg@ktEkzoArzr@obx@||Iawr@gmLhoo@_hRl`ImiFvyQoaOx|FatIpyYufAljt@i{FvkL}iRxcOze@k__@}eLwbCgeI|wKrzCf_QsxI`jHetMifDwsBgbOanG{_GrmHdrv@waCjwIyjH~qCe_CeuQui@|zJc_GmtAefAelm@skHqdVw{Fwi@cqRb}Xjs@piIgcCkjDwiL`jHiiHjxUogTj~GydIuoBpf@kzExDseFk{AuhL}K_qCvp@irNfsB}iNzsDolh@rt@sgBduCcn@fiA{l@zXomBaHudPguAiyEyMapBvcA}|BbF}hBoRavDclBisJeiEm|Ice@kpA``FiSlnCqq\\`o@klB`kGq~LsnAs~L}`ClWtkD{lNv~JqiHziD|iPfk@clTzzSfkAdoI~}J~_EhoWofBsfz@v_E}xJvtY`cKumPgcOmwDmjQz~XwsoAipLczf@uf@gbf@riDxmLdtMmmDpmRlmDr{N||`@xn@faPvvG`iIvtH}dH`_@ayMxcEgiA}pI_ew@lvFmvLnnEfN}cBeyP`xIacWgmXo~JiOisIauUiuU{qFgylAdxQyfDyiHr{BmxEqiHwN`sEyrVupl@a_c@kmd@jcFagJ|pBmk_AbaA|~x@r{Hh~Qk~AjhPdbNpyMj_Gef@dtAmmPetA`jHufKgjL{lNmnf@gQkdFrbG`pE{iDe_CzcG}h\\e`J{qMqwBcnSzeE`b@zwDqaLhzB~tBddManFz|G}}`@doIkvJltA|iDui@e_Od_Cb_CvtIe`Ohm@meGktE|lD{mHeml@d_CntAzmHumTniLrbKbbScp^xvNptPhaRk`j@|Uajk@ddBiQpm@jp@h{BlcHxl@kiDxCktCjoDoFd`BxXlyBi}AzqFcbCzkLRz_Cel@dkCyvCnaCg`A`cCcbCrbIg`Av`NclDtbF}_@lgDsnFx_EvaDduBxlDjkApk@drAlPnwG_hAduKvkEzoB~rAfxBx~DbpDfoJdwBl{JxfChmHrpK{~BduBxXvjAp_AjvA`eDxvAwfAtr@okB~]_mGr`@i|BhqCckEjv@qiDhhH_wS|cA}_Bl_GytE`gAawDntCqrDhyA_uJzoGqmKp`BmaEh_F`nDdpClo@z_AisBtaAa\\tqCjfBvqCbvCpmDrbIfwF{a@voGqsCxtDcbCtpBnd@v_BekCzaA{rEhgAu_JxlB{_B|~B|@bbB`yBve@?`vAmyAhxAzVtpBdbAbaDbe@rxAs}@rzDiuKnlCvaDlkDby@`sErgDp|J|TfyB~s@poBf_Bl~A|qBfaFneJjkB`qAlcCwQj`BueBpo@apB`gAquAzcDoWz}Cs_AveBykCb|CsnH~jBwoAr}Ci|@pcDkIb|BrhA~vBnxBlxB~q@|mBga@byAgiCvtAewKlmBqqGp_BwqLtzBctE`uAs_AdmA~s@rbP{vAbj@mPj~BsrBngBmlCpyBczAjtDh_@xc@mo@fcCfjBneDrsAjnD~q@dmF~RzpBlzBj|F~]d}L~uGxxMfzHh{Cd}Ej{AnwAfsBT~nFf}Cfk@t{@dMlkF}_@zfFiqCdgIwe@~wEym@j]wnB}TioFcmCweAp`@]rjAvyApxI{b@tfAekAga@adDkzB_}AsJ_|@jRgjBlzBce@xkAyYrgDga@nlAer@xy@a_C`fAluAfu@fV`fAaGtaFzo@`nDeW|gC}~AxwB}n@pgFzR|eEd_BztC|rDxvAr~@vdAj]}gAlPgrE|^u{Bzb@t}@p`AxDxbBq~C|i@seF`|@seDtzCld@naBlvDqWhiNt\\x_FtcEjaGfzG`m]qLx{IxObyBfiCtaF}An_E|k@nx@t{@nYz`CkRve@ri@tGhiAtfAxsDpMloBkSx}GvG~eC`fAdeJ}@hpPz`@pzGdaBx~F|~AkgAhsApWlcAhrA`q@~fB`q@cc@xd@xDhfE|xHjQfsBsj@njCcvCxaDwPdkAdw@xy@|hBniFheDjdBri@bbCqiAllEcFlxBhaJ~nEtfBxxAhhAtxCiKlhIjs@|dH}j@dtE~]~fBx}Ftg@~pBtg@lwDdrGwFxtEcjF`vRfu@xuDcEzrEpV`eBreFb_H}_@h~KfUtrMnn@|zFxwBrzEvyArr@ly@qL|UpLxN~fBdvC|cIv`Fx`Ez~Bx~DdbBxOjdBjzBxy@p`@bnBlRhoC}u@pv@?tg@dvAuGriBi~@f~@sxGfuB{kBlwCmYRey@ymAir@lPwQd_FaQ`zAmf@vdAwo@pWgjB}@yx@xc@qu@bwBsTjhMf_BaoCnv@kq@dxAgV`oArJdw@piBrjAdmAfiAs_AtzBwsHtpDo~EfiAyn@~|AaQbmC|j@r|A{Bt}Aiu@njC_vGt|@qv@n_Dga@feIcw\\t{@s~AnN}dFr_AihBjzAcOhsAfj@xbAbFdvB}wIdl@we@lxBjRr~Ajq@bkD`oCr_Bzl@{Wh|BbwA`e@zhFfVf`AnbB`fAjaG||ArhA~pCq~AnwBe`C|}AmxD~|AoeHdaAur@~qAqCpkA~s@dW{`Ah_Ada@jq@}~@ht@etCbn@{`AxbA}j@zrEgu@rpDq_Cdm@ybAvFigCpWom@juHoYldB_^lyAafAdaAayBnwAc_H|sA}|BljHwkExaA{aBl\\_{Bdb@eoJiI}sBmoAvZwP{tA`oA}wIphDciGj\\qkBphDufAtzCqfEl\\ymA|gOghQ~bFotDxbA_pDtJ{xJr^i|Bfj@gkA~eD}|DrpDmhGzkBydAjnD{B|~AwxAvmBqrDpjCuvIupD_{BcYquA~RknCziCkaIkGupBduBoNtpBoaAp`Bq`B`eAqiBxc@guBfW{gFh_@yvAntCkpChlKslHrrAcyBhcGtyDlz@fMwFi_@zuAqaA|j@clBrs@hrAriAbOzhE}_@|gBpCbkDby@`yCfjBr~@jpAzWzgEffA~s@xeDnv@guAtlGo`Br~AexApqEcwB|fSvQfpExq@bkE~lAlyAlbDyn@f{@cy@|u@qLz{@lPtnDtpBtjH|KhjAdw@zcDxn@j`BdvAzbJ`bRjmA|pEfcB`|YxjDzfSddEhlIl~BliHxMkG`l@fyIv`@h}Ax_Fp{FrsBtwFbqBhqBpRzuBzk@be@hsAfKjqAs^|gCupBvsH}vLpjCs|ArlCks@pp@kq@j{@ytC`nDuxGviI{rEhzCacDtrL`rKbp@fa@bxBdXheDr|C|dAaeBvj@e{Iyx@{zFnI}_BfeBkyCblCayBvyAwp@boAmPlvClPvyCt|AlxAvcBeCxOvlCeDoBqLp}B`qArrCeDbgBkq@~r@}@|gDp|EbAdbAy}@tdCv_@zhD{QncAu{CdqHwpFlqIoX`zCisAjyCeyC|_@{oCgkAcx@oiDapA~zBkgAbaDkpAdaBelBq_AjHfjBa|@v}I?x}Edc@z}Ejg@zuB|fDjuGf\\p`BdNhcGg\\zvA}lCjkFm{Ep_CqLteBfjAl~I_s@`hJfLhgCdw@hnEid@`}@}cAda@apAu{@uoCox@`\\piBpjBxkEbZxaBptAi_@rxAq_Cja@mP~eDiIjaB`\\tyChqDkb@tlm@jgAnkOj[leAhiBr}@nMr_A}o@~~Im@`|Nkf@dcKlwBvbEvkGjzBptAtpBnc@rfC|_@fdHqk@ffHrU`mCvdFpeHlk@uoCnm@{V~|@rt@p\\jdBjChnGdXfsBtwErcHhi@hgCsJztC{hDqLcd@tdCf\\r|Cx~DfwKjX`yBoExjSqa@dvAqsA|~@ugAap@meArS_cF~vHcdBt`Iqp@ri@e|AohE}i@is@uaB|pE_CnuAf~AlsIdNvwD_Jrt@upAzkAgrEzwXg@bvCxg@puAtvBr}Bfj@lnAvGrhAmZdiGk]n`Bit@~rAst@~Ig}Ak|@iy@vZsJ~TnXlwC~Wr|A|t@_H|iCs~A|qH`fAn_@{vAl~AevAhlAyX`eCi@nkCxw@l}BbzAx|A~fBhtA|xF|cFjoDveBjoBzjAbyBvsBtvG~b@hgCjh@toN_JxZslBuqA}o@giCuh@oNomAlPgfFapBooBoCei@~qB|`@nqGpdAxcBo\\z~B~r@xjDag@v{@wz@p`@ccCrUgu@lcAajAamCw`Aa\\ihAzu@gpHnrH`fAntDwZlcAmiArJcsAzw@mnBjnCkfBlP~g@rjArKh}A_I~xDen@~zD~h@z}Cqk@f~BaaA|VakAiK}hAtS}t@~gAsTnwA`Fr|Ar_@lyAfjBhgCfpE|fD|u@piDtCnoKygA`gKowUf`u@}d@zqFBxhFixArsA?rxI}s@|wTr^fuB~gAlbBbp@jbFrNxsFcZbxCkyCnqGmj@TaQ_rBefBn`DgoAbkEeD|fDpyBdw@re@_r@dB`iIiwAxZq{AdkA{sCywBc~@jh@gG`bEsvDtzCaQvoA`g@xw@fgCh_@fa@nlAy]j|@eiEnd@gb@rrByDrzCle@nbBnaBcy@h_@h}A|j@huKtR`p@n`CtpB~{Bdn@rcBv_Fhn@j|@dm@bF~aB_i@tbA`g@pZe`ChcCk`HgBcnB}hBsoG{a@qnHnh@esF`sCpb@{Ltg@mo@tHfUtqApa@dMxs@eXpkB|@xm@bFfo@tg@`R|rAamClnCa|@f_B|KhzDptAd~DrgCvoA|Tn_Eq@znKydAjiHqrCbnBwe@niFH~oBle@dpIfjA|hB`VjqBjkAtyD~bB|hB|Zt{@ri@~wG?flXwnCbp@yY`e@mObrKk]xvAzgEdtEfl@ptBbhArgOmKrhCsrB|aI^mbDmj@oaAo{CaqA~HnlCv_Av}HeuAkg@sz@pLyvAnmBg}DxkCynArsAs_@j{Au[nW{hEnd@cxCcaDaeBgByyEnlAuuAyOoiEcxAg|@ovB{dAqLisBh_@qtA?srCu{Bo`C{BorAq`@uuBueDgjAmz@abEpLc~H`mG{`GjR}xBmf@i~A_i@wmCovBayAgKmkAih@}pJkjKwyA{`AekJq~Au_BwvE}zA}tAivAwFcbDhgAgjA`SaqAcFgkFolAk_DsrB_vB}Kuw@i_@adCgfF}i@?wcCthAkxEUckE}s@}oGqfGow@koD{u@q~AkwGijKmp@yZcvBwF{v@}_@m[qeHkSob@i|AcZebAwfAs@{u@_fBmjGguAolAcbOqzGqrFcbEcbBcbC}k@jgA}rAheR{zIz}]dO~sMebB|fOzlA~bHsk@rdGfk@vaF{KnuAs_@dy@quBpiBmn@ziCk^fpGyw@hgC_zEn~Ea{@xvAqu@_hAmyAjh@cZ~oB_K`mGwdAbZwElxBxbAryFh{C|hBxd@peFjoCbmCqv@jnEgBvyOqv@hcE{sBfpGxc@zw@bbAeXt^tHlnAx~DafArhCojDhgAq{EeBqC~eCuoCbeKwEbdArJduDaf@|cG~Ht}@zuAbc@vkEafA~|AzjB|JnnAcz@nrFq@luGuSrhAqvA`oAszCap@ebBjf@le@|gCkbFhhBqv@rqC}qBzBixIhgOsgAlf@ex@ga@qBcnBkq@cZmd@hTg{FzyIod@}IufBpv@obAdjB_zDr|PwFbdAfaAzhDtC|vBijF|Q}dG}s@_eE?ycAqb@uaFa`G}|Cg~Bkh@xDs}@bxAewA{_Bc`EijMql@|u@k]iTpCkrAurAhiA_r@dM{v@oCqa@w{@g_BrLy_Emf@mFoNqVp`@yd@`xE?vjHkRblBudEnfK~_@|eEcbDxaBebB|mH}~@p`Bw~HllEaoBdjB|tAdbAsu@vp@unD|}A}hBzjBuq@bZmdAmPoaCve@kr@tg@eXzbAi|AmpAqBt}@sdGwdAmyAij@}i@yw@_i@cQme@~T}_@h~@wcBga@_i@g`AyXejDcz@md@meA{B_fAza@mz@hrAgu@q_Ai_AolCu{@k|@{vAqmKoaA}qBgw@vZ}}@}KqkBifBi^ssAh^y_FkR_eDgt@emAgvA|KqpF`xE}hAjG}eFyvAigBqCyYncAqw@rt@ovBhs@aq@oNqj@ydA}j@j_K_h@~zBrr@hmHq}BvxC}t@wp@cPjzBm[lGco@cp@srA~fB_rB_rBi|CooIqU?omB_rBmd@dMyEnnL}i@p_CyxA~gA{`@irAopJl{JgkAdwBcE~fBhj@jvFcEtqAks@pW{`AgVa{@dBqa@~fB}UtHigBwZ{tBnuCcoAnYdN_~Ait@wxAgiA}_@mnBjRs}@}u@a^{tAxFykC_t@ydAg_Aae@wp@}@q_C|s@mcByw@{k@bkEwzA`bE{jCdXsgB_}@s_@zKbPd_Fuh@ryFzkA~jIq|CnEwrDf_BiqCnb@cy@anDt]quA~kBs|CwF}qBit@{kAa~CewBa|@}rAo|GcaQsxB}bJslA_|AalAbbCuzAo_Ea^jnCpeCjvHkr@rqCmrBpk@qjCaQo{Bst@{hDwbE}qJk_IulBc_Fav@_}@ylAs`@}gCkR}u@ec@qeAh}AcrBkGs_GmeAc}H|iAytD|zF{cJ~~KwkAbZcdFqiByrB`fLupBlnC_pD_sAuf@~|@avAfrEaLnrFebAcDwtA{iCi}B_J}wBhrAubAbmCuv@iTul@_gBc[e~FgxBwrIoi@o}FngA{aM_qA|rAi{DvuHkfAqsE|y@ohE|cB{gEvt@grEwUwuFb|BcmCrUsnFtc@mdBj`BwQqg@miFi}@{X_lC`r@nhAooK?g~Ba\\kfBiqCmrHybCamR}IihPuw@ehDkjBkpCg_DwmC}y@oaCouBtHyfCx`EspEtcSg~AzKc`Agj@si@_dEkyCkeC_cA}hBqa@ccBa[_tKmk@ymAwlDgiCimAmxBkgAakGccAu{B}aEytE_gBwtGc_@mPgz@hs@qdCpv@oqB{B}oGqiDybF}s@u_DxvAuyJn{JzuAhgCta@j_IqPjoDsjA|aIwi@xmNgp@vcD{vAjzBe|A`e@u|E~]aaBzkAsDifBajB{yI_qCtwF_s@|@{jBug@m`Ahs@k`Bi{Eqf@gpEyD_pQ`|@kjI}ZcsHtaAe|HudCupBi{Eyl@uaTey@}oEgu@a}DwnB{gN{_OgpEsfCifEox@}~EuHo~Lby@}}BnlAwiApCqfHuqCk`BkRwtGnWkSq|@"),
+	country("Germany", "eqxgIim~tA~_Dva\\}~BjxUstEsmTuwQzhDte@giLjmHltAm_C_qDl_Cm{JipKiQq_KrpSv_JzwIxo@dyEwhHyn@biDnnPqiNi~F_jKphImx@qhIrxIk_GtkJ_k^xfUsia@xn@kmBpgDbiBh^bnBlnAelBp`Bkq@"),
+	country("Germany", "wrdfIewnh@ltA`xMfmDctEkaFf}Q"),
+	country("Germany", "elcgI}f{l@|dAcmTvsC|j[sxDvl@"),
+	country("Germany", "oinhI{xldA{_Gm}H`@q|Lv~JhzFq~EraAzvDntC"),
+	country("Germany", "kp`iIatjeAmfFiaPzwIb|M"),
+	country("Germany", "}cxkIuruu@bxI~tMmtAltM_jFydE"),
+	country("Germany", "}jokIujucAttKemJ}Gzjf@sbFnvA_hBxcQq{F}mDkhDibP"),
+	country("Germany", "iuflIgafoAniXt_Oy|W{~Fev@soHdiDrG"),
+	country("Germany", "om~jIamrrAveHgiLx~KpmGa_@lhEs~BgbCdi@~zJuwE_{J`xArpQktEkmMti@fjd@bqLjjZ`fDlWikBsbKnkFr`AenCduQmiEasCzgDtcLi}FbiKfj@zwIs{B}lDemDpbK}sFapE{t@_`VqyGjxItYhtIqxIytU}zJviX}bAytUbtEqnFw~D{RmxEgjLf_Kd_Ckr@eyEukEfk@j`MgpI|Zq|MwbOmtA|sAznNivItbMxgHfsUgdNgzF}cAreK}xE}e^rq@grIbpG`cKd_I{bGfuBcao@~_Deh@vkLh{R|iJctE"),
+	country("Germany", "ekylIilzq@d{JinLrHp{Lw}E`qF"),
+	country("Germany", "sremIytzr@nmDexQx~F|mDkiDdm`@"),
+	country("Germany", "cl`nImiys@tfAr{CxgBd}}@v_W|lCof`@_tAg|V_xMu|D}eGnzAqhH~Jn|JdtBonDbmGvjLxmMxm@f~Cu_O"),
+	country("Germany", "mz{kIylus@a_@a~KbyIxsJ_dCxzG"),
+	country("Norway", "ebygJ{`gcAuzCho@w\\mgEu~Hf|DntAx~Fx~F?qpEpiHpiH{S?fmJ{xIzkOz_G|gEitI~wAnlEzmN}}CrpG{fKte@f|EtxEmxQk_GqPtoSaqJxbE{|CyhBg}DdhLseKqiIjxI}nBijG}lDc}GtsFaj`@jcFgNrsFuoHhkA_dTy_Q`dVadKmm\\wxE}mChnKx`Kbth@drZkfDfvRk}TbbM``MhjBvuSoc`@dsF}aIhpXlbBnhGdxK{f]v_WeJyxCda[hmSoxXf_D}bThwNnmEr~GkrLpwIvuG_rCnfTnbIcFx|@jeEj}NffBeh@ntC|rMyiBitIdxEpxMp{@qxM~lFjmMyo@ntCd|C~eAhaQgfHupHrtE~hSe`BniGlyJfKjlBz|_@{o@psEw`DmmEoaKtkKltBnrC}kJjrLttFb{BwrNnlF|bAf{PlqSmtc@hbExP?fiLjiDssEf{EpfUil@vtS`rDwoI~[li\\~j@ooD|bCjlBgxApvOjmH~mD?aab@b_GxgO?kcGbmIx}RoN~~l@jcEwdMolD`jGlbBqfUtzD|mDdu@~zWkTacWheCyl@duLfp]yjAbpVbiDg}Ep|KbaCbhYzbj@~~GdpRqzAlmD`sPhgNq{Af}P|lDhRz_Gfw^ti@onD`iMjsLwoCruDtrGfvHudA~vLemDlmDobCkxI}{JpxMpqJ}pBz~NxxS`zAdep@hyGdrH_qCh_FvmD?wkEztqA`dJxa\\wiFfl@}lDkyUsbGibEftI~lh@iuJixGrjInqNqiHim@b_C|bGc_CpcKc_Gscc@|e@vpU_fHdxQf_Ku|DhC`}M{qLnw@pmLrtEb|HqpQleAnwBa|@|k[oeIxdPu|Iq~Kqa@ucNim@d_CslBwbCqqKsj`@bmHlc_@wbIpiHumDklCbcAsqFo|Exo@br@rcLjpMcf@akD~`k@g_UxgkAqaQzyIqhAdz]ydEbqBmlDzxXcgg@zz_@ovDyoHyoOyVe}CmeGacKf|EdpHotNoqBmv@_zG~wMupB_pDoM|zIweH}iD~~Jof_@rmT?__Ks{BabFo`Vd_Vytm@hl@}rYyzGj`_@k~Mq`K{uPmr`B~sIj~yArdQzuWekJfnJmxE_xMi{BjxUskHdmJojFs}AeuG_am@{Dn~Uk{Tgw^}mIcx\\w\\c|YyRd{[xwIzd^krFjoLoqC_tZwdCziDn|DjhDbH|pNktEtxEb{BrcKguH}~Gc~Gqv[{xNwpIusHm{y@jtEbc{@u_H_gGyhO}lEbf@r{Cjad@baY`dJjrW{iDttGziDntA~bCydDt}Cp|L}xP~kFm}Dics@eIrap@{wJpmQtsAd|DzfKu~BhkBfrHczCvyFj`GvhAtaChyHu`Cs}YpiNovMz`Ajf]~eHjcQi_GstEgbOhsJvk@v`DvuAsxCjmNzkBhpFreKezDzqLy_RvOndTpqEs`AzeDwfT`wCxbKhiBvx@rtE_rObtQ}|N`xBgzAmxJ}~Bj_GoxRczZuI}cGxtI{iDk_GmtAktEziDwiL_nPhz^dGtgE~zLvmF?mxEqmRgxYqiHjqAey]l{Irf@gLsqFebHswEzDssPgcC?bFd`YumIwhLi~EmjPpyByjYqqQkpp@t}Ndpw@u~BfiLm_OuxEzqLvsIhwBvcOasJzwUcvDei@vi@~wMgmDw{QcoHcvDasDs|e@yeCedAmeBtxEsuJadKipKi{u@k_GrtErxI`r\\{wPv|DyiBedL}mOwqJ_jLmi\\{fK_n\\nxAq{Yzb]rmTlmb@fi@qmd@qiHsmTwbOctPg_\\eh@i}t@idD{hD?xbg@m_OkxUuIpuDlzOnxX|uLd`f@kbAhoKwtM}bSxmXtulAgsCr`M_nJpiHh~PabKzbAvoVrpAkrLrmHbmHt~Be~BbfEv`CkaAt_[twQt}NhwBtiKpqWoZn@|mOrtDliEa~Kj{H_\\g_PqsDigCr`Ab|My`QzoAexEgbOddMlre@quCfsH}vAw|D}jD`}@mmDwc[?rmTi{FuwErtEp_KkxUeuQefFipLvtGf{Qe_Cti@~m\\hxSzwOzxUqqJdtSieDqiHje@deXgtIwaPkpAxgZsoF?kVcgJg}Dv`PmgF}dFgm@umVgeN|zJqsIylLreQah`@nYisa@emI{xJseVjTenUusF~wAbxOsmHybEy`@`yBjvJllO?bjJ`nLvi@tgL~fk@srAtaNuiPr|Y|bGu~ZyqPt~Znm@q_Kc_Cf`CaxM`{e@cu@ytIl~Osiy@r{NegL~\\swCkiDt_CrtEu_Oi|GthKtfAuhKk_Gd_CsbMhta@}eFysUewF_\\mnCsfb@{dElvKttFji[xzG`pE_^rqSsgDd}BfiGzcFmmCeJwz@pvOgxSlvKrFssQj`M_qC_hMfLk_GmcGh}@n|W{rKiTu{DsfVhxHejy@xaEazA_tIwhA_~CaaLr~A}_UumDnkFycDgq`@`xHwj@mmIwcOeZ{zl@npDmd^nfHd`BmbCkxU~`JhSmvLs}NumC_bTy{LivIh_Fobm@tqH{cEvLwbPrmNgpIb_M|pYuiDiua@pkF{jNbvK`vCi_F}`I{lI}V{oAtfL}eXjeQqgHqlj@dtFqqh@}}H`yMmrNqn`AxrE~zz@}kPr|M{wPilL{{Swjd@lxQlbk@ui@vbOxiHmbGz|H|wKnvSulVdmDvsIs~BtzPbaMr{q@cyCh_F_gHszOr~B`jTo~Jtu`AumVqxY}iJwi@dfW~fRjaAx}RvnKiaFkTrbM|yJ{_GlhEb`M{aApr\\}jJndTrtE~h^_uApgIy~F_xMrmHdml@i~FfeCh~FnnEpwB|qd@~aJbbY_tHjqWxsC~hSuwEptCiPukJy}Aj_`@}zDOpyCy|^s~Bzq@?w|E}fFtaO}V~}a@ecC_xMwi@rmTemCu}CcdLogj@ndCgeNxnLkaGkvK_@w~DxqKmtAmqhApb@tpzA~jEvtS}lIxsJw`DizGfoD}rB}}IeqHnxAh~^m|Isll@vfG{aaAcxIg~[|iDoxYc_Gt_Oj_Hdar@ocDbzBg~Dzjr@yxArtF}zDsGui@spi@stE|ih@bf@exu@afDbt]sjDoxLtj@nvgA}fG~qDgkLcnHndC_ab@qeDjcGygBipK_]hvTegLmoq@e_C~eH}pHqkSxuCg{u@poFsbLppE`cWe{Dqx}@hxSlcGa|Q{bQhmL_}c@imL`cWh_EchpAy|KwrU|wDmvc@wzEy~F}~BzmZ~rBn|VbjIjnNuhJpfrB|iDd~Nk_G|tMyzBcjl@_cCdkgB|cGf~s@}_C`dJqtImcGjpAydPoiLu_O|g@qpPeaOxld@wi@`lRetAhkBui@stEqtI`jH_qD}fSpmG{bQ`qEn[bvDajH~vGuaYpvIa_A}bM}qM|bAywIcwIvdf@}fLwjKxbDww_@p{Ma}Wm_OvbO{}Bgpm@~{La}AzoR|tMueLuyPygI{nBh}FooPhaEug@ti@ou|@qiH~hu@gpPlf^aeDvOt`Cc`Zy~FhxU{sEe~Da`Qw|_Abjf@}xm@f_Kr{ByeDo_KkxCtdAum\\h{^azMxkByjAcpRrpGyqKixId_C{~Fmc_@jrEah`@xdKp`@vbOwpUbkNubBvbJreKfzE{pA?cil@iqDpmFdoBpg`@ozLchJw`Olw@gyAmpOzwDwyQw~DgyRvtHakSk_GjbG_fIdjpA_uGpkGui@q_KqeNdfWi_A`qQetAstErlIbwkA_bDlf]m_Iych@h{@}cj@mjD|zJvj@p{YoxA{hPoy@jeEny@bvs@exKiRulCg~s@ptI_uMemJmw@_zEwgYvrNuq^kmHsaAe_Cj_Gim@g_g@nmDxsIrbAgnJxiNg~DctQu|DjbF_pg@~vG}wKnj@w~OdiFs{C{`Hg}DcyCwag@?lr|@ukJfuGeh@ipJhvI_kGwoPyQ{gJua|@eH{ub@dcGccXueLx~Re_CmmDhaQ|jeCkaAd}CutAeqGgbClfFg_Kkn|@`mLjziCyl@vdC{~BatEyiBjiDotGgpI?yfg@atEvcOfm@jwUonKvkLcb@tgLmqBe{DitOu}~@hhS}mr@akLws_@~h@{{TbyJbyOktKcru@jeE}_UxmXueLl~S_beA`yHmgE}{CihCynFlpCeyWdheAmrGzoAqwBsoF_{FrcKaxMlb_@hgBttSu~NwmV{kBmmPhgOykMrjIoc`@`sDt`ChnKs{Box@{vJx{GsaYqgDsFkwChjMxiMwgp@otYlbw@e_Cylp@?`cWw|@~qCaaEirK_NljEvtH`bb@afDvsIu~B}l\\q_@~fl@opPfgMyvBwqInoJ{tKstEuxEi_GxgOh_G{`nA{vEjuKo|DyPmiFcdfAbaAjk}@iaQrw[kxIagw@qjGhoWauD_pEcr@ao\\jeEqgJ_sCc_AxRutSk~AbyD_nE{|HjuPimX|~NxwImeFguHggMke`AbfE|pq@kzDhyHgjEa~AykH_{y@tcB`q\\qpE~tMz~FuuEy@d_Oom@`kGyzFp`@}}Iyfg@fwGw{EelJg~Ph_FweNevFziDmv@zxIcwIcvg@j{NuuRvsHcd@rxO{e]zzHzgEjmHbrQwmEceb@bfFqwO{Ge|Z~oJghBs}He_Cg`DtwEasD|xc@qpEd`BuhEckIemDmig@x~Ey~t@afCodUlyEk~SyqFuoTibExmXmzD~tB_hF{~^_zAnyKgnEoyKdpIv~r@ctKihNmtSejy@vzAsyO{sLop[k`Bind@svJnhGcq@vf}@g|Eq`@wcI{uVo}J}mr@ibEtll@moC|tAm}I{vV~xGnuYztKxxHvse@vmtBekPipeAbdKgdZjnS|__@|sj@l`aDal@dt^{qMtcBspAhmLcyCjkCaiBkkCu~Bh_GsiDui@gxMq`z@lbCdxQefFawC~_V|x}AsxJ}t@sbGk_k@wi@bjl@}iPic_Ac_Ct_OitI{`nAkiDhxIveHpxe@_jLacc@riDxmd@_xMe_OmpMt~Brq@c}d@kkCe~Cvi@rmT{~FmmPy~FziD|sAe_g@kwJfrUguHskl@{lBjcG}pGecCtiDmiPc_GxtIltAid[{mHtvQ?|kP_uCapEpcEkqXcdFpjG}nBqwBlpM_{o@yoKnuNs_ApaVumDgtSfxAklN{bGj~FmWswCjdFceLalA{qK{~BbtE?kbGovGfm@grCh{FkiDi{FjiDmfSvdIebB`xGy{RdkEr~AfxAm|a@xiTjxUocO}b_@se@jiD{~FqiHumDcco@fxArti@azGknCi}@{kNkiDppEtdAioKqsJw}g@fxAhc[s~GpxC~~CdiJje@mbGpgCzlB~KjxJokKtwQurHbxCc_AmmD{bAlmDipEi|i@siDtfK}iDsjw@emD`xe@{pAgc[yfC?vxAp|Ya_GcwQqxMgd}@d_Can^qpEbn`@frCpuZtqNdpx@`dDo^vjEva[b}HlsMl}CpxZs|Nk}_@bcAxgO{mBtkUk}AgbCiyCe}s@iuHkz`@vgGp}rAcyIqm]fbD{qXgbDi|F|~B|zJy`FbpFa{F}l~@ceFbd@s|H}a_@btF{eFy_FsrFfbDieCgl@_}J~bI_cJszIpDaxNmpuA_sB~tBwnKky`@ggR{lZxu_@jsrAwi@ddYkmHymXafDhc[miD{udA_wBh~t@dwFded@ymLg|QscAcjTooCkiDlHjvJa~EqvNj_Gkqd@stEhf[wbOfjLvwAacWf_KumTg_KvcOsiD}m\\?dq`@wbCcnH_mEv_Qy{Ak~Hg_KjbGneNlyJoz@boHi_KimLptIdtQgqCjdGgwGe`Com@rbK{lH_w@ixNksXveHmoZtxE|lDbpA}{a@eoC~eSy_QhqWgpIibP~rBcbM}`H~sCjDv_O_~PoxLgyFsm_@`_Gknd@dtL{nYw`PbkIm}H`xe@umPitUh_Gxb_A{~BlcGs}Laxe@whAkaj@q_M}gi@{iCmej@ncCgsHfnEziO?_nPfuM|`HecHogS`_Aipb@{yCaDgvApqh@uxEidEigCkxUjr@kyHdxE`jHsG}nD}fFa`c@evQsk`@|wKpdm@sjDx_]hvIddYwcCdtGspAqiHaxApiHlmTdogBgsB|u@m{J}tXyxHyogAym@jqXu~BumHyEv_PcaFvoItbHkfDn}DhuTcc@nqPkiDmtAb`A~`UegF|gFktD}oZwbDuxDqvCntAkkBfsIspA_~JaxIxmd@e_CynXvqDixSbwJeeB{HouYwfA`rQwhGuaCinAnoDomD{{k@dxEgnb@smH~mh@snGczZhNwkWuItsj@bpGloN~xA`_c@qp@ptEkfJgyGefAg~\\ip@ty\\otBlsNqzAc_C~oDgqdAmfFbcLcqFnwp@|lCsjzAww@e_DetAd_CubCknd@tbCzm|@k|ClhEelC{}G?{~^o{@d}g@sbAjaFwaCexEdsAybwAazGhoz@mnCa}@ccGreLme@tmTucB}mCk}Aiff@ex@w~EyfC`|BafD{xa@?stEj_Cb|A|~BreLheDimLmpI}pO}bGbwDqnF{{GtlPu{PwiA_uMq|MyzG|fAwgq@v|DspFyzF{pAm~Cvh|@gxMstQue@mjPn_JrtDcnGkbFei@qoi@z`Ga~KakGb}BguBtzg@yuCopPvuBajHqtDg__Af_KjiP|nImdS}nIbdKmyJgy^`aJskw@npJdh@ziDwjW{iDdgKcxI}bGyiHnfSziDa`fAi_GbtE_eCrgy@d{DfpVeu@xbDsiDszNme@ttu@agC_uMa_G{iD~xFbha@`Ef~h@~wAm{JbpFbq^~MpbJktEgdNmyDznAddFl`dAmoY_lt@_dCf`DqhCoeHkFwpa@laBkkCb_CziDhl@cxDilLwxj@?~fk@oxAc~B{iDvsUeiPez_AtbGcpRgmD~k\\nwAfeCxpEgjLxsDvvG`jAdkb@bf@{rc@qgIy`^~jE}eF~m@n~JntAebCleHrwEunJmdSuVmvc@|tXejVrmHexQ|lCz`G~[ivI}iD{bGqbWbgc@uxEejLke@dqIghGyoLodHiry@v]j{k@dbG|a`@gtBntq@swDdcA_sBolE_j@d_Cmy@gyEldBeed@epIxrJ~aDpgHcqArgb@imLy|]wi@rbKobDybDtwFkxdAotI|qp@guAkqd@lsL_rf@{|A}eF_jLzfg@atEccKblCjaRclClzb@i~EexEoqDlrA`bFnoDy}FdzE~}J_zAlmPzmf@daMtpbAihB`jTq~Fki\\qqDzkOspAki~@w\\x{RclChfCetAk_Gb_Cvbg@cwDeKfj@`hIwuBbxCqjA_uBuzE_m_Aze@zmp@yzHyb\\vy@owd@luAk`Gb_GrtEqpJ{bSsiDwjb@hC{wJtbGxzFdoCsvD_iFwMy}FimLoeCg_\\xfI}`TahT_fTcD_mQkiDpqh@m{DckHdjEphTuWxnc@qbF`cJ|aIro^|sAytIpyAh|F}cGxvU_bPgnb@kq@esG`_GwbCqmGuwRsuEouApuDatPntAbtElpC_iFtfAfmV`nFucAamGkei@a`FaxNqtIvsIbEq|LsjIym@edAe{Dfk@sjJldGe}Ct~Bv{EdrBqzA~Kq_KhiHtuEpwIovA|jDlcGbmH_n\\zbRuqGhwOglo@ce@g|DeuAxtIapEvi@qmFrbc@kxOwi@siDfjLiRccL~kRu_Zfi@epIkiDb_CotAc_CabKp~XzaGswg@ikBzkNokFmhFmtAzpY{vDqwC_zEkpMroGs{e@c~BybDihHnwc@riD|ih@c_CotA}~Bp_Ke_Ccjl@ktE|zJb{Bita@w~J~vYzyCx{u@w{Emzb@}rGvgp@}wEinLb`AsjT~cI_gk@jlC_aIroFiQxbKm|m@m}Ovgd@ydJvvGgtMxybA}pIyt{AtlEqfa@dnHh}GepHcfz@zhPgzQxpDizSdrCd_ClvKqtC_fBszDmeGviA{_AegL_gSzme@woExhBgmEodGveHkg_AipKhnb@tzDqa|AshJ`vq@pShf[wbOklNtvQns`B}rAfxGonEqsPqsD~hS|{JxeZhm@f}`Au_O~iFq~@cz[uiJrkJixHo~K}gKsv_AlbM}uc@s~AyaEykGjnNgoEye\\xdJy_h@ze@uxi@syDpbc@gqItxPogAz~_@yqJ_gk@j`Ls{}@swDNmqAroGexFx`t@{bKmym@d_Cwb[i_Gvb[}~B}sAdxEvqx@o~Jk_TuxExzGexQgid@otApiH}eAkiDufFik_BorC`{N}`IstEue@jxUizH_zAs`LknYixImcGivHd}Cw}D_dTxaJsfV|wFzlB`eCs~YniY`BugXsdKpqK}gFrdF_oPavCwfA_fBbwDa|Bo~J|fF}gu@}`GtkJi_Gdrw@qbKv~OunC`aWomDstEstEpyAg_Fc|hAvtHjr@jpMof_@hbEq]|tMchfAbcE~rDbfFzx`@u`CijMpgCgdCx{@npClsBobIo^cvg@ibElzJkrKqD_rI~kRmj@|`l@ihN`aVmdGoZkhDekKqjB{pWbiDegLgvG`pE~oDjzl@_xMhxHigN{mZ_jGmrA{~F}l\\siDwogBv~VrmTduFsjItwVj`Rd{Ju}BnqJflVmtGy{]ix_@ytIliDscgA}dGnwp@_pO`oEuvWmc_@bVc|Br}Lf|Fy^qoFu|`@qk^`aKiQheCieE}vK~AsoGwjLdcNau[bxg@ngR~cH{qKh{Gdh@pqDj~RtdBu{DbhDtxErgE}v@ffBbhKnsMncHfuIfmc@ntAe_C||IrwOje@yaEofMo|Vu~I{ig@}fc@wbg@d_OikgA{sXfmeAigNwsHsiJxqJqnFccz@wwFuwFolF~oEimLkxy@btQpxe@di@o}JljDnvAv}DmoN_z@g}DuiDhtIc|H}~`@vbCa~@zmGdoIur@agI}`DcpG{bKltA?kxUsiDjxUkzOawNxdEmks@jtEcmH|iD~lD|bBg|Dr{Oyvx@pwMqu[~y@|_I`DcyOwpId{D_xGzmZui@{_ScsEjeP_{KteBwkFq|AwMctQfeH_oDqhNrC}sAccKdrBfko@}|CdeLavCmkg@woJ_lQjtJheiAilFvvS?zqX{}Hz|Gm}CkvJfm@~~b@y~F|bSg|J{|k@f|JkzHexQ}mDgeBg{El~`@ou}@kxIltAse@stEuoHjjPw|Kekb@x^wvG`tE`iHk{ByrVvxLilAspAw}EwjFviAaR_f_@zgJrlHpbKstE|iDxtIn\\_jFlv@erv@{~Fvql@cvP}qMvwF_tf@zcA_~eAzzNxzS|gFbcrAhvHxmXmaTwojCjtKd_CtrGihC|YodTriJe_C|~BxsI{`Gi|i@zkHeaBwhFazYonFcaM}`Bdjn@ahIjuVqiH_gk@}iD|bSmmDq_Wu~Bjxa@umD_gw@eqAdxi@_iH}{y@mtAnm\\afHwtHgb[}`nAlkIylsAmeLpeV{lBtcXktKcq^m_I}nBapEmuLdtLingAesGsG}rGvmLmtMpfoAgpIqvCwpD{tWlpHgjL`nFum`@wuB_xAseDtpHasEimAwuAixT_cChxUmtAc_CkiDvtIoxAe_OcpAviLspAurHn{@oo[~iFebNb~BdwF{e@kb_@cmHltAuu@cdK|tAsvDtxD`{@ntInxY|~B_q\\a`LuyO`dJyfPzeFqvCbtEb_C_cCsvQ{`M{{HuiLhzHoqC}oCrkIsk`@tjKl`UdpB}rBmoI}cj@f~EkgQq`FioWdgEesGzmNfu`@d_CtfWtmD}iDfsCjbFdyg@|viBsxCgt`@hjBk`GpuCvuGyQhtb@ppEakk@hq@v{Ep`K_]ovAtnJr_GjxUze@wtIhhBd|D|nBwfBgtAu~NtzCfOddNngj@fyWzbFpnFm{JaAolEcrLrzCk{BwbOliDfcC?qcKt_CvtIdrCmkEutBgcZczNa_MgpU}di@siD|{UyiBkoBwrr@gijBguSotNcaA}xK}k[wmm@_wN{vm@wh@ucNnmDstE|xF_]bpFn}KpiBe_OlxQhtIpePngcAhm@{q|@~~VdxQj_Ge_CnoDzuJpuOr_ByhBga[wnEr}AgxAqdV{gIypJj~@erw@cgJhrWqaEw`Ewi@rtEalBotBw|DmlPnmDugc@}{Idq_@g{FqbJ_cC`yMibDuxDgzAuvi@kcEdqHupHcoGnsBkuz@u~Bje\\c_CwoIg}Db{ZwkF_wB|{D~uMmcAfd[afDfjL_pDeeAhp@y_i@otIjwUsiCbbA_m@ycExyBerj@d`IgeNqbK_pDufAftHk|Gzs@obC__VydEyo@|mCmwK`pFjlBbd@_sChhC}g]wnEso_@tzDqkFiyGupT~|D{qLhoFbcKd_CltAgxAysItmDtwEogB{|v@biDqeJpfOlyK~NpeUd_C_xM|~BvzRb_Ca{Bui@r|YxzBayMblBf|E~Kfln@umC`wCpwChsm@d_CwiLjiDhtIcyCkrWdhE}al@niXjy|AunTmytBfuAajHfaPthL~yXt}iAptIstE}{J__KowAm|m@rpGbaYa_AikNr_BvdD`mAmoCoiLiqWin@waOxiYnba@vho@eh@voKv}\\br@ofIifIo~UmlJkbG}oTpsE{lU{`G_lQux]bVwjLx~FenHktEvi@emDwsIgj@dxQkaG`dJ{gVwnUWmeSsnG|jQ_rD_sDy~V}xm@om@_gw@viAayB|w@txFfYka^hyHm|J|_C_|Vx~FtmT`n@a_WbpAbtQltAkbGnmDvaOnqDe{CutGqvOyzGoadAloIi~EpmLj~RmtAgb[qzLmqYccAeqTbtEqxYnpEtmTvbIa|o@vtG`nj@z~Bw{Fui@|b_@dsAwu^csE{baAfeB_tNplAikBvjAzdFftH}b`@`hHytIsvD_i^hgOivUdcGzbGp~FkxIh}E~cfB`|GjuJpjB`hU|}IpyB~vGn`m@tsAuuFurS~flHny@_xY~eHt~ZtqAavBjt@kaj@bpA|{U~mD}cnAr~BitIt~BrcK`n@_nDan@scc@~eHg{aArmTb|}@_tIen`@li@yfg@pmLstEptUtgc@ltAr}N`Bw|EffH|}IsoMksd@qD_hj@wvFedXrb@}hEvbOrbWieI_wXheIunUwiL~wM_xAexQa_G`jH_jLcf@wj@efWhxBexExfCzwInwAecYzdXgl@noDteMeZgxSgwXwk@ip@mhEf{@{ci@hnF}pBm}E{eWtbYe}OzhQdCntEzrDbmCj|Zw{Axqn@avR`{u@bPrxTx`DuoPpyHwmCpeI|~MbpKjcE~{Mzfa@znKnqnB|tf@ll]haIxtRxmAhs[rj@jyBkjIljUq`AfjAmcBzBcpMgjBejCkq@upAymAqh^}dfAcbPqoU_wVrrZ_fNb}Ii_AnbBy{UhneCobAjmFs|B~yCqrE_HqyDnnd@yr@|gCkoUtkc@}oGpoI{kBtyD{[z|DjeB`rMmy@nyWze@bgIhcChcGdkBnn@f~B~`V~sDxpKfb@~`Hzv@`fArcAyuDbwBz|FhlDrhh@mFnvB}xBpkMjMt_JuwAfyIkVlaEp{@jbQuh@ttKj|@~x`@fj@hnEfuBvyBf|CfyGtpB{iAp~BbuDp}F|{R|cFpnHvjGhtLdYhhB`zA`e\\zl@|fDlyApsCnjCvyBt|@yOnx@sgBnlC{tCzrCbF~fC`nBrgBbaD~fBhvJxdArsAxuBkzBncAbxArhA`g@ptCyDxwAnaC~oClwCpdHy_FviJdvPdvApjAxvNdhF~fDjRj~I{fFbo@vFpkAlz@~mE~G`fB`{@jf@cDdbBa|AlnAoCnlBg{Ezj@sUboApv@h|AfeGdmAvdAhrA|_@~}@bdAhhB~cEtpAveBntDhfDny@dtCpKf}Co`BtuVdWpeHby@|bH|aKbic@jnD|oFt{B~pAphCv\\tbH|I~qAr_Abe@zBpt@qjAf~BrhAj}@~{A`f@hbHhhBnoKgBd_Dt}@bwDtwEjyC~rA|gCdLbaDqt@jqB{~DtbEqpGpvMioDbvPyhGjs_Ao_Fbm[g_AvoCmdCljEgyFnnf@}Aj|MrhBzwKzpGbwBd|FjnE|~BreQly@|f^leLbnv@qUziC_nHxbNcbAjdDoc@deIvcAvv_@ypHzyp@qLtcDxC|hDxbBvhUwP`yBguBdmAmvPf}Ci~@`zAkyClpNy}Hd`Ns~@jgAs{CbxAolAftAo|Il{Y_ap@djeA_r@nuCi|Bxo~@pcIz_i@ny@|sB|}A~fBzqE`wD|gQk~VzpGneJpk@xjDcZnrF}jK|gw@za@vlDbeK|fQzwArvdBn{Twsm@|hByn@fhPmo@fuBbZf}BhrA~wSx}RpeIjqOdyKt_d@zmKelv@fdUx_m@xmAbsFvEhzFolM|vvA_}NlsuAt}@t~`Agb@b{IozJjx^giAz{G`lFjsr@xb@p_AjgA~_@x_QjxBf~DbFdne@uiIpzF|gCbu_@jdi@~pB`yD`Sd_DynY~aoBdm@heCjhJhrN`hXlnoAhtA~{C~zBbbCr{k@h|\\tdCheCjhLxjSzsCvrIdlA~_KtrAlu_@fiAptBfkMfnIfmj@ku{@rmByc@~jXhoDrgBnbB~gAhzDvxAnpJdwMrbc@rcHr}Oxgw@rcgAhcT|nn@vkDsUf~VeeI`iIw{@`qA~|@|vVburA|lDfdcBzdv@ehSf|FwZjcCrhAbrK|`Lx`Blz@~cm@pyH|hHmEhgIij@jaHdXvvD|gEtnEf}Nx}Ar}BfxCbxAjcQzjDfqFvlDvaQ~}Y~qnAnnfBlbQcay@vzAcrVteAm`FnuCgsBv`m@y}GtpBxD`qBlnAje\\di_@h}@`pB?~yCk{NhwhCfgE|cp@ndLxjk@|yEriM|t[`}e@bx\\j~c@xnJ~~VtyRmrHnjC}@vyB~{Axj_@`oh@jcw@e~k@jgNrbVlbBbdAnaCgVjz\\cqJzoCpLvlDf_BfoHd}E`lDlpAd|BcF`_p@qbe@bsHmmDpdI_SlmbBll]drAquApxJmrUjpBuaHx{HqjN|u@_pDfZwjFzLsjLjc@{iExvA{|Dlka@}lr@fz@i|@fiAwFllQfpE~oD~{AvhC~|BhmI`gMhw@bn@`{Nd_Dn|Av{@tc@`dCm_Aj`UnHvhJ`sEjal@hsAblDj~BmPl~GgqFbpE}qB`gMigCd}Eu|AhtFqgDx{QorS|xZ}yTxpDexAlaEeXjaFzl@zuC~gAnvBdaBbcBxwBbuEnoIhrAlpAjeBvZzrEis@lqKcaDn_FkRdpGztAtdFblDf{D`cFn`Q~n]|wJ|t[vZl_Gkq@bj^xx@hcIf~Bn}FnsEhh@dwAgiA`nCkyEvdB{kAr}A_HllFjf@toB?jr@za@niExsFftApuCxhFrhY~}AptB~dDpWjeCag@dwe@}xS|aLwmCvlCk]vcC?h~Anx@vdB`nBn`Bzw@neKfhDjjH~RjeDbdAnqGf}CtkH|}A~lInvDfgD`iIrs@`rKqL`rKcx@f~DyqKwFgbEfaBug@q@_{FrtEwfG`xAi{B~tBebAv`DsVf_Ev\\x~Q"),
+	country("Norway", "_wk_LqwwyAoqCd_N}|Ikf[ryCkpA"),
+	country("Norway", "yx}_LehtuAljEinLprEdfL}cHxtIyhBbrSsyCc_N"),
+	country("Norway", "ops_L_yrtA{{BirJziDs~LblHblHxgHtv`AziDgiLl~CxfBudBzbSrzCrwCd_Ce_Cdi@|sMefF~uBohAnzK|oBzlBrzB_rCxnF~iFmkDlwL{hPozXziDrmT{iDctEqm@btE}e@cmHetAbmHewF_uYu`BhsUodIouYhcDkd]sfJwbOghAqjTpkGsyCryCf{F"),
+	country("Norway", "{{`aL}kbyAlWx{GefL~bUarD{uKljEar_AjuJlbT"),
+	country("Norway", "k{|hJmse_@~iGs_GzbPfaClaDjhP}hIzgG}dNpD_kAwhK{mHfsIs~FuwEuwFllC|nO_mQ"),
+	country("Norway", "irvkJspk_@zmHokFheDvqIhCt`C}~BwzFsaHdbCn]rqQltMfzFkpAysIviLvi@lnCdpH_kQx`FufMq|LavCl|UgvG}mD?cjHk_GlcGse@erH"),
+	country("Norway", "mrjlJ}ao`@bgJku@~vG|bT}sAxcEk}AcpGoLhbPulVxoK}bGagHs`BpzAy|@qmGhmFqmQ"),
+	country("Norway", "_etmJunha@pm@{`GtxKhn@xpFnhHlbHswE~eB|vX_nKhbE``@p_LojFbmHo{@gbPqiHykA"),
+	country("Norway", "e|lnJ{je^vi@vsIusGfdC`nApjGypEvxGmiEtg@p^s|NkiDmtAaxBlyKowSzhChfVkh[twEbcAaCnjE"),
+	country("Norway", "_dlpJefk]g}EhiBtlJs~c@rqLwqIzvD|nNsaGyo@"),
+	country("Norway", "s{iqJ}zua@bae@bf@xkFddMti@hzSsmTjxa@ahNo~n@}jKcqF"),
+	country("Norway", "_vktJ{{g]ldMsxC|oHd{PoxGf|EftIzwIa}Szt@`kBecNm{JziDxuJyzH}nIcyC"),
+	country("Norway", "uwvxJyfu\\x~F{ydA|iDpkSexEin@dsMv}g@uiEh{GazA}|ImtAbcW"),
+	country("Norway", "{cc{Jsi_b@`RkuKltLlmC`{Anel@anEvvG?mfSikBvbPslHgyG"),
+	country("Norway", "}xy{J{spc@t|DccArvCmnNdxQzfs@mxEwzF}pSx_F}rGwxR"),
+	country("Norway", "yc_|J}ffe@`{B{xIhm@f}\\s~F~sZ"),
+	country("Norway", "_a~}J}lyh@drA_bIbvE_{@vfGren@kjCt_DgcI_mF"),
+	country("Norway", "svp~Jyluh@a}Ag~Pv~DknBznB`cKvlEuh@udGhvUa`@qrEg|EnwA"),
+	country("Norway", "ys_`Kqdnn@?mtYf_KltYuxEf|QcqFwxG"),
+	country("Norway", "gmz_Kmgsm@rmH_{K|yJpbb@{{H|f^_rCinLk_GrtEmi@mjPxiHmsMujJy~F_mFfbCti@ufK"),
+	country("Norway", "im~`Kasop@d_O_uM`yBzz_@}xFt|P"),
+	country("Norway", "q{_aKk_xr@~eHsvPpfC|v@fhBbzZa~Lz`^ouGqvCjyHm_Tw{JmkE"),
+	country("Norway", "oqraK_zho@?icO_sDvhd@gyF~nDi_K}bk@tu@g{QrlMqxNv~JppQltArmT"),
+	country("Norway", "sq~bKw{wv@za@ohFxsDzoL~iR|xtB}hE|ak@myP{mYi}@kq{@{wEzxa@wiFwmn@xuCujJg|DyfCy}@g}QvkKmoC}rAw|P"),
+	country("Norway", "ayrcKg{_r@otAzhPmmP_c{Avi@fjL}bGacK}e@wiLb{Ns|Bl_Hd`O"),
+	country("Norway", "ud`iKmj{cAhwBweZzyCg`D~fGtwEi|Fp}Xbc@~fSkmH~`IotAexQ_bD~mExl@j`TqbDnbH_jFumHz}Fu_["),
+	country("Norway", "_bckKgfubA~`I~qr@}bSart@ftI}b_@zsJdbYeeAbyO"),
+	country("Norway", "uj~jKgldaAltAxlXotIytIusL{hs@j~As|N"),
+	country("Norway", "qnnlKktriAliDqwMh_Gvb[{iDhta@{mHu~ZwYtwDwjGgMokEebMtaHqkS"),
+	country("Norway", "apinKm|~jA`pFw{F||Ilj]~i@hnWdsG{cFbyJ`}d@gcOimL{e@|bGmnHkbG{}B}jPupBxyHa|Lwv_@mmD~wAvbCzxUetAexE?|lDo_PgrIbbLuaZ"),
+	country("Norway", "__}oKcwghApmRzfPomDtw]mtMkvK"),
+	country("Norway", "}dyqKqaolAqtDus^l}Ce{Dv`Dpn]h~Rlks@vLdyEsiDqiHli@rmT_jR}{VmiE}pYrp@}mCp~FtxE"),
+	country("Norway", "mmhrK}{vkAd{Jduu@caM{mYzUhbPetMsil@qm@t~Z_sIgpVz~AupGhlHwfBf_DzsKjiDsmT"),
+	country("Norway", "oi}xKqybvA|~Nb{N|`I}lChsD|mCcjJv~PecBj|S{xIypK~`DapQ"),
+	country("Norway", "ils~K{vtnAjpAi~RlmD~~JnuBu{CntAnvLeVmyJtmOkpAe~C`v[~pDvfBn~Cc{OhbF~lE`nL~fk@_fk@kod@suEifO"),
+	country("Norway", "cny~Kiz`pAieDywHtxDi|S~bUp}LyiBfvG|sGfeOu~HloC|kDpjGq}FlYwgGoyLhrD}kPelCa_A"),
+	country("Norway", "spg`LcxpzAdoT`wr@cyCzmAanF_bIqzB|{Il`IrzCfrC|tLrpAajHl{CnaJp_MripA}oCwcCm|CvcCa_Gux]qm@|bSspAotMosHrzCct@wsIlhFsbLo{EkmBhlAsiIg~Kj{Tnm@yfg@y~F`jH{kCiyTh{HwpIqzGeh@kaAocH`vCo_Kd}HfjLf\\cwD{eEg|QsjHyiBc_Ccut@"),
+	country("Norway", "qi`aL{qfcBdpCcpG~bCppQbtE{gDtbBdiz@u{DecNue@vtIopP_iSmdAqwY"),
+	country("Norway", "q|_cLusagB~eHybQdxEjg_Ay_Wa_c@"),
+	country("Norway", "{uncL}r_hBemD_sBxO_oQhgIyqVlfM~ii@oqVxjZtkJgt`@"),
+	country("Norway", "mgmbLsf{cBnxAsmHxeDnfG~_DquC~sHdgLnbIug@z_BnnkAv{]nf_@deAjsKstW`a@dzJheClWzrMnkF{cGeiEtiLjiIfcCnm@viL|e@ajHntIfjLopEdpTxb@`~c@ixBtaBacWskw@saFbgJ`_Fq@xbJtkUxfIbmk@~yAvcDntAajHn~Krx[|IniResGkkCqtVmxb@ycDka_@mtAhtUmtAgxQexEx|]qpEcqFmmDysa@ziDagJqiHlzJhm@mzJyfC|sAfm@ymd@u|D`}XmvKvoJmnDcm_@ruE_gTmdGhmMg`Dn}b@_gGjSmtAegc@{iDlmPqpE_xM_cC|lDyiHcmHg\\ihr@fgMggBdxQblIptI`jTm}Ia|d@|nDqsE|nN|_JnaIx~]|vLiTjgE|hFwcCseKyrJmuA}pCmdGcnGas[rqFweN}dMhvHivCcdb@_|KrdVswDiOmiDsmTlpBe`NehKrvDnnI_~b@ztEygBa{@crR|wE~~Jj{B__Kny@dyD"),
+	country("Norway", "ak}cLqqbdBfcCaoEvuHxkAvgAftTwbOv{h@sgDwuG"),
+	country("Norway", "}fabLu}zvAmgFvcB_lFak^}~BnfSutFkyHkt@isJzfJqvCbbA}`IkpArtEecGitIqiBzcFklCeaC~]qeJtdLqCsqFut]h`FukKaeJ{{GomDbmH{~BcmHjaMslIgoJupGf{EqhTen[ze\\kjH}tMuJukIbzCu}DhbDfhBjmHmmPtmDxxI_cCsmTl_OxsI{iDu~ZnsH~nEpc@`yMd_C_mDltA~lDreEuhVn}JnuBdzPt`|@kpAh}i@mtAe_Cgz@xwIeoCyo@wlK_ecAqtIltAs}AhnWzr@liEhxA_tMzbFfrH|ZlzJyfC?rkHbiJxo@vgM{`GhnAnfHzsKgzF`}LrkNn_JxzBx{Rq`AleSweHqzA"),
+	country("Norway", "_vrcLc}w}ArwDh~Q_fNdg@g_[yf[k{Iohj@kfPghMojFgrTmnDkuWpwCcmHnvYxkMnjWtqk@fiGjci@}lC|eS`fHi_G"),
+	country("Norway", "abyfLqmhmBlpNc}BnaDfx_@boHySl_Ck_GdtA|iPjmHimXltAd_ChpE~t`BweHmbGbEbgJbxUbmv@nnEbxDte@{wIljE~nOy_@faPcxIewQinA~nDd|Dj~v@arEhxHy`EcoGmtA{vm@kiDaAc_GgtU|e@~mt@e_Ce_Cei@x{FstEycs@l[zdF_kFb|B~cCbnHkt@hqJ_cCyeD?f{RspAmbk@ktEppP_gBqiG~Mjfg@stEajH?qgcAziDcbWyiHl{UgxAsuh@idDhkp@akHn_Jx~FwiXu~BjpA?mjPi_GljPgxAgyFfxMo~y@uxPph`@cbHvk@bxPedd@_hAg~EcoNrwEloJmkQk_HwuSxvHqqEjiPpbKmtM_cUziDixSltAviL~jEgLfdCdpGlqBa`K_`E{jC"),
+	country("Norway", "wkeiL}v}rBlvKukJvcPjlr@psDbzC~gGsbL~jErbL`_GnjoBw~Jpxe@i_GctEzV_og@lgFslI{mHumT|HphTmsLblSq`@_mDhkAivpAwmDf{u@_}ErzC{gEkyIpiHumV}aHisIcq@uxQlzPa~@t|C_kReqBd_DweNcjImjKhrUcrF_]?u}Zb}B{`F|~Bd_C"),
+	country("Norway", "mx|iL{_gcC?giL|~Bh{F"),
+	country("Norway", "gngjLuo|~Bgm@mmZddMnog@c`Ad}Om_IfvGetG_lR"),
+	country("Norway", "mzrjLmql_CziCab@z|AtyP"),
+	country("Norway", "ucjjLuzvvBqBiyH`dEotArtEd|Zz`X~h_@v|Ejmp@}f^rukAfNmf]avCixHqmAlkPwlE_@kiCcdc@vaCcqG_wAk{G{mHzwIszBwb[`pEyeDmtAqqPnhHljEttFsqR}wKc}Zvz@utFxsIyl@"),
+	country("Norway", "u|xjL_z{}BtxEd_C_uAvbO|iDbxOqbK`_A~hHbvOetGzxU{kOcjH}aHqcW{Tiu`@xfDqtPnlQrlI"),
+	country("Norway", "_rckLuw}xBrtEeq`@phIbja@_l@|mf@cuVzkO__K|g]g{F}mZfqVq|YsrGqxM"),
+	country("Norway", "ekblLy_|jCntCcxOjdEti@io@bus@}vEz}v@i|Fp}LcyD_gHzcFkiOswIx`ElsAmvWsgE{oMxaKgwFmiJmeGv}Jq~Ltt@saX"),
+	country("Norway", "wdimLcikoCltAnmDb{BstEvsIzrLfhMnlt@psCcfKf}E~g_@_uBffYo`UqgIxjMbuQa`Lpbc@`CcyOoxAosCexDp_L{~GkuVu_Ce_g@czD`jHtfAu{OxrJgfBitIajH_wBntBxa@agl@_hMf~[~\\{sb@jtE}lDxiHfbC"),
+	country("Norway", "_~gnLeq{qCz~Fo_KxbEzfD|wKvu`@`}@vjWs`ArkI_xMhpK?fxGwiLsmHqvCxtIo`JykMaOgxSpn@cyCfzFzs@kU}n["),
+	country("Norway", "asjmLiizgC`m@nbUymFlpCncIngGhkBljPqdKiaE}eF{kNqm@|iDktEuxQryB~cx@cd@bwDstE}zJ}VppQc`AxeDgzAy`Fn~D}{l@klBasDu|Ct_CgAy`FzoHid\\yfOv~DhqE_cT{cGcmHbyDuqTvzEn`Jus@ojQreJg_F}pG_oCiBghNksR|cS{_BycOx~FcmH}IksLjxD`sDbsE{mM_zFwlKqfCxl@ltActQqeD`yB?ayNwkFdiXyhBlmCqp@oxLotAtuE~bCutQ{bAe`DgpCd`Dl_Hes_@waOtbBmZswDjcL_tZfxAtxQltAkqLt~BtfWh_GltA~wAviLntAu~BknAnbUxcDptCltAqiHpgCt}Dzd@x`QjpAytIjgDt|CsmB~wL`pFzaGmtAdwQzuDesGfaD|sYemDb_AylAvgYnkFhkAtnClxKyqDb_NtfFdaC"),
+	country("Norway", "e{bpLyw|qCli@gr`@rqFpkj@sqF`eU{~BwhXk{Bf|\\}iE{oMf[ozWziDqxY`Q~_V"),
+	country("Norway", "}pqpL_uo~CgiAedMjbFfK~lFddYo@duaApyBxmAltAexE`xAd`f@cxI`xYstEif[{bA|ks@oiF{yIoi@rtExAki[g~DkoAj{Hueo@{iP~f_@cxCagUrbAijZ~fGlqCfeByfCe`Cwwj@`vDkWd}B~iG`}AfwR~wA}pMzeDrtElw@wbD"),
+	country("Norway", "elqsK{uqmA~fGkWzjDfrI{iDtjW}gL{gQ"),
+	country("Norway", "eostKyn~mAfk@u_DtmJ|mDgfCrqFl~ClqB~|E{lBkxDldRszCgtH_mEnmEetA}oNxxC{lC"),
+	country("Norway", "{pu}K}lj|A|vEp{XmWzub@sgD`vBwpDubAw~DsbYfgGstE"),
+	country("Norway", "qza~Kujf`BxuC|_H}lD|h]xeDhwTqkBhjYmnCw{P"),
+	country("Norway", "gt}}Kucq{AawHyoK`tJ}~I"),
+	country("Norway", "_jpcLu_pzAhcD`nFgeB~aV{sFisJ"),
+	country("Norway", "cdqiLapj}B`~L}V~qCdsR{iD|oN}rMk`FetA}j\\"),
+	country("Norway", "ccfiLiofvDgLyjNbzC~iG|_HldbAwtMkyUsiDxfCalGctEr}Acu\\"),
+	country("Norway", "ekgjLgx|wBbwDslGvdTlpY`vCh|v@_rO}~T"),
+	country("Norway", "igljLgz|pB{gPgtItvAkuJxiGu|CwcHygOdjEcwO|jP`ot@"),
+	country("Norway", "klvjL{{o~Btg@hlLk~GljEu`DwnKjfFuhJ"),
+	country("Norway", "sdyjLedgvB{tErxf@itEggMpyH_h_@"),
+	country("Norway", "mbnkLwgmtB|zKb`BzzBbhI}eFjya@ic@kaS}pGeuFiaF|aHyhBe_N|YqlG"),
+	country("Norway", "_wplLivtcCb}BitIlwEaEf`K`uP}nCxbD{lNyn@"),
+	country("Norway", "}ilqL|dro@hoFepC`bEzqLjvJw`DxmAlnCdtLdflAf[zsi@pw[rsfAmIjeQyzBmtAqiG|_IggZshtBc_Yyid@"),
+	country("Norway", "uzzeMag}sBvwGowYtfFlvAftMzfP~eCjuV{pXpmv@glForO"),
+	country("Norway", "kyesMwfozCdtAk_Sncf@`fgB|kJbxDka@znMwiRaq\\"),
+	country("Norway", "ck_zMymatCdhLzva@r_GrrBfxTvql@v|Qj}R|tXpdhBdyHtwOj~Eu_CxhDvsI~cDdsfBmdE`kv@ezErwDsfEafUktQvbOsbKimp@__K}bStbGb|e@agB`zBkpB_eHmxD|e^dnC|f]j|HbpRduK~tuEqiB`jk@yzGiqKmsFzcRom@kxIu~BlsAc_Gy{aAacWevGccWqay@azHdJucAcrSanMhupAixSzqfAgyFgoU}cMurhCdeAsrgCu_JmhnBrcRmoaBjlIfoV`hB|wn@r|HjzHd`I}wKrwD{oo@zxO_tq@tpBue|@meGovX{mB_fl@tbHyyR"),
+	country("Norway", "sdl|Mshi_Cam@~fk@|iElk\\inA`yBueL{~^uzD`}KkcAdbq@sbEieg@utG|rAenOfcbBszNqekCfiAya\\qiNuy]dv@oyn@t`Hq{M__Eckx@~lFop[cuE}k[dfLajHduFkaSdqHtwFbaMcmHnrOvi@hxAstEzbLvikF"),
+	country("Norway", "g{i_Nwn|cDj_GfmLpcEvzh@amGz{HwwFlcj@}yKjrL}gE{{G{G{{`@l_Ow{]"),
+	country("Norway", "qto|MusbiApbDc|B|gAzjC{uEhno@y}FhiNyoEewEmrZ~paB`_A~ij@um`@hcg@ywIviXe_Ce_CitOznq@yiB~oCaxImbG_fHvbOgrB_yM~{Dakv@nvGqn]hoFjs@`cKkqd@z~FbcWtbO_nh@rvCtdBhhCmcGf~EtrFyzBmvc@`rK{uVp}Eeiz@fkAomD`}FtcA`nR_mh@"),
+	country("Norway", "acr`NsxtrDu`B_gk@jkCkbGxiHjrgAg_Kj}yArtE~t`BxwIv~r@mbCd`r@otMgk{@gOqqh@wpCksKkkC`~@pPgigAzuJg{t@"),
+	country("Norway", "_gfaN}}ovDltA{fs@t}Chff@"),
+	country("Norway", "q||aNaqtyBuIq`gAnxLcbfAzxJtn{AmcHxi|@awBsvPskDbyCswDuzD"),
+	country("Norway", "{jncNuplyBhoAy~QtcA}mChjBbtEqCzkuAs`A|bGcyDwcCqxAvtI"),
+	country("Norway", "kwneN_xibAd_ChfD|~BwiLj_Bxhp@i_FhhOui@smTomDnwY"),
+	country("Norway", "ipueNcgl`AyxBb_MgdCsbL`~Fely@"),
+	country("Norway", "a_~~Mu_jzBdt@tu]d_DdbCs}Bzfs@~iGreb@jaL}eR`cKfokCbqMcmSlwQj{TfcI{pXf`I~c_@~vM{ua@zpMlaw@qeDdrk@hsEvna@b_GexQ~tMfr`@hlHgtSvcO}qBv|PvwRheINxiH|wLxbKwiBtzDtsSgx@hgvAfcHphGti@lsN~|D{iD|hQz~^f}KikAn|Jb~MhnGoqC|wE`xMpmLrtEgm@l|m@fxM_gk@zmSz{l@`pFxbDlcGd_[fjG_ki@thEyoKxhCzq@zdKdib@dvE~uBnnEjtb@}dAdwi@arDjgDcaB`}XhwHv_PorC`jHu~Bi~Fc_G?mtA`iHceFn^{hIh{aA{vPpxq@c{C`db@eyDwaDk~Gefo@yiCivoA|dGclIikAmjPylFrEqjBe_f@pm@f|s@uwErm_@shJreK|cBrm`@tmHeqIf^r|C~j@jshBewFlud@w`CbvDwgBs~Ay|@noPe_CydDesBv{EmbHvurBceKpvY}dFudAmtAj`_@awC`yBe|DgaDg|Jdfd@wiGp{A?ctQweHntYmxEvl@oaIakfBnaIqm]ziDfjLpzAs|BeKy|^orIeiKeoB}js@vzEmk}CljPi`t@lJezQm}C~pDkq@ieDjq@arPwcCrqFkgDbhn@qqKjub@ymF~{zD_fHt~Zs`YibvH_uA}xgBz`F_cm@gcHxyj@ywIf`Q?ajT}lDexQk{BbtEmtAoeHxdDbdo@j~GxhNv~DrhnDwvGlal@tqC`~n@nyKdui@`hCn`qAqwChzRviA~fH|sAsjTp|@jw`@ybDzbv@`qKtti@uzDf|i@coB~rBqpE}bSkmHri`@esGcyBmyKfyFktEgyFybKt|N_xFq~fAlsXovgAgdBswD}tF|sAgjM~bU_pC_s{Cc_Cj_Gi~@ycDuyPcrlAnsCg}t@ovB{`FytIxfZgrIw_g@oy@}|}E_uNeheA`iHv_vAwaCrqj@evFbgJgk@|{m@ujb@e|jAqyAoeTe}CeJac@dwFvm@`j^aiHzpMlfL|wc@f_Eui@jjIxvI~dHfzt@fbDsc@hiAz}G`wCrzlBw`Df}QeqSze\\{iDcwg@ojFglKa_MqqEkbKxkMc_Co_KiiGroSftThsb@zbKgcCrpAi_GjoB`vf@f}DjeFdi@e_CznAvnJucHvlb@ecGcmHqiHtwEbxIrcgAd_CexQv|L?haEajH`tIxfg@vgHweq@zdKidQrU|lvAfsCtvFbxI_xM|xKpao@uXh}EccGo_Jti@zpXrxIfcCi_G`xe@ntIumTs~A~ciCbwCvcZ{lGjeRc_C}dHgmDti@{iD`pQ?_fHc{ChkBexDxuk@{mTvrx@gbCg{{B{tEihe@uI|`uC}`IvbO_hGnsuAyrKlyJouGt_f@qhNqzYpRsq]qgCx}EwjGvz`A{aLrti@_vBvhAghAunl@`hOwcdCa_Aijq@gqOdaOzjCbfeAymA`wOiuCetSmlJpjmA__Kc|e@qxArtEytI{~R}sAccc@kiD`xMe_CytIa_G?ze@duu@jxIu~NxzAvnKyeIr_XkqGpqEpmAdyQnmDlbGjtKqxY`vDmhE`yBziCddL`f{AoqCttGq`@wcC{|SxoK_gYvpy@utA}tM_bD||JqqDm|JibEddBs_Mtrj@c_Cg`bB{sEjf_AcjIy~]tpBavuAy{Mxrn@}pAvdBmwF{~FgxArmHcoAmgF|aC{{x@wfC{aF}~BrtEq~Em{x@|sXmyn@t~Bd_CxxBexQg|E_mDdsG{tWi^evFwgHkpAggFzj[otIj_GwmDk_GntAego@dxEgbOptEz~Fds@cpGoaOofeBclIc}nCluGg|\\jcKkkCdiF~vMpbEz_^lqAxolCbgW`ylBd_C{sW}oHy_xAx]ytpA|pCk`G~cIdbYg]f`QdmDc_Cd_C|lDbsEore@}rAqvDmmDtpHk`A_jFj`AotZjxUmxy@dxL`xB|lDe}ZmbNiwUybKfb[e_CssEkxUng_@wnKymd@mdAycg@gxA~tYk_Gk__@ke@~~Ji{Xa`z@fkFgl{@bzTuml@jiJu}f@laBjkC`gIkkCjtE}tMbvDn]hwZovX`dPext@xgCecB`nLhmXjsF_sCenIa`W~\\q|MlfQujm@j`NolEdnN_jv@qwB}zIsaFf~D_gBjlNmbTdxQmbHx_QeuQlfGowMhjd@qe\\x{GmsLl{JasE{tKqiB~pOaqVbx[m_Ce_CoeHn_Kc_CkiDclGt{D_zGimM|f@wkqAgjLjwa@m{IuvQe_CrsE_dImit@b{Bueo@xcQqi`@hjGbc@``FqeUvLa~KguBwcDc~F~iGyuD_nQpiBipz@`iHafx@ph`@itgBlkEtyDrvPzl}@vi@s{BtzD|jE|qBll[?w{]vsIctE~iF|lD~~De_CjtEhc[ziCyeCsfEcok@qsI{vVg{X_fSecG_`n@d{OkhiAf}KcpHx`FwsSbfE|lCjmBwqIdxE~wMb_GkbGntI|bw@vi@wjp@btEmbSbsF|hEpvCuyDjb@ikp@imFuiWx`Fepw@whAo`a@fuA_cI|oCnhGfmE_oEhaFuqSfvGnmDvt_@ig~HriInjEntBsnGx{Mxmd@~zF}oCei@tbq@t~ClmDeoIdqnAthK~aU"),
+	country("Norway", "anthNoq_iEs~Bw`f@ziDewaAzlBdsGbtL`mtClyJxgmFibEvdY"),
+	country("Norway", "m|}hNootnBmtAxta@kpM}te@f_KautAfnKprr@"),
+	country("Norway", "ec_jN_qorCsp@yjMreKebZbr@d{h@igCbqF"),
+	country("Norway", "ekgjNqqgsCntBnuMk{Cjwm@qiB}oe@"),
+	country("Norway", "}ivgNezydDf_EtxEltAuxE`tIhnLvtHa_W|~HzlBtfGdmcAls^f}zBrnG~klAffBxiB|jQ}e^bzHlm[rmCsd@fuSlc}BdJ`ug@spGfrIfjLppzBxnL~oDncI|jg@ksEne{EqpQ`kv@caHkoAkxIm{m@hiHlbfFm~Czeh@~vB~pdCmsAr}CetAo_KklIpaWc_A~agBwrOv{bByeJ~cIktE}hoB|mIu{f@jmCc|oCafJ~saBkuJl}`@e_CeokGkiDn|@mpBskI_gCcxjAyzAkvKyhBvrJ|hFzo~AwxAssE?dxQihC}jEguBcfVpnFzanI~pJrztAhQpgxDcsKtxRqEnhj@i{Rftk@a|AvmVwvFmxb@mgAyb_AitC_cJ?`cWswDiyTh~E{sVerBs|B}`BjgDyiHom\\z{Cx}QkmBhptBexEvbO?omh@mtAxbi@i}FzpWb}B|lDs~Bnnh@itIoup@x`Eww|BkzCidP}~BhmL`uU{ezBgfHwhLmtAsec@c}GfleAcxJrgV}|HlqCmxErpRnZkwy@pxTqp_Afj@ysm@{iD~tYecGq_WapEteLe_CyePui@xeP}wEu~ZwrCt|uAouGbe@otBokFdiEm}`@{`GrqFgtCwcClgFgfiAxfCb_CrpAacK~sIkhD_kGcxOgL_we@lxKmf]vmDfcCriDgjLwi@eio@z~FprPvtIahSajHsk{Ad_CjiDzfDmcHipJwic@h_FywU{UysIspAbmHe_CmoC`P}``@tmJk}HjfPpey@yeDclk@x~Fwom@~iLqq\\~bC|bGjpAsmHd_ClmD~]wbPe~CmmOsiDbmHgx@mbHbxDkxTotIzmX?ytUc_GlmPorTw_Q_zFffBugF}uWkbGpuf@{mGtyEgcJujVdvAy{j@xmLhmLvmDqwYwmDvoIgtIotYobHlcGijCqxAwpCzxIylAibElmO{apBjbG|xm@npIenl@~{@dmJncHqC|jEp`n@dzObmHduGyqUyn@maT_fHv_[ze@eu]mtAjfDi{A_qCxdIw{]odBg_EyzGf_Eox@s_Md_CkiDt~BbtEjrFaiSe~Hsim@saBarDexE|bSkpAqcKotA~xMofHcnHroFmto@urFtiVnpCu`uAow@usFwfCfjLs~A{qLupBbkk@}|CytTfnJcraAvfCfjXlpAe_CltA|iDzoAqdJymFszf@qbKclSlkEenJftMneTstE_koBtmDxmXlaCwuGyo@yau@z~FwiLm|CeeX~jA{cFz~F`{MmJkkvEfpJ{le@rnB}|l@"),
+	country("Norway", "ekgjNuaoxBggBhuIg{EyxSt|Cqca@"),
+	country("Norway", "u`bkNkeu}B{wEv`r@?kux@"),
+	country("Norway", "qyhkNqsf`CfN~qO{cF|vKetAeg{@riDstE"),
+	country("Norway", "iowkNe}||B}wEvpUyBqjS|{I{liAjtEfnb@g_DdcBkIzoX"),
+	country("Norway", "|`~jIagbTviAqvDttFnvBnxAjxUypKycD"),
+	country("Norway", "y|qgJodqbAhgIntCoi@hvIqiIwhA"),
+	country("Norway", "wdygJq}qaAtsGxeDkiDxtI"),
+	country("Norway", "igtmJwch_@loNtaCitIx}QqqFkfEj~Gu}Bgx@{lC_{Kz`G"),
+	country("Norway", "{dorJyk`]seKjl[?euS"),
+	country("Norway", "m|psJady[pdDylBt|CzgEabEeJblC~oD{xJjcF{xCm~I"),
+	country("Norway", "clqyJy`g^|lD{eEtwLnbJmfAxkMipEmlE}fFluL}~Cmu@~qDu}O"),
+	country("Norway", "ovj{J_xka@yyAhcDyfDovXpsEzoA"),
+	country("Norway", "uvn{J_qca@v]tdMauDdfA"),
+	country("Norway", "kbs`Km~vq@z{BboSqdIfuG}u@msL"),
+	country("Norway", "qencK_a|v@lxExiOwtArdK{uEcqG"),
+	country("Norway", "akxeKwrr{@lcBkpAfyAnaIaaFbnH"),
+	country("Norway", "_yeiKmj~dAreEa{AdtA`gHibEjgPuzJkhC"),
+	country("Norway", "ggriKg}gdAfcIpoFkvJxd[ciE}pY"),
+	country("Norway", "asmlKyexfAjpMx_Qo{@ll\\kwIo_K"),
+	country("Norway", "upudLqdbjB~Nc{CbsEnnE~pJtlVui@|rMqyMowM"),
+	country("Norway", "_v|pLy}qvChlLbaLg}Dnl]qcEqeJxxB_~JsrFb~B"),
+	country("Norway", "umygJaxdb@fhFcbAjr@`uC_bI`ha@"),
+	country("Norway", "_skhJayi`@vcI~tBkuJdgLmpC_qD"),
+	country("Norway", "co{hJiavc@r`ByhO`_F`oGiPluWw{FmlC"),
+	country("Norway", "wl`qJwmq\\xnFfK}u@xqKceLwhA"),
+	country("Norway", "}xlwJuqo\\}hFsoGxrJmnC"),
+	country("Norway", "abpzK{c}vAtxEleG|sAhhZomKki["),
+	country("Sweden", "k|rpKe`crCkp@rcMltAc_Cx}ElwWqmLxoc@vmExtUqmFhhZ|gEn_KreFeg@w|@rnGygHbbAzfDnoOqaE|hQ|fFn_@fcC}cHfoKfhYo`J`dJuxEtgc@uiL|sMmrG~xXxkS}bHdaHx_FglEx~Er_BxvUqiHej@{`Gl`UjgDlpNjiDkbGxm@bmHh_GmbGv_DdwEw_DzxU`xGux\\jpGgbDatDfxSxbFptChpE{iDpm@buQpmAiwTc|BmrBipErtEeZ_sC~{Es_MlyD~dHj|CyfNtzD{lB~j@`sCgvMncdA}pTlaw@h`LkjOzzTcjdAtwEnwYobCnnh@~iL{wa@z~FrsEmiDzxIvrHnyA_wAtraAxmA~fGntA{wIeHtqTjaFhzFn|Lyjp@xxGzkNug@xhNioW`ze@dmCdfLz`Gyd[piZktc@daC_w@baA|oMxrI`C|uAofGvi@xySp~FavCvi@b{N~sIdtGfj@vfY|{U`cKzpAviLolFtqGdnDjeEhgH{tJfiBcwg@t_Cb~MrmHifOxlA~qC~bCedBkcLpm^vfBdxEhoE}sAhhCqiTbsFmqA`gI}bTioFu`Ch{Gu|N}aCuzDjtFs`B~y@{{H~hAxcDm~C`eKveBxrUhiBucX|yJcbBo`DojQtmDe_ClxVvpl@qqExtItqH_xArp@jjPlx]ldu@pj_@zqLrrFbaM~uMpiHpeDz~Rd_CutE~lEtuE_mEpbK|cHltLubHbtFhsInpPj{OpwBiq@dvFcbFej@_qDhaFpmRjlBodBf{FcdKghBl_OzbGcd@`ug@|lCnqCrdEqxB{Bf`[jkNdcYflKeaBzvDr|NusA`jH{wId~B}zJhw`@`lAv`DfxAcmHd}CnwAloCimLphBpbKvqIywIy{L`h`@j_GycDifC|nNhzY`sEt`CzsWhxAs~BpeJzdF_tCxqJhsE`Enj@kzIh}FfqJkfPno[pn@nk]hgHut^~wAnuBptDztVcrFb}BgiAozL}e@pbW~iL{wI{~Bxid@v`EkjNnnEegAoPhaRh_GrsE?ssEjxIjkNg|EzoMrq@jeE~oEqbK}}CrwPfwFvxRpp@_fv@hpEoeIeg@jz`@pyBbbApiB_eHuYa}L~oDsHoj@z}TrtE{oM~yFh|QecH`jTvvA~}JbtE?ue@axMxoKxbPcuE|u@lwFj}GkkC|tXemDqxYqpEhmLbqFpvZwgR|uA~[`jGoiLxmLc_CwbCze@pxY|bM{a]l_OwqIxwCfeBn_PsmT|fLdKkcAzvVdvGcn`@~|DgbCn{EtvQswIhbEbrW|iQn@dq`@nnDfgAntCaiHkf@|l[i_RxjYjlItvQloImbHdzDfyFp|LcmGdeAgjMtxEstE|sAlnDj_G{qd@||DncT}|Du{C|sAl{JptI?jtQn~Jrd@yfCn}E`kGfpCsuEbcAfjLl{I`hHxyHc|BjfKzhD`OgiLb_CrsE`hNmkDlt@umU`kNjnB}YvkK{jNh~Rz~Fc~B_uG|mOkWrbX|wEynXjtEx~RvxA{hDre@dxQtiD_m\\wi@lb_@|~BqcKjpAlmPfcCwlXz`AfxSfrCcyOltAlcGfxAajHrhJhR}i@m~HhdEvqIe`CbxDvjFljEhtOer`@ixAbgWj_GewQsFpjGeiElaTxuOgqUpaEl{JrpAswEtbG|lDncDquCdt@|fFvtBwqIhzMj`Gvm@olPd}B~sNjpGpz@`zHkePhcJy_Fl}ClnCtoHq|MzpMfb\\plGyva@|sAh~FbnAuaBuzCm~l@nvF}kO`vCzwIdsNq`Ljb@azL{aF`yAyzHmkPaOi_^lxEe_Cz~FlmDviXgqx@ziDzwIvmD}lDwmDq_Kdf@muXz{ImaHfi@`iH`jH{wa@wcCt{f@o~JziOrmT}pXubCzwIppF{iD~jFsyO}qB_hGjqRexDoiL?ce@whM`pE{jOzuKa}@vvG}`IuaNboGg|EwmKxg`@uyOtyKceXq{AgvFjfKlVkGnnEyoQf`\\ntGgvGnnEfh@xhOrcd@iTwrcAh`FljDxiBvbOokE`bKxiNb|e@htCd_C`p@oeGjc@pwM|yDydDhiCzaHj}Sbzq@qEfrTz~BstE~i@vtIzbGmmPfhAf~DkaApeVi{AbvD?ajHa`Fd_DbUvhLjfJ`jH_fA~_JviLqxY|rA|}H~j@ynWykGstEcqAgkKrqFqt\\ukJhjC?ajHc_GhmLvLshJnqJ_}Wni@djLvkF{_SzfE~yWqwCdpk@tqGe_DniB|iFvbHa~Xgk@lhRvgHiuUv~Dra@bnAhwUbbAzkBxlAssEvsC|hQbUbxDuwFwsIjEjeEjqMf_h@~{V`gIli@n_KxtIvi@{iJxdE}bGhbPpfIuyDxhCptDyaR~lEwtY{iDbe@h`Fh`KjjCwmDfvGs{H}lDeiFrwEjtEowAx~FrbK`xMe_C~~JvtIbrFgvHjaBbvPj_GytIstEd_[zyCigC`tIpvBldBqnFui@~m\\rjDstE|lDluA|~Bt}N}~Bflo@dtA{xI|iDfbC|sAyfOp~E`hIkxC~`VtcBvbCvmDe_OdbBzxT}pGbzeCdxEecC?~wMfsBwvSgpCunH`hHunIg`JodIb_GqgcAbvEzS`mGclH`oFwuSbcKjnd@{mH`q`AbsLafx@~gH}x@}sAgwFbtEe_CgxAf_[peIs`LchCctRpzGf`QxpEexQ~iFmqBr}MtrGudMle^pbVqdV~bDfvHxpEwiLjiDhtIy~FjxI`vCvm@hfIioLi|@atD~aJoeHmtAxwIz~FkbGaaEbxOrjDvjAiSzvJctEvi@pm@y~FocNtgXhrDbbA|vEquOjcGzlB{q@j|Tpq\\sco@gc[~bw@`nd@iux@ui@p|YxkGqkGn\\ldFdlEofHz`FrfLstEvi@?f|EntIyfCte@jiD~y@qhU~rO{tJtwE|zI`fCutF|wEz~F}IjlOdjKs}AjqGlhQ|iEu}C~xKbwDbcLwaPjvKg_Eui@t_[b_CaqEpoEpoFrnHonEli\\zvJxfCgmKltAfrTb_Ss`AfuBvmWfhSttFrgVxyT|a_@`cJj}_@vlc@k|BthK{iI_kFnLrrQohFxeOrsEl}IcmHpb@pcJhxSomD`zMrpF`oFmf@lvL{e@{iDexEziDv|E|aScr@~kRsiDjlBti@h~FnmDoxAqwBrtQd|Dp`Wc{CbqSrzHpiGb{OauOfpDneI}u@`iSedHjr@zlBttS`va@zhr@pwYbrFpeb@ep`@be]vkc@krGpmv@vfBzgs@rbMhno@ydJrpmAn`C`r[afIkeDtcBmvLauD}|IqkRfiLaePirVwnJgfBkyInyXuoOkTchIrmSsmThnLcc{@jwy@d_O{np@mtAalT_hGs|A}nZ`rg@akHy~PnjAuma@w|PusGa_SrfKfNziZ}bShtUgzLxkBsaSrfn@csPt_BqjN|{V{~A_vBc_NfzFkTbmHyvCmfGyuC~pPue@{wIwm@`_KktJeqHi_G|kQcmGgoJ?tmHgiBkeEqgH|kEbyCbhIhbPjkDsiH`xMgzLkVcyCakFstElbG_|QmsAlsAftHwsIliEs~FmiPnZdsi@qeOeoSsiJjb]ufK}bSscF|lCqiOglKgeNth@gcCuyEmdMkoAunCcmHq|@nkFgwM`kGo~DylMzb@nn]blOlcj@qtPi_F}hPa`XvbCb{NacKf|ElxWf_Ez~LjtWc_G_fHymAhsJgrC{sK}`HbxCdfGvgNe{QwgNdtM~pPndC~bUeeMfiAkSwwHajHuwEokFxwIcaMqiHqkR`xMwrDim@kaAx|F_oP}|IsiUx~EaaE|mPokF_xMyOn|JafDhTkpLctRmy@uuRhp@sqE`wHweC`jC{lBlqBs}Bx{@u`Bt@ws@n~Di}AxqKvFbx@g~DpLarKss@arKggDaiI_mIovDukH}}AoqGg}CkeDcdAkjH_SoeKghDo`B{w@wdBanBi~Aox@wcC?wlCj]}aLvmCewe@|xSkeC`g@_eDqW_~AqtByhFshYgtAquCoiEysFkr@{a@uoB?mlFkf@s}A~GwdBzkAanCjyEewAfiAosEih@g~Bo}Fyx@icIjq@cj^wZm_G}wJ}t[o`Q_o]g{DacFudFclDepG{tAo_FjRmqKbaD{rEhs@keBwZirAmpAcuEooIccBywBovBeaB{uC_hAkaF{l@maEdXypDdxA}xZ|yTy{QnrSitFpgDe}Et|AagMhgCcpE|qBm~GfqFk~BlPisAclDasEkal@oHwhJl_Ak`Uuc@adCo|Aw{@a{Ne_Diw@cn@imIagMwhC_}B_pD_|AmlQgpEgiAvFgz@h|@mka@|lr@yvAz|Dkc@ziE{LrjLgZvjF}u@~oDy{HpjNkpBtaHqxJlrUerApuAmmbBml]qdI~RcsHlmDa_p@pbe@e|BbFalDmpAgoHe}EwlDg_B{oCqLkz\\bqJoaCfVmbBcdAkgNsbVkcw@d~k@yj_@aoh@wyB_|AojC|@uyRlrHynJ__Wcx\\k~c@}t[a}e@}yEsiModLyjk@ggE}cp@j{NiwhC?_zCi}@apBke\\ei_@aqBmnAupByDw`m@x}GouCfsBueAl`FwzAbrVmbQbay@_rnAonfBwaQ_~YgqFwlDkcQ{jDgxCcxAy}As}BunEg}NwvD}gEkaHeXigIhj@}hHlE_dm@qyHy`Bmz@crK}`LkcCshAg|FvZ{dv@dhS}lDgdcB}vVcurAaqA_}@aiIv{@g~VdeIwkDrUicT}nn@ygw@scgAscHs}OewMsbc@wxAopJ_hAizDsgBobB_kXioDsmBxc@gmj@ju{@gkMgnIgiAqtBurAmu_@elA_`K{sCwrIkhLyjSudCieCs{k@i|\\_{BcbCitA_|CahXmnoAkhJirNem@ieCxnY_boBaSe_D_qBayDcu_@kdi@qzF}gCene@tiIg~DcFy_QkxBkgA_`@yb@q_AalFksr@fiA{{GnzJkx^fb@c{Iu}@u~`A~|NmsuAnlM}vvAwEizFymAcsFgdUy_m@{mKdlv@eyKu_d@qeIkqO_xSy}Rg}BirAguBcZghPlo@}hBxn@o{Tvsm@{wAsvdBtpByeIhj@ilV~zC}kLza@efH|tA_qC`oB|@lcBveBxc@r{Df~BjRpjAmcCh|C_o_@deIaf[d~FmpJhr@moBplA_hAfKevN~]{~BtdCa|Az|FygG`fAggEtq@}~MprEykPnxAsxItbEq}B`zBeqb@`dCuvGbe@yc@lwCegG~|@{tC~pBgMleAerEnDabl@lo@ahLzL}Tj|@zBzLeXpM{kN|sAarK|J}pG`fAmhGoNatIpaAanDjoBgsD~qAwwDyy@usJh~@_|CrqE}cGjyA}qD~nFq~[vp@k{A~zCwzA~{@uqAldCgyGrgB_~AhqCdc@zl@yw@vp@wFvtFp_Adx@eMhr@gvAg@_fCcmCiqO{BcvElnBmwCh}MqdI|{EszRbiGckPh~@cqLvnAyuBxlBxDbxB|{Cp`AxkEj|@lyLxcApqEnlBxlBrpCl[|xGwp@dpJTdlA}VxaBcdAjwFkeAveBkq@lbDqsCnaBmo@dtCaSt}@`pBphCpbKfjAmeAf_C|sBvmBxkC~vBfkA`xColApjBipEgE{fF}k@mvF_Io}FtkBuhLtv@_qPfl@ymAxkChK`nDfiArpCSlu@ytE|`EdkC~gBvlDd`ChbUvu@byB~aB~q@fxBjR`k@}_@zWupBfsCnkBvjBhrCh{B{l@bxI}sOjhEmd@`l@{XbcGqoG~aBsqCdi@wnBj`@}|D~rAwxCx|H{bJh_FktJ|fDyjDbtHq{Fdl@mPtv@h]tSj{ArJhyGjpBlyA|hCdiExl@nChdFkeAp_Ci@t{BxX`wEfjBn~EafAfjB~Iz~A`{@zv@d`C|~ArmIldAtHv{@p}Bp~AjuIxbB?dqAbrIx}AlwCblCh~@fkAcZrrBcwB`eGydAbtAzBrrC|iAt|@jIbdAaSnkCuzAlaD}u@vyH_xE`iBo`D`pAg}Ej`BogS~lAgiCxxMuiKftCsxGdvAi~@jvHq~CzgFfBvtFchH`wFgiCtnDorF`pB|@tpBlmC"),
+	country("Sweden", "odz|IgsogBjmHvbOdsGqvC`oFvgBvlWljPd|CpbKd_Cw{EjmHrfKxiIwhAdbMz`GnfHvgMpuf@bxO`rEj}Gzop@ruQ`_LhyH`fCbeKq_n@lfFmxW}mDk~v@{if@keEeqT}yh@ut]}kOweC_qDgsHylMgl@enIkgP"),
+	country("Sweden", "mie`JixesBnfGixGlqBngR|eF~]akAnlEzjIxm@o]deYb_TnhGhyBi|G`nRtpHf|EwhAxmE{nYraH{kBveBvjWvyKxsVdlEdi@zS}gFhcEyRjoRbb{@j`Bd`C?imLpbE`oQrtKhhCliDscK?zxIj|IpnExaDhw`@{i`@ar\\ziDlmP}iL{hDudA`jG_rOd~CkuDzxJopV}qNwdT~yKcwJa{AuhWkme@coXs}YgnE}l[wcN{nLcXisK~~Po|JomPajH{e@qbKnnEmWqtCyiN"),
+	country("Sweden", "mpz`J_gstBbpA}xK~iFtna@jmN|hEukJjcQy|Lq_K"),
+	country("Sweden", "{pjaJkvsfAd{Jvi@qkBjeFtoNbrRwsBbaLuaHfkAiRocIavCjrL"),
+	country("Sweden", "aclbJs`agAhmSfM|jDfzQszC~~VzfFhnLmaCpfI|wEte@goJj~Hu}NytVywCklYgpImpC"),
+	country("Sweden", "s|fcJgv~tBzyCr{NydIbfK"),
+	country("Sweden", "i{thJeunoBa_GpvO`_Gigs@kpAdxQzvDwdBaP{|G~sBxdD"),
+	country("Sweden", "iseoJkafpBajGt{[k}YnqC|uKy`P{El_HtbNksKuIiyG"),
+	country("Sweden", "gredK_de~B{uDw_DdoC{yI"),
+	country("Sweden", "wnugJolyoBzwOp_MwvAjoLchIw|DcrFcyP"),
+	country("Sweden", "afkhJ}lfqBddAkiCdaChhN"),
+	country("Sweden", "}_|hJqhvqBxiGxgC{~@`gI"),
+	country("Sweden", "w{diJgt~pBelDplFab@kvJzcGeqH|hEzqL"),
+	country("Sweden", "_smiJ{momBdqBczZxaDjjO"),
+	country("Sweden", "cioiJ}trrBj~Bn\\sjD~mF"),
+	country("Sweden", "uewiJemorBzfDjfEkkB~nE"),
+	country("Sweden", "clejJuzzpBb{BrcKf_Eim@{o@~pCdcGxhCe{Jz}GbF}cHspAu}B{|AxdDuwFs{N~y@iyS"),
+	country("Sweden", "sf~jJuqyrBj{HtcMimA|bHelI}mN"),
+	country("Sweden", "czwjJcnarB~pDx_RumOwu_@"),
+	country("Sweden", "_r`lJef}rB|aBfrTesLyiM"),
+	country("Sweden", "wclnJy_hqBc{B`a@pl@cwErnHgoI`_@vbO"),
+	country("Sweden", "ofouIimz~AjTe_CxpFptDu|DfsI"),
+	country("Sweden", "urzbJ}g}eB~tCilA`_@zdEqrD`|A"),
+	country("Sweden", "yh`cJqcbfBte@hpKigI|fGm}B{pMftG`uD"),
+	country("Sweden", "cl|fJclynBcp@y}E~~DvlKxwBmnCt`Bp~V"),
+	country("Sweden", "midgJajanB``@pfIv{EbFzq@|jQy`KsiI"),
+	country("Sweden", "mcagJqmakBd{PvcBkuD~cIkmZkpA"),
+	country("Sweden", "qvr}Ji~rmBcmBbdVeqHgyEn\\odT"),
+	country("Sweden", "c~bdKgtp~Bc_C{yHvqJt`BdhEthL"),
+	country("Sweden", "wjjnK{zefCknFdf@ib@yeD`zHypJ"),
+	country("Sweden", "khynKgrrfCaaEzfEol@cyCb`Gsw[_y@nrN|yEcG"),
+	country("Sweden", "echoKiwxhCuf@wkKvzEdrGwuAfnK"),
+	country("Sweden", "{ebpKs{ckCvrH}x@ulI~oP_eCajH"),
+	country("Sweden", "yoipKq{fpChcJfoJ}zC~aJifD{q@"),
+	country("Sweden", "auapKsrxiC_bJcc@|~IirK"),
+	country("Finland", "}o`dLcp~nD`xOdtqAnkEqsExCanv@vwDuhMb_T|rO|ue@zls@vr~@adi@tsXihaC`to@cq}@v{Y{}dAtua@heQf_h@pllA~}z@n_qAxgOhmH`vLcjFfw]sol@nxTe`PzaN}mUnaUsyGfsPkzN|bb@auo@hjlAgih@tzItqA_gCxsQvoHzry@`rKcoYriMn|Tbv^ve@phQfjZ||D}lp@trLh{CfuAqeGn_Pzdp@jkG|~@xmOe{GhxTss[xkDccaA`jHt{@dbN}~LdlMjck@~sK{}EzhEirNhsM`lFxra@sveBfdJp_DteNgnIjhKbtEvvk@jocAl~Hvbj@dns@wx|An~QeheAty^}xiAp{TonJ`iUupi@puNqjPt{Ut`Xnvv@jqe@rhf@fc}@ljT~gu@xbtBrshDz`Vber@fwk@vh|@rb\\venAbjY~vb@|pDdbYrhiArmbC}bCfjL|iLmtArkBbxEuVd_OyeDywI_PxrJvfCntC`sDwdC_jGjtV`q@v~EjmHqsE{e@vvHsmHhyj@mtAaxMctEdxQu{CwObmBjeFlrGknCtdAjnC}wElvKf^zmNfoIvzEfOp`LxmFw`E}iD`iHxiBxsVwpDkTn{KrtQomElnOr{BvlK_rIziNwoDaaKayMcmGa}Au}OthEs{OgdNljQdpCbdLxoVjrW~vNkUzcExyGe_Ch~Fx~Fz`GuuMtyQxlAvdBpaFurGte@zqX_nPj~j@ndIiyTvvGvgBbhIuvQx`FvhL_jLrtEpjAfsHm_HrjUr~F_uMntIrtE~~JoeHgwMvma@|zCjs@d{D_fSdrBncUigIrwO}kDuaC_vAtaC?ytImpCh~EviFryZlxEquBj_HngG`E~pZ{iD`jH~bCym@hwBnf_@rbGi~F?btQhjGrbMs{AhcE{dF}mDurAjhDrpL`jHw@tsSc`ForC{bArbKtmDe_CnjEry[psD~y@auIhuUfrDojGfwF|_JmtAufLriDrtEltAc_C|gLfa\\rDrvD}}IuyDpwHdaYs|MkxI}JrlHp`FbcLsSljQnrCcc@lv@le]elD|u@l}Nhif@wbClck@vbChc[edMiwT_wGa`@~m\\f|i@j{C~lPt{Dp|AzeDauDtmDn|eAyiTabeAg~O}nNtoN|bS{}BdwE`gDfgNs}HaCexK_q[g]`kj@wkEvvG}sG{wImtAd_Cx~Fl|IaxInoQktExm@um`@sth@rmHvbOo|@pbJfhNd`g@bsEjfh@kpM_`WxdEhf[tJfqIqzBq{@eqT{{_@asCzSxdD`xNyQpuNieDe_C`yBhod@{oNded@|pCvpIarPfb[lrAhqJviFe{D?jiPgm\\{wIv~J~{a@tkJvm@z|B~fGkTdpIynAe_DkdKn{K?m{JspA~e`@mmDef@?ywIcqFpsPqlGxcDn|@svOevGhhBme@ajHocCfcOei@exEwjLzeQegFr_AmdAumTafDntM?otM_x]viX?mzb@smTtvFstEwpU{bGt_OctEejLssQb}BujD~uMetAccWymLlj\\?oe`@g_KlzVobCykAz~Fyul@i_W|{a@w~Jb{B|sAgiLybKxsUu`IwcBuvKheOo}DccAggNhta@otYagIxlA_pCotImtAotGwnK_tCzcFmxEgbClxEpbKccWifD|e@fjLg_K?j~H|xKbyCucA__KdxEgcCubCltAfmLe{JebC~[pmFy}E`DaeEsrFseD|lDumDwbOy~F|bSjiDh_GwbO}tAk_Gu~NyaKnfHkFksLe_PkvJlvAw_QkzCkwIg_KybE_{@k_G}zOzjCasEwrI|uGowd@ehLtdXooDkoAkTnaIw~DxkB{~BcnHyyAj}Hc{CkWbnBekm@ibDdwF{wEind@~bOltAzwE}bSsbG~mDqtIimLni@mj\\imL}bSbmB_dIoiFsoGsmTjxa@ciD~[}dA}{It`I{f]cqLjwVapFcHxP}|Id{DlXxaF{mMcdXopPbaAumHyiHtmH}wE_mD`C{yJe`H{SzDczOlrMgnJNeuFofNsaXe_ChmLqkBybQw`IlpChbEkdRobI`}@}iFuaM{~L{|cAygCvwGclTg_D~pD_sg@mpNf`EXcnHi}LwyR_}DsCghA_oQgfHoeG~bCeaOqtInxAacP_vMgtZ_jaAkh[ikN}fGk~Sa`FgL}iDkxIyuCvrHfdByjXy|FwMe_DmyKuhK__eAvbOmc_@zpArtEq_AowYyfNfO{nBpbV_kFhyHqsCuzDlcGwdf@{nBihCwiXhePmiDfc[exQgjLej@aiHctE`iHwbOywIktEhmLstEomP}bMzUghM`}X{VnkQ_~IxoKwwGpbn@bfDfa[kyIhq@oaDjjOobHppEwsUqrQ}jEktKzmChbQjeQnjRrfDpgUemIto_@nqD{oAhwBheDcvDlaI}gA`}@yzC}@unDnrFawFfiCwtFbhH{gFgBkvHp~CevAh~@gtCrxGyxMtiK_mAfiCk`BngSapAf}EaiBn`DwyH~wEmaD|u@okCtzAcdA`Su|@kIsrC}iActA{BaeGxdAsrBbwBgkAbZclCi~@y}AmwCeqAcrIybB?q~AkuIw{@q}BmdAuH}~AsmI{v@e`C{~Aa{@gjB_Jo~E`fAawEgjBu{ByXq_Ch@idFjeAyl@oC}hCeiEkpBmyAsJiyGuSk{Auv@i]el@lPctHp{F}fDxjDi_FjtJy|HzbJ_sAvxCk`@||Dei@vnB_bBrqCccGpoGal@zXkhEld@cxI|sOi{Bzl@wjBirCgsCokB{WtpBak@|_@gxBkR_bB_r@wu@cyBe`CibU_hBwlD}`EekCmu@xtEspCRanDgiAykCiKgl@xmAuv@~pPukBthL~Hn}F|k@lvFfEzfFqjBhpEaxCnlA_wBgkAwmBykCg_C}sBgjAleAqhCqbKu}@apBetC`SoaBlo@mbDpsCweBjq@kwFjeAyaBbdAelA|VepJU}xGvp@spCm[olBylBycAqqEk|@myLq`AykEcxB}{CylByDwnAxuBi~@bqLciGbkP}{ErzRi}MpdImnBlwCzBbvEbmChqOf@~eCir@fvAex@dMwtFq_Awp@vF{l@xw@iqCec@sgB~}AmdCfyG_|@tqA_{CvzAwp@j{A_oFp~[kyA|qDsqE|cGi~@~{Cxy@tsJ_rAvwDkoBfsDqaA`nDnN`tIafAlhG}J|pG}sA`rKqMzkN{LdXk|@{B{L|Tmo@`hLoD`bl@meAdrE_qBfM_}@ztCmwCdgGce@xc@adCtvGazBdqb@ubEp}BoxArxIqrExkPuq@|~MafAfgE{|FxgGudC`|A_^z~BgKdvNqlA~gAir@loBe~FlpJeeI`f[i|C~n_@qjAlcCg~BkRyc@s{DmcBweBaoB}@}tA~pC{a@dfH_{C|kLij@hlVupBxeIceK}fQ{a@wlD|jK}gw@bZorFqk@yjD{pGoeJ}gQj~VypHa_Hoy@}sBqcI{_i@h|Byo~@~q@ouC~`p@ejeAn|Im{YnlAgtAr{CcxAr~@kgAx}He`NjyCmpNh~@azAlvPg}CfuBemAvPayBybBwhUyC}hDpLucDxpH{yp@wcAwv_@nc@eeIbbAkdD~mHybNpU{iCmeLcnv@my@}f^}~BseQe|FknE{pGcwBshB{wK|Ak|MfyFonf@ldCmjEf_AwoCn_Fcm[xhGks_AhoDcvPppGqvMz~DubEpt@kqBeLcaD_sA}gCuwEkyCu}@cwDfBe_DihBooKaf@ibHk}@_|Ag~BshAqt@pjAce@{B_rAs_AubH}IqhCw\\u{B_qAknD}oF}aKcic@cy@}bHeWqeHn`BuuVqKg}Coy@etCotDifDupAweBihB_dE_~@cdAirA}_@emAwdAi|AgeGcoAqv@{j@rUolBf{EmnAnCebB`|Akf@bDafBa{@_nE_HqkAmz@co@wFk~IzfF_gDkRyvNehFevAqjAwiJevPqdHx_F_pCmwCywAoaCqtCxDshAag@ocAcxAyuBjzBydAssA_gBivJsgBcaD_gCanB{rCcFolCztCox@rgBu|@xOojCwyBmyAqsC{l@}fDazAae\\eYihBwjGitL}cFqnHq}F}{Rq~BcuDupBziAg|CgyGguBwyBgj@inEk|@_y`@th@utKq{@kbQjVmaEtwAgyIkMu_J|xBqkMlFovBilDshh@cwB{|FscAxuD{v@afAgb@_aH_tDypKg~B_aVekBon@icCicG{e@cgIly@oyWkeBarMz[{|DzkBuyD|oGqoIjoUukc@xr@}gCpyDond@prE~Gr|B_zCnbAkmFx{UineCh_AobB~eNc}I~vVsrZbbPpoUph^|dfAtpAxmAdjCjq@bpMfjBlcB{Bp`AgjAjjImjU"),
+	country("Finland", "_apmJkswgCmiEiT~sBojF`nLti@v]ztJoxL`sEslByfC"),
+	country("Finland", "wd`nJupebC_zEmpMxyAigOtnIj`Gv\\|gRalB|gEwi@ayM"),
+	country("Finland", "k~jnJ{xucCfxAic[jfKvu_@qp@rwCixHilA"),
+	country("Finland", "i~nnJ_qiaC|iDstQtbCfjLumDrmT"),
+	country("Finland", "i~nnJikseCjlMfuHesFxlM"),
+	country("Finland", "qsmnJwvpfC{~FwbOfsHjkC"),
+	country("Finland", "uhtnJqjk{CazB`eIqnFggAz~H{`Sz~FrmHudA~jE"),
+	country("Finland", "a~tnJ_sljCivIuaNjVw}EtkCfj@hpLblThoFb_CvaI{jDxqKhvUhxA}jDziD~nDpm@`iHmxEzhDpiHxtIgjEt}CswD_sBvqHbcWegQkV_tC|`I}iDkxa@om@rcc@s_MolEsSsuDz~FtbA"),
+	country("Finland", "c~boJkv|eCjiDenl@bfEdbZsgDbzB~hAtvR"),
+	country("Finland", "}ldoJussgC?ltMctEotObtEmmN|mDtmT"),
+	country("Finland", "a~foJykgeCvi@dxQ_dHj}SunCtJinAeoIktE`iHenCa|@`nGceW|iD}qB?|{I|zD_kQlqBt|C"),
+	country("Finland", "oycpJ_jrcCxcD~kFyrDiQscGwhM"),
+	country("Finland", "oycpJk{baCwgBfmL__KvbC"),
+	country("Finland", "gbspJ{pqaCbtEiePntItvFybKvbO`p@zcEcgEq_@"),
+	country("Finland", "yptpJw{~`CmqH~pOyeD_bJ"),
+	country("Finland", "u_rrJsex`Com@rbK}e@wxQsiD}sAemDhmLyiBieDtiFqjSzxH|}I"),
+	country("Finland", "q{_aKi_waCjmHef@yo@~xKbzIbiVs~FhtIvy@zbG}rSviLni@}b_@`xIbmH`yAe|Pq|FctD"),
+	country("Finland", "u{gaKywbaCi_GlmPoyE{xUv|DmmD"),
+	country("Finland", "kaalK_ppwCrgAkjLjv@hrEcu@dug@xwF`_Rr}D}nSzhCxaQk|Gj|HprD`fIkpLfmL_lGmzPczA_fb@jp@unHnlDr@"),
+	country("Finland", "s~rmJceujC~MetFxqJxrIqgCdcCc}BmkEym@`rD"),
+	country("Finland", "mk{mJq{bkC`bDbbAnNfxRmeFk_H"),
+	country("Finland", "wfknJg`egCsSucMjmHpaKqQf|E"),
+	country("Finland", "wu|nJom_eCwqCr|NuZmfR"),
+	country("Finland", "aspoJ{qfaCjdE{jB_tBn{J"),
+	country("Finland", "w}|oJ{ujaDzdEevEbcFhdP}{C|aG"),
+	country("Finland", "yrnaK{~leCj|BcwEz{BnwAlLfjL"),
+	country("Finland", "ix`aKk`dfCa|AjtKqeIr~@|u@muWqtDw}EjeEs|AhrE~iFecA~kQ`~@urG"),
+	country("Finland", "qmpaKqo_eC{jIctFrFqmR"),
+	country("Finland", "a}gdKutejCbSwaCfmFjfDjmBreK_kGbjJ"),
+	country("Finland", "uownJuyybC~LicDtzDb{C{U`nF"),
+	country("Finland", "{jlrJagw`CtmC{U_cDr_N"),
+	country("Finland", "sqktJy}vaCwuAjlNgqC}fF"),
+	country("Finland", "}de_Kehm`C_k@paKahHvdBdg@}vK"),
+	country("Luxembourg", "{c|pHiyid@joDthAtnDjf@pBu}@h|AlpAdX{bAjr@ug@naCwe@ldAlPtq@cZ|hB{jBtnD}}Aru@wp@}tAebA`oBejBv~HmlE|~@q`BdbB}mHbbDyaB_`@}eEtdEofKjRclB?wjHxd@axEpVq`@lFnNx_Elf@f_BsLpa@v{@zv@nC~q@eMtrAiiAqCjrAj]hTpl@}u@b`EhjMdwAz_Br}@cxAjh@yD||Cf~BtaF``GxcApb@~dE?|dG|s@hjF}QqGz{Bmz@~dDouCvsHsk@djDgKtpDxXbfJnx@ug@hUz{Ebe@~_@rhApLvQpjA`{@t{@fUvzA~S|sOrVnkB}qD|tAelAptBu|@pkKco@~{CqaAdlBeiDhcEem@qsCybBknCq`Bmz@wd@|hBij@keAumE{`CqjAwnBgu@{a@{wAza@yWfl@kHh|B_Tri@{l@|TqtBug@co@st@yn@lGcd@bc@pArrBur@vcDcbCtSs{DfrCuf@hh@u^nnAmZlaCafA|`AomA`e@qiA`Hwz@wZi_@iiAec@`{@a{A_^~IacDyw@by@czAzrEkz@eXqqFkwE_gBi~@_i@cp@}_@ggCk^ur@{tAocAmdAbdAguAaFg`@ye@ebAyjDmp@oNcbCbFi|BueDwvDkq@w{@ifBihAqgFi}@_|AknDsjAyx@}s@aHadCmp@g_Bb[ag@rhBwy@h_@cxCqMcmCmp@i}AeCmo@`q@woAnx@cZnkBaF"),
+	country("Belgium", "_l|vHq{vNk|j@wliBi{Gwwj@foF{w@tkHj]|{CowAngByvCzu@g|DvMsfEa\\yfHcz@cQcnAdOegAsJgk@ocAyy@}qQhOo~Cl~Ce{Vvo@euBzpAi_@teC?ldAa|AlTolAyDsbVg[ckCikC{{GysFoeW}wCauHwqEcuFyhFusAhfAiyB~|@yp@|pBgk@pdJqeIkzCkkCkoGfvGa`AhRswBjhC|BmtDzeB}yIvJibHqf@yuBujBa^k|@be@{_Dr{Dg~@oCk|Ast@yr@pjAkvByeIelAgnI_IqfGds@wp@lgBjGzlA~s@~{@rJrt@ovByN}eGn^qfEaC}_Baq@qsCgrJebNkaA_mEnDmyAfeAkyE|s@q_Ah}AqLhwDnaArkBel@qnApoGlEleA`jAnb@zb@kcCjHitL~wAscJgV_fCc_Bq|Ce~EspF_fCq_Asd@od@oNg_Bt{AwkEnqCwyB~fB~T|bBvdAhfC|Tv{@ag@dtCavE`hFg~Bld@{jB}DgdJnvAylBp}EeBpPylB}c@yjFnRsbVe]maCmxCu`KtH_fCdy@sqC|}AsrBbxBqk@bmC_StkAwmCdbA_aJlnBosEg\\}_Mle@aeBjpBoaC`k@irAu]{~DhDqiBtlBafAvu@xvAjfA`S|~AcmCng@xwBx@rfClxAsi@daCvoAng@bn@kf@~gAxR`r@lnCnm@tgAssAlwCxaBjwFbuF}VojC|VywBliHhcG`q@lmDv}HzaMzjCqLf~AmdBh}@ykClz@qgFrhA~IlnCp_Ahh@upBJadCk|AeaBcZov@dNu}@ztB{~DuG}|O`[ojCjHodM{M}|Bzl@nCpa@_gB`{@eBz`AfVjs@qWbEuqAij@kvFbE_gBfkAewBnpJm{Jz`@hrAxxA_hA|i@q_CxEonLld@eMnmB~qBpU?h|CnoI~qB~qBrrA_gBbo@bp@l[mGbPkzB|t@vp@p}BwxCsr@imH~g@_{B|j@k_Kpj@xdA`q@nNnvBis@pw@st@xYocAhgBpC|eFxvA|hAkGppFaxEfvA}Kft@dmAjR~dDi^x_Fh^rsApkBhfB|}@|Kfw@wZnaA|qBzvApmKt{@j|@h_AnlCfu@p_Alz@irA~eA{a@leAzBbz@ld@jRnm@lEt{BhTrt@lxBzl@|_@i~@le@_U~h@bQ|i@xw@yY`|AokB`Fox@bZaq@voAdClo@lp@h}A`GpsEyXtqAshBvy@c[`g@lp@f_B`H`dCxx@|s@jnDrjAh}@~{AhhApgFv{@hfBvvDjq@h|BteDbbCcFlp@nNdbAxjDf`@xe@fuA`FldAcdAztAncAj^tr@|_@fgC~h@bp@~fBh~@pqFjwEjz@dXbzA{rExw@cy@_J`cD`{A~]dc@a{@h_@hiAvz@vZpiAaHnmAae@`fA}`AlZmaCt^onAtf@ih@r{DgrCbbCuStr@wcDqAsrBbd@cc@xn@mGbo@rt@ptBtg@zl@}T~Ssi@jHi|BxWgl@zwA{a@fu@za@pjAvnBtmEz`Chj@jeAvd@}hBp`Blz@xbBjnCdm@psC_hAbtGfVdbAldAvoA|TnlAmnAdyKtr@tzCdaAhiAbeAza@i@|pCmz@vbEjoDtsLgV~eCqv@ve@}rDyZcx@zXiaJ~kHybAfsBemArnHtqAtqAeCpsCqWj]ox@zMowAkRqj@ymAu|Afj@gsDhzFhA~eC~q@fyGmZ`oCkpBtmE_yFbiGem@hiAsh@z}E{l@r{B{sCpgFwg@`pBfCbbCnkAlzKeNlbDmn@dw@{hFg@_eCvxAce@sJsiAekAy_Cg`A}t@eOmpA~I}qBp`BomAxfHqvAhrAwbBcZ_aKgoFknDgBieDqtByDce@bn@ebAumFwn@mlBmGyo@nx@dYvbEaRjeA_|Al[vp@pgD`qLrySvmB~s@vnBqk@rfExn@bbBzj@jfAnx@v[zbAxYhcGvuGjfZ~SjxDmmDlw]rk@~|MihC`qL|Aup@izD|h@cGv\\mwBox@ugBq~CsqBqeH_h@}@qkBvoAulGhhBiS~|@fj@z~Dw[nwA_pB{MsyFcwBupBs`@coB}}AcvBuoEg`AyMug@be@myAtxEhTbmA~qAoC`\\zl@qa@|qBmjFdjDkzBfeGirCjnEcd@lxB`HlcCdjBflK^llCk{Bd{IiStbGfLpoG~RrsAxc@jq@b`Eh{CjIxn@jg@pWybCrzEm~F|u@ubHRqpF|s@{tBldB}jA~zBs}@luGs@trKyc@plAmdAw{@kq@uHs_@tg@mPxxAnCbxAdkBpqEr~@|pGrJvvCmmBl}JwxBdsDafAh_@ifEtHu~IjdD{sDjq@igBevA}sC`fAyx@vp@eXxjDyc@vp@yuBzkAkxEn}Ds~@|hBk^daDbcDdwXbx@`oCzlAtpB~mE~zDjg@tqA_fD|pRu|@rrBamElwCclCngFi|Bj{AmZ`qAxCvaFeXphCsqBpuAgaA~zB{iC_}@eqGjzBex@ec@o_CysDk{B~Rs|AdaBu{Cn~EswB~|@yrGt}@inCd~Aiy@g}B"),
+	country("Spain", "_mxyEpbr_@caNhjJ`oAurU"),
+	country("Spain", "cjsvE|v~PjiIgyEcy@z|H"),
+	country("Spain", "gzwbGkquGnjE~zBh|C}nGdkBzgGtdI}zFd_@qlL}|Juc`@aWwdA_s@mz@xT_^za@g@jb@_rBpf@gcKng@cbCxpDw}Id]qfGt^{_B|gBgtAxhFaqAr_Bmz@|rBqfE~HssA}_@ohGwa@igAggDq}Fe`DqiB_b@mbBpZgfHmxAgaKuNkcEzuBkxFv}BsjJfb@}dH|cB{l@j`BeaBzeAwwBn|A_wHm@g`Aq]_i@gdBis@}_@mo@mAywBf{AahLcFgfDcZpAw_AnlAovBzw@}t@wp@kyCqfG_i@mmBcF}~B|ZysFiNmaCgbF{cKaQuwHquBklEtx@eiE_s@qiB|UuaHj~BexAtn@u{@j`AojEvF}aIsa@ulG`yElbAfcJqsExfBqdUpfOxtI{zBhcQjaG|_IneN{U~aMa~P`{EfsBneLuwF`nVnuO`tBb~LnhUfm[rgo@tg{Bjq^rm_@juUrnrBfdObx}@r~GfwG|i@|j]rem@dfz@puD~wBbEsaMxcJizSjcGt`Bd}CxmMtuQj{SfhAl}JarDnqC`m@_yLwoJkqLbpGl``@`rz@pqh@ncUlk[pe~@l|x@dx_Ahil@x|d@qhC`kZqwOf`BxeDrsIw_BflTelLvzXu}[zpFk~^riPqvNb{N`r\\dzDfNpwBbcWr_M|iEzsLlrp@tpStmOfdD|wQtrXkc@~vG|jVxx`@v_El`HfnK`u[ngGyeDjiD~tNvhLnwMs_Hb_BshO|fEgcCvlK`}i@e{Cfw^pgClhEbgD{wAeeHxvYpjBnyNjqNvv[hsIvaD`rKd~`@po]nuZnzi@tfQdaHcc@h}FrhPr`_@b|YqCz_NymRvz]loBj{e@xsPn_J|`HtvRupBpkXm`IfrOdi@~klArsHzuO}dHbic@hrBrlXemDj}SjvFrljAufAlza@r}ZfwVbj@phKr|Kp}LzEhpn@zrQhmv@dcYhiSxrY`lHopFv|O|yUjqAd_Jby`@czJ|cN_rBxyS}fRbtVaa@jjV}xPvgLorC~tIk{k@~k\\`_KyiNk~FydIxOhxGstKx`A{cFzlHkxCxwTkiUrpFspGicPsiPgm@mmHg_[x_E|g\\r{NdKfhAjaGyq\\hxTelg@dykAcnHryC_tN{tKv~JrmTf_KatEkcIru`@`tGjwu@soAfmEu~KzzB}}Ash@clEt|@koNbn@maEzjBmeA{LeaHfv@wsBfk@etCz|DceAzw@qcDnr@mE`a@m`ApBauAquAsxAoXge@bOe^az@u}D_oE{iK{vA}zDssA}~BmmBe~Jq}PwqFekDctEcdAubH}gCsmB}_@qlDnXcd@}i@__@seE_c@ebAb_@ulFuq@q~Ck{AmnB_aC_}@un@qu@lGgkBhrBs`JuMq}CuvCkr@cmAyy@g|Dqu@s|DqsBi~MwcEmz@jgBtJjmD`f@xaD_Irr@xrAzkAfu@bwCtW|{FekB|pCkzN|zFo|BduDq}C||Aa|SdtScgCzeGgoCeuCigAwEaqA~fAihAk|@{yHapBihHqdGwuA}`@ao@jRwg@dmAaf@t]epBpKccJqv@svCgtAqkFeoJ}[i}AccAigBimAssAgz@{a@}f@a{@kp@m_Hse@cdBcgCwjEixAlFe`Bu^qyBpuAuvAueCovCyjCg{LgpHuw@~Hak@jfA}iBgj@kyBjf@coBpvAujAlcBalA`oCeb@`bDnMvcDn|Ab`Fup@~yDgq@f_Ais@vGcyBocAg~@gLovBpkAywAj_IqbA`zCy`BdvAi|Bbo@cbCaHkqBgsAofH~tHugAptBshAdm@adCv\\giCfBgqDwpAymCs_@kdCSi}@dm@gu@peGmp@vcCk{@f~A}gClz@}t@|t@itAfrEolArgBo_E`yDmnAjoBwxO`bGvp@cdi@wfAgoHdEixFeY{iBr_AufCvEwaEowAov[u\\mzAaeC_gAsqCfVq{E}}B_pDfl@ssAgl@u^wo@ihAoiEotDi|Cg{Eyc@{`CrTyvAeaBgqE_|@{bArJoaAm\\}hCojBc{IfzFwlFx~Eq`A`eCeb@fpF}sAfiAetE|k@kzAtr@_hAit@uoCk|@cy@ew@cmDw|JgL_kJ_|@_rBi|Dap@}dF_kJouColBevCfqEysD~oCyjEnc@izEirBg}C}pCqjAmP{jBpa@mlEp|DelB|Ke`Bod@oiEuoCgiB}j@kzDhKeuN`nCcxB?yaCeb@}iBj[g}CuG{WaeBwmA{XuqA`^oc@bcAwQt|A_s@hr@s`K~yDmnBzvA_dFj`GymA|KaqA{aAqtBoW_r@mbCdYu_K}LqiDg_AgtAwtGkvFupBur@kyCnNgk@qVymAapAanCs{EaoCkqA}xHqeHkRsfOovCyeLyvBmkEuzC{gEgoFkcE?q~BkrAsUwd@zB}AvQsiAsfD}IcbEst@Sy~G_mFucCjQi~@wf@g_Cw`DwhIciH{xCxuCioEpfG_hChwGcsAfpH{oA~oQjaArsAfoAfrDpLz_A{p@tnD_cB|tAkmFvo@yeDnxAuhD{jBs}NmwCwtBagAys@|oG}j@lp@ylGar@kfBdXgjAhsA}n@bwCndChrAfp@dsE|L`|L_i@~yDkfG`cFkXz}DjyDjf@vcAfsC`WpcKsY`nDsxAlwCgu@vmClnAhqCxgAz|EibAtcEwyBf}Dc}AdhE~IxxA~l@xlBpzAllCjvApLjiEkg@bbBfMhkCn`C|~AblEhqBvzL~S`qLxi@hsAxuDdhFibBnYukB`{@erA`oAylAjaHd]nm@|nA`R`l@r_@tq@r}A|o@tcGgGlmBmi@vcByy@zBocEq_ByIfoIkdCpyGwQz`B|bAtnDfeApbKwFfhDhOp`AvnBh}AIreEm|Ilz@nb@`zBjoDvz@fpAxy@kItwEz]xwAbgC|hCj~CjlEj{@twG{W`yOy`DcYyqAhIgp@dn@m`Ax~EqnBveAmsBkHuoBmz@}iGcpFwdCiuDouCywGsmAuaA_lBgMa|Avq@cdAf~Awd@xvBnHz`Cby@tlEsm@nnAmdB~S{kGaRezCtoBhcBhzDza@tqB|JjyChfC`wEbi@~gBhsBjd]p`@ptBhmB`vEjb@zqBbT~pIrz@fiBx}@fu@rdE~pBx~@z~BryA~lHhe@`eAzeEhcDtfAlqBfhA|hFt{CzeEoZxfBebk@~wAmfAanLm|D|~BedFs`Gm_Uy}b@gmF~\\dsHbmHfiGlsd@smH_xAzq@waDwwGzT{EgnJmgRkxUfjF~wc@_yK~iRkiDo~J|wEauI}_TcUafVg~P`jHtpIudGxsIztKxuDddAteLfxMvtM}wKvqJixTq}FuoOgwXsqFcaA`kMpoh@kxIdxEe_CexEavCb_GsvJ_i@seEn~JtiJldMybEfrCokQi_GymFnqDqrJabKyeDsmThSzsR{|HinF}aHskm@iiHzyIm|Iyt[r{Go|[{zBwhYeeMif`@|gF{wEqcJ}mCjzB_lKleMicJecNoBg_D}tEexE`jXy~FwtYd_Clc_@a_Kk_GstEl_Cks@ouNayN}l[ltAmxEi_Lpm@wbJgm\\jmHybKxiHxbKdKqxH{uDlx@?smH{vQcbYv|Krd@}}C{zHxoKs{CklG}pMfaIu`a@lfRopT|~BpxA|Iihk@ftNx@gcNcmGgy@yeJspAqtx@jdE}`Zym@weHu~Ble@c_Csbo@vbDa_]gtBcr^kmH?gbD{wIh{R_ca@zc@qkv@joHj|BqaEygUzlHmyV~sSi~fCqtIou`B{qFuaGk{BgcUlnB{fJ`tEvtMryB_nE{nAw|KipEx`AuaHska@f`Im{[p`FtuLlqBy|Fbs@{od@`gO{|q@kxI~bCgtIgcOyfC{wa@|mD}lI`cJavCmtGmyDlxQckx@voDsxm@}zIou_@biCmcGifCvj@yfIcjUtw@mjBpwGtPx~@grDhlAylArxB_Tpq@s^avAcqKdm@unDh_@u|@rbAjQt`D{v@fu@ejB?iqCw[ecAo`D}sAoh@i|AfBa{Bli@maE~bBscGoYotEnfCqrDtcF{V~wFxbArdEf~AbmI|bKnfComAd}AkpBly@giC~\\axCtIejDuXgiBmsAit@wbDeNi|Bgj@cxAqjB}e@ekClZclCnqAxaCzpAtq@trAa[hxAshBhs@qtBhn@kiJjvAyuEfyBwjEyNoiFx{AmkExh@a_Il`AgkA~vBuxEbx@_nFd]aqNw~@{`Nhi@gfDn}EgtBbiB~]~w@{a@pl@s~@vEwxDneDqdIrnFqqFflAdChSeiDxd@ec@xlAtHj\\k{@igAsoEwsC_JfBg~CbsBepI_J{}DqaAcvCqmDkkHtg@gu@{L}o@slBklAtaAa_BvgDmxJfsBgiAtMmfDufAmdHpkBzL~jBoc@p{AmjA``BilClsAoXpe@opCpvAkkB~fAwuF{MkwE}aCeaNci@k~Jsy@gtBsJmdB`_AskInc@ybB|mDaeCptAk`B_Iy{AyuDmnDeqAwmBa]kpBtkBykD`gAcOlTo]bQ_|Ber@igJb_@ufByO_jW|Tq|E}TaqAuaAgu@scBmEs}Ari@sdA|iAkVnwAixAk|@_Zom@qeAbdAanD{vAodCpLyxAu\\yh@aoCc@_xGfoA{cIdDucFztB_mGft@mxDpfBuxCrSebAmhAeuDnaBckEnY{sO~\\oaCdhAmmBvoGokDxx@gjBlEadAwp@uoEx@}`Wj\\sqCxwAbZ`Xu^fVanBhlGseFhuEihBdvAmyAxcBt}@|y@vFbYis@"),
+	country("Spain", "{h`bGakbKkNbmC}m@~pAumArJgoBmcAoWafAfdGadE"),
+	country("Spain", "gz~hDdvmlBniFytI~q`@jqR_rNtib@}zEvi@pEgqT"),
+	country("Spain", "{sajDld}|Ad_CumD|v]vwA~_Vtaf@mtLrq^}|Ut|NcoMa_@{wOe`TauOmLhnEwio@s{G_tH"),
+	country("Spain", "oedkDlxshBlI}|QbqJwzQd{GsmBboL~{O`k@tfMygMhtO"),
+	country("Spain", "m~xjDbpgcBnpW|`VzuCdu[ojdAbsj@u`FkjN~yCucLsxEkfc@}nCicNw{[cgd@}eAaea@rvFgxAtw^vrk@"),
+	country("Spain", "mnknD``htA{r@obTbeKgzFztt@~rB~w^jvPpiM`kq@|mV`r\\c_Cj__@{bEq~@{fEgl]klOsxU"),
+	country("Spain", "ur{nDp|jkBddPaxExjIhsGptRu`@|uVpaNml~@le^_wMmvO"),
+	country("Spain", "s|mqDrkhqAx`EkvKn_Kx~Ft{[vpCvpOhkk@ltMlwKe}C`bQ{wb@amL{kNkq^ueAqmR"),
+	country("Spain", "ysvqDvbiqAdeGh`@zqAvrH"),
+	country("Spain", "mrkeFrbnC~bOef@qkHryC_}JrS"),
+	country("Spain", "agakF{biHwtAstFdoFeaBwoDraWntFrtMoqLXqmGenH"),
+	country("Spain", "s_rmFitxHbcKyiBbaZ`ug@rkJrgA_iIjlc@inLeJmtAwbOmxKzq@etGwnK{`Gcl_@"),
+	country("Spain", "ikyqFsdnStwEglVnqPd_C|oYjoXp{YlxL|zUzmd@okQh|RinAfs`@syOxwH_~Jgj@o{EpaKrhDl~T~}Jp|M{bLbqFltA`cKqhIz_Gg|Egj@m|q@g~wAexQyofAltAexE|bG|{U|hEtLwhAk{Tf}J`vNhlGs~@bsFgaP"),
+	country("Spain", "o}trFelgYtkJstEu~BrtEnzKxP``@ncImjVfbs@?~l\\yiHc{BcuE`gHkwD_tBseE_iv@ftImgFuwFooD"),
+	country("Spain", "ss`nFqafQfxHbpCk_FjnH"),
+	country("Denmark", "s_knIsh}s@ovMrrEmmT{~Fq~@`bVvdHdf@xrDfjMucAntAkzCdfAuhQe{DddBcnSrdE`pFpo@efYc{x@p_MqaDria@ieVtcXzyCjnMtjDokEltAnnDvgHkrLmqNbri@adb@e{O}nq@toI`fU}xKpbKo@i_Re_f@{p_@ftTo{Ep|Xl{U`|Aw|DzfDekb@w`DurbAdfAqoV}~I{fF{`G`nLjnB|gGogSrnGcHywC_\\mg@osNx|F_}VktEm~HfjR_|L{T_oP_tNy_FuiK~vNus@woJcyJirKmrAyvTusApyMyvNemU_{@k~S`iSo`UbqMjxUniLsF_fHapQim@btEa_GctEjkCrd@zc@mwWnqIv`DzmH_yM_zE}aHfZzlNodT}hEwaDb~Bli@ntY}kJwiMwoJbeLqcJw}Ew`EpiH_wGohF}jJkhPkiDu}[vsIck`@yoLqyNqxBqhk@yiHtmV~xGjjO{nB|dFb_N`}rAm~IzpXh~Fb`MlvKl|eAzaSr~LjyIjwUhhNp|Ad`Ck{Hlh@reV?{wa@bgJigCxlFbnHsoR~ng@oyLxxHmtAtkJviMwL{bLn{@kaMstEsru@y{bA|oBcl_@o~Iike@r{Bozo@awCgmb@gcNan^}`cAw{kAo|@m`l@kzZeuaA{UcxOp`AqtChxS~xc@bvJtzDhlYytU|qG`uDbe]gfCv_Qn|W~eYxpKpkBj~SioR~zn@jtEdfLe}C}sMnpPeed@|dAkuVvtM`jHtdd@}qMi^`|d@n`OroaAqhGiux@ieEurHvaDezPs_By_QxhOce@xbKhrWtiPx`Eue@_gGqjNgjAepHibQ~vGgyEniFamSmrAkycAf~PouZ~bT`gIpmSpo]lrMvgBsaA`~K}oClpCsrEaqFs~AfpJxqJloN|xKsxCx|@xwIugF|iDmtAstE_fBtbBx_@ttRmgFtpIaxAmtYopDtaBwmEcvDmaC`cKndT`~b@tgG`nFrnM{zGfaJtvEqC}`Hn}b@bsQkoH~tN||Dzwl@xlFmya@jmHnpDlcBohGjmHhtIpeD`rh@}fF~`m@ncCi}RdzJmqNbRwpUlmP`u[`jHbxg@vfAet^reFdeAdtArcKp_K_wAt{UckTj~Hv`DjaG~te@|nCu~Cxm@zxI~zF}bSbjHvmWeqA}l[jxI_o\\ppQkhEfxAhtJj{Bk_GdxE|qA}YvgAcwDtxQalFzoAy}@srEixBruDhwHxqK~\\hkA|`HrsQ~bCr`@vR~~AcF|hBwcA||BxM`pBfuAhyE`HtdP{XnmBgiAzl@euCbn@st@rgB{sDnlh@gsB|iNwp@hrN|K~pCj{AthLyDreFqf@jzEqjBaq@oi@fm@"),
+	country("Denmark", "oy`mIuohgAptC`nFp~@uuF~iGh~EueAdbf@vzF|qY_k^zx{AwvGahTqtInmPwmE}sAmeGs|e@~sNqxYltLmbk@meFhhCmqByhOgfHti@l_Oq|YtkJo~J"),
+	country("Denmark", "yranIy`x~@xiHgjLvcCjkY_t[`}d@vwNoi^|F}cGmzCvNziHmgFoKeyE"),
+	country("Denmark", "{a{nIifxjA|lDwoVd_Io^mv@`}d@plM|mZ}bCxbQ}~NqbK?umTstEwl@ohF`mG"),
+	country("Denmark", "alpnIgjb|@vmQsjI|qBvsTgrCznNntA}{UqaE`jHmqAfx^{tKlmCxpEmmPgjLrmHe[vkWexDkkC_m@diL}lCof_@"),
+	country("Denmark", "aw}nIubgaAwrUmnNbd@kkCxbWpiHlgu@hx_@xiB~eH{bSxbQcjHabW}bClbGiuC_wA"),
+	country("Denmark", "qeooIcgn{AdmUjmFreDp|FyrJ~~w@{xHb{QogYu{C_jM_bI"),
+	country("Denmark", "atxpIqpvr@cwEzmMigO|mD~~EmkP"),
+	country("Denmark", "wvaoIegb`A|vE`_XseKfkz@tmDlgFumDjrXajHarPcpG`lGusArjTp~L{hCuKvyFyrOtd@a}FbtQasQcDmsAvaO}nB`wCyzBqbKybK~tYtbCqfTgtIlpY}mH{kNuYsbMfwF}zJi|QaecAblB}~Im{DovA~pO{fh@bHboGlgFcbAx_F~}KlaBocIotHa{YaaE|fGuY}cHyyH`yBltAc~BeqHmpAbsWclUvwG|wc@nMgb[jh\\msYxRn~JngM_gGppPzeEffIxuH"),
+	country("Denmark", "{q{rIi__lA~}OwnJj|H~aVqlLz`F"),
+	country("Denmark", "gk~sIkkm_AyyGeaCewFjtKecHqqDf_KyaF|`BckH`_Gvi@_@ajHvnVd_PasDbbKkdFju@"),
+	country("Denmark", "ih`nIksnhAy^m}IrtJykMnd[f|g@hoWtgA{wPnrN{bKk_Giq@tuE{eDwj@cnHxkMkpYhaR{~Fcn`@{mHh_GqbK|bk@rbGmih@}sMtmTgcComPip@hiNumIdcAziDdiLy~F~dk@fdCtt^mdGjkCmtAqiHhm@ftGmyDnAa}FloNicD_nD|sAd_OyeDt~ByyGkuWcdRhyH}dAxeOmsGeeLglFfeBktKlnf@?gy]gcOnmh@gi@mbw@bpAx~FbaFoyLkr@u}CwmDlmDqwBct^wdIoqC}|DjiDcSefXa`L_mE{fPvmm@psIey~@ypD{~_@j`L`wCkTpxNx|MgkLjzBnvAtmDrmToxA{qX`jHqtCnsBdrS_@}kPhlGqkHtlDnzAgeM_xMs`MmtAsoH}nOibKrrGupBs|Cv_EwkKrm_@unIb~AjnN~}JreK{oFswPfvGwpJetMexEwmh@xmLlK~j^ooEOan]gciArxDaan@jxI{oYvpUpiTjqXmnP|iP?|gRdnUhyA~l]laTr_YxgIifD`|Lw~g@viMg|DdiK|bv@`wOzjOg|FjjCnyApbKrcKuu^jeEtlJpxHgwGxuD~xAvaDxsU{iDzoYfzKvj@_sBefL"),
+	country("Denmark", "si`yIkngeAstEqxYrmHfjL"),
+	country("Denmark", "_xiyIsxzt@~xGjtJt_Cj`TukJtaM}Ip~LiiIg~DexEacWue@`iHqcKw}D_dBus^olLk`SpjSdbBi^|zJjpMkbG"),
+	country("Denmark", "cv||Iuf_cAti@qiHdbEhElQx|V|oFgxC||HbdKagKp}`@"),
+	country("Poland", "mvyfIk|hvAk_BtdBsT_|@{~@~{@om@ab@p_@q|@byOqik@frJisJgsIonEui@`jHotGx`EexKy`E?ajHm_Owi@zqFboGmfGdeAs{AxhYkcF|x@jfDhmLxcDahI~}EfaPqtIxzRse@|_Dq`Bjq@mnAdlBi^cnBqgDciB~fBuiJ?uaZ}_UwghAcai@goqEqpEyat@d_CpcK?smT{cFy_Fny@dcN_jLq{Y``FroG{iDw~P}oCcEvyApmG_jG_hGim]_~n@cpHwigAo|JevQe_O}{y@emPkd~Cf]{pc@d_[eooArhJecMbtEdg@mcStmTe{Orbp@l{I`mGdtGo~JxgHcHmg@_eHpqh@{uKxhOsdy@sjDq}~AkmNckl@dfUghzQkiJaeZbwBslWp}FolNj_H_t@}t@qaLzbLmze@j{Nk~XzpTqeHjhKniFxigAyyX|_gAu}g@ttPidQxkOluEfhK}cIjgr@wdAndMrmIni^bb|Ap_j@pgk@jpHouCdoJgey@jdJazCxxF}kWzsPqrFlm\\r~N~aJknCrvHpvKjb[pv@~sQk}LliBujHzbDxvAptHopHjnRbeKtp]qrk@bsZ_lHbpLmeLvyN{~^rmHnfc@b_GiiLxrFmE~|AoqIzr`@ueDhkHp}Q`ePlvDhcFlly@fzItlFjmdA|~hB|bkAtatAfpFtzCbn[{kNl_TkoBlhU`}I~|M}o`@dvPsJ{{HtoR_mHvifAqdHl_a@}fEloBe~Cnu]i`Yb}TkaHdgT~oDpbKwcNjp]hgBfz`@wvFxt_@fpHh~KisB|mWbyBd}ElhGmtF|`Lz~\\m~FbnZcpLziPjxDnl[_eEjxSdcAhlV`jI~nE~xDd_\\xnYptOgcIl~TbtFbuS_s@v|LizCnv@_cIgbJokCzsDinRf`Awu@zwZquFoo@omAtfNcd[llRxvPfrj@haUl`Qj^l`b@qfS`FelBt~WigBmYe`C`QoiGxxAw`FlaCavE~h@yo@zXel@r|Afj@d}Gu]xmAghCbkEm[rxGwd@hrA{m@jf@ytDt}@kcEfrC}|DpkBsfDpAy_CyjDyx@~dDqkAbZ}~AoNkpBve@ec@j{AvdBbuFHhpCudDloMov@rpF~R|zFhTag@naB`nDrJlyAq`A~zBmlDrgBob@hiAah@f{EknC~bHwo@dnKw{@jpAirBug@wxC|kJ_^n_EnlCwZ?igCfj@}j@j}@}IlZr^`f@njE|VhkJ|hB`wDpk@lbF_^hbFkaIlzM{{Er}BczAz~Byb@jRga@_S__@cy@iT?koAvrIbd@xuDm[p`BavFpnHql@|K}}@{l@iUmcAmFulH_i@seDkeBeiGkeAq}Bs}Ayy@urBp`B}h@z`A_~@dMidFq~AueCjdDeX|hBxOtg@r|A~q@lcDjxDdw@fjBdWtoCg~@vmPlPxhHvmClvDpLxaBqLt^isBby@ueAhgCvEdiCjqBzbAkg@hrAi_Afj@o_DpComAr|Au\\}qBgb@d`CnDfdHmZpsCcxAf{Esi@j|@iu@nd@qsC`{@mPqLeM~gApWlsGccC|dH_rB`aS~ItoC_fBvkGsJvmCz`AzhDdvAS|{DggEhqBafAd}F{jBdlA}sBdx@mwCboAupB`oB{`AxvCaHbdAkpAhqAiKht@`g@mQpjAudBr~AmPd`CrrBr^sUvaFf`@|iAh}BxaDzV|pCbEjxFdlAdkCffGl_Gp_AziC|gDn_E|Jx|FovAheGq~B`vEk{AxlBemAxy@ctE`fAguBxbAs}Bf|DitLpaLi}@ziCg`AluGs}AbnBqhDnNcy@zj@}JhwInm@lbDolAxlBe`DvoA}UxiEwnBxy@cbBcp@`Qu{BowA~h@ga@y`C_|AamEkr@u|AwoAmo@iSeaBnb@ggEeNejBotD_pFpBnP}sAwFgtAiu@ybA|Vkr@kcEevBxw@yeJhsOcdAx~Dg`@bkE}AvaF~IvoAlmC`zA~{@fkAnNfhBs{BrcHcPpfGtmFpbK~]nhGmy@fK{aAaeBiiAeMeuAfa@yuDrfCur@~rAkHhfBnwAbqJyDdtEktIzzHwoAp`@kH`zCvnAhfOgL|`AykBn_Ca]`fAwtGpaf@zLjcGr}CryDyPnwAsr@`g@yuDuSgtB|iAuoCtkIejDdkCcmBby@ywAvQ_rB}j@q`Agu@k{@oEmeBbcBis@teDqB|bJuzAvy@}}A`\\gV~gAxb@voA~pCzkA`HjeAihAn`Du|@~s@}j@n{H_dAzuBhoAnm@|}Ad}EveAoYtq@kdBnvA_~AptDaFz_BdaBza@xDtaGwQ|t@|Kg`@vhU`g@baF}A`dCgsBUk{AowAi{Ce}E_w[gqQk|F_^{pBmzBemF_SknD_r@oeDssAgcCgjByc@lo@ktDi_@qyBbzAogBllCk~BrrBezCpk@qrLv{@emA_t@auAr_AuzBbtEq_BvqLmmBpqGwtAdwKcyAfiC}mBfa@mxB_r@_wBoxBc|BshAqcDjIs}Ch|@_kBvoAc|CrnHweBxkC{}Cr_A{cDnWagApuAqo@`pBk`BteBmcCvQkkBaqAgaFoeJm~A}qBqoBg_BgyB_t@q|J}TasEsgDmkDcy@olCwaDszDhuKsxAr}@caDce@upBebAixA{VavAlyAwe@?cbBayB}~B}@ylBz_BigAt_J{aAzrEw_BdkCupBod@ytDbbCwoGpsCgwFza@qmDsbIwqCcvCuqCkfBuaA`\\{_AhsBepCmo@i_FanDq`BlaE{oGpmKiyA~tJotCprDagA`wDm_GxtE}cA|_BihH~vSkv@piDiqCbkEs`@h|B_^~lGur@nkByvAvfAkvAaeDwjAq_AeuByXspKz~ByfCimHewBm{JcpDgoJgxBy~D{oB_sAeuKwkEowG~gAerAmPkkAqk@euBylDy_EwaDmgDrnFubF|_@w`NblDsbIf`AacCbbCoaCf`AekCxvC{_Cdl@{kLS{qFbbCmyBh}Ae`ByXkoDnFa`@slD"),
+	country("Ireland", "u|}hIzh{d@v\\s`BhkBieCdf@i`FhzBaeD`lAbVzfD`vH}bGh{e@njRpvBv|E_oDvdBk_Trvg@mrA`|Rux\\rgQl}I?~~JnrBgpKroGmX|sAaxMroBtdAivClvLvtGrwO~_KitVt}Ihp@`cVspM`{RvcC~bIejJ|rZvtYth\\nsOvmV{e@fvYhv[prK`}@k{BxmXftMw`PapEiaFjhD~gBpuIi}L|zJ|`Iia@dxDqdDkjB`rE|pe@mwElbk@{mHsbGd_CniLhtIotAn_KpdUahHopDkzUlhQldS{kBdh@czCb_GxbKnm@djPmxEstEol@rvEnzFjyNjeEvqoApnFbjD`o@u`O~mKvvGbkBbiV`vDnvAmv@vuSyeDb_Cj{Hb|BrmBhfI|yDg_EfbDjxUbfEplAncCdak@gsIqyLwi@djDauDeaBzt@din@~oCspRhcElpB?uqHziDfcC?uxEjcFhnAhiMt~Y_cCrmHf|E?wmDjpMntAm{J|sGbcG|iFom@stEx~F?bcc@ntAueLntIle@owBtx]ryJtnNskDjsc@r{Cq^drAd_NdhFhbEwbCbmHruEz`NpAdhEsiDexEjf@t{DqjHwsHdcG|oMqxG?usAzaGnyKhy_@qcDtuG|aHphHlmDjxU{bGltA{bW_gw@x~Jd|y@ihYu}jAi`F}`Bui@vbOexEdxEzbGoxAtyExxNhoQ`ugBi_G}bSa{F~oDexEksXom@x~F}oByiB{cF}be@szBfmD{mHixMy~F{s]dtY`kkBa_GriPym@wmFgtHhq@oiAp{FbgKfxSqxI?iO`jHqnF~h@avCgsZwvGphI}yE_}FygI_ol@epHycKbiDgvGoiLcoB|e@wtYmtA~bCotAmxEvi@xxe@rbFwL{wDplGxsD~xc@kgD`bQ~gAtmDbpAmxEcpAnxQriDtiLqtI|bGkeF_qD{bAq{F|lDciDw~I{_BemJ}_e@neIab@{e@}wK_qDm|Ii{F`|ArSc`HbjIw\\jaB_~JkaAo~g@szCdvXenCfhA}sAgtI}xKxp@meFvpUq}Lcco@h}@qmLobC|mHoxMmxEwi@cnhAkuQ_wdBm`Cn}g@quIlw@afDkiDlnD`yN`bW`aW~lEvcZej@nfH}bGgqIriD~j]epI~p[peDomDfpJza]~qChyk@ioFs|TwyX_fe@u{C_jXs{Ham@qmXoxYui@hcWshg@_cg@doD{|e@y~FpxIltAsfW}mDzbGkSooJhbEiyHktE|~Bi_GqbK?lm\\s~F{bSmtAroNneHds~AapExtIysD_iA`tIxgJitOh^izBg`JasCh{Fc_CemHdj@hmLbuDa}ApwBdnDegKtoB`iN`tPboAvlKsjCbtQo{Ea}AypEqzR{e@|wEe_CobC||Cf|JikAtaCsiDypEpo@pnK|`HhwHymEf_i@omEcuEip@}lPmmDrtE?gtM{oAbrQgrHpzG?b{HgiAmnCwyAclT~cD}wKowBstEkbFfxMepB}|a@xvBsl`@ajH|ih@itU?stEwxaAobChxIyiH__Kwi@xeD{iDc_C?pmd@x|Ftx\\e`CfmEkpMgmDan@ajH`zGgvNy`EoKahNxcUm}OmuK?~eH~gGzaFev@blIgbDt}BmtAwbOkcKfmLui@y~Fy`EzpMjxDde@lGx_Fb_C}sAxm@piHpqKu}BaEzzHcvDbbAo|QgjLcpArxIqmLe|Ux~FkxUraFz~GmaBhxH`yBtf@~rBykMebMw{KnbC{kNwmDrtE?i_G_lF`dP_gBeyJjtEy~cB~oDyeJbhJaCzaMaoLybK}e@}}NkjOtpBwehApzLogSim@__KyiHbmTwmDkiDfj@qbWauD~lRgbCs{HueAzwZwcCvN_yLcad@gxGim@|kD_sCitIenl@yfC~zJstQmtQltAokFy~FogBx~Fjm`@stEh{@vtMzf]k_Ga`Mw}E`sEhoFxjGe{DtjElsAlza@_zFbhVeiEbd@c}Min^fjA{cb@ydE`iM|}CuwVcxI|i\\ktEefL|~Bof_@qwC|zIgqCilLyvCjwCvfBrbM_zEjbGivCgA}JckOytIvqP?{bGw~JueLdzDkjDgxLvAszIeeG?oxYh`F`~Ay_R_bb@vgAieDx`FdpIgxAktQbgEa|@}fAypF|lDlxEzEioFoaDyfCawHhcEk`GkdL~~JpiBnnDmdRzdLk}AykHkuEuwJzfDok@psDzqFcDwjGluKoiFuiDggBsyUz~McwD|qNkiPdxKdoUte@{mN`rPdjVyzRs~j@w`P|h@s`Mt|O}rMu|CauC_pa@tgEqeDmJyoK{~R`}Wz`Gkha@pqJuqNxcEcha@p~EcbA`_AbyDxRnnDxrIz_^foJ|eMzkCp{A|XfxB|rB`yCpb@dnKfjAhhCf}CroDpu@yXlPvp@fk@dXp~Cqb@~r@xFjq@|s@v\\~zBle@tr@|hAfVb_FmY`eCp_AxmBnaBdeIndMhsAtf@rzChKlpA}t@tQfrCwd@ftBp`@hzDqa@luEbe@lnBj{BjxEjfAluE~GzrEi~@vmEkyApqDsA|tA~{BxtEnxAvtHr}@ykC~|Abo@p_Akr@pkAegGbmBaoBh@ks@kr@_oC`eCo}G|Tq`BafAeiE`IoaAr|ByO`q@taGfxIjmRzu@jnFyDltF{_ArxMztAxbC|gErjEp`AtfB|s@vxCnx@~jIxn@|rCfj@cnAnaBe_@znJqzMnxAsqC`p@c|CTeuD~bEgcCxxAkpAveAqjBnl@}{I`h@}zAvbBih@dsG{Wp_BuqAfk@wxBbZ_vIqMwrJhKmnB|eEqaMvf@qj@njBu]t^uhAj{A}ySyNueCowBa`GvF_{Cpu@ldA~JigC~pAcy@uGyvBt{@inHwE_gBknDxmCuh@?i_@}k@{`Ae}DnNkTrdFr_@fWgU{X}tAu{AifBs~@oOi}@b[oc@myB}_@vF}t@dwAkfBgjAi~@myBgt@iuIar@wdCueB`g@kfAquAmmBp}E{uAod@a|@rpEebAdNnC{kAiqAwyAgl@ri@ybAbE{Lsi@|I{_DazAklE_xGinH}gBwuDkIkcE~}@}qE||D{bKzrCmcBjT_|BdXaf@buEcdCvyA}I~}@`q@da@nlBnbA}|Edd@{v@~nDeuAlvDy`FptB_lGzBsiAigAq`BsVyaAxDiiAnwAsdEzaBylCvnBec@hqBxy@piBnkCvfAqaA~eA`G~qCp_Bnl@g~AxwBueDl[mwAs}AwdCrTcaEgtBeuOoCykD~pAgiBup@k_J_Uuf@_s@oY{iClFirA_rAxCar@|iAayB{l@ohG"),
+	country("Ireland", "sjddItf~y@rwCawC|qBz{Bcd@fdImeF?"),
+	country("Ireland", "ozhhIp_y{@?y~FvhMv{E_nEp`RwoIldA_xAr|e@stEi|Ma_@mvh@lfEevF"),
+	country("Ireland", "uawnIp_`s@rtEy~F?t~N"),
+	country("Ireland", "ulbcIdm{y@i_Ffz^rEclU"),
+	country("United Kingdom", "yo`oIjj|j@fy@wtZowXorOovAee@qCcbBngBslGjDob\\_zLalu@xaFofhA~tGgnKdiQb{Cd|DmiQ`cKloBz~FkoRlmViwOdfFoqO}zJd_OalAk~GnmKaaKzrKtuFd~Izo^|tFbcFziDc{BmrMkc^lnC}gh@vs`@mtSdvWjrGd_Cz~FksKl{JawI_mEy{LrdEkoHtvWh_GltAt~ByqPbaAnvGprKovGnmPhgOsfEefXdqBiuD`aLvNhmLz`SkDjhg@rqWjeE~iLjkf@an@`lB}e@?ac@i{B_rBb{N}qBdgAgk@~`Jw]fcCdi@|e@sl@f{Azl@nhG}iA`yByC`r@hrA~qAziCmF~r@nY~Ttf@tp@j_J_qAfiBnCxkDftBduOsTbaEr}AvdCm[lwAywBteDol@f~A_rCq_B_fAaGwfApaAqiBokCiqByy@wnBdc@{aBxlCowArdEyDhiArVxaAhgAp`B{BriAqtB~kGmvDx`F_oDduAed@zv@obA||Eea@olB_~@aq@wyA|IcuEbdCeX`f@kT~{B{rClcB}|DzbK_~@|qEjIjcE|gBvuD~wGhnH`zAjlE}Iz_DzLri@xbAcEfl@si@hqAvyAoCzkAdbAeN`|@spEzuAnd@lmBq}EjfApuAteBag@`r@vdCft@huIh~@lyBjfBfjA|t@ewA|_@wFnc@lyBh}@c[r~@nOt{AhfBzX|tAgWfUsdFs_@oNjTz`Ad}Dh_@|k@th@?jnDymCvE~fBu{@hnHtGxvB_qAby@_KhgCqu@mdAwF~zCnwB``GxNteCk{A|ySu^thAojBt]wf@pj@}eEpaMiKlnBpMvrJcZ~uIgk@vxBq_BtqAesGzWwbBhh@ah@|zAol@|{IweApjByxAjpA_cEfcCUduDap@b|CoxArqC{nJpzMoaBd_@gj@bnAyn@}rCox@_kI}s@wxCq`AufB}gEsjE{tAybCz_AsxMxDmtF{u@knFgxIkmRaq@uaGs|BxOaInaA`fAdiE}Tp`BaeCn}Gjr@~nCi@js@cmB`oBqkAdgGq_Ajr@_}Aco@s}@xkCoxAwtH_|BytErA}tAjyAqqDh~@wmE_H{rEkfAmuEk{BkxEce@mnBpa@muEq`@izDvd@gtBuQgrCmpA|t@szCiKisAuf@eeIodMymBoaBaeCq_Ac_FlY}hAgVme@ur@w\\_{Bkq@}s@_s@yFq~Cpb@gk@eXmPwp@qu@xXg}CsoDgjAihCqb@enK}rBayC}XgxBlsBf{@pAsaB"),
+	country("United Kingdom", "{n`zHlqgOp{Lnwd@ghBxsUrmTvte@zyInjAsDxedAg`Qhwe@soX~hSh{@xjYdbCnpCnmDe}C|v@ncIuHdmr@__K?wmD{wI~bCutAecBwiXq`Fu`CmHtxn@usHvnKwi@_mLgx@ndIs_H~NljDzsEwgAlbHfaDqwB|fAbuE|Ux`c@t_OjaLjd@~o\\phHncN}~N|{a@g|Ecut@klHrtEqqEytIr~BxiTlqAomEjqGp}@Vxrm@`sD`qEutGp_L_wGgeZmcNt`B{p@pcc@odHymAyjHm|a@kdLgsH|fA_uk@stEkTaqKu~UtbBwcHswD~LggAqmFXilYafNglc@gb\\{gg@}`_@sjIyzBqiTti@dtSg_KdcMw{QymLaqEwiLjnAlyJstPjqRwsBceEmlJhmA}hFoeIjtEbg_AbcGdtMv~JlxEsiDpgNriDhz_@ecZmq_@kpRmmm@umOkkBdeAk}BqtU{zZa~LwubA{mHneHr{Het^gKchUchKibt@zzU}jn@y}FcSe|Uh{RmeGq_Xvq[woa@ufAouSocIgxMf_E`pRatDhmWisg@~pa@qsa@_sf@ctEji\\_uYpm@__Kqxe@smHmmDltA|wK{fEzlC{vP}zVqmLdkJqtIekJdhQd~Z}ZtlJseKt`IzvJ`iAxaKjmOpaFXcmHjtQo_Knm@ue@onEyfOyo@|xJzyCraAjqMswOtsRsvKzd@ae`@tbj@{wg@glWuwFcrL}xVwaIweBc`Ye_CrfKmoCy}FbbAy_]wjG}uRk{BldjBzyTtdAvpDt`l@y`Ev[dcGx~Fb{B{sE|_Jduc@oxAlrGeqHrr@htIxzBjWlsFskIryOgyFut@zfDtuLegL~xXn~JpxAl`IweMhaQzUxgCduEm_CpiUuqMroRswPhgr@n_KbuQlkUgbPhdJ{q@kfD~mQwlLjEgl\\fpf@}hXltA}mC}zIrrRgrIrbA{vKoxSvtI{iUohGc{NkmZ_fTexE}b_@_}h@mnHppL{oBuaCykGpdD{fEpnWa}L`_SclHqrJgy^t}CanFe_PbxDu`}@ypEpmd@u{IlnTm{Jp~FlmDrqAvlJmmJlX|dM{jNnuAyhZumT|iQ|wQg`KtdGtf@rgD~aPkuEruPdg@wi@xbK|wEi_GrvP`qLutAxqJgmWbzN~eTufFcuEfrOcrE|w@r|Mv~Jv~Ja_GocCrtQc~H|bGccQ?__WecYmgEkoRctWo}b@zmHhc[htI|sGxbW`gm@ddGlnCkpBpxMr`MbaAvu`@qxYpbK~qUr|M~`J`hImzE|~S||IfcJhkM`mLksJfdIdyPmj@dp_@yrOjt@}rHoxMkxUre@umTy~Fsm`@}bk@r|Nxe[_nE|{JsmHltAuiP}bSphHdtRqtDjyC{vVqnWrpA`tI|~N|~Ncd]ykXraAq}G_uGjhDyfJwqHrmH|wQ_oPs`Hw_Ei_LbaAvkRahNiyBsdE}hW?vmPizNavNmd@yno@gbV_d[|bSdtYpRfqa@gvG|iEbtEvtGwiL}iDlpBozLa{GmkU|~Bfq`@a{RwpUa|AxwBqtIs|}@r|B|e_@u`CvdNi{RycVrx}@ngkB{~FdxQktE}bCheCrpSw|Ph`]laBe_`@mxEpeDmuFmtS``@}fi@ivBjhg@luFpt[iRxvr@yjBn|J_nEfhAw~Iars@vkFgaUwkFjtE?oiXmrGxmQkrGogd@vtBtlOcc@luFstEumH?dgc@}e@ymLofCnmDotMsmH{vD{|MfeHg{QaxCslYnjAtrSkjIhhMui@vgNquC}WenIqmXptDobPkd@wy]?rkZ}`IrkZukJsxIaiB|rBqpE}zKbeF{k`@iiH~nQccG_~JbcGb|Yc_C~m\\u{ImsMqiBw{\\{{HkkIdmJ~oUsbA`}M{}AmlEkkDhmAvbJfbPf]tsRo`PjTq`A`jHk_GdtAyuOipEniLc|e@e_C|sAa}@koZiRbz[}cRll[klIs}AmtAkxUstE~iXomP`m@}wEuwEkFweNl_NanFxfCitIyfCzzBfm@a_GmbH`CejKtiLctEy~FvfMyoWlLkgEgsIoyF~}Jgwd@y|Lrm`@kzCyeDrmTiux@yePnm\\sqFyiBa{Lrqj@}bCutAmgEvmE~wA_q[udBauCsmHt_CupAsxIitEvmP}nN|yJ|~B}dRo}D}b_@bmHwfe@emDrmHmtAixIf\\`bWwjQh`We{Jm{DpeD_xWqeDt~NyfCxm@akBkyI`kBwsIqnLrnTyoFgdNmmOuwFlpCsv\\j|GugEdxEhxAwbO}bGt_IcwUdaT~uRc~Mia\\otH{hDjt@ckTb_GeqHltMhmLomPyq\\`xAaiTuiDutAy|@igZgoEqfIrwDc`Yo_Ksfy@ncDgrl@c|B{qAexE`cKgeCwlFxsDkuExo@cx}@`}WloT|cHqyM~yQz|MnusA|iiDqqDfbOl|IuwQf{FvbC`_@tpw@i{GlgQ~aJgpO`oE{hs@avCo_JrwEqlLyjHylY|d^bxg@kuEnoPhxHfji@laNt|U|}CvdBwhAunHivOkwa@nhB{oSe}DoaPxiTrtQbiDdx]ltAgtIz~Fx~Frc@gqI}vK}e^{uDlpHui@{bo@anL_nh@b_C|~B?qtIyeDt_Cte@wxM}~HmqGy~@wcO~tIebhA{tEqeV|wEgk}A}wE{~sAnmPiz_@hiZeuLjc]|cj@`g[hfPt{I_wBleY`{YluLiBh{^d{m@te^zePdyVxrh@jnB```A|kOxta@lJ`tOx|@kcQi|RcobArzDu`OzbKbxEd_CcxEdpCey[ltGiiNjwOlkg@mtAthb@fdO~z^voJ``FjdFn~a@_sCbf_AkzIhl_@lxQukb@|jKo{oBkkCm_ZitP{xUtIevc@xpEuyKxfC~wAqm@ixM~tM}af@j~B_d`@z_v@qx}@{nAgzEhdDyqE|e@z}BdmD_xYzqrAw`Ubqd@mi]dgi@_`P~eHedSviAj}BbcFmlDbhD|rFhmAe~HooEizAl``@eioB~iSgoUhoW}aNdzDsfQ`eJamGbmHy|]prDrgP|yJbzIpbWgjLdcq@sqv@roLdMz`AxeCcxIcaAumDpvOx|AnwWikSpy_@faDhnv@{kCxaQ`cE~mPeeAi{SrtE_oP{vDa}o@t|OcvPxf[coaA~~IseLnvd@o}VnvNqyAhqJhfDlcNl_`@v|V|k\\aQasQdhQ_qZqm@ikNlxEwoJ_g_@gwRy~EkkOyiBunUbrFclv@anAi{GgxA`jHvyGug{@p}\\udgAll\\{bSneZqtCrai@|mZ~{c@plH||CdpTxtUhhZsgP~`a@vdHm`Ur{Cq@xQ|lf@vi@qqh@xmLfbO}Wg_PleF`_AhqLjeh@uiL`vOtxQfvSjiDpea@|iDqcKe}H}``@lvKigC~sIf{Fvi@g{FhrDziDhvJv|\\|lCzp|@xfIb~LcpFmj\\pzA_g^n|D_pDrhD|j\\faDkVvy@msY__DhmAmx@waDftIqlFb~A_qh@akGkfvAnx@qwCrsFhnAjlBbiJ`lSw|E|tL`rDn`Pr_}@l}NtrRprKmrAqnApmi@bbMb_ZdwFlqq@r~L~kQmkOhrhBvqIhl~A~dHjeEmdGphUibF_|FwyEfwMqAr|N|fKpbKyyAt}BclHsrE?btQlzCy~FthFziDwuYtq|@~_Vac\\|sFnog@|eGzbF_bC|vX~qHtfo@_rHniRb_GbpGwxA_eHrdFwdOzgE{}B~fGlkEcnGdrsAdsFv`K|pHacEzyDbyCcgKl{Dc_S|{g@a_Gfq`@fqJzclAbfJ|cT__KvxQtiLiaE~mP|dMv~JurBriD~lL|iD_mLpvItuFeh@roGlrG~~JryP~qD|t@baXsmTfr`@xgCjfQsaF~oIysEsSyjAp~KuxEb|BedGsfE?h_G~bCrtEx~F{e@?v~J|~B}wQ|e@dxEfxA}mHjtEvtAsmHvb[|fGdx]jpAbxa@enDnaCndC`nLhaQjeFt{Djv[jxIxwIajHboB}VvjE~aIoz@piHvbOh~EcqLfyRnhYctVnbUqxHph`@`iB~{KloI|kCxl@ntZsiTe_CciJk|`@d|CggMqqJq`WozQ_f_@esHtVsuDgeSijTuwEswCejLvfGotAre@siPa~Fj`NkuEkGsd@i_X}rMi|FcmSwba@m|m@s|HlqBozc@eqNwiXwtMpiH?{~Fy~FntAkxI_vxAf_K}_jAqtEendAtmDqm@y}FcjC_oPbjCpDkxDidKklB_pUcmq@}d^eg^{xPax_@ceEnz@jtFdlO"),
+	country("United Kingdom", "g}otHdnrEdnDs~BtvEtjQvfHpjA|aCdsTu}Odkg@teAbiOihUs_p@"),
+	country("United Kingdom", "qnbuHdnzDriDobCqm@|~NsaGclH"),
+	country("United Kingdom", "cmxxHu_qDjuDw~DdsBv~DccAlsYmeFj`Go~EomE"),
+	country("United Kingdom", "gucdIz~|ZpwBotBbbAnqCueLj{TeeA}eL"),
+	country("United Kingdom", "g`kdIhl_Xvi@ixI`hIxwIxqQ`qb@jwCluW_cIieC|nCjeK}~BpiHktQ~wMe|U?afDqbo@lrB{jHz_SgjM"),
+	country("United Kingdom", "}~qpIn`ae@lpBkrKd|DlnCmiEvdB}I|cM"),
+	country("United Kingdom", "mtxqIlk}]vv@ziC~xGi{Ab`BdvFyjC`oc@e_Ch_Gk__@~eH{{MctQvrB_jR`|YoiShkGl~C"),
+	country("United Kingdom", "kqgsIbku]pbDcEo|Jl}O}vWrkOzcEidVhmMgpJ"),
+	country("United Kingdom", "uqcsI|xxc@~eH_j@xfCdxEhpErt]hlHbaMgvHjfEkfE_zMkxId_O__K_uMmf@bkNffT|eY}i@p|FmbGhAmpIkxIetAppEukJ}eFc`GqfU`~L}~BsoH}uAsnMks]blZs~F"),
+	country("United Kingdom", "aqluIv`{d@ihCefLxxNzaF|e@jtQ"),
+	country("United Kingdom", "{nnuI~ikb@g|Fm}NraGieDp}j@jne@vnDfpJloI`gBo\\brRoxSznAcwJi{c@ze@vlQkrF`O"),
+	country("United Kingdom", "y}_vIz~ua@loCozArnBvvM"),
+	country("United Kingdom", "{}kvI`fka@~vA{kCzvJxfDonEruD"),
+	country("United Kingdom", "ewvvI`fka@wi@cnHntHrfF"),
+	country("United Kingdom", "iliwIhp|`@wi@axItwE`xIqBreD"),
+	country("United Kingdom", "uetwIbcrd@mtAvfSu~BcmH"),
+	country("United Kingdom", "mpaxIdwrh@xm@mmPz{HhjZ~{ExkAm`BriOspGjyC"),
+	country("United Kingdom", "olmwIz~ua@|wEw~DwwAnpOjmH|bGjGszNh|GnuSuh@h}LepBieDuvAvvBfkLfwd@t_Dhff@umJnkRm|C}{I`~EeiKe`ChPquHiwk@foCr_YqdCnrHuhLk~M_cCkxUctEti@bvDfsTogG~cHg|Ej__@k{ByiHktElxEoNspQamGilNngAkdQ`hUeuQz`Gegp@|fFabK"),
+	country("United Kingdom", "i}|xIbqlf@lbSzt]xhBx_KejD~tCupNszZ"),
+	country("United Kingdom", "wx}zIdccl@ziDs~Bp~Ep_Mkd@pwTmrSc_T"),
+	country("United Kingdom", "cgg{Ilkre@jmHe_OxcJ|kEdeAnzKacK~dU"),
+	country("United Kingdom", "aks|Ihxjc@_mErxI`_AooO"),
+	country("United Kingdom", "mk{|Inxek@j{BcmHpmLf~Jue@vxQreDe_Ot_JudAfiAbzObtEimXfxA`xYixUv~J}~Bh_GguMuyKwwM`oFu~BmtMniLymXyiHt~[vi@|mCd_DwqC"),
+	country("United Kingdom", "kdz}Ijtik@|~B__KltA~~Jt|DmjJbaAf`U}iFlmPepGolF"),
+	country("United Kingdom", "co_}Ivm_d@onP~[izLoxMdjP`wC~yL_oDvaCdwK"),
+	country("United Kingdom", "y}`~Iptlc@c}AxfCetGonD"),
+	country("United Kingdom", "si{~Irmoj@fuBaqKpeDjtEqeDjxUlbH_`Kab@slHpgChyB~uBnlc@bUqx_@fiFtrA?lz[qpEp~Fym@mmDq~F~fk@anLwiL?}mPltAriDzoAsnBskI_oOheDt~BhlAitIsrFexQ~oEhm@"),
+	country("United Kingdom", "co_}Iv`ag@mnB|vXg~PhdP?__KycEryCicDabElvLaiTshJxsCmJq~K{mHruPkxIqxApoQaha@q`@}rGbyDzlCmlEamGtkJomPqpPrjN_|FuxDwi@p{Fs~Gj_BicJ{mS~bUkt]`cUe_Cz~NhtImtA__Kr{Hju@l{Ca`F`fDptIyO_eTn~DlkE}w@krLbqG}`TsvEq|XxnAgpVntMboGlpCrxPvhGlqBvbO`hYboAx_MarEd_Cm}I_{F}tLwsZltAloZsxIbhJrtEe_C~iLzuKm{JxvCspAfaI~zFx`]y~FecGltApmLmxErtE{zBstEwAbfKakLjmM{lC_zKloCiaQuzEfdZitIbpAt~B`jH}wEvi@dmDp~F"),
+	country("United Kingdom", "op~_Jfxrs@fxAwlLnwAppF"),
+	country("United Kingdom", "a_l`Jt{~i@kpA_fHn~CvhA"),
+	country("United Kingdom", "{mibJ~l}h@c_Gfi@xiH}iP"),
+	country("United Kingdom", "_bm_Jtlmi@|u@d_Dq{Rxd[hvCy|GcxIig_@ifDjmHim@gmXgwBvpEgxAafDitInmt@acKkxa@ltAjcW{~FstEjkB|`IokFtgFe_O?im@ktQqtCj_GybE}iDnmDacW`c@zxDxiSecNo_KlmDobCsoB{oAa{LjsEaaKobCsiD{tQpiTatI{bSqsD_{]u_[_tw@boAgtGdqNe~IfcIzmH~bCajHxiTpxe@t`CcrQudGenV|bGdy@djEpjMo_D|p_@pmLsiDptI`co@}~Bwmh@bmHa_GrpAjtEvi@yiHdxEbaMetAvoV`_G_nPp|FpdIgk@boGxfCetAg|En`QbcGs`AmtA|bGmtMrmHqtIm|U?txQptIhxMltMyqPpm@pfCh_G}wEurBzrWtrB|rBdpHwlKuIfsTbxIt_C"),
+	country("United Kingdom", "}dcfJ|v_Qxo@apLfyRt~Ie_OpxMgm@enH?fjL"),
+	country("United Kingdom", "czyeJnmyRgxAkxUziDpv_@qpQroN}bA`tIioG{kObiEodSzzMoiM"),
+	country("United Kingdom", "aw|fJxvsOhoF_cCb}Hd|UupCtbSkxIvrIdsG~gj@uyEh~EoqIqaEipEd_CjiDhjH~lEotBtmDtiEuyK|aNyvTakGizBupZplMmwc@ltMtzV_wBsmSvvBsaY~bCnj@kqAsmSlxFvbJzvCgjGg~DjF"),
+	country("United Kingdom", "m~ygJjnoNdfFoy@`_AjsKw{E|fFpxB~aIsqF_jLciDhiH{e@gpI~vBmfGpfC~wA"),
+	country("United Kingdom", "_m{gJ|ziQq{Av~OarE~qDeh@wu_@"),
+	country("United Kingdom", "i{hhJ|vgP?urGyyHdKo]qfDlmDapEhaE`pEfvGieDhRntI"),
+	country("United Kingdom", "ot{hJ~x|MgaD_|EppE}rBtf@n~Kx|Gr{GeeAxmR`fIzgPmdMq}QgoD|bBmtA}mPdcGx_G"),
+	country("United Kingdom", "q{`iJjprQgxAfmD{fDczT|eAwtBdyDzaG~gBw_Q||H}wKpfCrxIgmDayAwlEz|Mj{BtzJ"),
+	country("United Kingdom", "s{}pJrfxCriDatC?zlTd_CgxAw~JncO"),
+	country("United Kingdom", "abwoJnguEktEmfKbaLruPsmA_bJ|dFlLniAh{RpeDw|Q|{DjfKtpBclH~rCdjJ_sCvvGziDqm@vi@ztEte@g{FlmDr~Bu~B{bGbmHdxEmdBgiFpkGacE|fG~mPneHenHd_CxtIjlImpBkdAz~Gt~ZliDisIvxRwgGcjIyeD`fD?afDqyYmxJy}FhcDcxDayB~\\`lGkrKgvGz~Fn_KitIt~NpiHw~J?jmH`vIheJsdFz_MyvCe{JgbDtgFv`EvdCo]pmRmgLnxKybEswg@~jF{|AigH{oGnxFgnJu~BmmDoxAlmDqeDc_Cqm@qfOeyEnhYqoEcaAwm@htIa_Kdj@lxEjtEumD?~K|fXijM}aOxjHk`MiSoxLkiDztQk_GanLmmDhn@~wAucSeqHgwAdx]twEwi@s_G`rP~tMccGwtY}iDv~JyfDmcNzbLgzKnfCpnLl_Ca}M"),
+	country("United Kingdom", "s_nqJxx_ExbKlf@kiDhmLpiHsmH?hxI~hFcjJ`yCn_ExwH}oBq`@`lXyuUnbDsxCmeGbtEmwEqmRr`AoeBwoV"),
+	country("United Kingdom", "ejkqJhuoDke@h_KenT}iDwwGieJvbCe}CztE|gEmjJy|KpyAiyHr}BmdAte@jiDti@kiDd_O~lPhnEwnD"),
+	country("United Kingdom", "{`xmJhz_GhxHnrBmkErExcE~nEwoK{iC"),
+	country("United Kingdom", "{bdnJp_pKliF`|@c|BdsH"),
+	country("United Kingdom", "ixenJzqnE~nJlbCcoBpnFmzIjnA_{@syI"),
+	country("United Kingdom", "sdqoJhmrDr}GpdJ^djJ"),
+	country("Austria", "wzjgHuknfBvkRxmP~`Qz~B|`QumT|yTmkD`mKieRbbKz`A|{DqjNp{EbgKfhPjoBtqBflKh}HiaIn{Nr^tkC_zChdDjv`@c`EbgIdiFlhIakNhzk@plLbqYzaExc@vhEa|f@xkOeoJdwGxeIvzFeXl}Iflg@xaI`{@ljLqpHlqEhcIx|Ipt@x}@otD`cE`r@hr@cyM~{I|wIljE{qFt{CfkLp~Cs`Kac@rhh@nsYfe_@lzFhaXfpOvdA~bMu_HgoIjl_@hj@f}h@hdFfw@dw@jyNtdJtgOi`EtpM{Wtu~@bsHhoQoaC|pE~{B~fOpmQfaKjbMfwe@t{IjvHgwFjmFcbCbuQoCzbq@ctG~nRmvEbf`A^l}GqhDlsVyEnhG_gBprDfRb|Jc`@fpGx|Az{RuG|zFoc@vbCmgDhlKwpAf_OogA`vl@u~CfbJwo@rqEv_@~nEm~AffFsTvsHxYjfMu{BngH_h@brIkfAz|FobCjwEqgEfuBwgCzu@am@~fBym@hcGst@r~AazBpLy_Dox@{kA|@sgCnx@um@xaBgE|eGuTftAivBjnCq{@|yGi}@uzAex@Uu_C~rAsvEf`A_}@hh@olB{`AkoCoyJezBcdCwcD{Bqa@hrCbu@lqKl`F~wRpMv|Ld`AxrGdaBnhGftBvvE~]pzG~aBx~DnJjgAk]|sBueBdiEyEbtEom@tkInHpsC`aAtlHa\\blBaeAxlB}PxlBve@fuB||D|cGsEd~D}_AlaEg`@z}E`q@|tLf_BxoLxl@vnBnrGjsKzkAtr@xzEz`Ar_EptBdwB`Hly@`yBvcDdkCb~@dvApGbxAaf@rbI~|@zxJcdBvaF~SdsFuuAzzF|Y~eEwu@ld@ekB}fDgj@k]e~@v{@kcE~|MmPf_BlGlmB~vBrzRwR`bG{oBxiEkdCzMksEqlAmhBr`@e`D`kGc_E`bEkRncAzjAvvEtzBp|ClnCdlBvsBfB~CtzCyTlxB|KjdBrlB~}AprDrr@x~@~~@b~ApoGn^n`DmBd`Ckz@~lG{v@xkCo|CrpFwrCp{SkpA~yCelAlnAsy@jIquHk|@w_@bxAgfAiIo}Fx{c@yIrgBvZnfIcT|sBktCokDmaDgj@yvJzlKg}OhgCex@xw@qfF{gEwmDctEcpEitAynEeqF_~Aw{@ygGeD}iB|qD_gApgFs{DvwDyrCtm@dnAcvKuCooK}u@qiDgpE}fDgjBigCs_@myAaFs|ArTowA|t@_hA|hAuS`kAhK`aA}Vpk@g~B_i@{}Cdn@_{D~H_yDsKi}A_h@sjAjfBmPlnBknCbsA{w@liAsJvZmcAafAotDpyJkiJv`A`\\`jA`mCfu@mcAbcCsUvz@q`@`g@w{@_s@yjDn\\{~BqdAycB}`@oqGdi@_rBnoBnCffF`pBnmAmPth@nN|o@fiCrlBtqA~IyZkh@uoN_c@igCwsBuvG{jAcyBweBkoB}cFkoDitA}xFy|A_gBm}BczAokCyw@aeCh@ilAxXm~AdvAo_@zvA}qHafA}iCr~A}t@~G_Xs|AoXmwCrJ_Uhy@wZf}Aj|@rt@_Jht@_sAj]o`BlZeiGwGshAgj@mnAuvBs}Byg@quAf@cvCfrE{wXtpA{kA~Ist@eNwwDg~AmsI~BouAtaB}pE|i@hs@d|AnhEpp@si@bdBu`I~bF_wHleAsStgA`p@psA}~@pa@evAnEyjSkXayBy~DgwKg\\s|Cbd@udCzhDpLrJ{tCii@igCuwEscHeXgsBkCinGq\\kdB_}@st@om@zVmk@toCwdFqeHsUamCpk@gfH}_@gdHoc@sfCqtAupBwkGkzBmwBwbEjf@ecKl@a|N|o@__JoMs_AiiBs}@k[meAkgAokOjb@ulm@uyCiqDkaBa\\_fDhIka@lPsxAp_CqtAh_@cZyaBqjBykEa\\qiBtoCnx@`pAt{@|cAea@hd@a}@ew@inEgLigC~r@ahJgjAm~IpLueBl{Eq_C|lCkkFf\\{vAeNicGg\\q`B}fDkuGkg@{uBec@{}E?y}E`|@w}IkHgjBdlBp_AjpAeaBjgAcaD`pA_{Bbx@niDzoCfkAdyC}_@hsAkyCnXazCvpFmqIt{CeqHzQocAw_@{hDx}@udCcAebA}gDq|E_s@|@cgBjq@srCdDq}BaqAnBpLwlCdDdCyOmxAwcBwyCu|AmvCmPcoAlPwyAvp@clC`yBgeBjyCoI|_Bxx@zzFwj@d{I}dA`eBieDs|CcxBeXcp@ga@urLarKizC`cDwiIzrEanDtxGk{@xtCqp@jq@slCjs@qjCr|AwsH|vLu|@by@s|CdvAisAgK{k@ce@qR{uBcqBiqBssBuwFy_Fq{Fw`@i}Aal@gyIyMjGm~BmiHedEilIyjD{fSgcBa|YkmA}pE{bJabRk`BevA{cDyn@ijAew@ujH}KunDupB{{@mP}u@pLg{@by@mbDxn@_mAmyAyq@ckEwQgpEbwB}fSdxAqqEn`Bs~AfuAulGyeDov@gfA_t@sIcvCio@mdBsvD}gC_qAwe@abE}V{hE|_@siAcOss@irA}j@blB{uApaAvFh_@mz@gMicGuyDpk@gzF|i@{uB`eCsyF`xCocLjcE}eExo@moB`e@cObf@rJ|InlAny@~h@xYdn@nvBgw@bwBe_Dbz@osEz`AstZhpCckRoCa{@}nAanD{{A{cIibA{aBsuFckCsh@czAzsBytCpaB_bIuhA_xGcDg_BjVag@ppAkGhY_SqKwwDp}CcxCnCueBcrAi|BoaDu^_~DfBwgDsU}}BeuBe~DygIovBs^khAu}@ifC_}@{Vi}At\\eeIpvA_bIwFq}BolBwfAokCvoA{`O}zD}|OpUybCgtA}}@xDi^u\\sJ}gClmAqzIdYkeNblDkgA|sArAbxAhh@r@_{BssAw{MaqAyuB{sBec@qXeXIyn@`fAowAfAscHdYojCvcBkkHt|@wtGvq@keChoDwsHbcCobOnsDcdN~Te{GobBofIaHotFbQcc@zkAgX|^_^xPcaDxm@}qBgAks@zv@~~@kIgtCb[om@z`AmEaQ}hBxY}_@fiAaHhmHosR|}AkjI}Uo`QrtA_iZcP}gC~r@qtBaReaBii@mcAcfJe|Hyn@okBnNysFyc@qiDby@acDvoA_hN~^ovB~mFqtB`e@aqAdDepIftCssLgaAmgJdDweBphC{rCv|KquAheDwvE"),
+	country("Italy", "ovrzGyoprAzfUvtp@flKdmLnaOkwGq_BsjJ~kGy_`@|xIbbCxoJtvTvsJtmGnrFyhHkoBuyQzuB}hBjqYhbJf~Ouph@hxTysUzeHhdHmt@~pY_cCgyQmxEhmLotIjpAswObu\\rwDxwH|mCg_E`aKv|\\io@b{CasCytI_kFpbK{iD`zo@dxEmUf{FbjHej@mqMpaKjsKjrA|pZted@brdBk~Ggl@ku@mmO{vJa_LpiBvtGgiFv~Dv~JxwI{iDxtIh_GkiDfy@bjUhhInbJ~lVjbFhPpaKjyIybDy~E{cF~nPkqAidDc_NniXgcC{iDfcCtwFbaAnk@urGnwL_nDqtI?jtQof_@re\\~lPyvNdf@h|@hcE`eP_y@ddAh|F{{HdeMtdBzcF|mUrzBxgTetFdnUfLrvr@iwSpil@}{y@lrMcjm@`ph@mj~@zxPy~i@izB_jGh|LqlRrrsAgyi@bg_AsmTt}e@{oXh{gAku}ApxHib\\zvPycEbs]a}qAlaCgyu@egFqnpB`vCocUheDcqFz~RmtAtla@~dx@xgNlVfcJ}gFzet@_~_C`mWcnyAfzRgyi@zh\\e_f@|d^iu|AfrCpDsVodI|hQg}DnkRgff@bad@sze@bcXykNni^pmS|q^ziC~uBniGidUtut@kjO`{My~Fu~B_cCbmH{pFcoGotN~qOcmN|qAm|DfuHqzGbqyAwmPb|e@ebCojGomEr_BiuCcuQvfBb`Yw}D`sE}h@hxSxlM`zYlwn@vlb@huUhrW`bVyfD`xNlmPtoT`gIriU}}ItrHqem@noJgmKpr[c`|@hbWrpGraMg_EtkVfcCv~Ju~N`nXx~RuvF|~_@buJnng@hyNpwZbcRj|H|xs@exErw\\jlr@tcYpvY~b`@dmJx~F`iHpm@l|y@ckO|tWapE_qCwtHdvE{}HqsCeaNr|A_uNcdb@kz`@w}Ps|MeeAmgRloNs|Mql_@ia@mh\\s{GcmH}sNu`CceKvj@a`FjxJonVp|L}~eArbPi_UxgVgc[jsLoee@zfEymc@li\\?nmPzjObkWe|Eja\\gtGwLkvQlrYhC~rNoeMlqYo~PwcCkyCecKqsFsV}ga@zjT_|QzzSv~Idmm@avBbgJrbKvb[goIn]wxLmaZcwE~LyhTpcb@fm@~wLl{Db}B}zDxrVpiH?}d@txEoyXvcDo{R~dSycW`}KuoSvjXqCn{Wr}Af_EzyDwhAdHxcE}kPjlq@diLpbn@up`@~n\\o}Klxm@jpB|sBkxb@jqc@mrSdyg@u~ZfmLidUjg\\gwG~eSjVlmPm{n@~``@a|Wx~u@_jAph_@xgIjjDc{CtaNoaIxm@|QsuKw{FsvCojOlzDjd@dyD{bc@rcc@__K~mh@w_EaqEyeOrGgzFzqXjoBb~XwtHhhCqrEevFaaWcwDymR|qBiuZtxPgiStkVqcdA|xKgf`@ln[mmDd}ZynKn`Vt`Bd|Cb_CmmDv{D`~Aiy{@hotBjmHr{B_lL~eTmjJ~iaA`~Wzzx@fsTthJdvK`s\\bj[~gS`fDs~AztJrqRbfRrt_A~tAfcg@yn@|pBObaA_kHfyBc}Cj]o`Cq`B{vEsmGupC}|B_dE_bIaoBojCm_HekCykDucFekBgKcwCdaBybBdiCgsBfkAcjE}tAgXd`EbxB``GjIfsDv`Fb~`@oCfzFaIb{@ih@vy@q`BzkAomAjiJ{eHtwS{`AryFykCpnJmOxmA|^dhFqWhrAco@xy@kmDnwAazCtlFmjFr|CkoClbDcxA|TqgFybA}t@}j@_rA}pEgpFh`JanDdkCmaEjR}rAoaAwmDo}HyhGmuEym@evAwcCjeA}}Bqk@cdB}qBew@mnCS_rBxn@m`Fs@wnBkpAdc@c_G||DunEnx@ifC~eAcnBcn@ueBlE{uBfiCwzAhxHmEnkB|j@bsHiTduBsqDzmL}|BvkEyaCdlBy|IgKgrD~f@ssA`cDoM~yEag@z`Aa{A|iAouCjRi}@vdB{uBxlBweAgiAebAapBcwAwwEaIuxEwcBytEdY}yExvAidFdw@y_FmdAojEwe@gKs}@f`A_t@}_@mY}zFyy@wzAkeCokBqu@woA_gAo~E_j@g{EvyA{_DwvDy_DwcB{hDeaB?i|BdbAetDr^anEoiFmbDe`Cg~Aqv@ylAmEcxCdnKkwE`zCkoC|nGmoBn|GwyAdn@kaGda@cwB`|Akq@fKkdEkfB_|Abe@yd@zkAcc@xiEmnCl`Fel@vuFewArgDadC||BabGleAc~EfKweBq`@a{AmnAckA_iGj_@_~A`h@wc@sG{e@ifBoPqBudEylAoqGsiA_}BccBgtAq~CyxAox@{`Au\\wxA~yEkwGt{@ipClZ{~DqAckEqWs{DamEkbSza@qtMap@ggEs{DopJew@ayDsJcbCkdCe`Cyd@sgBbnB}{RbcBy~DhfCs}BjQ{kAa\\y_DpkAkkHgAyjDdX}rCu\\emAc_Cwc@cCs_CyuB_PqyA{bAoq@eyAuqAevNq_BuyBevB{kAw~Hen@g_Aqk@s`@s}@oXadEucDgpGoiEsfCidFlPmvFvwDkoB|fDupBcxA}tAayBgj@afC_i@gnGi}@e`C_xGorH_cBuwFukBobBg~@rAio@d`AinAis@o`BadC__A{hDyr@e{IxTqrDztA{tAdlC{XtoJbQvbIjdBz_DhTlwCqWlcBmcAz`A_rB|hB{nIr}BspD~sKuiKtq@otDfuBkbHzBylByxAesDfLur@ly@{l@rUyn@lE}bH|Ui}A~pC}}CrqCj{AnvC|qDn}E`oCl[yiE`eCoeJr}@uqAniF{hDlbDgvAdkBiI`wF|tAus@mvFr~@wkEpW_{DejDqfEqrEuzCgiB}VufB`fAmdAx~Fwy@zw@upBrr@i~@voAon@qLacCklEuxE`nBafAcZguAe_Dgu@kcEmy@wp@{kA_SykCpLwoAqWowBsrBctEaeMseEapBmz@wmCcmBe`C{~Ea|AygGwuDshCye@koAlGw|E|rAcgDoNs}@hK{f@hrAosA`{@axCap@ouCqkBqkAovByXgoHvU_{BjlAoCbvBwmEuQybAyxB}}A}y@{tCff@_SxxUug@lrB_nFl`Fu{BzjAmnAj}@mcCxc@ipCxDkkU}_@qtBwzAs~Ao~DeDct@ybAb@ebAr~@mwC|JcoAcpDy`Po_@qoGhEmxB`Vq_C`fAi{CvdBkh@tzBqA|gCug@|tAwzAxvCwuFfiBi~@blCcD|AwmEmdAofGgkAmmDovB_qAmnB`oAytDv`GmyB~pAwlCob@w{@en@kzBioDigCen@mdAzl@{sB`zPssAdaBo`ComBqXfu@koAfl@yx@vFqaAcZeuDuHicEioF_yF_gBekCwjS]{_Ddw@mbBpeAiKj_Cfa@xbAwZ`|@w`GfyAmxBn^ouCeMuxEdpAu~L_N_pBybBgvA{yCup@i`DqCmwBdn@sjAlaCuRdiGmyA~oBovBhs@mz@u\\kk@ybAyhAa{@{~CwFex@eXsm@}hB_nAsjAauAnN}yApk@_|AtJmwCclDm`Gqv@zoByiEvRabGm_CaiVlPg_BjcE_}Md~@w{@fj@j]dkB|fDvu@md@}Y_fEtuA{zF_TesFbdBwaF_}@{xJ`f@sbIqGcxAc~@evAwcDekCmy@ayBewBaHs_EqtByzE{`A{kAur@orGksKyl@wnBg_ByoLaq@}tLf`@{}E|_AmaErEe~D}|D}cGwe@guB|PylB`eAylB`\\clBaaAulHoHqsCnm@ukIxEctEteBeiEj]}sBoJkgA_bBy~D_^qzGgtBwvEeaBohGe`AyrGqMw|Lm`F_xRcu@mqKpa@irCvcDzBdzBbdCjoCnyJnlBz`A~|@ih@rvEg`At_C_sAdx@Th}@tzAp{@}yGhvBknCtTgtAfE}eGtm@yaBrgCox@zkA}@x_Dnx@`zBqLrt@s~Axm@icG`m@_gBvgC{u@pgEguBnbCkwEjfA{|F~g@crIt{BogHyYkfMrTwsHl~AgfFw_@_oEvo@sqEt~CgbJngAavl@vpAg_OlgDilKnc@wbCtG}zFy|A{{Rb`@gpGgRc|J~fBqrDxEohGphDmsVoEy~Dd|A~IzfAby@", "kt{jGaszjAc{CgpHqxKeWntEt{OftCr}@"),
+	country("Italy", "ooy_F_tfhAx{G_dIdqHtaCgxLdsS{xDgcC"),
+	country("Italy", "o~whFc}w}Ah_FujVvwFjjNnvZhpLxd~@nbw@`uIyk@~cZn_JxrPtbMbz[yiAjaMu~[htC_y@ltAh_GkxCr{AtgFhhCpeIomEsTiaEx{FbEhiB_aJ`iI`yB|dFeoHfaPl~`@djWhqKdhWwzFt}Bh{FcjHd}OemDflWpbEvxRmjQ`ix@yr\\zbQwiRfrUoiLdx\\lfAphl@}wL|za@k}Rps[c}ChwUapQnba@efQphS{e@bz[ouMjjObTpiv@}}Or|NmsAp~L}tX|mNg`DqlFixe@mmDc_GwpUuoIwpI`a@mfGe_O{hDtlUe|OxvHgdOkyIoh^ukJth@slH{_HnvAiwSouGgtT`jHeoIbrLqxB_m@mzVnrIqrEjxIogSziDygZoxM{zk@buEgjp@{uDyqm@}fF__WyzMqjR}zDiz`@prJeib@onDonP_eTitJhhHkr@x~@maH"),
+	country("Italy", "ylqiFobjzAjxIk_G|sAd_CeiEbpF"),
+	country("Italy", "cmyiFit~yAumHyiBtxEwsIle@xeDtmDmtA"),
+	country("Italy", "kbhjFq~uyAclCjqLoz@ghM"),
+	country("Italy", "upnmFkprr@rV_nDtaTzmMkpYvmKi^}fR"),
+	country("Italy", "e}enFofuq@tiP?_Ol~HoyKryC"),
+	country("Italy", "aplwFeqbtAmtAbtQoeHmtA|iD}bS"),
+	country("Italy", "__ryFedoq@vlK~oDoLzdFsmTeiL}~BcmHh_Kue@"),
+	country("Italy", "}gpvFqpwz@ziDstEbnMhbDrqd@l_a@pcV_qDdzJgdNf~VfxGdjDu}DdvF~oEfcoAtuFx`XpjG~mJmjEvnJ`bLjjPbFfvG`jHkxU~ek@ei@`yMx~FhyHc_Nru\\rtF_{KjiNp_K~oQieDt|I~vBd|Uxt`@gtInuYtiLnwMe_DuJ_x@pkGcnHu`B{oAf|EexQd_C_sCb_NotZdnToqNyqJk`NdjJcmHy`EgiGlpBc|Sc}Mceb@`xBt|I}wVs~Bn~J}rXk|Hs_HjeEk~AlxJl}IzkDgtIj~FskTimAggAryDopDezPogGwdCumJheDeeXknAysJ`rPsyUde@uvWhgPryC~tM_dHlnCtnIjdE{vDnyA{fEth@w{K_bJojFvlKcx\\s|NgcHzbFo}EowArF{_FxfI}XprJs`N~rCiah@goVo~y@_ba@{vb@a`E_sYirPquDizBisJteLaiHcfDwj@bfD?nm@aiHquCwLh`Eu_PriPe_CwqIybDj|BqtPtmT`iHe`CibErjDa{@vi@smTti@|sMv{JveBxp@baLdf@s|YjiD|mDzmHsmTpxG~{Kv{F{~G"),
+	country("Italy", "}suaG_boaAzgLyjDehHj_K"),
+	country("Italy", "agodGavv~@thJlrAnuCh`G|lCyaFjfE~z@u|IfdNzvDlcTihC`_WysIybD|d@uzh@}dLkuK"),
+	country("Italy", "idzeGs{`{@hzFuKdjB||FgmJ_a@"),
+	country("Italy", "cgctGkokjAonD}fFrnB}cG}i@xtIzpMhuI"),
+	country("Italy", "aomjFc{}wA`wB_z@}XprE}|C~w@"),
+	country("Italy", "}}hkF_ckoA|v@_oDtsAtqG"),
+	country("Italy", "ic{kFud}{A|gFp_A}bBf}D"),
+	country("Italy", "}nvwE_e`lAd_CraAmjD`rO"),
+	country("Italy", "m|tzFkpyx@dkK``A{qA|iD"),
+	country("Italy", "_vrzFg~px@y^rhJmjEk`G"),
+	country("Italy", "qn~bGy_r|@tpCrc@gz@x|E{fEcuD"),
+	country("Italy", "qtmtGqdgjAd`CkhEzr@zaG"),
+	country("Switzerland", "quc_H__|x@j|Ri{CxrG|{EzfAsiO}Zo_CbT}sBwZofIxIsgBn}Fy{c@ffAhIv_@cxApuHj|@ry@kIdlAmnAjpA_zCvrCq{Sn|CspFzv@ykCjz@_mGlBe`Co^o`Dc~AqoGy~@__AqrDsr@slB_~A}KkdBxTmxB_DuzCwsBgBmnCelBuzBq|CgfAqtDvMueBb_EabEd`DakGlhBs`@jsEplAjdC{MfhE|_@z~Alz@voBhgC~{AuJ|yAqk@`uAoN~mArjArm@|hBdx@dXz~CvFxhA`{@jk@xbAlz@t\\nvBis@lyA_pBtReiGrjAmaClwBen@h`DpCzyCtp@xbBfvA~M~oBepAt~LbDvhHmUleAgyAlxBa|@v`GybAvZk_Cga@qeAhKew@lbB\\z_DdkCvjS~xF~fBhcEhoFduDtHpaAbZxx@wFjoAgl@pXgu@n`CnmBrsAeaBzsBazPldA{l@hgCdn@jzBhoDv{@dn@vlCnb@lyB_qAxtDw`GlnBaoAnvB~pAfkAlmDldAnfG}AvmEclCbDgiBh~@yvCvuF}tAvzA}gCtg@uzBpAwdBjh@afAh{CaVp_CiElxBn_@poGbpDx`P}JboAs~@lwCc@dbAbt@xbAn~DdDvzAr~A|_@ptByDjkUyc@hpCk}@lcC{jAlnAm`Ft{BmrB~mFyxUtg@gf@~R|y@ztCxxB|}AtQxbAcvBvmEklAnCwU~zBxXfoHpkAnvBnuCpkB`xC`p@nsAa{@zf@irAr}@iKbgDnNv|E}rAjoAmGrhCxe@xgGvuDz~E`|AbmBd`Clz@vmCreE`pBbtE`eMnwBrrBvoApWxkCqLzkA~Rly@vp@fu@jcEfuAd_D`fAbZtxEanB`cCjlEnn@pLh~@woAtpBsr@vy@{w@ldAy~FtfBafAfiB|VprEtzCdjDpfEqW~zDs~@vkEts@lvFawF}tAekBhImbDfvAoiFzhDs}@tqAaeCneJm[xiEo}EaoCovC}qDsqCk{A_qC|}C}Uh}AmE|bHsUxn@my@zl@gLtr@xxAdsD{BxlBguBjbHuq@ntD_tKtiKs}BrpD}hBznI{`A~qBmcBlcAmwCpW{_DiTwbIkdBuoJcQelCzX{tAztAyTprDxr@d{I~~@zhDn`B`dChnAhs@ho@e`Af~@sAtkBnbB~bBtwF~wGnrHh}@d`C~h@fnGfj@`fC|tA`yBtpBbxAjoB}fDlvFwwDhdFmPniErfCtcDfpGnX`dEr`@r}@f_Apk@v~Hdn@dvBzkAveAbmAve@rgBvdAbzLnq@dyApyAzbAxuB~ObCr_Cb_Cvc@t\\dmAeX|rCfAxjDqkAjkH`\\x_DkQzkAifCr}BccBx~DcnB|{Rxd@rgBjdCd`CrJbbCdw@`yDr{DnpJ`p@fgE{a@ptM`mEjbSpWr{DpAbkEmZz~Du{@hpCklGrbIutJxjD{yIndMsj@`mC`gAvoC}a@hqBidDqLa}@~IdElPwyBwyByn@mEg_Ap~AgkAjpN}gBhu@cuEox@{~EqkBwiHq|EqhDbmC}aDp|EwmCnvBegAq`@udCwcDugBqk@ouBpLc}@jy@e_@`}AoeDdb\\eHr_Lzu@noKp{FzsOzb@ziErEjlEbj@n_EpxBxiE~}G`uHftAr`@bhHsqC`q@wzAg`@sgBfL}j@`oCi_@nuBn`DxvDznKhbG`~HjgAxiE{`ApsEvFz{Gxm@pzGh}@nuCg{FouCg_BlmBqtAf`AgjAgVmaEcnO|JyuBs_@ylDsh@cZi|BrA_pDqk@aiJ{}EcdAjGs}AfkAocAtzAgnCdpImn@ufAeh@sJelBlPev@l[ceAqW}s@afAw`GuxEo_E{{EssBbvCiqAjR}jA}rAupOmgYahDs~LwbDwaFqjAinEo}AigCyr@{VmhBpAcdBz_BuhFejBopBemAquAiIcdA~RkdDdlBgzEa|AewB}mHuoCm_TexE}_L_lCwmC}rB|iAibB_sAqf@yy@me@}{Cwf@gVsi@apB{iBvFqk@izDmaGqxKudCyuBm~BotD_sEmgLazCdXe_C_g@kqAqtB{LmyAyrAczAo~@}zDscAmo@m~@d`AilBtyDrd@~qBtp@nfV}nA_^u`BolA_qAk{Ai_A{_D{tBuH{WacDo|AmaEss@cn@{_D|}AqdAnNst@yjD{Hew@lf@upB~NeaD{c@ivHt|@_wHsJ{hDhpDtpB`eBqb@fv@sfC`f@mvDbz@ocAzLmo@qjAmuGqVidDpKcqLmn@wlDolBkzBmy@_Sgb@hI_rAtqAme@RoM_hAdaA_bG}cAqiBaa@aHey@~q@{k@dDeNcvCub@cZo}@|@yx@bkCcvCqoGqw@{tCwxB}|O_IolCn{C`qAlj@naA_@lbDrrB}aIlKshCchAsgOgl@qtB{gEetEj]yvAlOcrKxYae@vnCcp@?glXsi@_xG}Zu{@_cB}hBkkAuyDaVkqBgjA}hBme@epII_pBve@oiFprCcnBxdAkiHp@{nK}To_EsgCwoAqtAe~D}KizD`|@g_B`mCmnCaR}rAgo@ug@{dCsJiiAvZqa@eMgUuqAlo@uHzLug@asCqb@oh@dsFza@pnH|hBroGfBbnBicCj`HqZd`CubAag@_bB~h@em@cFin@k|@scBw_F_|Ben@o`CupBuRap@}j@iuKi_@i}AoaBby@me@obBxDszCfb@srBdiEod@x]k|@ga@olAggCi_@ag@yw@`QwoArvDuzCfGabEb~@kh@zsCxwBp{AekAhwAyZeBaiIse@~q@qyBew@dD}fDfoAckEdfBo`D`Q~qBlj@Ux}@_|ApzAotDbZcxCsNysFcp@kbF_hAmbBs^guB|s@}wT?sxIhxAssACyhF|d@{qFbqUeqt@xrCum@r{DwwD~fAqgF|iB}qDxgGdD~}Av{@xnEdqFbpEhtAvmDbtEv}IfoH"),
+	country("Netherlands", "ujuxHuj|XarJdow@||HhvItuAlsMejK`zYy]jc]e}OjgPcoHqcU_dByww@tbGwtIb{BrtE|rByd[~rO}jPmK{ya@uyJr_BifDtma@cxI|cSk~BmpNj~BigZ}qHnlQicE`Ac}AypKybEtqSacQ~qPvfCtmTulD|x@{jDy`i@koFz{GmsSteB{nB{sWir_AipfAyf`@{iPk`yAg_\\?s}LplFxm@d_DiqKywHe|f@}~Z{xy@acWgiL}~Nwc[mdSy_kAfk@_r_Ai`K}wpAlaBcjVzdQk|Ht_DckHveBwkX?wi@vi@?``Fn\\pfCk`SNeeAvtGoW|kE`}@~zD~fBviAqC|}BolAn~Lcy@|~EtHhfEnx@fpErfCzgNz_O`}DvnB|oEfu@taTdy@h{Exl@tdCtpBuaAd|H|ZbsHa|@jjIxD~oQpf@fpEj`Bh{El`Ais@zjBtg@~r@}@~pCuwF`jBzyIrDhfB`aB{kAt|E_^d|Aae@zvAkzBfp@wcDvi@ymNrjA}aIpPkoDua@k_I{uAigCtyJo{Jt_DyvAxbF|s@|oGpiDnqBzBpdCqv@fz@is@b_@lP~fBvtG|aExtEbcAt{BjgA`kGhmAlxBvlDfiClk@xmA`[~sKpa@bcB~bA|hBjyCjeCri@~cEb`Afj@f~A{KrpEucSxfCy`EnuBuH|y@naCf_DvmCjjBjpCtw@dhD|IhhPxbC`mRhqClrH`\\jfB?f~BohAnoK~kCar@h}@zXpg@liFk`BvQuc@ldBsUrnFc|BbmCvUvuFwt@frE}cBzgE}y@nhEjfApsEh{DwuH~pA}rAogAzaMni@n}FhxA~yE|^vwBb[d~Ftl@~fBtv@hTtbAcmC|wBirAh}B~IvtAziCdbAbD`LorF`vAgrEtf@_}@~oD~rAtpBmnCxrBafLbdFpiBvkAcZzcJ__LxtD}zFb}H}iAr_GleAbrBjGpeAi}A|u@dc@|gCjRxlAr`@`v@~|@tlBb_F|qJj_IzhDvbEn{Brt@pjC`QlrBqk@jr@sqCqeCkvH`^knCtzAn_E`lAcbCrlA~{ArxB|bJn|GbaQ`|@|rA`~CdwBht@zkAvF|qB_lBr|Cu]puAby@`nDhqCob@vrDg_Bp|CoE{kA_kIth@syFcPe_Fr_@{KrgB~|@zjCeXvzAabEzk@ckElcBxw@p_C}s@vp@|@f_A`e@~s@xdAyFxkC`^ztAr}@|u@lnBkRfiA|_@ht@vxAeN~}AboAoYztBouCjpApLzM||BkHndMa[njCtG||O{tBz~DeNt}@bZnv@j|AdaBK`dCih@tpBmnCq_AshA_Jmz@pgFi}@xkCg~AldB{jCpLw}H{aMaq@mmDmiHicG}VxwB|VnjCkwFcuFmwCyaBugArsAmnCom@yRar@jf@_hAog@cn@eaCwoAmxAri@y@sfCog@ywB}~AbmCkfAaSwu@yvAulB`fAiDpiBt]z~Dak@hrAkpBnaCme@`eBf\\|_MmnBnsEebA~`JukAvmCcmC~RcxBpk@}}ArrBey@rqCuH~eClxCt`Kd]laCoRrbV|c@xjFqPxlBq}EdBovAxlB|DfdJmd@zjBahFf~BetC`vEw{@`g@ifC}T}bBwdA_gB_UoqCvyBu{AvkEnNf_Brd@nd@~eCp_Ad~ErpFb_Bp|CfV~eC_xArcJkHhtL{b@jcCajAob@mEmeApnAqoGskBdl@iwDoaAi}ApL}s@p_Acj@tgBs_@bkEjaA~lEfrJdbN`q@psC`C|_Bo^pfExN|eGst@nvB_|@sJ{lA_t@mgBkGes@vp@aHxmA`RvwDdlAfnIjvBxeIxr@qjAj|Art@f~@nCz_Ds{Dj|@ce@tjB`^pf@xuBwJhbH{eB|yI}BltDw{AfjA"),
+	country("Netherlands", "urwxHgvlTjsKgb~@gqDkxV{pF_pDl~Hc|YggBgdNfq@ahCxhFtsAvqEbuF|wC`uHxsFneWhkCz{Gf[bkCxDrbVmTnlAmdA`|AueC?{pAh_@wo@duBm~Cd{ViOn~Cxy@|qQfk@ncAdgArJbnAeObz@bQ`\\xfHwMrfE{u@f|DogBxvC}{CnwAukHk]uoF`u@"),
+	country("Netherlands", "shsiAbqi_LnpCqeCd|Or~AltAppEloIueAjhDxeDw_b@bmH_qItlUkjIy}F"),
+	country("Netherlands", "c~}iBbco_KnzFmdI`aChtA"),
+	country("Netherlands", "s{mzH}ksWjeEegKv_EjuVq{Mz}_@vi@~|VeiEgP_pCwjWh~@cy["),
+	country("Netherlands", "}fdcIuqz\\|e@e_CfrDb|BxxMz_R_oDxdPkb^qs[~i@w|E"),
+	country("Netherlands", "quycIg|w\\ynLk_T{yBqbV"),
+	country("Netherlands", "yrbeIohb`@epCsq^vxGjxU|{Iryq@c`Bh}F"),
+	country("Netherlands", "o}ieIoesa@ti@wxu@p`Etyt@_sB`kG"),
+	country("Netherlands", "k}qeIof|d@vi@oxWrmHxbg@gnDr`A"),
+	country("Portugal", "us|aFtfml@|zEpaWtm[`}o@c_Bto`@|iD_yAkxRtof@p`@~uq@w`GfrZptUvmfA}sA~bIwllAwob@a}Ua_CqvK|`Ei}QciDonEoeHhp@f`Js}a@iWu{HtqP{kTymNekW_aGws`@d`CozVdy[rxIic[fm@qmd@gnEngXwwLhEokGbaFzdF|uFveH{wCy`E||Uh_Mh{ZjhCrpb@ooIu|FylN_f@cgUpmLm`Bw_TtiCchFe_Cre@exQsm`@k|YxmLg{R_jLwbOcxa@fcJ`j`@pbh@lb_@htUpkAj_Blv`@tpDpcFygExg\\s}Sf`DgwVcrMadR|uA{w_@cwOgfVbSooJ`xIw`Eamd@}vExkA{`ZsfZgwRctBkxuAcwf@be@yfOs~GxwTq_FxiA}}tAoj]`gCks@emDitIafDle@e_CatI}yDzhCycEuwPrq@tuQc`RmqArmd@btQcpaAcuQs`^ei@}h@|hFqrPd`IalqAjfQ_zHufYbmB|e_@ef]|f@esG_zL_rDqrEmfFyiAm{AerEoc@{_Dy~@{~BsdE_qBy}@gu@sz@giBcT_qIkb@{qBimBavEq`@qtBisBkd]ci@_hBifCawE}JkyC{a@uqBicBizDdzCuoBzkG`RldB_Trm@onAcy@ulEoH{`Cvd@yvBbdAg~A`|Awq@~kBfMrmAtaAnuCxwGvdChuD|iGbpFtoBlz@lsBjHpnBweAl`Ay~Efp@en@xqAiIx`DbYzWayOk{@uwGk~CklEcgC}hC{]ywAjIuwEgpAyy@koDwz@ob@azBl|Imz@HseEwnBi}AiOq`AvFghDgeAqbK}bAunDvQ{`BjdCqyGxIgoIncEp_Bxy@{Bli@wcBfGmmB}o@ucGuq@s}Aal@s_@}nAaRe]om@xlAkaHdrAaoAtkBa{@hbBoYyuDehFyi@isA_TaqLiqBwzL}~AclEikCo`CcbBgMkiEjg@kvAqLqzAmlC_m@ylB_JyxAb}AehEvyBg}DhbAucEygA{|EmnAiqCfu@wmCrxAmwCrYanDaWqcKwcAgsCkyDkf@jX{}DjfGacF~h@_zD}La|Lgp@esEodCirA|n@cwCfjAisAjfBeXxlG`r@|j@mp@xs@}oGvtB`gAr}NlwCthDzjBxeDoxAjmFwo@~bB}tAzp@unDqL{_AgoAgrDkaAssAzoA_pQbsAgpH~gCiwGhoEqfGzxCyuCvhIbiHf_Cv`Dh~@vf@tcCkQx~G~lFrt@R|IbbEriArfD|AwQvd@{BjrArU?p~BfoFjcEtzCzgExvBlkEnvCxeLjRrfO|xHpeH`oCjqA`nCr{ExmA`pAfk@pVjyCoNtpBtr@vtGjvFf_AftA|LpiDeYt_K~q@lbCptBnW`qAzaAxmA}K~cFk`GlnB{vAr`K_zD~r@ir@vQu|Anc@ccAtqAa^vmAzXzW`eBf}CtG|iBk[xaCdb@bxB?duNanCjzDiKfiB|j@niEtoCd`Bnd@dlB}KllEq|DzjBqa@pjAlPf}C|pChzEhrBxjEoc@xsD_pCdvCgqEnuCnlB|dF~jJh|D`p@~{@~qBfL~jJbmDv|Jby@dw@toCj|@~gAht@jzAur@dtE}k@|sAgiAdb@gpFp`AaeCvlFy~Eb{IgzF|hCnjBnaAl\\zbAsJfqE~{@xvAdaBz`CsTf{Exc@ntDh|ChhAniEt^vo@rsAfl@~oDgl@p{E|}BrqCgV`eC~fAt\\lzAnwAnv[wEvaEs_AtfCdYziBeEhxFvfAfoHwp@bdi@vxOabGlnAkoBn_EayDnlAsgBhtAgrE|t@}t@|gCmz@j{@g~Alp@wcCfu@qeGh}@em@jdCRxmCr_@fqDvpAfiCgB`dCw\\rhAem@tgAqtBnfH_uHjqBfsAbbC`Hh|Bco@x`BevApbAazCxwAk_InvBqkAf~@fLbyBncAhs@wGfq@g_Atp@_zDo|Ac`FoMwcDdb@abD`lAaoCtjAmcBboBqvAjyBkf@|iBfj@`k@kfAtw@_If{LfpHnvCxjCtvAteCpyBquAd`Bt^hxAmFbgCvjEre@bdBjp@l_H|f@`{@fz@za@hmArsAbcAhgB|[h}ApkFdoJrvCftAbcJpv@dpBqK`f@u]vg@emA`o@kRvuA|`@hhHpdGzyH`pBhhAj|@`qA_gAhgAvEfoCduCbgC{eG`|SetSp}C}|An|BeuDjzN}zFdkB}pCuW}{Fgu@cwCyrA{kA~Hsr@af@yaDuJkmDlz@kgBh~MvcEr|DpsBf|Dpu@bmAxy@tvCjr@tMp}CirBr`JmGfkBtn@pu@~`C~|@j{AlnBtq@p~Cc_@tlF~b@dbA~^reEbd@|i@plDoXrmB|_@tbH|gCbtEbdAvqFdkDd~Jp}P|~BlmB|zDrsAziKzvAt}D~nEd^`z@|cAiJ|y@tS`uApuAl`AqBlEaa@pcDor@beA{w@dtC{|DvsBgk@daHgv@leAzLlaE{jBjoNcn@blEu|@|}Arh@blCi]pqGq|A`lAcq@fKse@re@?xm@fm@s~@hgB"),
+	country("Portugal", "ubofEh`neBniGnpJyRdeXkkN`yf@khPr}LysJccLbvJuyUchDm}[p_Lg{VtbB}jW"),
+	country("Portugal", "onwhEvkvbBzkB|kJ{zHedF}qBmdMzmH}tA"),
+	country("Portugal", "ew}`Fnz~wCz}HwvHz`Fp~@_hApvZutGljE"),
+	country("Portugal", "qkbfFlmxxC~eGufKtfLpfCxiHfccAghF~we@smTfi]odIuoB{a@s_NvyKixMddBakY"),
+	country("Portugal", "gbtiFhcqjDvvA{dKxsEfk@om@zqXfpD~`JyqEhvIhb@fx^ikNnmPurG?{uDwlKxl@gsT"),
+	country("Portugal", "wpqjFxyrmDfvGmtApkBhjHbpAm_Cxm@vfSmoO~oUc}Gq~V"),
+	country("Portugal", "m{rjFrmfiDdgFk{TzoBmqBpm@`_FcnH~nm@{vI|~ZknTh{Y"),
+	country("Portugal", "mjdkFxj|cDrfK`nLa~@neZqmG|gWmpMzrFevGkgQnm@aa]vaIyzR"),
+	country("Portugal", "occmFtoxiDgcCpgN__Koi@wi@qiH|`IktK"),
+	country("Portugal", "yocpFnhn}Dv`D{bLhtJgx@bdLj|GoNlcNgrOnbDiqKc|C"),
+	country("Portugal", "cmnqFbr||D|yEufFlvE|_I_gG~bC"),
+	country("Mexico", "wss|Ct_apQ|pSoMdvj@nyQlo}@zpe@`r`C|qd@xuiC`lMxjBn|Q`xBejK|zU|Ia_WsiDv|EedAhr|Aj`H`gw@wbDf~VtfAcmMPfzb@dnOn@hoFr~FifCh_Sp_FpOujEi}K{gD`mWnsBfutAwmPpxq@mi\\rtt@}mx@fdw@z~Rub_@dnIuxEk|NktKe_Cwdq@vwp@oaU`rEaj_@paVztVaeJlq|@kcK~m\\ktQn]udM~sNhm@`irByoy@b|HwwBd_Cz~Bn}PqiHjp~Awt{Armw@i}i@ty_AuwQftIguGjf\\e|Cj~Sa~XcbA}}Ctv]wwLt}BumOdma@akM|~Nmm\\ptE?e{Qrs^be@lrGpjSyq\\q@dtLp}E}|CmiDebN|oMs`d@gtAggBamMdg^gjGvZnhNqc\\ffBwzkA~pZik_@ndIcjm@b|}@{fg@b|GagUomKmz_A_gRwkt@huH`hBebBi~QegLwhG_Z_n[a}LimAbbRnsq@wsTebw@mtAymlAtwKd`CefAiuIrmHvi@ydIwyFq`FtrHmgFu`v@ksWsbZnx^yiHgxSwi@wqN`qEmyIaxw@a}BwgxAzpLwbPlqCle@~ZdzKw}ElnBnfBriJfvBklBgm@rmHftIntAcEcaR~dGaaL{ePl_OzpA_jLt~BrpAlxEk_G?d_CjtEoiLdkJjtEduGk_HsoAahC}pHr}BntAi_GitI`fDx~FqtUmtAsxa@llD{lBqaKyPjiDjxIy~FuxEyf[{{}@lmDbxUy{SanEazGdeFv{@`sK}gG`iHyb[ita@lzOza]|uGlqC`~@buPutbA{gnBicb@_vd@a|fAuvFoyLanFakZ_nc@m{IgvAwxRhbF_zwAq_Aulb@w}DszT_uNsh[wxFpr\\noJpdDlrGqmj@y`Qy_R}i\\ah`@iwoAygNkobCsuQ}`oAkgO{xU_oEc~Y|qBag_@ujE}se@z_Blj\\}fGesSzmN_~pA{mB~l]rqGgbPtdAkf\\quCpcKrRcaM|bC{wU`wB{iDvgAlpBdmDw`m@c`GoxRc{NyjB~yE~aVaeDpxN~xGjtKyxCtbAgzEc_Gjs@ipRsfKak^fdC_iLvv^}jWjf@cuPfsk@soAdaHyuCcc@msHvj{@|bY~`m@xod@lvW`v_@f`t@|de@zt\\`~Fxbb@}fFrnSb|GefXi_FepHz_Gt|Oc`AmtAk{Bd{O~wLbWxtIvg_@faPowH_l@njApyNx{Lo{@tkEa}LbcKltAccKyiTfwAy`WioFu`Bf[neSli@u~NywIgj@tkJ}kDf}Vh|G`jIj|Mbb@``Fk_GctE|fEbyNflQrjJpnL`jAfeCkqFozQe_Oy_Ai}Lh_Gz~B{iDixIhwOddM|ayArbXrxV|sMjbi@l~Chs\\zkNbyNpRkmH`xAx|AnpDq`RyeC~{Eb{BsmHue@irJ||IalGW{yIjzZald@owMkbc@btEzg[zwPutF~l@vhM~aU}fGezJxbK`n@aiCkqGlmg@p{Xhj@`fg@v|D`pF~ui@hlPneJtpNzvTtzDria@ng[scT|wa@hjJ`lShwW`h@dlA~ndJvljBxEdvAt~vAvhJuy^nct@szj@fqEsaKmwCwkFlwCrtAtlFkvHb`EezVjz\\wfXzxKgBi_@}oEbfK`yAhhCw`FvvClbC}i@qmPvrJggL~fAwxNrcIe}ARxnFz}EssAtoChzFf_Cu_EzrDrzGbmC_{ChoDjaE~wGquFt`HnoEboB}hC~nD~lHv{@d}sFtayCna{Avve@gzYbeNpuNpqQzAjxKbfFvs\\{_EleYbwKkoqBf}sBk`Hj`BloCisJ~|KqnGykNv`E{yBjqQkpMrjV{_k@~gi@u_cArcxAum`@|bw@gxAomPqoKlrG_dIzha@mrN~pIxa@|bZygOp{XnxM~iFt}Nqd[gl@khDqpEpxMziDqxYtt]e|m@ceb@zsrAihCvn_Ao~DcaAydIejWkrGmf@ohFllPvvSh`Wa_GfxAqqD}wEwzRdoNb_S`_W?tkJzjJt}DffBar^irEwgL|bGlxQti@_kWhoFapFipAvdX~lIp{`AfkN`mVtiSrzNtx[vreBrf[v`u@kmAr~d@feFrkWsjMvmw@{uf@teqAcoGx}aBodh@~niAguBloBti@_gSq}Tjr}Am}m@hwj@d~B`lX__YlgzAa|Dlmw@ubRp`e@_e@_kCuqAb`Gq`FqsBrvC~yIacOjfNsfdAzmoE_oFuW{mTxyWgfUnlt@gcJxM}qOtkc@{`MfjDmh\\xdZaoOr|a@kn@frTr}KlrPuocAxucFs_LvuYk_^j~MoiRvuXmiK_h@yaj@vsu@ww[`ucA{nGkgEm_H~tIj|DrsOyt[nlx@lWf|KwuL|It`BjmNeiK~aU}rSz|MacU`mBioS`z^qmfAztt@gkc@hoEqpQj{QyhQ|kGexNi`WoaD{eo@ksLyrOqgIky@ucVd}S~_CrpLctEldYwnDwkJixl@kpa@k|D}uMi_[aqBo{YbhD_vRqjJ{nMtjE~Vb~Ge~Uvbc@ml~@~{b@ouy@hmCeuv@zbNvi@d|C_hS~hGsac@bjf@ypEklBpeD`jHoij@fxc@gxXtk\\cFnoJamj@jdQa|j@bys@gzh@xfTmeYtcY}fcB|vrCatDgNf~a@e_x@ktEhxI|~BixIohGszBmxVl`IlJlhKt_CmzEj_Gh_LqlGxgItx@|eGkaSxzR{oGxuUnfSmbSgbUbo^{}YzuK`wD{{N}qNjuE{wUumDakHvkKfz^in@b~AtaH_gw@l|m@fxAq`KvpUgeOv[ahIaiGtJyQf`E}iFieDziJscWswDg{@g~P`tUx|@rlIisJxxGzUdcYugFhTb_Ci_GegGhSk~FtqGtfWnkFymLiRmbHbrFwbOl}a@q{A~_Vvi@a|YafHzdQzlBpiN{lBdvAwm@gvHspFpiBffAtkEk{ScbAk|B~zWvkKegA||DvwGmiFhiCppFxdOg{Lb|NcmHukJfyFebNgdTmlOyr\\ukJvhStgK|pTxn^~i@boLmwKtxF}hFjlNtgXmhQc`M`{LklBjub@kpAcmT}`ImtAm~HzxP~jLthE}zKmXstEjuEuqk@ol@edv@cl`@duXfoEd[{lCsja@_~IxmAqhIhmLrpAz~GueLimM}bC{ePdxQb{BgxApvCroNa}^de@ejZ~sXesGztQv_A}qImyLdvI_v@n{GdvE~sC~iH_lJmjD`pTfrBdpP}`GlrPs|Nh_Mwhe@zlPd~IgdKygV|qE_pHbrHmt@hgJh~EroF{_L`_k@uqWrh^qcBhpMggAk{QuzPtmM|bBl_Ieor@`{MwzFwpJ?f|E_nE{t@apDmbHoz@l`IscMp}@szB{gParDqo@gpExq`A_cCy~Fa`Kv~DvnP~bIx}@chDl}HrlCqmW~`UhrD`tSo}j@rmk@sg_@ngVg_KetAmgMxws@}dWreIku`@xzc@_vC{vIhaEsiDumIjFxGzaJetJ~pSuvQduMmy@b|Js_]kA}gLt{JmqKq`ByeKbnD_~A|pMnsEfvBelHjgNgpZepEujl@fpf@sab@|lPioAd_Nixt@lj@keMd{Ic`A~bIkuXw\\urg@tdc@myf@jbPyaYwcEsfV~pEjwMqiHot_@mvE_|J|hLgI|qDzuDexCwzEjzMwgK`_F~{@hlGlfF}vEcnOdkiAwq`@dhFe{Ub_k@fxAntIsfKpmF`|MbT~sB}zD~|MbfNs`Ctcb@_po@zkhAy\\h}RopIjxUgq\\|bk@d~IklBd`Uwpa@~o[_sIrfXvbMf`fAp~Er`UauMl|Jzr@zwLwvTp|u@{iArxZegLrz{@rxDr`i@yyWx~OahW`cRofB|dGurLq`EmeGpiDiaMfjyA_wnBdwDyqAtzGrhEjm]siVrhFflDl|VeqFeh@shHqkHbHhg@svP`gZuoE_xFakQjkHeqKtcw@udQfqHixRozBokRv}FckCsg@gqEjv]l`DzbPmvObwMd}CncSqlAzyBwrFrcYjoA~|g@afa@r{Sson@bn_@seJhqX}zR|vFjjB|iL}dRlpH_a_@jlUl`Ldk\\gr[tsG}x@voLrpFf{Z}rUvgE}mHsfG{oJo|Xf|Ysj_@deFmVa_Jjxc@}{l@h{Yl\\|{Fy|VtcRleDhnPauMdeNtVva]ivM~dYzmFbwWuuDx{CmsFoo@tqCb~Fur@zuIe`OnkT}nEdoO_gLd}A_mSrhLhe@`n]qxPx|[iyDjmT{rP|aCw_J~fX_mMfzMcgDzpW~~KhvWb}AhhPe`IrqSm`@h{Sw`MjiJkeRf|B}kc@raDtrGqtCniKnuKm`BsN{|IguSivJyqFp|EufMuVayAcoR|rPauJlkQak_@d~T{wBhiBo~IipEocSr}FizCxkOvrEzgZ_cZt}XgLzpT{qk@n|^}rIvp\\buCjnUxiN|kQfne@|nGrqBjcUb__@geGrxXwxRbzLahz@nmLs}i@rjZmtd@~laAiigAdrkAg_j@r`nA|sEkpOot\\|`m@qc[phOh|HlzBacAhkS~aE}aGpy@|~Hyz[``F{tPfbQxAerDopXnsQxmXkcG}eA|lJgqOx_Ah~Ojm@m{BdxGetRbaFshDksEcfRvh@noW`kHarNlaCanOghG_cYjl@}|OuhI{dc@t_@cxD_fIgm@lnIotAwgCkck@~tBhhi@nlC_uu@z[}k`@|uLkvOjsJysD|uLgdTdxEacK~pJ}lC`yHnmDhzG_|Kp`KifCdfXk~t@nqgAi~H_V}~SrxOibFauCfuHv{QofGrjCanRydU{jOfcCr{CncHn{P~gA`sD|nIbbMpmAodIxcf@ltOz`Mqf@pkQkpMlePcb\\zxPanGlgQb|ArrQc_XhiTstEleL{x@v~PnqCn{Be}HbqZojEk`@iuDzgJoy_@fuByzLhrPkrGf{Ym|NzgHs_G`kQhSkgDgsHfnA``D`nFa{M|~VgsTb}Jqx@omD|yDgcGv~I_scB}sRmub@|bZyrEp|KmtXtfLakHm_I{xIwgFjiD|wE{vVwlK}wEg~DxySk_IxrEpyNgm@cbAtlPqsObsEueM}pGe~IrjCbqGz_Wkub@{vZt}FqjBhpFjqEz}MwtGukHo{AidHbxDxiDkkImgE{oDyiDpuJmwK`sAwwOuzI_|Fl`C_w@vbH}qf@iaOisJf_DclHj}P}zPl{A{Ed|Q_iFlUwvEj~Lgz[jcMu{HheJw_@v|Kq~G|qBv|A`}DueXtwFgIljMszP|qFeh@lbGmzb@`nc@}|Hf_i@}wRfgR{rP~m_@lu@jbHue[rze@swZrMmtLpvT_jWomDmqp@tpIouId~YkmH{bGyiHhxIhxI{iDho@tlIntM{{Ed@vyCsoVjvGey[ipB{fa@pfDmgJn_V{zQjmMtArvOapd@qaD_{LrgEu_f@tp_@c`Yfu_@cyC`a@_MkcFupNbUmeQn~R~oBezSweTyeEg`Ll|ZyhLn}Ag{EzsQ{hg@`eJqfJh~R_bm@fmRetBtBikb@e|sMvbDfj@xlBtr@hhB~gA~uCz|DvpEbcCl~B_t@vgClGt~FzuAfdAbnAzfgEesfSjrZowtAhg@i{Jq@se`Pm}vA|@pWasjIfUsdAbeAyqAxW{yBiTesGnmAkgFhpD_oEf|E{hDrpE{uB|jLmaDnlBegAviGafG|~Aa|@d`Cum@hh@qe@riBkxExlAm~@fuBytK`oBatEjf@_vBnN}{Cr_@qeBbaDcoGrsBmxBx`DwtA|hBc`E|T_@~^ghBbmAqpBnbNeaNjqBsj@lnAk{@xgGmyM`|@ovCdvCmvCnhEmiInwB{kAz|EwoAxvA_|@dcAi|C|zEetExpIazN`kGcuFnx@olA`h@awDlwCeeJteBolBxXg`Aq@s^mdAa{Avo@cwClyAa{@hi@anD`f@w[nbAiJxDyn@lnAaoAom@ui@tkH_bH|`AwiFbx@keC`qAwxBf~AonAfhEsh@dv@wf@th@scG|T_i@xcAce@h{D}zDnlBkgAl_G}i@|s@igB~^sVlvCpm@xcA}L~qAi|@t|@}L~eDdD`pBeaB|i@_rAth@od@hyDq`@j{AyuCt}LcuFpkA_zChr@oy@f}EcxBz_BixAblCaQlaFhJtnC~Xd_FdvAt{BvQdtCoNrvImsBntE?dwBgiB~{Aqk@f}BgtBjvI}nBhqBmrBdkAcmBd}AerA`fGqv@rmGyvC~vC{Kx|@kY|sAswAvsDkfH`jAowAdvCaeAraIspQxsA}`Aqr@wnBoBamD`f@{~CjoB}cBptCaqFh~EcnB`hEklEbdBsgCb|AyuEnbHukH|sBomFtiD}wPxgA_oK`h@{uNnl@}y@j}@a]~iCzr@`a@mj@~i@adClzEwdHhxAmvDduB{eP?exEnc@it@vhCwiApvGa_IbvCivIttDcfFt^}yAce@egA}z@kv@iJuw@jlBwjAqm@a_C|bCbf@bo@{zAaFutJ~qAqtI|^osF_fAs|CcgDwsAsvEi[cNgy@bN}VyiDibAwe@kr@wyCslHc|Ji_FeaBmsBtbA{iCer@}_@_{DszGkv@emFgeAhx@ovAdvCmz@hn@gkA}@{pF{uAatDeNoaBepB{L_rBq[qv@_rBlp@ovB}_AciAi@ilCle@gtA{K}rBohAalM}eEkvAa{AwsAb_@yo@yhAgnA|a@mdAoqBc_Ho`DwEoY}uAg[sxGetDkiD_i@cj@in@?qvGgUmlBqsCmsIrIgWb_Aq[pFihAgjAq_BolAkq@_Nso@nm@miTetEyaD{`Hiy@ug@ym@uf@sqCkqBqz@i^ux@l[utB~zBoaDqW{nBtpBg]le@ktAvQs`F~eCeaHfv@ogA|sA}aFqv@coQjc@ueDgpA_aBxX_~A`eBojDqLatBolAsqClk@kyD?mpEyi@efAR_i@p`AwQza@ys@h}Bmu^_d@scIu]a_Bw~@icAuqC_n@rLql@|i@kl@j{@uS||DhxA|^wbCqyB_dEid@cxBdO_t@jpAci@j]}z@gAwgC}j@ovBbnAhYpoAgP|uE{oCb~Hq_D~pA_eCm@kdDn^mu@xaIhdAfV}dAmtAukBelA_zCsNkzChlAe|AbbDwFzxDlu@ltAcVnx@_}@lT_eCxfDcmDftA{rDnkDg}QviB_r@rhGom@h{BewAdxAe`C|uDceMrrB}qCpmD{iD`{BwiHzpAu{BfzAapA`vEwbCpdC`q@lsAon@zmFwhIliAco@nm@umErsAwq@phJ}t@zvFmxBdaCeW`eCdw@zyAaeArrC{Mv}CmvD`yBus@n}@}}Bbo@aQ~lAvf@lk@uTnR_h@lAwyAvu@{aA||Cg_BrzQ}{CpjComAdgG_cF~jB`GnvCuvGpz@cZrzEdCr~BssAhlAi}B|xAuaGrtAdtDdm@h_@pdAwFryBcwBncFa\\lzEqw@x{IorGfdB{BxqB`g@nwByx@ngBgjBrbAqjBri@ixEd~E_s@nc@us@lPaeBxrGicR`fB_rBliGkfAbwCyjD|Kem@brBnWxzDssAxmAaz@|~AwyAnx@i_Fxm@ulAtzAdfAf{A_|@xoEenEjg@m_Bz[yNh`D~lAbeAoSp_AwiB`v@in@h_G?fdAcsBffAyhAz`@IdZuyCp`AsnA`bDiaCltAitEp|Dg|@xZmn@doDylCi@u}EteDe{BcA{eAorAooEhZ_}@hwBcjAbZid@ftAugFldBc|Bt{Ayo@zkMjMxZic@xDg_BdWgp@nkCym@hcG?`dPdyCdkDpsCftAkxElxAqe@hi@ixA|~AixAjeBicAlnB}i@bxBwKbeKrqB~|BfLnlB}cAbx@sKvaGpjC~mDsgCbdAc~Ar_@g}Clp@{g@rdEeNdcAcYbiForHlaRkiP`zPggDltSmzFb{Kq|@|qCag@t{A_vHrsAwjAjnD{fAloAgLreFbyB`eAoNf_ByaC`QuvBkHmoFthAqfArJ}c@{m@irCpBuuCza@ku@z`Bwu@evAw_BlwCmeFyYsmCjq@axB}Iet@mf@af@pb@cdAod@_{A|@aw@|~Ba_C`yBgvEpjAm`A~gBzWUcfDnYohApv@c}@|sA|nAh^sKtR}hBdvCudEnN{pA{~BioJdvAgsDvmB_uJlnCq_CkqB_bBjr@alDtfB{dEpk@amDf~Bpp@~}@?hT{fA|@udDrmHuqFb`FaeNr~@_jFtHuxEmeAqeHdXuzBxvC_r@xZq{@keCofI|i@e}Lgj@ykK?a{M|^azBf_BqKhUorAh^{`PzWkzB~q@crB~rAqtBviG_pDtgAcuDfeGuwGriBesEhT{lFdm@mj@x`B{a@byAm_ArTwcCgVyYyaA}K\\qp@~^ii@fuAkk@vPu}@_s@s}BwxBoBy_Gb}@eb@_X_|AslC`eAelB_h@ypCeaBirBwdBwPwPerAvPukHi_@myK"),
+	country("Mexico", "i}_oBbbp{Tvx@wtIfcCl|J"),
+	country("Mexico", "}zvqB|bddTdiF}wEvzE|pAbaAxwIwcIpcPylRy_L"),
+	country("Mexico", "i|auBnljcTjtEqeDp|F~hG"),
+	country("Mexico", "swdaCf{igSxiFtTyqC`cI"),
+	country("Mexico", "iacbCjamhSjyBiaG|oGhvCimHlrSw`DkmE"),
+	country("Mexico", "mdybCdebiSfyI`|BeqJnhRg_QpxDdxCi`U"),
+	country("Mexico", "akzrClhk}SlbPolEozAb{L}nj@pqJ"),
+	country("Mexico", "_jxsCfk|gT|lHwgR}qDhah@"),
+	country("Mexico", "qxetChzaiTlgMi}J`nMtaB}{h@`jy@_e@gyZ"),
+	country("Mexico", "cgstCzyj`Tf_HwfFpcLbwJqfTpoHmyBk_CuqF~uF{jByuD"),
+	country("Mexico", "akdxClevbTvnNepYhoYmwHbeBzqIwsC}_@_hDtjLk{QppEsoB~hF"),
+	country("Mexico", "i`guCxefkTvkKq~GnLrrEu|RfrOauGo{FewKhwFw}ChvL|tF`sAijGlyGio{Am_b@fcj@hpIvu`@eNjzCheF|{]cdRr_JfaC"),
+	country("Mexico", "cbezCpdgwSb_GwxGzbAxkBecMdzg@"),
+	country("Mexico", "cye{C`z`cTozHnzFkjLwgE"),
+	country("Mexico", "szt}Clp~dTflEguAw_EzwGhkQ|jLjhRdvCusFhpDig[soKm{Ihi@_qD{jV"),
+	country("Mexico", "spveDbkabTqiRidAfsO|DncEckK"),
+	country("Mexico", "ol~kDr_b~Trdf@usFhwEdzDdjNoXsCfaHupJvdIp]deN}n\\ibVupWnv@ouBarE"),
+	country("Mexico", "sb`nD`q`pTj|OqrZr~AneC"),
+	country("Mexico", "_oinDdwinTmPmvKr~Ijd@zP`zH"),
+	country("Mexico", "myqnDrsglTl~@nnO_{Uxvj@uxQ_~Q_iShyAwvSy}EsuKicf@piTps@dvUcaNfdi@zvNnbF_oC"),
+	country("Mexico", "qvkpDphpqTrhAq`Fd`LpcCcak@~bv@kfLvuGga@d~GohWjdQumXgoBuy@swJrqPgrUhuMszGzmMd_CxrE_lf@|t[dc@"),
+	country("Mexico", "apbaEfdr{T~bU{`Yu`DrmTk_Gx}E"),
+	country("Mexico", "srbpB`{_tOxfNpSfcIxwG"),
+	country("Mexico", "glqqB`uanPwbC__Kb_GoxAnm@txQnuMdhLlh@tuE}wE}~Bl`HpiZ"),
+	country("Mexico", "m|t|BhdnpOdlUrkHdii@nue@_uYpwC_gTeaI__K{fKb_CwiL"),
+	country("Mexico", "mm~cCnp_sQx_FwuHvi@~mFm|U`xG"),
+	country("Mexico", "_pppDv~vpU|`a@o_DpdHrhKckXf`BkvUzqKgzEcjAoyF{eO~jIrlA"),
+	country("Mexico", "wsbbDrberT|XmvLzxIkbF}vFnlV"),
+	country("Mexico", "ejpgCdpgbPmaHbrEksIojGqnLxnJxo@raJarGq{CrcFsaPlpNskHt`OxuC"),
+	country("Mexico", "aqmyCl~vbTkN{|CpmHztF"),
+	country("Mexico", "cvz{CtfzdThk@oxFbfG~x@"),
+	country("Mexico", "qum~CjtffTmx@anCtzDdB"),
+	country("Mexico", "ghdeDf}dkTs|Eej@zmGgwGljFlbC"),
+	country("Mexico", "kflfDt}|iTi}B`v@~eAivG"),
+	country("Australia", "v}{_EkryaXipX|emA|oN`vZdoIpvf@stEbtzBvbOzxyD|aI~ns@r{Yh~t@fqm@`r{BbrFb`p@|i\\rg|A`mGvivBklOdb_B`kHh{Swk@`|Lxut@jzzAj{c@j{gBjnXdn_@|rOpd|ApsOnl]l~UfxStkoA`xZpt[vee@rvP|lDjoNvu^frIfyFqiHt~ZttQjxUjnCzzTcoSh_GcqGrtPplGhrb@khDhpKlpBl``@hqKneHcqGhbh@rqGd`OtgK`}@qrDfzF|iDt|DuHhaQo\\fcC{yHmhEedMjdEoyLfkLr|M~kh@wxFtyQ`wBhmLqbJbtQ}Yr{e@phIfu_@e~CflLzzHvqgBrpGvxElfFp~XwzEfrUntAlxa@icDzdRfoJhhe@rt]hvl@dn`@z_ShyGayAb|C_vMfaCnqNuvE~zLlcGzfE|dHs{CzaFniFerHbfKbzOp}LitJnaUc{Bzbu@`jHcjHbgJni^xzGuzDliEliE~iSlcv@bxOjxI|sMedAfuGdlm@p|LapFuxDjeQftGd{s@hyHemIgyFylA`jHk{HsGgtHpuDriI_{Lnrr@bsErfJ`gJvk@emV`ou@s_Axa\\jxIl_IgsHded@zaGrbM_gHnrZ`jGlfRwtHxv`@}~H|tLcxCfjA~wAgqVs}NxtUhuJdfAikNttu@yQl~UubYdgKc_q@rgz@ic[rx}@?x{Sp_KztKqbVndTkhjAvrHyiN}iD}gRjeEmwKoeHevQb_BbcWigg@mrAiu`@cfV{g\\c|f@qgTecA_xMgdD{s@wcNfkA~mQhaEyhr@io@o}eAhkMy`\\cpRxb[~}J`cWkpMst\\hxI|u@opPuyEu_C}vKhuIobViaEgrH~jFibF}mD{tJpcK}v@qcKkkNgbCgtoAv{EefWj`GalbA|ak@ca|@~sZk}^nxXipz@vmVmof@~bIqdJouAepHrjHejLotAukUz}GsbXczC{un@vdC}xa@mkEyogA|rYsvs@xrm@emIfcDufXglAeaYdnJmax@ldj@sbWfc[ysn@vhLqda@k~GovgA|sXe}{BflqAcvq@dhu@aja@vgLafP`cPdqE}wRbsHwmByom@a|D`ju@cgCbaTu~I{`Tp`EcvKutCcne@bzAn~^isEojBuvInv_@atGlq[~fB}W{oDyde@af@drAsxDfhu@chCwiAsoD_bUccE~z]oUbQ}oElcIim@qpFi}Cs{Am}Uq_FaHoaGq_Js`b@oyA_xRzbSuzB`aRy`Oj{EywHv~Le~[`zBaeCjqFg|Kbj@omWhxPwjMuJgk[waQr{Pua@taEclKf`\\wvPpzAahI|{Lha@dsBiwEtir@tgIdqHwrCn{@edLirOghI}hNi_EajRbwCrgEgwIl|ZfUz|B}eGrpd@ygCxpJeaIdcJaaOccMkfSooJygDoqVlhGesXy}EqvOj_GbaAw_OccKtuEquOcwC_eIndSqkaA~hk@mhu@jrWa_LdpT{{T|`HyqJgbCi_G|}I}b_@df@wde@ne_@sgV`kHmc_@re@klNakHyqV~sBenJy}FauNoZgxv@yf[kaRs_YipLibEkoXmtA}d_@rtEgtTejKyqV|XquZnhH}{JduR}h\\xkAefo@ev]a{hAc}ZelU}iPuxEmsY~vgA~nQ`oR}cH|zIpzA{bF_kFlnCqtCr{NtnIzkNvi@brGimLiiNi{SzzHk|Hc`BexDmwz@ssR}t@u{D_mQcxC{sKxeD~hFwtIaxe@wyQ{d\\}bv@orDcla@m`_@s|gA__b@sx[exQmsd@cq_@o`VmkPw}h@q`WwvGdeB{dQcnHet^}i]glo@uhWuzPlZysIn_V~~JhvIa~LgrUciy@daNmiDvqIwt`@ydC}d^eqH}lPbFebY{bG}bSaaKknNsdKyhC_uYy`h@d`BorNy_FuzPvcD}aHqyNiitAm`l@{~_@{iOirVh}F_{Kk`GuJwl@auDh_Guzs@~zKmwWqcKa}Wk_GihBctQezzBwql@slqBmaU}}`@ep_@kxb@eqv@arg@_tq@aaWyaRbvD}u@k~Rm`HcmIm_Isb@c|CplGacVmoNtJokRk`bAihtAaeJiiCsxNr{Cm\\n`a@saLamFwcf@hNcw[pcKqaz@asD}xVk_TkoB|nC{fEqxBkwUouYkjCanFxaE^saAkjDk|Hp`@j|H}yVqiThmLw`Oo~If`C{xJmbGysUfbCqcKc_N|rMmfRknAy~Po|WeyQeyDqoEoiSh{FxgC`iH{xIfPfmKj{St{DvxGuaCedAyzFhgNigCpyBgqJg`De{DxeOnjF`oFo_JvuShn@dvR}zUlwy@_|WphUqdUph_@ipLgpl@xRwra@c~McvPjsKqgTc_BraAo|JjrKavCbc@skIvd[o{Wp_@agIafIhsJwz^vpJd~CwjL{lNvdCo`VyxT_pDxgCdnJ`sDjeEdoTga\\lmOs}Ml_UgfA`vOk_TokRowAnxMtxEq}@aAh`E_wMjgP~w@gjL{bFxeDpcKita@c_CxwIgbC}zKc}Mt{[hgCycg@{uKnsNvfBosNaiH?w{FhaQhfDhkBytIbcKkmCmdFmxKdf@y|Fia]huIidOzqAfyFhfDo_Kb|A`|AzoA`xNdjLgkXz}FkT^v_En_K}lD?ieDswPwLbsFevRmmOzaGa{AcwE__KltMhuIy`i@`bWwyQhlAsiV_bJuqGlfGgbPyp@isx@f{FozLmpCypKayMrb{@xOr_e@siUloCo~JoeHuuQ}u@xOycExsUysIcmHwcD}pAgtHisWxlAwgAmhEyiNdf@omEolEb_BmcGmpMsmH?p_Wjmd@`bWoh]h_FghBviArmHrtEaxe@|lDi_G}lDrtEmtAajHoz@msAv~DycOb~Bo@q|Lo_Jc|CzaFsr]mkPs`An_J}uArHqqDs|NaDuxEwrHsxC|iD`xBv}EuoIooDug@rtEmbG?txEicQ`iHxiBi_GukJfbD_jFh`F|rAfj@}eR~}JwfMxxI|sAoxAokFdyEmhEsnHgiAfiLacWoiRl_Us~LyiBstEytIydEvvStkJfoVqkG|iEeeLcuFyp@u~NwoJupH?~wMxePtmT}nO?sFv}E_rCio@ewFytI~oEq{MuwEcmHwjXvbCt_C}bGkkCw}DfkKbaAlmD_xAwbCqiHbcW{bG_n\\?~mEyl@xeCimMjpMmcGiOgb[u|DtkJy`EihBc|C`vC_hGeiKu`Bv_\\mgFuh@cbAduR}fFqtDfhAylMgzEqvBgzFxyS}wK|rAqnGphIgzEovMmkEtg@hT{xIkeFi_Ge_CfjLi_Gu~NmpMolE~eTp]eiLcjH`nRcpHe}[wqIti@o~J|{Ir_B{p@guHgc[_nDok]jdEihCojEdfLigPe_CkeDbgKziCqBriIl|`@icDp~WlbHacm@arQr~Lq@w{E{tKlmC_cIxeDnkErqRuaBrkI_sOsgVgj@xeDukJ{cFshJq~Wl|Jg_E_wNcjUgLg{Fe~NerRr_AggB`~W_bJ`rEy`Eim@`mFenIwtGksAj_GgqH{zIb`AagHvkL`|@_jG_hGycDx_F__@kSkvJttQh_Gq_KsmTxtIhtIprDcmHny@vxGhbFjSkW}`HfgNfcCt~C{yH~lDb|AxiBcdKyzGm~IclH|_IuaNg|EomP}bSzhCokFuhKsfJjpAkcGjnYfsU_\\{oMx{FrsEpsE_mDui@_iHnnPuuEytIwl@icEk_GweN?vtIi~FajHk_GqbKmtAfbCzhDcmHjpAcE}|VonE`zB?_fHw`DvsHovXzxJoqCabLzxHqqDe_CstEqtDf_Eg_DyiBt_CyxHu_Clu@qzBajHvqIsbL`lHcFfLeqHvqJp{A{TcyD|jOwuGw_Pw|EvlLkeEaeJgxFjoLcvQ{vJfNpbKgjLwbCc{BvoUkeExsV{ci@|of@uw]|{I{SowAeuGn~JlsAa|@cvDvnJceLxzFvm@{lAcjJveMo|JlpOme_@v_DzTgj@pkGjcQcc@ntCdeM|aGa|Ajhh@xpKtl`@wk@nwA|lEj~TswDh~PbkHu`Mu`Nujn@_tChgNqkR~sZktKiuTgNorO`~Lga\\rwCct\\o~U`zX{cFukIq{AfmJatOk_G_{@m{UdoIcwE_mEmaHzzH{zHwb[dx\\}hnBb}Mc}NxmYs~AxwSlbHwpIimLdfLxl@gjXupHw|s@buEl_IcuEg`Do~Jb_Mcv\\dlVevQhtU{nAexE{wJhpKkeEqa@gvHc`p@doUsmTmhF`sD_tYigN{f\\zkC}dHqxNecA~mQl`l@ywIstEt~B`tOen`@bcW{lBwhXipKs_MbpG|yVuzD~wXmtYxra@}oNs{Acbc@wnVyaQtcAj_G}bSstQc_CnlF{fDqnG}bS{uJ}sAiqKpnEerSsoRoZnkEu~NgMunm@gtSxkMmqC~uBwqI{pLof_@oxYgnVzkCigNwnb@v|g@wwj@wk@uuFm~Hh_GurGi`FkgQglKqnEah`@wN~x@k}GitIajH|iDuhLcgJ|x@|_UmpN{t@gxSibD|jQk_H`oFqwC_dJ{iCrsFegXowAu_B}lDy`FleG{lBcgKnbIe{Zli\\se@qiHgbCj_SkjPoeG_y@psDirJ_bUpoQpsCqoQsxObwOkTbjJoeH?imLwbOlbGgc[iqb@zwIesGuzDr}BobIgcConDpqPaq\\uwEfcCux]kvJria@i{HluLwuRaxBsv`AwsI}~T`kHmeFpjGcfW}j\\_xd@``M{gDgkAwyRvbP}cGb~MbtEmrNqaKylLxdDs~MygBujKehWldG_yd@a}K``Wcui@kmByoKvpJkqX{_SohGjxUyiYzhOk`GhoW|lC~wY|wKjtWsrFvlKoxKfLavCxcQamG_pDi|GdpSp_@|dSe~B}gFkhDr}A~vAgrHayMrzB`wBwsHepHovAloNchJe|CqkGy}QwMvjKg_QzbF{q@e}BoqD`aWfl@fhAe_CwzFkoA|bSqsEjWsuDgpJd`BzmAcpFswCmu@i`FpfHu{OiNmpNpiGm[gzFjoYi|QgwSxn@qA}eGwwFoqCbn^eyEoxLsbLhfCuyD~hS_hGviLexQh|R{yJa@ugKoeTuxQ`wBa`KqwNljDuf@coGjxa@of_@|}HdJd_[iaQt|ComQkaGg_E?ytI~{LlnCtHeoHmgFuxEpkGfK`uCyhMmcGsmIjxU?itIicDp~@w|Q{~HkhD?imLkxIm_Ihm@_cUf}E_pCtpHxaQrmHhOdlJalRimLm`UhfOiaEsmH{|HfiLadJ`tDjiDpnRkoBocT_kRnmPyb[vi@_h_@ogSuqT{oAymK||a@iaQ`yBeuSpfUmcSadBs`NpcJyvL}bDolAy`HluJflBmy_@axBeeAwyEzvW}iFqvP}}Cz`Ak}FwjKfaDejQs_IczMzaF{aIqsNsfWdsFhqXy`QufAy|Gktb@ijMspRkmNvi@in@{fEjnBkbFlfMnoEr|Rh_Qj~HjlQuxE_}e@nhFhdP|sZfd^|}IfgB`cTwqN}e^g}[v~D}iE_`VmoZxc\\~`Vj}HcsFmiEawOjpXltMlbSepHcoFkeFbtEmaSi}GgtTotd@yiNmhAt_Xa{F`iJcsk@smt@sH_`KetGmuJlqPh|Rri@_iKnxOwfDrXsdDhwPuWfmPigMvLc~NgyFim@sbCjiMkzFg|NhoJspUvxKyzH`vJuD}T}{Irge@|nh@yqOquEnwB|vKbgRobCfdGrvJ`~Q~cAlIx}NfpUgMasOl`Inz@vfEruHa_@a~DrzJr_N}oBvbCm|Ij_Gp@~eG_{P~}Fm_CurAlyIfeEb~BmyHndDlfAnlJ~kEsHxxHymLn|Qj_FbfA~wHjyMtv@gaD~pDbpStwRusA|_C}qJ_`B_nCu{Hoi^vfBorB`qFhsUbxC_nEfpJddLlWnzKd}O}mZtcAzaT~`K]liEr~N?uyQlyGddMv`IefEhvA`rLtrJnmPotFx`AmcHhoFvtHqsE|tFz`Db`DzjOqzAjxJnxCj_LaxFpr@coFbpFjbF~qDyfOidPsdLzr@i|FzlYfgM|bSlsAdmJtiLn_m@ntAluLjj\\rr^dzPd}CtkJpze@f}OznLjhPtL|zKj~j@uxRx}xAanrC~m\\snHxrI_uMxn@whLh`Ri{T{q@q|K`q\\a`XxkMvl@w|Ea}LrtEeaZeuF}YiaEqbKjkq@ecgAfkXwdjAbcc@yqVpxq@u~|@h~Quf{@qzAmc_@rxPkwb@rxCyx_@t`OwoIzxIcvQbj`@ef@xf[smT|iD}b_@pr]mof@|jPcpv@~pO{nMmXaan@s_M}ev@_}c@u{}@q`z@ogSipVfKari@_tYi~QwsUykXexEecNelJ{dQhR{pc@ejKg~\\yhNozXbmIgxu@qbKs_sAmqZujWyfNwbOei@ubY~~Jw|kAfcOcru@moN_pPhOsl_@~cUyeOzjBemx@iqJ{~j@alS?}bGctQc_Cavr@rmTw~PqiHp`@uyDovNkfQom\\yvIrtEim@{jCotB`pFk_HhnWwiAzuKgvGaq\\`pEmfG}bSo]xeO}|Ix|RymL_n\\a_@dna@c_N`iSsmH}~Hwi@pwOzmN?zjBi_GxlB`pRecCny@uc{@{uWjTyaQuwEb_Ctg@x~Eknf@geNo`Ie`O`r\\ef@wiLusGbtEcjJw~DucAmxK~rN}oNp`@s~kBq~b@aujA_wBeqHit`@szNudNy_Q_mEw{Fk{ThsK}rLpkRdsRdxQltAieO}kPxkLwkLzqLwi@onD_xMxhe@whLhyS|u@pmSozLpqQz~Hp{WkeFpgl@oZh~QwjXmfE{zTlwJo{Lpc@ykMpxAvxGro_@n~Upbb@|w@r`A_iRfuHs_N~n[meGvgNwcZtkVvsIdz[d_C}lDe_Cs{Bkb_@ziPbmHx~\\ym@nvp@_sCntOyqKphTwi@nck@tfLhle@szNtdLqyMdce@c_Clsd@osMngTg}]s}Y{`j@_~VevFrcK{~RsdKouYkgP{~GlbS}eR~kR~YqvCshIp}WhjBxeP_`KyQ{lN`hHwyFluAaiSrgVanGtdMe{OjfD{pd@rlHxP~{KadJ_[wkLtw\\|eRnzLtfAxhO_mEp^{`GanFqyArtEszCxwHflK|iEwiArsQdpInt}@m|Vjb]`aArt]gySzjZ|mDjvJstExkMvpIngTnwA`lFuhKpfa@{`Sfa[qp]~rO{wIjdFlgFp}KarE{zSktKbwC{`GokE}qMxlc@vhMd|_Aq}c@beWxOjxU}nNnaIj_GvvT|lChmLuwDlfu@|_TpmQmYlaUsvPf~DwbOr_Yw_DltAylX|k~@xkLj~SseK`eb@wrk@vnI{sXe}BelI~vXi_^_dIeqS_uMmtAxmLk_G|rLvg@~nQ_vMdKocw@atEqtDmrMrkHfsl@sr]hc\\gyFlrBprE`_WsfVvl@stRgb[rtElmCwgMzjZ{yHplGiuIltAqwOstEsfJvpJciJrwDe}[fiL_mDltAlbGv|E?fpUaxYkpAqbKe_CjbG_mEuoI}tLlhExcCgcNw}DaoF`m^k|HeoH_jFppEui@kpA_mDj_Gd~B~hHe~BooE}hRlaTedLbcMcHnbIedM~Zt|CbgJcrFwi@fmLaoQjuJtuFhwHaqE`eJp_KmoCp}LvnKznMuhL|iElrBfyFezDdmJogSnaUfeBaiHajH|xKs~Ya}@slGxbDyjBsa@~eHpcJo@r{BefW|gQovNxmXtwEn_KqkF{iDc~BziDqlFbtQ}lDd_CbtExeDgtIjdFrnHxtH~@y`EywI`vCajHlrNstEajHchJpqEwgAtfLnbJnmPibFthK~jGp`gAedZ~mDtbCrgJywInkFdbCpcKsmTj`SeJ~cHx_FsnHi}RsbLagHxds@gul@ywbA`aWeiWytImhE_vMvjWsqRl}TgzF`hl@oapAk_SlmDyeDx_FcjUtHspGqtCdeAk|HxiCe}Cf|EvaC|iDk_Go~JmtAr~NkiDf~D{vIdkJfpI{iCrwD~}IsF?apF|wKebB{bRmbGn~VedAvwG}dGfsk@dsFdg@z|HpsEmgFznd@|x@`rPkqMjxJ~aIh|R{fDvcNwhLb~BfjLrdKq_MasDm~HnsCcpMpgT}kUrcXo}JrzBquPfnb@iz^wfBgyF|kE}~IjrL}qA_iHibEftIsmTk_SnxMylBe~C~lFeyPngGfgAy`FirJtkVg`EhaEulJ_nD_mD`wr@euSflb@eiVtwh@wubAnzd@yaFpl^kqXlqBxoLxhMegXzfEaaV}bGmtAxzGorOdeXkoBvoJ~~JanFg~P`nFnsCxeC}jDtkK~|JpgTiQ~[ptC~m\\qxYfb[?}wKwzFsxO?huUkvJrF}kO|oiA|tXr|MuiK|qp@zhDnkFi~F|lg@n\\bfc@ioKpnEb_XxeOrqGxT{mNpdU`gIrkI}bSrvOosC|cHs}Mtb|@uaY{mBsqGfb[ltAydDluAxzG~qBhhC}rA{hDg_DdgWq`AvpV{sVvjWs}Btic@~oDnrOmsAr`MitIzeg@byCp|XxmX~qOduFp~K_{@tgX`rQp~c@kqAfqdAhmLvgqCbbt@na^l{E|oZgwFd{DqaKvgWhtHffHsyBliP~kN~a]fWhuMdoJfbPegBt{O~kPbsMuRttJjsJbaZxcGjdSh}YlbR~gKvaVxaF`fJmrJpdKdoGfvFaqD~jPdsElfEexBj}U`{n@vdQhaShuGkiDapEvbOrtE`yMziDggXlbG`_@xfEzaf@hpQpgc@dtVhtKj`HxmKjcl@bzRxxEbnIstHq~CueBvyChlGldKj_I`Ol[wgIvyMxlJv|PbcCruE|qZ{hD`eI~gH{hCheDzhDgoF{kMngNnXoaJy{Dx}Co~Ajrg@h`Fx`EbhJnvA_hHd_OjpA|`IjgEgvGngHjxIxySvaD{pYnxArbLnmD|mDti@y~Ft_Chq@nx\\`af@jfLdrGzm]xd@lpJx{GuxEzfE|iFh{FzwIitUlpC|aH~k]jdFf|PxyS|jDm`IffBlkEziD{bGjbGrdAnmD}uMpdKti@`oE~iF{fEptDwlK}x@deAt|PfuH|oBhrJcoGucAk}GxtIhm@twEfcg@f_QjtKbdLgQ|hQd`Ot~NhT|~`@tlU|WttRfwSaiH|uKljPlb_@rEovA|dGtzEt|Cx~DaaKvqJziC~p[ycCtzOb`Mlh^n\\leaApn]`jHssEvrH~qChsJwdCpqE|wKhtI{uV~aV}|Id`Bz_Sjih@suErhIlkPxm@l~UyyGs~A~]~vMviLajHh{SxcPx_Qjqc@jTli\\zdFfmLssE`vBmtA|}U`iHrjoAOvqpArq^dejB|{l@zueAfxyA`_jBe|DzkBpwCnoh@lzJzeg@gwGn~JpxBjtWlbG|cGxqKu|N|mPkkCwtIeiWktKmeFb}BatD`eUse@bvQ`jHfvGcmH~tNllPcsFfjLkbGubC{aFrhJme^j~SsnHnpPfiAjpMzsKntA`_AvbOqv[|zKsoR|cRj_GgyQkzHdsGggAr{MlbGbaYyzRbxO{bGzmZuwE}zJ}tMzpAyPotOcrFm|Hol\\haQfj@bvg@xeOdmIziPkeDui@htUvbOviLvdCrpRgra@zhs@x}G_zWojFm}a@wpb@msY}_T|w@kyIhfOw|QttGkcEbjHjcE|lDcDr|NngGfbCb|MnzXd}D`eUxnKjaGti@fc[duF}u@b}B_uZclIgkXvdC}~HfkLs_A`|LbdKb_Bp_p@p`c@pfcAxbg@npg@zeFzkZpbKnkFfiLfjXspR`ab@eKtdYgoVx{^sfJnxo@syf@rb{@|fEdyEutFjsLjdE`lj@yrUftk@wbCbbc@ndHvjXrvD~wAp`Ky`Fin@pgIngHboGukKlrMt}CjhPs}A~rCouNs}Bmza@ddq@giMzub@luAt_r@w}[~_x@{qp@dx]ctQxmXmtAquCetFb|Bg}DzsWssi@~ud@idPrlIgtImcHkw`@xuU{~S_f`@keh@s|Aihr@p_Xo_y@dq^m|UtrT{fh@jjq@oe`@v{u@zt@yfOfxaAyevA~{Vo~Uzwa@yzSwag@hhNksWpbV_bx@~wsAe{D?hPstE}aGc`A{fEipLdsG{kNdgL~x@fbC{dEbjIzgDgKoeT}jQkmBcyO~uY{mBawZ}~TgKdbCpiHa_LphHubB~nP`rDef@xyGv`OpxCloZhwH|YtnI{mM}`Hlg\\joAv`PflKu~e@}u@fjo@~oDxzSzkNl`TeI`~o@|iDzkNm}H~kQotNkdEivHadVs{[sgb@cjl@opDubMagHsdo@b|Bo{@oaJmdu@taq@o}b@teL{od@t~[xsUhmLfmK_qDhzSxzShvTrpFfmK|w@rtEkiD`}sAx|^dbMgOfvHfgNgvHtpT{nAzmYdoIlgQ?zdQ|uLzrLa}AfhXhpLhsWw{FznM}d^{vVa_LbzBsaX{iNrxCekVgbC_{WdqHuhW{cFyuIwoy@a_Lar\\rtE_tB`vBtzCfyGmzU{qLm~Uq_@w_OldFq`@gqIuiK_fGq_MhgBsrQewQsnGv`Dimu@{`u@fsBodI}hPah@ais@`yWspLmuG}}Hiqb@ciJiRsfJd{DqxB`fI_li@jyIeyE{`FytInmPmdSfyGycDknBcnH`jHl|`@b|BnmEoiGvoVknA~xL~_JjvKu~CgdC~p[fiM|cGtpSr~Yljt@vbO|zm@zg[`gIjsLrwCxiYajHjqMddMbeKuIc|Njg\\jwdAj~Gv`DdiW|lh@l}l@pxY|sM||Uno\\lrAajIjgEphIp{XzwId_C`iH{iDuf@`iIliFzjCboRazAzdFzhOnwAegL_vMib\\|oM|XrjJ`jG|bSqkG|lD`jH{g\\vob@nnEjkNrgJ|tAnZbgJweMnqDw_\\pr[y{Gr|YajHu~Bk|GpeIkjDpeJf`E~{Vmb_@yzR|zJmWh|E_dUv}EdaCzsKirKtdBeyEmjEkbRwjA`_LgqIeHnrCfsHg|Quf@s`p@baL_oOhaRuaCmfG_`V|sAcoSzrLwbr@~ccAat\\c}BmvW|dHuiVpyYyo@eqIqgI`xC{gDfjWf`Evk@doHydPfcC`tEomP~l\\d_CpmR_tCntBz}Hd_DogGxtIepUvxGszB`sO{iDo~JunIpwBijBjyVmhQwoKmwVdnJfiAc{ZpuNhPq}WkiOcgWboGk_RftSfLprQhcPnyc@ciUo\\qlGaxNgc[`cW~tBxuHqiHtbBw}CrbL_gHxcEwbCs}BebCnnEbHthKhnLbzCkwJloOxeCdjJn|KowM}u@~zc@~mDtwEkiC~_WqmRpfUfiAreJw~Er`Ao{Vpad@oz@rxq@niGdjKlaHouBvNdom@gmW`m]"),
+	country("Australia", "dib{A}_fmWzcFw}EtjKho@{yI|zJi~Qq`@"),
+	country("Australia", "lchzAehxnWvbOaxCgbOdbOe_P~Z"),
+	country("Australia", "jxryAczevWr~Bd`CelK`sD"),
+	country("Australia", "viiyAcoruW~qOenI~sNmZ_g_@nnP"),
+	country("Australia", "tutsAefbcW|iDsmTzVbuQ"),
+	country("Australia", "t~qhA}wgvXbaAi_GvuHlqBy_Gx}FurFzoA"),
+	country("Australia", "l|khA{kwvXr|Aq`CrqAzgFggErzF"),
+	country("Australia", "r_ygAg_vwXvhAhyM{hC{o@"),
+	country("Australia", "jaweAkkgmX~|@axMtkJdgWqkG`C"),
+	country("Australia", "xg|dAsutmXbmHctE?vhLh_G`jHywI?"),
+	country("Australia", "~lzfG}}hf[ixHnjQuoHxfCbyCvaD~cHcbAh_G|sMc{Nv_QoqD~[_qDafIuhKhqVuvEhQ_sCukJcmHa}@raA_lFdkJltAliE{_HiRefWeaBiiCeeAhxHsqGt~Cwi@{hDikAzfDohGug@ei@~m\\ilM|hQp}ApcKh{Gf~DrqQ}}IhmL~~VcjHbtE|pAcaLk_S{r@|oBjwIw~[t`ZtgA~rCjkYcqR||UzgDc_BhkNz{I}cHz~GtmIhmWq|@liEtpHwfBfwGcuD`_@}w@`_LeaNqyA|cHz~GijCzbFcaLdf@~sBvwGdgLdg@rrRc`Yj~F~wYtbBibQ~~KtiJvi@~wMbnHexQcHjpMzxIue@?bjHv`Dxn@lpCskHf~Dr}ArwD~uNw_ErmG|rBhaQc}MdrTgfOxzGj{IkV|u@vwGauDl_a@zhD`iHqgHtmIhvItpHqrEttFjoA`mG|lD?re@vbOctRn@w~OpwNxiB{xJstElmDte@smTpbKqxMm~HotBf_EywH_yMrCofG~}JdlJpdJeqI`nGnkG~fGceXlcTz|HdHhcD|`HtcB_`JjTljQmrd@h{RqCl|IwtHowAweNbvP}rAd`Pyff@hc[alGu}BwlKbrE}aHf`PyzGmiEolQtkK_os@j|GhmLafIdlU_zd@baYogFi_Ra_AvfAoiFqtOqnF|rB~iFi~FxiBxp@vzFopPx|QqrPd_CdyDfpK|bHvfA_nDh_GsbMmhFss]ztJ}nr@x|v@i|]tnIymXzwUuqHibEawZplRsxZtf@kmOhqLkjCkiEwuGdaCekJe{Okfh@p_@s{BmmCtyOwwGlqBcti@p}MgeYsxDa{A?kxUa_MouBtzEqpFnfGnkFx}FsdKryDurTiiCsxNvlKalHbxDeu]hdPovNraXm_nArbAe{g@_pE_~V~fHctFg`PkoL{iDmmPvbDobIztKikAnZ_sZyyGzwTy{F|sBw}E}qBeiKacb@ryDg~\\kvKemU`D_bJ`}K{|Rcv\\_tZ}kDwoKjwJcze@i`Fa`LiuUehLhbEg{QpbLehKrc@mxKbp^}yVpjGfpJd_CctEnwYbtE~zKurHfgNdlKywIexQh`^|qM`o]w|ExdO`sD~}Ju}BvuHxzGfpU_{KfiYlkDhtJyqKjuJ`jHrtEcmHhkMnhGarEvmKelJsmHw|DbtEi`FqiHkwJdx]obIknNkvJlzJtmIknAeI~bHrfKr`BjcEqvOti@rfWh~QnrBbyZdrT}iDi~Fb{BybDdwEv_D`w[aDbpFphIg|EplF|lDheDj}GclHteXwdBh}FxmKtnJ`ClbHtrGjjC_yLwgBpqEqoEusF~wL{kOl|Jq^lnBx~E~rOpwCrsE}lDtxElsAveBevFvwGvaD"),
+	country("Australia", "vsukIwzxh]mbGinLt~Nti@r~d@~sMn\\lgG"),
+	country("Australia", "t{ogGwtgb[|_JmmDz{T|yJqxCn}KynLhhCb{O`jHcaMt|NqzA}nCbsFmtLawOddAc`BkhC|kEw|EclIiQ_wLoyM"),
+	country("Australia", "n`gfGentb[|`Tg|EnuBb~MezDlsAkeEi|FamGtwQ}sAceK_uB|lDk|GqqE"),
+	country("Australia", "p``cGs}dg[vtGstE|rB|bSxuH}fFdxE|fRwbOaqEyiB|hEqmFghAstEkxI"),
+	country("Australia", "~xeaGwsch[|`Ig_EsgJ~iR"),
+	country("Australia", "l_fwFo~atZx~F_aIvi@lqd@}~HxRgtHm{J"),
+	country("Australia", "b{ivFso_g[cxEaxYxzS`cJewEfiM"),
+	country("Australia", "fwuuF{mvrZ`yN~@psPrkH{bSrtE"),
+	country("Australia", "je_vFq|ksZmbGjqLsmH}yKlqBwkKzyI`_A"),
+	country("Australia", "dlluFcf}h[vtIitI`xMvb[efL|qAlqB~{n@kcFxhOuvFoyAkgDo|JnyAemVm}JwgX"),
+	country("Australia", "`|`tFcdlf[erSfjM_xAn_JytIavCszN~oD`wBvqIuxEhnLsdKguSknMcH|sAeyDwvGelJvvj@svh@xbD_xXtjVtaBvwGvqJ}iDmtM`xMexEwi@zbGxtIexEr}AbyBqpEzeQz`HlnDb|B`aKcxDjoMcrFmv@"),
+	country("Australia", "frnrFgrpnZ`pEutFpkS|hEdzQbbd@eaCh`FuaZ`BqoE`jH{wa@}sA{{Ia_AmbHsxOotMjTkVunHruPgtU"),
+	country("Australia", "vopkFo`}}ZhoAu|CnoD~aUgvHbeL"),
+	country("Australia", "`k`jFcx`vZp_KikBobIljPteA|l\\glK{qMc_Bw{P"),
+	country("Australia", "buzhF}ruvZpgI}cHp}@|qMfsH|zJg`DzqLwuSowA"),
+	country("Australia", "bkryEimajYphH|VvtIfgNgtI|bk@j`Hnud@vpUfdDpbJd}Zc`MzePiaEb~ZreKzdP}tA|fu@buE~r[mjPvnJqbKdgW{c]}vKqwO_b|AmiPc|q@~mDumTasD{va@ffCcbAlcGpdInvAq|KbdLbaLv~DupSkoBw`Qr`MeuE_rOo~VrvEefXrlHs`B"),
+	country("Australia", "xcutE_lc~XhvJ{hEqc@rpGm|U`zMn@i~F"),
+	country("Australia", "t{kqEe|leY|xKnxA}lCnsB"),
+	country("Australia", "`lwlE_retXp}LbdLs`Mwl@"),
+	country("Australia", "rvgdEo`fnXieDxgCeyEi}Ee~BexQ"),
+	country("Australia", "bbk_Ewjvi]rmHsbKvdChhBqDbzCeyD}pB"),
+	country("Australia", "bpifDw~xg\\?o_Kztz@zcQ`a@pgIepl@p`@spRy`F"),
+	country("Australia", "`wzeDudjg\\qgUleG}c^|v@eqHsvP"),
+	country("Australia", "hlb~Cas_rT|zJwMkS|cG_~LveCsgUtkTur_@v_Q_vo@dvEy_GgyFltAcmHzbj@snG|~_@czOtbNhhCyiBcuD"),
+	country("Australia", "lceyC_e`qTgcf@mqCcjIcpF"),
+	country("Australia", "jpcwCe~rqTtiLrtEctEvi@gcg@k_G"),
+	country("Australia", "ltowCiabg\\vdcCj}v@v}PeeBbuFduGgkL||Iuma@l~HkhD_jFcxPa|AeoSufLovNaAe|CriIc|Cq`@wrTuv]_lQwmKkyTb}ByqK~rOacWgc[jwb@{U"),
+	country("Australia", "pkkpC_|{z[dsGgcBvl@v_D{vVrvD"),
+	country("Australia", "dimoCu{gz[d_OmlDtyDjjO{ww@dwl@_kGmjHxOspGt|OsdWgxGerGpdV`|@"),
+	country("Australia", "dkjgCuuzp[`vCsoF|tMlbG"),
+	country("Australia", "vs}fC}txu[x`EawBrpFxjMarD_{@ue@btEiwIi_FsqFr~A"),
+	country("Australia", "`apeCgq_r[naVehA``LdwFmoOnvB"),
+	country("Australia", "rgucCscit[owAaxMfxGhbD"),
+	country("Australia", "`dicCknjt[?fpIkxI?"),
+	country("Australia", "nzo}Bitv_U`cW~_JhhBtvRilLkUmbTmjPu}CmbI"),
+	country("Australia", "||r{Ba}~l[~~JebCrmHpbK{iDltAubAyaE"),
+	country("Australia", "~x~zBevyl[mmD|mDzbGd_Co^`nFqjG~y@df@dsG}oYshJ"),
+	country("Australia", "xi}yBqgdl[txErtE{hDeJmuApmGimL{hD{hDytI"),
+	country("Australia", "|pzxBifai[rqFw}D`lGbbA"),
+	country("Australia", "zqtsBgsj_[`jHui@deAldHalGvwF"),
+	country("Australia", "tleoB{|`|ZrpFcuEncJnhGkqAfaPasP`vCykXx{RnkEwmVorNgjAdwEavB~[smIluLnsCvsIo|J"),
+	country("Australia", "f~~fBufyrY~|Jwk@feCxnKwbC~iG~eG~iFofGvbCgqUaqP"),
+	country("Australia", "hsueBoz|oY?imLdyExyH"),
+	country("Australia", "pqjcBkyzsYxfOhdDzeDf~QmsLtiJtvE|wKbsF_jF`B|}Hj}S`tDmlCldHvbOttQkTnxMenJbmHlvL}W~pC|fFihZqxBmm[acW_tMwqx@`iHkxUd_Cvm@"),
+	country("Australia", "zer~AcmdaYh{FmbG|sMbmHkrKbjH"),
+	country("Australia", "ran~A}z}aYh{FsuE_z@hvIdsHcbAjiCvoJctQ?"),
+	country("Australia", "flc_By_tcYrnCaqDreMhpEouJldM}sM~sBdy@bdHqxNyuDx}B_zCsgLmd@jrJugP"),
+	country("Australia", "v}y}Aiwy`YdxQ|lDym@lmP{fQwcD}nBweN"),
+	country("Australia", "zlc~A_mpbY`iH~eHi_FdeAgwR{~G"),
+	country("Australia", "~hb|AkkkvVraAssEtqGd~B}t@rsQ"),
+	country("Australia", "zuq|AaejwVrcKmpMh`GfvR}nChcEstF{sKkpAbdWqkGwaCxq@igD}bHdMuzEypKrkIpvB"),
+	country("Australia", "xokzAm`oyVzzIrF_aJdjK{aF}zJ"),
+	country("Australia", "h}vyAe}s{XhkElXlKlvMgjLs|F"),
+	country("Australia", "leiwAgdc{VbaMhkBz|H{hDhtItdLqtDz}G{qLq]p}@i_GscKxgCsjI}gF"),
+	country("Australia", "j|srA_py~XzbFnhFftHw}DzyIrqGbd@rpFmmPt~Bvi@~qCfmL{Q}mCvwFydQdKltBixHueMe}D"),
+	country("Australia", "xypuA}i{bYfxQ?dcCrmHojGpe`@puCjhQ_rO`ph@ruEz`SqiT_cUo}y@|kDb`Bq_SwkHkoBlcDurKy_FqrEarP|~IcHqmG~lEq`@mtLi_RtuFsc@n`JphHr|BwqHlmDfbCpiH{hDr~@gbOamRmjDfm@__KpiHwcCtmTfjLq}@nnEbuFhsIpeI``@ljEqiHxeCl_JnoDfLef@`iHfpI?`dKkeEiQajHkdFq^dlJgcOmyJwwG?acK"),
+	country("Australia", "t~~pAo`|}X{oMz~FdJ}mCniRatOdhAtnHbbPlgA"),
+	country("Australia", "nruhA_aabYtaKaX}wDjtC"),
+	country("Australia", "dxthAc}_gX~|J}pBcvE~zK"),
+	country("Australia", "~~ahAydz~XfhAwaDnaIldHbsF~qOe_CzwIi~F?zoBokEuxEomFf}D{p@wi@qsE"),
+	country("Australia", "tcufAwuw`YndI`sCim@pbK"),
+	country("Australia", "xpmfAm{x|Xg}G_{CweAsoMlmJfrH"),
+	country("Australia", "rvafAwhmhXzTw_Dj_Gn]`tD`bJ_xL|sM"),
+	country("Australia", "r}feA}dt_YumHytIhwGzRbyO~jRdxQpxe@woUqpPtzDahI"),
+	country("Australia", "hcmfA}mv{WtiK}dRzbFvi@xcDhvIhq@r_NgmLpfa@`gJbgm@k|HteLwdCcjI}nOeKnoDknM}mCgwF_e`@{pA{lB~`I_n\\q~VwbC}tMxsUwqIt|OhnAdcM}tM`jTltA"),
+	country("Australia", "zctdAo{yaXp_Kdj@|rBhrJxzSiQ{jCfaP~p[|fQf~g@ty_Acpv@vvyAu}uAdfX}}HokEt~ZwsUieDogGhhBqrD|`TfbCwjKy_Q|_H~[~aJ}cGguHmnDswCmxVk}HovM`mFwaOlzKa|B{dRw~C_zKqjS|j\\o|Ke|\\~uAqbJ{nLxcPygDuxEuyDfk@uyQloZ_dHa}@qmG"),
+	country("Australia", "lgkbAatvaYnm_@`fHzgm@lkb@v\\|dJear@aja@kv@otEgwWo~DcdEupG"),
+	country("Australia", "pubcA}ysgXe_ComDqhIrc@qbJbnGfNyfOgoKakHtsGp{BdxQstEvuGzcEb~B_dIdm`@dxE"),
+	country("Australia", "vib`AcggcZ`jH}kD~rBb`NjcF`vBatOzqMicQqxN"),
+	country("Australia", "h~p_A{qhcZdwEuhKnpDj|H"),
+	country("Australia", "hg{|@euncZn_Ky}FjtJttG}oBxkLcnH|mDi{FgcC"),
+	country("Australia", "d}o|@gozbZbgK?|dG`fHabLx}GgiLwxG"),
+	country("Australia", "xchbEu`|_U|`AovJt`Bh{T"),
+	country("Australia", "natlDs`ztTcyC_tLv|If~I"),
+	country("Australia", "bhlqBqxa~ZuL}hEheD`tDoy@|mOkjNudA"),
+	country("Australia", "h`ex@awhfZffB}mDlgGflLauD|~T"),
+	country("Australia", "`vkw@_x}bZfeCwhMt}C`Es`Br`X"),
+	country("New Zealand", "dmmuHas_u^v`DouMnwA~gk@ckI`_K"),
+	country("New Zealand", "rzp_Icv_h_@fuEudA`}Gd}KigJlcb@tVwdRyaNeeMv}Hk}S"),
+	country("New Zealand", "v}ysHkltu^b_AvmJzbG}iDui@|iDh_Gr~Bfi@qbKti@nfSd_CaxMrtEd_C?ytItwE?ti@rtEd_CixIbsE|sAclHzrXlmD`iHsqGkjCexErmGprEknA_|@zuJhuJoqDqzAhgPtyDjbF_aJ`_Ay|FqqFiiNi~\\uvRybFcpF~wBvi@ind@rtE_xA"),
+	country("New Zealand", "fe|mH_opba@ruPxhBazBjcSwfMmbI"),
+	country("New Zealand", "zeamGxjbr`@xhBacE`{MzoSa{M{r@moCt}CgaCa_L"),
+	country("New Zealand", "h}|jGtrps`@utGziDgsH}_IsrF~wAa_Apz^x~EgcC~xA}xPfmJriNntOixGeeAg~J~eHaoA`bJwaOnvN`~u@ohS`eOw|Qw~[_oOxxHanFlbMbtEjzO}sAhmXi_G_xMmmC~k@jq@gtZ_|JipKlbGgzc@msAsoj@vzFrtEeKd~N"),
+	country("New Zealand", "bnuqDr}z|`@}{IzmHnpDqaQljDckB"),
+	country("New Zealand", "|v|cHsiax^fmL?c_ChmL"),
+	country("New Zealand", "top|Gwur~^ab@|`HwcCw}D"),
+	country("New Zealand", "dlf|Gsv~c_@ziCcaBlbHnhGkbGtbC"),
+	country("New Zealand", "r~c~Gumra_@h{FqeIgaC|yJ|dF`~@hmAle^r`MbiVyjB|~TqqEbwCzqMd}[ej@smThxIvtIquCzlArsExxHhOhfP_qOqxCybE_qOi_QhQ}hFkvUuaMfi@sqGeaN_hSl|IuoTcuDfOqdb@~c_@}ih@rsFkoAdf@ryCa{Aza^d}Dgl@~dHroHk_Gu_OrtEi_SzeDb|BgaCqjRlkDg_E|bGltA"),
+	country("New Zealand", "|lluGoyox^pfIwzFfqJxlAdeAllPqxNzgD`_LjyUicP{xJ"),
+	country("New Zealand", "j}srGg`cz^pxY}zJqcJ|pZ"),
+	country("New Zealand", "zq{yFmwig`@rtEe_CvsId_CvyElzb@itIia]eyEs_BmtAj_G"),
+	country("New Zealand", "vttxFuguc`@giL?rtEa_KgqIbtE__Ke_Cr{Bs~BwcCmgEtqHwO}lDytI?rtEylLobJbvO`FtcM~eGfsJxvU"),
+	country("New Zealand", "`adgGi~j}_@vkY|uBj|ZgsHny@hbEtvEooOnjF~m\\kp@cn`@~hFmnCwrI}oBb_C}hEz{HgM}iFk~HlmDiyGmmDwzFv{QajH`jT`{BdxEtwQc|YrlH~kPhgCjvKsqFauCf~[o`JraM{gEmqBpaKbqaAs`M{}j@}zJnl\\r`BlqNnxWj_GbhBluW|ra@joiAl_Jxgf@d~^xv}@pcQf{Kd`Pny@jfPf|Qdae@wzFrqGvzFllDapFv}P|x@xy_@zzTb{NvsTjhOvqIl}UfrH~cHcvDtuG|`HtwEee@zhOrbLoZ`jGh{FotA~vBrvOjjCalFzaFnrCxgDb_Mv_D{U~@wlKvtG}vKntCd{ObtQhtUuuG{mXq}L}mPrvQgKncHfuv@dmJ|d^d`OxkMbjT`lGtq`@r_}@n}VjzHrxCb`d@xm@exQvsHl`IpvDhnLkrAdiLzeFd{OfcCwcCd_CbnTyhBlnNliEvvSqnGfbDj_Ht|CvMjcFkfDhuIfuG|kDw`Dpt[evQtsGhdC`cb@e~BajHmtA`qQvsI{iDhlAtnIilAvs`@czDy~FjpAeoSgwGd}e@feOwwFySljDmgQdpTr`AgmJmgFumJwcOddBn_KraLcfWpwO}mBxwGjlBz|TprDqAp_A|aj@_|L|eRomDgqHexQb|MqnF~~V_z@pxYdvR~dHjkCdrTcnIpcgBa~K`vPex\\qm^qcJgnVtoHze\\fdNncIoiFzlBhbElrAptC~hSidPohG}rL{h\\`|@l|UknNchI`kStkUf_Ejd]_k]xp@_mEmnCiaEkbmAikN{rXfcCbdo@inLkxUti@~bUseK}YomEgiXnxA_uNoxAieCoxA|{J{wIi_S|kO`naAqnEvjAa}X{uKwgBgvGrrGkkZqeJphG~oCfkA{{GjhPgyFudBstE}wKtrGgjL~mDzhDti@gpU~mD_z@jcFz}GwMiwIoeGy`EfzQoeTar\\v{]ogGa~X~cHkjN}pBwuHoz@lvKk|HkUvfBb_f@_vYv|Eq~@_eIpbKyxSyeOxrUseKglKf{QikMkbGwi@?_xMohFjcQgtHd|DkjC_jFpcI_s[ihMluYkwVgnc@cH_mEbnHy`Ej_Sa}@pCwsIsr]~{K}aH_{@u~C}xKxhCmfEjvJ|nBx`Fy_E{hPieDgoJz}G}pBgxFfrHquPefL|oOqmQsu]{WwnJjbRmxVmdGui@myJvcOomPb~Bau\\yle@olEn^}VokR}jEylAw_DzcFqjTcyf@ssQswP_xAjiDmqMsut@h|FulIyo@alSwubAihwBu_Nqfy@qm_@uu]q`Kmh]icP}rMmjEwhMhlAmyJ_xM{sKvsI|bSsmT}iPkgAmvQgiPmfXucIokc@svTmx]skTauO_sZm}a@qtZg`Son]qoN_dl@gpJadJwoIsja@ozLioKd`C}u@um`@g~P{ci@wzh@ecZkeFc~MumUyfCyo@oeHi|Ft|CabfAxn@aby@}dmAeeAmgFrmHjT{kCobIsrFqpE?xlLmhFsoFg_Dirn@xhCuu]iSnvp@tjVxzGvk@jbFdwF{~FdgKvi@mtA}lDx|SgeYizHgaPlXijMjqLhn@`{BywIr|Mth@dpI~~J?ctEz|SaoFucAjdEh_Fti@vyFqgI~vMklCb{BxfD|oCqxCcd@qgTme_@{`k@puCc`AceJgoUogHayCzoAsjH|fFefAuh@i~FqsClvAaqFueMieClvKopPul`@j~GlV|XauC_tMsmTnjE}~Hsc@|wKznMl`Inz@`zYr{BqwC`|MfcDeqHyxH?}wWr~AfhMjfEkTdqHddLv|D{wIl~IheDlpChgOzeD{iDytIu~Ze_CneHrb@adV}aHq~WtdBdlm@kaHjVq|K}~Ite@exEpcKvl@wsIkpL_xMxiAf~EppE}eGl_I?exEmeGc|AhyHcuQ{~GmjPh~FooEbwDzgEzhD}mCo}JchVn}UxnLb~BhxH{zIxm@`wCliEfgMerHruPx|u@qjGarh@|mDm_IcnH{sWvvSnuN`vCjsLokF_uCteA~}KjyUfbO`uNym@rqG{_HgqIkoA|fi@ofTfi@a}KpwCbc@jvVzgPlh]pzd@f}g@nbUb|Yjqc@bjJcyB}oBteLddMx|QrfVfpJhiBtrG~wAgcCz_^zhPj~H|wKz_Sf{EdoHbbYxqK~vLx~Ehz_@~|K~gS"),
+	country("New Zealand", "b_g_F}rcl`@jkB}aHfhBnmEhuHaBkjCbk`@k`Geh@t~BybP"),
+	country("New Zealand", "zip~E}fhp`@{ShxHiyGv~Cui@{hD"),
+	country("New Zealand", "bwb}E_`fj`@n|@_gGphHs`AaClyJ"),
+	country("New Zealand", "vrx{Ey}|k`@~iFu~B~wAhtIkiC`yB"),
+	country("New Zealand", "~km|Eczkn`@|iD}jD~gGplGakGzjBbbA`qFutGdcA_cIlzVatCti@rzB_jGwqI_z@qrEhzGotAgpIodIhkAbe@wvGj~GqlG`wBzkBbtF_pO|tK`sD"),
+	country("New Zealand", "b{koEoguy_@lwCrF_lC~zD"),
+	country("New Zealand", "|yvpFug`w`@~~Ie_OjTigOh`RpsPzlhAdq_@d`OzsKnbIfoUjdFhlAubCstEdq`@|tMptCbxO~_Vl~Uzp{@p}c@rjIrwOxv`@b}Nx|SfxRhpz@|fdBvjLjeh@exQzxIwvS`A}fFns[tgKj}SquCrdKuae@quCrzCpjS`pD~vA|sB_|J|gFziCbc@doUohH`uOysIeLu}C_tNuhWe_ZvfBi`F{xJ`uCmzJ{eQobTuqGgdf@et^mpf@yvIgj@ycDk}_@ucBur^xvIqqi@|gi@gpIhnWg~Epqt@sqh@zpo@q~Lv|_AihNxlY_yLr`Lg}Pb|CkhQmqByhMmtMan^abeAa_Aqvf@ye[o}a@o`Vy}Fgmm@}u@m`a@wmKo{b@gN?inLdqHegLiq@c}BgbC|lD}iDofShm@rtEeqH`gI`tDxnKkbGgh@c|M}mP_vBdxEblI~iFebBjkD_cUkVoeH{gDubCgz]e_CnmDqoE_oDr|A|vWm}cAp{Xm`UwmVrbAtkJbkIz}Hs}AjiCutv@t|[di@qhT|iDlrAby[sxO{tK|XmqAinLmtAhfPkoMweZnqCy`Q}aTn|V`{AvyFoyKmdHdG~h_@xnM|{IijBjfEx`EjnNuzs@zbQmgi@``d@g}Px}E{iDmjDxwIfj@ctEwrIh{RmfRfbCtdBltAk~HltA|hFrtEui@nxAmbG}mD?`kHuzDmkPwqJvi@dbCwhLb{BoxMc{BomE~oDaoEqxCc_B~lFtwEf{QyuIjwJq|AcgWszNwiXhtIdq`@otA`jHygCo]xdCemIscWshJ~]qtDcsFn`JjrKjs@bsFbjIe_Ce_CsuErzN{vIkoAliDz~RleG{rKjcFmY{pAcsFv_EarDhfDdkJcuQbhV_[n|K}aIdaNubMnvA}nOzhOamG}_Hm_HrqFn`J_yA~kE|fGjmN{lNrmTclHpaWqdWp\\_gGnzLcwDrvE|mDzkBppQ}sXl{I{bgCjnwBc`Yk{HayBctFrtEwtGytIiTtxE{hDkzIsaA_vA_`IiyHifD}oBhrKlcGlVqbKziDrxOcFv~Dlc^fnWjfFgmWjbRmkP{_HpyBboHtoHd~BajH`iHkbG{wI|w@|nN{iOloOs{CwfBteA}hF}kOo`Ik`_@lcGw}kAzxdAa{LxmWuhLqrDbtEo}VaqE{nMqBqab@tzEjcF~tM~[{yIfhL|rMbfKb~BomPnmPsaAisJorCixHfdCbcAwaDps[y_FnnQk~SneG_z@q_KjqLh}EmWpnRqea@leRjdEvk@gmJaiHqmGmfRbaAkjCcaLixHowBtmI_\\_xAmbGrtEyoA`qEnjRfeNytIpqEa}W{pLfcCmoBmnCj}Ge}OueAafJh{G_dInaJxuJnkEy~Eu_OevG_xAcxO~`VojRzmBc}Yj}GkhDbbAzbGmbGzxIzlBtvEf|DopDxlBjfFxPyiNzg\\agJi}FihBug@{iOakHvzQwi@mzVuiLayMj}TjoAddAo}Jz|HebCgvGnpPnnPskIf{EmkPnfHatDvfBtxEb~MusRbfJlYmt@liPfvGoyLnlEbzDfzFinLvbOc~B_[pjH}wL`bKdbChwTa{Mf_DbcXvk@c|CuiKjqAidP|fFilA~kFdvFvbOe~BxtIcgWzuKq^nc`@ove@rhJzoBveBinMjRfjX`}XonDaxMpcKtrG{pBt}Cr{Cb{BstE|mDrtEzsK{pAzjBudYrwE|uMoxAdjJjqd@stQui@ltMukJh{FnkFvbOti@q_KlmCnnEb|Ng_EcfK}wLte@wkWhzSuaBwoVspGdxQomDmqA}zJi_Gt}Bo\\oqC`}@shI`xO}sA}nDcy[pbLorOrwZbGjzIohGjmBqwNwwGulUp~LmcH_{@}qBasDlhEq}o@dgLkbRt{PiQqaLyhBa{Awm@pbKm_IszNc{NxtIijMs}AwzRzlZshIq`@eLgfNj{I{yI{lC{aFrqGcyDxzGnrCxxGinL`oF?wLzdFfbDwmKxqJtf@mbGumI{Waf`@byDjTpxMvjXl_Ip]`{AybEa}Ku|BjkBawO`}XmgFlpNr{BrF_bIvbOh_F|t@kgEftIwi@ctEdxE`xM?v{F{wInf_@o_KjkCruDnhFwvRl~UyaQ_qD|fQilAteBmtA{bGkzIftTdk@lcHdnHmtAltAw{F`iH?n@_|KjwIghLgcCgyGtmI_jFq_@g_EewQlnDxpKivIlpNueo@fqUc_f@hbPwciAvfB_jFvOzqL|fF{uKkT}iDibFnx@psEq{Xy|Rcvt@elm@ipb@_tO{mp@cjH?fdBgxv@voJwmKrdKuyg@|fF}tAfrx@zdi@~{K}kEbcL|`IlfGu{CfdNz`Fj|HcoGx_i@`bKxsa@x_j@g`DvbOpvB|tKvoJ{dEzh~@rzNtsGitUbpGvsIfbOliEbuErnG_~JziDygZccAe|DnutAjlZ|_cAhd\\n{Wd`OtwEhpKmdG"),
+	country("Japan", "uzaaEahcwWvqIgeBnwR|uLjmCnoEa_CxcF"),
+	country("Japan", "ccfeEuxqbXfwIkvDlyEftJxqbBhqb@hvI{t@naDy{F`ea@j_GtaMhrKbla@pgI{_BjgPi_LlgF`_@loZz{OrjJ|tEatPbcHfyDdOyhJdlJteWnnRvdLhaVngv@o}Kcb@qbI}nNuqj@ogI_mU||Pq{O`p@ezFfmScpE}|Qd}IuxEiAy_Fw_P}aIwqJ`sE_[luXjjf@vqUpuJlpAjfV}aHlhKkaR~qOd_C`aErlI}qAjsKkwNfxLhRxuk@g|NtjGgv@mpDcaMziPcrAanG`}F_{KikKeuKu~Uq`G}pNlw@k|[ra]}wGauBf`B_wGorFfmFm{LoqCgoLp`HqtHczDgyGdvCwzFwjLnnEw|DsmBy~Fouk@kh\\tv@akGyzFfNwiM_~JuwFdaCotAcmHa_GbtEc_SofSziDz_j@gcOkwa@ovGrsEsdFaxBs~Y`f`@s__@r_C_tRl_b@pcLlfUxm[coQhuJ`rEfuFbgObpE_mHclEeqPzsB}uQn|Mm|Ej_QrvAvpQv`e@ewPrkFyiLsoNw}FfuDcK~oOrjHrbSsnBzhE`}RjlK`lQbe]ekQmnMsxDzlBshEkcKxNl{Ey}PzhIjs@jnFcsVflQcv_@{lGv_H{fNjbJpoBggDeyC`oF}bJftKvfB}mEhzBpfMqm@xcF_~HshFakFl|DslV}hOp`M}}O_yAwdHbpKvyBzfJutErcDfhA~}HmiMgkA~fBl{DuzFhtBh|Hhb@z`A|iFc_Gc_CakHruEm}EjsRyfJ_uFobPhdA~t@ynKaaF_sDp{G}lDn]kxSthLsrG}|UyiBucJv{NqxD{aE|`GuiImvLtvEitFeeBbaC_kPzdIzD|qEywM_tHeoFyqFi_U}tFlsMy{PsmU|vPgaOs~DssRspK{o@~mDvuHs{FdtJfvBo~Ik{IijRcvGw}C_uFt~BckIcuF}fPmds@bgIsw]}rMwgQjg\\d{GrR}yGhaLuP|xVoeQ`tH_ur@_j@_xHynQ{dSjx@qq[l~OqcKttQmo@|sM`aEuj@`{KxbLnrGmtAvrPloPeZpvDu{{@qhC{uN|_ZrdQtPy{Rn}DvhGrwBigAolBuaK|hEuqEscEc|Bj|FkrAnXjrNdoKzcDxoFm}IbGeyVprIviRbmBkoBjpGtuGhiBi_GjhD`hUl_R`eDyfBlsE`lUfjNzf@lpFppJvyBh_Em`I"),
+	country("Japan", "m`nmEerauXwi@__KbyL`q`@zld@|cs@nyTffLzzj@rhLwhq@vap@{pGhhc@nfC|uXl_KjuZ~gIvbDzyHzwUurAv_EhoFnjF``LudB`aMzyIrpDa~@vgCnxJxeUlcLecBtwGjgIt~Hd~UuCjgB|fHplFkApsMwaM|mDt}Co}Kv_PdhF`cWipHvsN|hFjjOigHvY}mTcwO{iD|i\\vxGhuIoeGhfEgsJquDsgHjzDeeAvbIzgEbhFutHo~CfIiuM_fHbtEunBi_Goz@vsHmcEaoBu{DpnKUcuKzxEwaD}gJsfK{}Epj@ivAbbJimFo_Ge]ju[ihCmeFw_JrwCebHuzDwzBlcUntRbra@woCf~BzjFnnR_{Q}k^mmPman@sqPqfPouP_ka@u{c@o~Eac@aiGexOg|A{aMyc\\kmGak@_dAbrD}rBowGxrg@}`c@{dJoee@d}Cmxc@agUimXerS`@urMfdOfoDe}Zwa[m_m@|iDyd[mrGcdKhaL{lCu{D{zHzfEgsIpsD|rAlwOepb@c`Ekmg@jvIlsFp^quEhe\\~gHveNuxRh|Gij@bkI~`J`_@oyL"),
+	country("Japan", "auk{EmgokXvcHuJleGtrGaqJwL"),
+	country("Japan", "ofo{E}`fkX~eMbvDadDdyEpmG`}AgsCz{G"),
+	country("Japan", "ipfqEy_jmXxbWzlZkmBfiAyfDwxGwdB~_JqxBmpBsyCbvD~rOjvKxpEydDtiDloCtaC`iTciJ|X{tE{cGxpEqvBmeFw{EexE~tMbfJrtEfxGxfg@osChhBx}FhmM|yE}x@``FdoUwvB~iG~aDhpJqpQj|HmyK_vAdYdo`@rmTbcWbaMgcCtkJzcGjfVueAd_IfdNn{QwaD|wKvnVomJ}fF~[g}DmeG~y@csWfio@~mEt~CuZndHicJcmGswDb{NfuBf}[daI~xAk`HtvQbkI|cGwi@`jHmpIjTfdNpeJdhFzpMgaDnfGr}B~iFinRdtM_nBfeLldY`lVstCbbFipUinEgrJv}H_{LaaKssQnbIyxM_pDcfDsbYuwA~mRmnCouAg|B}qDbrDsoEyF{fWzpGkqFgaKrGap@e{N|vDsqD}{@dbIjmHd_CqtIibg@qqQw{FuaHu}NwrHeaCmKcpFcjGeEevIsar@ovG}~AoaUwdb@e{E{y@{f]uop@k_UgyPojVe`l@kvP{{EazIrpFgzM{h`A_oOovVzqFqvKazA{v\\|mGvpInjFa}@tdG{mYunNcdb@x~Dqn\\kpGk}aBuoWcmy@z`EsdkAobR}t]coEa{ZztRunN|zAxfHhxTtwLeaHmuJxrDwlCpaC`hEjrEu{Qv}J|eBayFkpEb]mvHwxIlrKqzK{nW~wNwaBm~ConErxIikBaxHgiVzs@caCxiH`iHaYu~RmjGqsCglAn`JayCqcH~`FevQa~FzzDeWqzHgqKblGh{Em`TojCa}JqbRnxCkfFamKd`KuhDhmC~zBdvBcfGkfUu|D_kTpuSklU|{D_`f@{s]{lIaX{dAqdM}vWufc@ojaAg`{@{~]syMwhm@`rF}pC~_Jwrg@ukNacGwdQLwaQyfPe~a@kpGuob@~xA{yGh}L__AvgEtpSjeRo|@xeEjv[`gR|mQ_uHjiKf}JllJjzQ|dE_ReeM~lEyaHwcIwaLlxXwl@huQ|_LjzEgnBltMqq\\_Kema@{hFeyHu`Y}nK{eWcvvAsdUwef@qnAgx[i|d@yuz@mwi@wge@obl@iuTkzWubh@wxEmz[ivZuod@wxu@{sKm`m@{tWm~_@mba@s~uAw|ZetKmiNksa@}kIsoj@_bAo}QbeEkmMvvSx{Fbo^g`SlpJcxGdA`lG}nRufCk~KiqZmuTmnT}mEgjV}pA}mKvgNwu\\vlBkkFzhLs`^cgc@vnCk|MweLo~[__i@w~HtaG}hHo}EGgXomFy_BnxNauLdfDojAjdIcdDacMusUqgCn~NylVugGuzOzfEslOxy\\ut@fwc@maKxwAswQyvGiiIg|JitBwq@n|DihJ_lHr|Y}km@wjUypRix_@w`IujTx`M}tAfvGrfG~xArkItiPyrAfvNbhL|y^ofBpoHg`c@mhFwq\\{bRqfJwd@vqMcef@trTe{_@gxB{uScgJciNbbmAdoMjjeAg~Hx`TarIh`Ek~F_cAalG|oRcpUnid@wfZleLxiBtsGinKxmLdxEhlMk|SjaMxl@zs\\sfKpm^ttG{iD_xMpuIp`@hsEevF~mPl|Usa@erS|oYf|\\e_CaxM~sCwOpcIloOxeD?kFqjHdiFhiB~[|_I~iF}fFjmBbaMhtI{qLti@dxQvmDytIze@tnHdxEavCoxAdkV~rOyNqyBzxHkxC}sAx|@hxIdaYg{EusFleFlv@blHthKbHl~H|wKprKwvGvfG~dShvDuaMreKvhLd}HqvNtgF~\\qeD~mD~~Jti@xiBniFdiFyiB~i@o_KdtA`jHpmL}bGtxEzkBicEpbKszNv{EsjDrcW`iCx|RthKlkEmoHnkFjaApfIl`If_E`zAkfEthW`fUfbg@~dHxom@k_ShicAqyBnon@rsExv_@~gHntNpm]l}UreKxfr@nbVxz_@vh@ztIvhIjzVexAvy[yeL|dx@sql@rkEbtBsbDhiEh}Cft_@p}V|ta@dcc@t`RrqN{hDjkWt|E_YhrDhxHhlDjo@hmc@l}Rt`[bbUprKbm@rgO{~IdyOclGcaUmmDttEanX`hBmhP_gH{fHfiBexC`jLw_AwlGuiH{_CdjAwjHgoJcm@qyH{sSkhQexQwyRfcVbh@fiKj|CavBvkC`hG{sCrsPlyEnmBeyHftCrkZumCtoJfn[~hC{xGvcEjByDd~HjgSqmAvpHy}QxdGzkBlqAx_K~lIisBhg@zlL_gLryAc|@qiCuxQrqNicAfgXdzIvdl@fnDziFhhRqjAguA~gFxtMfrGt_DwuDndIjxBxvIg}Ij_MtI|xYfwZlhOz^keAdiIrvKprSktQliP{tJ}kDc`Xr~B{iDy_FygMnmD{aHgvCmYevVgyPljSuiBr|SdkGdwZzkKf~IjbGax@q`DyxCrdFhkB|gKzv[rbKpqF`sDimAvhUfkWhyLzjCfdH_wFu`Mr{r@thDjga@{kFh{n@rfRbufBiwOqaJxsFslF}sSin`@|cGmcCmaFic@oDgdEu|Nj{JxEpuOplGlrCc}B}BvCr|\\sqHbvJe}P{_Cr}[pjKdpNs|IgnG`qUo{Qyr@i|EhcFgeOrYqfYcdKvuHleF_uFbtBl`Hqx@gcBbxF`xCxH{RzbMrmG`~KbpQd{B|aWjkShqOf_BxkN{cE|bAe_Obu]_hv@jhJqCvi@jbGfxAswE`fDheDx~JilAduAfyRyvCdf@boBwnIqkH_]plAva[usF}XtrL~gRet@~rOrmGzq@`}Gjxa@|xDnjFppK_z@_sBj{I`pErtEjhJ_~Jz}Gfh@yfCh_GxfCziDnmE_sCpdHtdWbot@|v_@jwComChePxl\\b_MfrHglHua@epLps}@coVb{U{gGmnKujL|`^ktTzfUtoAztJapMwgGaoCf~CwgHywOoiGrbLiqLepDcmAmbLwaQnvLw{CdmJw|H}qG_vCwhS}wZi}^akMkdGgdY`HorA|rWvpDxnEmtC_Gj~@rxEbyFgjC_vC~~IlsCxKtbDx~Y{sZpzy@vi@l_k@}iD|lD|~NnxY{mC|j[byD}tLn~JbcW?stEfxFjbG~{@jgh@tgEsc@}tFsjJzoAyzGhmXrmTzr@puZ}oO~zLlfSjnp@gpJqfI`~@v~P"),
+	country("Japan", "maygGmeuxZrmCk~HxtIp~c@toZ|fR_\\tpk@hzGj`_@vxFdaB|u@i{GxzGhaQkaAtdMpwIeeAp}@bkUccLtvRbcLdiKnvGajHz`AlbGkoAzbu@uxKnuZhzMh{u@~aOnk]f`n@|w{@bov@~dk@nmh@znB||a@nnPgsa@j`v@cl_@nr`Bmyc@jax@}bAhpSmdX||n@edBbhb@d`Itwg@xu`@bt`A~~P`iUauDj|GedA{wIokEhmLylHvfBu~Zhw_@{|Adol@j_GziOjqSd{PncOjeFbuPyRxa]weq@umDmd_@h|i@s|q@leGa|XzbMkdQx`P~xd@_mJxrb@d|CxsUgzK_wBuaBp`L|vP|}I`xI`q\\|i\\d_CzmHtx]zzNnyKcbG`j`@wdZdlJ_xi@}cS{qAcpG{|_@mqBa_W||UooErpRi}QdfXetMf_Ew`UioLue_@|WeqMesGklCql^s`Xwv^hcJczNgtYstQq_Sgc[e{OprEy}Wzc\\skU{hCkSilMsqGskInpa@gj{@ipEi_i@npIcbAhtEsv[m_JyiYypVciV_qPgeByrU~qN{yI{cE}bYfpIqiNqhHwvHu}Zo{Q}}TmcXswDgah@`{Asw[u|Pqni@epHarn@nhGinu@ts_@o}OxOopP}wKexQrFok@cvDteGa|As}BkrXefQexQlfi@_}y@h|u@gc}@`iYezPv`y@eocA~bg@eggAnlKuoI``X{ogAfj@dxQpmLajHpiBg|g@wiF__Kv]umV~nCqfIjpM~|KfhA_gHalRarPfbOg|EvtNwvRtdGwoeAmkDcvPqcb@_mi@{iOg|\\ihZyhZv{Qw|D``b@hwTxvUxtUtya@ffMxbc@cv[bpj@keQv~Ju~Ni_GbcWpwBfhAr|HwjKnfHude@}bGd_CkvKsvOmrG{nN"),
+	country("Japan", "m_{sC}purVpvCekVxiTn~JynFf_g@yfDe`BjVemJmgR}jD"),
+	country("Japan", "mcktCk}vmVlxEnYo\\htJ"),
+	country("Japan", "gn`uC}_zuV~|i@f`PxfCvyRgkGiPc{HjsK{rEk|HbkCidO"),
+	country("Japan", "cvhvC_}w}YrfKfiLgoJa}@"),
+	country("Japan", "qggvCcuh|V~wG_bJzVrdc@ys\\eH"),
+	country("Japan", "uu||Cswb`XvfBihC|tFbyCq}@f{F"),
+	country("Japan", "ezy_D}q~dWrmHfcC_yKrxPouBsaM"),
+	country("Japan", "{hsaDqb{bZjiDjpAuqAt}BelPrnHb{BwsI"),
+	country("Japan", "{wpbDk}hnWlwQrwDjeLzsKpyA|nOzsKd_CbUfbOvkR~eT`}@btQluXeiLeh@t{PhnR`eJ`cEmZcIclH|dHxeDhxHb~Lp_@hkMymXlsAetS_gSsjT`xBkmBycOiwU}g]w~D~Yw}ClvLsoMvl@|HqhTtnI{r@qa@_aKio]k|_@itOcuD"),
+	country("Japan", "kjjdDqb{bZaOgyFtsGsGqEh`F"),
+	country("Japan", "sgqfDizopWptPfbPgn@bzO{|GegA"),
+	country("Japan", "kzvhD{bhrWvsIw|Ev|JpjH|u@~cH{wI`pFyd\\{UumCagI"),
+	country("Japan", "ibsjD_gdtW`nA{{H~oD|pCe_Cz_SsxPx|Fb_B{gPfaEjlB"),
+	country("Japan", "gudlDgpsxW~bOtxEue@r~N"),
+	country("Japan", "k_vlDaotvWdmE{s@hpDf{Q~bUvoVkTl`I`_GjiDrtEqiHbxIxtUb_GavCukJnmP_aIhkBe_CbcWy`@wlWorDcyBe_CtfKqvCkaFy`Qkhh@|mDk_G}mPsmTdsG~{@uFkkD{tLstD"),
+	country("Japan", "y{~sDy|uvW~w@crFjtFlkE"),
+	country("Japan", "c|iuDyyxwWhwIyfD_{DltNgfFmbA"),
+	country("Japan", "cpkxDakq|WdzVryLxeD`_VmqAtlHuj]b_Ki[meHenLmwM"),
+	country("Japan", "onf{Dab{~WdgJo_Fzm`@l|Dv}PrsMrr[z~BtsDv|P_tW~tB_yL_kNetXww@"),
+	country("Japan", "oxpcEyokyWziDi_G~zKfwGqdOnmF"),
+	country("Japan", "s|leEou}zW?wlJ|tYvvSerH|s@`rDjzTk_GpsDcuPmh\\"),
+	country("Japan", "quseEa~lyWn|KsoFfrZmZ~`VraXd{D|oNg_KotA?bjHqtI}bSwbDloO{d\\yzHk}HziD"),
+	country("Japan", "_slfEeqrqWfcEeaCwYtjVptMvg@{eEtfFyaAnnYynIoeI}lU{q@xrFuqF{}IggT"),
+	country("Japan", "wvfiE}|etYhiHc{OxiHlcGskNf`QqeDgm@"),
+	country("Japan", "u_jhEmm}rWulBw~L`cCkqAnyKjbOlwRf|E_qUjfEexAdnIeeE}sMwwJlc@jdBa|Eu~ZevC"),
+	country("Japan", "qp|jE_zvuWrtGog@ttVrnLv`LdvNcG`uLqrEam@lxB_aJw_ChsDijCmsFsuTy`D}}BstNmcF_N"),
+	country("Japan", "_ggmE{ycfXryCcvDudA~oO"),
+	country("Japan", "{jcmEk}awWzjCmpCjxAtrKvrFrvAcwGlnKy{Dl`A|sAg{Fu~I`{Dc{IgeEfeDijNdjJexD"),
+	country("Japan", "q`vmEckbfXntAb{BozKtzDycEwwFl_JqpRccGu~ZjpGlpO`gIdbA}bGjyI"),
+	country("Japan", "{n{nEeqjrYmaIkbFrjCmaI`gIf|F"),
+	country("Japan", "_q}nE}t`hXwgBboRczOynKjVkhExbK?i_AibD"),
+	country("Japan", "kixoEumwjXt~@zuGasEi`Fe_CfcCyiG{wJfgMyfC"),
+	country("Japan", "ogfpEijgtWcl@awEty]twLlzF`~IaoB|oHmmj@i~E~~EigB}vD}}I"),
+	country("Japan", "{}|pEwf`qYmV{gEl`NtwE"),
+	country("Japan", "smfrEwu~vXzwDojD`gg@vfY|~RqeKjoNxyk@c|DvdDmcEyfCpw@r~E{uGj`EeyFabDwHuiFgzUmgN"),
+	country("Japan", "qrhrEavbuWrjCu~CvjGfvAz`\\`_Se`@}bEnfMkn@`jFxbJqxCxnFwMovFyqDcK~mAzdGm`GkYl{FnnCmgA`fJs|BonGmeJrVsjDkgGoItxEyhM_xHevEhfFwgNutEbS_wIw|I{yO`vKgsC"),
+	country("Japan", "echsE{b}qYhuC{eEneNmVy_Gf`SoaN}_@"),
+	country("Japan", "_yk|E}f_mXn{KikAvfBrkIhpE?ui@jrLu~HvkLsyNwfCazGwbO"),
+	country("Japan", "yojgFgyalYoZysUpv_@|qM~eNhlXxdDbz[gnDvk@{oBcfLkgJ_\\m{JytIg~DllDnuB`aL{uDb~Agi^ubNmg]szf@lxm@`zM`dEq^"),
+	country("Japan", "}sy`GeqvrYxyZdvFj~GtjKwuYhgC"),
+	country("Japan", "iiaiGad|uZhoFm_IdfFjnNahHcnHsmHfiX"),
+	country("Japan", "evurGw}q}YpiHxiBt}CvnJqjHdwRuiK|pBsqFoyL"),
+	country("Japan", "e`ltGsj|{Yv_DgaC|e^~kFaxe@hmL"),
+	country("Japan", "kwdbDmzakWvpDsG{c@`hI"),
+	country("Japan", "kn~xDkrxyWncFcfGpxApuHykLzxL"),
+	country("Japan", "e{{qE_sbsXrbWt~BwwAh_GqtCa{Ad_Ip{Mk_HrF{~Ad~Ns~FmtA"),
+	country("Japan", "kbjaDsaogWhmAihC|v@bvD"),
+	country("Japan", "}uadDwv_oWpfDbc@k|B`fIu_DokE"),
+	country("Japan", "c~vrDgz}uWovBgfBt`DoY"),
+	country("Japan", "_r{uDcsbxWol@`lGkfDks@"),
+	country("Japan", "mjg{DkccxWpbEvi@gbCv|E"),
+	country("Japan", "ygymEqaesY`sDQcc@|bG_oEudA"),
+	country("Japan", "oxl|FsgtqYbyClgEqcD`B"),
+	country("Japan", "cxsvCaud{Vz`GwMefAzuKanFoqC"),
+	country("Japan", "e`__Dg{khWtyJtcBifIxl@"),
+	country("Japan", "}doaEor`xWz_G|X|bBttFubHtuFfiAryDezEqwCdyE{tK"),
+	country("Japan", "m}cdEmg}yWd_CovBfaD`fI"),
+	country("Japan", "eq}eEm{d{W`sJ`wBe|I`sD{jD_kF"),
+	country("Japan", "myhgEmvvqWjjIxkBwkA~fGo~IxPfzE{cFkcFbcA"),
+	country("Japan", "yvsgEcdarWrsLjq@ekJhqKboAsoH"),
+	country("Japan", "yvxgE_worWnbIjVe_Dl}IsxCjTho@y`EqpFtcA"),
+	country("Japan", "e{fjE}wdsWhfIjr@qlApeI"),
+	country("Japan", "}jrnEqoqhXvrHfj@js@hzG"),
+	country("Japan", "ktqoEoyikXryIr|M_xLubB"),
+	country("Japan", "abvoE{ndiXzzB{mCxjBvdCmzDz}H"),
+	country("Japan", "ixepEedhjXryIlZ|h@p`Ls{CzT"),
+	country("Japan", "uakpE}ivfXdwFjoAt}BbgJ"),
+	country("Japan", "qucqEkgfxX_vCu~E|tBocB"),
+	country("Japan", "uglqEgmdqXmbB{yId}Dd_C"),
+	country("Japan", "yhwaFuencYdhEny@loCtzPmbHneG"),
+}
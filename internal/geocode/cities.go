@@ -0,0 +1,375 @@
+package geocode
+
+import "math"
+
+// MaxCityDistanceKm is how far a point may be from a city for NearestCity to name it
+// Farther out the nearest bundled city says little about where a repeater is
+const MaxCityDistanceKm = 80
+
+// City is a populated place in the bundled gazetteer
+type City struct {
+	Name    string
+	State   string // Empty outside the US and Canada, like Lookup
+	Country string // Spelled like Lookup's countries
+	Lat     float64
+	Lng     float64
+}
+
+// usCity is a city in the United States
+func usCity(name, state string, lat, lng float64) City {
+	return City{Name: name, State: state, Country: "United States", Lat: lat, Lng: lng}
+}
+
+// canadaCity is a city in Canada
+func canadaCity(name, province string, lat, lng float64) City {
+	return City{Name: name, State: province, Country: "Canada", Lat: lat, Lng: lng}
+}
+
+// worldCity is a city in one of the countries outside the US and Canada that Lookup knows
+func worldCity(name, country string, lat, lng float64) City {
+	return City{Name: name, Country: country, Lat: lat, Lng: lng}
+}
+
+// cities are the largest places of each state, province and country, plus regional centers where
+// they'd otherwise be far apart. Coordinates are city centers to two decimals
+var cities = []City{
+	usCity("Birmingham", "Alabama", 33.52, -86.80),
+	usCity("Huntsville", "Alabama", 34.73, -86.59),
+	usCity("Mobile", "Alabama", 30.69, -88.04),
+	usCity("Montgomery", "Alabama", 32.38, -86.30),
+	usCity("Anchorage", "Alaska", 61.22, -149.90),
+	usCity("Fairbanks", "Alaska", 64.84, -147.72),
+	usCity("Juneau", "Alaska", 58.30, -134.42),
+	usCity("Flagstaff", "Arizona", 35.20, -111.65),
+	usCity("Phoenix", "Arizona", 33.45, -112.07),
+	usCity("Tucson", "Arizona", 32.22, -110.97),
+	usCity("Yuma", "Arizona", 32.69, -114.63),
+	usCity("Fort Smith", "Arkansas", 35.39, -94.40),
+	usCity("Little Rock", "Arkansas", 34.75, -92.29),
+	usCity("Bakersfield", "California", 35.37, -119.02),
+	usCity("Eureka", "California", 40.80, -124.16),
+	usCity("Fresno", "California", 36.74, -119.79),
+	usCity("Los Angeles", "California", 34.05, -118.24),
+	usCity("Palm Springs", "California", 33.83, -116.55),
+	usCity("Redding", "California", 40.59, -122.39),
+	usCity("Riverside", "California", 33.95, -117.40),
+	usCity("Sacramento", "California", 38.58, -121.49),
+	usCity("San Diego", "California", 32.72, -117.16),
+	usCity("San Francisco", "California", 37.77, -122.42),
+	usCity("San Jose", "California", 37.34, -121.89),
+	usCity("Santa Barbara", "California", 34.42, -119.70),
+	usCity("Colorado Springs", "Colorado", 38.83, -104.82),
+	usCity("Denver", "Colorado", 39.74, -104.99),
+	usCity("Grand Junction", "Colorado", 39.06, -108.55),
+	usCity("Hartford", "Connecticut", 41.76, -72.69),
+	usCity("New Haven", "Connecticut", 41.31, -72.92),
+	usCity("Dover", "Delaware", 39.16, -75.52),
+	usCity("Wilmington", "Delaware", 39.74, -75.55),
+	usCity("Washington", "District of Columbia", 38.91, -77.04),
+	usCity("Daytona Beach", "Florida", 29.21, -81.02),
+	usCity("Fort Myers", "Florida", 26.64, -81.87),
+	usCity("Gainesville", "Florida", 29.65, -82.32),
+	usCity("Jacksonville", "Florida", 30.33, -81.66),
+	usCity("Key West", "Florida", 24.56, -81.78),
+	usCity("Miami", "Florida", 25.76, -80.19),
+	usCity("Orlando", "Florida", 28.54, -81.38),
+	usCity("Pensacola", "Florida", 30.42, -87.22),
+	usCity("Tallahassee", "Florida", 30.44, -84.28),
+	usCity("Tampa", "Florida", 27.95, -82.46),
+	usCity("Atlanta", "Georgia", 33.75, -84.39),
+	usCity("Macon", "Georgia", 32.84, -83.63),
+	usCity("Savannah", "Georgia", 32.08, -81.09),
+	usCity("Hilo", "Hawaii", 19.72, -155.09),
+	usCity("Honolulu", "Hawaii", 21.31, -157.86),
+	usCity("Boise", "Idaho", 43.62, -116.20),
+	usCity("Idaho Falls", "Idaho", 43.49, -112.03),
+	usCity("Chicago", "Illinois", 41.88, -87.63),
+	usCity("Peoria", "Illinois", 40.69, -89.59),
+	usCity("Springfield", "Illinois", 39.80, -89.64),
+	usCity("Evansville", "Indiana", 37.97, -87.56),
+	usCity("Fort Wayne", "Indiana", 41.08, -85.14),
+	usCity("Indianapolis", "Indiana", 39.77, -86.16),
+	usCity("Cedar Rapids", "Iowa", 41.98, -91.67),
+	usCity("Davenport", "Iowa", 41.52, -90.58),
+	usCity("Des Moines", "Iowa", 41.59, -93.62),
+	usCity("Sioux City", "Iowa", 42.50, -96.40),
+	usCity("Topeka", "Kansas", 39.05, -95.68),
+	usCity("Wichita", "Kansas", 37.69, -97.34),
+	usCity("Lexington", "Kentucky", 38.04, -84.50),
+	usCity("Louisville", "Kentucky", 38.25, -85.76),
+	usCity("Baton Rouge", "Louisiana", 30.45, -91.19),
+	usCity("Lafayette", "Louisiana", 30.22, -92.02),
+	usCity("New Orleans", "Louisiana", 29.95, -90.07),
+	usCity("Shreveport", "Louisiana", 32.53, -93.75),
+	usCity("Augusta", "Maine", 44.31, -69.78),
+	usCity("Bangor", "Maine", 44.80, -68.77),
+	usCity("Portland", "Maine", 43.66, -70.26),
+	usCity("Baltimore", "Maryland", 39.29, -76.61),
+	usCity("Boston", "Massachusetts", 42.36, -71.06),
+	usCity("Springfield", "Massachusetts", 42.10, -72.59),
+	usCity("Worcester", "Massachusetts", 42.26, -71.80),
+	usCity("Detroit", "Michigan", 42.33, -83.05),
+	usCity("Grand Rapids", "Michigan", 42.96, -85.67),
+	usCity("Lansing", "Michigan", 42.73, -84.56),
+	usCity("Marquette", "Michigan", 46.54, -87.40),
+	usCity("Traverse City", "Michigan", 44.76, -85.62),
+	usCity("Duluth", "Minnesota", 46.79, -92.10),
+	usCity("Minneapolis", "Minnesota", 44.98, -93.27),
+	usCity("Gulfport", "Mississippi", 30.37, -89.09),
+	usCity("Jackson", "Mississippi", 32.30, -90.18),
+	usCity("Tupelo", "Mississippi", 34.26, -88.70),
+	usCity("Columbia", "Missouri", 38.95, -92.33),
+	usCity("Kansas City", "Missouri", 39.10, -94.58),
+	usCity("Springfield", "Missouri", 37.21, -93.29),
+	usCity("St. Louis", "Missouri", 38.63, -90.20),
+	usCity("Billings", "Montana", 45.78, -108.50),
+	usCity("Helena", "Montana", 46.59, -112.04),
+	usCity("Missoula", "Montana", 46.87, -113.99),
+	usCity("Lincoln", "Nebraska", 40.81, -96.70),
+	usCity("Omaha", "Nebraska", 41.26, -95.94),
+	usCity("Las Vegas", "Nevada", 36.17, -115.14),
+	usCity("Reno", "Nevada", 39.53, -119.81),
+	usCity("Manchester", "New Hampshire", 42.99, -71.46),
+	usCity("Atlantic City", "New Jersey", 39.36, -74.42),
+	usCity("Newark", "New Jersey", 40.74, -74.17),
+	usCity("Albuquerque", "New Mexico", 35.08, -106.65),
+	usCity("Las Cruces", "New Mexico", 32.31, -106.78),
+	usCity("Santa Fe", "New Mexico", 35.69, -105.94),
+	usCity("Albany", "New York", 42.65, -73.76),
+	usCity("Binghamton", "New York", 42.10, -75.91),
+	usCity("Buffalo", "New York", 42.89, -78.88),
+	usCity("New York", "New York", 40.71, -74.01),
+	usCity("Plattsburgh", "New York", 44.70, -73.45),
+	usCity("Rochester", "New York", 43.16, -77.61),
+	usCity("Syracuse", "New York", 43.05, -76.15),
+	usCity("Asheville", "North Carolina", 35.60, -82.55),
+	usCity("Charlotte", "North Carolina", 35.23, -80.84),
+	usCity("Greensboro", "North Carolina", 36.07, -79.79),
+	usCity("Raleigh", "North Carolina", 35.78, -78.64),
+	usCity("Wilmington", "North Carolina", 34.23, -77.94),
+	usCity("Bismarck", "North Dakota", 46.81, -100.78),
+	usCity("Fargo", "North Dakota", 46.88, -96.79),
+	usCity("Cincinnati", "Ohio", 39.10, -84.51),
+	usCity("Cleveland", "Ohio", 41.50, -81.69),
+	usCity("Columbus", "Ohio", 39.96, -83.00),
+	usCity("Dayton", "Ohio", 39.76, -84.19),
+	usCity("Toledo", "Ohio", 41.65, -83.54),
+	usCity("Oklahoma City", "Oklahoma", 35.47, -97.52),
+	usCity("Tulsa", "Oklahoma", 36.15, -95.99),
+	usCity("Bend", "Oregon", 44.06, -121.32),
+	usCity("Eugene", "Oregon", 44.05, -123.09),
+	usCity("Medford", "Oregon", 42.33, -122.87),
+	usCity("Portland", "Oregon", 45.52, -122.68),
+	usCity("Allentown", "Pennsylvania", 40.60, -75.47),
+	usCity("Erie", "Pennsylvania", 42.13, -80.09),
+	usCity("Harrisburg", "Pennsylvania", 40.27, -76.88),
+	usCity("Philadelphia", "Pennsylvania", 39.95, -75.17),
+	usCity("Pittsburgh", "Pennsylvania", 40.44, -80.00),
+	usCity("Scranton", "Pennsylvania", 41.41, -75.66),
+	usCity("San Juan", "Puerto Rico", 18.47, -66.11),
+	usCity("Providence", "Rhode Island", 41.82, -71.41),
+	usCity("Charleston", "South Carolina", 32.78, -79.93),
+	usCity("Columbia", "South Carolina", 34.00, -81.03),
+	usCity("Myrtle Beach", "South Carolina", 33.69, -78.89),
+	usCity("Pierre", "South Dakota", 44.37, -100.35),
+	usCity("Rapid City", "South Dakota", 44.08, -103.23),
+	usCity("Sioux Falls", "South Dakota", 43.55, -96.73),
+	usCity("Chattanooga", "Tennessee", 35.05, -85.31),
+	usCity("Knoxville", "Tennessee", 35.96, -83.92),
+	usCity("Memphis", "Tennessee", 35.15, -90.05),
+	usCity("Nashville", "Tennessee", 36.16, -86.78),
+	usCity("Abilene", "Texas", 32.45, -99.73),
+	usCity("Amarillo", "Texas", 35.22, -101.83),
+	usCity("Austin", "Texas", 30.27, -97.74),
+	usCity("Brownsville", "Texas", 25.90, -97.50),
+	usCity("Corpus Christi", "Texas", 27.80, -97.40),
+	usCity("Dallas", "Texas", 32.78, -96.80),
+	usCity("El Paso", "Texas", 31.76, -106.49),
+	usCity("Fort Worth", "Texas", 32.76, -97.33),
+	usCity("Houston", "Texas", 29.76, -95.37),
+	usCity("Laredo", "Texas", 27.53, -99.49),
+	usCity("Lubbock", "Texas", 33.58, -101.86),
+	usCity("Midland", "Texas", 32.00, -102.08),
+	usCity("San Antonio", "Texas", 29.42, -98.49),
+	usCity("Tyler", "Texas", 32.35, -95.30),
+	usCity("Waco", "Texas", 31.55, -97.15),
+	usCity("Salt Lake City", "Utah", 40.76, -111.89),
+	usCity("St. George", "Utah", 37.10, -113.58),
+	usCity("Burlington", "Vermont", 44.48, -73.21),
+	usCity("Richmond", "Virginia", 37.54, -77.44),
+	usCity("Roanoke", "Virginia", 37.27, -79.94),
+	usCity("Virginia Beach", "Virginia", 36.85, -75.98),
+	usCity("Olympia", "Washington", 47.04, -122.90),
+	usCity("Seattle", "Washington", 47.61, -122.33),
+	usCity("Spokane", "Washington", 47.66, -117.43),
+	usCity("Yakima", "Washington", 46.60, -120.51),
+	usCity("Charleston", "West Virginia", 38.35, -81.63),
+	usCity("Morgantown", "West Virginia", 39.63, -79.96),
+	usCity("Green Bay", "Wisconsin", 44.51, -88.02),
+	usCity("Madison", "Wisconsin", 43.07, -89.40),
+	usCity("Milwaukee", "Wisconsin", 43.04, -87.91),
+	usCity("Casper", "Wyoming", 42.87, -106.31),
+	usCity("Cheyenne", "Wyoming", 41.14, -104.82),
+
+	canadaCity("Calgary", "Alberta", 51.05, -114.07),
+	canadaCity("Edmonton", "Alberta", 53.55, -113.49),
+	canadaCity("Kelowna", "British Columbia", 49.89, -119.50),
+	canadaCity("Prince George", "British Columbia", 53.92, -122.75),
+	canadaCity("Vancouver", "British Columbia", 49.28, -123.12),
+	canadaCity("Victoria", "British Columbia", 48.43, -123.37),
+	canadaCity("Winnipeg", "Manitoba", 49.90, -97.14),
+	canadaCity("Fredericton", "New Brunswick", 45.96, -66.64),
+	canadaCity("Moncton", "New Brunswick", 46.09, -64.78),
+	canadaCity("St. John's", "Newfoundland and Labrador", 47.56, -52.71),
+	canadaCity("Yellowknife", "Northwest Territories", 62.45, -114.37),
+	canadaCity("Halifax", "Nova Scotia", 44.65, -63.57),
+	canadaCity("Iqaluit", "Nunavut", 63.75, -68.52),
+	canadaCity("London", "Ontario", 42.98, -81.25),
+	canadaCity("Ottawa", "Ontario", 45.42, -75.70),
+	canadaCity("Sudbury", "Ontario", 46.49, -80.99),
+	canadaCity("Thunder Bay", "Ontario", 48.38, -89.25),
+	canadaCity("Toronto", "Ontario", 43.65, -79.38),
+	canadaCity("Windsor", "Ontario", 42.31, -83.04),
+	canadaCity("Charlottetown", "Prince Edward Island", 46.24, -63.13),
+	canadaCity("Montréal", "Quebec", 45.50, -73.57),
+	canadaCity("Quebec City", "Quebec", 46.81, -71.21),
+	canadaCity("Saguenay", "Quebec", 48.43, -71.07),
+	canadaCity("Sherbrooke", "Quebec", 45.40, -71.89),
+	canadaCity("Regina", "Saskatchewan", 50.45, -104.61),
+	canadaCity("Saskatoon", "Saskatchewan", 52.13, -106.67),
+	canadaCity("Whitehorse", "Yukon", 60.72, -135.06),
+
+	worldCity("Buenos Aires", "Argentina", -34.60, -58.38),
+	worldCity("Adelaide", "Australia", -34.93, 138.60),
+	worldCity("Brisbane", "Australia", -27.47, 153.03),
+	worldCity("Canberra", "Australia", -35.28, 149.13),
+	worldCity("Darwin", "Australia", -12.46, 130.84),
+	worldCity("Hobart", "Australia", -42.88, 147.33),
+	worldCity("Melbourne", "Australia", -37.81, 144.96),
+	worldCity("Perth", "Australia", -31.95, 115.86),
+	worldCity("Sydney", "Australia", -33.87, 151.21),
+	worldCity("Graz", "Austria", 47.07, 15.44),
+	worldCity("Innsbruck", "Austria", 47.27, 11.40),
+	worldCity("Vienna", "Austria", 48.21, 16.37),
+	worldCity("Brussels", "Belgium", 50.85, 4.35),
+	worldCity("Rio de Janeiro", "Brazil", -22.91, -43.17),
+	worldCity("São Paulo", "Brazil", -23.55, -46.63),
+	worldCity("Santiago", "Chile", -33.45, -70.67),
+	worldCity("Brno", "Czech Republic", 49.20, 16.61),
+	worldCity("Prague", "Czech Republic", 50.08, 14.44),
+	worldCity("Copenhagen", "Denmark", 55.68, 12.57),
+	worldCity("Helsinki", "Finland", 60.17, 24.94),
+	worldCity("Bordeaux", "France", 44.84, -0.58),
+	worldCity("Lille", "France", 50.63, 3.06),
+	worldCity("Lyon", "France", 45.76, 4.84),
+	worldCity("Marseille", "France", 43.30, 5.37),
+	worldCity("Nantes", "France", 47.22, -1.55),
+	worldCity("Paris", "France", 48.86, 2.35),
+	worldCity("Strasbourg", "France", 48.57, 7.75),
+	worldCity("Toulouse", "France", 43.60, 1.44),
+	worldCity("Berlin", "Germany", 52.52, 13.40),
+	worldCity("Bremen", "Germany", 53.08, 8.80),
+	worldCity("Cologne", "Germany", 50.94, 6.96),
+	worldCity("Dresden", "Germany", 51.05, 13.74),
+	worldCity("Frankfurt", "Germany", 50.11, 8.68),
+	worldCity("Hamburg", "Germany", 53.55, 9.99),
+	worldCity("Hannover", "Germany", 52.37, 9.73),
+	worldCity("Leipzig", "Germany", 51.34, 12.37),
+	worldCity("Munich", "Germany", 48.14, 11.58),
+	worldCity("Nuremberg", "Germany", 49.45, 11.08),
+	worldCity("Stuttgart", "Germany", 48.78, 9.18),
+	worldCity("Cork", "Ireland", 51.90, -8.47),
+	worldCity("Dublin", "Ireland", 53.35, -6.26),
+	worldCity("Bari", "Italy", 41.12, 16.87),
+	worldCity("Bologna", "Italy", 44.49, 11.34),
+	worldCity("Florence", "Italy", 43.77, 11.26),
+	worldCity("Milan", "Italy", 45.46, 9.19),
+	worldCity("Naples", "Italy", 40.85, 14.27),
+	worldCity("Palermo", "Italy", 38.12, 13.36),
+	worldCity("Rome", "Italy", 41.90, 12.50),
+	worldCity("Turin", "Italy", 45.07, 7.69),
+	worldCity("Venice", "Italy", 45.44, 12.32),
+	worldCity("Osaka", "Japan", 34.69, 135.50),
+	worldCity("Tokyo", "Japan", 35.68, 139.69),
+	worldCity("Luxembourg", "Luxembourg", 49.61, 6.13),
+	worldCity("Guadalajara", "Mexico", 20.66, -103.35),
+	worldCity("Mexico City", "Mexico", 19.43, -99.13),
+	worldCity("Monterrey", "Mexico", 25.69, -100.32),
+	worldCity("Tijuana", "Mexico", 32.51, -117.04),
+	worldCity("Amsterdam", "Netherlands", 52.37, 4.90),
+	worldCity("Rotterdam", "Netherlands", 51.92, 4.48),
+	worldCity("Auckland", "New Zealand", -36.85, 174.76),
+	worldCity("Christchurch", "New Zealand", -43.53, 172.64),
+	worldCity("Wellington", "New Zealand", -41.29, 174.78),
+	worldCity("Bergen", "Norway", 60.39, 5.32),
+	worldCity("Oslo", "Norway", 59.91, 10.75),
+	worldCity("Kraków", "Poland", 50.06, 19.94),
+	worldCity("Warsaw", "Poland", 52.23, 21.01),
+	worldCity("Lisbon", "Portugal", 38.72, -9.14),
+	worldCity("Porto", "Portugal", 41.15, -8.61),
+	worldCity("Cape Town", "South Africa", -33.92, 18.42),
+	worldCity("Johannesburg", "South Africa", -26.20, 28.05),
+	worldCity("Barcelona", "Spain", 41.39, 2.17),
+	worldCity("Bilbao", "Spain", 43.26, -2.93),
+	worldCity("Madrid", "Spain", 40.42, -3.70),
+	worldCity("Seville", "Spain", 37.39, -5.98),
+	worldCity("Valencia", "Spain", 39.47, -0.38),
+	worldCity("Gothenburg", "Sweden", 57.71, 11.97),
+	worldCity("Stockholm", "Sweden", 59.33, 18.07),
+	worldCity("Bern", "Switzerland", 46.95, 7.45),
+	worldCity("Geneva", "Switzerland", 46.20, 6.14),
+	worldCity("Zurich", "Switzerland", 47.38, 8.54),
+	worldCity("Belfast", "United Kingdom", 54.60, -5.93),
+	worldCity("Birmingham", "United Kingdom", 52.49, -1.89),
+	worldCity("Cardiff", "United Kingdom", 51.48, -3.18),
+	worldCity("Edinburgh", "United Kingdom", 55.95, -3.19),
+	worldCity("Glasgow", "United Kingdom", 55.86, -4.25),
+	worldCity("London", "United Kingdom", 51.51, -0.13),
+	worldCity("Manchester", "United Kingdom", 53.48, -2.24),
+}
+
+// NearestCity returns the bundled city closest to a point and its state (US and Canada only)
+// name is "" for 0,0 and when no city is within MaxCityDistanceKm
+func NearestCity(lat, lng float64) (name, state string) {
+	if city, ok := Nearest(lat, lng, "", ""); ok {
+		return city.Name, city.State
+	}
+	return "", ""
+}
+
+// Nearest returns the bundled city closest to a point among those in country and state, "" matching any
+// ok is false for 0,0 and when no such city is within MaxCityDistanceKm
+func Nearest(lat, lng float64, country, state string) (City, bool) {
+	if lat == 0 && lng == 0 {
+		return City{}, false
+	}
+
+	best, bestKm := -1, float64(MaxCityDistanceKm)
+	for i := range cities {
+		c := &cities[i]
+		if math.Abs(c.Lat-lat) > 1 { // About 111 km, skips most of the list cheaply
+			continue
+		}
+		if (country != "" && c.Country != country) || (state != "" && c.State != state) {
+			continue
+		}
+		if km := distanceKm(lat, lng, c.Lat, c.Lng); km <= bestKm {
+			best, bestKm = i, km
+		}
+	}
+	if best < 0 {
+		return City{}, false
+	}
+	return cities[best], true
+}
+
+// distanceKm is the equirectangular approximation of the distance between two points, close enough at
+// city distances and cheaper than haversine across tens of thousands of records
+func distanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	const earthRadiusKm = 6371
+	toRad := math.Pi / 180
+	dLng := math.Mod(math.Abs(lng2-lng1)+180, 360) - 180 // Across the antimeridian the short way
+	x := dLng * toRad * math.Cos((lat1+lat2)/2*toRad)
+	y := (lat2 - lat1) * toRad
+	return earthRadiusKm * math.Sqrt(x*x+y*y)
+}
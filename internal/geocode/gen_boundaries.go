@@ -0,0 +1,348 @@
+//go:build ignore
+
+// gen_boundaries writes boundaries.go from the Natural Earth 1:10m GeoJSON layers, public domain,
+// from naturalearthdata.com:
+//
+//	go run gen_boundaries.go -admin1 ne_10m_admin_1_states_provinces.geojson -admin0 ne_10m_admin_0_countries.geojson
+//
+// Stretches near a neighbour keep their detail so points near a border land on the right side,
+// coastlines are simplified further and islands under about 5 km² are dropped to keep the file small
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"math"
+	"os"
+	"strings"
+)
+
+const (
+	borderTolerance = 0.002  // Degrees, about 200 m
+	borderDistance  = 0.03   // Degrees, how close a neighbour's vertex puts one on a border
+	coastTolerance  = 0.02   // Degrees, about 2 km
+	minArea         = 0.0005 // Square degrees, about 5 km² at mid latitudes
+)
+
+// countryNames are the countries outside the US and Canada to include, by Natural Earth ADMIN name,
+// with the RepeaterBook spelling where it differs
+var countryNames = map[string]string{
+	"Mexico": "", "Brazil": "", "Argentina": "", "Chile": "",
+	"Luxembourg": "", "Belgium": "", "Netherlands": "", "Switzerland": "", "Denmark": "",
+	"Czechia": "Czech Republic", "Austria": "", "Germany": "", "Poland": "", "France": "", "Italy": "",
+	"Portugal": "", "Spain": "", "United Kingdom": "", "Ireland": "", "Norway": "", "Sweden": "",
+	"Finland": "", "Japan": "", "Australia": "", "New Zealand": "", "South Africa": "",
+}
+
+// provinceNames are Natural Earth's province names that RepeaterBook spells differently
+var provinceNames = map[string]string{"Québec": "Quebec"}
+
+type feature struct {
+	Properties map[string]interface{} `json:"properties"`
+	Geometry   struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	} `json:"geometry"`
+}
+
+// ring is a closed outline of {lng, lat} points as GeoJSON stores them, without the repeated last point
+type ring [][2]float64
+
+type outline struct {
+	state, country string
+	polygons       [][]ring // Each is an outer ring followed by its holes
+}
+
+func main() {
+	admin1 := flag.String("admin1", "ne_10m_admin_1_states_provinces.geojson", "Natural Earth admin-1 layer")
+	admin0 := flag.String("admin0", "ne_10m_admin_0_countries.geojson", "Natural Earth admin-0 layer")
+	out := flag.String("out", "boundaries.go", "Go file to write")
+	flag.Parse()
+
+	var states, provinces, countries []outline
+	for _, f := range readFeatures(*admin1) {
+		name, _ := f.Properties["name"].(string)
+		switch f.Properties["iso_a2"] {
+		case "US":
+			states = append(states, outline{state: name, country: "United States", polygons: polygons(f)})
+		case "CA":
+			if spelling, ok := provinceNames[name]; ok {
+				name = spelling
+			}
+			provinces = append(provinces, outline{state: name, country: "Canada", polygons: polygons(f)})
+		}
+	}
+	for _, f := range readFeatures(*admin0) {
+		name, _ := f.Properties["ADMIN"].(string)
+		spelling, ok := countryNames[name]
+		if !ok {
+			continue
+		}
+		if spelling != "" {
+			name = spelling
+		}
+		countries = append(countries, outline{country: name, polygons: polygons(f)})
+	}
+	if len(states) != 51 || len(provinces) != 13 || len(countries) != len(countryNames) {
+		log.Fatalf("Found %d states, %d provinces and %d countries, want 51, 13 and %d",
+			len(states), len(provinces), len(countries), len(countryNames))
+	}
+
+	all := append(append(append([]outline{}, states...), provinces...), countries...)
+	border := borderVertices(all)
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_boundaries.go from Natural Earth; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package geocode")
+	for _, section := range []struct {
+		name, doc, helper string
+		list              []outline
+	}{
+		{"usStates", "usStates are the state outlines", "usState", states},
+		{"canadaProvinces", "canadaProvinces are the province and territory outlines", "province", provinces},
+		{"countries", "countries are outlines of countries outside the US and Canada with active repeater networks",
+			"country", countries},
+	} {
+		fmt.Fprintf(&buf, "\n// %s\nvar %s = []region{\n", section.doc, section.name)
+		for _, o := range section.list {
+			name := o.state
+			if name == "" {
+				name = o.country
+			}
+			for _, p := range o.polygons {
+				if area(p[0]) < minArea {
+					continue
+				}
+				outer := simplify(p[0], border)
+				if len(outer) < 3 {
+					continue
+				}
+				fmt.Fprintf(&buf, "%s(%q, %q", section.helper, name, encode(outer))
+				for _, hole := range p[1:] {
+					if h := simplify(hole, border); area(hole) >= minArea && len(h) >= 3 {
+						fmt.Fprintf(&buf, ", %q", encode(h))
+					}
+				}
+				fmt.Fprintln(&buf, "),")
+			}
+		}
+		fmt.Fprintln(&buf, "}")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format output: %v", err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalf("Failed to write %s: %v", *out, err)
+	}
+}
+
+// readFeatures loads a GeoJSON FeatureCollection, gzipped when the name ends in .gz
+func readFeatures(path string) []feature {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", path, err)
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		if r, err = gzip.NewReader(f); err != nil {
+			log.Fatalf("Failed to read %s: %v", path, err)
+		}
+	}
+	var collection struct {
+		Features []feature `json:"features"`
+	}
+	if err := json.NewDecoder(r).Decode(&collection); err != nil {
+		log.Fatalf("Failed to parse %s: %v", path, err)
+	}
+	return collection.Features
+}
+
+// polygons returns a Polygon or MultiPolygon geometry as a list of polygons
+func polygons(f feature) [][]ring {
+	var list [][][][2]float64
+	switch f.Geometry.Type {
+	case "Polygon":
+		var p [][][2]float64
+		if err := json.Unmarshal(f.Geometry.Coordinates, &p); err != nil {
+			log.Fatalf("Bad polygon: %v", err)
+		}
+		list = append(list, p)
+	case "MultiPolygon":
+		if err := json.Unmarshal(f.Geometry.Coordinates, &list); err != nil {
+			log.Fatalf("Bad multipolygon: %v", err)
+		}
+	default:
+		log.Fatalf("Unexpected geometry %s", f.Geometry.Type)
+	}
+
+	result := make([][]ring, len(list))
+	for i, p := range list {
+		for _, r := range p {
+			if len(r) > 1 && r[0] == r[len(r)-1] {
+				r = r[:len(r)-1]
+			}
+			result[i] = append(result[i], ring(r))
+		}
+	}
+	return result
+}
+
+// area is the shoelace area of a ring in square degrees
+func area(r ring) float64 {
+	sum := 0.0
+	j := len(r) - 1
+	for i := range r {
+		sum += r[j][0]*r[i][1] - r[i][0]*r[j][1]
+		j = i
+	}
+	return math.Abs(sum) / 2
+}
+
+// borderVertices finds the vertices within borderDistance of another outline's, on a land border or
+// across a river or strait from a neighbour
+func borderVertices(outlines []outline) map[[2]float64]bool {
+	cell := func(v [2]float64) [2]int {
+		return [2]int{int(math.Floor(v[0] / borderDistance)), int(math.Floor(v[1] / borderDistance))}
+	}
+	type owned struct {
+		v     [2]float64
+		owner int
+	}
+	grid := make(map[[2]int][]owned)
+	for i, o := range outlines {
+		for _, p := range o.polygons {
+			for _, r := range p {
+				for _, v := range r {
+					grid[cell(v)] = append(grid[cell(v)], owned{v, i})
+				}
+			}
+		}
+	}
+
+	border := make(map[[2]float64]bool)
+	for c, list := range grid {
+		for _, a := range list {
+			for dx := -1; dx <= 1 && !border[a.v]; dx++ {
+				for dy := -1; dy <= 1 && !border[a.v]; dy++ {
+					for _, b := range grid[[2]int{c[0] + dx, c[1] + dy}] {
+						if b.owner != a.owner && math.Hypot(a.v[0]-b.v[0], a.v[1]-b.v[1]) <= borderDistance {
+							border[a.v] = true
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+	return border
+}
+
+// simplify runs Douglas-Peucker over each stretch of a ring separately, border stretches at
+// borderTolerance and the rest at coastTolerance
+func simplify(r ring, borders map[[2]float64]bool) ring {
+	border := func(i int) bool { return borders[r[i%len(r)]] }
+
+	// Start at a change between border and coast, if there is one
+	start := 0
+	for i := 1; i < len(r); i++ {
+		if border(i) != border(i-1) {
+			start = i
+			break
+		}
+	}
+
+	var result ring
+	from := start
+	for i := start + 1; i <= start+len(r); i++ {
+		if i < start+len(r) && border(i) == border(from) {
+			continue
+		}
+		stretch := make(ring, 0, i-from+1)
+		for j := from; j <= i; j++ {
+			stretch = append(stretch, r[j%len(r)])
+		}
+		tolerance := coastTolerance
+		if border(from) {
+			tolerance = borderTolerance
+		}
+		kept := douglasPeucker(stretch, tolerance)
+		result = append(result, kept[:len(kept)-1]...)
+		from = i
+	}
+	return result
+}
+
+// douglasPeucker keeps the first and last points and any between that stray more than tolerance from the line
+func douglasPeucker(points ring, tolerance float64) ring {
+	keep := make([]bool, len(points))
+	keep[0], keep[len(points)-1] = true, true
+	stack := [][2]int{{0, len(points) - 1}}
+	for len(stack) > 0 {
+		a, b := stack[len(stack)-1][0], stack[len(stack)-1][1]
+		stack = stack[:len(stack)-1]
+		farthest, index := -1.0, -1
+		for i := a + 1; i < b; i++ {
+			if d := distance(points[i], points[a], points[b]); d > farthest {
+				farthest, index = d, i
+			}
+		}
+		if farthest > tolerance {
+			keep[index] = true
+			stack = append(stack, [2]int{a, index}, [2]int{index, b})
+		}
+	}
+
+	var kept ring
+	for i, p := range points {
+		if keep[i] {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
+
+// distance is how far p is from the line through a and b, or from a when they're the same point
+func distance(p, a, b [2]float64) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return math.Hypot(p[0]-a[0], p[1]-a[1])
+	}
+	return math.Abs(dx*(a[1]-p[1])-dy*(a[0]-p[0])) / length
+}
+
+// encode writes a ring with Google's polyline algorithm, lat before lng to five decimals
+func encode(r ring) string {
+	var sb strings.Builder
+	var lastLat, lastLng int
+	for _, p := range r {
+		lat, lng := int(math.Round(p[1]*1e5)), int(math.Round(p[0]*1e5))
+		encodeValue(&sb, lat-lastLat)
+		encodeValue(&sb, lng-lastLng)
+		lastLat, lastLng = lat, lng
+	}
+	return sb.String()
+}
+
+func encodeValue(sb *strings.Builder, v int) {
+	u := v << 1
+	if v < 0 {
+		u = ^u
+	}
+	for u >= 0x20 {
+		sb.WriteByte(byte((0x20 | (u & 0x1f)) + 63))
+		u >>= 5
+	}
+	sb.WriteByte(byte(u + 63))
+}
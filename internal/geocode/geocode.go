@@ -0,0 +1,134 @@
+package geocode
+
+// Offline reverse geocoding against bundled boundaries, so a sync can fill in state and country for
+// tens of thousands of records without an API call each.
+// boundaries.go is generated from Natural Earth's 1:10m admin-1 states and provinces and admin-0
+// countries (public domain, naturalearthdata.com). Borders are kept to about 200 m of the source lines,
+// which along rivers can themselves be a kilometer or so off, coastlines are simplified to about 2 km
+// and islands under about 5 km² are left out, so a point right on a coast may not be found
+
+//go:generate go run gen_boundaries.go
+
+// region is one outline, a state or province of the US and Canada or a whole country elsewhere
+// A region with islands is listed once per piece
+type region struct {
+	State   string         // Empty for countries outside the US and Canada
+	Country string         // Spelled like RepeaterBook's Country field
+	Polygon [][2]float64   // {lat, lng} vertices, the last joins back to the first
+	Holes   [][][2]float64 // Lakes and enclaves inside Polygon that aren't part of the region
+
+	minLat, maxLat, minLng, maxLng float64
+}
+
+// usState is a state outline, in the United States
+func usState(name, outline string, holes ...string) region {
+	return newRegion(name, "United States", outline, holes)
+}
+
+// province is a province or territory outline, in Canada
+func province(name, outline string, holes ...string) region {
+	return newRegion(name, "Canada", outline, holes)
+}
+
+// country is a country outline, or one island of it
+func country(name, outline string, holes ...string) region {
+	return newRegion("", name, outline, holes)
+}
+
+// newRegion decodes an outline and its holes
+func newRegion(state, country, outline string, holes []string) region {
+	r := region{State: state, Country: country, Polygon: decodePolyline(outline)}
+	for _, h := range holes {
+		r.Holes = append(r.Holes, decodePolyline(h))
+	}
+	return r
+}
+
+// decodePolyline reads {lat, lng} points written with Google's polyline algorithm, to five decimals
+func decodePolyline(s string) [][2]float64 {
+	var points [][2]float64
+	var lat, lng int
+	for i := 0; i < len(s); {
+		var delta [2]int
+		for k := range delta {
+			shift, v := 0, 0
+			for i < len(s) {
+				b := int(s[i]) - 63
+				i++
+				v |= (b & 0x1f) << shift
+				shift += 5
+				if b < 0x20 {
+					break
+				}
+			}
+			if v&1 != 0 {
+				v = ^v
+			}
+			delta[k] = v >> 1
+		}
+		lat, lng = lat+delta[0], lng+delta[1]
+		points = append(points, [2]float64{float64(lat) / 1e5, float64(lng) / 1e5})
+	}
+	return points
+}
+
+// regions are checked in order, states and provinces before countries
+var regions []region
+
+func init() {
+	for _, list := range [][]region{usStates, canadaProvinces, countries} {
+		for _, r := range list {
+			r.minLat, r.maxLat = r.Polygon[0][0], r.Polygon[0][0]
+			r.minLng, r.maxLng = r.Polygon[0][1], r.Polygon[0][1]
+			for _, p := range r.Polygon[1:] {
+				r.minLat, r.maxLat = min(r.minLat, p[0]), max(r.maxLat, p[0])
+				r.minLng, r.maxLng = min(r.minLng, p[1]), max(r.maxLng, p[1])
+			}
+			regions = append(regions, r)
+		}
+	}
+}
+
+// Lookup returns the state (US and Canada only) and country containing a point
+// ok is false for 0,0, which sources use for "no coordinates", and for points outside every outline
+func Lookup(lat, lng float64) (state, country string, ok bool) {
+	if lat == 0 && lng == 0 {
+		return "", "", false
+	}
+
+	for i := range regions {
+		r := &regions[i]
+		if lat < r.minLat || lat > r.maxLat || lng < r.minLng || lng > r.maxLng {
+			continue
+		}
+		if contains(r.Polygon, lat, lng) && !inHole(r.Holes, lat, lng) {
+			return r.State, r.Country, true
+		}
+	}
+	return "", "", false
+}
+
+// inHole is whether a point falls in any of a region's holes
+func inHole(holes [][][2]float64, lat, lng float64) bool {
+	for _, h := range holes {
+		if contains(h, lat, lng) {
+			return true
+		}
+	}
+	return false
+}
+
+// contains is the ray casting test: a point is inside when a ray from it crosses the outline an odd number of times
+func contains(polygon [][2]float64, lat, lng float64) bool {
+	inside := false
+	j := len(polygon) - 1
+	for i := range polygon {
+		latI, lngI := polygon[i][0], polygon[i][1]
+		latJ, lngJ := polygon[j][0], polygon[j][1]
+		if (latI > lat) != (latJ > lat) && lng < (lngJ-lngI)*(lat-latI)/(latJ-latI)+lngI {
+			inside = !inside
+		}
+		j = i
+	}
+	return inside
+}
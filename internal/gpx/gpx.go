@@ -0,0 +1,90 @@
+package gpx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// GPX 1.1 waypoint export for handheld GPS units and logging apps
+// Only the <wpt> subset of the schema is modelled here
+
+const (
+	gpxNamespace = "http://www.topografix.com/GPX/1/1"
+	gpxCreator   = "digiLogRT"
+)
+
+// Document is the root <gpx> element
+type Document struct {
+	XMLName   xml.Name   `xml:"gpx"`
+	Xmlns     string     `xml:"xmlns,attr"`
+	Version   string     `xml:"version,attr"`
+	Creator   string     `xml:"creator,attr"`
+	Waypoints []Waypoint `xml:"wpt"`
+}
+
+// Waypoint is a single named point
+type Waypoint struct {
+	Lat         float64 `xml:"lat,attr"`
+	Lon         float64 `xml:"lon,attr"`
+	Name        string  `xml:"name"`
+	Description string  `xml:"desc,omitempty"`
+	Type        string  `xml:"type,omitempty"`
+}
+
+// BuildGPX builds a GPX document with a waypoint per repeater
+// Repeaters without coordinates (or at 0,0) are skipped
+func BuildGPX(repeaters []database.RepeaterRecord) ([]byte, error) {
+	doc := Document{Xmlns: gpxNamespace, Version: "1.1", Creator: gpxCreator}
+
+	for _, r := range repeaters {
+		if r.Latitude == nil || r.Longitude == nil || (*r.Latitude == 0 && *r.Longitude == 0) {
+			continue
+		}
+		doc.Waypoints = append(doc.Waypoints, Waypoint{
+			Lat:         roundCoordinate(*r.Latitude),
+			Lon:         roundCoordinate(*r.Longitude),
+			Name:        r.Callsign,
+			Description: waypointDescription(r),
+			Type:        r.Mode,
+		})
+	}
+
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GPX: %v", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// waypointDescription is the frequency, split, tone and mode, e.g. "146.9400 MHz -0.6000, Tone 103.5 Hz, FM"
+func waypointDescription(r database.RepeaterRecord) string {
+	var parts []string
+
+	if info, err := r.GetProgrammingInfo(); err == nil {
+		frequency := fmt.Sprintf("%.4f MHz", info.RxFrequency)
+		if info.Duplex != "" {
+			frequency += fmt.Sprintf(" %s%.4f", info.Duplex, info.Offset)
+		}
+		parts = append(parts, frequency)
+		if info.Tone != nil && *info.Tone > 0 {
+			parts = append(parts, fmt.Sprintf("Tone %.1f Hz", *info.Tone))
+		}
+		if info.ColorCode != nil {
+			parts = append(parts, fmt.Sprintf("CC %d", *info.ColorCode))
+		}
+	}
+	if r.Mode != "" {
+		parts = append(parts, r.Mode)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// roundCoordinate keeps six decimal places (about 10 cm), like the KML export
+func roundCoordinate(degrees float64) float64 {
+	return math.Round(degrees*1e6) / 1e6
+}
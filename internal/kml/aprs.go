@@ -0,0 +1,69 @@
+package kml
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// BuildAPRSTrack builds a KML document showing a station's recorded movement
+// The positions are connected in time order by a LineString, and each report gets
+// its own timestamped Placemark so Google Earth's time slider animates the trip
+func BuildAPRSTrack(callsign string, positions []database.APRSPositionRecord) ([]byte, error) {
+	if len(positions) == 0 {
+		return nil, fmt.Errorf("no recorded positions for %s", callsign)
+	}
+
+	// History comes back newest first, a track must be drawn oldest first
+	sorted := make([]database.APRSPositionRecord, len(positions))
+	copy(sorted, positions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].PositionTime.Before(sorted[j].PositionTime)
+	})
+
+	doc := NewDocument(fmt.Sprintf("APRS track for %s", callsign))
+	doc.Content.Styles = []Style{
+		{
+			ID:        "aprsTrack",
+			LineStyle: &LineStyle{Color: "ff0000ff", Width: 3},
+		},
+		{
+			ID: "aprsPosition",
+			IconStyle: &IconStyle{
+				Scale: 0.8,
+				Icon:  Icon{Href: "http://maps.google.com/mapfiles/kml/shapes/placemark_circle.png"},
+			},
+		},
+	}
+
+	coords := make([]string, 0, len(sorted))
+	for _, p := range sorted {
+		coords = append(coords, formatCoordinate(p.Latitude, p.Longitude))
+	}
+
+	// Path first so the points are drawn on top of it
+	doc.Content.Placemarks = append(doc.Content.Placemarks, Placemark{
+		Name: fmt.Sprintf("%s track", callsign),
+		Description: fmt.Sprintf("%d positions from %s to %s", len(sorted),
+			formatTime(sorted[0].PositionTime), formatTime(sorted[len(sorted)-1].PositionTime)),
+		StyleURL: "#aprsTrack",
+		LineString: &LineString{
+			Tessellate:  1,
+			Coordinates: strings.Join(coords, " "),
+		},
+	})
+
+	for _, p := range sorted {
+		doc.Content.Placemarks = append(doc.Content.Placemarks, Placemark{
+			Name:        p.Callsign,
+			Description: p.Comment,
+			TimeStamp:   &TimeStamp{When: formatTime(p.PositionTime)},
+			StyleURL:    "#aprsPosition",
+			Point:       &Point{Coordinates: formatCoordinate(p.Latitude, p.Longitude)},
+		})
+	}
+
+	return doc.Marshal()
+}
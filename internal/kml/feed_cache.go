@@ -0,0 +1,76 @@
+package kml
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// feedMaxStale is how long a failed build may still fall back to an old document, past that it's dropped
+const feedMaxStale = time.Hour
+
+// FeedCache keeps the last good document built for each bounding box, for a live feed that Google Earth
+// polls through a NetworkLink. Polls within the TTL are answered from memory, and when a build fails
+// (say the database is busy) the last good document is served rather than an error Earth would retry
+type FeedCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[database.BoundingBox]feedEntry
+}
+
+type feedEntry struct {
+	data  []byte
+	built time.Time
+}
+
+func NewFeedCache(ttl time.Duration) *FeedCache {
+	return &FeedCache{
+		ttl:     ttl,
+		entries: make(map[database.BoundingBox]feedEntry),
+	}
+}
+
+// Get returns the document for box, calling build when the cached one is older than the TTL
+// Polls that arrive together after it expires share one build through api.ShareFetch
+// If build fails the last good document is returned with stale set, the error only comes back when
+// there is none younger than feedMaxStale
+func (c *FeedCache) Get(box database.BoundingBox, build func() ([]byte, error)) (data []byte, stale bool, err error) {
+	c.mu.Lock()
+	entry, ok := c.entries[box]
+	c.mu.Unlock()
+
+	if ok && time.Since(entry.built) <= c.ttl {
+		return entry.data, false, nil
+	}
+
+	key := fmt.Sprintf("kml feed %p %v", c, box)
+	data, err = api.ShareFetch(key, build)
+	if err != nil {
+		if ok && time.Since(entry.built) <= feedMaxStale {
+			log.Printf("KML feed build failed, serving the document from %v ago: %v", time.Since(entry.built).Round(time.Second), err)
+			return entry.data, true, nil
+		}
+		return nil, false, err
+	}
+
+	c.put(box, data)
+	return data, false, nil
+}
+
+// put stores a freshly built document, dropping entries too old to fall back on
+func (c *FeedCache) put(box database.BoundingBox, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.Sub(entry.built) > feedMaxStale {
+			delete(c.entries, key)
+		}
+	}
+	c.entries[box] = feedEntry{data: data, built: now}
+}
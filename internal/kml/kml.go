@@ -0,0 +1,115 @@
+package kml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// KML document structures for Google Earth export
+// Only the subset of the KML 2.2 schema that we actually generate is modelled here
+
+const kmlNamespace = "http://www.opengis.net/kml/2.2"
+
+// Document is the root <kml> element
+type Document struct {
+	XMLName xml.Name  `xml:"kml"`
+	Xmlns   string    `xml:"xmlns,attr"`
+	Content Container `xml:"Document"`
+}
+
+// Container holds styles, folders and placemarks (used for both <Document> and <Folder>)
+type Container struct {
+	Name        string      `xml:"name,omitempty"`
+	Description string      `xml:"description,omitempty"`
+	Open        int         `xml:"open,omitempty"`
+	Styles      []Style     `xml:"Style,omitempty"`
+	Folders     []Container `xml:"Folder,omitempty"`
+	Placemarks  []Placemark `xml:"Placemark,omitempty"`
+}
+
+// Style defines how placemarks and lines are drawn
+type Style struct {
+	ID        string     `xml:"id,attr"`
+	IconStyle *IconStyle `xml:"IconStyle,omitempty"`
+	LineStyle *LineStyle `xml:"LineStyle,omitempty"`
+}
+
+// IconStyle sets the icon used for point placemarks
+type IconStyle struct {
+	Color string  `xml:"color,omitempty"` // aabbggrr
+	Scale float64 `xml:"scale,omitempty"`
+	Icon  Icon    `xml:"Icon"`
+}
+
+// Icon references an icon image by URL
+type Icon struct {
+	Href string `xml:"href"`
+}
+
+// LineStyle sets the colour and width of LineStrings
+type LineStyle struct {
+	Color string  `xml:"color,omitempty"` // aabbggrr
+	Width float64 `xml:"width,omitempty"`
+}
+
+// Placemark is a single feature on the map
+type Placemark struct {
+	Name        string      `xml:"name,omitempty"`
+	Description string      `xml:"description,omitempty"`
+	TimeStamp   *TimeStamp  `xml:"TimeStamp,omitempty"`
+	TimeSpan    *TimeSpan   `xml:"TimeSpan,omitempty"`
+	StyleURL    string      `xml:"styleUrl,omitempty"`
+	Point       *Point      `xml:"Point,omitempty"`
+	LineString  *LineString `xml:"LineString,omitempty"`
+}
+
+// TimeStamp marks a feature with a single moment in time (drives Earth's time slider)
+type TimeStamp struct {
+	When string `xml:"when"`
+}
+
+// TimeSpan limits a feature to a period, Earth's time slider shows it only then
+// Either end may be left out for a period open on that side
+type TimeSpan struct {
+	Begin string `xml:"begin,omitempty"`
+	End   string `xml:"end,omitempty"`
+}
+
+// Point is a single coordinate
+type Point struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+// LineString is a connected path of coordinates
+type LineString struct {
+	Tessellate  int    `xml:"tessellate,omitempty"`
+	Coordinates string `xml:"coordinates"`
+}
+
+// NewDocument creates an empty KML document with the given name
+func NewDocument(name string) *Document {
+	return &Document{
+		Xmlns:   kmlNamespace,
+		Content: Container{Name: name, Open: 1},
+	}
+}
+
+// Marshal renders the document as indented KML with the XML header
+func (d *Document) Marshal() ([]byte, error) {
+	body, err := xml.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal KML: %v", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// formatCoordinate formats a lng,lat pair the way KML expects (longitude first)
+func formatCoordinate(lat, lng float64) string {
+	return fmt.Sprintf("%.6f,%.6f,0", lng, lat)
+}
+
+// formatTime formats a time as an RFC 3339 KML timestamp
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
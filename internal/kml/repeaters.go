@@ -0,0 +1,307 @@
+package kml
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// modeStyles maps a normalized mode to its placemark style (colors are aabbggrr)
+var modeStyles = []Style{
+	modeStyle("modeFM", "ff00ff00"),     // green
+	modeStyle("modeDMR", "ff0000ff"),    // red
+	modeStyle("modeDSTAR", "ffff0000"),  // blue
+	modeStyle("modeFusion", "ff00ffff"), // yellow
+	modeStyle("modeOther", "ffffffff"),  // white
+}
+
+func modeStyle(id, color string) Style {
+	return Style{
+		ID: id,
+		IconStyle: &IconStyle{
+			Color: color,
+			Icon:  Icon{Href: "http://maps.google.com/mapfiles/kml/shapes/placemark_circle.png"},
+		},
+	}
+}
+
+// sourceFolderNames gives each database source a friendly folder name
+var sourceFolderNames = map[string]string{
+	"brandmeister": "Brandmeister",
+	"hearham":      "hearham",
+	"repeaterbook": "RepeaterBook",
+	"tgif":         "TGIF",
+}
+
+// styleForMode picks the style ID for a repeater mode string
+func styleForMode(mode string) string {
+	m := strings.ToUpper(strings.ReplaceAll(mode, "-", ""))
+	switch {
+	case m == "FM" || m == "NFM" || m == "ANALOG":
+		return "#modeFM"
+	case strings.Contains(m, "DMR"):
+		return "#modeDMR"
+	case strings.Contains(m, "DSTAR"):
+		return "#modeDSTAR"
+	case strings.Contains(m, "FUSION") || strings.Contains(m, "YSF") || strings.Contains(m, "C4FM"):
+		return "#modeFusion"
+	default:
+		return "#modeOther"
+	}
+}
+
+// RepeaterPlacemark builds a point placemark for a repeater (callers must check it has coordinates)
+func RepeaterPlacemark(r database.RepeaterRecord) Placemark {
+	description := fmt.Sprintf("%s\n%s\nMode: %s\n%s",
+		r.GetFrequencyString(), r.GetLocationString(), r.Mode, r.GetPowerString())
+	if km, ok := r.EstimateCoverageKm(); ok {
+		description += fmt.Sprintf("\nCoverage: ~%.0f km (estimate)", km)
+	}
+	span := operationalSpan(r)
+	if span != nil {
+		description += fmt.Sprintf("\nOn the air: %s to %s", span.Begin, span.End)
+	}
+
+	return Placemark{
+		Name:        r.Callsign,
+		Description: description,
+		TimeSpan:    span,
+		StyleURL:    styleForMode(r.Mode),
+		Point:       &Point{Coordinates: formatCoordinate(*r.Latitude, *r.Longitude)},
+	}
+}
+
+// operationalSpan is the TimeSpan of a seasonal or event repeater (see RepeaterRecord.OperationalPeriod),
+// nil for the rest so they show whatever the time slider is set to
+func operationalSpan(r database.RepeaterRecord) *TimeSpan {
+	begin, end, ok := r.OperationalPeriod()
+	if !ok {
+		return nil
+	}
+	// A bare date as the end would read as the start of that day, so the span ends on the last second of it
+	return &TimeSpan{Begin: formatTime(begin), End: formatTime(end.AddDate(0, 0, 1).Add(-time.Second))}
+}
+
+// BuildKMZ packages a KML document into a KMZ (zip) archive as doc.kml
+func BuildKMZ(doc *Document) ([]byte, error) {
+	kmlData, err := doc.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	w, err := zw.Create("doc.kml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create KMZ entry: %v", err)
+	}
+	if _, err := w.Write(kmlData); err != nil {
+		return nil, fmt.Errorf("failed to write KMZ entry: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish KMZ: %v", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// BuildKMZByLayers exports every repeater inside box as a KMZ organized for Google Earth's layer tree
+// There is one Folder per source with a sub-Folder per band, and placemarks are styled by mode
+func BuildKMZByLayers(db *database.Database, box database.BoundingBox, devices database.DeviceFilter) ([]byte, error) {
+	repeaters, err := db.GetRepeatersInBoundingBox(box, 0, devices)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceNames, err := db.GetSourceNames()
+	if err != nil {
+		return nil, err
+	}
+
+	// Group placemarks by source, then by band
+	layers := make(map[string]map[string][]Placemark)
+	for _, r := range repeaters {
+		if r.Latitude == nil || r.Longitude == nil {
+			continue
+		}
+
+		source := sourceNames[r.SourceID]
+		if source == "" {
+			source = "unknown"
+		}
+		band := r.GetBand()
+		if band == "" {
+			band = "Other"
+		}
+
+		if layers[source] == nil {
+			layers[source] = make(map[string][]Placemark)
+		}
+		layers[source][band] = append(layers[source][band], RepeaterPlacemark(r))
+	}
+
+	doc := NewDocument("digiLogRT Repeaters")
+	doc.Content.Styles = modeStyles
+
+	sources := make([]string, 0, len(layers))
+	for source := range layers {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		name := sourceFolderNames[source]
+		if name == "" {
+			name = source
+		}
+		sourceFolder := Container{Name: name}
+
+		for _, band := range bandOrder(layers[source]) {
+			placemarks := layers[source][band]
+			sourceFolder.Folders = append(sourceFolder.Folders, Container{
+				Name:       fmt.Sprintf("%s (%d)", band, len(placemarks)),
+				Placemarks: placemarks,
+			})
+		}
+
+		doc.Content.Folders = append(doc.Content.Folders, sourceFolder)
+	}
+
+	return BuildKMZ(doc)
+}
+
+// WriteRepeatersKML streams the repeaters matching opts to w as a flat KML document, one placemark per
+// repeater with coordinates. Rows are written as they are read, so memory stays flat however many match
+// It returns the number of placemarks written
+func WriteRepeatersKML(ctx context.Context, w io.Writer, db *database.Database, name string, opts database.SearchOptions) (int, error) {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return 0, fmt.Errorf("failed to write KML: %v", err)
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	kmlStart := xml.StartElement{
+		Name: xml.Name{Local: "kml"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: kmlNamespace}},
+	}
+	documentStart := xml.StartElement{Name: xml.Name{Local: "Document"}}
+	if err := enc.EncodeToken(kmlStart); err != nil {
+		return 0, fmt.Errorf("failed to write KML: %v", err)
+	}
+	if err := enc.EncodeToken(documentStart); err != nil {
+		return 0, fmt.Errorf("failed to write KML: %v", err)
+	}
+	if err := enc.EncodeElement(name, xml.StartElement{Name: xml.Name{Local: "name"}}); err != nil {
+		return 0, fmt.Errorf("failed to write KML: %v", err)
+	}
+	for _, style := range modeStyles {
+		if err := enc.EncodeElement(style, xml.StartElement{Name: xml.Name{Local: "Style"}}); err != nil {
+			return 0, fmt.Errorf("failed to write KML: %v", err)
+		}
+	}
+
+	count := 0
+	err := db.SearchRepeatersStream(ctx, opts, func(r database.RepeaterRecord) error {
+		if r.Latitude == nil || r.Longitude == nil || (*r.Latitude == 0 && *r.Longitude == 0) {
+			return nil
+		}
+		if err := enc.EncodeElement(RepeaterPlacemark(r), xml.StartElement{Name: xml.Name{Local: "Placemark"}}); err != nil {
+			return fmt.Errorf("failed to write KML: %v", err)
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+
+	if err := enc.EncodeToken(documentStart.End()); err != nil {
+		return count, fmt.Errorf("failed to write KML: %v", err)
+	}
+	if err := enc.EncodeToken(kmlStart.End()); err != nil {
+		return count, fmt.Errorf("failed to write KML: %v", err)
+	}
+	if err := enc.Flush(); err != nil {
+		return count, fmt.Errorf("failed to write KML: %v", err)
+	}
+	return count, nil
+}
+
+// bandOrder returns the bands present in frequency order, with unclassified last
+func bandOrder(bands map[string][]Placemark) []string {
+	var ordered []string
+	for _, band := range database.Bands {
+		if _, ok := bands[band.Name]; ok {
+			ordered = append(ordered, band.Name)
+		}
+	}
+	if _, ok := bands["Other"]; ok {
+		ordered = append(ordered, "Other")
+	}
+	return ordered
+}
+
+// BuildRepeaterKML builds a KML document holding a single repeater placemark
+func BuildRepeaterKML(r database.RepeaterRecord) ([]byte, error) {
+	if r.Latitude == nil || r.Longitude == nil {
+		return nil, fmt.Errorf("repeater %s has no coordinates", r.Callsign)
+	}
+
+	doc := NewDocument(r.Callsign)
+	doc.Content.Styles = append([]Style{coverageStyle}, modeStyles...)
+	if circle, ok := CoveragePlacemark(r); ok {
+		doc.Content.Placemarks = append(doc.Content.Placemarks, circle)
+	}
+	doc.Content.Placemarks = append(doc.Content.Placemarks, RepeaterPlacemark(r))
+
+	return doc.Marshal()
+}
+
+// coverageStyle draws coverage circles as a thin translucent yellow line
+var coverageStyle = Style{ID: "coverage", LineStyle: &LineStyle{Color: "9900ffff", Width: 2}}
+
+// coverageCirclePoints is how many points approximate a coverage circle
+const coverageCirclePoints = 72
+
+// CoveragePlacemark outlines the repeater's estimated coverage (see RepeaterRecord.EstimateCoverageKm) as
+// a circle around it, ok is false when there is no estimate or no coordinates. It uses the #coverage style
+func CoveragePlacemark(r database.RepeaterRecord) (Placemark, bool) {
+	km, ok := r.EstimateCoverageKm()
+	if !ok || r.Latitude == nil || r.Longitude == nil {
+		return Placemark{}, false
+	}
+
+	const earthRadius = 6371.0
+	lat1 := *r.Latitude * math.Pi / 180
+	lng1 := *r.Longitude * math.Pi / 180
+	angle := km / earthRadius
+
+	// Destination points around the repeater, the first repeated at the end to close the ring
+	coords := make([]string, 0, coverageCirclePoints+1)
+	for i := 0; i <= coverageCirclePoints; i++ {
+		bearing := 2 * math.Pi * float64(i%coverageCirclePoints) / coverageCirclePoints
+		lat2 := math.Asin(math.Sin(lat1)*math.Cos(angle) + math.Cos(lat1)*math.Sin(angle)*math.Cos(bearing))
+		lng2 := lng1 + math.Atan2(math.Sin(bearing)*math.Sin(angle)*math.Cos(lat1),
+			math.Cos(angle)-math.Sin(lat1)*math.Sin(lat2))
+		coords = append(coords, formatCoordinate(lat2*180/math.Pi, math.Remainder(lng2*180/math.Pi, 360)))
+	}
+
+	return Placemark{
+		Name:        fmt.Sprintf("%s coverage (~%.0f km)", r.Callsign, km),
+		Description: "Rough estimate from antenna height and power, terrain not considered",
+		TimeSpan:    operationalSpan(r), // The circle comes and goes with its repeater
+		StyleURL:    "#coverage",
+		LineString:  &LineString{Tessellate: 1, Coordinates: strings.Join(coords, " ")},
+	}, true
+}
@@ -0,0 +1,127 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/unklstewy/digiLogRT/internal/config"
+)
+
+// defaultMaxBackups is how many rotated log files are kept when the config doesn't say
+const defaultMaxBackups = 3
+
+// Setup installs a leveled slog logger as the default, writing to stderr or the configured file
+// Standard log package output goes through it too, at INFO, so a higher level quiets it
+// The returned closer closes the log file, call it on exit
+func Setup(settings config.LoggingSettings) (io.Closer, error) {
+	level, err := config.ParseLogLevel(settings.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var out io.WriteCloser = nopCloser{os.Stderr}
+	if settings.File != "" {
+		maxBackups := settings.MaxBackups
+		if maxBackups == 0 {
+			maxBackups = defaultMaxBackups
+		}
+		file, err := newRotatingFile(settings.File, int64(settings.MaxSizeMB)*1024*1024, maxBackups)
+		if err != nil {
+			return nil, err
+		}
+		out = file
+	}
+
+	handler := slog.NewTextHandler(out, &slog.HandlerOptions{Level: level})
+	slog.SetDefault(slog.New(handler))
+	return out, nil
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+// rotatingFile is a log file that moves aside to path.1 (path.1 to path.2, and so on) once it reaches maxSize
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64 // 0 never rotates
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %v", err)
+	}
+	file, size, err := openLog(path)
+	if err != nil {
+		return nil, err
+	}
+	return &rotatingFile{path: path, maxSize: maxSize, maxBackups: maxBackups, file: file, size: size}, nil
+}
+
+// openLog opens a log file for appending, returning its current size to carry on from
+func openLog(path string) (*os.File, int64, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open log file: %v", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, fmt.Errorf("failed to stat log file: %v", err)
+	}
+	return file, info.Size(), nil
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			// Keep logging to the oversized file rather than losing messages
+			fmt.Fprintf(os.Stderr, "Warning: failed to rotate log file: %v\n", err)
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// rotate shifts the backups up one, dropping the oldest, and starts a new file
+// The old file stays open until the new one is, so a failure leaves writes going to the old handle
+func (r *rotatingFile) rotate() error {
+	for i := r.maxBackups - 1; i >= 1; i-- {
+		from := fmt.Sprintf("%s.%d", r.path, i)
+		if _, err := os.Stat(from); err == nil {
+			os.Rename(from, fmt.Sprintf("%s.%d", r.path, i+1))
+		}
+	}
+	if err := os.Rename(r.path, r.path+".1"); err != nil {
+		return err
+	}
+
+	file, size, err := openLog(r.path)
+	if err != nil {
+		return err
+	}
+	r.file.Close()
+	r.file, r.size = file, size
+	return nil
+}
+
+func (r *rotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
@@ -0,0 +1,66 @@
+package maidenhead
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Maidenhead grid locators ("FN20", "FN20jw", "FN20jw45"): pairs of longitude/latitude steps,
+// letters A-R for the 20x10 degree field, digits for the 2x1 degree square, letters a-x for the
+// 5x2.5 minute subsquare and digits again for the extended square
+
+// ToLatLng returns the center of a 2, 4, 6 or 8 character grid locator
+func ToLatLng(grid string) (lat, lng float64, err error) {
+	grid = strings.TrimSpace(grid)
+	if n := len(grid); n == 0 || n > 8 || n%2 != 0 {
+		return 0, 0, fmt.Errorf("invalid grid square %q: must be 2, 4, 6 or 8 characters", grid)
+	}
+	upper := strings.ToUpper(grid)
+
+	lngSize, latSize := 20.0, 10.0
+	lng, lat = -180, -90
+	for i := 0; i < len(upper); i += 2 {
+		var base byte
+		var steps int
+		switch i {
+		case 0:
+			base, steps = 'A', 18
+		case 2, 6:
+			base, steps = '0', 10
+		case 4:
+			base, steps = 'A', 24
+		}
+		if i > 0 {
+			lngSize /= float64(steps)
+			latSize /= float64(steps)
+		}
+
+		x, y := int(upper[i])-int(base), int(upper[i+1])-int(base)
+		if x < 0 || x >= steps || y < 0 || y >= steps {
+			return 0, 0, fmt.Errorf("invalid grid square %q: bad character at position %d", grid, i+1)
+		}
+		lng += float64(x) * lngSize
+		lat += float64(y) * latSize
+	}
+
+	return lat + latSize/2, lng + lngSize/2, nil
+}
+
+// FromLatLng returns the 6 character grid locator holding a point, e.g. "FN20jw"
+func FromLatLng(lat, lng float64) (string, error) {
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return "", fmt.Errorf("invalid coordinates %v,%v", lat, lng)
+	}
+
+	// Keep the north pole and the date line inside the last square
+	x := math.Min(lng+180, 360-1e-9)
+	y := math.Min(lat+90, 180-1e-9)
+
+	grid := []byte{
+		byte('A' + int(x/20)), byte('A' + int(y/10)),
+		byte('0' + int(math.Mod(x, 20)/2)), byte('0' + int(math.Mod(y, 10))),
+		byte('a' + int(math.Mod(x, 2)*12)), byte('a' + int(math.Mod(y, 1)*24)),
+	}
+	return string(grid), nil
+}
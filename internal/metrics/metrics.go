@@ -0,0 +1,214 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Lightweight metrics registry exposed in the Prometheus text format
+// Recording is always on (it's just a few map updates), serving /metrics is optional
+
+// Metric collectors used by the api and database packages
+var (
+	APIFetches = NewCounterVec("digilog_api_fetches_total",
+		"Number of API fetches by source and result", "source", "result")
+	APIFetchDuration = NewSummaryVec("digilog_api_fetch_duration_seconds",
+		"Time spent fetching data from each API", "source")
+	CacheHits = NewCounterVec("digilog_cache_hits_total",
+		"Number of reads served from the file cache", "source")
+	CacheMisses = NewCounterVec("digilog_cache_misses_total",
+		"Number of reads that missed the file cache", "source")
+	RecordsSynced = NewCounterVec("digilog_db_records_synced_total",
+		"Number of records written to the database by sync", "source")
+	SyncErrors = NewCounterVec("digilog_sync_errors_total",
+		"Number of records or whole syncs that failed", "source")
+)
+
+// collector is anything that can write itself in the exposition format
+type collector interface {
+	write(w io.Writer)
+}
+
+var (
+	registryMu sync.Mutex
+	registry   []collector
+)
+
+func register(c collector) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry = append(registry, c)
+}
+
+// labelKey joins label values into a single map key
+func labelKey(values []string) string {
+	return strings.Join(values, "\xff")
+}
+
+// formatLabels renders {name="value",...} for a stored label key
+func formatLabels(names []string, key string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	values := strings.Split(key, "\xff")
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		value := ""
+		if i < len(values) {
+			value = values[i]
+		}
+		value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+		pairs[i] = fmt.Sprintf(`%s="%s"`, name, value)
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// CounterVec is a monotonically increasing counter partitioned by labels
+type CounterVec struct {
+	name   string
+	help   string
+	labels []string
+	mu     sync.Mutex
+	values map[string]float64
+}
+
+// NewCounterVec creates and registers a counter with the given label names
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{
+		name:   name,
+		help:   help,
+		labels: labels,
+		values: make(map[string]float64),
+	}
+	register(c)
+	return c
+}
+
+// Add increases the counter for the given label values
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		return // Counters only go up
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[labelKey(labelValues)] += delta
+}
+
+// Inc increases the counter by one for the given label values
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Value returns the current counter value for the given label values
+func (c *CounterVec) Value(labelValues ...string) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.values[labelKey(labelValues)]
+}
+
+func (c *CounterVec) write(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n", c.name, c.help)
+	fmt.Fprintf(w, "# TYPE %s counter\n", c.name)
+	for _, key := range sortedKeys(c.values) {
+		fmt.Fprintf(w, "%s%s %g\n", c.name, formatLabels(c.labels, key), c.values[key])
+	}
+}
+
+// SummaryVec tracks the count and sum of observations partitioned by labels
+type SummaryVec struct {
+	name   string
+	help   string
+	labels []string
+	mu     sync.Mutex
+	counts map[string]uint64
+	sums   map[string]float64
+}
+
+// NewSummaryVec creates and registers a summary with the given label names
+func NewSummaryVec(name, help string, labels ...string) *SummaryVec {
+	s := &SummaryVec{
+		name:   name,
+		help:   help,
+		labels: labels,
+		counts: make(map[string]uint64),
+		sums:   make(map[string]float64),
+	}
+	register(s)
+	return s
+}
+
+// Observe records a single observation for the given label values
+func (s *SummaryVec) Observe(value float64, labelValues ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := labelKey(labelValues)
+	s.counts[key]++
+	s.sums[key] += value
+}
+
+func (s *SummaryVec) write(w io.Writer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n", s.name, s.help)
+	fmt.Fprintf(w, "# TYPE %s summary\n", s.name)
+	for _, key := range sortedKeys(s.sums) {
+		labels := formatLabels(s.labels, key)
+		fmt.Fprintf(w, "%s_sum%s %g\n", s.name, labels, s.sums[key])
+		fmt.Fprintf(w, "%s_count%s %d\n", s.name, labels, s.counts[key])
+	}
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ObserveFetch records the outcome and duration of an API fetch started at start
+func ObserveFetch(source string, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	APIFetches.Inc(source, result)
+	APIFetchDuration.Observe(time.Since(start).Seconds(), source)
+}
+
+// WriteAll writes every registered metric in the Prometheus text format
+func WriteAll(w io.Writer) {
+	registryMu.Lock()
+	collectors := make([]collector, len(registry))
+	copy(collectors, registry)
+	registryMu.Unlock()
+
+	for _, c := range collectors {
+		c.write(w)
+	}
+}
+
+// Handler returns an http.Handler serving the metrics for Prometheus to scrape
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		WriteAll(w)
+	})
+}
+
+// Serve starts an HTTP server exposing /metrics on addr (blocks until it fails)
+func Serve(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	return http.ListenAndServe(addr, mux)
+}
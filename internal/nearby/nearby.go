@@ -0,0 +1,124 @@
+package nearby
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Kind tells what an Activity is
+type Kind string
+
+const (
+	KindRepeater Kind = "repeater"
+	KindStation  Kind = "aprs"
+)
+
+// Activity is one thing on the air near the search point, a repeater from the database or an APRS
+// station from aprs.fi. Exactly one of Repeater and Station is set, matching Kind
+type Activity struct {
+	Kind       Kind                     `json:"kind"`
+	Callsign   string                   `json:"callsign"`
+	Latitude   float64                  `json:"latitude"`
+	Longitude  float64                  `json:"longitude"`
+	DistanceKm float64                  `json:"distance_km"`
+	Repeater   *database.NearbyRepeater `json:"repeater,omitempty"`
+	Station    *api.NearbyStation       `json:"station,omitempty"`
+}
+
+// Find returns the repeaters and APRS stations within radiusKm of lat/lng as one list, closest first
+// Both searches run at once. aprs may be nil when no aprs.fi key is configured, the result then only
+// has repeaters. When one search fails the other's results are still returned, along with the error,
+// so a panel can show what it has. limit caps the merged list when above zero
+func Find(ctx context.Context, db *database.Database, aprs *api.APRSClient, lat, lng, radiusKm float64, limit int, devices database.DeviceFilter) ([]Activity, error) {
+	var (
+		wg                      sync.WaitGroup
+		repeaters               []database.NearbyRepeater
+		stations                []api.NearbyStation
+		repeaterErr, stationErr error
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		repeaters, repeaterErr = db.GetRepeatersNearContext(ctx, lat, lng, radiusKm, limit, devices)
+	}()
+
+	if aprs != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// aprs.fi takes whole kilometers, round up so the circle isn't cut short
+			resp, err := aprs.GetStationsInRadiusContext(ctx, lat, lng, int(math.Ceil(radiusKm)))
+			if err != nil {
+				stationErr = err
+				return
+			}
+			// Untrimmed, merge drops the stations past radiusKm before applying the limit
+			stations = api.SortByDistance(resp.Entries, lat, lng, 0)
+		}()
+	}
+	wg.Wait()
+
+	activity := merge(repeaters, stations, radiusKm, limit)
+
+	switch {
+	case repeaterErr != nil && stationErr != nil:
+		return nil, fmt.Errorf("repeater search failed: %w; APRS search failed: %w", repeaterErr, stationErr)
+	case repeaterErr != nil:
+		return activity, fmt.Errorf("repeater search failed: %w", repeaterErr)
+	case stationErr != nil:
+		return activity, fmt.Errorf("APRS search failed: %w", stationErr)
+	}
+	return activity, nil
+}
+
+// merge tags both result lists and sorts them together by distance
+func merge(repeaters []database.NearbyRepeater, stations []api.NearbyStation, radiusKm float64, limit int) []Activity {
+	activity := make([]Activity, 0, len(repeaters)+len(stations))
+
+	for i := range repeaters {
+		r := &repeaters[i]
+		activity = append(activity, Activity{
+			Kind:       KindRepeater,
+			Callsign:   r.Callsign,
+			Latitude:   *r.Latitude,
+			Longitude:  *r.Longitude,
+			DistanceKm: r.DistanceKm,
+			Repeater:   r,
+		})
+	}
+
+	for i := range stations {
+		s := &stations[i]
+		// The whole-kilometer radius sent to aprs.fi can reach a little past the one asked for
+		if s.DistanceKm > radiusKm {
+			continue
+		}
+		activity = append(activity, Activity{
+			Kind:       KindStation,
+			Callsign:   s.Name,
+			Latitude:   s.GetLatitude(),
+			Longitude:  s.GetLongitude(),
+			DistanceKm: s.DistanceKm,
+			Station:    s,
+		})
+	}
+
+	sort.SliceStable(activity, func(i, j int) bool {
+		if activity[i].DistanceKm != activity[j].DistanceKm {
+			return activity[i].DistanceKm < activity[j].DistanceKm
+		}
+		return activity[i].Callsign < activity[j].Callsign
+	})
+
+	if limit > 0 && len(activity) > limit {
+		activity = activity[:limit]
+	}
+	return activity
+}
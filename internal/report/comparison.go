@@ -0,0 +1,221 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Side by side reports of several repeaters, for documenting the frequencies of a linked-system net
+
+// WriteComparisonTable writes a plain-text table with a column per repeater and a row per setting,
+// followed by a row per linked talkgroup grouped by timeslot
+func WriteComparisonTable(w io.Writer, comparison []database.RepeaterComparison) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	row := func(label string, cell func(c database.RepeaterComparison) string) {
+		cells := []string{label}
+		for _, c := range comparison {
+			cells = append(cells, cell(c))
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+
+	row("Callsign", func(c database.RepeaterComparison) string { return c.Repeater.Callsign })
+	row("Mode", func(c database.RepeaterComparison) string { return c.Repeater.Mode })
+	row("Output", func(c database.RepeaterComparison) string { return outputColumn(c) })
+	row("Input", func(c database.RepeaterComparison) string { return inputColumn(c) })
+	row("Offset", func(c database.RepeaterComparison) string { return offsetColumn(c) })
+	row("Tone", func(c database.RepeaterComparison) string { return toneColumn(c) })
+	row("Color Code", func(c database.RepeaterComparison) string { return colorCodeColumn(c) })
+	row("Location", func(c database.RepeaterComparison) string { return c.Repeater.GetLocationString() })
+
+	// One row per talkgroup, the longest list of each timeslot sets how many rows it takes
+	for _, slot := range timeslots(comparison) {
+		lines := 0
+		for _, c := range comparison {
+			lines = max(lines, len(talkgroupsOnSlot(c, slot)))
+		}
+		for line := 0; line < lines; line++ {
+			label := ""
+			if line == 0 {
+				label = slotLabel(slot)
+			}
+			row(label, func(c database.RepeaterComparison) string {
+				if talkgroups := talkgroupsOnSlot(c, slot); line < len(talkgroups) {
+					return talkgroupName(talkgroups[line])
+				}
+				return ""
+			})
+		}
+	}
+
+	return tw.Flush()
+}
+
+// comparisonCSVColumns are the columns WriteComparisonCSV can write, in their default order
+var comparisonCSVColumns = []database.CSVColumn[database.RepeaterComparison]{
+	{Header: "ID", Value: plain(func(c database.RepeaterComparison) string { return fmt.Sprint(c.Repeater.ID) })},
+	{Header: "Callsign", Value: plain(func(c database.RepeaterComparison) string { return c.Repeater.Callsign })},
+	{Header: "Mode", Value: plain(func(c database.RepeaterComparison) string { return c.Repeater.Mode })},
+	{Header: "Output MHz", Value: outputCSV},
+	{Header: "Input MHz", Value: inputCSV},
+	{Header: "Offset", Value: offsetCSV},
+	{Header: "Tone", Value: plain(toneColumn)},
+	{Header: "Color Code", Value: plain(colorCodeColumn)},
+	{Header: "Location", Value: plain(func(c database.RepeaterComparison) string { return c.Repeater.GetLocationString() })},
+	{Header: "Talkgroups", Value: plain(talkgroupsCSV)},
+}
+
+// plain adapts a column the export options don't change
+func plain(value func(database.RepeaterComparison) string) func(database.RepeaterComparison, database.ExportOptions) string {
+	return func(c database.RepeaterComparison, _ database.ExportOptions) string { return value(c) }
+}
+
+// ComparisonCSVColumns lists the columns WriteComparisonCSV can write, for ExportOptions.Columns
+func ComparisonCSVColumns() []string {
+	return database.CSVHeaders(comparisonCSVColumns)
+}
+
+// WriteComparisonCSV writes one row per repeater, talkgroups joined with "; " in a single column
+// opts picks the columns, frequency decimals and whether there's a header, the zero value writes them all
+func WriteComparisonCSV(w io.Writer, comparison []database.RepeaterComparison, opts database.ExportOptions) error {
+	return database.WriteCSV(w, comparisonCSVColumns, comparison, opts)
+}
+
+// outputCSV is outputColumn with the decimals opts asks for
+func outputCSV(c database.RepeaterComparison, opts database.ExportOptions) string {
+	if c.Programming == nil {
+		return "unknown"
+	}
+	return opts.FormatFrequency(c.Programming.RxFrequency) // The radio receives the repeater's output
+}
+
+// inputCSV is inputColumn with the decimals opts asks for
+func inputCSV(c database.RepeaterComparison, opts database.ExportOptions) string {
+	if c.Programming == nil {
+		return "unknown"
+	}
+	return opts.FormatFrequency(c.Programming.TxFrequency)
+}
+
+// offsetCSV is offsetColumn with the decimals opts asks for
+func offsetCSV(c database.RepeaterComparison, opts database.ExportOptions) string {
+	switch {
+	case c.Programming == nil:
+		return "unknown"
+	case c.Programming.Duplex == "":
+		return "simplex"
+	}
+	if c.Programming.Inferred {
+		return c.Programming.Duplex + opts.FormatFrequency(c.Programming.Offset) + " (standard)"
+	}
+	return c.Programming.Duplex + opts.FormatFrequency(c.Programming.Offset)
+}
+
+// talkgroupsCSV joins the linked talkgroups into one column, each with its timeslot
+func talkgroupsCSV(c database.RepeaterComparison) string {
+	var talkgroups []string
+	for _, tg := range c.Talkgroups {
+		name := talkgroupName(tg)
+		if tg.Timeslot > 0 {
+			name = slotLabel(tg.Timeslot) + " " + name
+		}
+		talkgroups = append(talkgroups, name)
+	}
+	return strings.Join(talkgroups, "; ")
+}
+
+func outputColumn(c database.RepeaterComparison) string {
+	if c.Programming == nil {
+		return "unknown"
+	}
+	return formatMHz(c.Programming.RxFrequency) // The radio receives the repeater's output
+}
+
+func inputColumn(c database.RepeaterComparison) string {
+	if c.Programming == nil {
+		return "unknown"
+	}
+	return formatMHz(c.Programming.TxFrequency)
+}
+
+func offsetColumn(c database.RepeaterComparison) string {
+	switch {
+	case c.Programming == nil:
+		return "unknown"
+	case c.Programming.Duplex == "":
+		return "simplex"
+	}
+	if c.Programming.Inferred {
+		return c.Programming.Duplex + formatMHz(c.Programming.Offset) + " (standard)"
+	}
+	return c.Programming.Duplex + formatMHz(c.Programming.Offset)
+}
+
+func toneColumn(c database.RepeaterComparison) string {
+	if c.Programming == nil || c.Programming.Tone == nil || *c.Programming.Tone <= 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%.1f", *c.Programming.Tone)
+}
+
+func colorCodeColumn(c database.RepeaterComparison) string {
+	if c.Programming == nil || c.Programming.ColorCode == nil {
+		return "-"
+	}
+	return fmt.Sprint(*c.Programming.ColorCode)
+}
+
+// timeslots lists the timeslots any repeater has talkgroups on, in order
+func timeslots(comparison []database.RepeaterComparison) []int {
+	seen := make(map[int]bool)
+	var slots []int
+	for _, slot := range []int{1, 2, 0} {
+		for _, c := range comparison {
+			if !seen[slot] && len(talkgroupsOnSlot(c, slot)) > 0 {
+				seen[slot] = true
+				slots = append(slots, slot)
+			}
+		}
+	}
+	return slots
+}
+
+func talkgroupsOnSlot(c database.RepeaterComparison, slot int) []database.RepeaterTalkgroup {
+	var talkgroups []database.RepeaterTalkgroup
+	for _, tg := range c.Talkgroups {
+		if tg.Timeslot == slot {
+			talkgroups = append(talkgroups, tg)
+		}
+	}
+	return talkgroups
+}
+
+// slotLabel is "TS1" or "TS2", talkgroups without a timeslot are just "Talkgroups"
+func slotLabel(slot int) string {
+	if slot == 0 {
+		return "Talkgroups"
+	}
+	return fmt.Sprintf("TS%d", slot)
+}
+
+func talkgroupName(tg database.RepeaterTalkgroup) string {
+	name := fmt.Sprint(tg.TalkgroupID)
+	if tg.Name != "" {
+		name = fmt.Sprintf("%d %s", tg.TalkgroupID, tg.Name)
+	}
+	// Dynamic links come and go with use, unlike the static ones a repeater always carries
+	if !tg.StaticLink {
+		name += " (dynamic)"
+	}
+	return name
+}
+
+// formatMHz shows at least three decimals and a fourth only when it's needed, like programming strings
+func formatMHz(mhz float64) string {
+	return strings.TrimSuffix(fmt.Sprintf("%.4f", mhz), "0")
+}
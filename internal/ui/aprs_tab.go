@@ -1,8 +1,13 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
@@ -10,19 +15,39 @@ import (
 
 	"github.com/unklstewy/digiLogRT/internal/api"
 	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
 )
 
+// aprsSearchTimeout bounds how long a single search may take before it's abandoned
+const aprsSearchTimeout = 20 * time.Second
+
+// aprsNearbyLimit caps how many stations a radius search lists, busy areas return thousands
+const aprsNearbyLimit = 200
+
 type APRSTab struct {
 	client       *api.APRSClient
+	db           *database.Database // Optional - records position history when set
 	searchEntry  *widget.Entry
 	searchButton *widget.Button
-	resultsText  *widget.RichText
+	nearbyButton *widget.Button
+	radiusEntry  *widget.Entry // Nearby search radius, in the configured units
+	groupCheck   *widget.Check // Nearby results show one row per base callsign, the latest SSID heard
+	units        config.Units
+	cfg          *config.Config // The user's location is the nearby center until a station is found
+	results      *aprsResultsTable
+	detailText   *widget.RichText // Full details of the selected (or only) station
 	statusLabel  *widget.Label
+
+	// In-flight search tracking so a new search supersedes the previous one
+	searchMu     sync.Mutex
+	cancelSearch context.CancelFunc
+	searchSeq    uint64
+	lastStation  *api.APRSStation // Most recent station found, the center for nearby searches
 }
 
-func NewAPRSTab(cfg *config.Config) *APRSTab {
+func NewAPRSTab(cfg *config.Config, db *database.Database) *APRSTab {
 	// Create APRS client
-	client := api.NewAPRSClient(cfg.APIs.AprsKey)
+	client := api.NewAPRSClient(cfg.APIs.AprsKey, cfg.Cache.APRS)
 
 	// Create UI elements
 	searchEntry := widget.NewEntry()
@@ -32,78 +57,249 @@ func NewAPRSTab(cfg *config.Config) *APRSTab {
 	// ITU regions can have callsigns up to 9-10 characters plus portable indicators
 	searchEntry.Resize(fyne.NewSize(250, searchEntry.MinSize().Height))
 
-	resultsText := widget.NewRichText()
-	resultsText.Wrapping = fyne.TextWrapWord
+	detailText := widget.NewRichText()
+	detailText.Wrapping = fyne.TextWrapWord
 
 	statusLabel := widget.NewLabel("Ready")
 
+	radiusEntry := widget.NewEntry()
+	radiusEntry.SetText(strconv.FormatFloat(cfg.DefaultRadius, 'f', -1, 64))
+
 	aprsTab := &APRSTab{
 		client:      client,
+		db:          db,
 		searchEntry: searchEntry,
-		resultsText: resultsText,
+		radiusEntry: radiusEntry,
+		units:       cfg.Units,
+		cfg:         cfg,
+		results:     newAPRSResultsTable(cfg.Units),
+		detailText:  detailText,
 		statusLabel: statusLabel,
 	}
+	aprsTab.results.OnSelected = aprsTab.showStationDetail
 
-	// Create search button
+	// Create search buttons
 	aprsTab.searchButton = widget.NewButton("Search Station", aprsTab.searchStation)
+	aprsTab.nearbyButton = widget.NewButton("Stations Nearby", aprsTab.searchNearby)
+	aprsTab.groupCheck = widget.NewCheck("One per callsign", nil)
+	aprsTab.updateNearbyButton()
+	searchEntry.OnSubmitted = func(string) { aprsTab.searchStation() }
 
 	return aprsTab
 }
 
 func (a *APRSTab) searchStation() {
-	callsign := a.searchEntry.Text
-	if callsign == "" {
-		a.statusLabel.SetText("Please enter a callsign")
+	callsign, err := api.ValidateCallsign(a.searchEntry.Text)
+	if err != nil {
+		a.statusLabel.SetText(err.Error())
 		return
 	}
+	a.searchEntry.SetText(callsign) // Show the normalized form that was searched
 
+	ctx, seq := a.startSearch()
 	a.statusLabel.SetText("Searching...")
-	a.searchButton.Disable()
 
 	// Perform search in background
 	go func() {
-		response, err := a.client.GetStation(callsign)
+		response, err := a.client.GetStationContext(ctx, callsign)
+
+		// A newer search has started - drop these results so they can't overwrite it
+		if !a.finishSearch(seq) {
+			return
+		}
 
 		// Update UI in main thread
 		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				err = fmt.Errorf("search timed out after %v", aprsSearchTimeout)
+			}
 			log.Printf("APRS search error: %v", err)
-			a.statusLabel.SetText(fmt.Sprintf("Error: %v", err))
-			a.searchButton.Enable()
+			a.statusLabel.SetText("Error: " + describeError(err))
 			return
 		}
 
-		// Format results
-		var resultText string
-		if response.Found == 0 {
-			resultText = fmt.Sprintf("No stations found for '%s'", callsign)
-		} else {
-			resultText = fmt.Sprintf("Found %d station(s) for '%s':\n\n", response.Found, callsign)
-
-			for i, station := range response.Entries {
-				resultText += fmt.Sprintf("Station %d:\n", i+1)
-				resultText += fmt.Sprintf("  Callsign: %s\n", station.Name)
-				resultText += fmt.Sprintf("  Location: %.6f, %.6f\n", station.GetLatitude(), station.GetLongitude())
-				resultText += fmt.Sprintf("  Last Heard: %s\n", station.GetLastTimeString())
-				resultText += fmt.Sprintf("  Timestamp: %s\n", station.GetTimeString())
-				if station.Comment != "" {
-					resultText += fmt.Sprintf("  Comment: %s\n", station.Comment)
-				}
-				if station.Speed.Value > 0 {
-					resultText += fmt.Sprintf("  Speed: %d km/h\n", station.Speed.Value)
-				}
-				if station.Course.Value > 0 {
-					resultText += fmt.Sprintf("  Course: %d°\n", station.Course.Value)
-				}
-				resultText += "\n"
+		a.recordPositions(response.Entries)
+
+		if response.Found == 0 || len(response.Entries) == 0 {
+			a.results.SetStations(nil)
+			a.detailText.ParseMarkdown(fmt.Sprintf("No stations found for '%s'", callsign))
+			a.statusLabel.SetText("Search completed")
+			return
+		}
+
+		station := response.Entries[0]
+		a.searchMu.Lock()
+		a.lastStation = &station
+		a.searchMu.Unlock()
+		a.updateNearbyButton()
+
+		a.results.SetStations(response.Entries)
+		a.showStationDetail(station)
+		a.statusLabel.SetText(fmt.Sprintf("Found %d station(s) for '%s'", response.Found, callsign))
+	}()
+}
+
+// updateNearbyButton enables the nearby search when there's a position to search around, a station found
+// or the user's location. Called again whenever either changes
+func (a *APRSTab) updateNearbyButton() {
+	a.searchMu.Lock()
+	found := a.lastStation != nil
+	a.searchMu.Unlock()
+
+	if _, _, ok := a.cfg.GetUserLocation(); found || ok {
+		a.nearbyButton.Enable()
+	} else {
+		a.nearbyButton.Disable()
+	}
+}
+
+// searchNearby lists the stations around the most recently found station, or the user's location
+func (a *APRSTab) searchNearby() {
+	a.searchMu.Lock()
+	last := a.lastStation
+	a.searchMu.Unlock()
+
+	var lat, lng float64
+	var centerName string
+	if last != nil {
+		lat, lng, centerName = last.GetLatitude(), last.GetLongitude(), last.Name
+	} else {
+		var ok bool
+		if lat, lng, ok = a.cfg.GetUserLocation(); !ok {
+			a.statusLabel.SetText("Search for a station or set your location in Settings first")
+			return
+		}
+		centerName = "your location"
+	}
+
+	radius, err := strconv.ParseFloat(strings.TrimSpace(a.radiusEntry.Text), 64)
+	if err != nil || radius <= 0 {
+		a.statusLabel.SetText(fmt.Sprintf("Please enter a radius in %s", a.units))
+		return
+	}
+	radiusText := fmt.Sprintf("%g %s", radius, a.units)
+	keepSSIDs := !a.groupCheck.Checked
+
+	ctx, seq := a.startSearch()
+	a.statusLabel.SetText(fmt.Sprintf("Searching within %s of %s...", radiusText, centerName))
+
+	go func() {
+		// aprs.fi takes kilometers, the entry is in the user's units
+		response, err := a.client.GetStationsInRadiusContext(ctx, lat, lng, a.units.RadiusKm(radius))
+
+		if !a.finishSearch(seq) {
+			return
+		}
+
+		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				err = fmt.Errorf("search timed out after %v", aprsSearchTimeout)
 			}
+			log.Printf("APRS nearby search error: %v", err)
+			a.statusLabel.SetText("Error: " + describeError(err))
+			return
 		}
 
-		a.resultsText.ParseMarkdown(resultText)
-		a.statusLabel.SetText("Search completed")
-		a.searchButton.Enable()
+		a.recordPositions(response.Entries)
+
+		stations := api.LatestPerCallsign(response.Entries, keepSSIDs)
+		nearby := api.SortByDistance(stations, lat, lng, aprsNearbyLimit)
+		a.results.SetNearbyStations(nearby)
+		a.detailText.ParseMarkdown("Select a station to see its details")
+		if len(nearby) == aprsNearbyLimit && len(stations) > aprsNearbyLimit {
+			a.statusLabel.SetText(fmt.Sprintf("Showing the nearest %d of %d stations within %s of %s",
+				len(nearby), len(stations), radiusText, centerName))
+		} else {
+			a.statusLabel.SetText(fmt.Sprintf("Found %d station(s) within %s of %s",
+				len(nearby), radiusText, centerName))
+		}
 	}()
 }
 
+// recordPositions remembers every position we see so a station's track can be reviewed later
+func (a *APRSTab) recordPositions(stations []api.APRSStation) {
+	if a.db == nil {
+		return
+	}
+	for _, station := range stations {
+		if err := a.db.RecordAPRSPosition(station); err != nil {
+			log.Printf("Failed to record APRS position: %v", err)
+		}
+	}
+}
+
+// showStationDetail shows everything known about one station below the table
+func (a *APRSTab) showStationDetail(station api.APRSStation) {
+	detail := fmt.Sprintf("**%s**\n\n", station.Name)
+	detail += fmt.Sprintf("Location: %.6f, %.6f\n\n", station.GetLatitude(), station.GetLongitude())
+	detail += fmt.Sprintf("Last Heard: %s\n\n", station.GetLastTimeString())
+	detail += fmt.Sprintf("Timestamp: %s\n\n", station.GetTimeString())
+	if station.Comment != "" {
+		detail += fmt.Sprintf("Comment: %s\n\n", station.Comment)
+	}
+	if station.Speed.Value > 0 {
+		detail += fmt.Sprintf("Speed: %d km/h\n\n", station.Speed.Value)
+	}
+	if station.Course.Value > 0 {
+		detail += fmt.Sprintf("Course: %d°\n\n", station.Course.Value)
+	}
+	for _, repeater := range a.repeatersAtSite(station) {
+		detail += fmt.Sprintf("Repeater: %s %s %s\n\n", repeater.Callsign, repeater.GetFrequencyString(), repeater.Mode)
+	}
+	a.detailText.ParseMarkdown(detail)
+}
+
+// repeatersAtSite lists the repeaters the station shares a site and callsign with, see database.MatchAPRSStation
+func (a *APRSTab) repeatersAtSite(station api.APRSStation) []database.RepeaterRecord {
+	if a.db == nil {
+		return nil
+	}
+	base, _ := api.SplitSSID(station.Name)
+	repeaters, err := a.db.GetRepeatersByCallsign(base, false)
+	if err != nil {
+		log.Printf("Failed to look up repeaters for %s: %v", station.Name, err)
+		return nil
+	}
+
+	var atSite []database.RepeaterRecord
+	for _, repeater := range repeaters {
+		if _, ok := database.MatchAPRSStation(repeater, station); ok {
+			atSite = append(atSite, repeater)
+		}
+	}
+	return atSite
+}
+
+// startSearch cancels any in-flight search and returns the context and sequence number for a new one
+func (a *APRSTab) startSearch() (context.Context, uint64) {
+	a.searchMu.Lock()
+	defer a.searchMu.Unlock()
+
+	if a.cancelSearch != nil {
+		a.cancelSearch()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), aprsSearchTimeout)
+	a.cancelSearch = cancel
+	a.searchSeq++
+	return ctx, a.searchSeq
+}
+
+// finishSearch releases the search's context and reports whether it is still the current search
+func (a *APRSTab) finishSearch(seq uint64) bool {
+	a.searchMu.Lock()
+	defer a.searchMu.Unlock()
+
+	if seq != a.searchSeq {
+		return false
+	}
+	if a.cancelSearch != nil {
+		a.cancelSearch()
+		a.cancelSearch = nil
+	}
+	return true
+}
+
 func (a *APRSTab) GetContainer() *fyne.Container {
 	// Search section with better layout for callsign entry
 	callsignLabel := widget.NewLabel("Callsign:")
@@ -112,13 +308,21 @@ func (a *APRSTab) GetContainer() *fyne.Container {
 	// Create a container that gives the entry field more space
 	searchForm := container.NewBorder(
 		nil, nil, // top, bottom
-		callsignLabel, a.searchButton, // left, right
+		callsignLabel, container.NewHBox(
+			a.searchButton,
+			widget.NewLabel("Radius:"),
+			container.NewGridWrap(fyne.NewSize(70, a.radiusEntry.MinSize().Height), a.radiusEntry),
+			widget.NewLabel(string(a.units)),
+			a.nearbyButton,
+			a.groupCheck,
+		), // left, right
 		a.searchEntry, // center - this will expand to fill available space
 	)
 
-	// Results section
-	resultsScroll := container.NewScroll(a.resultsText)
-	resultsScroll.SetMinSize(fyne.NewSize(600, 300))
+	// Results section - the table scrolls itself, give it room for several rows
+	resultsTable := container.NewGridWrap(fyne.NewSize(720, 240), a.results.table)
+	detailScroll := container.NewScroll(a.detailText)
+	detailScroll.SetMinSize(fyne.NewSize(600, 120))
 
 	// Status section
 	statusSection := container.NewHBox(
@@ -132,7 +336,8 @@ func (a *APRSTab) GetContainer() *fyne.Container {
 		searchForm,
 		widget.NewSeparator(),
 		widget.NewLabel("Results:"),
-		resultsScroll,
+		resultsTable,
+		detailScroll,
 		widget.NewSeparator(),
 		statusSection,
 	)
@@ -0,0 +1,180 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+)
+
+// APRS results table columns, in display order
+const (
+	aprsColCallsign = iota
+	aprsColDistance
+	aprsColLastHeard
+	aprsColComment
+)
+
+var aprsColumnTitles = []string{"Callsign", "Distance", "Last Heard", "Comment"}
+var aprsColumnWidths = []float32{120, 90, 160, 320}
+
+// aprsRow is one station in the results table with its distance from the search point
+type aprsRow struct {
+	station     api.APRSStation
+	distanceKm  float64
+	hasDistance bool
+}
+
+// aprsResultsTable shows APRS stations in a table that can be sorted by clicking a column header
+type aprsResultsTable struct {
+	table         *widget.Table
+	rows          []aprsRow
+	sortColumn    int
+	sortAscending bool
+	units         config.Units // Distances are kept in km and shown in these units
+
+	// OnSelected is called with the station the user clicked
+	OnSelected func(station api.APRSStation)
+}
+
+func newAPRSResultsTable(units config.Units) *aprsResultsTable {
+	t := &aprsResultsTable{sortColumn: aprsColCallsign, sortAscending: true, units: units}
+
+	t.table = widget.NewTableWithHeaders(
+		func() (int, int) { return len(t.rows), len(aprsColumnTitles) },
+		func() fyne.CanvasObject {
+			label := widget.NewLabel("")
+			label.Truncation = fyne.TextTruncateEllipsis
+			return label
+		},
+		func(id widget.TableCellID, cell fyne.CanvasObject) {
+			cell.(*widget.Label).SetText(t.cellText(id.Row, id.Col))
+		},
+	)
+	t.table.ShowHeaderColumn = false
+
+	// Header cells are buttons so a click sorts by that column
+	t.table.CreateHeader = func() fyne.CanvasObject {
+		return widget.NewButton("", nil)
+	}
+	t.table.UpdateHeader = func(id widget.TableCellID, header fyne.CanvasObject) {
+		button := header.(*widget.Button)
+		title := aprsColumnTitles[id.Col]
+		if id.Col == t.sortColumn {
+			if t.sortAscending {
+				title += " ▲"
+			} else {
+				title += " ▼"
+			}
+		}
+		button.SetText(title)
+		col := id.Col
+		button.OnTapped = func() { t.sortBy(col) }
+	}
+
+	t.table.OnSelected = func(id widget.TableCellID) {
+		if id.Row >= 0 && id.Row < len(t.rows) && t.OnSelected != nil {
+			t.OnSelected(t.rows[id.Row].station)
+		}
+	}
+
+	for col, width := range aprsColumnWidths {
+		t.table.SetColumnWidth(col, width)
+	}
+
+	return t
+}
+
+// SetStations replaces the table contents with stations from a callsign lookup
+func (t *aprsResultsTable) SetStations(stations []api.APRSStation) {
+	t.rows = make([]aprsRow, len(stations))
+	for i, station := range stations {
+		t.rows[i] = aprsRow{station: station}
+	}
+	t.sortColumn, t.sortAscending = aprsColCallsign, true
+	t.applySort()
+}
+
+// SetNearbyStations replaces the table contents with the results of a radius search
+// showing each station's distance, closest first
+func (t *aprsResultsTable) SetNearbyStations(stations []api.NearbyStation) {
+	t.rows = make([]aprsRow, len(stations))
+	for i, station := range stations {
+		t.rows[i] = aprsRow{station: station.APRSStation, distanceKm: station.DistanceKm, hasDistance: true}
+	}
+	t.sortColumn, t.sortAscending = aprsColDistance, true
+	t.applySort()
+}
+
+// sortBy sorts on a column, toggling the direction when it is already the sort column
+func (t *aprsResultsTable) sortBy(col int) {
+	if col == t.sortColumn {
+		t.sortAscending = !t.sortAscending
+	} else {
+		t.sortColumn, t.sortAscending = col, true
+	}
+	t.applySort()
+}
+
+func (t *aprsResultsTable) applySort() {
+	sort.SliceStable(t.rows, func(i, j int) bool {
+		a, b := t.rows[i], t.rows[j]
+		var less bool
+		switch t.sortColumn {
+		case aprsColDistance:
+			less = a.distanceKm < b.distanceKm
+		case aprsColLastHeard:
+			less = a.station.LastTime.Value < b.station.LastTime.Value
+		case aprsColComment:
+			less = strings.ToLower(a.station.Comment) < strings.ToLower(b.station.Comment)
+		default:
+			less = a.station.Name < b.station.Name
+		}
+		if !t.sortAscending {
+			return !less && !t.equal(a, b)
+		}
+		return less
+	})
+	t.table.UnselectAll()
+	t.table.Refresh()
+}
+
+// equal reports whether two rows tie on the sort column, keeping descending sorts stable
+func (t *aprsResultsTable) equal(a, b aprsRow) bool {
+	switch t.sortColumn {
+	case aprsColDistance:
+		return a.distanceKm == b.distanceKm
+	case aprsColLastHeard:
+		return a.station.LastTime.Value == b.station.LastTime.Value
+	case aprsColComment:
+		return strings.EqualFold(a.station.Comment, b.station.Comment)
+	default:
+		return a.station.Name == b.station.Name
+	}
+}
+
+func (t *aprsResultsTable) cellText(row, col int) string {
+	if row < 0 || row >= len(t.rows) {
+		return ""
+	}
+	r := t.rows[row]
+
+	switch col {
+	case aprsColCallsign:
+		return r.station.Name
+	case aprsColDistance:
+		if !r.hasDistance {
+			return "—"
+		}
+		return t.units.Format(r.distanceKm)
+	case aprsColLastHeard:
+		return r.station.GetLastTimeString()
+	case aprsColComment:
+		return r.station.Comment
+	}
+	return ""
+}
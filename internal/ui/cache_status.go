@@ -0,0 +1,174 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+)
+
+// cacheStatusInterval is how often the status bar rechecks the caches, data goes stale while the app is open too
+const cacheStatusInterval = 10 * time.Minute
+
+// cacheSource is one bulk-download client whose file cache the status bar watches
+type cacheSource struct {
+	name    string
+	status  func() map[string]interface{}
+	refresh func() error
+}
+
+// CacheStatusBar warns about sources whose cached data is stale, each with a button that refreshes it
+// in the background. It stays hidden while every cache is fresh. In offline mode it says so instead,
+// since nothing can be refreshed
+type CacheStatusBar struct {
+	sources   []cacheSource
+	container *fyne.Container
+	offline   bool
+
+	mu         sync.Mutex
+	refreshing map[string]bool  // Sources with a refresh in flight
+	failures   map[string]error // Why the last refresh of a source failed
+}
+
+func NewCacheStatusBar(cfg *config.Config) *CacheStatusBar {
+	bar := &CacheStatusBar{
+		container:  container.NewVBox(),
+		offline:    cfg.Offline,
+		refreshing: make(map[string]bool),
+		failures:   make(map[string]error),
+	}
+
+	// Brandmeister needs an API key to refresh, without one there's nothing to offer
+	if cfg.APIs.BrandmeisterKey != "" {
+		client := api.NewBrandmeisterClient(cfg.APIs, cfg.Cache.Brandmeister)
+		bar.sources = append(bar.sources, cacheSource{"Brandmeister", client.GetCacheStatus, client.ForceRefresh})
+	}
+	tgif := api.NewTGIFClient(cfg.Cache.TGIF)
+	bar.sources = append(bar.sources, cacheSource{"TGIF", tgif.GetCacheStatus, tgif.ForceRefresh})
+	hearham := api.NewHearhamClient(cfg.Cache.Hearham)
+	bar.sources = append(bar.sources, cacheSource{"hearham", hearham.GetCacheStatus, hearham.ForceRefresh})
+
+	bar.update()
+	go func() {
+		for range time.Tick(cacheStatusInterval) {
+			bar.update()
+		}
+	}()
+
+	return bar
+}
+
+// update rebuilds the warnings from each client's GetCacheStatus
+func (b *CacheStatusBar) update() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var rows []fyne.CanvasObject
+	if b.offline {
+		rows = append(rows, container.NewHBox(widget.NewIcon(theme.InfoIcon()),
+			widget.NewLabel("Offline mode: using cached data only, nothing is fetched")))
+	}
+	for _, source := range b.sources {
+		if row := b.sourceRow(source); row != nil {
+			rows = append(rows, row)
+		}
+	}
+
+	b.container.Objects = rows
+	if len(rows) == 0 {
+		b.container.Hide()
+	} else {
+		b.container.Show()
+	}
+	b.container.Refresh()
+}
+
+// sourceRow is the warning for one source, or nil when its cache is fresh. Call with b.mu held
+func (b *CacheStatusBar) sourceRow(source cacheSource) fyne.CanvasObject {
+	if b.offline {
+		return nil
+	}
+	if b.refreshing[source.name] {
+		return container.NewHBox(widget.NewIcon(theme.ViewRefreshIcon()),
+			widget.NewLabel(fmt.Sprintf("Refreshing %s data...", source.name)))
+	}
+
+	refreshButton := widget.NewButtonWithIcon("Refresh", theme.ViewRefreshIcon(), func() {
+		b.refresh(source)
+	})
+	if err := b.failures[source.name]; err != nil {
+		refreshButton.SetText("Retry")
+		return container.NewHBox(widget.NewIcon(theme.ErrorIcon()),
+			widget.NewLabel(fmt.Sprintf("%s refresh failed: %s", source.name, describeError(err))), refreshButton)
+	}
+
+	status := source.status()
+	if needsRefresh, _ := status["needs_refresh"].(bool); !needsRefresh {
+		return nil
+	}
+
+	message := fmt.Sprintf("No %s data downloaded yet — refresh?", source.name)
+	if lastUpdate, _ := status["last_update"].(time.Time); !lastUpdate.IsZero() {
+		message = fmt.Sprintf("%s data is %s old — refresh?", source.name, formatCacheAge(time.Since(lastUpdate)))
+	}
+	return container.NewHBox(widget.NewIcon(theme.WarningIcon()), widget.NewLabel(message), refreshButton)
+}
+
+// refresh calls the source's ForceRefresh in the background, the bar shows progress until it finishes
+func (b *CacheStatusBar) refresh(source cacheSource) {
+	b.mu.Lock()
+	if b.refreshing[source.name] {
+		b.mu.Unlock()
+		return
+	}
+	b.refreshing[source.name] = true
+	delete(b.failures, source.name)
+	b.mu.Unlock()
+	b.update()
+
+	go func() {
+		err := source.refresh()
+		if err != nil {
+			log.Printf("%s cache refresh error: %v", source.name, err)
+		}
+
+		b.mu.Lock()
+		delete(b.refreshing, source.name)
+		if err != nil {
+			b.failures[source.name] = err
+		}
+		b.mu.Unlock()
+		b.update()
+	}()
+}
+
+// formatCacheAge rounds an age to whole minutes, hours or days, whichever reads best
+func formatCacheAge(age time.Duration) string {
+	switch {
+	case age < 2*time.Hour:
+		return countOf(int(age.Minutes()), "minute")
+	case age < 48*time.Hour:
+		return countOf(int(age.Hours()), "hour")
+	}
+	return countOf(int(age.Hours()/24), "day")
+}
+
+// countOf is "1 day" or "3 days"
+func countOf(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+func (b *CacheStatusBar) GetContainer() *fyne.Container {
+	return b.container
+}
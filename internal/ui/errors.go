@@ -0,0 +1,31 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+)
+
+// errorHint suggests what to do about a failed API call, or "" when there's nothing useful to say
+func errorHint(err error) string {
+	switch {
+	case errors.Is(err, api.ErrOffline):
+		return "offline mode is on"
+	case errors.Is(err, api.ErrAuth):
+		return "check your API key in configs/config.yaml"
+	case errors.Is(err, api.ErrRateLimited):
+		return "too many requests, try again in a few minutes"
+	case errors.Is(err, api.ErrNetwork):
+		return "check your connection"
+	}
+	return ""
+}
+
+// describeError is the error followed by its hint, for status labels
+func describeError(err error) string {
+	if hint := errorHint(err); hint != "" {
+		return fmt.Sprintf("%v (%s)", err, hint)
+	}
+	return err.Error()
+}
@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// mapMarkerLimit caps how many repeaters are drawn at once, zoom in to see the rest
+const mapMarkerLimit = 1000
+
+// MapTab shows the synced repeaters on an OpenStreetMap tile map
+type MapTab struct {
+	db          *database.Database
+	cfg         *config.Config // Read when centering, the Settings tab can change the location
+	view        *mapView
+	statusLabel *widget.Label
+
+	// Marker loads run in the background, only the newest one may update the map and a new one cancels
+	// the query of the last
+	loadMu     sync.Mutex
+	loadSeq    uint64
+	cancelLoad context.CancelFunc
+}
+
+func NewMapTab(cfg *config.Config, db *database.Database) *MapTab {
+	userAgent := fmt.Sprintf("%s/%s", cfg.App.Name, cfg.App.Version)
+	tiles := api.NewTileClient(cfg.Map.TileURL, userAgent)
+
+	lat, lng, zoom := mapHome(cfg)
+	tab := &MapTab{
+		db:          db,
+		cfg:         cfg,
+		view:        newMapView(tiles, lat, lng, zoom),
+		statusLabel: widget.NewLabel("Drag to pan, scroll to zoom, click a marker for details"),
+	}
+	tab.view.OnViewChanged = tab.loadMarkers
+	tab.view.OnMarkerTapped = func(r database.RepeaterRecord) {
+		ShowRepeaterDetail(r, db, cfg.Units, cfg.HeightUnits)
+	}
+
+	return tab
+}
+
+// mapHome is where the map opens: the user's location, or the whole US when none is set
+func mapHome(cfg *config.Config) (lat, lng float64, zoom int) {
+	if lat, lng, ok := cfg.GetUserLocation(); ok {
+		return lat, lng, cfg.Map.Zoom
+	}
+	return config.DefaultMapLatitude, config.DefaultMapLongitude, config.DefaultMapZoom
+}
+
+// CenterOnMe moves the map back to the user's location
+func (t *MapTab) CenterOnMe() {
+	t.view.SetCenter(mapHome(t.cfg))
+}
+
+// loadMarkers queries the repeaters inside the visible area and puts them on the map
+func (t *MapTab) loadMarkers(boxes []database.BoundingBox) {
+	t.loadMu.Lock()
+	if t.cancelLoad != nil {
+		t.cancelLoad()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancelLoad = cancel
+	t.loadSeq++
+	seq := t.loadSeq
+	t.loadMu.Unlock()
+
+	go func() {
+		// A view across the date line has a box either side of it
+		var repeaters []database.RepeaterRecord
+		var err error
+		for _, box := range boxes {
+			var inBox []database.RepeaterRecord
+			limit := mapMarkerLimit + 1 - len(repeaters)
+			if inBox, err = t.db.GetRepeatersInBoundingBoxContext(ctx, box, limit, database.SearchDeviceFilter(t.cfg.Search)); err != nil {
+				break
+			}
+			if repeaters = append(repeaters, inBox...); len(repeaters) > mapMarkerLimit {
+				break
+			}
+		}
+
+		// The view moved on while this was loading
+		t.loadMu.Lock()
+		stale := seq != t.loadSeq
+		if !stale {
+			t.cancelLoad()
+			t.cancelLoad = nil
+		}
+		t.loadMu.Unlock()
+		if stale {
+			return
+		}
+
+		if err != nil {
+			log.Printf("Map marker query error: %v", err)
+			t.statusLabel.SetText(fmt.Sprintf("Error: %v", err))
+			return
+		}
+
+		if len(repeaters) > mapMarkerLimit {
+			t.view.SetMarkers(repeaters[:mapMarkerLimit])
+			t.statusLabel.SetText(fmt.Sprintf("Showing %d repeaters, zoom in to see them all", mapMarkerLimit))
+			return
+		}
+		t.view.SetMarkers(repeaters)
+		t.statusLabel.SetText(fmt.Sprintf("%d repeaters in view", len(repeaters)))
+	}()
+}
+
+func (t *MapTab) GetContainer() fyne.CanvasObject {
+	zoomIn := widget.NewButtonWithIcon("", theme.ZoomInIcon(), func() { t.view.Zoom(1) })
+	zoomOut := widget.NewButtonWithIcon("", theme.ZoomOutIcon(), func() { t.view.Zoom(-1) })
+	centerOnMe := widget.NewButtonWithIcon("Center on Me", theme.HomeIcon(), t.CenterOnMe)
+
+	toolbar := container.NewHBox(zoomIn, zoomOut, centerOnMe, t.statusLabel)
+	return container.NewBorder(toolbar, nil, nil, nil, t.view)
+}
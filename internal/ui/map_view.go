@@ -0,0 +1,476 @@
+package ui
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/jpeg" // Some tile servers serve JPEG
+	_ "image/png"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// Slippy-map view: Web Mercator tiles, positions are kept in "world pixels" at the current zoom,
+// where the whole world is mapTileSize * 2^zoom pixels square
+
+const (
+	mapTileSize       = 256
+	mapMarkerSize     = 10
+	mapTapRadius      = 10                     // How close a tap has to be to a marker to open it, in pixels
+	mapMaxTiles       = 512                    // Decoded tiles kept in memory before the cache is cleared
+	mapViewChangeWait = 300 * time.Millisecond // Quiet time after panning or zooming before OnViewChanged
+	mapTileRetryWait  = 30 * time.Second       // How long a tile that failed to load is left before trying again
+	mapTileFetches    = 2                      // Tiles downloaded at once, the OSM tile usage policy allows two
+)
+
+type mapTileKey struct{ z, x, y int }
+
+// mapView is a pannable, zoomable tile map with a marker per repeater
+// Drag to pan, scroll to zoom, tap a marker to select it
+type mapView struct {
+	widget.BaseWidget
+	tiles *api.TileClient
+
+	mu       sync.Mutex
+	zoom     int
+	centerX  float64 // View center in world pixels
+	centerY  float64
+	images   map[mapTileKey]*canvas.Image // Loaded tiles
+	failed   map[mapTileKey]time.Time     // When tiles that couldn't be loaded last failed
+	loading  map[mapTileKey]bool          // Queued or downloading
+	queue    []mapTileKey                 // Tiles waiting for a download, in the order they were wanted
+	fetchers int                          // Download goroutines running, at most mapTileFetches
+	markers  []database.RepeaterRecord
+	lastSize fyne.Size
+
+	viewTimer *time.Timer
+
+	// OnViewChanged is called with the visible area once the view stops moving, see VisibleBounds
+	OnViewChanged func(boxes []database.BoundingBox)
+	// OnMarkerTapped is called with the repeater whose marker was tapped
+	OnMarkerTapped func(r database.RepeaterRecord)
+}
+
+func newMapView(tiles *api.TileClient, lat, lng float64, zoom int) *mapView {
+	m := &mapView{
+		tiles:   tiles,
+		images:  make(map[mapTileKey]*canvas.Image),
+		failed:  make(map[mapTileKey]time.Time),
+		loading: make(map[mapTileKey]bool),
+	}
+	m.setCenterLocked(lat, lng, zoom)
+	m.ExtendBaseWidget(m)
+	return m
+}
+
+// SetCenter moves the view to lat/lng at a zoom level
+func (m *mapView) SetCenter(lat, lng float64, zoom int) {
+	m.mu.Lock()
+	m.setCenterLocked(lat, lng, zoom)
+	m.mu.Unlock()
+	m.Refresh()
+	m.viewChanged()
+}
+
+func (m *mapView) setCenterLocked(lat, lng float64, zoom int) {
+	m.zoom = clampZoom(zoom)
+	m.centerX = lngToWorldX(lng, m.zoom)
+	m.centerY = latToWorldY(lat, m.zoom)
+}
+
+// SetMarkers replaces the repeaters shown on the map
+func (m *mapView) SetMarkers(repeaters []database.RepeaterRecord) {
+	m.mu.Lock()
+	m.markers = repeaters
+	m.mu.Unlock()
+	m.Refresh()
+}
+
+// Zoom zooms in (positive) or out (negative) around the view center
+func (m *mapView) Zoom(delta int) {
+	size := m.Size()
+	m.zoomAt(delta, fyne.NewPos(size.Width/2, size.Height/2))
+}
+
+// zoomAt changes the zoom level keeping the point under pos where it is
+func (m *mapView) zoomAt(delta int, pos fyne.Position) {
+	m.mu.Lock()
+	zoom := clampZoom(m.zoom + delta)
+	if zoom == m.zoom {
+		m.mu.Unlock()
+		return
+	}
+
+	size := m.Size()
+	scale := math.Pow(2, float64(zoom-m.zoom))
+	pointX := m.centerX - float64(size.Width)/2 + float64(pos.X)
+	pointY := m.centerY - float64(size.Height)/2 + float64(pos.Y)
+	m.centerX = pointX*scale + float64(size.Width)/2 - float64(pos.X)
+	m.centerY = pointY*scale + float64(size.Height)/2 - float64(pos.Y)
+	m.zoom = zoom
+	m.clampCenterLocked()
+	m.mu.Unlock()
+
+	m.Refresh()
+	m.viewChanged()
+}
+
+// Dragged pans the map
+func (m *mapView) Dragged(e *fyne.DragEvent) {
+	m.mu.Lock()
+	m.centerX -= float64(e.Dragged.DX)
+	m.centerY -= float64(e.Dragged.DY)
+	m.clampCenterLocked()
+	m.mu.Unlock()
+	m.Refresh()
+}
+
+// DragEnd reloads the markers for the new view
+func (m *mapView) DragEnd() {
+	m.viewChanged()
+}
+
+// Scrolled zooms in and out around the pointer
+func (m *mapView) Scrolled(e *fyne.ScrollEvent) {
+	switch {
+	case e.Scrolled.DY > 0:
+		m.zoomAt(1, e.Position)
+	case e.Scrolled.DY < 0:
+		m.zoomAt(-1, e.Position)
+	}
+}
+
+// Tapped opens the marker nearest the tap, if one is close enough
+func (m *mapView) Tapped(e *fyne.PointEvent) {
+	m.mu.Lock()
+	originX, originY := m.originLocked()
+	best, bestDistance := -1, float64(mapTapRadius)
+	for i, r := range m.markers {
+		x := m.markerXLocked(*r.Longitude, originX)
+		y := latToWorldY(*r.Latitude, m.zoom) - originY
+		if d := math.Hypot(x-float64(e.Position.X), y-float64(e.Position.Y)); d <= bestDistance {
+			best, bestDistance = i, d
+		}
+	}
+	var tapped *database.RepeaterRecord
+	if best >= 0 {
+		r := m.markers[best]
+		tapped = &r
+	}
+	m.mu.Unlock()
+
+	if tapped != nil && m.OnMarkerTapped != nil {
+		m.OnMarkerTapped(*tapped)
+	}
+}
+
+// VisibleBounds returns the area currently in view, as two boxes when the view crosses the date line: the
+// part up to 180 east and the part from 180 west
+func (m *mapView) VisibleBounds() []database.BoundingBox {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.visibleBoundsLocked()
+}
+
+func (m *mapView) visibleBoundsLocked() []database.BoundingBox {
+	size := m.Size()
+	world := worldSize(m.zoom)
+	originX, originY := m.originLocked()
+	west, east := originX, originX+float64(size.Width)
+	box := func(fromX, toX float64) database.BoundingBox {
+		return database.BoundingBox{
+			MinLat: worldYToLat(originY+float64(size.Height), m.zoom),
+			MaxLat: worldYToLat(originY, m.zoom),
+			MinLng: worldXToLng(fromX, m.zoom),
+			MaxLng: worldXToLng(toX, m.zoom),
+		}
+	}
+
+	switch {
+	case east-west >= world:
+		// Zoomed out far enough to see the world more than once
+		return []database.BoundingBox{box(0, world)}
+	case west < 0:
+		return []database.BoundingBox{box(west+world, world), box(0, east)}
+	case east > world:
+		return []database.BoundingBox{box(west, world), box(0, east-world)}
+	default:
+		return []database.BoundingBox{box(west, east)}
+	}
+}
+
+// markerXLocked is where a marker at lng goes across the view, on the copy of the world the view shows
+// east of the date line when it crosses it
+func (m *mapView) markerXLocked(lng, originX float64) float64 {
+	world := worldSize(m.zoom)
+	x := math.Mod(lngToWorldX(lng, m.zoom)-originX, world)
+	if x < 0 {
+		x += world
+	}
+	return x
+}
+
+// viewChanged reports the visible area once panning and zooming have paused
+func (m *mapView) viewChanged() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.viewTimer != nil {
+		m.viewTimer.Stop()
+	}
+	m.viewTimer = time.AfterFunc(mapViewChangeWait, func() {
+		if m.OnViewChanged != nil {
+			m.OnViewChanged(m.VisibleBounds())
+		}
+	})
+}
+
+// originLocked is the world pixel at the view's top-left corner
+func (m *mapView) originLocked() (float64, float64) {
+	size := m.Size()
+	return m.centerX - float64(size.Width)/2, m.centerY - float64(size.Height)/2
+}
+
+// clampCenterLocked keeps the view from scrolling past the poles and wraps it around the date line
+func (m *mapView) clampCenterLocked() {
+	world := worldSize(m.zoom)
+	m.centerY = math.Max(0, math.Min(world, m.centerY))
+	m.centerX = math.Mod(m.centerX, world)
+	if m.centerX < 0 {
+		m.centerX += world
+	}
+}
+
+// tileImage returns a loaded tile, queueing a download if it isn't loaded yet
+// Called with m.mu held
+func (m *mapView) tileImage(key mapTileKey) *canvas.Image {
+	if img, ok := m.images[key]; ok {
+		return img
+	}
+	if m.loading[key] {
+		return nil
+	}
+	// A failed tile is tried again after a while, in case the network or tile server was only down briefly
+	if failedAt, ok := m.failed[key]; ok && time.Since(failedAt) < mapTileRetryWait {
+		return nil
+	}
+
+	m.loading[key] = true
+	m.queue = append(m.queue, key)
+	if m.fetchers < mapTileFetches {
+		m.fetchers++
+		go m.fetchTiles()
+	}
+	return nil
+}
+
+// fetchTiles downloads queued tiles until the queue runs dry
+func (m *mapView) fetchTiles() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for len(m.queue) > 0 {
+		key := m.queue[0]
+		m.queue = m.queue[1:]
+		m.mu.Unlock()
+
+		img, err := m.loadTile(key)
+
+		m.mu.Lock()
+		delete(m.loading, key)
+		if err != nil {
+			log.Printf("Map tile %d/%d/%d: %v", key.z, key.x, key.y, err)
+			m.failed[key] = time.Now()
+			continue
+		}
+		delete(m.failed, key)
+		if len(m.images) >= mapMaxTiles {
+			m.images = make(map[mapTileKey]*canvas.Image)
+		}
+		m.images[key] = img
+
+		m.mu.Unlock()
+		m.Refresh()
+		m.mu.Lock()
+	}
+	m.fetchers--
+}
+
+func (m *mapView) loadTile(key mapTileKey) (*canvas.Image, error) {
+	data, err := m.tiles.GetTile(key.z, key.x, key.y)
+	if err != nil {
+		return nil, err
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	img := canvas.NewImageFromImage(decoded)
+	img.FillMode = canvas.ImageFillStretch
+	img.ScaleMode = canvas.ImageScaleFastest
+	return img, nil
+}
+
+func (m *mapView) MinSize() fyne.Size {
+	return fyne.NewSize(300, 300)
+}
+
+func (m *mapView) CreateRenderer() fyne.WidgetRenderer {
+	background := canvas.NewRectangle(color.NRGBA{R: 0xe0, G: 0xe0, B: 0xe0, A: 0xff})
+	attribution := canvas.NewText("© OpenStreetMap contributors", color.NRGBA{A: 0xcc})
+	attribution.TextSize = theme.CaptionTextSize()
+	return &mapRenderer{m: m, background: background, attribution: attribution}
+}
+
+type mapRenderer struct {
+	m           *mapView
+	background  *canvas.Rectangle
+	attribution *canvas.Text
+	objects     []fyne.CanvasObject
+}
+
+func (r *mapRenderer) Layout(size fyne.Size) {
+	r.m.mu.Lock()
+	resized := size != r.m.lastSize
+	r.m.lastSize = size
+	r.m.mu.Unlock()
+
+	r.rebuild(size)
+	if resized && !size.IsZero() {
+		r.m.viewChanged()
+	}
+}
+
+func (r *mapRenderer) MinSize() fyne.Size {
+	return r.m.MinSize()
+}
+
+func (r *mapRenderer) Refresh() {
+	r.rebuild(r.m.Size())
+	canvas.Refresh(r.m)
+}
+
+func (r *mapRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *mapRenderer) Destroy() {}
+
+// rebuild positions the visible tiles and markers for the current view
+func (r *mapRenderer) rebuild(size fyne.Size) {
+	m := r.m
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r.background.Resize(size)
+	objects := []fyne.CanvasObject{r.background}
+
+	originX, originY := m.originLocked()
+	tilesPerSide := 1 << m.zoom
+
+	firstX, lastX := int(math.Floor(originX/mapTileSize)), int(math.Floor((originX+float64(size.Width))/mapTileSize))
+	firstY, lastY := int(math.Floor(originY/mapTileSize)), int(math.Floor((originY+float64(size.Height))/mapTileSize))
+	visible := make(map[mapTileKey]bool)
+	for ty := max(firstY, 0); ty <= min(lastY, tilesPerSide-1); ty++ {
+		for tx := firstX; tx <= lastX; tx++ {
+			// Tiles repeat east and west of the date line
+			key := mapTileKey{z: m.zoom, x: ((tx % tilesPerSide) + tilesPerSide) % tilesPerSide, y: ty}
+			visible[key] = true
+			img := m.tileImage(key)
+			if img == nil {
+				continue
+			}
+			img.Move(fyne.NewPos(float32(float64(tx*mapTileSize)-originX), float32(float64(ty*mapTileSize)-originY)))
+			img.Resize(fyne.NewSize(mapTileSize, mapTileSize))
+			objects = append(objects, img)
+		}
+	}
+
+	// Tiles that scrolled out of view before their turn aren't worth downloading
+	queue := m.queue[:0]
+	for _, key := range m.queue {
+		if visible[key] {
+			queue = append(queue, key)
+		} else {
+			delete(m.loading, key)
+		}
+	}
+	m.queue = queue
+
+	for _, rep := range m.markers {
+		x := m.markerXLocked(*rep.Longitude, originX)
+		y := latToWorldY(*rep.Latitude, m.zoom) - originY
+		if x < 0 || y < 0 || x > float64(size.Width) || y > float64(size.Height) {
+			continue
+		}
+		marker := canvas.NewCircle(markerColor(rep.Mode))
+		marker.StrokeColor = color.NRGBA{A: 0xff}
+		marker.StrokeWidth = 1
+		marker.Move(fyne.NewPos(float32(x)-mapMarkerSize/2, float32(y)-mapMarkerSize/2))
+		marker.Resize(fyne.NewSize(mapMarkerSize, mapMarkerSize))
+		objects = append(objects, marker)
+	}
+
+	textSize := r.attribution.MinSize()
+	r.attribution.Move(fyne.NewPos(size.Width-textSize.Width-4, size.Height-textSize.Height-2))
+	objects = append(objects, r.attribution)
+
+	r.objects = objects
+}
+
+// markerColor colors markers by mode, like the KML export's styles
+func markerColor(mode string) color.Color {
+	m := strings.ToUpper(strings.ReplaceAll(mode, "-", ""))
+	switch {
+	case m == "FM" || m == "NFM" || m == "ANALOG":
+		return color.NRGBA{G: 0xc0, A: 0xff}
+	case strings.Contains(m, "DMR"):
+		return color.NRGBA{R: 0xe0, A: 0xff}
+	case strings.Contains(m, "DSTAR"):
+		return color.NRGBA{B: 0xe0, A: 0xff}
+	case strings.Contains(m, "FUSION") || strings.Contains(m, "YSF") || strings.Contains(m, "C4FM"):
+		return color.NRGBA{R: 0xe0, G: 0xc0, A: 0xff}
+	default:
+		return color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	}
+}
+
+func clampZoom(zoom int) int {
+	return max(config.MinMapZoom, min(config.MaxMapZoom, zoom))
+}
+
+func worldSize(zoom int) float64 {
+	return mapTileSize * math.Exp2(float64(zoom))
+}
+
+// Web Mercator conversions between degrees and world pixels
+
+func lngToWorldX(lng float64, zoom int) float64 {
+	return (lng + 180) / 360 * worldSize(zoom)
+}
+
+func latToWorldY(lat float64, zoom int) float64 {
+	sin := math.Sin(lat * math.Pi / 180)
+	sin = math.Max(-0.9999, math.Min(0.9999, sin)) // The projection goes to infinity at the poles
+	return (0.5 - math.Log((1+sin)/(1-sin))/(4*math.Pi)) * worldSize(zoom)
+}
+
+func worldXToLng(x float64, zoom int) float64 {
+	return x/worldSize(zoom)*360 - 180
+}
+
+func worldYToLat(y float64, zoom int) float64 {
+	n := math.Pi - 2*math.Pi*y/worldSize(zoom)
+	return 180 / math.Pi * math.Atan(math.Sinh(n))
+}
@@ -0,0 +1,189 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+	"github.com/unklstewy/digiLogRT/internal/kml"
+)
+
+// ShowRepeaterDetail opens a window with everything known about a repeater
+// The coverage estimate is shown in units and antenna heights in heightUnits
+// db holds the watchlist, the window offers an alert for when the repeater comes on the air
+func ShowRepeaterDetail(r database.RepeaterRecord, db *database.Database, units config.Units, heightUnits config.HeightUnits) {
+	w := fyne.CurrentApp().NewWindow(fmt.Sprintf("%s - Repeater Details", r.Callsign))
+
+	// Label/value rows, leaving out fields the source didn't provide
+	form := container.New(layout.NewFormLayout())
+	addRow := func(label, value string) {
+		if value == "" {
+			return
+		}
+		valueLabel := widget.NewLabel(value)
+		valueLabel.Wrapping = fyne.TextWrapWord
+		form.Add(widget.NewLabelWithStyle(label, fyne.TextAlignTrailing, fyne.TextStyle{Bold: true}))
+		form.Add(valueLabel)
+	}
+
+	addRow("Callsign", r.Callsign)
+	addRow("Frequency", r.GetFrequencyString())
+	if channel := r.GetChannelName(); channel != "" {
+		addRow("Channel", channel)
+	}
+	if r.OffsetFrequency != nil {
+		offset := fmt.Sprintf("%+.4f MHz", *r.OffsetFrequency)
+		if r.OffsetInferred {
+			offset += " (standard for the band, not listed by the source)"
+		}
+		addRow("Offset", offset)
+	}
+	if r.ToneFrequency != nil {
+		addRow("Tone", fmt.Sprintf("%.1f Hz", *r.ToneFrequency))
+	}
+	addRow("Mode", r.Mode)
+	switch r.DeviceType {
+	case api.DeviceTypeHotspot:
+		addRow("Device Type", "Hotspot")
+	case api.DeviceTypeRepeater:
+		addRow("Device Type", "Repeater")
+	}
+	if r.ColorCode != nil {
+		addRow("Color Code", fmt.Sprintf("%d", *r.ColorCode))
+	}
+	addRow("Digital Modes", stringValue(r.DigitalModes))
+	addRow("Status", repeaterStatus(r))
+	if r.PowerWatts != nil {
+		addRow("Power", fmt.Sprintf("%d watts", *r.PowerWatts))
+	}
+	if r.AntennaHeightAGL != nil {
+		addRow("Antenna (AGL)", heightUnits.Format(*r.AntennaHeightAGL))
+	}
+	if r.AntennaHeightMSL != nil {
+		addRow("Antenna (MSL)", heightUnits.Format(*r.AntennaHeightMSL))
+	}
+	if km, ok := r.EstimateCoverageKm(); ok {
+		addRow("Coverage", fmt.Sprintf("~%s (estimate from height and power, terrain not considered)", units.Format(km)))
+	}
+	addRow("Hardware", stringValue(r.Hardware))
+	addRow("Firmware", stringValue(r.Firmware))
+	if r.LastMaster != nil {
+		addRow("Brandmeister Master", fmt.Sprintf("%d", *r.LastMaster))
+	}
+	location := r.GetLocationString()
+	if r.CityInferred {
+		location += " (nearest city, not listed by the source)"
+	}
+	addRow("Location", location)
+	addRow("Coordinates", r.GetCoordinatesString())
+	addRow("Website", stringValue(r.Website))
+	addRow("Description", stringValue(r.Description))
+	if r.LastSeen != nil {
+		addRow("Last Seen", r.LastSeen.Format("2006-01-02 15:04:05"))
+	}
+	addRow("Last Synced", r.LastAPISync.Format("2006-01-02 15:04:05"))
+	if site, found, err := db.GetAPRSSite(r); err != nil {
+		log.Printf("Failed to look up APRS stations for %s: %v", r.Callsign, err)
+	} else if found {
+		addRow("APRS", site.String())
+	}
+
+	programming := r.ProgrammingString()
+	addRow("Programming", programming)
+
+	copyButton := widget.NewButton("Copy Programming Line", func() {
+		w.Clipboard().SetContent(programming)
+	})
+
+	buttons := container.NewHBox(copyButton)
+
+	watched, err := db.IsWatched(r.ID)
+	if err != nil {
+		log.Printf("Failed to check the watchlist for %s: %v", r.Callsign, err)
+	}
+	watchCheck := widget.NewCheck("Alert when on the air", nil)
+	watchCheck.SetChecked(watched)
+	watchCheck.OnChanged = func(watch bool) {
+		update := db.RemoveFromWatchlist
+		if watch {
+			update = db.AddToWatchlist
+		}
+		if err := update(r.ID); err != nil {
+			log.Printf("Failed to update the watchlist for %s: %v", r.Callsign, err)
+			dialog.ShowError(err, w)
+		}
+	}
+	buttons.Add(watchCheck)
+
+	if r.Latitude != nil && r.Longitude != nil {
+		earthButton := widget.NewButton("Open in Google Earth", func() {
+			if err := openRepeaterInGoogleEarth(r); err != nil {
+				log.Printf("Failed to open %s in Google Earth: %v", r.Callsign, err)
+				dialog.ShowError(err, w)
+			}
+		})
+		buttons.Add(earthButton)
+
+		mapURL, err := url.Parse(fmt.Sprintf("https://www.google.com/maps/search/?api=1&query=%.6f,%.6f",
+			*r.Latitude, *r.Longitude))
+		if err == nil {
+			buttons.Add(widget.NewHyperlink("View on map", mapURL))
+		}
+	}
+
+	buttons.Add(layout.NewSpacer())
+	buttons.Add(widget.NewButton("Close", w.Close))
+
+	w.SetContent(container.NewBorder(nil, buttons, nil, nil, container.NewVScroll(form)))
+	w.Resize(fyne.NewSize(520, 560))
+	w.Show()
+}
+
+// openRepeaterInGoogleEarth writes a single-placemark KML file and opens it with the default application
+func openRepeaterInGoogleEarth(r database.RepeaterRecord) error {
+	data, err := kml.BuildRepeaterKML(r)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(os.TempDir(), "digiLogRT", "kml")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create KML directory: %v", err)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("repeater_%d.kml", r.ID))
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write KML file: %v", err)
+	}
+
+	return fyne.CurrentApp().OpenURL(&url.URL{Scheme: "file", Path: filepath.ToSlash(path)})
+}
+
+func repeaterStatus(r database.RepeaterRecord) string {
+	switch {
+	case !r.Operational:
+		return "Off the air"
+	case r.OnlineStatus:
+		return "Online"
+	default:
+		return "Offline"
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
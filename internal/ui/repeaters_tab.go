@@ -0,0 +1,232 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// repeaterSearchLimit caps how many rows one search shows
+const repeaterSearchLimit = 200
+
+type RepeatersTab struct {
+	db             *database.Database
+	units          config.Units
+	heightUnits    config.HeightUnits
+	searchSettings config.SearchSettings // Minimum power every search applies
+	searchEntry    *widget.Entry
+	searchButton   *widget.Button
+	callButton     *widget.Button
+	onlineCheck    *widget.Check
+	hotspotCheck   *widget.Check
+	resultsList    *widget.List
+	statusLabel    *widget.Label
+	results        []database.RepeaterRecord
+
+	// In-flight search tracking so a new search supersedes, and cancels, the previous one
+	searchMu     sync.Mutex
+	cancelSearch context.CancelFunc
+	searchSeq    uint64
+}
+
+func NewRepeatersTab(cfg *config.Config, db *database.Database) *RepeatersTab {
+	searchEntry := widget.NewEntry()
+	searchEntry.SetPlaceHolder("Callsign, city, state or country")
+
+	tab := &RepeatersTab{
+		db:             db,
+		units:          cfg.Units,
+		heightUnits:    cfg.HeightUnits,
+		searchSettings: cfg.Search,
+		searchEntry:    searchEntry,
+		statusLabel:    widget.NewLabel("Ready"),
+	}
+
+	tab.resultsList = widget.NewList(
+		func() int { return len(tab.results) },
+		func() fyne.CanvasObject {
+			label := widget.NewLabel("")
+			label.Truncation = fyne.TextTruncateEllipsis
+			return label
+		},
+		func(id widget.ListItemID, item fyne.CanvasObject) {
+			r := tab.results[id]
+			item.(*widget.Label).SetText(fmt.Sprintf("%-10s %-9s %s — %s",
+				r.Callsign, r.Mode, r.GetFrequencyString(), r.GetLocationString()))
+		},
+	)
+	tab.resultsList.OnSelected = func(id widget.ListItemID) {
+		ShowRepeaterDetail(tab.results[id], db, tab.units, tab.heightUnits)
+		tab.resultsList.Unselect(id) // Allow the same row to be opened again
+	}
+
+	tab.searchButton = widget.NewButton("Search", tab.search)
+	tab.callButton = widget.NewButton("Callsign listings", tab.callsignListings)
+	tab.onlineCheck = widget.NewCheck("Online only", nil)
+	tab.hotspotCheck = widget.NewCheck("Include hotspots", nil)
+	searchEntry.OnSubmitted = func(string) { tab.search() }
+
+	return tab
+}
+
+func (t *RepeatersTab) search() {
+	query := strings.TrimSpace(t.searchEntry.Text)
+	if query == "" {
+		t.statusLabel.SetText("Please enter something to search for")
+		return
+	}
+
+	onlineOnly := t.onlineCheck.Checked
+	devices := database.SearchDeviceFilter(t.searchSettings)
+	devices.IncludeHotspots = t.hotspotCheck.Checked
+	ctx, seq := t.startSearch()
+	t.statusLabel.SetText("Searching...")
+	go func() {
+		defer t.finishSearch(seq)
+
+		results, err := t.db.SearchRepeatersContext(ctx, query, repeaterSearchLimit, onlineOnly, devices)
+		if ctx.Err() == context.Canceled {
+			return // A newer search replaced this one
+		}
+		if err != nil {
+			log.Printf("Repeater search error: %v", err)
+			t.statusLabel.SetText(fmt.Sprintf("Error: %v", err))
+			return
+		}
+
+		// Nothing matched exactly, so try allowing for typos
+		fuzzy := false
+		if len(results) == 0 {
+			results, err = t.fuzzySearch(ctx, query, onlineOnly, devices)
+			if ctx.Err() == context.Canceled {
+				return
+			}
+			if err != nil {
+				log.Printf("Fuzzy repeater search error: %v", err)
+				t.statusLabel.SetText(fmt.Sprintf("Error: %v", err))
+				return
+			}
+			fuzzy = len(results) > 0
+		}
+
+		t.results = results
+		t.resultsList.UnselectAll()
+		t.resultsList.Refresh()
+
+		if fuzzy {
+			t.statusLabel.SetText(fmt.Sprintf("No exact matches for '%s', showing %d close match(es) - click one for details", query, len(results)))
+		} else if len(results) >= repeaterSearchLimit {
+			t.statusLabel.SetText(fmt.Sprintf("Showing the first %d matches for '%s' - click one for details", len(results), query))
+		} else {
+			t.statusLabel.SetText(fmt.Sprintf("Found %d repeater(s) for '%s' - click one for details", len(results), query))
+		}
+	}()
+}
+
+// callsignListings shows every source's listings of the callsign in the search box, suffixed ones like
+// W3ABC/R included, so a trustee can check their repeaters are listed correctly everywhere
+func (t *RepeatersTab) callsignListings() {
+	callsign := strings.TrimSpace(t.searchEntry.Text)
+	if callsign == "" {
+		t.statusLabel.SetText("Please enter a callsign to look up")
+		return
+	}
+
+	ctx, seq := t.startSearch()
+	t.statusLabel.SetText("Looking up listings...")
+	go func() {
+		defer t.finishSearch(seq)
+
+		results, err := t.db.GetRepeatersByCallsignContext(ctx, callsign, false)
+		if ctx.Err() == context.Canceled {
+			return // A newer search replaced this one
+		}
+		if err != nil {
+			log.Printf("Callsign lookup error: %v", err)
+			t.statusLabel.SetText(fmt.Sprintf("Error: %v", err))
+			return
+		}
+
+		t.results = results
+		t.resultsList.UnselectAll()
+		t.resultsList.Refresh()
+
+		sources := make(map[int]bool)
+		for _, r := range results {
+			sources[r.SourceID] = true
+		}
+		t.statusLabel.SetText(fmt.Sprintf("Found %d listing(s) of %s from %d source(s) - click one for details",
+			len(results), strings.ToUpper(callsign), len(sources)))
+	}()
+}
+
+// fuzzySearch runs the typo-tolerant search, applying the online filter to its results
+func (t *RepeatersTab) fuzzySearch(ctx context.Context, query string, onlineOnly bool, devices database.DeviceFilter) ([]database.RepeaterRecord, error) {
+	results, err := t.db.SearchRepeatersFuzzyContext(ctx, query, repeaterSearchLimit, devices)
+	if err != nil || !onlineOnly {
+		return results, err
+	}
+
+	var online []database.RepeaterRecord
+	for _, r := range results {
+		if r.OnlineStatus {
+			online = append(online, r)
+		}
+	}
+	return online, nil
+}
+
+// startSearch cancels any in-flight search and returns the context and sequence number for a new one
+// The database's query timeout still bounds the search
+func (t *RepeatersTab) startSearch() (context.Context, uint64) {
+	t.searchMu.Lock()
+	defer t.searchMu.Unlock()
+
+	if t.cancelSearch != nil {
+		t.cancelSearch()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancelSearch = cancel
+	t.searchSeq++
+	return ctx, t.searchSeq
+}
+
+// finishSearch releases the search's context unless a newer search has replaced it
+func (t *RepeatersTab) finishSearch(seq uint64) {
+	t.searchMu.Lock()
+	defer t.searchMu.Unlock()
+
+	if seq == t.searchSeq && t.cancelSearch != nil {
+		t.cancelSearch()
+		t.cancelSearch = nil
+	}
+}
+
+func (t *RepeatersTab) GetContainer() fyne.CanvasObject {
+	searchForm := container.NewBorder(
+		nil, nil,
+		widget.NewLabel("Search:"), container.NewHBox(t.onlineCheck, t.hotspotCheck, t.searchButton, t.callButton),
+		t.searchEntry,
+	)
+
+	header := container.NewVBox(
+		widget.NewLabel("Repeater Information"),
+		widget.NewSeparator(),
+		searchForm,
+	)
+
+	status := container.NewHBox(widget.NewLabel("Status:"), t.statusLabel)
+
+	// The list fills the remaining space so long result sets scroll
+	return container.NewBorder(header, status, nil, nil, t.resultsList)
+}
@@ -0,0 +1,142 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/maidenhead"
+)
+
+// SettingsTab edits the user's station location and saves it to the config file
+type SettingsTab struct {
+	cfg         *config.Config
+	latEntry    *widget.Entry
+	lngEntry    *widget.Entry
+	gridEntry   *widget.Entry
+	statusLabel *widget.Label
+
+	// OnLocationSaved is called after a new location is saved, so tabs searching around it can catch up
+	OnLocationSaved func()
+}
+
+func NewSettingsTab(cfg *config.Config) *SettingsTab {
+	tab := &SettingsTab{
+		cfg:         cfg,
+		latEntry:    widget.NewEntry(),
+		lngEntry:    widget.NewEntry(),
+		gridEntry:   widget.NewEntry(),
+		statusLabel: widget.NewLabel("Ready"),
+	}
+	tab.latEntry.SetPlaceHolder("e.g. 39.95")
+	tab.lngEntry.SetPlaceHolder("e.g. -75.16 (negative is west)")
+	tab.gridEntry.SetPlaceHolder("e.g. FM29kw")
+
+	if cfg.Location.Latitude != 0 || cfg.Location.Longitude != 0 {
+		tab.latEntry.SetText(strconv.FormatFloat(cfg.Location.Latitude, 'f', -1, 64))
+		tab.lngEntry.SetText(strconv.FormatFloat(cfg.Location.Longitude, 'f', -1, 64))
+	}
+	tab.gridEntry.SetText(cfg.Location.Grid)
+
+	return tab
+}
+
+// parseCoordinates reads the latitude/longitude entries, both empty means not set
+func (t *SettingsTab) parseCoordinates() (lat, lng float64, err error) {
+	latText := strings.TrimSpace(t.latEntry.Text)
+	lngText := strings.TrimSpace(t.lngEntry.Text)
+	if latText == "" && lngText == "" {
+		return 0, 0, nil
+	}
+
+	lat, err = strconv.ParseFloat(latText, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid latitude %q", latText)
+	}
+	lng, err = strconv.ParseFloat(lngText, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid longitude %q", lngText)
+	}
+	return lat, lng, nil
+}
+
+// fillFromGrid sets the coordinates to the center of the grid square
+func (t *SettingsTab) fillFromGrid() {
+	lat, lng, err := maidenhead.ToLatLng(strings.TrimSpace(t.gridEntry.Text))
+	if err != nil {
+		t.statusLabel.SetText(fmt.Sprintf("Error: %v", err))
+		return
+	}
+	t.latEntry.SetText(strconv.FormatFloat(lat, 'f', 4, 64))
+	t.lngEntry.SetText(strconv.FormatFloat(lng, 'f', 4, 64))
+	t.statusLabel.SetText("Coordinates set to the center of the grid square")
+}
+
+// fillGrid sets the grid square from the coordinates
+func (t *SettingsTab) fillGrid() {
+	lat, lng, err := t.parseCoordinates()
+	if err == nil {
+		var grid string
+		if grid, err = maidenhead.FromLatLng(lat, lng); err == nil {
+			t.gridEntry.SetText(grid)
+			t.statusLabel.SetText("Grid square set from the coordinates")
+			return
+		}
+	}
+	t.statusLabel.SetText(fmt.Sprintf("Error: %v", err))
+}
+
+// save writes the location to the config file and makes it the current location
+func (t *SettingsTab) save() {
+	lat, lng, err := t.parseCoordinates()
+	if err != nil {
+		t.statusLabel.SetText(fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	location := config.LocationSettings{
+		Latitude:  lat,
+		Longitude: lng,
+		Grid:      strings.TrimSpace(t.gridEntry.Text),
+	}
+	if err := config.SaveLocation(location); err != nil {
+		log.Printf("Failed to save location: %v", err)
+		t.statusLabel.SetText(fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	t.cfg.Location = location
+	t.statusLabel.SetText("Location saved")
+	if t.OnLocationSaved != nil {
+		t.OnLocationSaved()
+	}
+}
+
+func (t *SettingsTab) GetContainer() fyne.CanvasObject {
+	form := widget.NewForm(
+		widget.NewFormItem("Latitude", t.latEntry),
+		widget.NewFormItem("Longitude", t.lngEntry),
+		widget.NewFormItem("Grid Square", t.gridEntry),
+	)
+
+	buttons := container.NewHBox(
+		widget.NewButton("Coordinates from Grid", t.fillFromGrid),
+		widget.NewButton("Grid from Coordinates", t.fillGrid),
+		widget.NewButton("Save", t.save),
+	)
+
+	return container.NewVBox(
+		widget.NewLabel("Your Station"),
+		widget.NewSeparator(),
+		widget.NewLabel("Used to center the map and for nearby searches"),
+		form,
+		buttons,
+		t.statusLabel,
+	)
+}
@@ -1,23 +1,33 @@
 package ui
 
 import (
+	"context"
+	"fmt"
+
+	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
 
+	"github.com/unklstewy/digiLogRT/internal/api"
 	"github.com/unklstewy/digiLogRT/internal/config"
+	"github.com/unklstewy/digiLogRT/internal/database"
+	"github.com/unklstewy/digiLogRT/internal/watch"
 )
 
 type MainTabs struct {
 	Container *container.AppTabs
 	config    *config.Config
+	db        *database.Database
+	statusBar *CacheStatusBar
 }
 
-func NewMainTabs(cfg *config.Config) *MainTabs {
+func NewMainTabs(cfg *config.Config, db *database.Database) *MainTabs {
 	tabs := container.NewAppTabs()
 
 	mainTabs := &MainTabs{
 		Container: tabs,
 		config:    cfg,
+		db:        db,
 	}
 
 	// Dashboard tab
@@ -30,19 +40,33 @@ func NewMainTabs(cfg *config.Config) *MainTabs {
 	)
 	tabs.Append(container.NewTabItem("Dashboard", dashboardContent))
 
-	// Repeaters tab
-	repeatersContent := container.NewVBox(
-		widget.NewLabel("Repeater Information"),
-		widget.NewSeparator(),
-		widget.NewLabel("Search and display repeater data from:"),
-		widget.NewLabel("• hearham.com"),
-		widget.NewLabel("• RepeaterBook.com"),
-		widget.NewLabel("Coming soon..."),
-	)
-	tabs.Append(container.NewTabItem("Repeaters", repeatersContent))
+	// Repeaters tab - searches the synced database
+	if db != nil {
+		repeatersTab := NewRepeatersTab(cfg, db)
+		tabs.Append(container.NewTabItem("Repeaters", repeatersTab.GetContainer()))
+	} else {
+		repeatersContent := container.NewVBox(
+			widget.NewLabel("Repeater Information"),
+			widget.NewSeparator(),
+			widget.NewLabel("No repeater database is available."),
+			widget.NewLabel("Run sync_databases to download repeater data, then restart."),
+		)
+		tabs.Append(container.NewTabItem("Repeaters", repeatersContent))
+	}
+
+	// Map tab - repeaters from the database on an OpenStreetMap base map
+	if db != nil {
+		mapTab := NewMapTab(cfg, db)
+		tabs.Append(container.NewTabItem("Map", mapTab.GetContainer()))
+	}
+
+	// Watchlist alerts, repeaters are added from their detail window
+	if db != nil {
+		go newWatchChecker(cfg, db).Run(context.Background())
+	}
 
 	// APRS tab - now functional!
-	aprsTab := NewAPRSTab(cfg)
+	aprsTab := NewAPRSTab(cfg, db)
 	tabs.Append(container.NewTabItem("APRS", aprsTab.GetContainer()))
 
 	// DMR tab
@@ -68,14 +92,13 @@ func NewMainTabs(cfg *config.Config) *MainTabs {
 	)
 	tabs.Append(container.NewTabItem("Google Earth", earthContent))
 
-	// Settings tab
-	settingsContent := container.NewVBox(
-		widget.NewLabel("Settings & Configuration"),
-		widget.NewSeparator(),
-		widget.NewLabel("Configure API keys and preferences"),
-		widget.NewLabel("Coming soon..."),
-	)
-	tabs.Append(container.NewTabItem("Settings", settingsContent))
+	// Settings tab - the user's station location
+	settingsTab := NewSettingsTab(cfg)
+	settingsTab.OnLocationSaved = aprsTab.updateNearbyButton
+	tabs.Append(container.NewTabItem("Settings", settingsTab.GetContainer()))
+
+	// Stale cache warnings, shown under the tabs
+	mainTabs.statusBar = NewCacheStatusBar(cfg)
 
 	return mainTabs
 }
@@ -83,3 +106,21 @@ func NewMainTabs(cfg *config.Config) *MainTabs {
 func (mt *MainTabs) GetContainer() *container.AppTabs {
 	return mt.Container
 }
+
+// GetContent returns the tabs with the cache status bar under them, for the window content
+func (mt *MainTabs) GetContent() fyne.CanvasObject {
+	return container.NewBorder(nil, mt.statusBar.GetContainer(), nil, nil, mt.Container)
+}
+
+// newWatchChecker returns the watchlist checker, alerting with a desktop notification
+// Brandmeister repeaters are checked live when there's an API key and the app isn't offline
+func newWatchChecker(cfg *config.Config, db *database.Database) *watch.Checker {
+	var brandmeister *api.BrandmeisterClient
+	if cfg.APIs.BrandmeisterKey != "" && !cfg.Offline {
+		brandmeister = api.NewBrandmeisterClient(cfg.APIs, cfg.Cache.Brandmeister)
+	}
+	return watch.NewChecker(db, brandmeister, watch.DefaultInterval, func(r database.RepeaterRecord) {
+		fyne.CurrentApp().SendNotification(fyne.NewNotification("Repeater on the air",
+			fmt.Sprintf("%s (%s) is back on the air", r.Callsign, r.GetFrequencyString())))
+	})
+}
@@ -0,0 +1,142 @@
+package watch
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/unklstewy/digiLogRT/internal/api"
+	"github.com/unklstewy/digiLogRT/internal/database"
+)
+
+// DefaultInterval is how often the watchlist is checked when NewChecker is given an interval <= 0
+const DefaultInterval = 2 * time.Minute
+
+// lastHeardLimit is how many recent Brandmeister transmissions a check looks through
+const lastHeardLimit = 200
+
+// QuietChecksOffline is how many checks in a row must miss a Brandmeister repeater before it counts as off
+// the air again. The last heard list only holds the network's latest transmissions, so a repeater drops off
+// it within minutes of going quiet while it is still up
+const QuietChecksOffline = 5
+
+// Checker alerts when repeaters on the database's watchlist come on the air
+// Brandmeister repeaters are checked live, against the last heard list and the client's device data, which
+// it refreshes as its cache settings say. Other repeaters go by the online status their last sync stored
+// A Checker is not safe for concurrent use, Run makes every check from one goroutine
+type Checker struct {
+	db           *database.Database
+	brandmeister *api.BrandmeisterClient // nil when there's no API key or the app is offline
+	interval     time.Duration
+	onOnline     func(database.RepeaterRecord)
+	quiet        map[int]int // Checks in a row that missed each Brandmeister device last seen on the air
+}
+
+// NewChecker returns a Checker that calls onOnline for each watched repeater found back on the air
+// brandmeister may be nil, Brandmeister repeaters then go by their synced status like the rest
+func NewChecker(db *database.Database, brandmeister *api.BrandmeisterClient, interval time.Duration, onOnline func(database.RepeaterRecord)) *Checker {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Checker{db: db, brandmeister: brandmeister, interval: interval, onOnline: onOnline, quiet: make(map[int]int)}
+}
+
+// Run checks the watchlist now and then every interval until ctx is cancelled
+// A failed check is logged and the next one tries again
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Check(); err != nil {
+			log.Printf("Watchlist check failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check looks at every watched repeater once, calling onOnline for those that have come online since the last check
+func (c *Checker) Check() error {
+	watched, err := c.db.GetWatchlist()
+	if err != nil || len(watched) == 0 {
+		return err
+	}
+
+	brandmeisterSource, err := c.db.GetSourceID("brandmeister")
+	if err != nil {
+		return err
+	}
+	heard := c.heardRepeaters()
+
+	for _, w := range watched {
+		online := w.Repeater.OnlineStatus
+		if w.Repeater.SourceID == brandmeisterSource {
+			online = c.brandmeisterOnline(w, heard)
+		}
+		if online == w.LastOnline {
+			continue
+		}
+
+		if err := c.db.SetWatchedOnline(w.Repeater.ID, online); err != nil {
+			return err
+		}
+		if online {
+			log.Printf("Watched repeater %s (ID %d) is on the air", w.Repeater.Callsign, w.Repeater.ID)
+			c.onOnline(w.Repeater)
+		}
+	}
+	return nil
+}
+
+// heardRepeaters returns the Brandmeister device IDs with a recent transmission, nil when they can't be had
+func (c *Checker) heardRepeaters() map[int]bool {
+	if c.brandmeister == nil {
+		return nil
+	}
+	activity, err := c.brandmeister.GetLastHeard(lastHeardLimit)
+	if err != nil {
+		log.Printf("Watchlist check couldn't get Brandmeister last heard: %v", err)
+		return nil
+	}
+
+	heard := make(map[int]bool, len(activity))
+	for _, a := range activity {
+		heard[a.RepeaterID] = true
+	}
+	return heard
+}
+
+// brandmeisterOnline decides whether a Brandmeister repeater is up, live data first and its synced status last
+// Being heard or its device status says it's up; one that was up stays so until QuietChecksOffline checks miss it
+func (c *Checker) brandmeisterOnline(w database.WatchedRepeater, heard map[int]bool) bool {
+	r := w.Repeater
+	deviceID, err := strconv.Atoi(r.ExternalID)
+	if c.brandmeister == nil || err != nil {
+		return r.OnlineStatus
+	}
+
+	live := heard[deviceID]
+	if !live {
+		device, err := c.brandmeister.GetRepeater(deviceID)
+		if err != nil {
+			return r.OnlineStatus
+		}
+		live = device.IsOnline()
+	}
+	if live || !w.LastOnline {
+		delete(c.quiet, deviceID)
+		return live
+	}
+
+	c.quiet[deviceID]++
+	if c.quiet[deviceID] < QuietChecksOffline {
+		return true
+	}
+	delete(c.quiet, deviceID)
+	return false
+}